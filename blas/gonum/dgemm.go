@@ -5,7 +5,6 @@
 package gonum
 
 import (
-	"runtime"
 	"sync"
 
 	"gonum.org/v1/gonum/blas"
@@ -20,7 +19,7 @@ import (
 // where A is an m×k or k×m dense matrix, B is an n×k or k×n dense matrix, C is
 // an m×n matrix, and alpha and beta are scalars. tA and tB specify whether A or
 // B are transposed.
-func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+func (impl Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
 	switch tA {
 	default:
 		panic(badTranspose)
@@ -116,10 +115,10 @@ func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a
 		}
 	}
 
-	dgemmParallel(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
+	dgemmParallel(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha, impl.workers())
 }
 
-func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, alpha float64) {
+func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, alpha float64, nWorkers int) {
 	// dgemmParallel computes a parallel matrix multiplication by partitioning
 	// a and b into sub-blocks, and updating c with the multiplication of the sub-block
 	// In all cases,
@@ -151,14 +150,14 @@ func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []f
 
 	maxKLen := k
 	parBlocks := blocks(m, blockSize) * blocks(n, blockSize)
-	if parBlocks < minParBlock {
+	if parBlocks < minParBlock || nWorkers < 2 {
 		// The matrix multiplication is small in the dimensions where it can be
-		// computed concurrently. Just do it in serial.
+		// computed concurrently, or only one worker is allowed. Just do it in
+		// serial.
 		dgemmSerial(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
 		return
 	}
 
-	nWorkers := runtime.GOMAXPROCS(0)
 	if parBlocks < nWorkers {
 		nWorkers = parBlocks
 	}
@@ -232,6 +231,10 @@ func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []f
 
 // dgemmSerial is serial matrix multiply
 func dgemmSerial(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, alpha float64) {
+	if f64.HasGemmKernel() && m >= gemmMinMN && n >= gemmMinMN && k >= gemmMinK {
+		dgemmSerialKernel(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
+		return
+	}
 	switch {
 	case !aTrans && !bTrans:
 		dgemmSerialNotNot(m, n, k, a, lda, b, ldb, c, ldc, alpha)
@@ -309,6 +312,122 @@ func dgemmSerialTransTrans(m, n, k int, a []float64, lda int, b []float64, ldb i
 	}
 }
 
+// dgemmPackPool holds the buffers for the packed blocks used by
+// dgemmSerialKernel so that they are not allocated on each call.
+var dgemmPackPool = sync.Pool{
+	New: func() interface{} { return new([]float64) },
+}
+
+// dgemmSerialKernel is serial matrix multiply using the f64.GemmKernel
+// micro-kernel. Blocks of a and b are copied into contiguous panels in the
+// order the kernel reads them, so the transpose cases are handled during
+// packing and alpha is applied to the packed a. Blocks of c at the right and
+// bottom edges that are smaller than the kernel are updated in a temporary
+// buffer.
+func dgemmSerialKernel(aTrans, bTrans bool, m, n, k int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int, alpha float64) {
+	const (
+		mr = f64.GemmMR
+		nr = f64.GemmNR
+	)
+	kc := min(k, gemmKC)
+	mc := (min(m, gemmMC) + mr - 1) / mr * mr
+	nc := (min(n, gemmNC) + nr - 1) / nr * nr
+	size := (mc+nc)*kc + mr*nr
+	bufp := dgemmPackPool.Get().(*[]float64)
+	defer dgemmPackPool.Put(bufp)
+	if cap(*bufp) < size {
+		*bufp = make([]float64, size)
+	}
+	buf := (*bufp)[:size]
+	aPack := buf[:mc*kc]
+	bPack := buf[mc*kc : (mc+nc)*kc]
+	cTmp := buf[(mc+nc)*kc:]
+
+	for jc := 0; jc < n; jc += gemmNC {
+		nb := min(gemmNC, n-jc)
+		for pc := 0; pc < k; pc += gemmKC {
+			kb := min(gemmKC, k-pc)
+			dgemmPackB(bTrans, kb, nb, b, ldb, pc, jc, bPack)
+			for ic := 0; ic < m; ic += gemmMC {
+				mb := min(gemmMC, m-ic)
+				dgemmPackA(aTrans, mb, kb, alpha, a, lda, ic, pc, aPack)
+				for jr := 0; jr < nb; jr += nr {
+					bp := bPack[jr*kb : (jr+nr)*kb]
+					for ir := 0; ir < mb; ir += mr {
+						ap := aPack[ir*kb : (ir+mr)*kb]
+						i := ic + ir
+						j := jc + jr
+						if ir+mr <= mb && jr+nr <= nb {
+							f64.GemmKernel(uintptr(kb), ap, bp, c[i*ldc+j:], uintptr(ldc))
+							continue
+						}
+						rows := min(mr, mb-ir)
+						cols := min(nr, nb-jr)
+						for l := range cTmp {
+							cTmp[l] = 0
+						}
+						for ii := 0; ii < rows; ii++ {
+							copy(cTmp[ii*nr:ii*nr+cols], c[(i+ii)*ldc+j:])
+						}
+						f64.GemmKernel(uintptr(kb), ap, bp, cTmp, nr)
+						for ii := 0; ii < rows; ii++ {
+							copy(c[(i+ii)*ldc+j:(i+ii)*ldc+j+cols], cTmp[ii*nr:])
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// dgemmPackA copies alpha times the m×k block of op(A) starting at row i and
+// column l into dst as a sequence of panels of f64.GemmMR rows, each stored
+// in column-major order. The last panel is padded with zeros.
+func dgemmPackA(aTrans bool, m, k int, alpha float64, a []float64, lda, i, l int, dst []float64) {
+	const mr = f64.GemmMR
+	for ir := 0; ir < m; ir += mr {
+		panel := dst[ir*k : (ir+mr)*k]
+		rows := min(mr, m-ir)
+		for p := 0; p < k; p++ {
+			col := panel[p*mr : p*mr+mr]
+			for ii := 0; ii < rows; ii++ {
+				if aTrans {
+					col[ii] = alpha * a[(l+p)*lda+i+ir+ii]
+				} else {
+					col[ii] = alpha * a[(i+ir+ii)*lda+l+p]
+				}
+			}
+			for ii := rows; ii < mr; ii++ {
+				col[ii] = 0
+			}
+		}
+	}
+}
+
+// dgemmPackB copies the k×n block of op(B) starting at row l and column j
+// into dst as a sequence of panels of f64.GemmNR columns, each stored in
+// row-major order. The last panel is padded with zeros.
+func dgemmPackB(bTrans bool, k, n int, b []float64, ldb, l, j int, dst []float64) {
+	const nr = f64.GemmNR
+	for jr := 0; jr < n; jr += nr {
+		panel := dst[jr*k : (jr+nr)*k]
+		cols := min(nr, n-jr)
+		for p := 0; p < k; p++ {
+			row := panel[p*nr : p*nr+nr]
+			if bTrans {
+				for jj := 0; jj < cols; jj++ {
+					row[jj] = b[(j+jr+jj)*ldb+l+p]
+				}
+			} else {
+				copy(row[:cols], b[(l+p)*ldb+j+jr:])
+			}
+			for jj := cols; jj < nr; jj++ {
+				row[jj] = 0
+			}
+		}
+	}
+}
+
 func sliceView64(a []float64, lda, i, j, r, c int) []float64 {
 	return a[i*lda+j : (i+r-1)*lda+j+c]
 }
@@ -6,9 +6,28 @@
 
 package gonum
 
-import "math"
+import (
+	"math"
+	"runtime"
+	"sync"
 
-type Implementation struct{}
+	"gonum.org/v1/gonum/internal/math32"
+)
+
+// Implementation is the native Go implementation of the BLAS routines.
+//
+// The level 3 routines partition their output into independent blocks that
+// are computed concurrently. Workers specifies the maximum number of
+// goroutines used. If Workers is zero or negative, runtime.GOMAXPROCS(0)
+// goroutines are used, and if Workers is one, the routines are computed
+// serially.
+type Implementation struct {
+	Workers int
+}
+
+// serial is used to compute the blocks of a level 3 routine that has been
+// partitioned for concurrent computation.
+var serial = Implementation{Workers: 1}
 
 // [SD]gemm behavior constants. These are kept here to keep them out of the
 // way during single precision code genration.
@@ -16,6 +35,19 @@ const (
 	blockSize   = 64 // b x b matrix
 	minParBlock = 4  // minimum number of blocks needed to go parallel
 	buffMul     = 4  // how big is the buffer relative to the number of workers
+
+	// Cache blocking sizes for the packed micro-kernel. gemmMC and gemmNC
+	// must be multiples of the kernel block dimensions.
+	gemmMC = 128  // rows of A packed at a time
+	gemmKC = 256  // columns of A and rows of B packed at a time
+	gemmNC = 1024 // columns of B packed at a time
+
+	// Minimum dimensions of a product that is computed with the packed
+	// micro-kernel. Smaller products are faster with the unpacked loops
+	// since the cost of packing is not amortized. The values are the
+	// measured crossover sizes for the float64 kernel.
+	gemmMinMN = 16 // rows and columns of C
+	gemmMinK  = 8  // columns of A and rows of B
 )
 
 // subMul is a common type shared by [SD]gemm.
@@ -37,6 +69,48 @@ func min(a, b int) int {
 	return a
 }
 
+// workers returns the maximum number of goroutines used by the level 3
+// routines.
+func (impl Implementation) workers() int {
+	if impl.Workers > 0 {
+		return impl.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// parallel returns whether a level 3 routine with output that can be
+// partitioned into independent blocks along a dimension of length n, with
+// the other dimension of length m, should be computed concurrently.
+func (impl Implementation) parallel(n, m int) bool {
+	nb := blocks(n, blockSize)
+	return impl.workers() > 1 && nb > 1 && nb*blocks(m, blockSize) >= minParBlock
+}
+
+// forEachBlock calls fn concurrently for each of the consecutive ranges
+// [lo, hi) of length at most blockSize that partition [0, n), using at most
+// impl.workers() goroutines.
+func (impl Implementation) forEachBlock(n int, fn func(lo, hi int)) {
+	nb := blocks(n, blockSize)
+	work := make(chan int, nb)
+	for lo := 0; lo < n; lo += blockSize {
+		work <- lo
+	}
+	close(work)
+
+	nWorkers := min(impl.workers(), nb)
+	var wg sync.WaitGroup
+	wg.Add(nWorkers)
+	for i := 0; i < nWorkers; i++ {
+		go func() {
+			defer wg.Done()
+			for lo := range work {
+				fn(lo, min(lo+blockSize, n))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 // blocks returns the number of divisions of the dimension length with the given
 // block size.
 func blocks(dim, bsize int) int {
@@ -47,3 +121,8 @@ func blocks(dim, bsize int) int {
 func dcabs1(z complex128) float64 {
 	return math.Abs(real(z)) + math.Abs(imag(z))
 }
+
+// scabs1 returns |real(z)|+|imag(z)|.
+func scabs1(z complex64) float32 {
+	return math32.Abs(real(z)) + math32.Abs(imag(z))
+}
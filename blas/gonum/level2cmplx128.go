@@ -304,6 +304,18 @@ func (Implementation) Zgemv(trans blas.Transpose, m, n int, alpha complex128, a
 		return
 	}
 
+	if incX == 1 && incY == 1 {
+		switch trans {
+		default:
+			c128.GemvN(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, y)
+		case blas.Trans:
+			c128.GemvT(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, y)
+		case blas.ConjTrans:
+			c128.GemvC(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, y)
+		}
+		return
+	}
+
 	switch trans {
 	default:
 		// Form y = alpha*A*x + y.
@@ -405,6 +417,11 @@ func (Implementation) Zgerc(m, n int, alpha complex128, x []complex128, incX int
 		return
 	}
 
+	if incX == 1 && incY == 1 {
+		c128.Gerc(uintptr(m), uintptr(n), alpha, x, y, a, uintptr(lda))
+		return
+	}
+
 	var kx, jy int
 	if incX < 0 {
 		kx = (1 - m) * incX
@@ -463,6 +480,11 @@ func (Implementation) Zgeru(m, n int, alpha complex128, x []complex128, incX int
 		return
 	}
 
+	if incX == 1 && incY == 1 {
+		c128.Geru(uintptr(m), uintptr(n), alpha, x, y, a, uintptr(lda))
+		return
+	}
+
 	var kx int
 	if incX < 0 {
 		kx = (1 - m) * incX
@@ -0,0 +1,109 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"strconv"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/testblas"
+)
+
+// level3BenchImpls are the implementations compared by the level 3
+// benchmarks.
+var level3BenchImpls = []struct {
+	name string
+	impl Implementation
+}{
+	{"Serial", Implementation{Workers: 1}},
+	{"Parallel", Implementation{}},
+}
+
+func level3BenchSizes(f func(name string, n int)) {
+	for _, n := range []int{testblas.MediumMat, 500} {
+		f("N"+strconv.Itoa(n), n)
+	}
+}
+
+func BenchmarkDsymm(b *testing.B) {
+	for _, impl := range level3BenchImpls {
+		level3BenchSizes(func(size string, n int) {
+			b.Run(impl.name+size, func(b *testing.B) {
+				testblas.DsymmBenchmark(b, impl.impl, blas.Left, blas.Upper, n, n)
+			})
+		})
+	}
+}
+
+func BenchmarkDsyrk(b *testing.B) {
+	for _, impl := range level3BenchImpls {
+		level3BenchSizes(func(size string, n int) {
+			b.Run(impl.name+size, func(b *testing.B) {
+				testblas.DsyrkBenchmark(b, impl.impl, blas.Upper, blas.NoTrans, n, n)
+			})
+		})
+	}
+}
+
+func BenchmarkDsyr2k(b *testing.B) {
+	for _, impl := range level3BenchImpls {
+		level3BenchSizes(func(size string, n int) {
+			b.Run(impl.name+size, func(b *testing.B) {
+				testblas.Dsyr2kBenchmark(b, impl.impl, blas.Upper, blas.NoTrans, n, n)
+			})
+		})
+	}
+}
+
+func BenchmarkDtrmm(b *testing.B) {
+	for _, impl := range level3BenchImpls {
+		level3BenchSizes(func(size string, n int) {
+			b.Run(impl.name+size, func(b *testing.B) {
+				testblas.DtrmmBenchmark(b, impl.impl, blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, n, n)
+			})
+		})
+	}
+}
+
+func BenchmarkDtrsm(b *testing.B) {
+	for _, impl := range level3BenchImpls {
+		level3BenchSizes(func(size string, n int) {
+			b.Run(impl.name+size, func(b *testing.B) {
+				testblas.DtrsmBenchmark(b, impl.impl, blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, n, n)
+			})
+		})
+	}
+}
+
+func BenchmarkZgemm(b *testing.B) {
+	for _, impl := range level3BenchImpls {
+		level3BenchSizes(func(size string, n int) {
+			b.Run(impl.name+size, func(b *testing.B) {
+				testblas.ZgemmBenchmark(b, impl.impl, blas.NoTrans, blas.NoTrans, n, n, n)
+			})
+		})
+	}
+}
+
+func BenchmarkZherk(b *testing.B) {
+	for _, impl := range level3BenchImpls {
+		level3BenchSizes(func(size string, n int) {
+			b.Run(impl.name+size, func(b *testing.B) {
+				testblas.ZherkBenchmark(b, impl.impl, blas.Upper, blas.NoTrans, n, n)
+			})
+		})
+	}
+}
+
+func BenchmarkZtrsm(b *testing.B) {
+	for _, impl := range level3BenchImpls {
+		level3BenchSizes(func(size string, n int) {
+			b.Run(impl.name+size, func(b *testing.B) {
+				testblas.ZtrsmBenchmark(b, impl.impl, blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, n, n)
+			})
+		})
+	}
+}
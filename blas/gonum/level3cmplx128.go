@@ -19,7 +19,7 @@ var _ blas.Complex128Level3 = Implementation{}
 //  op(X) = X  or  op(X) = X^T  or  op(X) = X^H,
 // alpha and beta are scalars, and A, B and C are matrices, with op(A) an m×k matrix,
 // op(B) a k×n matrix and C an m×n matrix.
-func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
 	switch tA {
 	default:
 		panic(badTranspose)
@@ -72,6 +72,31 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 		panic(shortC)
 	}
 
+	if k > 0 {
+		if m >= n && impl.parallel(m, n) {
+			// The rows of C are independent.
+			off := lda
+			if tA != blas.NoTrans {
+				off = 1
+			}
+			impl.forEachBlock(m, func(lo, hi int) {
+				serial.Zgemm(tA, tB, hi-lo, n, k, alpha, a[lo*off:], lda, b, ldb, beta, c[lo*ldc:], ldc)
+			})
+			return
+		}
+		if impl.parallel(n, m) {
+			// The columns of C are independent.
+			off := 1
+			if tB != blas.NoTrans {
+				off = ldb
+			}
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Zgemm(tA, tB, m, hi-lo, k, alpha, a, lda, b[lo*off:], ldb, beta, c[lo:], ldc)
+			})
+			return
+		}
+	}
+
 	// Quick return if possible.
 	if (alpha == 0 || k == 0) && beta == 1 {
 		return
@@ -263,7 +288,7 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 // where alpha and beta are scalars, A is an m×m or n×n hermitian matrix and B
 // and C are m×n matrices. The imaginary parts of the diagonal elements of A are
 // assumed to be zero.
-func (Implementation) Zhemm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zhemm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -301,6 +326,22 @@ func (Implementation) Zhemm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 		panic(shortC)
 	}
 
+	if side == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of C are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Zhemm(side, uplo, m, hi-lo, alpha, a, lda, b[lo:], ldb, beta, c[lo:], ldc)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of C are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Zhemm(side, uplo, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb, beta, c[lo*ldc:], ldc)
+		})
+		return
+	}
+
 	// Quick return if possible.
 	if alpha == 0 && beta == 1 {
 		return
@@ -412,7 +453,7 @@ func (Implementation) Zhemm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 //
 // The imaginary parts of the diagonal elements of C are assumed to be zero, and
 // on return they will be set to zero.
-func (Implementation) Zherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha float64, a []complex128, lda int, beta float64, c []complex128, ldc int) {
+func (impl Implementation) Zherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha float64, a []complex128, lda int, beta float64, c []complex128, ldc int) {
 	var rowA, colA int
 	switch trans {
 	default:
@@ -448,6 +489,29 @@ func (Implementation) Zherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 		panic(shortC)
 	}
 
+	if k > 0 && impl.parallel(n, n) {
+		// Each block of rows of the triangle of C is updated independently,
+		// the diagonal block by a rank-k update and the off-diagonal block by
+		// a matrix multiplication. off is the offset between rows of op(A).
+		ta, tb := blas.NoTrans, blas.ConjTrans
+		off := lda
+		if trans != blas.NoTrans {
+			ta, tb = blas.ConjTrans, blas.NoTrans
+			off = 1
+		}
+		impl.forEachBlock(n, func(lo, hi int) {
+			serial.Zherk(uplo, trans, hi-lo, k, alpha, a[lo*off:], lda, beta, c[lo*ldc+lo:], ldc)
+			j0, j1 := hi, n
+			if uplo == blas.Lower {
+				j0, j1 = 0, lo
+			}
+			if j0 < j1 {
+				serial.Zgemm(ta, tb, hi-lo, j1-j0, k, complex(alpha, 0), a[lo*off:], lda, a[j0*off:], lda, complex(beta, 0), c[lo*ldc+j0:], ldc)
+			}
+		})
+		return
+	}
+
 	// Quick return if possible.
 	if (alpha == 0 || k == 0) && beta == 1 {
 		return
@@ -610,7 +674,7 @@ func (Implementation) Zherk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 //
 // The imaginary parts of the diagonal elements of C are assumed to be zero, and
 // on return they will be set to zero.
-func (Implementation) Zher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta float64, c []complex128, ldc int) {
+func (impl Implementation) Zher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta float64, c []complex128, ldc int) {
 	var row, col int
 	switch trans {
 	default:
@@ -651,6 +715,32 @@ func (Implementation) Zher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 		panic(shortC)
 	}
 
+	if k > 0 && impl.parallel(n, n) {
+		// Each block of rows of the triangle of C is updated independently,
+		// the diagonal block by a rank-2k update and the off-diagonal block by
+		// two matrix multiplications. offA and offB are the offsets between
+		// rows of op(A) and op(B).
+		ta, tb := blas.NoTrans, blas.ConjTrans
+		offA, offB := lda, ldb
+		if trans != blas.NoTrans {
+			ta, tb = blas.ConjTrans, blas.NoTrans
+			offA, offB = 1, 1
+		}
+		impl.forEachBlock(n, func(lo, hi int) {
+			serial.Zher2k(uplo, trans, hi-lo, k, alpha, a[lo*offA:], lda, b[lo*offB:], ldb, beta, c[lo*ldc+lo:], ldc)
+			j0, j1 := hi, n
+			if uplo == blas.Lower {
+				j0, j1 = 0, lo
+			}
+			if j0 < j1 {
+				cij := c[lo*ldc+j0:]
+				serial.Zgemm(ta, tb, hi-lo, j1-j0, k, alpha, a[lo*offA:], lda, b[j0*offB:], ldb, complex(beta, 0), cij, ldc)
+				serial.Zgemm(ta, tb, hi-lo, j1-j0, k, cmplx.Conj(alpha), b[lo*offB:], ldb, a[j0*offA:], lda, 1, cij, ldc)
+			}
+		})
+		return
+	}
+
 	// Quick return if possible.
 	if (alpha == 0 || k == 0) && beta == 1 {
 		return
@@ -803,7 +893,7 @@ func (Implementation) Zher2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 //  C = alpha*B*A + beta*C  if side == blas.Right
 // where alpha and beta are scalars, A is an m×m or n×n symmetric matrix and B
 // and C are m×n matrices.
-func (Implementation) Zsymm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zsymm(side blas.Side, uplo blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -841,6 +931,22 @@ func (Implementation) Zsymm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 		panic(shortC)
 	}
 
+	if side == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of C are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Zsymm(side, uplo, m, hi-lo, alpha, a, lda, b[lo:], ldb, beta, c[lo:], ldc)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of C are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Zsymm(side, uplo, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb, beta, c[lo*ldc:], ldc)
+		})
+		return
+	}
+
 	// Quick return if possible.
 	if alpha == 0 && beta == 1 {
 		return
@@ -947,7 +1053,7 @@ func (Implementation) Zsymm(side blas.Side, uplo blas.Uplo, m, n int, alpha comp
 //  C = alpha*A^T*A + beta*C  if trans == blas.Trans
 // where alpha and beta are scalars, C is an n×n symmetric matrix and A is
 // an n×k matrix in the first case and a k×n matrix in the second case.
-func (Implementation) Zsyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zsyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, beta complex128, c []complex128, ldc int) {
 	var rowA, colA int
 	switch trans {
 	default:
@@ -983,6 +1089,29 @@ func (Implementation) Zsyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 		panic(shortC)
 	}
 
+	if k > 0 && impl.parallel(n, n) {
+		// Each block of rows of the triangle of C is updated independently,
+		// the diagonal block by a rank-k update and the off-diagonal block by
+		// a matrix multiplication. off is the offset between rows of op(A).
+		ta, tb := blas.NoTrans, blas.Trans
+		off := lda
+		if trans != blas.NoTrans {
+			ta, tb = blas.Trans, blas.NoTrans
+			off = 1
+		}
+		impl.forEachBlock(n, func(lo, hi int) {
+			serial.Zsyrk(uplo, trans, hi-lo, k, alpha, a[lo*off:], lda, beta, c[lo*ldc+lo:], ldc)
+			j0, j1 := hi, n
+			if uplo == blas.Lower {
+				j0, j1 = 0, lo
+			}
+			if j0 < j1 {
+				serial.Zgemm(ta, tb, hi-lo, j1-j0, k, alpha, a[lo*off:], lda, a[j0*off:], lda, beta, c[lo*ldc+j0:], ldc)
+			}
+		})
+		return
+	}
+
 	// Quick return if possible.
 	if (alpha == 0 || k == 0) && beta == 1 {
 		return
@@ -1092,7 +1221,7 @@ func (Implementation) Zsyrk(uplo blas.Uplo, trans blas.Transpose, n, k int, alph
 //  C = alpha*A^T*B + alpha*B^T*A + beta*C  if trans == blas.Trans
 // where alpha and beta are scalars, C is an n×n symmetric matrix and A and B
 // are n×k matrices in the first case and k×n matrices in the second case.
-func (Implementation) Zsyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+func (impl Implementation) Zsyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
 	var row, col int
 	switch trans {
 	default:
@@ -1133,6 +1262,32 @@ func (Implementation) Zsyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 		panic(shortC)
 	}
 
+	if k > 0 && impl.parallel(n, n) {
+		// Each block of rows of the triangle of C is updated independently,
+		// the diagonal block by a rank-2k update and the off-diagonal block by
+		// two matrix multiplications. offA and offB are the offsets between
+		// rows of op(A) and op(B).
+		ta, tb := blas.NoTrans, blas.Trans
+		offA, offB := lda, ldb
+		if trans != blas.NoTrans {
+			ta, tb = blas.Trans, blas.NoTrans
+			offA, offB = 1, 1
+		}
+		impl.forEachBlock(n, func(lo, hi int) {
+			serial.Zsyr2k(uplo, trans, hi-lo, k, alpha, a[lo*offA:], lda, b[lo*offB:], ldb, beta, c[lo*ldc+lo:], ldc)
+			j0, j1 := hi, n
+			if uplo == blas.Lower {
+				j0, j1 = 0, lo
+			}
+			if j0 < j1 {
+				cij := c[lo*ldc+j0:]
+				serial.Zgemm(ta, tb, hi-lo, j1-j0, k, alpha, a[lo*offA:], lda, b[j0*offB:], ldb, beta, cij, ldc)
+				serial.Zgemm(ta, tb, hi-lo, j1-j0, k, alpha, b[lo*offB:], ldb, a[j0*offA:], lda, 1, cij, ldc)
+			}
+		})
+		return
+	}
+
 	// Quick return if possible.
 	if (alpha == 0 || k == 0) && beta == 1 {
 		return
@@ -1268,7 +1423,7 @@ func (Implementation) Zsyr2k(uplo blas.Uplo, trans blas.Transpose, n, k int, alp
 //  op(A) = A    if trans == blas.NoTrans,
 //  op(A) = A^T  if trans == blas.Trans,
 //  op(A) = A^H  if trans == blas.ConjTrans.
-func (Implementation) Ztrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
+func (impl Implementation) Ztrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -1305,6 +1460,22 @@ func (Implementation) Ztrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose
 		panic(shortB)
 	}
 
+	if side == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of B are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Ztrmm(side, uplo, trans, diag, m, hi-lo, alpha, a, lda, b[lo:], ldb)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of B are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Ztrmm(side, uplo, trans, diag, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb)
+		})
+		return
+	}
+
 	// Quick return if possible.
 	if alpha == 0 {
 		for i := 0; i < m; i++ {
@@ -1497,7 +1668,7 @@ func (Implementation) Ztrmm(side blas.Side, uplo blas.Uplo, trans blas.Transpose
 //  op(A) = A^T  if transA == blas.Trans,
 //  op(A) = A^H  if transA == blas.ConjTrans.
 // On return the matrix X is overwritten on B.
-func (Implementation) Ztrsm(side blas.Side, uplo blas.Uplo, transA blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
+func (impl Implementation) Ztrsm(side blas.Side, uplo blas.Uplo, transA blas.Transpose, diag blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
 	na := m
 	if side == blas.Right {
 		na = n
@@ -1534,6 +1705,22 @@ func (Implementation) Ztrsm(side blas.Side, uplo blas.Uplo, transA blas.Transpos
 		panic(shortB)
 	}
 
+	if side == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of B are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Ztrsm(side, uplo, transA, diag, m, hi-lo, alpha, a, lda, b[lo:], ldb)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of B are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Ztrsm(side, uplo, transA, diag, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb)
+		})
+		return
+	}
+
 	if alpha == 0 {
 		for i := 0; i < m; i++ {
 			for j := 0; j < n; j++ {
@@ -23,7 +23,7 @@ var _ blas.Float64Level3 = Implementation{}
 // stored in-place into X.
 //
 // No check is made that A is invertible.
-func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+func (impl Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
 	if s != blas.Left && s != blas.Right {
 		panic(badSide)
 	}
@@ -66,6 +66,22 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		panic(shortB)
 	}
 
+	if s == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of B are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Dtrsm(s, ul, tA, d, m, hi-lo, alpha, a, lda, b[lo:], ldb)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of B are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Dtrsm(s, ul, tA, d, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb)
+		})
+		return
+	}
+
 	if alpha == 0 {
 		for i := 0; i < m; i++ {
 			btmp := b[i*ldb : i*ldb+n]
@@ -219,7 +235,7 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 //  C = alpha * B * A + beta * C  if side == blas.Right
 // where A is an n×n or m×m symmetric matrix, B and C are m×n matrices, and alpha
 // is a scalar.
-func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+func (impl Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
 	if s != blas.Right && s != blas.Left {
 		panic(badSide)
 	}
@@ -262,6 +278,22 @@ func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64,
 		panic(shortC)
 	}
 
+	if s == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of C are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Dsymm(s, ul, m, hi-lo, alpha, a, lda, b[lo:], ldb, beta, c[lo:], ldc)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of C are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Dsymm(s, ul, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb, beta, c[lo*ldc:], ldc)
+		})
+		return
+	}
+
 	// Quick return if possible.
 	if alpha == 0 && beta == 1 {
 		return
@@ -360,7 +392,7 @@ func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64,
 //  C = alpha * A^T * A + beta * C  if tA == blas.Trans or tA == blas.ConjTrans
 // where A is an n×k or k×n matrix, C is an n×n symmetric matrix, and alpha and
 // beta are scalars.
-func (Implementation) Dsyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) {
+func (impl Implementation) Dsyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -397,6 +429,29 @@ func (Implementation) Dsyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 		panic(shortC)
 	}
 
+	if k > 0 && impl.parallel(n, n) {
+		// Each block of rows of the triangle of C is updated independently,
+		// the diagonal block by a rank-k update and the off-diagonal block by
+		// a matrix multiplication. off is the offset between rows of op(A).
+		ta, tb := blas.NoTrans, blas.Trans
+		off := lda
+		if tA != blas.NoTrans {
+			ta, tb = blas.Trans, blas.NoTrans
+			off = 1
+		}
+		impl.forEachBlock(n, func(lo, hi int) {
+			serial.Dsyrk(ul, tA, hi-lo, k, alpha, a[lo*off:], lda, beta, c[lo*ldc+lo:], ldc)
+			j0, j1 := hi, n
+			if ul == blas.Lower {
+				j0, j1 = 0, lo
+			}
+			if j0 < j1 {
+				serial.Dgemm(ta, tb, hi-lo, j1-j0, k, alpha, a[lo*off:], lda, a[j0*off:], lda, beta, c[lo*ldc+j0:], ldc)
+			}
+		})
+		return
+	}
+
 	if alpha == 0 {
 		if beta == 0 {
 			if ul == blas.Upper {
@@ -510,7 +565,7 @@ func (Implementation) Dsyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 //  C = alpha * A^T * B + alpha * B^T * A + beta * C  if tA == blas.Trans or tA == blas.ConjTrans
 // where A and B are n×k or k×n matrices, C is an n×n symmetric matrix, and
 // alpha and beta are scalars.
-func (Implementation) Dsyr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+func (impl Implementation) Dsyr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -553,6 +608,32 @@ func (Implementation) Dsyr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha fl
 		panic(shortC)
 	}
 
+	if k > 0 && impl.parallel(n, n) {
+		// Each block of rows of the triangle of C is updated independently,
+		// the diagonal block by a rank-2k update and the off-diagonal block by
+		// two matrix multiplications. offA and offB are the offsets between
+		// rows of op(A) and op(B).
+		ta, tb := blas.NoTrans, blas.Trans
+		offA, offB := lda, ldb
+		if tA != blas.NoTrans {
+			ta, tb = blas.Trans, blas.NoTrans
+			offA, offB = 1, 1
+		}
+		impl.forEachBlock(n, func(lo, hi int) {
+			serial.Dsyr2k(ul, tA, hi-lo, k, alpha, a[lo*offA:], lda, b[lo*offB:], ldb, beta, c[lo*ldc+lo:], ldc)
+			j0, j1 := hi, n
+			if ul == blas.Lower {
+				j0, j1 = 0, lo
+			}
+			if j0 < j1 {
+				cij := c[lo*ldc+j0:]
+				serial.Dgemm(ta, tb, hi-lo, j1-j0, k, alpha, a[lo*offA:], lda, b[j0*offB:], ldb, beta, cij, ldc)
+				serial.Dgemm(ta, tb, hi-lo, j1-j0, k, alpha, b[lo*offB:], ldb, a[j0*offA:], lda, 1, cij, ldc)
+			}
+		})
+		return
+	}
+
 	if alpha == 0 {
 		if beta == 0 {
 			if ul == blas.Upper {
@@ -673,7 +754,7 @@ func (Implementation) Dsyr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha fl
 //  B = alpha * B * A    if tA == blas.NoTrans and side == blas.Right
 //  B = alpha * B * A^T  if tA == blas.Trans or blas.ConjTrans, and side == blas.Right
 // where A is an n×n or m×m triangular matrix, B is an m×n matrix, and alpha is a scalar.
-func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+func (impl Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
 	if s != blas.Left && s != blas.Right {
 		panic(badSide)
 	}
@@ -716,6 +797,22 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		panic(shortB)
 	}
 
+	if s == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of B are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Dtrmm(s, ul, tA, d, m, hi-lo, alpha, a, lda, b[lo:], ldb)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of B are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Dtrmm(s, ul, tA, d, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb)
+		})
+		return
+	}
+
 	if alpha == 0 {
 		for i := 0; i < m; i++ {
 			btmp := b[i*ldb : i*ldb+n]
@@ -0,0 +1,313 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// The level 3 parallel tests compare the results of a concurrent
+// implementation with the results of a serial implementation.
+
+var parallelImpl = Implementation{Workers: 4}
+
+func randSlice(n int, rnd *rand.Rand) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
+
+func randSliceC(n int, rnd *rand.Rand) []complex128 {
+	s := make([]complex128, n)
+	for i := range s {
+		s[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return s
+}
+
+func equalApproxC(a, b []complex128, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if cmplx.Abs(a[i]-b[i]) > tol*(1+cmplx.Abs(b[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// diagDominant returns a k×k matrix with stride lda whose diagonal is large
+// so that triangular solves are well conditioned.
+func diagDominant(k, lda int, rnd *rand.Rand) []float64 {
+	a := randSlice((k-1)*lda+k, rnd)
+	for i := 0; i < k; i++ {
+		a[i*lda+i] += float64(k)
+	}
+	return a
+}
+
+func diagDominantC(k, lda int, rnd *rand.Rand) []complex128 {
+	a := randSliceC((k-1)*lda+k, rnd)
+	for i := 0; i < k; i++ {
+		a[i*lda+i] += complex(float64(k), 0)
+	}
+	return a
+}
+
+var parallelSizes = []struct{ m, n int }{
+	{3, 4},
+	{2*blockSize + 5, 3},
+	{3, 2*blockSize + 5},
+	{2*blockSize + 5, 3*blockSize - 2},
+	{3*blockSize - 2, 2*blockSize + 5},
+}
+
+func TestDtrsmTrmmSymmParallel(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range parallelSizes {
+		m, n := test.m, test.n
+		for _, side := range []blas.Side{blas.Left, blas.Right} {
+			k := n
+			if side == blas.Left {
+				k = m
+			}
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				lda := k + 3
+				ldb := n + 2
+				a := diagDominant(k, lda, rnd)
+				b := randSlice((m-1)*ldb+n, rnd)
+				for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+					for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+						name := fmt.Sprintf("m=%d,n=%d,side=%c,uplo=%c,trans=%c,diag=%c", m, n, side, uplo, trans, diag)
+
+						want := append([]float64(nil), b...)
+						got := append([]float64(nil), b...)
+						serial.Dtrsm(side, uplo, trans, diag, m, n, 1.5, a, lda, want, ldb)
+						parallelImpl.Dtrsm(side, uplo, trans, diag, m, n, 1.5, a, lda, got, ldb)
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("%s: Dtrsm mismatch", name)
+						}
+
+						want = append(want[:0], b...)
+						got = append(got[:0], b...)
+						serial.Dtrmm(side, uplo, trans, diag, m, n, 1.5, a, lda, want, ldb)
+						parallelImpl.Dtrmm(side, uplo, trans, diag, m, n, 1.5, a, lda, got, ldb)
+						if !floats.EqualApprox(got, want, tol) {
+							t.Errorf("%s: Dtrmm mismatch", name)
+						}
+					}
+				}
+
+				ldc := n + 1
+				c := randSlice((m-1)*ldc+n, rnd)
+				want := append([]float64(nil), c...)
+				got := append([]float64(nil), c...)
+				serial.Dsymm(side, uplo, m, n, 1.5, a, lda, b, ldb, 0.5, want, ldc)
+				parallelImpl.Dsymm(side, uplo, m, n, 1.5, a, lda, b, ldb, 0.5, got, ldc)
+				if !floats.EqualApprox(got, want, tol) {
+					t.Errorf("m=%d,n=%d,side=%c,uplo=%c: Dsymm mismatch", m, n, side, uplo)
+				}
+			}
+		}
+	}
+}
+
+func TestDsyrkSyr2kParallel(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range parallelSizes {
+		n, k := test.m, test.n
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				name := fmt.Sprintf("n=%d,k=%d,uplo=%c,trans=%c", n, k, uplo, trans)
+				row, col := n, k
+				if trans != blas.NoTrans {
+					row, col = k, n
+				}
+				lda := col + 3
+				a := randSlice((row-1)*lda+col, rnd)
+				b := randSlice((row-1)*lda+col, rnd)
+				ldc := n + 2
+				c := randSlice((n-1)*ldc+n, rnd)
+
+				want := append([]float64(nil), c...)
+				got := append([]float64(nil), c...)
+				serial.Dsyrk(uplo, trans, n, k, 1.5, a, lda, 0.5, want, ldc)
+				parallelImpl.Dsyrk(uplo, trans, n, k, 1.5, a, lda, 0.5, got, ldc)
+				if !floats.EqualApprox(got, want, tol) {
+					t.Errorf("%s: Dsyrk mismatch", name)
+				}
+
+				want = append(want[:0], c...)
+				got = append(got[:0], c...)
+				serial.Dsyr2k(uplo, trans, n, k, 1.5, a, lda, b, lda, 0.5, want, ldc)
+				parallelImpl.Dsyr2k(uplo, trans, n, k, 1.5, a, lda, b, lda, 0.5, got, ldc)
+				if !floats.EqualApprox(got, want, tol) {
+					t.Errorf("%s: Dsyr2k mismatch", name)
+				}
+			}
+		}
+	}
+}
+
+func TestZgemmParallel(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range parallelSizes {
+		for _, k := range []int{5, blockSize + 3} {
+			m, n := test.m, test.n
+			for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+				for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+					rowA, colA := m, k
+					if tA != blas.NoTrans {
+						rowA, colA = k, m
+					}
+					rowB, colB := k, n
+					if tB != blas.NoTrans {
+						rowB, colB = n, k
+					}
+					lda, ldb, ldc := colA+1, colB+2, n+3
+					a := randSliceC((rowA-1)*lda+colA, rnd)
+					b := randSliceC((rowB-1)*ldb+colB, rnd)
+					c := randSliceC((m-1)*ldc+n, rnd)
+					want := append([]complex128(nil), c...)
+					got := append([]complex128(nil), c...)
+					alpha, beta := complex(1.5, -0.5), complex(0.5, 0.25)
+					serial.Zgemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, want, ldc)
+					parallelImpl.Zgemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, got, ldc)
+					if !equalApproxC(got, want, tol) {
+						t.Errorf("m=%d,n=%d,k=%d,tA=%c,tB=%c: Zgemm mismatch", m, n, k, tA, tB)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestZlevel3Parallel(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	alpha, beta := complex(1.5, -0.5), complex(0.5, 0.25)
+	for _, test := range parallelSizes {
+		m, n := test.m, test.n
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, side := range []blas.Side{blas.Left, blas.Right} {
+				k := n
+				if side == blas.Left {
+					k = m
+				}
+				lda, ldb, ldc := k+1, n+2, n+3
+				a := diagDominantC(k, lda, rnd)
+				b := randSliceC((m-1)*ldb+n, rnd)
+				c := randSliceC((m-1)*ldc+n, rnd)
+				name := fmt.Sprintf("m=%d,n=%d,side=%c,uplo=%c", m, n, side, uplo)
+
+				for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+					for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+						want := append([]complex128(nil), b...)
+						got := append([]complex128(nil), b...)
+						serial.Ztrsm(side, uplo, trans, diag, m, n, alpha, a, lda, want, ldb)
+						parallelImpl.Ztrsm(side, uplo, trans, diag, m, n, alpha, a, lda, got, ldb)
+						if !equalApproxC(got, want, tol) {
+							t.Errorf("%s,trans=%c,diag=%c: Ztrsm mismatch", name, trans, diag)
+						}
+
+						want = append(want[:0], b...)
+						got = append(got[:0], b...)
+						serial.Ztrmm(side, uplo, trans, diag, m, n, alpha, a, lda, want, ldb)
+						parallelImpl.Ztrmm(side, uplo, trans, diag, m, n, alpha, a, lda, got, ldb)
+						if !equalApproxC(got, want, tol) {
+							t.Errorf("%s,trans=%c,diag=%c: Ztrmm mismatch", name, trans, diag)
+						}
+					}
+				}
+
+				want := append([]complex128(nil), c...)
+				got := append([]complex128(nil), c...)
+				serial.Zsymm(side, uplo, m, n, alpha, a, lda, b, ldb, beta, want, ldc)
+				parallelImpl.Zsymm(side, uplo, m, n, alpha, a, lda, b, ldb, beta, got, ldc)
+				if !equalApproxC(got, want, tol) {
+					t.Errorf("%s: Zsymm mismatch", name)
+				}
+
+				// The imaginary part of the diagonal of a Hermitian matrix
+				// must be zero.
+				for i := 0; i < k; i++ {
+					a[i*lda+i] = complex(real(a[i*lda+i]), 0)
+				}
+				want = append(want[:0], c...)
+				got = append(got[:0], c...)
+				serial.Zhemm(side, uplo, m, n, alpha, a, lda, b, ldb, beta, want, ldc)
+				parallelImpl.Zhemm(side, uplo, m, n, alpha, a, lda, b, ldb, beta, got, ldc)
+				if !equalApproxC(got, want, tol) {
+					t.Errorf("%s: Zhemm mismatch", name)
+				}
+			}
+
+			// Rank-k and rank-2k updates with n = m and k = n.
+			nn, k := m, n
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+				name := fmt.Sprintf("n=%d,k=%d,uplo=%c,trans=%c", nn, k, uplo, trans)
+				row, col := nn, k
+				if trans != blas.NoTrans {
+					row, col = k, nn
+				}
+				lda, ldc := col+1, nn+2
+				a := randSliceC((row-1)*lda+col, rnd)
+				b := randSliceC((row-1)*lda+col, rnd)
+				c := randSliceC((nn-1)*ldc+nn, rnd)
+				for i := 0; i < nn; i++ {
+					c[i*ldc+i] = complex(real(c[i*ldc+i]), 0)
+				}
+
+				if trans != blas.ConjTrans {
+					want := append([]complex128(nil), c...)
+					got := append([]complex128(nil), c...)
+					serial.Zsyrk(uplo, trans, nn, k, alpha, a, lda, beta, want, ldc)
+					parallelImpl.Zsyrk(uplo, trans, nn, k, alpha, a, lda, beta, got, ldc)
+					if !equalApproxC(got, want, tol) {
+						t.Errorf("%s: Zsyrk mismatch", name)
+					}
+
+					want = append(want[:0], c...)
+					got = append(got[:0], c...)
+					serial.Zsyr2k(uplo, trans, nn, k, alpha, a, lda, b, lda, beta, want, ldc)
+					parallelImpl.Zsyr2k(uplo, trans, nn, k, alpha, a, lda, b, lda, beta, got, ldc)
+					if !equalApproxC(got, want, tol) {
+						t.Errorf("%s: Zsyr2k mismatch", name)
+					}
+				}
+				if trans != blas.Trans {
+					want := append([]complex128(nil), c...)
+					got := append([]complex128(nil), c...)
+					serial.Zherk(uplo, trans, nn, k, 1.5, a, lda, 0.5, want, ldc)
+					parallelImpl.Zherk(uplo, trans, nn, k, 1.5, a, lda, 0.5, got, ldc)
+					if !equalApproxC(got, want, tol) {
+						t.Errorf("%s: Zherk mismatch", name)
+					}
+
+					want = append(want[:0], c...)
+					got = append(got[:0], c...)
+					serial.Zher2k(uplo, trans, nn, k, alpha, a, lda, b, lda, 0.5, want, ldc)
+					parallelImpl.Zher2k(uplo, trans, nn, k, alpha, a, lda, b, lda, 0.5, got, ldc)
+					if !equalApproxC(got, want, tol) {
+						t.Errorf("%s: Zher2k mismatch", name)
+					}
+				}
+			}
+		}
+	}
+}
@@ -27,7 +27,7 @@ var _ blas.Float32Level3 = Implementation{}
 // No check is made that A is invertible.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+func (impl Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
 	if s != blas.Left && s != blas.Right {
 		panic(badSide)
 	}
@@ -70,6 +70,22 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		panic(shortB)
 	}
 
+	if s == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of B are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Strsm(s, ul, tA, d, m, hi-lo, alpha, a, lda, b[lo:], ldb)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of B are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Strsm(s, ul, tA, d, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb)
+		})
+		return
+	}
+
 	if alpha == 0 {
 		for i := 0; i < m; i++ {
 			btmp := b[i*ldb : i*ldb+n]
@@ -225,7 +241,7 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 // is a scalar.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+func (impl Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
 	if s != blas.Right && s != blas.Left {
 		panic(badSide)
 	}
@@ -268,6 +284,22 @@ func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32,
 		panic(shortC)
 	}
 
+	if s == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of C are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Ssymm(s, ul, m, hi-lo, alpha, a, lda, b[lo:], ldb, beta, c[lo:], ldc)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of C are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Ssymm(s, ul, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb, beta, c[lo*ldc:], ldc)
+		})
+		return
+	}
+
 	// Quick return if possible.
 	if alpha == 0 && beta == 1 {
 		return
@@ -368,7 +400,7 @@ func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32,
 // beta are scalars.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Ssyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
+func (impl Implementation) Ssyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -405,6 +437,29 @@ func (Implementation) Ssyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 		panic(shortC)
 	}
 
+	if k > 0 && impl.parallel(n, n) {
+		// Each block of rows of the triangle of C is updated independently,
+		// the diagonal block by a rank-k update and the off-diagonal block by
+		// a matrix multiplication. off is the offset between rows of op(A).
+		ta, tb := blas.NoTrans, blas.Trans
+		off := lda
+		if tA != blas.NoTrans {
+			ta, tb = blas.Trans, blas.NoTrans
+			off = 1
+		}
+		impl.forEachBlock(n, func(lo, hi int) {
+			serial.Ssyrk(ul, tA, hi-lo, k, alpha, a[lo*off:], lda, beta, c[lo*ldc+lo:], ldc)
+			j0, j1 := hi, n
+			if ul == blas.Lower {
+				j0, j1 = 0, lo
+			}
+			if j0 < j1 {
+				serial.Sgemm(ta, tb, hi-lo, j1-j0, k, alpha, a[lo*off:], lda, a[j0*off:], lda, beta, c[lo*ldc+j0:], ldc)
+			}
+		})
+		return
+	}
+
 	if alpha == 0 {
 		if beta == 0 {
 			if ul == blas.Upper {
@@ -520,7 +575,7 @@ func (Implementation) Ssyrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha flo
 // alpha and beta are scalars.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Ssyr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+func (impl Implementation) Ssyr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(badUplo)
 	}
@@ -563,6 +618,32 @@ func (Implementation) Ssyr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha fl
 		panic(shortC)
 	}
 
+	if k > 0 && impl.parallel(n, n) {
+		// Each block of rows of the triangle of C is updated independently,
+		// the diagonal block by a rank-2k update and the off-diagonal block by
+		// two matrix multiplications. offA and offB are the offsets between
+		// rows of op(A) and op(B).
+		ta, tb := blas.NoTrans, blas.Trans
+		offA, offB := lda, ldb
+		if tA != blas.NoTrans {
+			ta, tb = blas.Trans, blas.NoTrans
+			offA, offB = 1, 1
+		}
+		impl.forEachBlock(n, func(lo, hi int) {
+			serial.Ssyr2k(ul, tA, hi-lo, k, alpha, a[lo*offA:], lda, b[lo*offB:], ldb, beta, c[lo*ldc+lo:], ldc)
+			j0, j1 := hi, n
+			if ul == blas.Lower {
+				j0, j1 = 0, lo
+			}
+			if j0 < j1 {
+				cij := c[lo*ldc+j0:]
+				serial.Sgemm(ta, tb, hi-lo, j1-j0, k, alpha, a[lo*offA:], lda, b[j0*offB:], ldb, beta, cij, ldc)
+				serial.Sgemm(ta, tb, hi-lo, j1-j0, k, alpha, b[lo*offB:], ldb, a[j0*offA:], lda, 1, cij, ldc)
+			}
+		})
+		return
+	}
+
 	if alpha == 0 {
 		if beta == 0 {
 			if ul == blas.Upper {
@@ -685,7 +766,7 @@ func (Implementation) Ssyr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha fl
 // where A is an n×n or m×m triangular matrix, B is an m×n matrix, and alpha is a scalar.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+func (impl Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
 	if s != blas.Left && s != blas.Right {
 		panic(badSide)
 	}
@@ -728,6 +809,22 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		panic(shortB)
 	}
 
+	if s == blas.Left {
+		if impl.parallel(n, m) {
+			// The columns of B are independent.
+			impl.forEachBlock(n, func(lo, hi int) {
+				serial.Strmm(s, ul, tA, d, m, hi-lo, alpha, a, lda, b[lo:], ldb)
+			})
+			return
+		}
+	} else if impl.parallel(m, n) {
+		// The rows of B are independent.
+		impl.forEachBlock(m, func(lo, hi int) {
+			serial.Strmm(s, ul, tA, d, hi-lo, n, alpha, a, lda, b[lo*ldb:], ldb)
+		})
+		return
+	}
+
 	if alpha == 0 {
 		for i := 0; i < m; i++ {
 			btmp := b[i*ldb : i*ldb+n]
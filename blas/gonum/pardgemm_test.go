@@ -163,7 +163,7 @@ func testMatchParallelSerial(t *testing.T, rnd *rand.Rand, i int, tA, tB blas.Tr
 	copy(want, c)
 
 	dgemmSerial(tA == blas.Trans, tB == blas.Trans, m, n, k, a, lda, b, ldb, want, ldc, alpha)
-	dgemmParallel(tA == blas.Trans, tB == blas.Trans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
+	dgemmParallel(tA == blas.Trans, tB == blas.Trans, m, n, k, a, lda, b, ldb, c, ldc, alpha, 4)
 
 	if !floats.Equal(a, aCopy) {
 		t.Errorf("Case %v: a changed during call to dgemmParallel", i)
@@ -183,3 +183,66 @@ func randmat(r, c, stride int, rnd *rand.Rand) []float64 {
 	}
 	return data
 }
+
+func TestDgemmKernel(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i, test := range []struct {
+		m, n, k int
+	}{
+		{m: 4, n: 8, k: 4},
+		{m: 5, n: 9, k: 7},
+		{m: 67, n: 67, k: 67},
+		{m: gemmMC + 3, n: 21, k: gemmKC + 7},
+		{m: 6, n: gemmNC + 3, k: 5},
+	} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				aTrans := tA == blas.Trans
+				bTrans := tB == blas.Trans
+				rowA, colA := test.m, test.k
+				if aTrans {
+					rowA, colA = colA, rowA
+				}
+				rowB, colB := test.k, test.n
+				if bTrans {
+					rowB, colB = colB, rowB
+				}
+				lda := colA + 2
+				a := randmat(rowA, colA, lda, rnd)
+				ldb := colB + 3
+				b := randmat(rowB, colB, ldb, rnd)
+				ldc := test.n + 1
+				c := randmat(test.m, test.n, ldc, rnd)
+
+				const alpha = 2.5
+				want := make([]float64, len(c))
+				copy(want, c)
+				for ii := 0; ii < test.m; ii++ {
+					for jj := 0; jj < test.n; jj++ {
+						var sum float64
+						for l := 0; l < test.k; l++ {
+							var av, bv float64
+							if aTrans {
+								av = a[l*lda+ii]
+							} else {
+								av = a[ii*lda+l]
+							}
+							if bTrans {
+								bv = b[jj*ldb+l]
+							} else {
+								bv = b[l*ldb+jj]
+							}
+							sum += av * bv
+						}
+						want[ii*ldc+jj] += alpha * sum
+					}
+				}
+
+				dgemmSerialKernel(aTrans, bTrans, test.m, test.n, test.k, a, lda, b, ldb, c, ldc, alpha)
+				if !floats.EqualApprox(c, want, 1e-12) {
+					t.Errorf("Case %v (tA=%c, tB=%c): unexpected result", i, tA, tB)
+				}
+			}
+		}
+	}
+}
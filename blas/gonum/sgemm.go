@@ -7,7 +7,6 @@
 package gonum
 
 import (
-	"runtime"
 	"sync"
 
 	"gonum.org/v1/gonum/blas"
@@ -24,7 +23,7 @@ import (
 // B are transposed.
 //
 // Float32 implementations are autogenerated and not directly tested.
-func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+func (impl Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
 	switch tA {
 	default:
 		panic(badTranspose)
@@ -120,10 +119,10 @@ func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a
 		}
 	}
 
-	sgemmParallel(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
+	sgemmParallel(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha, impl.workers())
 }
 
-func sgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, alpha float32) {
+func sgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, alpha float32, nWorkers int) {
 	// dgemmParallel computes a parallel matrix multiplication by partitioning
 	// a and b into sub-blocks, and updating c with the multiplication of the sub-block
 	// In all cases,
@@ -155,14 +154,14 @@ func sgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []f
 
 	maxKLen := k
 	parBlocks := blocks(m, blockSize) * blocks(n, blockSize)
-	if parBlocks < minParBlock {
+	if parBlocks < minParBlock || nWorkers < 2 {
 		// The matrix multiplication is small in the dimensions where it can be
-		// computed concurrently. Just do it in serial.
+		// computed concurrently, or only one worker is allowed. Just do it in
+		// serial.
 		sgemmSerial(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
 		return
 	}
 
-	nWorkers := runtime.GOMAXPROCS(0)
 	if parBlocks < nWorkers {
 		nWorkers = parBlocks
 	}
@@ -236,6 +235,10 @@ func sgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []f
 
 // sgemmSerial is serial matrix multiply
 func sgemmSerial(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, alpha float32) {
+	if f32.HasGemmKernel() && m >= gemmMinMN && n >= gemmMinMN && k >= gemmMinK {
+		sgemmSerialKernel(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
+		return
+	}
 	switch {
 	case !aTrans && !bTrans:
 		sgemmSerialNotNot(m, n, k, a, lda, b, ldb, c, ldc, alpha)
@@ -313,6 +316,122 @@ func sgemmSerialTransTrans(m, n, k int, a []float32, lda int, b []float32, ldb i
 	}
 }
 
+// sgemmPackPool holds the buffers for the packed blocks used by
+// sgemmSerialKernel so that they are not allocated on each call.
+var sgemmPackPool = sync.Pool{
+	New: func() interface{} { return new([]float32) },
+}
+
+// sgemmSerialKernel is serial matrix multiply using the f32.GemmKernel
+// micro-kernel. Blocks of a and b are copied into contiguous panels in the
+// order the kernel reads them, so the transpose cases are handled during
+// packing and alpha is applied to the packed a. Blocks of c at the right and
+// bottom edges that are smaller than the kernel are updated in a temporary
+// buffer.
+func sgemmSerialKernel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, alpha float32) {
+	const (
+		mr = f32.GemmMR
+		nr = f32.GemmNR
+	)
+	kc := min(k, gemmKC)
+	mc := (min(m, gemmMC) + mr - 1) / mr * mr
+	nc := (min(n, gemmNC) + nr - 1) / nr * nr
+	size := (mc+nc)*kc + mr*nr
+	bufp := sgemmPackPool.Get().(*[]float32)
+	defer sgemmPackPool.Put(bufp)
+	if cap(*bufp) < size {
+		*bufp = make([]float32, size)
+	}
+	buf := (*bufp)[:size]
+	aPack := buf[:mc*kc]
+	bPack := buf[mc*kc : (mc+nc)*kc]
+	cTmp := buf[(mc+nc)*kc:]
+
+	for jc := 0; jc < n; jc += gemmNC {
+		nb := min(gemmNC, n-jc)
+		for pc := 0; pc < k; pc += gemmKC {
+			kb := min(gemmKC, k-pc)
+			sgemmPackB(bTrans, kb, nb, b, ldb, pc, jc, bPack)
+			for ic := 0; ic < m; ic += gemmMC {
+				mb := min(gemmMC, m-ic)
+				sgemmPackA(aTrans, mb, kb, alpha, a, lda, ic, pc, aPack)
+				for jr := 0; jr < nb; jr += nr {
+					bp := bPack[jr*kb : (jr+nr)*kb]
+					for ir := 0; ir < mb; ir += mr {
+						ap := aPack[ir*kb : (ir+mr)*kb]
+						i := ic + ir
+						j := jc + jr
+						if ir+mr <= mb && jr+nr <= nb {
+							f32.GemmKernel(uintptr(kb), ap, bp, c[i*ldc+j:], uintptr(ldc))
+							continue
+						}
+						rows := min(mr, mb-ir)
+						cols := min(nr, nb-jr)
+						for l := range cTmp {
+							cTmp[l] = 0
+						}
+						for ii := 0; ii < rows; ii++ {
+							copy(cTmp[ii*nr:ii*nr+cols], c[(i+ii)*ldc+j:])
+						}
+						f32.GemmKernel(uintptr(kb), ap, bp, cTmp, nr)
+						for ii := 0; ii < rows; ii++ {
+							copy(c[(i+ii)*ldc+j:(i+ii)*ldc+j+cols], cTmp[ii*nr:])
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// sgemmPackA copies alpha times the m×k block of op(A) starting at row i and
+// column l into dst as a sequence of panels of f32.GemmMR rows, each stored
+// in column-major order. The last panel is padded with zeros.
+func sgemmPackA(aTrans bool, m, k int, alpha float32, a []float32, lda, i, l int, dst []float32) {
+	const mr = f32.GemmMR
+	for ir := 0; ir < m; ir += mr {
+		panel := dst[ir*k : (ir+mr)*k]
+		rows := min(mr, m-ir)
+		for p := 0; p < k; p++ {
+			col := panel[p*mr : p*mr+mr]
+			for ii := 0; ii < rows; ii++ {
+				if aTrans {
+					col[ii] = alpha * a[(l+p)*lda+i+ir+ii]
+				} else {
+					col[ii] = alpha * a[(i+ir+ii)*lda+l+p]
+				}
+			}
+			for ii := rows; ii < mr; ii++ {
+				col[ii] = 0
+			}
+		}
+	}
+}
+
+// sgemmPackB copies the k×n block of op(B) starting at row l and column j
+// into dst as a sequence of panels of f32.GemmNR columns, each stored in
+// row-major order. The last panel is padded with zeros.
+func sgemmPackB(bTrans bool, k, n int, b []float32, ldb, l, j int, dst []float32) {
+	const nr = f32.GemmNR
+	for jr := 0; jr < n; jr += nr {
+		panel := dst[jr*k : (jr+nr)*k]
+		cols := min(nr, n-jr)
+		for p := 0; p < k; p++ {
+			row := panel[p*nr : p*nr+nr]
+			if bTrans {
+				for jj := 0; jj < cols; jj++ {
+					row[jj] = b[(j+jr+jj)*ldb+l+p]
+				}
+			} else {
+				copy(row[:cols], b[(l+p)*ldb+j+jr:])
+			}
+			for jj := cols; jj < nr; jj++ {
+				row[jj] = 0
+			}
+		}
+	}
+}
+
 func sliceView32(a []float32, lda, i, j, r, c int) []float32 {
 	return a[i*lda+j : (i+r-1)*lda+j+c]
 }
@@ -8,6 +8,10 @@ WARNING='//\
 // Float32 implementations are autogenerated and not directly tested.\
 '
 
+WARNINGC64='//\
+// Complex64 implementations are autogenerated and not directly tested.\
+'
+
 # Level1 routines.
 
 echo Generating level1single.go
@@ -24,9 +28,9 @@ cat level1double.go \
 | gofmt -r 'f64.ScalInc -> f32.ScalInc' \
 | gofmt -r 'f64.ScalUnitary -> f32.ScalUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
       -e 's_^// D_// S_' \
-      -e "s_^\(func (Implementation) \)Id\(.*\)\$_$WARNING\1Is\2_" \
+      -e "s_^\(func ([a-z ]*Implementation) \)Id\(.*\)\$_$WARNING\1Is\2_" \
       -e 's_^// Id_// Is_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
       -e 's_"math"_math "gonum.org/v1/gonum/internal/math32"_' \
@@ -40,7 +44,7 @@ cat level1double_ddot.go \
 | gofmt -r 'f64.DotInc -> f32.DotInc' \
 | gofmt -r 'f64.DotUnitary -> f32.DotUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
       -e 's_^// D_// S_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level1single_sdot.go
@@ -53,7 +57,7 @@ cat level1double_ddot.go \
 | gofmt -r 'f64.DotInc -> f32.DdotInc' \
 | gofmt -r 'f64.DotUnitary -> f32.DdotUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNING\1Ds\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNING\1Ds\2_" \
       -e 's_^// D_// Ds_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level1single_dsdot.go
@@ -66,7 +70,7 @@ cat level1double_ddot.go \
 | gofmt -r 'f64.DotInc(x, y, f(n), f(incX), f(incY), f(ix), f(iy)) -> alpha + float32(f32.DdotInc(x, y, f(n), f(incX), f(incY), f(ix), f(iy)))' \
 | gofmt -r 'f64.DotUnitary(a, b) -> alpha + float32(f32.DdotUnitary(a, b))' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNING\1Sds\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNING\1Sds\2_" \
       -e 's_^// D\(.*\)$_// Sds\1 plus a constant_' \
       -e 's_\\sum_alpha + \\sum_' \
       -e 's/n int/n int, alpha float32/' \
@@ -93,7 +97,7 @@ cat level2double.go \
 | gofmt -r 'f64.DotUnitary -> f32.DotUnitary' \
 | gofmt -r 'f64.Ger -> f32.Ger' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
       -e 's_^// D_// S_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level2single.go
@@ -113,7 +117,14 @@ cat level3double.go \
 | gofmt -r 'f64.DotUnitary -> f32.DotUnitary' \
 | gofmt -r 'f64.ScalUnitary -> f32.ScalUnitary' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
+| gofmt -r 'serial.Dgemm -> serial.Sgemm' \
+| gofmt -r 'serial.Dsymm -> serial.Ssymm' \
+| gofmt -r 'serial.Dsyrk -> serial.Ssyrk' \
+| gofmt -r 'serial.Dsyr2k -> serial.Ssyr2k' \
+| gofmt -r 'serial.Dtrmm -> serial.Strmm' \
+| gofmt -r 'serial.Dtrsm -> serial.Strsm' \
+\
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
       -e 's_^// D_// S_' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> level3single.go
@@ -131,13 +142,111 @@ cat dgemm.go \
 | gofmt -r 'dgemmSerialTransNot -> sgemmSerialTransNot' \
 | gofmt -r 'dgemmSerialNotTrans -> sgemmSerialNotTrans' \
 | gofmt -r 'dgemmSerialTransTrans -> sgemmSerialTransTrans' \
+| gofmt -r 'dgemmSerialKernel -> sgemmSerialKernel' \
+| gofmt -r 'dgemmPackA -> sgemmPackA' \
+| gofmt -r 'dgemmPackB -> sgemmPackB' \
+| gofmt -r 'dgemmPackPool -> sgemmPackPool' \
 \
 | gofmt -r 'f64.AxpyInc -> f32.AxpyInc' \
 | gofmt -r 'f64.AxpyUnitary -> f32.AxpyUnitary' \
 | gofmt -r 'f64.DotUnitary -> f32.DotUnitary' \
+| gofmt -r 'f64.GemmKernel -> f32.GemmKernel' \
+| gofmt -r 'f64.GemmMR -> f32.GemmMR' \
+| gofmt -r 'f64.GemmNR -> f32.GemmNR' \
+| gofmt -r 'f64.HasGemmKernel -> f32.HasGemmKernel' \
 \
-| sed -e "s_^\(func (Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
+| sed -e "s_^\(func ([a-z ]*Implementation) \)D\(.*\)\$_$WARNING\1S\2_" \
       -e 's_^// D_// S_' \
       -e 's_^// d_// s_' \
+      -e 's_f64\.Gemm_f32.Gemm_g' \
       -e 's_"gonum.org/v1/gonum/internal/asm/f64"_"gonum.org/v1/gonum/internal/asm/f32"_' \
 >> sgemm.go
+
+
+# Complex64 routines.
+
+echo Generating level1cmplx64.go
+echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > level1cmplx64.go
+cat level1cmplx128.go \
+| gofmt -r 'blas.Complex128Level1 -> blas.Complex64Level1' \
+\
+| gofmt -r 'float64 -> float32' \
+| gofmt -r 'complex128 -> complex64' \
+\
+| gofmt -r 'c128.AxpyInc -> c64.AxpyInc' \
+| gofmt -r 'c128.AxpyUnitary -> c64.AxpyUnitary' \
+| gofmt -r 'c128.DotcInc -> c64.DotcInc' \
+| gofmt -r 'c128.DotcUnitary -> c64.DotcUnitary' \
+| gofmt -r 'c128.DotuInc -> c64.DotuInc' \
+| gofmt -r 'c128.DotuUnitary -> c64.DotuUnitary' \
+| gofmt -r 'c128.ScalInc -> c64.ScalInc' \
+| gofmt -r 'c128.ScalUnitary -> c64.ScalUnitary' \
+| gofmt -r 'dcabs1 -> scabs1' \
+\
+| sed -e "s_^\(func (\)\(Implementation) \)Dz\(.*\)\$_$WARNINGC64\1\2Sc\3_" \
+      -e 's_^// Dz_// Sc_' \
+      -e "s_^\(func (\)\(Implementation) \)Iz\(.*\)\$_$WARNINGC64\1\2Ic\3_" \
+      -e 's_^// Iz_// Ic_' \
+      -e "s_^\(func (\)\(Implementation) \)Zdscal\(.*\)\$_$WARNINGC64\1\2Csscal\3_" \
+      -e 's_^// Zdscal_// Csscal_' \
+      -e "s_^\(func (\)\(Implementation) \)Z\(.*\)\$_$WARNINGC64\1\2C\3_" \
+      -e 's_^// Z_// C_' \
+      -e 's_"gonum.org/v1/gonum/internal/asm/c128"_"gonum.org/v1/gonum/internal/asm/c64"_' \
+      -e 's_"math"_math "gonum.org/v1/gonum/internal/math32"_' \
+>> level1cmplx64.go
+
+echo Generating level2cmplx64.go
+echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > level2cmplx64.go
+cat level2cmplx128.go \
+| gofmt -r 'blas.Complex128Level2 -> blas.Complex64Level2' \
+\
+| gofmt -r 'float64 -> float32' \
+| gofmt -r 'complex128 -> complex64' \
+\
+| gofmt -r 'c128.AxpyInc -> c64.AxpyInc' \
+| gofmt -r 'c128.AxpyUnitary -> c64.AxpyUnitary' \
+| gofmt -r 'c128.DotuInc -> c64.DotuInc' \
+| gofmt -r 'c128.DotuUnitary -> c64.DotuUnitary' \
+| gofmt -r 'c128.GemvC -> c64.GemvC' \
+| gofmt -r 'c128.GemvN -> c64.GemvN' \
+| gofmt -r 'c128.GemvT -> c64.GemvT' \
+| gofmt -r 'c128.Gerc -> c64.Gerc' \
+| gofmt -r 'c128.Geru -> c64.Geru' \
+| gofmt -r 'c128.ScalInc -> c64.ScalInc' \
+| gofmt -r 'c128.ScalUnitary -> c64.ScalUnitary' \
+\
+| sed -e "s_^\(func (\)\([a-z ]*Implementation) \)Z\(.*\)\$_$WARNINGC64\1\2C\3_" \
+      -e 's_^// Z_// C_' \
+      -e 's_"gonum.org/v1/gonum/internal/asm/c128"_"gonum.org/v1/gonum/internal/asm/c64"_' \
+      -e 's_"math/cmplx"_cmplx "gonum.org/v1/gonum/internal/cmplx64"_' \
+>> level2cmplx64.go
+
+echo Generating level3cmplx64.go
+echo -e '// Code generated by "go generate gonum.org/v1/gonum/blas/gonum”; DO NOT EDIT.\n' > level3cmplx64.go
+cat level3cmplx128.go \
+| gofmt -r 'blas.Complex128Level3 -> blas.Complex64Level3' \
+\
+| gofmt -r 'float64 -> float32' \
+| gofmt -r 'complex128 -> complex64' \
+\
+| gofmt -r 'c128.AxpyUnitary -> c64.AxpyUnitary' \
+| gofmt -r 'c128.DotcUnitary -> c64.DotcUnitary' \
+| gofmt -r 'c128.DotuUnitary -> c64.DotuUnitary' \
+| gofmt -r 'c128.DscalUnitary -> c64.SscalUnitary' \
+| gofmt -r 'c128.ScalUnitary -> c64.ScalUnitary' \
+\
+| gofmt -r 'serial.Zgemm -> serial.Cgemm' \
+| gofmt -r 'serial.Zhemm -> serial.Chemm' \
+| gofmt -r 'serial.Zherk -> serial.Cherk' \
+| gofmt -r 'serial.Zher2k -> serial.Cher2k' \
+| gofmt -r 'serial.Zsymm -> serial.Csymm' \
+| gofmt -r 'serial.Zsyrk -> serial.Csyrk' \
+| gofmt -r 'serial.Zsyr2k -> serial.Csyr2k' \
+| gofmt -r 'serial.Ztrmm -> serial.Ctrmm' \
+| gofmt -r 'serial.Ztrsm -> serial.Ctrsm' \
+\
+| sed -e "s_^\(func (\)\([a-z ]*Implementation) \)Z\(.*\)\$_$WARNINGC64\1\2C\3_" \
+      -e 's_^// Z_// C_' \
+      -e 's_"gonum.org/v1/gonum/internal/asm/c128"_"gonum.org/v1/gonum/internal/asm/c64"_' \
+      -e 's_"math/cmplx"_cmplx "gonum.org/v1/gonum/internal/cmplx64"_' \
+>> level3cmplx64.go
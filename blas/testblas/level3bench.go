@@ -0,0 +1,166 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testblas
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func randomSlice(n int, rnd *rand.Rand) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
+
+func randomSliceC(n int, rnd *rand.Rand) []complex128 {
+	s := make([]complex128, n)
+	for i := range s {
+		s[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return s
+}
+
+// randomTriangular returns an n×n matrix with a well-conditioned triangle
+// suitable for triangular solves.
+func randomTriangular(n int, rnd *rand.Rand) []float64 {
+	a := randomSlice(n*n, rnd)
+	for i := 0; i < n; i++ {
+		a[i*n+i] += float64(n)
+	}
+	return a
+}
+
+func randomTriangularC(n int, rnd *rand.Rand) []complex128 {
+	a := randomSliceC(n*n, rnd)
+	for i := 0; i < n; i++ {
+		a[i*n+i] += complex(float64(n), 0)
+	}
+	return a
+}
+
+func DsymmBenchmark(b *testing.B, impl Dsymmer, side blas.Side, uplo blas.Uplo, m, n int) {
+	rnd := rand.New(rand.NewSource(1))
+	k := n
+	if side == blas.Left {
+		k = m
+	}
+	a := randomSlice(k*k, rnd)
+	bm := randomSlice(m*n, rnd)
+	c := randomSlice(m*n, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Dsymm(side, uplo, m, n, 1.5, a, k, bm, n, 0.5, c, n)
+	}
+}
+
+func DsyrkBenchmark(b *testing.B, impl Dsyker, uplo blas.Uplo, trans blas.Transpose, n, k int) {
+	rnd := rand.New(rand.NewSource(1))
+	lda := k
+	if trans != blas.NoTrans {
+		lda = n
+	}
+	a := randomSlice(n*k, rnd)
+	c := randomSlice(n*n, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Dsyrk(uplo, trans, n, k, 1.5, a, lda, 0.5, c, n)
+	}
+}
+
+func Dsyr2kBenchmark(b *testing.B, impl Dsyr2ker, uplo blas.Uplo, trans blas.Transpose, n, k int) {
+	rnd := rand.New(rand.NewSource(1))
+	lda := k
+	if trans != blas.NoTrans {
+		lda = n
+	}
+	a := randomSlice(n*k, rnd)
+	bm := randomSlice(n*k, rnd)
+	c := randomSlice(n*n, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Dsyr2k(uplo, trans, n, k, 1.5, a, lda, bm, lda, 0.5, c, n)
+	}
+}
+
+func DtrmmBenchmark(b *testing.B, impl Dtrmmer, side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int) {
+	rnd := rand.New(rand.NewSource(1))
+	k := n
+	if side == blas.Left {
+		k = m
+	}
+	a := randomSlice(k*k, rnd)
+	bm := randomSlice(m*n, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Dtrmm(side, uplo, trans, diag, m, n, 1, a, k, bm, n)
+	}
+}
+
+func DtrsmBenchmark(b *testing.B, impl Dtrsmer, side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int) {
+	rnd := rand.New(rand.NewSource(1))
+	k := n
+	if side == blas.Left {
+		k = m
+	}
+	a := randomTriangular(k, rnd)
+	bm := randomSlice(m*n, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Dtrsm(side, uplo, trans, diag, m, n, 1, a, k, bm, n)
+	}
+}
+
+func ZgemmBenchmark(b *testing.B, impl Zgemmer, tA, tB blas.Transpose, m, n, k int) {
+	rnd := rand.New(rand.NewSource(1))
+	lda := k
+	if tA != blas.NoTrans {
+		lda = m
+	}
+	ldb := n
+	if tB != blas.NoTrans {
+		ldb = k
+	}
+	a := randomSliceC(m*k, rnd)
+	bm := randomSliceC(k*n, rnd)
+	c := randomSliceC(m*n, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Zgemm(tA, tB, m, n, k, 1.5, a, lda, bm, ldb, 0.5, c, n)
+	}
+}
+
+func ZherkBenchmark(b *testing.B, impl Zherker, uplo blas.Uplo, trans blas.Transpose, n, k int) {
+	rnd := rand.New(rand.NewSource(1))
+	lda := k
+	if trans != blas.NoTrans {
+		lda = n
+	}
+	a := randomSliceC(n*k, rnd)
+	c := randomSliceC(n*n, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Zherk(uplo, trans, n, k, 1.5, a, lda, 0.5, c, n)
+	}
+}
+
+func ZtrsmBenchmark(b *testing.B, impl Ztrsmer, side blas.Side, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, m, n int) {
+	rnd := rand.New(rand.NewSource(1))
+	k := n
+	if side == blas.Left {
+		k = m
+	}
+	a := randomTriangularC(k, rnd)
+	bm := randomSliceC(m*n, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		impl.Ztrsm(side, uplo, trans, diag, m, n, 1, a, k, bm, n)
+	}
+}
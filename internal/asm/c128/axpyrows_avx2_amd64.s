@@ -0,0 +1,128 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine,!safe
+
+#include "textflag.h"
+
+#define SIZE 16
+
+#define M CX
+#define N BX
+#define X_PTR SI
+#define SRC_ROW DI
+#define DST_ROW DX
+#define SRC_PTR AX
+#define DST_PTR R10
+#define LEN R11
+#define INC_SRC R8
+#define INC_DST R9
+
+#define ALPHA X15
+#define ALPHA_SW X14
+#define CONJ Y13
+#define CONJ_X X13
+#define SCALE_R Y12
+#define SCALE_R_X X12
+#define SCALE_I Y11
+#define SCALE_I_X X11
+
+// func axpyRowsAVX2(m, n uintptr,
+//	alpha complex128,
+//	x, src []complex128, incSrc uintptr,
+//	conj bool,
+//	dst []complex128, incDst uintptr)
+TEXT ·axpyRowsAVX2(SB), NOSPLIT, $0-128
+	MOVQ      m+0(FP), M
+	MOVQ      n+8(FP), N
+	VMOVSD    alpha_real+16(FP), ALPHA
+	VMOVHPD   alpha_imag+24(FP), ALPHA, ALPHA // ALPHA = { real(alpha), imag(alpha) }
+	VPERMILPD $1, ALPHA, ALPHA_SW             // ALPHA_SW = { imag(alpha), real(alpha) }
+	MOVQ      x_base+32(FP), X_PTR
+	MOVQ      src_base+56(FP), SRC_ROW
+	MOVQ      incSrc+80(FP), INC_SRC
+	SHLQ      $4, INC_SRC                     // INC_SRC *= SIZE
+	MOVQ      dst_base+96(FP), DST_ROW
+	MOVQ      incDst+120(FP), INC_DST
+	SHLQ      $4, INC_DST                     // INC_DST *= SIZE
+
+	// CONJ holds the sign bits of the imaginary parts when src is
+	// conjugated and is zero otherwise.
+	VXORPD      CONJ, CONJ, CONJ
+	CMPB        conj+88(FP), $0
+	JE          rows
+	MOVQ        $0x8000000000000000, R12
+	VMOVQ       R12, CONJ_X
+	VPSLLDQ     $8, CONJ_X, CONJ_X
+	VINSERTF128 $1, CONJ_X, CONJ, CONJ
+
+rows:
+	TESTQ M, M
+	JZ    end
+
+row_loop:
+	// SCALE = alpha * x[i]
+	VMOVDDUP       (X_PTR), X0
+	VMOVDDUP       8(X_PTR), X1
+	VMULPD         ALPHA_SW, X1, X1
+	VFMADDSUB231PD ALPHA, X0, X1
+	VBROADCASTSD   X1, SCALE_R
+	VPERMILPD      $1, X1, X1
+	VBROADCASTSD   X1, SCALE_I
+	MOVQ           SRC_ROW, SRC_PTR
+	MOVQ           DST_ROW, DST_PTR
+	MOVQ           N, LEN
+	SHRQ           $2, LEN           // LEN = floor( n / 4 )
+	JZ             tail2
+
+loop4: // dst[j:j+4] += SCALE * src[j:j+4]
+	VXORPD         (SRC_PTR), CONJ, Y0
+	VXORPD         32(SRC_PTR), CONJ, Y1
+	VPERMILPD      $5, Y0, Y2
+	VPERMILPD      $5, Y1, Y3
+	VMULPD         SCALE_I, Y2, Y2
+	VMULPD         SCALE_I, Y3, Y3
+	VFMADDSUB231PD SCALE_R, Y0, Y2
+	VFMADDSUB231PD SCALE_R, Y1, Y3
+	VADDPD         (DST_PTR), Y2, Y2
+	VADDPD         32(DST_PTR), Y3, Y3
+	VMOVUPD        Y2, (DST_PTR)
+	VMOVUPD        Y3, 32(DST_PTR)
+	ADDQ           $4*SIZE, SRC_PTR
+	ADDQ           $4*SIZE, DST_PTR
+	DECQ           LEN
+	JNZ            loop4
+
+tail2:
+	TESTQ          $2, N
+	JZ             tail1
+	VXORPD         (SRC_PTR), CONJ, Y0
+	VPERMILPD      $5, Y0, Y2
+	VMULPD         SCALE_I, Y2, Y2
+	VFMADDSUB231PD SCALE_R, Y0, Y2
+	VADDPD         (DST_PTR), Y2, Y2
+	VMOVUPD        Y2, (DST_PTR)
+	ADDQ           $2*SIZE, SRC_PTR
+	ADDQ           $2*SIZE, DST_PTR
+
+tail1:
+	TESTQ          $1, N
+	JZ             next_row
+	VXORPD         (SRC_PTR), CONJ_X, X0
+	VPERMILPD      $1, X0, X2
+	VMULPD         SCALE_I_X, X2, X2
+	VFMADDSUB231PD SCALE_R_X, X0, X2
+	VADDPD         (DST_PTR), X2, X2
+	VMOVUPD        X2, (DST_PTR)
+
+next_row:
+	ADDQ $SIZE, X_PTR
+	ADDQ INC_SRC, SRC_ROW
+	ADDQ INC_DST, DST_ROW
+	DECQ M
+	JNZ  row_loop
+
+end:
+	VZEROUPPER
+	RET
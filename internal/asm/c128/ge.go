@@ -0,0 +1,44 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c128
+
+import "math/cmplx"
+
+func gemvNGeneric(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128) {
+	for i := uintptr(0); i < m; i++ {
+		y[i] += alpha * DotuUnitary(a[i*lda:i*lda+n], x[:n])
+	}
+}
+
+func gemvTGeneric(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128) {
+	for i := uintptr(0); i < m; i++ {
+		AxpyUnitary(alpha*x[i], a[i*lda:i*lda+n], y[:n])
+	}
+}
+
+func gemvCGeneric(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128) {
+	for i := uintptr(0); i < m; i++ {
+		tmp := alpha * x[i]
+		for j, v := range a[i*lda : i*lda+n] {
+			y[j] += tmp * cmplx.Conj(v)
+		}
+	}
+}
+
+func geruGeneric(m, n uintptr, alpha complex128, x, y, a []complex128, lda uintptr) {
+	for i := uintptr(0); i < m; i++ {
+		AxpyUnitary(alpha*x[i], y[:n], a[i*lda:i*lda+n])
+	}
+}
+
+func gercGeneric(m, n uintptr, alpha complex128, x, y, a []complex128, lda uintptr) {
+	for i := uintptr(0); i < m; i++ {
+		tmp := alpha * x[i]
+		aRow := a[i*lda : i*lda+n]
+		for j, v := range y[:n] {
+			aRow[j] += tmp * cmplx.Conj(v)
+		}
+	}
+}
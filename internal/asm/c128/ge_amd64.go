@@ -0,0 +1,87 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!appengine,!safe
+
+package c128
+
+import "gonum.org/v1/gonum/internal/cpu"
+
+// useAVX2FMA specifies whether the AVX2 and FMA kernels are used in
+// preference to the generic kernels. It is set from the features of the
+// processor the program is running on.
+var useAVX2FMA = cpu.X86.HasAVX2 && cpu.X86.HasFMA
+
+// GemvN computes
+//  y += alpha * A * x
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func GemvN(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128) {
+	if useAVX2FMA {
+		gemvNAVX2(m, n, alpha, a, lda, x, y)
+		return
+	}
+	gemvNGeneric(m, n, alpha, a, lda, x, y)
+}
+
+// GemvT computes
+//  y += alpha * A^T * x
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func GemvT(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128) {
+	if useAVX2FMA {
+		axpyRowsAVX2(m, n, alpha, x, a, lda, false, y, 0)
+		return
+	}
+	gemvTGeneric(m, n, alpha, a, lda, x, y)
+}
+
+// GemvC computes
+//  y += alpha * A^H * x
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func GemvC(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128) {
+	if useAVX2FMA {
+		axpyRowsAVX2(m, n, alpha, x, a, lda, true, y, 0)
+		return
+	}
+	gemvCGeneric(m, n, alpha, a, lda, x, y)
+}
+
+// Geru performs the rank-one operation
+//  A += alpha * x * y^T
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func Geru(m, n uintptr, alpha complex128, x, y, a []complex128, lda uintptr) {
+	if useAVX2FMA {
+		axpyRowsAVX2(m, n, alpha, x, y, 0, false, a, lda)
+		return
+	}
+	geruGeneric(m, n, alpha, x, y, a, lda)
+}
+
+// Gerc performs the rank-one operation
+//  A += alpha * x * y^H
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func Gerc(m, n uintptr, alpha complex128, x, y, a []complex128, lda uintptr) {
+	if useAVX2FMA {
+		axpyRowsAVX2(m, n, alpha, x, y, 0, true, a, lda)
+		return
+	}
+	gercGeneric(m, n, alpha, x, y, a, lda)
+}
+
+// gemvNAVX2 computes
+//  y += alpha * A * x
+// using AVX2 and FMA instructions.
+func gemvNAVX2(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128)
+
+// axpyRowsAVX2 computes
+//  dst_i += alpha * x[i] * src_i
+// for i = 0, ..., m-1, where src_i and dst_i are the vectors of length n
+// starting at src[i*incSrc] and dst[i*incDst] respectively, using AVX2 and
+// FMA instructions. A zero incSrc or incDst uses the same vector for each i.
+// If conj is true, the complex conjugate of src_i is used.
+func axpyRowsAVX2(m, n uintptr, alpha complex128, x, src []complex128, incSrc uintptr, conj bool, dst []complex128, incDst uintptr)
@@ -0,0 +1,31 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!appengine,!safe
+
+package c128
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/internal/cpu"
+)
+
+// withAVX2FMA runs fn with the AVX2 and FMA kernels enabled or disabled.
+func withAVX2FMA(t *testing.T, use bool, fn func(*testing.T)) {
+	if use && !(cpu.X86.HasAVX2 && cpu.X86.HasFMA) {
+		t.Skip("AVX2 and FMA not supported")
+	}
+	defer func(v bool) { useAVX2FMA = v }(useAVX2FMA)
+	useAVX2FMA = use
+	fn(t)
+}
+
+func TestGemvGeneric(t *testing.T) { withAVX2FMA(t, false, TestGemv) }
+
+func TestGerGeneric(t *testing.T) { withAVX2FMA(t, false, TestGer) }
+
+func TestGemvAVX2(t *testing.T) { withAVX2FMA(t, true, TestGemv) }
+
+func TestGerAVX2(t *testing.T) { withAVX2FMA(t, true, TestGer) }
@@ -0,0 +1,47 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !amd64 noasm appengine safe
+
+package c128
+
+// GemvN computes
+//  y += alpha * A * x
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func GemvN(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128) {
+	gemvNGeneric(m, n, alpha, a, lda, x, y)
+}
+
+// GemvT computes
+//  y += alpha * A^T * x
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func GemvT(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128) {
+	gemvTGeneric(m, n, alpha, a, lda, x, y)
+}
+
+// GemvC computes
+//  y += alpha * A^H * x
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func GemvC(m, n uintptr, alpha complex128, a []complex128, lda uintptr, x, y []complex128) {
+	gemvCGeneric(m, n, alpha, a, lda, x, y)
+}
+
+// Geru performs the rank-one operation
+//  A += alpha * x * y^T
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func Geru(m, n uintptr, alpha complex128, x, y, a []complex128, lda uintptr) {
+	geruGeneric(m, n, alpha, x, y, a, lda)
+}
+
+// Gerc performs the rank-one operation
+//  A += alpha * x * y^H
+// where A is an m×n dense matrix, x and y are vectors with unit stride, and
+// alpha is a scalar.
+func Gerc(m, n uintptr, alpha complex128, x, y, a []complex128, lda uintptr) {
+	gercGeneric(m, n, alpha, x, y, a, lda)
+}
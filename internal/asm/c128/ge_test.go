@@ -0,0 +1,159 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c128
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func randSlice(n int, rnd *rand.Rand) []complex128 {
+	s := make([]complex128, n)
+	for i := range s {
+		s[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return s
+}
+
+func within(x, y complex128) bool {
+	const tol = 1e-13
+	return cmplx.Abs(x-y) <= tol*(1+cmplx.Abs(y))
+}
+
+var geSizes = []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 16, 17}
+
+func TestGemv(t *testing.T) {
+	const (
+		yGdVal = 1.5 - 0.5i
+		gdLn   = 4
+	)
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range geSizes {
+		for _, n := range geSizes {
+			for _, trans := range []string{"N", "T", "C"} {
+				const alpha = 1.25 - 0.75i
+				lda := n + 3
+				a := randSlice(m*lda+1, rnd)
+				lx, ly := n, m
+				if trans != "N" {
+					lx, ly = m, n
+				}
+				x := randSlice(lx, rnd)
+				y := randSlice(ly, rnd)
+				prefix := fmt.Sprintf("Test (%vx%v) %s", m, n, trans)
+
+				want := make([]complex128, ly)
+				for i := range want {
+					var sum complex128
+					for j := range x {
+						switch trans {
+						case "N":
+							sum += a[i*lda+j] * x[j]
+						case "T":
+							sum += a[j*lda+i] * x[j]
+						case "C":
+							sum += cmplx.Conj(a[j*lda+i]) * x[j]
+						}
+					}
+					want[i] = y[i] + alpha*sum
+				}
+
+				yg := guardVector(y, yGdVal, gdLn)
+				y = yg[gdLn : len(yg)-gdLn]
+				switch trans {
+				case "N":
+					GemvN(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, y)
+				case "T":
+					GemvT(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, y)
+				case "C":
+					GemvC(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, y)
+				}
+				for i := range want {
+					if !within(y[i], want[i]) {
+						t.Errorf(msgVal, prefix, i, y[i], want[i])
+					}
+				}
+				if !isValidGuard(yg, yGdVal, gdLn) {
+					t.Errorf(msgGuard, prefix, "y", yg[:gdLn], yg[len(yg)-gdLn:])
+				}
+			}
+		}
+	}
+}
+
+func TestGer(t *testing.T) {
+	const (
+		aGdVal = 1.5 - 0.5i
+		gdLn   = 4
+	)
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range geSizes {
+		for _, n := range geSizes {
+			for _, conj := range []bool{false, true} {
+				const alpha = 1.25 - 0.75i
+				lda := n + 3
+				x := randSlice(m, rnd)
+				y := randSlice(n, rnd)
+				a := randSlice(m*lda, rnd)
+				prefix := fmt.Sprintf("Test (%vx%v) conj:%v", m, n, conj)
+
+				want := make([]complex128, len(a))
+				copy(want, a)
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						v := y[j]
+						if conj {
+							v = cmplx.Conj(v)
+						}
+						want[i*lda+j] += alpha * x[i] * v
+					}
+				}
+
+				ag := guardVector(a, aGdVal, gdLn)
+				a = ag[gdLn : len(ag)-gdLn]
+				if conj {
+					Gerc(uintptr(m), uintptr(n), alpha, x, y, a, uintptr(lda))
+				} else {
+					Geru(uintptr(m), uintptr(n), alpha, x, y, a, uintptr(lda))
+				}
+				for i := range want {
+					if !within(a[i], want[i]) {
+						t.Errorf(msgVal, prefix, i, a[i], want[i])
+					}
+				}
+				if !isValidGuard(ag, aGdVal, gdLn) {
+					t.Errorf(msgGuard, prefix, "a", ag[:gdLn], ag[len(ag)-gdLn:])
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkGemvN(b *testing.B) {
+	const m, n = 100, 100
+	rnd := rand.New(rand.NewSource(1))
+	a := randSlice(m*n, rnd)
+	x := randSlice(n, rnd)
+	y := randSlice(m, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		GemvN(m, n, 1, a, n, x, y)
+	}
+}
+
+func BenchmarkGeru(b *testing.B) {
+	const m, n = 100, 100
+	rnd := rand.New(rand.NewSource(1))
+	a := randSlice(m*n, rnd)
+	x := randSlice(m, rnd)
+	y := randSlice(n, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Geru(m, n, 1, x, y, a, n)
+	}
+}
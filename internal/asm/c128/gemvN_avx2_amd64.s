@@ -0,0 +1,109 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine,!safe
+
+#include "textflag.h"
+
+#define SIZE 16
+
+#define M CX
+#define N BX
+#define X_PTR SI
+#define Y_PTR DX
+#define A_ROW DI
+#define A_PTR AX
+#define X_IDX R9
+#define LEN R10
+#define LDA R8
+
+#define ALPHA X14
+#define ALPHA_SW X15
+
+// func gemvNAVX2(m, n uintptr,
+//	alpha complex128,
+//	a []complex128, lda uintptr,
+//	x, y []complex128)
+TEXT ·gemvNAVX2(SB), NOSPLIT, $0-112
+	MOVQ      m+0(FP), M
+	MOVQ      n+8(FP), N
+	VMOVSD    alpha_real+16(FP), ALPHA
+	VMOVHPD   alpha_imag+24(FP), ALPHA, ALPHA // ALPHA = { real(alpha), imag(alpha) }
+	VPERMILPD $1, ALPHA, ALPHA_SW             // ALPHA_SW = { imag(alpha), real(alpha) }
+	MOVQ      a_base+32(FP), A_ROW
+	MOVQ      lda+56(FP), LDA
+	SHLQ      $4, LDA                         // LDA *= SIZE
+	MOVQ      x_base+64(FP), X_PTR
+	MOVQ      y_base+88(FP), Y_PTR
+	TESTQ     M, M
+	JZ        end
+
+row_loop:
+	// The products of the row of A with x are accumulated into Y0 and Y1
+	// and the products with x with its real and imaginary parts swapped
+	// are accumulated into Y2 and Y3.
+	VXORPD Y0, Y0, Y0
+	VXORPD Y1, Y1, Y1
+	VXORPD Y2, Y2, Y2
+	VXORPD Y3, Y3, Y3
+	MOVQ   A_ROW, A_PTR
+	MOVQ   X_PTR, X_IDX
+	MOVQ   N, LEN
+	SHRQ   $2, LEN        // LEN = floor( n / 4 )
+	JZ     tail2
+
+loop4:
+	VMOVUPD     (X_IDX), Y4
+	VMOVUPD     32(X_IDX), Y5
+	VPERMILPD   $5, Y4, Y6
+	VPERMILPD   $5, Y5, Y7
+	VFMADD231PD (A_PTR), Y4, Y0
+	VFMADD231PD 32(A_PTR), Y5, Y1
+	VFMADD231PD (A_PTR), Y6, Y2
+	VFMADD231PD 32(A_PTR), Y7, Y3
+	ADDQ        $4*SIZE, A_PTR
+	ADDQ        $4*SIZE, X_IDX
+	DECQ        LEN
+	JNZ         loop4
+
+tail2:
+	TESTQ       $2, N
+	JZ          reduce
+	VMOVUPD     (X_IDX), Y4
+	VPERMILPD   $5, Y4, Y6
+	VFMADD231PD (A_PTR), Y4, Y0
+	VFMADD231PD (A_PTR), Y6, Y2
+	ADDQ        $2*SIZE, A_PTR
+	ADDQ        $2*SIZE, X_IDX
+
+reduce:
+	VADDPD       Y1, Y0, Y0
+	VADDPD       Y3, Y2, Y2
+	VEXTRACTF128 $1, Y0, X1
+	VADDPD       X1, X0, X0
+	VEXTRACTF128 $1, Y2, X3
+	VADDPD       X3, X2, X2
+	TESTQ        $1, N
+	JZ           store
+	VMOVUPD      (A_PTR), X5
+	VMOVUPD      (X_IDX), X4
+	VPERMILPD    $1, X4, X6
+	VFMADD231PD  X5, X4, X0
+	VFMADD231PD  X5, X6, X2
+
+store: // y[i] += alpha * sum
+	VHSUBPD        X0, X0, X0      // X0 = { real(sum), real(sum) }
+	VHADDPD        X2, X2, X2      // X2 = { imag(sum), imag(sum) }
+	VMULPD         ALPHA_SW, X2, X2
+	VFMADDSUB231PD ALPHA, X0, X2   // X2 = alpha * sum
+	VADDPD         (Y_PTR), X2, X2
+	VMOVUPD        X2, (Y_PTR)
+	ADDQ           $SIZE, Y_PTR
+	ADDQ           LDA, A_ROW
+	DECQ           M
+	JNZ            row_loop
+
+end:
+	VZEROUPPER
+	RET
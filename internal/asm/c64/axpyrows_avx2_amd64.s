@@ -0,0 +1,142 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine,!safe
+
+#include "textflag.h"
+
+#define SIZE 8
+
+#define M CX
+#define N BX
+#define X_PTR SI
+#define SRC_ROW DI
+#define DST_ROW DX
+#define SRC_PTR AX
+#define DST_PTR R10
+#define LEN R11
+#define INC_SRC R8
+#define INC_DST R9
+
+#define ALPHA X15
+#define ALPHA_SW X14
+#define CONJ Y13
+#define CONJ_X X13
+#define SCALE_R Y12
+#define SCALE_R_X X12
+#define SCALE_I Y11
+#define SCALE_I_X X11
+
+// func axpyRowsAVX2(m, n uintptr,
+//	alpha complex64,
+//	x, src []complex64, incSrc uintptr,
+//	conj bool,
+//	dst []complex64, incDst uintptr)
+TEXT ·axpyRowsAVX2(SB), NOSPLIT, $0-120
+	MOVQ      m+0(FP), M
+	MOVQ      n+8(FP), N
+	VMOVSS    alpha_real+16(FP), ALPHA
+	VINSERTPS $0x10, alpha_imag+20(FP), ALPHA, ALPHA // ALPHA = { real(alpha), imag(alpha) }
+	VPERMILPS $0xB1, ALPHA, ALPHA_SW                 // ALPHA_SW = { imag(alpha), real(alpha) }
+	MOVQ      x_base+24(FP), X_PTR
+	MOVQ      src_base+48(FP), SRC_ROW
+	MOVQ      incSrc+72(FP), INC_SRC
+	SHLQ      $3, INC_SRC                            // INC_SRC *= SIZE
+	MOVQ      dst_base+88(FP), DST_ROW
+	MOVQ      incDst+112(FP), INC_DST
+	SHLQ      $3, INC_DST                            // INC_DST *= SIZE
+
+	// CONJ holds the sign bits of the imaginary parts when src is
+	// conjugated and is zero otherwise.
+	VXORPS       CONJ, CONJ, CONJ
+	CMPB         conj+80(FP), $0
+	JE           rows
+	MOVQ         $0x8000000000000000, R12
+	VMOVQ        R12, CONJ_X
+	VPBROADCASTQ CONJ_X, CONJ
+
+rows:
+	TESTQ M, M
+	JZ    end
+
+row_loop:
+	// SCALE = alpha * x[i]
+	VMOVSD         (X_PTR), X0
+	VMOVSLDUP      X0, X1
+	VMOVSHDUP      X0, X2
+	VMULPS         ALPHA_SW, X2, X2
+	VFMADDSUB231PS ALPHA, X1, X2
+	VBROADCASTSS   X2, SCALE_R
+	VMOVSHDUP      X2, X2
+	VBROADCASTSS   X2, SCALE_I
+	MOVQ           SRC_ROW, SRC_PTR
+	MOVQ           DST_ROW, DST_PTR
+	MOVQ           N, LEN
+	SHRQ           $3, LEN           // LEN = floor( n / 8 )
+	JZ             tail4
+
+loop8: // dst[j:j+8] += SCALE * src[j:j+8]
+	VXORPS         (SRC_PTR), CONJ, Y0
+	VXORPS         32(SRC_PTR), CONJ, Y1
+	VPERMILPS      $0xB1, Y0, Y2
+	VPERMILPS      $0xB1, Y1, Y3
+	VMULPS         SCALE_I, Y2, Y2
+	VMULPS         SCALE_I, Y3, Y3
+	VFMADDSUB231PS SCALE_R, Y0, Y2
+	VFMADDSUB231PS SCALE_R, Y1, Y3
+	VADDPS         (DST_PTR), Y2, Y2
+	VADDPS         32(DST_PTR), Y3, Y3
+	VMOVUPS        Y2, (DST_PTR)
+	VMOVUPS        Y3, 32(DST_PTR)
+	ADDQ           $8*SIZE, SRC_PTR
+	ADDQ           $8*SIZE, DST_PTR
+	DECQ           LEN
+	JNZ            loop8
+
+tail4:
+	TESTQ          $4, N
+	JZ             tail2
+	VXORPS         (SRC_PTR), CONJ, Y0
+	VPERMILPS      $0xB1, Y0, Y2
+	VMULPS         SCALE_I, Y2, Y2
+	VFMADDSUB231PS SCALE_R, Y0, Y2
+	VADDPS         (DST_PTR), Y2, Y2
+	VMOVUPS        Y2, (DST_PTR)
+	ADDQ           $4*SIZE, SRC_PTR
+	ADDQ           $4*SIZE, DST_PTR
+
+tail2:
+	TESTQ          $2, N
+	JZ             tail1
+	VXORPS         (SRC_PTR), CONJ_X, X0
+	VPERMILPS      $0xB1, X0, X2
+	VMULPS         SCALE_I_X, X2, X2
+	VFMADDSUB231PS SCALE_R_X, X0, X2
+	VADDPS         (DST_PTR), X2, X2
+	VMOVUPS        X2, (DST_PTR)
+	ADDQ           $2*SIZE, SRC_PTR
+	ADDQ           $2*SIZE, DST_PTR
+
+tail1:
+	TESTQ          $1, N
+	JZ             next_row
+	VMOVSD         (SRC_PTR), X0
+	VXORPS         CONJ_X, X0, X0
+	VPERMILPS      $0xB1, X0, X2
+	VMULPS         SCALE_I_X, X2, X2
+	VFMADDSUB231PS SCALE_R_X, X0, X2
+	VMOVSD         (DST_PTR), X3
+	VADDPS         X3, X2, X2
+	VMOVSD         X2, (DST_PTR)
+
+next_row:
+	ADDQ $SIZE, X_PTR
+	ADDQ INC_SRC, SRC_ROW
+	ADDQ INC_DST, DST_ROW
+	DECQ M
+	JNZ  row_loop
+
+end:
+	VZEROUPPER
+	RET
@@ -0,0 +1,127 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine,!safe
+
+#include "textflag.h"
+
+#define SIZE 8
+
+#define M CX
+#define N BX
+#define X_PTR SI
+#define Y_PTR DX
+#define A_ROW DI
+#define A_PTR AX
+#define X_IDX R9
+#define LEN R10
+#define LDA R8
+
+#define ALPHA X14
+#define ALPHA_SW X15
+
+// func gemvNAVX2(m, n uintptr,
+//	alpha complex64,
+//	a []complex64, lda uintptr,
+//	x, y []complex64)
+TEXT ·gemvNAVX2(SB), NOSPLIT, $0-104
+	MOVQ      m+0(FP), M
+	MOVQ      n+8(FP), N
+	VMOVSS    alpha_real+16(FP), ALPHA
+	VINSERTPS $0x10, alpha_imag+20(FP), ALPHA, ALPHA // ALPHA = { real(alpha), imag(alpha) }
+	VPERMILPS $0xB1, ALPHA, ALPHA_SW                 // ALPHA_SW = { imag(alpha), real(alpha) }
+	MOVQ      a_base+24(FP), A_ROW
+	MOVQ      lda+48(FP), LDA
+	SHLQ      $3, LDA                                // LDA *= SIZE
+	MOVQ      x_base+56(FP), X_PTR
+	MOVQ      y_base+80(FP), Y_PTR
+	TESTQ     M, M
+	JZ        end
+
+row_loop:
+	// The products of the row of A with x are accumulated into Y0 and Y1
+	// and the products with x with its real and imaginary parts swapped
+	// are accumulated into Y2 and Y3.
+	VXORPS Y0, Y0, Y0
+	VXORPS Y1, Y1, Y1
+	VXORPS Y2, Y2, Y2
+	VXORPS Y3, Y3, Y3
+	MOVQ   A_ROW, A_PTR
+	MOVQ   X_PTR, X_IDX
+	MOVQ   N, LEN
+	SHRQ   $3, LEN        // LEN = floor( n / 8 )
+	JZ     tail4
+
+loop8:
+	VMOVUPS     (X_IDX), Y4
+	VMOVUPS     32(X_IDX), Y5
+	VPERMILPS   $0xB1, Y4, Y6
+	VPERMILPS   $0xB1, Y5, Y7
+	VFMADD231PS (A_PTR), Y4, Y0
+	VFMADD231PS 32(A_PTR), Y5, Y1
+	VFMADD231PS (A_PTR), Y6, Y2
+	VFMADD231PS 32(A_PTR), Y7, Y3
+	ADDQ        $8*SIZE, A_PTR
+	ADDQ        $8*SIZE, X_IDX
+	DECQ        LEN
+	JNZ         loop8
+
+tail4:
+	TESTQ       $4, N
+	JZ          reduce
+	VMOVUPS     (X_IDX), Y4
+	VPERMILPS   $0xB1, Y4, Y6
+	VFMADD231PS (A_PTR), Y4, Y0
+	VFMADD231PS (A_PTR), Y6, Y2
+	ADDQ        $4*SIZE, A_PTR
+	ADDQ        $4*SIZE, X_IDX
+
+reduce:
+	VADDPS       Y1, Y0, Y0
+	VADDPS       Y3, Y2, Y2
+	VEXTRACTF128 $1, Y0, X1
+	VADDPS       X1, X0, X0
+	VEXTRACTF128 $1, Y2, X3
+	VADDPS       X3, X2, X2
+	TESTQ        $2, N
+	JZ           fold
+	VMOVUPS      (A_PTR), X5
+	VMOVUPS      (X_IDX), X4
+	VPERMILPS    $0xB1, X4, X6
+	VFMADD231PS  X5, X4, X0
+	VFMADD231PS  X5, X6, X2
+	ADDQ         $2*SIZE, A_PTR
+	ADDQ         $2*SIZE, X_IDX
+
+fold:
+	VMOVHLPS    X0, X0, X1
+	VADDPS      X1, X0, X0
+	VMOVHLPS    X2, X2, X3
+	VADDPS      X3, X2, X2
+	TESTQ       $1, N
+	JZ          store
+	VMOVSD      (A_PTR), X5
+	VMOVSD      (X_IDX), X4
+	VPERMILPS   $0xB1, X4, X6
+	VFMADD231PS X5, X4, X0
+	VFMADD231PS X5, X6, X2
+
+store: // y[i] += alpha * sum
+	VHSUBPS        X0, X0, X0
+	VMOVSLDUP      X0, X0          // X0 = { real(sum), real(sum), ... }
+	VHADDPS        X2, X2, X2
+	VMOVSLDUP      X2, X2          // X2 = { imag(sum), imag(sum), ... }
+	VMULPS         ALPHA_SW, X2, X2
+	VFMADDSUB231PS ALPHA, X0, X2   // X2 = alpha * sum
+	VMOVSD         (Y_PTR), X3
+	VADDPS         X3, X2, X2
+	VMOVSD         X2, (Y_PTR)
+	ADDQ           $SIZE, Y_PTR
+	ADDQ           LDA, A_ROW
+	DECQ           M
+	JNZ            row_loop
+
+end:
+	VZEROUPPER
+	RET
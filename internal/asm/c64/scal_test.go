@@ -0,0 +1,92 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c64
+
+import (
+	"fmt"
+	"testing"
+)
+
+var sscalTests = []struct {
+	alpha float32
+	x     []complex64
+	want  []complex64
+}{
+	{
+		alpha: 0,
+		x:     []complex64{},
+		want:  []complex64{},
+	},
+	{
+		alpha: 1,
+		x:     []complex64{1 + 2i},
+		want:  []complex64{1 + 2i},
+	},
+	{
+		alpha: 2,
+		x:     []complex64{1 + 2i},
+		want:  []complex64{2 + 4i},
+	},
+	{
+		alpha: 2,
+		x:     []complex64{1 + 2i, 3 + 5i, 6 + 11i, 12 - 23i},
+		want:  []complex64{2 + 4i, 6 + 10i, 12 + 22i, 24 - 46i},
+	},
+	{
+		alpha: 3,
+		x:     []complex64{1 + 2i, 5 + 4i, 3 + 6i, 8 + 12i, -3 - 2i, -5 + 5i},
+		want:  []complex64{3 + 6i, 15 + 12i, 9 + 18i, 24 + 36i, -9 - 6i, -15 + 15i},
+	},
+	{
+		alpha: 5,
+		x:     []complex64{1 + 2i, 5 + 4i, 3 + 6i, 8 + 12i, -3 - 2i, -5 + 5i, 1 + 2i, 5 + 4i, 3 + 6i, 8 + 12i, -3 - 2i, -5 + 5i},
+		want:  []complex64{5 + 10i, 25 + 20i, 15 + 30i, 40 + 60i, -15 - 10i, -25 + 25i, 5 + 10i, 25 + 20i, 15 + 30i, 40 + 60i, -15 - 10i, -25 + 25i},
+	},
+}
+
+func TestSscalUnitary(t *testing.T) {
+	const xGdVal = -0.5
+	for i, test := range sscalTests {
+		for _, align := range align1 {
+			prefix := fmt.Sprintf("Test %v (x:%v)", i, align)
+			xgLn := 4 + align
+			xg := guardVector(test.x, xGdVal, xgLn)
+			x := xg[xgLn : len(xg)-xgLn]
+
+			SscalUnitary(test.alpha, x)
+
+			for i := range test.want {
+				if !same(x[i], test.want[i]) {
+					t.Errorf(msgVal, prefix, i, x[i], test.want[i])
+				}
+			}
+			if !isValidGuard(xg, xGdVal, xgLn) {
+				t.Errorf(msgGuard, prefix, "x", xg[:xgLn], xg[len(xg)-xgLn:])
+			}
+		}
+	}
+}
+
+func TestSscalInc(t *testing.T) {
+	const xGdVal = -0.5
+	gdLn := 4
+	for i, test := range sscalTests {
+		n := len(test.x)
+		for _, incX := range []int{1, 2, 3, 4, 7, 10} {
+			prefix := fmt.Sprintf("Test %v (x:%v)", i, incX)
+			xg := guardIncVector(test.x, xGdVal, incX, gdLn)
+			x := xg[gdLn : len(xg)-gdLn]
+
+			SscalInc(test.alpha, x, uintptr(n), uintptr(incX))
+
+			for i := range test.want {
+				if !same(x[i*incX], test.want[i]) {
+					t.Errorf(msgVal, prefix, i, x[i*incX], test.want[i])
+				}
+			}
+			checkValidIncGuard(t, xg, xGdVal, incX, gdLn)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine,!safe
+
+#include "textflag.h"
+
+#define SIZE 4
+
+#define M CX
+#define N BX
+#define X_PTR SI
+#define SRC_ROW DI
+#define DST_ROW DX
+#define SRC_PTR AX
+#define DST_PTR R10
+#define LEN R11
+#define INC_SRC R8
+#define INC_DST R9
+
+#define ALPHA X15
+#define SCALE Y14
+#define SCALE_X X14
+
+// func axpyRowsAVX2(m, n uintptr,
+//	alpha float32,
+//	x, src []float32, incSrc uintptr,
+//	dst []float32, incDst uintptr)
+TEXT ·axpyRowsAVX2(SB), NOSPLIT, $0-112
+	MOVQ   m+0(FP), M
+	MOVQ   n+8(FP), N
+	VMOVSS alpha+16(FP), ALPHA
+	MOVQ   x_base+24(FP), X_PTR
+	MOVQ   src_base+48(FP), SRC_ROW
+	MOVQ   incSrc+72(FP), INC_SRC
+	SHLQ   $2, INC_SRC                // INC_SRC *= SIZE
+	MOVQ   dst_base+80(FP), DST_ROW
+	MOVQ   incDst+104(FP), INC_DST
+	SHLQ   $2, INC_DST                // INC_DST *= SIZE
+	TESTQ  M, M
+	JZ     end
+
+row_loop:
+	VMULSS       (X_PTR), ALPHA, SCALE_X // SCALE = alpha * x[i]
+	VBROADCASTSS SCALE_X, SCALE
+	MOVQ         SRC_ROW, SRC_PTR
+	MOVQ         DST_ROW, DST_PTR
+	MOVQ         N, LEN
+	SHRQ         $5, LEN                 // LEN = floor( n / 32 )
+	JZ           tail8_start
+
+loop32: // dst[j:j+32] += SCALE * src[j:j+32]
+	VMOVUPS     (SRC_PTR), Y0
+	VMOVUPS     32(SRC_PTR), Y1
+	VMOVUPS     64(SRC_PTR), Y2
+	VMOVUPS     96(SRC_PTR), Y3
+	VMULPS      SCALE, Y0, Y0
+	VMULPS      SCALE, Y1, Y1
+	VMULPS      SCALE, Y2, Y2
+	VMULPS      SCALE, Y3, Y3
+	VADDPS      (DST_PTR), Y0, Y0
+	VADDPS      32(DST_PTR), Y1, Y1
+	VADDPS      64(DST_PTR), Y2, Y2
+	VADDPS      96(DST_PTR), Y3, Y3
+	VMOVUPS     Y0, (DST_PTR)
+	VMOVUPS     Y1, 32(DST_PTR)
+	VMOVUPS     Y2, 64(DST_PTR)
+	VMOVUPS     Y3, 96(DST_PTR)
+	ADDQ        $32*SIZE, SRC_PTR
+	ADDQ        $32*SIZE, DST_PTR
+	DECQ        LEN
+	JNZ         loop32
+
+tail8_start:
+	MOVQ N, LEN
+	ANDQ $31, LEN
+	SHRQ $3, LEN    // LEN = floor( (n % 32) / 8 )
+	JZ   tail1_start
+
+tail8:
+	VMOVUPS     (SRC_PTR), Y0
+	VMULPS      SCALE, Y0, Y0
+	VADDPS      (DST_PTR), Y0, Y0
+	VMOVUPS     Y0, (DST_PTR)
+	ADDQ        $8*SIZE, SRC_PTR
+	ADDQ        $8*SIZE, DST_PTR
+	DECQ        LEN
+	JNZ         tail8
+
+tail1_start:
+	MOVQ N, LEN
+	ANDQ $7, LEN // LEN = n % 8
+	JZ   next_row
+
+tail1:
+	VMOVSS      (SRC_PTR), X0
+	VMULSS      SCALE_X, X0, X0
+	VADDSS      (DST_PTR), X0, X0
+	VMOVSS      X0, (DST_PTR)
+	ADDQ        $SIZE, SRC_PTR
+	ADDQ        $SIZE, DST_PTR
+	DECQ        LEN
+	JNZ         tail1
+
+next_row:
+	ADDQ $SIZE, X_PTR
+	ADDQ INC_SRC, SRC_ROW
+	ADDQ INC_DST, DST_ROW
+	DECQ M
+	JNZ  row_loop
+
+end:
+	VZEROUPPER
+	RET
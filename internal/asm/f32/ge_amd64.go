@@ -6,10 +6,36 @@
 
 package f32
 
+import "gonum.org/v1/gonum/internal/cpu"
+
+// useAVX2FMA specifies whether the AVX2 and FMA kernels are used in
+// preference to the SSE kernels. It is set from the features of the
+// processor the program is running on.
+var useAVX2FMA = cpu.X86.HasAVX2 && cpu.X86.HasFMA
+
 // Ger performs the rank-one operation
 //  A += alpha * x * y^T
 // where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar.
 func Ger(m, n uintptr, alpha float32,
+	x []float32, incX uintptr,
+	y []float32, incY uintptr,
+	a []float32, lda uintptr) {
+	if useAVX2FMA && incX == 1 && incY == 1 {
+		axpyRowsAVX2(m, n, alpha, x, y, 0, a, lda)
+		return
+	}
+	gerSSE(m, n, alpha, x, incX, y, incY, a, lda)
+}
+
+func gerSSE(m, n uintptr, alpha float32,
 	x []float32, incX uintptr,
 	y []float32, incY uintptr,
 	a []float32, lda uintptr)
+
+// axpyRowsAVX2 computes
+//  dst_i += alpha * x[i] * src_i
+// for i = 0, ..., m-1, where src_i and dst_i are the vectors of length n
+// starting at src[i*incSrc] and dst[i*incDst] respectively, using AVX2
+// instructions. As in gerSSE, each product is rounded before it is added to
+// dst. A zero incSrc or incDst uses the same vector for each i.
+func axpyRowsAVX2(m, n uintptr, alpha float32, x, src []float32, incSrc uintptr, dst []float32, incDst uintptr)
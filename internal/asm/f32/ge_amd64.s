@@ -348,11 +348,11 @@
 	MOVSS X5, (A_PTR)  \
 	ADDQ  $SIZE, A_PTR
 
-// func Ger(m, n uintptr, alpha float32,
+// func gerSSE(m, n uintptr, alpha float32,
 //	x []float32, incX uintptr,
 //	y []float32, incY uintptr,
 //	a []float32, lda uintptr)
-TEXT ·Ger(SB), 0, $16-120
+TEXT ·gerSSE(SB), 0, $16-120
 	MOVQ M_DIM, M
 	MOVQ N_DIM, N
 	CMPQ M, $0
@@ -0,0 +1,70 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!appengine,!safe
+
+package f32
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/internal/cpu"
+)
+
+// withAVX2FMA runs fn with the AVX2 and FMA kernels enabled or disabled.
+func withAVX2FMA(t *testing.T, use bool, fn func(*testing.T)) {
+	if use && !(cpu.X86.HasAVX2 && cpu.X86.HasFMA) {
+		t.Skip("AVX2 and FMA not supported")
+	}
+	defer func(v bool) { useAVX2FMA = v }(useAVX2FMA)
+	useAVX2FMA = use
+	fn(t)
+}
+
+func TestGerSSE(t *testing.T) { withAVX2FMA(t, false, TestGer) }
+
+func TestGemmKernelGeneric(t *testing.T) { withAVX2FMA(t, false, TestGemmKernel) }
+
+func TestGerAVX2(t *testing.T) {
+	const (
+		aGdVal = 10
+		gdLn   = 4
+	)
+	withAVX2FMA(t, true, func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		for _, m := range []int{0, 1, 3, 4, 17} {
+			for _, n := range []int{0, 1, 7, 8, 9, 31, 32, 33, 100} {
+				const alpha = -0.75
+				prefix := fmt.Sprintf("Test (%vx%v)", m, n)
+				lda := n + 3
+				x := randomSlice(m, rnd)
+				y := randomSlice(n, rnd)
+				a := randomSlice(m*lda, rnd)
+
+				want := make([]float32, len(a))
+				copy(want, a)
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						want[i*lda+j] += alpha * x[i] * y[j]
+					}
+				}
+
+				ag := guardVector(a, aGdVal, gdLn)
+				a = ag[gdLn : len(ag)-gdLn]
+				Ger(uintptr(m), uintptr(n), alpha, x, 1, y, 1, a, uintptr(lda))
+				for i := range want {
+					if !within(a[i], want[i]) {
+						t.Errorf(msgVal, prefix, i, a[i], want[i])
+					}
+				}
+				if !isValidGuard(ag, aGdVal, gdLn) {
+					t.Errorf(msgGuard, prefix, "a", ag[:gdLn], ag[len(ag)-gdLn:])
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,25 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f32
+
+// GemmMR and GemmNR are the number of rows and columns of the block of C
+// updated by GemmKernel.
+const (
+	GemmMR = 4
+	GemmNR = 16
+)
+
+// gemmKernelGeneric is the Go implementation of GemmKernel.
+func gemmKernelGeneric(k uintptr, a, b, c []float32, ldc uintptr) {
+	for l := uintptr(0); l < k; l++ {
+		bl := b[l*GemmNR : l*GemmNR+GemmNR]
+		for i, av := range a[l*GemmMR : l*GemmMR+GemmMR] {
+			ci := c[uintptr(i)*ldc : uintptr(i)*ldc+GemmNR]
+			for j, bv := range bl {
+				ci[j] += av * bv
+			}
+		}
+	}
+}
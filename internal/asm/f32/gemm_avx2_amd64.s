@@ -0,0 +1,96 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine,!safe
+
+#include "textflag.h"
+
+#define SIZE 4
+
+#define K CX
+#define A_PTR SI
+#define B_PTR DI
+#define C_PTR DX
+#define LDC R8
+#define C_ROW R9
+
+// KERNEL_4x16 accumulates the outer product of a column of the packed A
+// panel and a row of the packed B panel into Y0-Y7.
+#define KERNEL_4x16 \
+	VMOVUPS      (B_PTR), Y8           \
+	VMOVUPS      32(B_PTR), Y9         \
+	VBROADCASTSS (A_PTR), Y10          \
+	VBROADCASTSS 4(A_PTR), Y11         \
+	VFMADD231PS  Y8, Y10, Y0           \
+	VFMADD231PS  Y9, Y10, Y1           \
+	VFMADD231PS  Y8, Y11, Y2           \
+	VFMADD231PS  Y9, Y11, Y3           \
+	VBROADCASTSS 8(A_PTR), Y12         \
+	VBROADCASTSS 12(A_PTR), Y13        \
+	VFMADD231PS  Y8, Y12, Y4           \
+	VFMADD231PS  Y9, Y12, Y5           \
+	VFMADD231PS  Y8, Y13, Y6           \
+	VFMADD231PS  Y9, Y13, Y7           \
+	ADDQ         $4*SIZE, A_PTR        \
+	ADDQ         $16*SIZE, B_PTR
+
+// LOAD_ROW loads the row of C at C_PTR into r0 and r1 and moves C_PTR to
+// the next row.
+#define LOAD_ROW(r0, r1) \
+	VMOVUPS (C_PTR), r0    \
+	VMOVUPS 32(C_PTR), r1  \
+	ADDQ    LDC, C_PTR
+
+// STORE_ROW stores r0 and r1 to the row of C at C_PTR and moves C_PTR to
+// the next row.
+#define STORE_ROW(r0, r1) \
+	VMOVUPS r0, (C_PTR)    \
+	VMOVUPS r1, 32(C_PTR)  \
+	ADDQ    LDC, C_PTR
+
+// func gemmKernelAVX2(k uintptr, a, b, c []float32, ldc uintptr)
+TEXT ·gemmKernelAVX2(SB), NOSPLIT, $0-88
+	MOVQ k+0(FP), K
+	MOVQ a_base+8(FP), A_PTR
+	MOVQ b_base+32(FP), B_PTR
+	MOVQ c_base+56(FP), C_ROW
+	MOVQ ldc+80(FP), LDC
+	SHLQ $2, LDC              // LDC *= SIZE
+
+	// The block of C is accumulated in Y0-Y7.
+	MOVQ C_ROW, C_PTR
+	LOAD_ROW(Y0, Y1)
+	LOAD_ROW(Y2, Y3)
+	LOAD_ROW(Y4, Y5)
+	LOAD_ROW(Y6, Y7)
+
+	SHRQ $2, K    // K = floor( k / 4 )
+	JZ   tail_start
+
+loop: // Unrolled four times.
+	KERNEL_4x16
+	KERNEL_4x16
+	KERNEL_4x16
+	KERNEL_4x16
+	DECQ K
+	JNZ  loop
+
+tail_start:
+	MOVQ k+0(FP), K
+	ANDQ $3, K      // K = k % 4
+	JZ   store
+
+tail:
+	KERNEL_4x16
+	DECQ K
+	JNZ  tail
+
+store:
+	MOVQ C_ROW, C_PTR
+	STORE_ROW(Y0, Y1)
+	STORE_ROW(Y2, Y3)
+	STORE_ROW(Y4, Y5)
+	STORE_ROW(Y6, Y7)
+	VZEROUPPER
+	RET
@@ -0,0 +1,22 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !amd64 noasm appengine safe
+
+package f32
+
+// GemmKernel computes
+//  C += A * B
+// where C is a GemmMR×GemmNR block with row stride ldc, A is a GemmMR×k
+// matrix packed in column-major order and B is a k×GemmNR matrix packed in
+// row-major order.
+func GemmKernel(k uintptr, a, b, c []float32, ldc uintptr) {
+	gemmKernelGeneric(k, a, b, c, ldc)
+}
+
+// HasGemmKernel reports whether GemmKernel is implemented with vector
+// instructions on the current processor.
+func HasGemmKernel() bool {
+	return false
+}
@@ -0,0 +1,109 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine,!safe
+
+#include "textflag.h"
+
+#define SIZE 8
+
+#define M CX
+#define N BX
+#define X_PTR SI
+#define SRC_ROW DI
+#define DST_ROW DX
+#define SRC_PTR AX
+#define DST_PTR R10
+#define LEN R11
+#define INC_SRC R8
+#define INC_DST R9
+
+#define ALPHA X15
+#define SCALE Y14
+#define SCALE_X X14
+
+// func axpyRowsAVX2(m, n uintptr,
+//	alpha float64,
+//	x, src []float64, incSrc uintptr,
+//	dst []float64, incDst uintptr)
+TEXT ·axpyRowsAVX2(SB), NOSPLIT, $0-112
+	MOVQ   m+0(FP), M
+	MOVQ   n+8(FP), N
+	VMOVSD alpha+16(FP), ALPHA
+	MOVQ   x_base+24(FP), X_PTR
+	MOVQ   src_base+48(FP), SRC_ROW
+	MOVQ   incSrc+72(FP), INC_SRC
+	SHLQ   $3, INC_SRC                // INC_SRC *= SIZE
+	MOVQ   dst_base+80(FP), DST_ROW
+	MOVQ   incDst+104(FP), INC_DST
+	SHLQ   $3, INC_DST                // INC_DST *= SIZE
+	TESTQ  M, M
+	JZ     end
+
+row_loop:
+	VMULSD       (X_PTR), ALPHA, SCALE_X // SCALE = alpha * x[i]
+	VBROADCASTSD SCALE_X, SCALE
+	MOVQ         SRC_ROW, SRC_PTR
+	MOVQ         DST_ROW, DST_PTR
+	MOVQ         N, LEN
+	SHRQ         $4, LEN                 // LEN = floor( n / 16 )
+	JZ           tail4_start
+
+loop16: // dst[j:j+16] += SCALE * src[j:j+16]
+	VMOVUPD     (SRC_PTR), Y0
+	VMOVUPD     32(SRC_PTR), Y1
+	VMOVUPD     64(SRC_PTR), Y2
+	VMOVUPD     96(SRC_PTR), Y3
+	VFMADD213PD (DST_PTR), SCALE, Y0
+	VFMADD213PD 32(DST_PTR), SCALE, Y1
+	VFMADD213PD 64(DST_PTR), SCALE, Y2
+	VFMADD213PD 96(DST_PTR), SCALE, Y3
+	VMOVUPD     Y0, (DST_PTR)
+	VMOVUPD     Y1, 32(DST_PTR)
+	VMOVUPD     Y2, 64(DST_PTR)
+	VMOVUPD     Y3, 96(DST_PTR)
+	ADDQ        $16*SIZE, SRC_PTR
+	ADDQ        $16*SIZE, DST_PTR
+	DECQ        LEN
+	JNZ         loop16
+
+tail4_start:
+	MOVQ N, LEN
+	ANDQ $15, LEN
+	SHRQ $2, LEN    // LEN = floor( (n % 16) / 4 )
+	JZ   tail1_start
+
+tail4:
+	VMOVUPD     (SRC_PTR), Y0
+	VFMADD213PD (DST_PTR), SCALE, Y0
+	VMOVUPD     Y0, (DST_PTR)
+	ADDQ        $4*SIZE, SRC_PTR
+	ADDQ        $4*SIZE, DST_PTR
+	DECQ        LEN
+	JNZ         tail4
+
+tail1_start:
+	MOVQ N, LEN
+	ANDQ $3, LEN // LEN = n % 4
+	JZ   next_row
+
+tail1:
+	VMOVSD      (SRC_PTR), X0
+	VFMADD213SD (DST_PTR), SCALE_X, X0
+	VMOVSD      X0, (DST_PTR)
+	ADDQ        $SIZE, SRC_PTR
+	ADDQ        $SIZE, DST_PTR
+	DECQ        LEN
+	JNZ         tail1
+
+next_row:
+	ADDQ $SIZE, X_PTR
+	ADDQ INC_SRC, SRC_ROW
+	ADDQ INC_DST, DST_ROW
+	DECQ M
+	JNZ  row_loop
+
+end:
+	VZEROUPPER
+	RET
@@ -6,17 +6,76 @@
 
 package f64
 
+import "gonum.org/v1/gonum/internal/cpu"
+
+// useAVX2FMA specifies whether the AVX2 and FMA kernels are used in
+// preference to the SSE kernels. It is set from the features of the
+// processor the program is running on.
+var useAVX2FMA = cpu.X86.HasAVX2 && cpu.X86.HasFMA
+
 // Ger performs the rank-one operation
 //  A += alpha * x * y^T
 // where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar.
-func Ger(m, n uintptr, alpha float64, x []float64, incX uintptr, y []float64, incY uintptr, a []float64, lda uintptr)
+func Ger(m, n uintptr, alpha float64, x []float64, incX uintptr, y []float64, incY uintptr, a []float64, lda uintptr) {
+	if useAVX2FMA && incX == 1 && incY == 1 {
+		axpyRowsAVX2(m, n, alpha, x, y, 0, a, lda)
+		return
+	}
+	gerSSE(m, n, alpha, x, incX, y, incY, a, lda)
+}
 
 // GemvN computes
 //  y = alpha * A * x + beta * y
 // where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
-func GemvN(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr)
+func GemvN(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr) {
+	if useAVX2FMA && incX == 1 && incY == 1 {
+		if beta == 0 {
+			// Clear y so that NaN values in y are not propagated.
+			for i := range y[:m] {
+				y[i] = 0
+			}
+		}
+		gemvNAVX2(m, n, alpha, a, lda, x, beta, y)
+		return
+	}
+	gemvNSSE(m, n, alpha, a, lda, x, incX, beta, y, incY)
+}
 
 // GemvT computes
 //  y = alpha * A^T * x + beta * y
 // where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
-func GemvT(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr)
+func GemvT(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr) {
+	if useAVX2FMA && incX == 1 && incY == 1 {
+		switch beta {
+		case 0:
+			for i := range y[:n] {
+				y[i] = 0
+			}
+		case 1:
+		default:
+			ScalUnitary(beta, y[:n])
+		}
+		axpyRowsAVX2(m, n, alpha, x, a, lda, y, 0)
+		return
+	}
+	gemvTSSE(m, n, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+func gerSSE(m, n uintptr, alpha float64, x []float64, incX uintptr, y []float64, incY uintptr, a []float64, lda uintptr)
+
+func gemvNSSE(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr)
+
+func gemvTSSE(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, incX uintptr, beta float64, y []float64, incY uintptr)
+
+// gemvNAVX2 computes
+//  y = alpha * A * x + beta * y
+// for unit stride x and y using AVX2 instructions. The result is identical
+// to that of gemvNSSE.
+func gemvNAVX2(m, n uintptr, alpha float64, a []float64, lda uintptr, x []float64, beta float64, y []float64)
+
+// axpyRowsAVX2 computes
+//  dst_i += alpha * x[i] * src_i
+// for i = 0, ..., m-1, where src_i and dst_i are the vectors of length n
+// starting at src[i*incSrc] and dst[i*incDst] respectively, using AVX2 and
+// FMA instructions. A zero incSrc or incDst uses the same vector for each i.
+func axpyRowsAVX2(m, n uintptr, alpha float64, x, src []float64, incSrc uintptr, dst []float64, incDst uintptr)
@@ -0,0 +1,163 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!appengine,!safe
+
+package f64
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/internal/cpu"
+)
+
+// withAVX2FMA runs fn with the AVX2 and FMA kernels enabled or disabled.
+func withAVX2FMA(t *testing.T, use bool, fn func(*testing.T)) {
+	if use && !(cpu.X86.HasAVX2 && cpu.X86.HasFMA) {
+		t.Skip("AVX2 and FMA not supported")
+	}
+	defer func(v bool) { useAVX2FMA = v }(useAVX2FMA)
+	useAVX2FMA = use
+	fn(t)
+}
+
+func TestGemvSSE(t *testing.T) { withAVX2FMA(t, false, TestGemv) }
+
+func TestGerSSE(t *testing.T) { withAVX2FMA(t, false, TestGer) }
+
+func TestGemmKernelGeneric(t *testing.T) { withAVX2FMA(t, false, TestGemmKernel) }
+
+func TestGemvAVX2(t *testing.T) {
+	const (
+		yGdVal = 1.5
+		gdLn   = 4
+	)
+	withAVX2FMA(t, true, func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		for _, m := range []int{0, 1, 3, 4, 17, 40} {
+			for _, n := range []int{0, 1, 3, 4, 5, 15, 16, 17, 33, 100} {
+				for _, beta := range []float64{0, 1, -0.5} {
+					const alpha = 1.25
+					lda := n + 3
+					a := randSlice(m*lda+1, 1, rnd)
+					for _, trans := range []bool{false, true} {
+						prefix := fmt.Sprintf("Test (%vx%v) t:%v (a:%v,b:%v)", m, n, trans, alpha, beta)
+						lx, ly := n, m
+						if trans {
+							lx, ly = m, n
+						}
+						x := randSlice(lx+1, 1, rnd)[:lx]
+						y := randSlice(ly+1, 1, rnd)[:ly]
+						if beta == 0 && ly > 0 {
+							y[0] = nan
+						}
+
+						want := make([]float64, ly)
+						for i := range want {
+							var sum float64
+							for j := range x {
+								if trans {
+									sum += a[j*lda+i] * x[j]
+								} else {
+									sum += a[i*lda+j] * x[j]
+								}
+							}
+							want[i] = alpha * sum
+							if beta != 0 {
+								want[i] += beta * y[i]
+							}
+						}
+
+						yg := guardVector(y, yGdVal, gdLn)
+						y = yg[gdLn : len(yg)-gdLn]
+						if trans {
+							GemvT(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, 1, beta, y, 1)
+						} else {
+							GemvN(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, 1, beta, y, 1)
+						}
+						for i := range want {
+							if !within(y[i], want[i]) {
+								t.Errorf(msgVal, prefix, i, y[i], want[i])
+							}
+						}
+						if !isValidGuard(yg, yGdVal, gdLn) {
+							t.Errorf(msgGuard, prefix, "y", yg[:gdLn], yg[len(yg)-gdLn:])
+						}
+					}
+				}
+			}
+		}
+	})
+}
+
+func TestGemvNAVX2MatchesSSE(t *testing.T) {
+	withAVX2FMA(t, true, func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		for _, m := range []int{1, 2, 3, 4, 5, 17, 40} {
+			for _, n := range []int{1, 2, 3, 4, 5, 6, 7, 15, 16, 17, 33, 100} {
+				for _, beta := range []float64{0, 1, -0.5} {
+					const alpha = 1.25
+					prefix := fmt.Sprintf("Test (%vx%v) (a:%v,b:%v)", m, n, alpha, beta)
+					lda := n + 3
+					a := randSlice(m*lda+1, 1, rnd)
+					x := randSlice(n, 1, rnd)
+					y := randSlice(m, 1, rnd)
+					want := make([]float64, m)
+					copy(want, y)
+
+					gemvNSSE(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, 1, beta, want, 1)
+					gemvNAVX2(uintptr(m), uintptr(n), alpha, a, uintptr(lda), x, beta, y)
+					for i := range want {
+						if !same(y[i], want[i]) {
+							t.Errorf(msgVal, prefix, i, y[i], want[i])
+						}
+					}
+				}
+			}
+		}
+	})
+}
+
+func TestGerAVX2(t *testing.T) {
+	const (
+		aGdVal = 10
+		gdLn   = 4
+	)
+	withAVX2FMA(t, true, func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		for _, m := range []int{0, 1, 3, 4, 17} {
+			for _, n := range []int{0, 1, 3, 4, 5, 15, 16, 17, 33, 100} {
+				const alpha = -0.75
+				prefix := fmt.Sprintf("Test (%vx%v)", m, n)
+				lda := n + 3
+				x := randSlice(m+1, 1, rnd)[:m]
+				y := randSlice(n+1, 1, rnd)[:n]
+				a := randSlice(m*lda+1, 1, rnd)[:m*lda]
+
+				want := make([]float64, len(a))
+				copy(want, a)
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						want[i*lda+j] += alpha * x[i] * y[j]
+					}
+				}
+
+				ag := guardVector(a, aGdVal, gdLn)
+				a = ag[gdLn : len(ag)-gdLn]
+				Ger(uintptr(m), uintptr(n), alpha, x, 1, y, 1, a, uintptr(lda))
+				for i := range want {
+					if !within(a[i], want[i]) {
+						t.Errorf(msgVal, prefix, i, a[i], want[i])
+					}
+				}
+				if !isValidGuard(ag, aGdVal, gdLn) {
+					t.Errorf(msgGuard, prefix, "a", ag[:gdLn], ag[len(ag)-gdLn:])
+				}
+			}
+		}
+	})
+}
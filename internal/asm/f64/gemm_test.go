@@ -0,0 +1,64 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package f64
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestGemmKernel(t *testing.T) {
+	const (
+		cGdVal = 10
+		gdLn   = 4
+	)
+	rnd := rand.New(rand.NewSource(1))
+	for _, k := range []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 16, 33} {
+		for _, ldc := range []int{GemmNR, GemmNR + 3} {
+			prefix := fmt.Sprintf("k=%d ldc=%d", k, ldc)
+			a := randSlice(GemmMR*k+1, 1, rnd)[:GemmMR*k]
+			b := randSlice(GemmNR*k+1, 1, rnd)[:GemmNR*k]
+			c := randSlice((GemmMR-1)*ldc+GemmNR, 1, rnd)
+
+			want := make([]float64, len(c))
+			copy(want, c)
+			for i := 0; i < GemmMR; i++ {
+				for j := 0; j < GemmNR; j++ {
+					var sum float64
+					for l := 0; l < k; l++ {
+						sum += a[l*GemmMR+i] * b[l*GemmNR+j]
+					}
+					want[i*ldc+j] += sum
+				}
+			}
+
+			cg := guardVector(c, cGdVal, gdLn)
+			c = cg[gdLn : len(cg)-gdLn]
+			GemmKernel(uintptr(k), a, b, c, uintptr(ldc))
+			for i := range want {
+				if !within(c[i], want[i]) {
+					t.Errorf(msgVal, prefix, i, c[i], want[i])
+				}
+			}
+			if !isValidGuard(cg, cGdVal, gdLn) {
+				t.Errorf(msgGuard, prefix, "c", cg[:gdLn], cg[len(cg)-gdLn:])
+			}
+		}
+	}
+}
+
+func BenchmarkGemmKernel(b *testing.B) {
+	const k = 256
+	rnd := rand.New(rand.NewSource(1))
+	a := randSlice(GemmMR*k, 1, rnd)
+	bp := randSlice(GemmNR*k, 1, rnd)
+	c := randSlice(GemmMR*GemmNR, 1, rnd)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		GemmKernel(k, a, bp, c, GemmNR)
+	}
+}
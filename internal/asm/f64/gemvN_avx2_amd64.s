@@ -0,0 +1,149 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//+build !noasm,!appengine,!safe
+
+#include "textflag.h"
+
+#define SIZE 8
+
+#define M CX
+#define N BX
+#define X_PTR SI
+#define Y_PTR DX
+#define A_ROW DI
+#define A_PTR AX
+#define X_IDX R9
+#define LEN R10
+#define LDA R8
+
+#define ALPHA X14
+#define BETA X15
+
+// The partial sums of each row are accumulated in the same order as in
+// gemvNSSE: the even and odd elements of a row are summed separately and
+// the two sums are added before the last element of an odd length row.
+// The results of the two kernels are therefore identical.
+
+// func gemvNAVX2(m, n uintptr,
+//	alpha float64,
+//	a []float64, lda uintptr,
+//	x []float64,
+//	beta float64,
+//	y []float64)
+TEXT ·gemvNAVX2(SB), NOSPLIT, $0-112
+	MOVQ     m+0(FP), M
+	MOVQ     n+8(FP), N
+	MOVQ     a_base+24(FP), A_ROW
+	MOVQ     lda+48(FP), LDA
+	SHLQ     $3, LDA                // LDA *= SIZE
+	MOVQ     x_base+56(FP), X_PTR
+	MOVQ     y_base+88(FP), Y_PTR
+	VMOVDDUP alpha+16(FP), ALPHA
+	VMOVDDUP beta+80(FP), BETA
+	SHRQ     $1, M                  // M = floor( m / 2 )
+	JZ       row1
+
+row2_loop:
+	// The even and odd sums of rows i and i+1 are accumulated in Y0.
+	VXORPD Y0, Y0, Y0
+	MOVQ   A_ROW, A_PTR
+	MOVQ   X_PTR, X_IDX
+	MOVQ   N, LEN
+	SHRQ   $2, LEN          // LEN = floor( n / 4 )
+	JZ     row2_tail2
+
+row2_loop4: // Multiply 4 elements of rows i and i+1 by x.
+	VMOVUPD    (X_IDX), Y4
+	VMULPD     (A_PTR), Y4, Y5
+	VMULPD     (A_PTR)(LDA*1), Y4, Y6
+	VPERM2F128 $0x20, Y6, Y5, Y7
+	VPERM2F128 $0x31, Y6, Y5, Y8
+	VADDPD     Y7, Y0, Y0
+	VADDPD     Y8, Y0, Y0
+	ADDQ       $4*SIZE, A_PTR
+	ADDQ       $4*SIZE, X_IDX
+	DECQ       LEN
+	JNZ        row2_loop4
+
+row2_tail2:
+	TESTQ          $2, N
+	JZ             row2_tail1
+	VBROADCASTF128 (X_IDX), Y4
+	VMOVUPD        (A_PTR), X5
+	VINSERTF128    $1, (A_PTR)(LDA*1), Y5, Y5
+	VMULPD         Y4, Y5, Y5
+	VADDPD         Y5, Y0, Y0
+	ADDQ           $2*SIZE, A_PTR
+	ADDQ           $2*SIZE, X_IDX
+
+row2_tail1: // Sum the even and odd elements of each row into X0.
+	VEXTRACTF128 $1, Y0, X1
+	VHADDPD      X1, X0, X0
+	TESTQ        $1, N
+	JZ           row2_store
+	VMOVDDUP     (X_IDX), X4
+	VMOVSD       (A_PTR), X5
+	VMOVHPD      (A_PTR)(LDA*1), X5, X5
+	VMULPD       X4, X5, X5
+	VADDPD       X5, X0, X0
+
+row2_store: // y[i:i+2] = alpha * sum + beta * y[i:i+2]
+	VMOVUPD (Y_PTR), X4
+	VMULPD  ALPHA, X0, X0
+	VMULPD  BETA, X4, X4
+	VADDPD  X4, X0, X0
+	VMOVUPD X0, (Y_PTR)
+	ADDQ    $2*SIZE, Y_PTR
+	LEAQ    (A_ROW)(LDA*2), A_ROW
+	DECQ    M
+	JNZ     row2_loop
+
+row1:
+	TESTQ  $1, m+0(FP)
+	JZ     end
+	VXORPD X0, X0, X0
+	MOVQ   X_PTR, X_IDX
+	MOVQ   N, LEN
+	SHRQ   $2, LEN      // LEN = floor( n / 4 )
+	JZ     row1_tail2
+
+row1_loop4: // Multiply 4 elements of the last row by x.
+	VMOVUPD      (X_IDX), Y4
+	VMULPD       (A_ROW), Y4, Y5
+	VEXTRACTF128 $1, Y5, X6
+	VADDPD       X5, X0, X0
+	VADDPD       X6, X0, X0
+	ADDQ         $4*SIZE, A_ROW
+	ADDQ         $4*SIZE, X_IDX
+	DECQ         LEN
+	JNZ          row1_loop4
+
+row1_tail2:
+	TESTQ   $2, N
+	JZ      row1_tail1
+	VMOVUPD (X_IDX), X4
+	VMULPD  (A_ROW), X4, X4
+	VADDPD  X4, X0, X0
+	ADDQ    $2*SIZE, A_ROW
+	ADDQ    $2*SIZE, X_IDX
+
+row1_tail1:
+	TESTQ  $1, N
+	JZ     row1_store
+	VMOVSD (X_IDX), X4
+	VMULSD (A_ROW), X4, X4
+	VADDSD X4, X0, X0
+
+row1_store: // y[i] = alpha * sum + beta * y[i]
+	VHADDPD X0, X0, X0
+	VMOVSD  (Y_PTR), X4
+	VMULSD  ALPHA, X0, X0
+	VMULSD  BETA, X4, X4
+	VADDSD  X4, X0, X0
+	VMOVSD  X0, (Y_PTR)
+
+end:
+	VZEROUPPER
+	RET
@@ -240,11 +240,11 @@
 	MOVSD X5, (A_PTR)  \
 	ADDQ  $SIZE, A_PTR
 
-// func Ger(m, n uintptr, alpha float64,
+// func gerSSE(m, n uintptr, alpha float64,
 //	x []float64, incX uintptr,
 //	y []float64, incY uintptr,
 //	a []float64, lda uintptr)
-TEXT ·Ger(SB), NOSPLIT, $0
+TEXT ·gerSSE(SB), NOSPLIT, $0
 	MOVQ M_DIM, M
 	MOVQ N_DIM, N
 	CMPQ M, $0
@@ -0,0 +1,21 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cpu implements processor feature detection used to select
+// assembly kernels at run time.
+package cpu // import "gonum.org/v1/gonum/internal/cpu"
+
+// X86 contains the supported CPU features of the current x86/amd64 platform.
+// If the current platform is not amd64, or if assembly has been disabled by
+// the noasm, appengine or safe build tags, all feature flags are false.
+//
+// The AVX, AVX2 and FMA flags are only set if the operating system saves the
+// YMM registers on a context switch.
+var X86 struct {
+	HasSSE2 bool // SSE2 vector instructions.
+	HasSSE3 bool // SSE3 vector instructions.
+	HasAVX  bool // AVX vector instructions.
+	HasAVX2 bool // AVX2 vector instructions.
+	HasFMA  bool // Fused multiply-add instructions (FMA3).
+}
@@ -0,0 +1,45 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !noasm,!appengine,!safe
+
+package cpu
+
+// cpuid executes the CPUID instruction with the given EAX and ECX inputs.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// xgetbv returns the contents of the XCR0 extended control register.
+func xgetbv() (eax, edx uint32)
+
+func init() {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 1 {
+		return
+	}
+
+	_, _, ecx1, edx1 := cpuid(1, 0)
+	X86.HasSSE2 = isSet(26, edx1)
+	X86.HasSSE3 = isSet(0, ecx1)
+
+	// The YMM registers may only be used if the operating system has
+	// enabled XSAVE and saves both the XMM and YMM state.
+	var osSupportsAVX bool
+	if isSet(27, ecx1) {
+		eax, _ := xgetbv()
+		osSupportsAVX = isSet(1, eax) && isSet(2, eax)
+	}
+	X86.HasAVX = isSet(28, ecx1) && osSupportsAVX
+	X86.HasFMA = isSet(12, ecx1) && osSupportsAVX
+
+	if maxID < 7 {
+		return
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	X86.HasAVX2 = isSet(5, ebx7) && osSupportsAVX
+}
+
+// isSet returns whether bit i of v is set.
+func isSet(i uint, v uint32) bool {
+	return v&(1<<i) != 0
+}
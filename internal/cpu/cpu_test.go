@@ -0,0 +1,20 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+import "testing"
+
+func TestX86(t *testing.T) {
+	t.Logf("%+v", X86)
+	if X86.HasAVX2 && !X86.HasAVX {
+		t.Error("AVX2 reported without AVX")
+	}
+	if X86.HasAVX && !X86.HasSSE3 {
+		t.Error("AVX reported without SSE3")
+	}
+	if X86.HasSSE3 && !X86.HasSSE2 {
+		t.Error("SSE3 reported without SSE2")
+	}
+}
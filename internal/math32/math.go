@@ -12,6 +12,9 @@ import (
 	"math"
 )
 
+// Sqrt2 is the square root of 2.
+const Sqrt2 = math.Sqrt2
+
 const (
 	unan    = 0x7fc00000
 	uinf    = 0x7f800000
@@ -107,5 +110,90 @@ func IsNaN(f float32) (is bool) {
 	return f != f
 }
 
+// Log returns the natural logarithm of x. It is computed in float64
+// precision.
+//
+// Special cases are:
+//	Log(+Inf) = +Inf
+//	Log(0) = -Inf
+//	Log(x < 0) = NaN
+//	Log(NaN) = NaN
+func Log(x float32) float32 {
+	return float32(math.Log(float64(x)))
+}
+
+// Log10 returns the decimal logarithm of x. It is computed in float64
+// precision. See math.Log10 for the special cases.
+func Log10(x float32) float32 {
+	return float32(math.Log10(float64(x)))
+}
+
+// Max returns the larger of x or y.
+//
+// Special cases are:
+//	Max(x, +Inf) = Max(+Inf, x) = +Inf
+//	Max(x, NaN) = Max(NaN, x) = NaN
+//	Max(+0, ±0) = Max(±0, +0) = +0
+//	Max(-0, -0) = -0
+func Max(x, y float32) float32 {
+	// special cases
+	switch {
+	case IsInf(x, 1) || IsInf(y, 1):
+		return Inf(1)
+	case IsNaN(x) || IsNaN(y):
+		return NaN()
+	case x == 0 && x == y:
+		if Signbit(x) {
+			return y
+		}
+		return x
+	}
+	if x > y {
+		return x
+	}
+	return y
+}
+
+// Min returns the smaller of x or y.
+//
+// Special cases are:
+//	Min(x, -Inf) = Min(-Inf, x) = -Inf
+//	Min(x, NaN) = Min(NaN, x) = NaN
+//	Min(-0, ±0) = Min(±0, -0) = -0
+func Min(x, y float32) float32 {
+	// special cases
+	switch {
+	case IsInf(x, -1) || IsInf(y, -1):
+		return Inf(-1)
+	case IsNaN(x) || IsNaN(y):
+		return NaN()
+	case x == 0 && x == y:
+		if Signbit(x) {
+			return x
+		}
+		return y
+	}
+	if x < y {
+		return x
+	}
+	return y
+}
+
+// Pow returns x**y, the base-x exponential of y. It is computed in float64
+// precision. See math.Pow for the special cases.
+func Pow(x, y float32) float32 {
+	return float32(math.Pow(float64(x), float64(y)))
+}
+
+// Trunc returns the integer value of x.
+//
+// Special cases are:
+//	Trunc(±0) = ±0
+//	Trunc(±Inf) = ±Inf
+//	Trunc(NaN) = NaN
+func Trunc(x float32) float32 {
+	return float32(math.Trunc(float64(x)))
+}
+
 // NaN returns an IEEE 754 ``not-a-number'' value.
 func NaN() float32 { return math.Float32frombits(unan) }
@@ -84,6 +84,55 @@ func TestIsNaN(t *testing.T) {
 	}
 }
 
+func TestMax(t *testing.T) {
+	f := func(x struct{ X, Y float32 }) bool {
+		y := Max(x.X, x.Y)
+		return same(y, float32(math.Max(float64(x.X), float64(x.Y))))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	for _, test := range []struct{ x, y float32 }{
+		{Inf(1), NaN()},
+		{NaN(), 1},
+		{0, float32(math.Copysign(0, -1))},
+		{float32(math.Copysign(0, -1)), float32(math.Copysign(0, -1))},
+	} {
+		if !same(Max(test.x, test.y), float32(math.Max(float64(test.x), float64(test.y)))) {
+			t.Errorf("unexpected result for Max(%v, %v)", test.x, test.y)
+		}
+	}
+}
+
+func TestMin(t *testing.T) {
+	f := func(x struct{ X, Y float32 }) bool {
+		y := Min(x.X, x.Y)
+		return same(y, float32(math.Min(float64(x.X), float64(x.Y))))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	for _, test := range []struct{ x, y float32 }{
+		{Inf(-1), NaN()},
+		{NaN(), 1},
+		{0, float32(math.Copysign(0, -1))},
+		{0, 0},
+	} {
+		if !same(Min(test.x, test.y), float32(math.Min(float64(test.x), float64(test.y)))) {
+			t.Errorf("unexpected result for Min(%v, %v)", test.x, test.y)
+		}
+	}
+}
+
+// same returns whether a and b are identical, including the sign of zero,
+// or both NaN.
+func same(a, b float32) bool {
+	if IsNaN(a) || IsNaN(b) {
+		return IsNaN(a) && IsNaN(b)
+	}
+	return a == b && Signbit(a) == Signbit(b)
+}
+
 func TestNaN(t *testing.T) {
 	if !math.IsNaN(float64(NaN())) {
 		t.Errorf("float32(nan) is a number: %f", NaN())
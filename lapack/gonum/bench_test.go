@@ -11,3 +11,12 @@ import (
 )
 
 func BenchmarkDgeev(b *testing.B) { testlapack.DgeevBenchmark(b, impl) }
+
+var serialImpl = Implementation{Workers: 1}
+
+func BenchmarkDgeqrf(b *testing.B)       { testlapack.DgeqrfBenchmark(b, impl) }
+func BenchmarkDgeqrfSerial(b *testing.B) { testlapack.DgeqrfBenchmark(b, serialImpl) }
+func BenchmarkDgetrf(b *testing.B)       { testlapack.DgetrfBenchmark(b, impl) }
+func BenchmarkDgetrfSerial(b *testing.B) { testlapack.DgetrfBenchmark(b, serialImpl) }
+func BenchmarkDpotrf(b *testing.B)       { testlapack.DpotrfBenchmark(b, impl) }
+func BenchmarkDpotrfSerial(b *testing.B) { testlapack.DpotrfBenchmark(b, serialImpl) }
@@ -0,0 +1,206 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dbdsdc computes the singular value decomposition of a real n×n upper or lower
+// bidiagonal matrix B using a divide and conquer method.
+//
+// The SVD of B is
+//  B = U * S * VT
+// where S is a diagonal matrix of singular values, U is an orthogonal matrix
+// of left singular vectors, and VT is the transpose of an orthogonal matrix of
+// right singular vectors.
+//
+// compq specifies whether the singular vectors are computed. If
+// compq == lapack.BDCompute, the n×n matrices U and VT are computed, and if
+// compq == lapack.BDNone, u and vt are not referenced.
+//
+// d, on entry, contains the diagonal elements of the bidiagonal matrix. On
+// return, d contains the singular values in decreasing order. d must have
+// length at least n.
+//
+// e, on entry, contains the n-1 off-diagonal elements of the bidiagonal
+// matrix. On return, e has been destroyed.
+//
+// work must have length at least 3*n*n+4*n if compq == lapack.BDCompute and at
+// least 4*n if compq == lapack.BDNone. iwork must have length at least 8*n.
+//
+// Dbdsdc returns whether all the singular values were found.
+func (impl Implementation) Dbdsdc(uplo blas.Uplo, compq lapack.BDComp, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool) {
+	wantuv := compq == lapack.BDCompute
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case compq != lapack.BDCompute && compq != lapack.BDNone:
+		panic(badBDComp)
+	case n < 0:
+		panic(nLT0)
+	case ldu < 1, wantuv && ldu < n:
+		panic(badLdU)
+	case ldvt < 1, wantuv && ldvt < n:
+		panic(badLdVT)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	lwmin := 4 * n
+	if wantuv {
+		lwmin = 3*n*n + 4*n
+	}
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case wantuv && len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case wantuv && len(vt) < (n-1)*ldvt+n:
+		panic(shortVT)
+	case len(work) < lwmin:
+		panic(shortWork)
+	case len(iwork) < 8*n:
+		panic(shortIWork)
+	}
+
+	smlsiz := impl.Ilaenv(9, "DBDSDC", " ", 0, 0, 0, 0)
+	if n == 1 {
+		if wantuv {
+			u[0] = math.Copysign(1, d[0])
+			vt[0] = 1
+		}
+		d[0] = math.Abs(d[0])
+		return true
+	}
+	nm1 := n - 1
+
+	// If the matrix is lower bidiagonal, rotate it to be upper bidiagonal
+	// by applying Givens rotations on the left.
+	lower := uplo == blas.Lower
+	wstart := 0
+	if lower {
+		if wantuv {
+			wstart = 2*n - 2
+		}
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if wantuv {
+				work[i] = cs
+				work[nm1+i] = -sn
+			}
+		}
+	}
+
+	bi := blas64.Implementation()
+	switch {
+	case !wantuv:
+		// If singular vectors are not desired, use Dlasdq to compute the
+		// singular values.
+		ok = impl.Dlasdq(blas.Upper, 0, n, 0, 0, 0, d, e, vt, ldvt, u, ldu, u, ldu, work[wstart:])
+	case n <= smlsiz:
+		// If n is smaller than the minimum divide size smlsiz, then solve
+		// the problem with another solver.
+		impl.Dlaset(blas.All, n, n, 0, 1, u, ldu)
+		impl.Dlaset(blas.All, n, n, 0, 1, vt, ldvt)
+		ok = impl.Dlasdq(blas.Upper, 0, n, n, n, 0, d, e, vt, ldvt, u, ldu, u, ldu, work[wstart:])
+	default:
+		impl.Dlaset(blas.All, n, n, 0, 1, u, ldu)
+		impl.Dlaset(blas.All, n, n, 0, 1, vt, ldvt)
+
+		// Scale.
+		orgnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+		if orgnrm == 0 {
+			return true
+		}
+		impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, 1, d, 1)
+		impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, nm1, 1, e, 1)
+
+		eps := 0.9 * dlamchE
+		for i := 0; i < n; i++ {
+			if math.Abs(d[i]) < eps {
+				d[i] = math.Copysign(eps, d[i])
+			}
+		}
+
+		start := 0
+		for i := 0; i < nm1; i++ {
+			if math.Abs(e[i]) >= eps && i < nm1-1 {
+				continue
+			}
+
+			// A subproblem is found. First determine its size and then
+			// apply divide and conquer on it.
+			var nsize int
+			switch {
+			case i < nm1-1:
+				// A subproblem with e[i] small for i < n-2.
+				nsize = i - start + 1
+			case math.Abs(e[i]) >= eps:
+				// A subproblem with e[n-2] not too small but i == n-2.
+				nsize = n - start
+			default:
+				// A subproblem with e[n-2] small. This implies a 1×1
+				// subproblem at d[n-1]. Solve this 1×1 problem first.
+				nsize = i - start + 1
+				u[(n-1)*ldu+n-1] = math.Copysign(1, d[n-1])
+				vt[(n-1)*ldvt+n-1] = 1
+				d[n-1] = math.Abs(d[n-1])
+			}
+			ok = impl.Dlasd0(nsize, 0, d[start:], e[start:], u[start*ldu+start:], ldu,
+				vt[start*ldvt+start:], ldvt, iwork, work[wstart:])
+			if !ok {
+				return false
+			}
+			start = i + 1
+		}
+
+		// Unscale.
+		impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+	}
+	if !ok {
+		return false
+	}
+
+	// Use selection sort to minimize swaps of singular vectors.
+	for ii := 1; ii < n; ii++ {
+		i := ii - 1
+		kk := i
+		p := d[i]
+		for j := ii; j < n; j++ {
+			if d[j] > p {
+				kk = j
+				p = d[j]
+			}
+		}
+		if kk != i {
+			d[kk] = d[i]
+			d[i] = p
+			if wantuv {
+				bi.Dswap(n, u[i:], ldu, u[kk:], ldu)
+				bi.Dswap(n, vt[i*ldvt:], 1, vt[kk*ldvt:], 1)
+			}
+		}
+	}
+
+	// If B is lower bidiagonal, update U by those Givens rotations which
+	// rotated B to be upper bidiagonal.
+	if lower && wantuv {
+		impl.Dlasr(blas.Left, lapack.Variable, lapack.Backward, n, n, work[:nm1], work[nm1:], u, ldu)
+	}
+	return true
+}
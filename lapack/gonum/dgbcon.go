@@ -0,0 +1,133 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgbcon estimates the reciprocal of the condition number of an n×n band
+// matrix A with kl sub-diagonals and ku super-diagonals, in either the 1-norm
+// or the ∞-norm, using the LU factorization computed by Dgbtrf.
+//
+// An estimate is obtained for norm(A^-1), and the reciprocal of the condition
+// number is computed as
+//  rcond = 1 / (anorm * norm(A^-1)).
+//
+// ab and ipiv contain the LU factorization of A and the pivot indices as
+// computed by Dgbtrf. ldab must be at least 2*kl+ku+1 and ipiv must have
+// length n, otherwise Dgbcon will panic.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work must have length at least 3*n and iwork must have length at least n,
+// otherwise Dgbcon will panic.
+func (impl Implementation) Dgbcon(norm lapack.MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case anorm < 0:
+		panic(negANorm)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(work) < 3*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	// Quick return if possible.
+	if anorm == 0 {
+		return 0
+	}
+
+	bi := blas64.Implementation()
+
+	// The elements of a column of L are stored with stride ldab-1 in ab.
+	inc := max(1, ldab-1)
+	kv := kl + ku
+	var rcond, ainvnm float64
+	var kase int
+	var normin bool
+	isave := new([3]int)
+	onenrm := norm == lapack.MaxColumnSum
+	smlnum := dlamchS
+	kase1 := 2
+	if onenrm {
+		kase1 = 1
+	}
+	x := work[:n]
+	for {
+		ainvnm, kase = impl.Dlacn2(n, work[n:2*n], x, iwork, ainvnm, kase, isave)
+		if kase == 0 {
+			if ainvnm != 0 {
+				rcond = (1 / ainvnm) / anorm
+			}
+			return rcond
+		}
+		var scale float64
+		if kase == kase1 {
+			// Multiply by L^-1.
+			if kl > 0 {
+				for j := 0; j < n-1; j++ {
+					lm := min(kl, n-j-1)
+					jp := ipiv[j]
+					t := x[jp]
+					if jp != j {
+						x[jp] = x[j]
+						x[j] = t
+					}
+					bi.Daxpy(lm, -t, ab[(j+1)*ldab+kl-1:], inc, x[j+1:], 1)
+				}
+			}
+			// Multiply by U^-1.
+			scale = impl.Dlatbs(blas.Upper, blas.NoTrans, blas.NonUnit, normin, n, kv, ab[kl:], ldab, x, work[2*n:])
+		} else {
+			// Multiply by U^-T.
+			scale = impl.Dlatbs(blas.Upper, blas.Trans, blas.NonUnit, normin, n, kv, ab[kl:], ldab, x, work[2*n:])
+			// Multiply by L^-T.
+			if kl > 0 {
+				for j := n - 2; j >= 0; j-- {
+					lm := min(kl, n-j-1)
+					x[j] -= bi.Ddot(lm, ab[(j+1)*ldab+kl-1:], inc, x[j+1:], 1)
+					if jp := ipiv[j]; jp != j {
+						x[jp], x[j] = x[j], x[jp]
+					}
+				}
+			}
+		}
+		// Divide x by 1/scale if doing so will not cause overflow.
+		normin = true
+		if scale != 1 {
+			ix := bi.Idamax(n, x, 1)
+			if scale == 0 || scale < math.Abs(x[ix])*smlnum {
+				return rcond
+			}
+			impl.Drscl(n, scale, x, 1)
+		}
+	}
+}
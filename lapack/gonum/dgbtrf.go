@@ -0,0 +1,124 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+// Dgbtrf computes an LU factorization of an m×n band matrix A with kl
+// sub-diagonals and ku super-diagonals using partial pivoting with row
+// interchanges. The factorization has the form
+//  A = P * L * U,
+// where P is a permutation matrix, L is a unit lower triangular band matrix
+// with kl sub-diagonals and U is an upper triangular band matrix with kl+ku
+// super-diagonals.
+//
+// On entry, ab contains A in band storage with row i of A stored in row i of
+// ab, where ldab must be at least 2*kl+ku+1. The element a_ij is stored at
+// ab[i*ldab+kl+j-i] for max(0,i-kl) <= j <= min(n-1,i+ku). The remaining kl
+// elements at the end of each row of ab are used for fill-in created by the
+// row interchanges and are set to zero by Dgbtrf. The storage scheme is
+// illustrated below when m = n = 6, kl = 2 and ku = 1, where f marks the
+// fill-in elements.
+//
+//   *   *  a11 a12  f   f
+//   *  a21 a22 a23  f   f
+//  a31 a32 a33 a34  f   f
+//  a42 a43 a44 a45  f   *
+//  a53 a54 a55 a56  *   *
+//  a64 a65 a66  *   *   *
+//
+// On return, U is stored in ab as an upper triangular band matrix in columns
+// kl to 2*kl+ku of ab, that is, u_ij is stored at ab[i*ldab+kl+j-i] for
+// i <= j <= min(n-1,i+kl+ku), and the multipliers used during the
+// factorization are stored in the first kl columns of ab in the positions of
+// the sub-diagonal elements of A.
+//
+// ipiv contains the pivot indices and must have length min(m,n), otherwise
+// Dgbtrf will panic. For 0 <= i < min(m,n), row i of the matrix was
+// interchanged with row ipiv[i]. ipiv is zero-indexed.
+//
+// Dgbtrf returns whether the matrix A is nonsingular. The factorization is
+// always completed even if ok is false, but U is exactly singular in that case
+// and it must not be used to solve a system of equations.
+func (Implementation) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	mn := min(m, n)
+	if mn == 0 {
+		return true
+	}
+
+	// Rows of A below row n+kl-1 have no elements in the band.
+	rows := min(m, n+kl)
+	switch {
+	case len(ab) < (rows-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	// kv is the number of super-diagonals of U.
+	kv := ku + kl
+
+	// Set the fill-in elements within the matrix to zero.
+	for i := 0; i < rows; i++ {
+		for j := i + ku + 1; j <= min(i+kv, n-1); j++ {
+			ab[i*ldab+kl+j-i] = 0
+		}
+	}
+
+	bi := blas64.Implementation()
+
+	// The elements of a column of A are stored with stride ldab-1 in ab.
+	inc := max(1, ldab-1)
+	ok = true
+	// ju is the index of the last column affected by the current stage of
+	// the factorization.
+	var ju int
+	for j := 0; j < mn; j++ {
+		// Find the pivot among the km+1 elements in column j on and below
+		// the diagonal.
+		km := min(kl, m-j-1)
+		jp := bi.Idamax(km+1, ab[j*ldab+kl:], inc)
+		ipiv[j] = j + jp
+		if ab[(j+jp)*ldab+kl-jp] == 0 {
+			// The pivot is zero so the column is already eliminated.
+			ok = false
+			continue
+		}
+		ju = max(ju, min(j+ku+jp, n-1))
+
+		// Apply the interchange to columns j through ju.
+		if jp != 0 {
+			bi.Dswap(ju-j+1, ab[(j+jp)*ldab+kl-jp:], 1, ab[j*ldab+kl:], 1)
+		}
+		if km == 0 {
+			continue
+		}
+
+		// Compute the multipliers.
+		bi.Dscal(km, 1/ab[j*ldab+kl], ab[(j+1)*ldab+kl-1:], inc)
+
+		// Update the trailing submatrix within the band. The elements
+		// a[j+1:j+km+1, j+1:ju+1] form a general km×(ju-j) matrix stored
+		// at ab[(j+1)*ldab+kl:] with leading dimension ldab-1.
+		if ju > j {
+			bi.Dger(km, ju-j, -1, ab[(j+1)*ldab+kl-1:], inc, ab[j*ldab+kl+1:], 1, ab[(j+1)*ldab+kl:], inc)
+		}
+	}
+	return ok
+}
@@ -0,0 +1,96 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgbtrs solves a system of linear equations
+//  A * X = B   if trans == blas.NoTrans
+//  A^T * X = B if trans == blas.Trans or blas.ConjTrans
+// with an n×n band matrix A with kl sub-diagonals and ku super-diagonals using
+// the LU factorization computed by Dgbtrf.
+//
+// ab and ipiv contain the LU factorization of A and the pivot indices as
+// computed by Dgbtrf. ldab must be at least 2*kl+ku+1 and ipiv must have
+// length n, otherwise Dgbtrs will panic.
+//
+// On entry b contains the n×nrhs right-hand side matrix B. On return, b
+// contains the solution matrix X.
+func (Implementation) Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	bi := blas64.Implementation()
+
+	// kv is the number of super-diagonals of U which is stored in ab
+	// starting at column kl.
+	kv := ku + kl
+	// The elements of a column of L are stored with stride ldab-1 in ab.
+	inc := max(1, ldab-1)
+
+	if trans == blas.NoTrans {
+		// Solve L * X = B, applying the row interchanges to B.
+		if kl > 0 {
+			for j := 0; j < n-1; j++ {
+				lm := min(kl, n-j-1)
+				if l := ipiv[j]; l != j {
+					bi.Dswap(nrhs, b[l*ldb:], 1, b[j*ldb:], 1)
+				}
+				bi.Dger(lm, nrhs, -1, ab[(j+1)*ldab+kl-1:], inc, b[j*ldb:], 1, b[(j+1)*ldb:], ldb)
+			}
+		}
+		// Solve U * X = B, one column at a time.
+		for i := 0; i < nrhs; i++ {
+			bi.Dtbsv(blas.Upper, blas.NoTrans, blas.NonUnit, n, kv, ab[kl:], ldab, b[i:], ldb)
+		}
+		return
+	}
+
+	// Solve U^T * X = B, one column at a time.
+	for i := 0; i < nrhs; i++ {
+		bi.Dtbsv(blas.Upper, blas.Trans, blas.NonUnit, n, kv, ab[kl:], ldab, b[i:], ldb)
+	}
+	// Solve L^T * X = B, applying the inverse row interchanges to B.
+	if kl > 0 {
+		for j := n - 2; j >= 0; j-- {
+			lm := min(kl, n-j-1)
+			bi.Dgemv(blas.Trans, lm, nrhs, -1, b[(j+1)*ldb:], ldb, ab[(j+1)*ldab+kl-1:], inc, 1, b[j*ldb:], 1)
+			if l := ipiv[j]; l != j {
+				bi.Dswap(nrhs, b[l*ldb:], 1, b[j*ldb:], 1)
+			}
+		}
+	}
+}
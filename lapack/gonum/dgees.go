@@ -0,0 +1,191 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgees computes for an n×n real nonsymmetric matrix A the eigenvalues, the
+// real Schur form T, and, optionally, the matrix of Schur vectors Z. This
+// gives the Schur factorization
+//  A = Z*T*Z^T.
+//
+// T is upper quasi-triangular in Schur canonical form, that is, block upper
+// triangular with 1×1 and 2×2 diagonal blocks; each 2×2 diagonal block has its
+// diagonal elements equal and its off-diagonal elements of opposite sign. The
+// 1×1 blocks correspond to real eigenvalues and the 2×2 blocks to complex
+// conjugate pairs of eigenvalues. Z is orthogonal and its columns are the Schur
+// vectors. The eigenvalues are not ordered; use Dtrsen to reorder them.
+//
+// On return, A will be overwritten by its real Schur form T.
+//
+// jobvs specifies whether the Schur vectors are computed. It must be either
+// lapack.SchurOrig, in which case the vectors are computed and stored in VS,
+// or lapack.SchurNone, in which case VS is not referenced. For other values of
+// jobvs Dgees will panic.
+//
+// wr and wi must have length n and on return they will contain the real and
+// imaginary parts, respectively, of the computed eigenvalues in the same order
+// that they appear on the diagonal of T. Complex conjugate pairs of
+// eigenvalues appear consecutively with the eigenvalue having the positive
+// imaginary part first.
+//
+// work must have length at least lwork and lwork must be at least max(1,3*n),
+// otherwise Dgees will panic. For good performance, lwork must generally be
+// larger. On return, the optimal value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Dgees, the function only calculates
+// the optimal value of lwork and stores it into work[0].
+//
+// first is the index of the first valid eigenvalue. If first is positive, the
+// QR algorithm failed to compute all the eigenvalues, and wr[first:] and
+// wi[first:] contain those eigenvalues which have converged. In this case A
+// and VS contain the matrices which were being iterated upon when the QR
+// algorithm failed.
+func (impl Implementation) Dgees(jobvs lapack.SchurComp, n int, a []float64, lda int, wr, wi, vs []float64, ldvs int, work []float64, lwork int) (first int) {
+	wantvs := jobvs == lapack.SchurOrig
+	minwrk := max(1, 3*n)
+	switch {
+	case jobvs != lapack.SchurOrig && jobvs != lapack.SchurNone:
+		panic(badSchurComp)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldvs < 1 || (ldvs < n && wantvs):
+		panic(badLdVS)
+	case lwork < minwrk && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0
+	}
+
+	maxwrk := 2*n + n*impl.Ilaenv(1, "DGEHRD", " ", n, 1, n, 0)
+	impl.Dhseqr(lapack.EigenvaluesAndSchur, jobvs, n, 0, n-1, a, lda, wr, wi, nil, max(1, ldvs), work, -1)
+	hswork := int(work[0])
+	if wantvs {
+		maxwrk = max(maxwrk, 2*n+(n-1)*impl.Ilaenv(1, "DORGHR", " ", n, 1, n, -1))
+	}
+	maxwrk = max(maxwrk, n+hswork)
+	maxwrk = max(maxwrk, minwrk)
+
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(wr) != n:
+		panic(badLenWr)
+	case len(wi) != n:
+		panic(badLenWi)
+	case len(vs) < (n-1)*ldvs+n && wantvs:
+		panic(shortVS)
+	}
+
+	// Get machine constants.
+	smlnum := math.Sqrt(dlamchS) / dlamchP
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum,bignum].
+	anrm := impl.Dlange(lapack.MaxAbs, n, n, a, lda, nil)
+	var scalea bool
+	var cscale float64
+	if 0 < anrm && anrm < smlnum {
+		scalea = true
+		cscale = smlnum
+	} else if anrm > bignum {
+		scalea = true
+		cscale = bignum
+	}
+	if scalea {
+		impl.Dlascl(lapack.General, 0, 0, anrm, cscale, n, n, a, lda)
+	}
+
+	// Permute the matrix to make it more nearly triangular.
+	workbal := work[:n]
+	ilo, ihi := impl.Dgebal(lapack.Permute, n, a, lda, workbal)
+
+	// Reduce to upper Hessenberg form.
+	iwrk := 2 * n
+	tau := work[n : iwrk-1]
+	impl.Dgehrd(n, ilo, ihi, a, lda, tau, work[iwrk:], lwork-iwrk)
+
+	if wantvs {
+		// Copy Householder vectors to VS.
+		impl.Dlacpy(blas.Lower, n, n, a, lda, vs, ldvs)
+		// Generate orthogonal matrix in VS.
+		impl.Dorghr(n, ilo, ihi, vs, ldvs, tau, work[iwrk:], lwork-iwrk)
+	}
+
+	// Perform QR iteration, accumulating Schur vectors in VS if desired.
+	iwrk = n
+	first = impl.Dhseqr(lapack.EigenvaluesAndSchur, jobvs, n, ilo, ihi,
+		a, lda, wr, wi, vs, max(1, ldvs), work[iwrk:], lwork-iwrk)
+
+	if wantvs {
+		// Undo balancing.
+		impl.Dgebak(lapack.Permute, lapack.EVRight, n, ilo, ihi, workbal, n, vs, ldvs)
+	}
+
+	if scalea {
+		// Undo scaling for the Schur form of A.
+		impl.Dlascl(lapack.General, 0, 0, cscale, anrm, n, n, a, lda)
+		bi := blas64.Implementation()
+		bi.Dcopy(n, a, lda+1, wr, 1)
+		if cscale == smlnum {
+			// If scaling back towards underflow, adjust wi if an
+			// offdiagonal element of a 2×2 block in the Schur form
+			// underflows.
+			i1 := ilo
+			if first > 0 {
+				i1 = first
+				impl.Dlascl(lapack.General, 0, 0, cscale, anrm, ilo, 1, wi, 1)
+			}
+			for i := i1; i < ihi; i++ {
+				if wi[i] == 0 {
+					continue
+				}
+				if a[(i+1)*lda+i] == 0 {
+					wi[i] = 0
+					wi[i+1] = 0
+				} else if a[i*lda+i+1] == 0 {
+					wi[i] = 0
+					wi[i+1] = 0
+					// Swap rows and columns of the 2×2 block to
+					// make it upper triangular.
+					bi.Dswap(i, a[i:], lda, a[i+1:], lda)
+					if i < n-2 {
+						bi.Dswap(n-i-2, a[i*lda+i+2:], 1, a[(i+1)*lda+i+2:], 1)
+					}
+					if wantvs {
+						bi.Dswap(n, vs[i:], ldvs, vs[i+1:], ldvs)
+					}
+					a[i*lda+i+1] = a[(i+1)*lda+i]
+					a[(i+1)*lda+i] = 0
+				}
+				i++
+			}
+		}
+		// Undo scaling for the imaginary part of the eigenvalues.
+		impl.Dlascl(lapack.General, 0, 0, cscale, anrm, n-first, 1, wi[first:], 1)
+	}
+
+	work[0] = float64(maxwrk)
+	return first
+}
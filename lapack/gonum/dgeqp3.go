@@ -37,8 +37,6 @@ import (
 //
 // If lwork == -1, instead of performing Dgeqp3, only the optimal value of lwork
 // will be stored in work[0].
-//
-// Dgeqp3 is an internal routine. It is exported for testing purposes.
 func (impl Implementation) Dgeqp3(m, n int, a []float64, lda int, jpvt []int, tau, work []float64, lwork int) {
 	const (
 		inb    = 1
@@ -21,6 +21,14 @@ import (
 // the optimal work length will be stored into work[0].
 //
 // tau must have length at least min(m,n), and this function will panic otherwise.
+//
+// If more than one worker is available and lwork is at least the optimal
+// value returned by a workspace query, the factorization of a block column
+// and the application of its block reflector to the block columns on its
+// right are computed concurrently, so that the factorization of the next
+// block column can proceed before the update of the trailing matrix is
+// complete. See Implementation for how the number of workers and the block
+// size are chosen.
 func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
 	case m < 0:
@@ -44,7 +52,24 @@ func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []fl
 
 	// nb is the optimal blocksize, i.e. the number of columns transformed at a time.
 	nb := impl.Ilaenv(1, "DGEQRF", " ", m, n, -1, -1)
+	// The tiled algorithm stores the triangular factors of the block
+	// reflectors and a workspace for each block column.
+	nw := impl.Ilaenv(7, "DGEQRF", " ", m, n, -1, -1)
+	var cols []int
+	var lworkTiled int
+	if nw > 1 && 1 < nb && nb < k {
+		cols = blockColumns(k, n, nb)
+		np := (k + nb - 1) / nb
+		lworkTiled = (np + len(cols) - 1) * nb * nb
+		if len(cols)-1 < minParTiles {
+			cols = nil
+		}
+	}
 	if lwork == -1 {
+		if cols != nil {
+			work[0] = float64(lworkTiled)
+			return
+		}
 		work[0] = float64(n * nb)
 		return
 	}
@@ -56,6 +81,12 @@ func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []fl
 		panic(shortTau)
 	}
 
+	if cols != nil && lwork >= lworkTiled {
+		impl.dgeqrfTiled(m, n, a, lda, tau, work, cols, nb, nw)
+		work[0] = float64(lworkTiled)
+		return
+	}
+
 	nbmin := 2 // Minimal block size.
 	var nx int // Use unblocked (unless changed in the next for loop)
 	iws := n
@@ -106,3 +137,60 @@ func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []fl
 	}
 	work[0] = float64(iws)
 }
+
+// dgeqrfTiled computes the QR factorization of a by partitioning it into the
+// block columns of width at most nb with boundaries cols and executing the
+// factorizations and updates of the block columns concurrently using at most
+// workers goroutines. work must have length at least (np+nc)*nb*nb, where np
+// is the number of block columns that are factorized and nc is the total
+// number of block columns.
+func (impl Implementation) dgeqrfTiled(m, n int, a []float64, lda int, tau, work []float64, cols []int, nb, workers int) {
+	k := min(m, n)
+	nc := len(cols) - 1
+	np := (k + nb - 1) / nb
+
+	// The triangular factor of the block reflector of block column p is
+	// stored in t at p*nb*nb, and the workspace of block column j is stored
+	// in w at j*nb*nb.
+	ldt := nb
+	t := work[:np*nb*nb]
+	w := work[np*nb*nb:]
+
+	// Each task writes a single block column. The tasks that update a block
+	// column have the priority of the step at which it is factorized.
+	g := newTaskGraph()
+	for p := 0; p < np; p++ {
+		p0 := cols[p]
+		pb := cols[p+1] - p0
+		app := a[p0*lda+p0:]
+		tp := t[p*nb*nb:]
+		wp := w[p*nb*nb:]
+		update := p+1 < nc
+		g.add(p, func() bool {
+			impl.Dgeqr2(m-p0, pb, app, lda, tau[p0:], wp)
+			if update {
+				impl.Dlarft(lapack.Forward, lapack.ColumnWise, m-p0, pb,
+					app, lda,
+					tau[p0:],
+					tp, ldt)
+			}
+			return true
+		}, nil, []int{p})
+
+		for j := p + 1; j < nc; j++ {
+			j0 := cols[j]
+			jb := cols[j+1] - j0
+			wj := w[j*nb*nb:]
+			g.add(j, func() bool {
+				dlarfb(serialBLAS, blas.Left, blas.Trans, lapack.Forward, lapack.ColumnWise,
+					m-p0, jb, pb,
+					app, lda,
+					tp, ldt,
+					a[p0*lda+j0:], lda,
+					wj, nb)
+				return true
+			}, []int{p}, []int{j})
+		}
+	}
+	g.run(workers)
+}
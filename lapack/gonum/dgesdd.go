@@ -0,0 +1,547 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgesdd computes the singular value decomposition of the input matrix A
+// using a divide and conquer method. Dgesdd is usually substantially faster
+// than Dgesvd for large matrices when the singular vectors are required.
+//
+// The singular value decomposition is
+//  A = U * Sigma * V^T
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobz specifies which singular vectors are computed. The behavior is as
+// follows
+//  jobz == lapack.SVDAll   All m columns of U and all n rows of V^T are
+//                          returned in u and vt.
+//  jobz == lapack.SVDStore The first min(m,n) columns of U and the first
+//                          min(m,n) rows of V^T are returned in u and vt.
+//  jobz == lapack.SVDNone  Neither U nor V^T are computed.
+// jobz == lapack.SVDOverwrite is not supported and Dgesdd will panic.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Dgesdd
+// the data is overwritten.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored column-wise. If
+// jobz == lapack.SVDAll, u is of size m×m. If jobz == lapack.SVDStore u is
+// of size m×min(m,n). If jobz == lapack.SVDNone, u is not used.
+//
+// vt contains the right singular vectors on exit, stored row-wise. If
+// jobz == lapack.SVDAll, vt is of size n×n. If jobz == lapack.SVDStore vt is
+// of size min(m,n)×n. If jobz == lapack.SVDNone, vt is not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. If jobz == lapack.SVDNone, lwork must be at least
+// 3*min(m,n)+max(max(m,n), 4*min(m,n)). Otherwise, lwork must be at least
+// 3*min(m,n)*min(m,n)+max(max(m,n), 4*min(m,n)*min(m,n)+4*min(m,n)).
+// If lwork == -1, instead of performing Dgesdd, the optimal work length will be
+// stored into work[0]. Dgesdd will panic if the working memory has insufficient
+// storage.
+//
+// iwork must have length at least 8*min(m,n).
+//
+// Dgesdd returns whether the decomposition successfully completed.
+func (impl Implementation) Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool) {
+	wntqa := jobz == lapack.SVDAll
+	wntqs := jobz == lapack.SVDStore
+	wntqn := jobz == lapack.SVDNone
+	wntqas := wntqa || wntqs
+
+	minmn := min(m, n)
+	maxmn := max(m, n)
+	minwork := 1
+	if minmn > 0 {
+		if wntqn {
+			minwork = 3*minmn + max(maxmn, 4*minmn)
+		} else {
+			minwork = 3*minmn*minmn + max(maxmn, 4*minmn*minmn+4*minmn)
+		}
+	}
+	switch {
+	case jobz == lapack.SVDOverwrite:
+		panic(noSVDO)
+	case !wntqa && !wntqs && !wntqn:
+		panic(badSVDJob)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldu < 1, wntqa && ldu < m, wntqs && ldu < minmn:
+		panic(badLdU)
+	case ldvt < 1, wntqas && ldvt < n:
+		panic(badLdVT)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// Compute the optimal workspace size. bdspac is the workspace required
+	// by Dbdsdc.
+	mnthr := int(float64(minmn) * 11 / 6)
+	bdspac := 4 * minmn
+	if wntqas {
+		bdspac = 3*minmn*minmn + 4*minmn
+	}
+	var maxwrk int
+	if m >= n {
+		if m >= mnthr {
+			impl.Dgeqrf(m, n, a, lda, nil, work, -1)
+			lwork_dgeqrf := int(work[0])
+			impl.Dgebrd(n, n, a, lda, s, nil, nil, nil, work, -1)
+			lwork_dgebrd := int(work[0])
+			switch {
+			case wntqn:
+				// Path 1 (m much larger than n, jobz == None)
+				maxwrk = max(n+lwork_dgeqrf, 3*n+max(lwork_dgebrd, bdspac))
+			case wntqs:
+				// Path 2 (m much larger than n, jobz == Store)
+				impl.Dorgqr(m, n, n, a, lda, nil, work, -1)
+				lwork_dorgqr := int(work[0])
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, a, lda, nil, u, ldu, work, -1)
+				lwork_dormbr_q := int(work[0])
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, nil, vt, ldvt, work, -1)
+				lwork_dormbr_p := int(work[0])
+				wrkbl := max(n+lwork_dgeqrf, n+lwork_dorgqr)
+				wrkbl = max(wrkbl, 3*n+max(lwork_dgebrd, bdspac))
+				wrkbl = max(wrkbl, 3*n+max(lwork_dormbr_q, lwork_dormbr_p))
+				maxwrk = n*n + wrkbl
+			case wntqa:
+				// Path 3 (m much larger than n, jobz == All)
+				impl.Dorgqr(m, m, n, a, lda, nil, work, -1)
+				lwork_dorgqr := int(work[0])
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, a, lda, nil, u, ldu, work, -1)
+				lwork_dormbr_q := int(work[0])
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, nil, vt, ldvt, work, -1)
+				lwork_dormbr_p := int(work[0])
+				wrkbl := max(n+lwork_dgeqrf, n+lwork_dorgqr)
+				wrkbl = max(wrkbl, 3*n+max(lwork_dgebrd, bdspac))
+				wrkbl = max(wrkbl, 3*n+max(lwork_dormbr_q, lwork_dormbr_p))
+				maxwrk = n*n + wrkbl
+			}
+		} else {
+			// Path 4 (m at least n, but not much larger)
+			impl.Dgebrd(m, n, a, lda, s, nil, nil, nil, work, -1)
+			lwork_dgebrd := int(work[0])
+			maxwrk = 3*n + max(lwork_dgebrd, bdspac)
+			if wntqas {
+				ncu := n
+				if wntqa {
+					ncu = m
+				}
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, ncu, n, a, lda, nil, u, ldu, work, -1)
+				lwork_dormbr_q := int(work[0])
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, m, a, lda, nil, vt, ldvt, work, -1)
+				lwork_dormbr_p := int(work[0])
+				maxwrk = max(maxwrk, 3*n+max(lwork_dormbr_q, lwork_dormbr_p))
+			}
+		}
+	} else {
+		if n >= mnthr {
+			impl.Dgelqf(m, n, a, lda, nil, work, -1)
+			lwork_dgelqf := int(work[0])
+			impl.Dgebrd(m, m, a, lda, s, nil, nil, nil, work, -1)
+			lwork_dgebrd := int(work[0])
+			switch {
+			case wntqn:
+				// Path 1t (n much larger than m, jobz == None)
+				maxwrk = max(m+lwork_dgelqf, 3*m+max(lwork_dgebrd, bdspac))
+			case wntqs:
+				// Path 2t (n much larger than m, jobz == Store)
+				impl.Dorglq(m, n, m, a, lda, nil, work, -1)
+				lwork_dorglq := int(work[0])
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, a, lda, nil, u, ldu, work, -1)
+				lwork_dormbr_q := int(work[0])
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, a, lda, nil, vt, ldvt, work, -1)
+				lwork_dormbr_p := int(work[0])
+				wrkbl := max(m+lwork_dgelqf, m+lwork_dorglq)
+				wrkbl = max(wrkbl, 3*m+max(lwork_dgebrd, bdspac))
+				wrkbl = max(wrkbl, 3*m+max(lwork_dormbr_q, lwork_dormbr_p))
+				maxwrk = m*m + wrkbl
+			case wntqa:
+				// Path 3t (n much larger than m, jobz == All)
+				impl.Dorglq(n, n, m, a, lda, nil, work, -1)
+				lwork_dorglq := int(work[0])
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, a, lda, nil, u, ldu, work, -1)
+				lwork_dormbr_q := int(work[0])
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, a, lda, nil, vt, ldvt, work, -1)
+				lwork_dormbr_p := int(work[0])
+				wrkbl := max(m+lwork_dgelqf, m+lwork_dorglq)
+				wrkbl = max(wrkbl, 3*m+max(lwork_dgebrd, bdspac))
+				wrkbl = max(wrkbl, 3*m+max(lwork_dormbr_q, lwork_dormbr_p))
+				maxwrk = m*m + wrkbl
+			}
+		} else {
+			// Path 4t (n greater than m, but not much larger)
+			impl.Dgebrd(m, n, a, lda, s, nil, nil, nil, work, -1)
+			lwork_dgebrd := int(work[0])
+			maxwrk = 3*m + max(lwork_dgebrd, bdspac)
+			if wntqas {
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, nil, u, ldu, work, -1)
+				lwork_dormbr_q := int(work[0])
+				nrvt := m
+				if wntqa {
+					nrvt = n
+				}
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, nrvt, n, m, a, lda, nil, vt, ldvt, work, -1)
+				lwork_dormbr_p := int(work[0])
+				maxwrk = max(maxwrk, 3*m+max(lwork_dormbr_q, lwork_dormbr_p))
+			}
+		}
+	}
+	maxwrk = max(maxwrk, minwork)
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) < minmn:
+		panic(shortS)
+	case wntqa && len(u) < (m-1)*ldu+m, wntqs && len(u) < (m-1)*ldu+minmn:
+		panic(shortU)
+	case wntqa && len(vt) < (n-1)*ldvt+n, wntqs && len(vt) < (minmn-1)*ldvt+n:
+		panic(shortVT)
+	case len(iwork) < 8*minmn:
+		panic(shortIWork)
+	}
+
+	// Perform decomposition.
+	eps := dlamchP
+	smlnum := math.Sqrt(dlamchS) / eps
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum, bignum].
+	anrm := impl.Dlange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iscl bool
+	if anrm > 0 && anrm < smlnum {
+		iscl = true
+		impl.Dlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+	} else if anrm > bignum {
+		iscl = true
+		impl.Dlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+	}
+
+	bi := blas64.Implementation()
+	if m >= n {
+		if m >= mnthr {
+			// A has sufficiently more rows than columns, so first compute
+			// the QR decomposition of A.
+			switch {
+			case wntqn:
+				// Path 1.
+				itau := 0
+				nwork := itau + n
+				impl.Dgeqrf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+
+				// Zero out below R.
+				impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+
+				// Bidiagonalize R in A.
+				ie := 0
+				itauq := ie + n
+				itaup := itauq + n
+				nwork = itaup + n
+				impl.Dgebrd(n, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Compute the singular values of the bidiagonal matrix.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDNone, n, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+			case wntqs:
+				// Path 2.
+				ir := 0
+				ldwrkr := n
+				itau := ir + ldwrkr*n
+				nwork := itau + n
+
+				// Compute A = Q * R, copying R to work[ir:] and zeroing out
+				// below it.
+				impl.Dgeqrf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+				impl.Dlacpy(blas.Upper, n, n, a, lda, work[ir:], ldwrkr)
+				impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, work[ir+ldwrkr:], ldwrkr)
+
+				// Generate Q in A.
+				impl.Dorgqr(m, n, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+
+				// Bidiagonalize R in work[ir:].
+				ie := itau
+				itauq := ie + n
+				itaup := itauq + n
+				nwork = itaup + n
+				impl.Dgebrd(n, n, work[ir:], ldwrkr, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Compute the SVD of the bidiagonal matrix, with the left
+				// singular vectors in u and the right singular vectors in
+				// vt.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, n, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+				if !ok {
+					break
+				}
+
+				// Overwrite u by the left singular vectors of R and vt by
+				// the right singular vectors of R.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, work[ir:], ldwrkr, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, work[ir:], ldwrkr, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+
+				// Multiply Q in A by the left singular vectors of R,
+				// storing the result in u.
+				impl.Dlacpy(blas.All, n, n, u, ldu, work[ir:], ldwrkr)
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, a, lda, work[ir:], ldwrkr, 0, u, ldu)
+			case wntqa:
+				// Path 3.
+				iu := 0
+				ldwrku := n
+				itau := iu + ldwrku*n
+				nwork := itau + n
+
+				// Compute A = Q * R, copying the result to u.
+				impl.Dgeqrf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+				impl.Dlacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+				// Generate Q in u.
+				impl.Dorgqr(m, m, n, u, ldu, work[itau:], work[nwork:], lwork-nwork)
+
+				// Produce R in A, zeroing out other entries.
+				impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+
+				// Bidiagonalize R in A.
+				ie := itau
+				itauq := ie + n
+				itaup := itauq + n
+				nwork = itaup + n
+				impl.Dgebrd(n, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Compute the SVD of the bidiagonal matrix, with the left
+				// singular vectors in work[iu:] and the right singular
+				// vectors in vt.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, n, s, work[ie:], work[iu:], ldwrku, vt, ldvt, work[nwork:], iwork)
+				if !ok {
+					break
+				}
+
+				// Overwrite work[iu:] by the left singular vectors of R and
+				// vt by the right singular vectors of R.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, n, n, n, a, lda, work[itauq:], work[iu:], ldwrku, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, n, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+
+				// Multiply Q in u by the left singular vectors of R in
+				// work[iu:], storing the result in A and copying to u.
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, u, ldu, work[iu:], ldwrku, 0, a, lda)
+				impl.Dlacpy(blas.All, m, n, a, lda, u, ldu)
+			}
+		} else {
+			// Path 4.
+			// Reduce A to upper bidiagonal form without the QR
+			// decomposition.
+			ie := 0
+			itauq := ie + n
+			itaup := itauq + n
+			nwork := itaup + n
+			impl.Dgebrd(m, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+			switch {
+			case wntqn:
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDNone, n, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+			case wntqs:
+				impl.Dlaset(blas.All, m, n, 0, 0, u, ldu)
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, n, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+				if !ok {
+					break
+				}
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, n, n, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, m, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+			case wntqa:
+				impl.Dlaset(blas.All, m, m, 0, 0, u, ldu)
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, n, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+				if !ok {
+					break
+				}
+
+				// Set the trailing (m-n)×(m-n) block of u to the identity.
+				if m > n {
+					impl.Dlaset(blas.All, m-n, m-n, 0, 1, u[n*ldu+n:], ldu)
+				}
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, m, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+			}
+		}
+	} else {
+		if n >= mnthr {
+			// A has sufficiently more columns than rows, so first compute
+			// the LQ decomposition of A.
+			switch {
+			case wntqn:
+				// Path 1t.
+				itau := 0
+				nwork := itau + m
+				impl.Dgelqf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+
+				// Zero out above L.
+				impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+
+				// Bidiagonalize L in A.
+				ie := 0
+				itauq := ie + m
+				itaup := itauq + m
+				nwork = itaup + m
+				impl.Dgebrd(m, m, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Compute the singular values of the bidiagonal matrix.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDNone, m, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+			case wntqs:
+				// Path 2t.
+				il := 0
+				ldwrkl := m
+				itau := il + ldwrkl*m
+				nwork := itau + m
+
+				// Compute A = L * Q, copying L to work[il:] and zeroing out
+				// above it.
+				impl.Dgelqf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+				impl.Dlacpy(blas.Lower, m, m, a, lda, work[il:], ldwrkl)
+				impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, work[il+1:], ldwrkl)
+
+				// Generate Q in A.
+				impl.Dorglq(m, n, m, a, lda, work[itau:], work[nwork:], lwork-nwork)
+
+				// Bidiagonalize L in work[il:].
+				ie := itau
+				itauq := ie + m
+				itaup := itauq + m
+				nwork = itaup + m
+				impl.Dgebrd(m, m, work[il:], ldwrkl, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Compute the SVD of the bidiagonal matrix, with the left
+				// singular vectors in u and the right singular vectors in
+				// vt.
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, m, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+				if !ok {
+					break
+				}
+
+				// Overwrite u by the left singular vectors of L and vt by
+				// the right singular vectors of L.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, work[il:], ldwrkl, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, work[il:], ldwrkl, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+
+				// Multiply the right singular vectors of L by Q in A,
+				// storing the result in vt.
+				impl.Dlacpy(blas.All, m, m, vt, ldvt, work[il:], ldwrkl)
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, m, 1, work[il:], ldwrkl, a, lda, 0, vt, ldvt)
+			case wntqa:
+				// Path 3t.
+				ivt := 0
+				ldwkvt := m
+				itau := ivt + ldwkvt*m
+				nwork := itau + m
+
+				// Compute A = L * Q, copying the result to vt.
+				impl.Dgelqf(m, n, a, lda, work[itau:], work[nwork:], lwork-nwork)
+				impl.Dlacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+				// Generate Q in vt.
+				impl.Dorglq(n, n, m, vt, ldvt, work[itau:], work[nwork:], lwork-nwork)
+
+				// Produce L in A, zeroing out other entries.
+				impl.Dlaset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+
+				// Bidiagonalize L in A.
+				ie := itau
+				itauq := ie + m
+				itaup := itauq + m
+				nwork = itaup + m
+				impl.Dgebrd(m, m, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+				// Compute the SVD of the bidiagonal matrix, with the left
+				// singular vectors in u and the right singular vectors in
+				// work[ivt:].
+				ok = impl.Dbdsdc(blas.Upper, lapack.BDCompute, m, s, work[ie:], u, ldu, work[ivt:], ldwkvt, work[nwork:], iwork)
+				if !ok {
+					break
+				}
+
+				// Overwrite u by the left singular vectors of L and
+				// work[ivt:] by the right singular vectors of L.
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, m, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, m, m, a, lda, work[itaup:], work[ivt:], ldwkvt, work[nwork:], lwork-nwork)
+
+				// Multiply the right singular vectors of L in work[ivt:] by
+				// Q in vt, storing the result in A and copying to vt.
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, m, 1, work[ivt:], ldwkvt, vt, ldvt, 0, a, lda)
+				impl.Dlacpy(blas.All, m, n, a, lda, vt, ldvt)
+			}
+		} else {
+			// Path 4t.
+			// Reduce A to lower bidiagonal form without the LQ
+			// decomposition.
+			ie := 0
+			itauq := ie + m
+			itaup := itauq + m
+			nwork := itaup + m
+			impl.Dgebrd(m, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[nwork:], lwork-nwork)
+
+			switch {
+			case wntqn:
+				ok = impl.Dbdsdc(blas.Lower, lapack.BDNone, m, s, work[ie:], nil, 1, nil, 1, work[nwork:], iwork)
+			case wntqs:
+				impl.Dlaset(blas.All, m, n, 0, 0, vt, ldvt)
+				ok = impl.Dbdsdc(blas.Lower, lapack.BDCompute, m, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+				if !ok {
+					break
+				}
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, m, n, m, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+			case wntqa:
+				impl.Dlaset(blas.All, n, n, 0, 0, vt, ldvt)
+				ok = impl.Dbdsdc(blas.Lower, lapack.BDCompute, m, s, work[ie:], u, ldu, vt, ldvt, work[nwork:], iwork)
+				if !ok {
+					break
+				}
+
+				// Set the trailing (n-m)×(n-m) block of vt to the identity.
+				if n > m {
+					impl.Dlaset(blas.All, n-m, n-m, 0, 1, vt[m*ldvt+m:], ldvt)
+				}
+				impl.Dormbr(lapack.ApplyQ, blas.Left, blas.NoTrans, m, m, n, a, lda, work[itauq:], u, ldu, work[nwork:], lwork-nwork)
+				impl.Dormbr(lapack.ApplyP, blas.Right, blas.Trans, n, n, m, a, lda, work[itaup:], vt, ldvt, work[nwork:], lwork-nwork)
+			}
+		}
+	}
+
+	// Undo scaling if necessary.
+	if iscl {
+		if anrm > bignum {
+			impl.Dlascl(lapack.General, 0, 0, bignum, anrm, minmn, 1, s, 1)
+		}
+		if anrm < smlnum {
+			impl.Dlascl(lapack.General, 0, 0, smlnum, anrm, minmn, 1, s, 1)
+		}
+	}
+	work[0] = float64(maxwrk)
+	return ok
+}
@@ -20,7 +20,12 @@ import (
 // changed with ipiv[i]. ipiv must have length at least min(m,n), and will panic
 // otherwise. ipiv is zero-indexed.
 //
-// Dgetrf is the blocked version of the algorithm.
+// Dgetrf is the blocked version of the algorithm. If more than one worker is
+// available, the factorization of a block column and the updates of the
+// block columns to its right are computed concurrently, so that the
+// factorization of the next block column can proceed before the update of
+// the trailing matrix is complete. See Implementation for how the number of
+// workers and the block size are chosen.
 //
 // Dgetrf returns whether the matrix A is singular. The LU decomposition will
 // be computed regardless of the singularity of A, but division by zero
@@ -56,6 +61,10 @@ func (impl Implementation) Dgetrf(m, n int, a []float64, lda int, ipiv []int) (o
 		// Use the unblocked algorithm.
 		return impl.Dgetf2(m, n, a, lda, ipiv)
 	}
+	nw := impl.Ilaenv(7, "DGETRF", " ", m, n, -1, -1)
+	if cols := blockColumns(mn, n, nb); nw > 1 && len(cols)-1 >= minParTiles {
+		return impl.dgetrfTiled(m, n, a, lda, ipiv, cols, nw)
+	}
 	ok = true
 	for j := 0; j < mn; j += nb {
 		jb := min(mn-j, nb)
@@ -83,3 +92,70 @@ func (impl Implementation) Dgetrf(m, n int, a []float64, lda int, ipiv []int) (o
 	}
 	return ok
 }
+
+// dgetrfTiled computes the LU factorization of a by partitioning it into the
+// block columns with boundaries cols and executing the factorizations and
+// updates of the block columns concurrently using at most workers goroutines.
+func (impl Implementation) dgetrfTiled(m, n int, a []float64, lda int, ipiv []int, cols []int, workers int) (ok bool) {
+	bi := serialBLAS
+	mn := min(m, n)
+	nc := len(cols) - 1
+	var np int
+	for np < nc && cols[np] < mn {
+		np++
+	}
+
+	// Each task writes a single block column. The tasks that update a block
+	// column have the priority of the step at which it is factorized.
+	panelOk := make([]bool, np)
+	g := newTaskGraph()
+	for k := 0; k < np; k++ {
+		k0 := cols[k]
+		kb := cols[k+1] - k0
+		akk := a[k0*lda+k0:]
+		piv := ipiv[k0 : k0+kb]
+		pok := &panelOk[k]
+		g.add(k, func() bool {
+			*pok = impl.Dgetf2(m-k0, kb, akk, lda, piv)
+			for i := range piv {
+				piv[i] += k0
+			}
+			return true
+		}, nil, []int{k})
+
+		for j := k + 1; j < nc; j++ {
+			j0 := cols[j]
+			jb := cols[j+1] - j0
+			g.add(j, func() bool {
+				impl.Dlaswp(jb, a[j0:], lda, k0, k0+kb-1, ipiv[:k0+kb], 1)
+				bi.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit,
+					kb, jb, 1,
+					akk, lda,
+					a[k0*lda+j0:], lda)
+				if k0+kb < m {
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, m-k0-kb, jb, kb, -1,
+						a[(k0+kb)*lda+k0:], lda,
+						a[k0*lda+j0:], lda,
+						1, a[(k0+kb)*lda+j0:], lda)
+				}
+				return true
+			}, []int{k}, []int{j})
+		}
+	}
+	g.run(workers)
+
+	// Apply the row interchanges of each block column to the columns on its
+	// left. These columns are only read by the updates of earlier steps, so
+	// the interchanges can be deferred until the factorization is complete.
+	ok = true
+	for k := 0; k < np; k++ {
+		if !panelOk[k] {
+			ok = false
+		}
+		if k > 0 {
+			k0 := cols[k]
+			impl.Dlaswp(k0, a, lda, k0, cols[k+1]-1, ipiv[:cols[k+1]], 1)
+		}
+	}
+	return ok
+}
@@ -0,0 +1,339 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dggbal balances a pair of n×n general matrices (A,B). Balancing consists of
+// two steps. First, the rows and columns of A and B are permuted to isolate
+// eigenvalues if possible. Second, the rows and columns of A and B are scaled
+// to make them as close in norm to 1 as possible. Both steps are optional and
+// balancing can improve the accuracy of the computed eigenvalues and
+// eigenvectors of the generalized eigenvalue problem
+//  A*x = λ*B*x.
+//
+// job specifies the operations that are performed on A and B. If job is
+// lapack.BalanceNone, A and B are not modified. If job is lapack.Permute, the
+// matrices are only permuted, if job is lapack.Scale, they are only scaled, and
+// if job is lapack.PermuteScale, they are both permuted and scaled.
+//
+// On return, A and B are overwritten by the balanced matrices, and A[i,j] and
+// B[i,j] are zero if i > j and j < ilo or i > ihi. If job is
+// lapack.BalanceNone or lapack.Scale, ilo = 0 and ihi = n-1.
+//
+// On return, lscale and rscale contain details of the permutations and scaling
+// factors applied to the left and right sides of A and B, respectively. If
+// P[j] is the index of the row interchanged with row j, and Dl[j] is the
+// scaling factor applied to row j, then
+//  lscale[j] = P[j]  for j = 0, ..., ilo-1,
+//            = Dl[j] for j = ilo, ..., ihi,
+//            = P[j]  for j = ihi+1, ..., n-1.
+// rscale contains the same information for the columns. The order in which the
+// interchanges are made is n-1 to ihi+1, then 0 to ilo-1. lscale and rscale
+// must have length at least n.
+//
+// work must have length at least 6*n if job is lapack.Scale or
+// lapack.PermuteScale, and it is not referenced otherwise.
+//
+// Dggbal is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dggbal(job lapack.BalanceJob, n int, a []float64, lda int, b []float64, ldb int, lscale, rscale, work []float64) (ilo, ihi int) {
+	switch {
+	case job != lapack.BalanceNone && job != lapack.Permute && job != lapack.Scale && job != lapack.PermuteScale:
+		panic(badBalanceJob)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	}
+
+	ilo = 0
+	ihi = n - 1
+
+	// Quick return if possible.
+	if n == 0 {
+		return ilo, ihi
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(lscale) < n:
+		panic(shortLscale)
+	case len(rscale) < n:
+		panic(shortRscale)
+	case (job == lapack.Scale || job == lapack.PermuteScale) && len(work) < 6*n:
+		panic(shortWork)
+	}
+
+	if job == lapack.BalanceNone {
+		for i := range lscale {
+			lscale[i] = 1
+			rscale[i] = 1
+		}
+		return ilo, ihi
+	}
+
+	if n == 1 {
+		lscale[0] = 1
+		rscale[0] = 1
+		return ilo, ihi
+	}
+
+	bi := blas64.Implementation()
+	k := 0
+	l := n - 1
+
+	if job != lapack.Scale {
+		// Permute the matrices A and B to isolate the eigenvalues.
+
+		// Search for rows isolating an eigenvalue and push them down.
+		swapped := true
+		for swapped && l > 0 {
+			swapped = false
+			for i := l; i >= 0; i-- {
+				// Find the columns of the nonzero elements in the
+				// i-th row of A[:l+1,:l+1] and B[:l+1,:l+1].
+				j := l
+				nnz := 0
+				for jj := 0; jj <= l; jj++ {
+					if a[i*lda+jj] != 0 || b[i*ldb+jj] != 0 {
+						nnz++
+						j = jj
+					}
+				}
+				if nnz > 1 {
+					continue
+				}
+				// Row i has at most one nonzero element in column j.
+				// Permute rows i and l, and columns j and l.
+				lscale[l] = float64(i)
+				if i != l {
+					bi.Dswap(n-k, a[i*lda+k:], 1, a[l*lda+k:], 1)
+					bi.Dswap(n-k, b[i*ldb+k:], 1, b[l*ldb+k:], 1)
+				}
+				rscale[l] = float64(j)
+				if j != l {
+					bi.Dswap(l+1, a[j:], lda, a[l:], lda)
+					bi.Dswap(l+1, b[j:], ldb, b[l:], ldb)
+				}
+				l--
+				swapped = true
+				break
+			}
+		}
+
+		// Search for columns isolating an eigenvalue and push them left.
+		swapped = true
+		for swapped && k < l {
+			swapped = false
+			for j := k; j <= l; j++ {
+				// Find the rows of the nonzero elements in the j-th
+				// column of A[k:l+1,k:l+1] and B[k:l+1,k:l+1].
+				i := l
+				nnz := 0
+				for ii := k; ii <= l; ii++ {
+					if a[ii*lda+j] != 0 || b[ii*ldb+j] != 0 {
+						nnz++
+						i = ii
+					}
+				}
+				if nnz > 1 {
+					continue
+				}
+				// Column j has at most one nonzero element in row i.
+				// Permute rows i and k, and columns j and k.
+				lscale[k] = float64(i)
+				if i != k {
+					bi.Dswap(n-k, a[i*lda+k:], 1, a[k*lda+k:], 1)
+					bi.Dswap(n-k, b[i*ldb+k:], 1, b[k*ldb+k:], 1)
+				}
+				rscale[k] = float64(j)
+				if j != k {
+					bi.Dswap(l+1, a[j:], lda, a[k:], lda)
+					bi.Dswap(l+1, b[j:], ldb, b[k:], ldb)
+				}
+				k++
+				swapped = true
+				break
+			}
+		}
+	}
+
+	ilo = k
+	ihi = l
+
+	// Initialize the scaling factors of the remaining submatrix.
+	for i := ilo; i <= ihi; i++ {
+		lscale[i] = 1
+		rscale[i] = 1
+	}
+	if job == lapack.Permute || ilo == ihi {
+		return ilo, ihi
+	}
+
+	// Balance the submatrix in rows ilo to ihi by computing the scaling
+	// factors as the solution of a linear least squares problem for their
+	// logarithms using a generalized conjugate gradient iteration.
+	const sclfac = 10
+	nr := ihi - ilo + 1
+	for i := ilo; i <= ihi; i++ {
+		lscale[i] = 0
+		rscale[i] = 0
+	}
+	for i := 0; i < 6*n; i++ {
+		work[i] = 0
+	}
+	wr := work[:n]       // Search direction for rscale.
+	wl := work[n : 2*n]  // Search direction for lscale.
+	ql := work[2*n : 3*n]
+	qr := work[3*n : 4*n]
+	gl := work[4*n : 5*n] // Residual for lscale.
+	gr := work[5*n : 6*n] // Residual for rscale.
+
+	// Compute the right-hand side vector of the resulting linear
+	// equations.
+	basl := math.Log10(sclfac)
+	for i := ilo; i <= ihi; i++ {
+		for j := ilo; j <= ihi; j++ {
+			var ta, tb float64
+			if v := a[i*lda+j]; v != 0 {
+				ta = math.Log10(math.Abs(v)) / basl
+			}
+			if v := b[i*ldb+j]; v != 0 {
+				tb = math.Log10(math.Abs(v)) / basl
+			}
+			gl[i] -= ta + tb
+			gr[j] -= ta + tb
+		}
+	}
+
+	coef := 1 / float64(2*nr)
+	coef2 := coef * coef
+	coef5 := 0.5 * coef2
+	var beta, pgamma float64
+	for it := 1; it <= nr+2; it++ {
+		gamma := bi.Ddot(nr, gl[ilo:], 1, gl[ilo:], 1) + bi.Ddot(nr, gr[ilo:], 1, gr[ilo:], 1)
+		var ew, ewc float64
+		for i := ilo; i <= ihi; i++ {
+			ew += gl[i]
+			ewc += gr[i]
+		}
+		gamma = coef*gamma - coef2*(ew*ew+ewc*ewc) - coef5*(ew-ewc)*(ew-ewc)
+		if gamma == 0 {
+			break
+		}
+		if it != 1 {
+			beta = gamma / pgamma
+		}
+		t := coef5 * (ewc - 3*ew)
+		tc := coef5 * (ew - 3*ewc)
+		bi.Dscal(nr, beta, wr[ilo:], 1)
+		bi.Dscal(nr, beta, wl[ilo:], 1)
+		bi.Daxpy(nr, coef, gl[ilo:], 1, wl[ilo:], 1)
+		bi.Daxpy(nr, coef, gr[ilo:], 1, wr[ilo:], 1)
+		for i := ilo; i <= ihi; i++ {
+			wr[i] += tc
+			wl[i] += t
+		}
+
+		// Apply the matrix to the search directions.
+		for i := ilo; i <= ihi; i++ {
+			var kount int
+			var sum float64
+			for j := ilo; j <= ihi; j++ {
+				if a[i*lda+j] != 0 {
+					kount++
+					sum += wr[j]
+				}
+				if b[i*ldb+j] != 0 {
+					kount++
+					sum += wr[j]
+				}
+			}
+			ql[i] = float64(kount)*wl[i] + sum
+		}
+		for j := ilo; j <= ihi; j++ {
+			var kount int
+			var sum float64
+			for i := ilo; i <= ihi; i++ {
+				if a[i*lda+j] != 0 {
+					kount++
+					sum += wl[i]
+				}
+				if b[i*ldb+j] != 0 {
+					kount++
+					sum += wl[i]
+				}
+			}
+			qr[j] = float64(kount)*wr[j] + sum
+		}
+		sum := bi.Ddot(nr, wl[ilo:], 1, ql[ilo:], 1) + bi.Ddot(nr, wr[ilo:], 1, qr[ilo:], 1)
+		alpha := gamma / sum
+
+		// Determine the correction to the current iteration.
+		var cmax float64
+		for i := ilo; i <= ihi; i++ {
+			cor := alpha * wl[i]
+			cmax = math.Max(cmax, math.Abs(cor))
+			lscale[i] += cor
+			cor = alpha * wr[i]
+			cmax = math.Max(cmax, math.Abs(cor))
+			rscale[i] += cor
+		}
+		if cmax < 0.5 {
+			break
+		}
+		bi.Daxpy(nr, -alpha, ql[ilo:], 1, gl[ilo:], 1)
+		bi.Daxpy(nr, -alpha, qr[ilo:], 1, gr[ilo:], 1)
+		pgamma = gamma
+	}
+
+	// Compute the scaling factors as integer powers of sclfac, limited so
+	// that the scaled elements neither overflow nor underflow.
+	sfmin := dlamchS
+	sfmax := 1 / sfmin
+	lsfmin := int(math.Log10(sfmin)/basl + 1)
+	lsfmax := int(math.Log10(sfmax) / basl)
+	for i := ilo; i <= ihi; i++ {
+		irab := bi.Idamax(n-ilo, a[i*lda+ilo:], 1)
+		rab := math.Abs(a[i*lda+ilo+irab])
+		irab = bi.Idamax(n-ilo, b[i*ldb+ilo:], 1)
+		rab = math.Max(rab, math.Abs(b[i*ldb+ilo+irab]))
+		lrab := int(math.Log10(rab+sfmin)/basl + 1)
+		ir := int(lscale[i] + math.Copysign(0.5, lscale[i]))
+		ir = min(max(ir, lsfmin), min(lsfmax, lsfmax-lrab))
+		lscale[i] = math.Pow(sclfac, float64(ir))
+
+		icab := bi.Idamax(ihi+1, a[i:], lda)
+		cab := math.Abs(a[icab*lda+i])
+		icab = bi.Idamax(ihi+1, b[i:], ldb)
+		cab = math.Max(cab, math.Abs(b[icab*ldb+i]))
+		lcab := int(math.Log10(cab+sfmin)/basl + 1)
+		jc := int(rscale[i] + math.Copysign(0.5, rscale[i]))
+		jc = min(max(jc, lsfmin), min(lsfmax, lsfmax-lcab))
+		rscale[i] = math.Pow(sclfac, float64(jc))
+	}
+
+	// Scale the rows of A and B.
+	for i := ilo; i <= ihi; i++ {
+		bi.Dscal(n-ilo, lscale[i], a[i*lda+ilo:], 1)
+		bi.Dscal(n-ilo, lscale[i], b[i*ldb+ilo:], 1)
+	}
+	// Scale the columns of A and B.
+	for j := ilo; j <= ihi; j++ {
+		bi.Dscal(ihi+1, rscale[j], a[j:], lda)
+		bi.Dscal(ihi+1, rscale[j], b[j:], ldb)
+	}
+	return ilo, ihi
+}
@@ -0,0 +1,212 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgges computes for a pair of n×n real nonsymmetric matrices (A,B) the
+// generalized eigenvalues, the generalized real Schur form (S,T) and,
+// optionally, the left and/or right matrices of Schur vectors (VSL and VSR).
+// This gives the generalized Schur factorization
+//  A = VSL*S*VSR^T,  B = VSL*T*VSR^T,
+// where VSL and VSR are orthogonal, S is upper quasi-triangular with 1×1 and
+// 2×2 diagonal blocks, and T is upper triangular. The 2×2 diagonal blocks of S
+// correspond to complex conjugate pairs of eigenvalues, and the corresponding
+// 2×2 diagonal blocks of T are diagonal with non-negative elements. The
+// diagonal elements of T corresponding to 1×1 blocks of S are also
+// non-negative.
+//
+// The eigenvalues are not reordered, so no particular ordering of the
+// eigenvalues on the diagonal of (S,T) is guaranteed.
+//
+// jobvsl and jobvsr specify whether the left and right Schur vectors are
+// computed. They must be either lapack.SchurOrig, in which case the vectors are
+// computed and stored in VSL and VSR, or lapack.SchurNone, in which case VSL
+// and VSR are not referenced. Otherwise Dgges will panic.
+//
+// On return, A will be overwritten by its generalized Schur form S and B by its
+// generalized Schur form T.
+//
+// alphar, alphai and beta must have length n. On return, the generalized
+// eigenvalues will be
+//  λ_j = (alphar[j] + alphai[j]*i) / beta[j].
+// If alphai[j] is zero, then the j-th eigenvalue is real. If positive, then the
+// j-th and (j+1)-st eigenvalues are a complex conjugate pair, with alphai[j+1]
+// negative. beta[j] is non-negative and if it is zero, λ_j is infinite. The
+// eigenvalues are the ratios of the diagonal elements of S and T if they are
+// real, and are given by the 2×2 diagonal blocks of S and T if they are
+// complex.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n),
+// otherwise Dgges will panic. For optimum performance lwork should be larger.
+//
+// If lwork == -1, instead of performing Dgges, the function only calculates
+// the optimal value of lwork and stores it into work[0].
+//
+// first is the index of the first valid eigenvalue. If first is positive, the
+// QZ iteration in Dhgeqz failed, (A,B) are not in Schur form, and
+// alphar[first:], alphai[first:] and beta[first:] contain those eigenvalues
+// which have converged.
+func (impl Implementation) Dgges(jobvsl, jobvsr lapack.SchurComp, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int) (first int) {
+	wantvsl := jobvsl == lapack.SchurOrig
+	wantvsr := jobvsr == lapack.SchurOrig
+	minwrk := max(1, 8*n)
+	switch {
+	case jobvsl != lapack.SchurOrig && jobvsl != lapack.SchurNone:
+		panic(badSchurComp)
+	case jobvsr != lapack.SchurOrig && jobvsr != lapack.SchurNone:
+		panic(badSchurComp)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldvsl < 1 || (ldvsl < n && wantvsl):
+		panic(badLdVSL)
+	case ldvsr < 1 || (ldvsr < n && wantvsr):
+		panic(badLdVSR)
+	case lwork < minwrk && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0
+	}
+
+	maxwrk := n * (7 + impl.Ilaenv(1, "DGEQRF", " ", n, 1, n, 0))
+	maxwrk = max(maxwrk, n*(7+impl.Ilaenv(1, "DORMQR", " ", n, 1, n, -1)))
+	if wantvsl {
+		maxwrk = max(maxwrk, n*(7+impl.Ilaenv(1, "DORGQR", " ", n, 1, n, -1)))
+	}
+	maxwrk = max(maxwrk, minwrk)
+
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(alphar) != n:
+		panic(badLenAlphar)
+	case len(alphai) != n:
+		panic(badLenAlphai)
+	case len(beta) != n:
+		panic(badLenBeta)
+	case len(vsl) < (n-1)*ldvsl+n && wantvsl:
+		panic(shortVSL)
+	case len(vsr) < (n-1)*ldvsr+n && wantvsr:
+		panic(shortVSR)
+	}
+
+	// Get machine constants.
+	smlnum := math.Sqrt(dlamchS) / dlamchP
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum,bignum].
+	anrm := impl.Dlange(lapack.MaxAbs, n, n, a, lda, nil)
+	var scalea bool
+	var anrmto float64
+	if 0 < anrm && anrm < smlnum {
+		scalea = true
+		anrmto = smlnum
+	} else if anrm > bignum {
+		scalea = true
+		anrmto = bignum
+	}
+	if scalea {
+		impl.Dlascl(lapack.General, 0, 0, anrm, anrmto, n, n, a, lda)
+	}
+
+	// Scale B if max element outside range [smlnum,bignum].
+	bnrm := impl.Dlange(lapack.MaxAbs, n, n, b, ldb, nil)
+	var scaleb bool
+	var bnrmto float64
+	if 0 < bnrm && bnrm < smlnum {
+		scaleb = true
+		bnrmto = smlnum
+	} else if bnrm > bignum {
+		scaleb = true
+		bnrmto = bignum
+	}
+	if scaleb {
+		impl.Dlascl(lapack.General, 0, 0, bnrm, bnrmto, n, n, b, ldb)
+	}
+
+	// Permute the matrices A and B to isolate eigenvalues if possible.
+	lscale := work[:n]
+	rscale := work[n : 2*n]
+	ilo, ihi := impl.Dggbal(lapack.Permute, n, a, lda, b, ldb, lscale, rscale, nil)
+
+	// Reduce B to triangular form using the QR decomposition of B.
+	irows := ihi + 1 - ilo
+	icols := n - ilo
+	tau := work[2*n : 2*n+irows]
+	iwrk := 2*n + irows
+	impl.Dgeqrf(irows, icols, b[ilo*ldb+ilo:], ldb, tau, work[iwrk:], lwork-iwrk)
+
+	// Apply the orthogonal transformation to A.
+	impl.Dormqr(blas.Left, blas.Trans, irows, icols, irows, b[ilo*ldb+ilo:], ldb, tau,
+		a[ilo*lda+ilo:], lda, work[iwrk:], lwork-iwrk)
+
+	// Initialize VSL.
+	if wantvsl {
+		impl.Dlaset(blas.All, n, n, 0, 1, vsl, ldvsl)
+		if irows > 1 {
+			impl.Dlacpy(blas.Lower, irows-1, irows-1, b[(ilo+1)*ldb+ilo:], ldb, vsl[(ilo+1)*ldvsl+ilo:], ldvsl)
+		}
+		impl.Dorgqr(irows, irows, irows, vsl[ilo*ldvsl+ilo:], ldvsl, tau, work[iwrk:], lwork-iwrk)
+	}
+
+	// Initialize VSR.
+	if wantvsr {
+		impl.Dlaset(blas.All, n, n, 0, 1, vsr, ldvsr)
+	}
+
+	// Reduce to generalized Hessenberg form.
+	impl.Dgghrd(jobvsl, jobvsr, n, ilo, ihi, a, lda, b, ldb, vsl, ldvsl, vsr, ldvsr)
+
+	// Perform the QZ algorithm, computing the Schur vectors if desired.
+	iwrk = 2 * n
+	first = impl.Dhgeqz(lapack.EigenvaluesAndSchur, jobvsl, jobvsr, n, ilo, ihi, a, lda, b, ldb,
+		alphar, alphai, beta, vsl, ldvsl, vsr, ldvsr, work[iwrk:], lwork-iwrk)
+
+	if first == 0 {
+		// Apply back-permutation to VSL and VSR.
+		if wantvsl {
+			impl.Dggbak(lapack.Permute, lapack.EVLeft, n, ilo, ihi, lscale, rscale, n, vsl, ldvsl)
+		}
+		if wantvsr {
+			impl.Dggbak(lapack.Permute, lapack.EVRight, n, ilo, ihi, lscale, rscale, n, vsr, ldvsr)
+		}
+	}
+
+	// Undo scaling if necessary.
+	if scalea {
+		impl.Dlascl(lapack.General, 0, 0, anrmto, anrm, n, n, a, lda)
+		impl.Dlascl(lapack.General, 0, 0, anrmto, anrm, n, 1, alphar, 1)
+		impl.Dlascl(lapack.General, 0, 0, anrmto, anrm, n, 1, alphai, 1)
+	}
+	if scaleb {
+		impl.Dlascl(lapack.UpperTri, 0, 0, bnrmto, bnrm, n, n, b, ldb)
+		impl.Dlascl(lapack.General, 0, 0, bnrmto, bnrm, n, 1, beta, 1)
+	}
+
+	work[0] = float64(maxwrk)
+	return first
+}
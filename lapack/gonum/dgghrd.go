@@ -0,0 +1,133 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dgghrd reduces a pair of real n×n matrices (A,B) to generalized upper
+// Hessenberg form using orthogonal transformations, where A is a general matrix
+// and B is upper triangular. The form of the generalized eigenvalue problem
+//  A*x = λ*B*x
+// is then reduced to
+//  H*y = λ*T*y,
+// where H is upper Hessenberg, T is upper triangular and
+//  H = Q1^T*A*Z1,  T = Q1^T*B*Z1,
+// with Q1 and Z1 orthogonal.
+//
+// The orthogonal matrices Q1 and Z1 are determined as products of Givens
+// rotations. They may either be formed explicitly, or they may be postmultiplied
+// into input matrices Q and Z, so that
+//  Q*A*Z^T = (Q*Q1)*H*(Z*Z1)^T,
+//  Q*B*Z^T = (Q*Q1)*T*(Z*Z1)^T.
+// If Q1 is the orthogonal matrix from the QR factorization of B in the original
+// equation A*x = λ*B*x, then Dgghrd reduces the original problem to
+// generalized Hessenberg form.
+//
+// compq and compz specify whether the matrices Q and Z are computed:
+//  lapack.SchurNone: Q or Z is not referenced,
+//  lapack.SchurHess: Q or Z is initialized to the identity and the matrix
+//                    Q1 or Z1 is returned,
+//  lapack.SchurOrig: Q or Z must contain an orthogonal matrix on entry and the
+//                    product Q*Q1 or Z*Z1 is returned.
+//
+// ilo and ihi determine the block of A that is reduced. It is assumed that A is
+// already upper triangular in rows and columns [0:ilo] and [ihi+1:n], as
+// returned by Dggbal. Otherwise ilo and ihi should be set to 0 and n-1,
+// respectively. It must hold that
+//  0 <= ilo <= ihi < n     if n > 0,
+//  ilo == 0 and ihi == -1  if n == 0,
+// otherwise Dgghrd will panic.
+//
+// On return, A contains the upper Hessenberg matrix H, and B contains the upper
+// triangular matrix T. The elements of B below the diagonal are set to zero.
+//
+// Dgghrd is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dgghrd(compq, compz lapack.SchurComp, n, ilo, ihi int, a []float64, lda int, b []float64, ldb int, q []float64, ldq int, z []float64, ldz int) {
+	wantq := compq != lapack.SchurNone
+	wantz := compz != lapack.SchurNone
+	switch {
+	case compq != lapack.SchurNone && compq != lapack.SchurHess && compq != lapack.SchurOrig:
+		panic(badSchurComp)
+	case compz != lapack.SchurNone && compz != lapack.SchurHess && compz != lapack.SchurOrig:
+		panic(badSchurComp)
+	case n < 0:
+		panic(nLT0)
+	case ilo < 0 || max(0, n-1) < ilo:
+		panic(badIlo)
+	case ihi < min(ilo, n-1) || n <= ihi:
+		panic(badIhi)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldq < 1, wantq && ldq < n:
+		panic(badLdQ)
+	case ldz < 1, wantz && ldz < n:
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case wantz && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	}
+
+	// Initialize Q and Z if desired.
+	if compq == lapack.SchurHess {
+		impl.Dlaset(blas.All, n, n, 0, 1, q, ldq)
+	}
+	if compz == lapack.SchurHess {
+		impl.Dlaset(blas.All, n, n, 0, 1, z, ldz)
+	}
+
+	// Zero out the lower triangle of B.
+	for i := 1; i < n; i++ {
+		for j := 0; j < i; j++ {
+			b[i*ldb+j] = 0
+		}
+	}
+
+	bi := blas64.Implementation()
+	// Reduce A and B.
+	for jcol := ilo; jcol <= ihi-2; jcol++ {
+		for jrow := ihi; jrow >= jcol+2; jrow-- {
+			// Apply a rotation to rows jrow-1 and jrow to annihilate
+			// A[jrow,jcol].
+			c, s, r := impl.Dlartg(a[(jrow-1)*lda+jcol], a[jrow*lda+jcol])
+			a[(jrow-1)*lda+jcol] = r
+			a[jrow*lda+jcol] = 0
+			bi.Drot(n-jcol-1, a[(jrow-1)*lda+jcol+1:], 1, a[jrow*lda+jcol+1:], 1, c, s)
+			bi.Drot(n+1-jrow, b[(jrow-1)*ldb+jrow-1:], 1, b[jrow*ldb+jrow-1:], 1, c, s)
+			if wantq {
+				bi.Drot(n, q[jrow-1:], ldq, q[jrow:], ldq, c, s)
+			}
+
+			// Apply a rotation to columns jrow and jrow-1 to annihilate
+			// B[jrow,jrow-1].
+			c, s, r = impl.Dlartg(b[jrow*ldb+jrow], b[jrow*ldb+jrow-1])
+			b[jrow*ldb+jrow] = r
+			b[jrow*ldb+jrow-1] = 0
+			bi.Drot(ihi+1, a[jrow:], lda, a[jrow-1:], lda, c, s)
+			bi.Drot(jrow, b[jrow:], ldb, b[jrow-1:], ldb, c, s)
+			if wantz {
+				bi.Drot(n, z[jrow:], ldz, z[jrow-1:], ldz, c, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+// Dlaed1 computes the updated eigensystem of a diagonal matrix after
+// modification by a rank-one symmetric matrix. It is used when the original
+// matrix is tridiagonal and is called by Dlaed0.
+//
+//  T = Q*(D + rho*z*z^T)*Q^T = Q*D*Q^T + rho*Q*z*z^T*Q^T
+//
+// where z = Q^T*u, u is a vector of length n with ones in the cutpnt-1 and
+// cutpnt-th elements and zeros elsewhere.
+//
+// The eigenvectors of the original matrix are stored in Q, and the eigenvalues
+// are in d. The algorithm consists of three stages:
+//
+// The first stage consists of deflating the size of the problem when there
+// are multiple eigenvalues or if there is a zero in the z vector. For each such
+// occurrence the dimension of the secular equation problem is reduced by one.
+// This stage is performed by the routine Dlaed2.
+//
+// The second stage consists of calculating the updated eigenvalues. This is
+// done by finding the roots of the secular equation via the routine Dlaed4 (as
+// called by Dlaed3). This routine also calculates the eigenvectors of the
+// current problem.
+//
+// The final stage consists of computing the updated eigenvectors directly
+// using the updated eigenvalues. The eigenvectors for the current problem are
+// multiplied with the eigenvectors from the overall problem.
+//
+// On entry, d contains the eigenvalues of the rank-one-modified matrix and q
+// contains the eigenvectors of the rank-one-modified matrix. On return, d and q
+// contain the eigenvalues and eigenvectors of the repaired tridiagonal matrix.
+//
+// On entry, indxq contains the permutation which separately sorts the two
+// subproblems in d into ascending order. On return, it contains the
+// permutation which will reintegrate the subproblems just solved back into
+// sorted order, i.e. d[indxq[0:n]] will be in ascending order.
+//
+// rho is the subdiagonal entry used to create the rank-one modification, and
+// cutpnt is the location of the last eigenvalue in the leading sub-matrix,
+// 0 < cutpnt <= n/2.
+//
+// work must have length at least 4*n+n*n and iwork must have length at least
+// 4*n.
+//
+// Dlaed1 returns whether the roots of the secular equation were all found.
+//
+// Dlaed1 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed1(n int, d, q []float64, ldq int, indxq []int, rho float64, cutpnt int, work []float64, iwork []int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case ldq < max(1, n):
+		panic(badLdQ)
+	case min(1, n/2) > cutpnt || n/2 < cutpnt:
+		panic(badCutpnt)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(indxq) < n:
+		panic(shortIndxq)
+	case len(work) < 4*n+n*n:
+		panic(shortWork)
+	case len(iwork) < 4*n:
+		panic(shortIWork)
+	}
+
+	// The following values are indices into the workspace used by
+	// particular arrays in Dlaed2 and Dlaed3.
+	iz := 0
+	idlmda := iz + n
+	iw := idlmda + n
+	iq2 := iw + n
+
+	indx := 0
+	indxc := indx + n
+	coltyp := indxc + n
+	indxp := coltyp + n
+
+	// Form the z vector which consists of the last row of Q_1 and the
+	// first row of Q_2.
+	bi := blas64.Implementation()
+	bi.Dcopy(cutpnt, q[(cutpnt-1)*ldq:], 1, work[iz:], 1)
+	bi.Dcopy(n-cutpnt, q[cutpnt*ldq+cutpnt:], 1, work[iz+cutpnt:], 1)
+
+	// Deflate eigenvalues.
+	k, rho := impl.Dlaed2(n, cutpnt, d, q, ldq, indxq, rho, work[iz:iz+n], work[idlmda:idlmda+n],
+		work[iw:iw+n], work[iq2:], iwork[indx:indx+n], iwork[indxc:indxc+n], iwork[indxp:indxp+n], iwork[coltyp:])
+
+	if k == 0 {
+		for i := 0; i < n; i++ {
+			indxq[i] = i
+		}
+		return true
+	}
+
+	// Solve the secular equation.
+	is := (iwork[coltyp]+iwork[coltyp+1])*cutpnt + (iwork[coltyp+1]+iwork[coltyp+2])*(n-cutpnt) + iq2
+	ok = impl.Dlaed3(k, n, cutpnt, d, q, ldq, rho, work[idlmda:idlmda+n], work[iq2:is],
+		iwork[indxc:indxc+n], iwork[coltyp:coltyp+4], work[iw:iw+n], work[is:])
+	if !ok {
+		return false
+	}
+
+	// Prepare the indxq sorting permutation.
+	impl.Dlamrg(k, n-k, d, 1, -1, indxq)
+	return true
+}
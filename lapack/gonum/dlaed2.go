@@ -0,0 +1,302 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlaed2 merges the two sets of eigenvalues together into a single sorted set.
+// Then it tries to deflate the size of the problem. There are two ways in which
+// deflation can occur: when two or more eigenvalues are close together or if
+// there is a tiny entry in the z vector. For each such occurrence the order of
+// the related secular equation problem is reduced by one.
+//
+// n is the dimension of the symmetric tridiagonal matrix and n1 is the location
+// of the last eigenvalue in the leading sub-matrix, 1 <= n1 <= n/2.
+//
+// On entry, d contains the eigenvalues of the two submatrices to be combined.
+// On return, d contains the trailing n-k updated eigenvalues, those which were
+// deflated, sorted into increasing order.
+//
+// On entry, q contains the eigenvectors of the two submatrices in the two
+// square blocks with corners at (0,0) and (n1,n1). On return, q contains the
+// trailing n-k updated eigenvectors, those which were deflated, in its last
+// n-k columns.
+//
+// On entry, indxq contains the permutations which separately sort the two
+// sub-problems in d into ascending order. Note that elements in the second
+// half of this permutation must first have n1 added to their values.
+// Destroyed on return.
+//
+// rho is the off-diagonal element associated with the rank-one cut which
+// originally split the two submatrices which are now being recombined.
+//
+// On entry, z contains the updating vector, the last row of the first
+// sub-eigenvector matrix and the first row of the second sub-eigenvector
+// matrix. On return, the contents of z have been destroyed by the updating
+// process.
+//
+// On return, dlamda contains a copy of the first k eigenvalues which will be
+// used by Dlaed3 to form the secular equation, w contains the first k values of
+// the final deflation-altered z-vector which will be passed to Dlaed3, and q2
+// contains a copy of the first k eigenvectors which will be used by Dlaed3 in
+// a matrix multiply to solve for the new eigenvectors. q2 must have length at
+// least n*n.
+//
+// indx, indxc, indxp and coltyp are integer workspace and must have length at
+// least n. On return, indxc contains the permutation used to arrange the
+// columns of the deflated q matrix into four groups: the first group has
+// non-zero elements only in the first n1 rows, the second group is dense, the
+// third group has non-zero elements only in the last n-n1 rows, and the fourth
+// group contains the deflated columns. On return, the first four elements of
+// coltyp contain the number of columns in each of the four groups, so
+// coltyp must also have length at least 4.
+//
+// Dlaed2 returns the number of non-deflated eigenvalues k, and the modified
+// value of rho to be passed to Dlaed3.
+//
+// Dlaed2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed2(n, n1 int, d, q []float64, ldq int, indxq []int, rho float64, z, dlamda, w, q2 []float64, indx, indxc, indxp, coltyp []int) (k int, rhoOut float64) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case ldq < max(1, n):
+		panic(badLdQ)
+	case min(1, n/2) > n1 || n/2 < n1:
+		panic(badN1)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, rho
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(indxq) < n:
+		panic(shortIndxq)
+	case len(z) < n:
+		panic(shortZ)
+	case len(dlamda) < n:
+		panic(shortDlamda)
+	case len(w) < n:
+		panic(shortW)
+	case len(q2) < n*n:
+		panic(shortQ2)
+	case len(indx) < n:
+		panic(shortIndx)
+	case len(indxc) < n:
+		panic(shortIndxc)
+	case len(indxp) < n:
+		panic(shortIndxp)
+	case len(coltyp) < max(n, 4):
+		panic(shortColtyp)
+	}
+
+	bi := blas64.Implementation()
+
+	n2 := n - n1
+	if rho < 0 {
+		bi.Dscal(n2, -1, z[n1:], 1)
+	}
+
+	// Normalize z so that norm(z) = 1. Since z is the concatenation of
+	// two normalized vectors, norm2(z) = sqrt(2).
+	bi.Dscal(n, 1/math.Sqrt2, z, 1)
+
+	// rho = |norm(z)^2 * rho|.
+	rho = math.Abs(2 * rho)
+
+	// Sort the eigenvalues into increasing order.
+	for i := n1; i < n; i++ {
+		indxq[i] += n1
+	}
+
+	// Re-integrate the deflated parts from the last pass.
+	for i := 0; i < n; i++ {
+		dlamda[i] = d[indxq[i]]
+	}
+	impl.Dlamrg(n1, n2, dlamda, 1, 1, indxc)
+	for i := 0; i < n; i++ {
+		indx[i] = indxq[indxc[i]]
+	}
+
+	// Calculate the allowable deflation tolerance.
+	imax := bi.Idamax(n, z, 1)
+	jmax := bi.Idamax(n, d, 1)
+	eps := dlamchE
+	tol := 8 * eps * math.Max(math.Abs(d[jmax]), math.Abs(z[imax]))
+
+	// If the rank-1 modifier is small enough, no more needs to be done
+	// except to reorganize q so that its columns correspond with the
+	// elements in d.
+	if rho*math.Abs(z[imax]) <= tol {
+		for j := 0; j < n; j++ {
+			i := indx[j]
+			bi.Dcopy(n, q[i:], ldq, q2[j:], n)
+			dlamda[j] = d[i]
+		}
+		impl.Dlacpy(blas.All, n, n, q2, n, q, ldq)
+		bi.Dcopy(n, dlamda, 1, d, 1)
+		return 0, rho
+	}
+
+	// If there are multiple eigenvalues then the problem deflates. Here
+	// the number of equal eigenvalues are found. As each equal eigenvalue
+	// is found, an elementary reflector is computed to rotate the
+	// corresponding eigensubspace so that the corresponding components of
+	// z are zero in this new basis.
+	//
+	// The columns of q are classified into four types: type 0 has non-zero
+	// elements only in the first n1 rows, type 1 is dense, type 2 has
+	// non-zero elements only in the last n2 rows and type 3 is deflated.
+	for i := 0; i < n1; i++ {
+		coltyp[i] = 0
+	}
+	for i := n1; i < n; i++ {
+		coltyp[i] = 2
+	}
+
+	k2 := n
+	var j, pj int
+	for ; j < n; j++ {
+		nj := indx[j]
+		if rho*math.Abs(z[nj]) > tol {
+			pj = nj
+			break
+		}
+		// Deflate due to small z component.
+		k2--
+		coltyp[nj] = 3
+		indxp[k2] = nj
+	}
+	for j++; j < n; j++ {
+		nj := indx[j]
+		if rho*math.Abs(z[nj]) <= tol {
+			// Deflate due to small z component.
+			k2--
+			coltyp[nj] = 3
+			indxp[k2] = nj
+			continue
+		}
+
+		// Check if eigenvalues are close enough to allow deflation.
+		s := z[pj]
+		c := z[nj]
+
+		// Find sqrt(a^2+b^2) without overflow or destructive underflow.
+		tau := impl.Dlapy2(c, s)
+		t := d[nj] - d[pj]
+		c /= tau
+		s = -s / tau
+		if math.Abs(t*c*s) > tol {
+			dlamda[k] = d[pj]
+			w[k] = z[pj]
+			indxp[k] = pj
+			k++
+			pj = nj
+			continue
+		}
+
+		// Deflation is possible.
+		z[nj] = tau
+		z[pj] = 0
+		if coltyp[nj] != coltyp[pj] {
+			coltyp[nj] = 1
+		}
+		coltyp[pj] = 3
+		bi.Drot(n, q[pj:], ldq, q[nj:], ldq, c, s)
+		t = d[pj]*c*c + d[nj]*s*s
+		d[nj] = d[pj]*s*s + d[nj]*c*c
+		d[pj] = t
+		k2--
+		i := 1
+		for k2+i < n && d[pj] < d[indxp[k2+i]] {
+			indxp[k2+i-1] = indxp[k2+i]
+			indxp[k2+i] = pj
+			i++
+		}
+		indxp[k2+i-1] = pj
+		pj = nj
+	}
+
+	// Record the last eigenvalue.
+	dlamda[k] = d[pj]
+	w[k] = z[pj]
+	indxp[k] = pj
+
+	// Count up the total number of the various types of columns, then form
+	// a permutation which positions the four column types into four
+	// uniform groups (although one or more of these groups may be empty).
+	var ctot [4]int
+	for j := 0; j < n; j++ {
+		ctot[coltyp[j]]++
+	}
+
+	// psm is the position in the submatrix of types 0 through 3.
+	psm := [4]int{0, ctot[0], ctot[0] + ctot[1], ctot[0] + ctot[1] + ctot[2]}
+	k = n - ctot[3]
+
+	// Fill out the indxc array so that the permutation which it induces
+	// will place all type-0 columns first, all type-1 columns next, then
+	// all type-2 columns, and finally all type-3 columns.
+	for j := 0; j < n; j++ {
+		js := indxp[j]
+		ct := coltyp[js]
+		indx[psm[ct]] = js
+		indxc[psm[ct]] = j
+		psm[ct]++
+	}
+
+	// Sort the eigenvalues and corresponding eigenvectors into dlamda and
+	// q2 respectively. The eigenvalues/vectors which were not deflated go
+	// into the first k slots of dlamda and q2 respectively, while those
+	// which were deflated go into the last n-k slots.
+	n12 := ctot[0] + ctot[1]
+	n23 := ctot[1] + ctot[2]
+	iq2 := n1 * n12
+	var i int
+	for ; i < ctot[0]; i++ {
+		js := indx[i]
+		bi.Dcopy(n1, q[js:], ldq, q2[i:], n12)
+		z[i] = d[js]
+	}
+	for ; i < n12; i++ {
+		js := indx[i]
+		bi.Dcopy(n1, q[js:], ldq, q2[i:], n12)
+		bi.Dcopy(n2, q[n1*ldq+js:], ldq, q2[iq2+i-ctot[0]:], n23)
+		z[i] = d[js]
+	}
+	for ; i < k; i++ {
+		js := indx[i]
+		bi.Dcopy(n2, q[n1*ldq+js:], ldq, q2[iq2+i-ctot[0]:], n23)
+		z[i] = d[js]
+	}
+	iq1 := iq2 + n2*n23
+	for ; i < n; i++ {
+		js := indx[i]
+		bi.Dcopy(n, q[js:], ldq, q2[iq1+i-k:], ctot[3])
+		z[i] = d[js]
+	}
+
+	// The deflated eigenvalues and their corresponding vectors go back into
+	// the last n-k slots of d and q respectively.
+	if k < n {
+		impl.Dlacpy(blas.All, n, ctot[3], q2[iq1:], ctot[3], q[k:], ldq)
+		bi.Dcopy(n-k, z[k:], 1, d[k:], 1)
+	}
+
+	// Copy ctot into coltyp for referencing in Dlaed3.
+	copy(coltyp, ctot[:])
+
+	return k, rho
+}
@@ -0,0 +1,149 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlaed3 finds the roots of the secular equation, as defined by the values in
+// dlamda, w and rho, between 0 and k-1. It makes the appropriate calls to
+// Dlaed4 and then updates the eigenvectors by multiplying the matrix of
+// eigenvectors of the pair of eigensystems being combined by the matrix of
+// eigenvectors of the k×k system which is solved here.
+//
+// k is the number of terms in the rational function to be solved, and n is
+// the number of rows and columns in the Q matrix, n >= k. n1 is the size of
+// the leading submatrix of the problem being merged.
+//
+// On return, d[:k] contains the updated eigenvalues and the first k columns of
+// the n×n matrix Q contain the corresponding eigenvectors.
+//
+// dlamda contains the k old roots of the deflated updating problem, and w
+// contains the k components of the deflation-adjusted updating vector. w is
+// overwritten on return. rho is the positive coefficient of the rank-one
+// updating problem.
+//
+// q2 contains the non-deflated eigenvectors of the split problem as computed
+// by Dlaed2: the leading n1×(ctot[0]+ctot[1]) block with stride
+// ctot[0]+ctot[1] is followed by the (n-n1)×(ctot[1]+ctot[2]) block with stride
+// ctot[1]+ctot[2]. indx is the permutation used to arrange the rows of the
+// eigenvectors of the secular equation to match the column types in q2. ctot
+// contains the number of columns of each of the four types.
+//
+// s is workspace and must have length at least max(ctot[0]+ctot[1], ctot[1]+ctot[2])*k.
+//
+// Dlaed3 returns whether all the roots of the secular equation were found.
+//
+// Dlaed3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed3(k, n, n1 int, d, q []float64, ldq int, rho float64, dlamda, q2 []float64, indx, ctot []int, w, s []float64) (ok bool) {
+	switch {
+	case k < 0:
+		panic(kLT0)
+	case n < k:
+		panic(kGTN)
+	case n1 < 0 || n < n1:
+		panic(badN1)
+	case ldq < max(1, n):
+		panic(badLdQ)
+	}
+
+	// Quick return if possible.
+	if k == 0 {
+		return true
+	}
+
+	if len(ctot) < 4 {
+		panic(shortCtot)
+	}
+	n2 := n - n1
+	n12 := ctot[0] + ctot[1]
+	n23 := ctot[1] + ctot[2]
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(dlamda) < k:
+		panic(shortDlamda)
+	case len(q2) < n1*n12+n2*n23:
+		panic(shortQ2)
+	case len(indx) < k:
+		panic(shortIndx)
+	case len(w) < k:
+		panic(shortW)
+	case len(s) < max(n12, n23)*k:
+		panic(shortS)
+	}
+
+	bi := blas64.Implementation()
+
+	// Solve the secular equation for each root, storing the differences
+	// dlamda[i] - d[j] in the j-th column of Q.
+	for j := 0; j < k; j++ {
+		d[j], ok = impl.Dlaed4(k, j, dlamda, w, s, rho)
+		if !ok {
+			return false
+		}
+		bi.Dcopy(k, s, 1, q[j:], ldq)
+	}
+
+	switch k {
+	case 1:
+	case 2:
+		for j := 0; j < k; j++ {
+			w[0] = q[j]
+			w[1] = q[ldq+j]
+			q[j] = w[indx[0]]
+			q[ldq+j] = w[indx[1]]
+		}
+	default:
+		// Compute updated w.
+		bi.Dcopy(k, w, 1, s, 1)
+
+		// Initialize w[i] = Q[i,i].
+		bi.Dcopy(k, q, ldq+1, w, 1)
+		for j := 0; j < k; j++ {
+			for i := 0; i < j; i++ {
+				w[i] *= q[i*ldq+j] / (dlamda[i] - dlamda[j])
+			}
+			for i := j + 1; i < k; i++ {
+				w[i] *= q[i*ldq+j] / (dlamda[i] - dlamda[j])
+			}
+		}
+		for i := 0; i < k; i++ {
+			w[i] = math.Copysign(math.Sqrt(-w[i]), s[i])
+		}
+
+		// Compute eigenvectors of the modified rank-1 modification.
+		for j := 0; j < k; j++ {
+			for i := 0; i < k; i++ {
+				s[i] = w[i] / q[i*ldq+j]
+			}
+			temp := bi.Dnrm2(k, s, 1)
+			for i := 0; i < k; i++ {
+				q[i*ldq+j] = s[indx[i]] / temp
+			}
+		}
+	}
+
+	// Compute the updated eigenvectors.
+	if n23 != 0 {
+		impl.Dlacpy(blas.All, n23, k, q[ctot[0]*ldq:], ldq, s, k)
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n2, k, n23, 1, q2[n1*n12:], n23, s, k, 0, q[n1*ldq:], ldq)
+	} else if n2 != 0 {
+		impl.Dlaset(blas.All, n2, k, 0, 0, q[n1*ldq:], ldq)
+	}
+	if n12 != 0 {
+		impl.Dlacpy(blas.All, n12, k, q, ldq, s, k)
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n1, k, n12, 1, q2, n12, s, k, 0, q, ldq)
+	} else {
+		impl.Dlaset(blas.All, n1, k, 0, 0, q, ldq)
+	}
+	return true
+}
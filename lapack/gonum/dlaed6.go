@@ -0,0 +1,222 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlaed6 computes one Newton step in the solution of the secular equation
+//  f(x) = rho + z[0]/(d[0]-x) + z[1]/(d[1]-x) + z[2]/(d[2]-x) = 0.
+// It is used by Dlaed4 and Dlasd4 to find the root of the secular equation in
+// the interval (d[1],d[2]) if orgati is true, or (d[0],d[1]) otherwise.
+//
+// d must contain the three poles satisfying d[0] < d[1] < d[2] and z must
+// contain the three positive numerators of the secular equation. finit is the
+// value of f at 0. kniter is the iteration count of the calling routine; if it
+// is 2 an initial guess is computed from a rational approximation.
+//
+// Dlaed6 returns the root of f, relative to 0, and whether the iteration
+// converged.
+//
+// Dlaed6 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlaed6(kniter int, orgati bool, rho float64, d, z []float64, finit float64) (tau float64, ok bool) {
+	switch {
+	case len(d) < 3:
+		panic(shortD)
+	case len(z) < 3:
+		panic(shortZ)
+	}
+
+	const maxit = 40
+
+	var lbd, ubd float64
+	if orgati {
+		lbd, ubd = d[1], d[2]
+	} else {
+		lbd, ubd = d[0], d[1]
+	}
+	if finit < 0 {
+		lbd = 0
+	} else {
+		ubd = 0
+	}
+
+	if kniter == 2 {
+		var a, b, c float64
+		if orgati {
+			temp := (d[2] - d[1]) / 2
+			c = rho + z[0]/((d[0]-d[1])-temp)
+			a = c*(d[1]+d[2]) + z[1] + z[2]
+			b = c*d[1]*d[2] + z[1]*d[2] + z[2]*d[1]
+		} else {
+			temp := (d[0] - d[1]) / 2
+			c = rho + z[2]/((d[2]-d[1])-temp)
+			a = c*(d[0]+d[1]) + z[0] + z[1]
+			b = c*d[0]*d[1] + z[0]*d[1] + z[1]*d[0]
+		}
+		temp := math.Max(math.Abs(a), math.Max(math.Abs(b), math.Abs(c)))
+		a /= temp
+		b /= temp
+		c /= temp
+		tau = dlaed6Root(a, b, c)
+		if tau < lbd || tau > ubd {
+			tau = (lbd + ubd) / 2
+		}
+		if d[0] == tau || d[1] == tau || d[2] == tau {
+			tau = 0
+		} else {
+			temp := finit + tau*z[0]/(d[0]*(d[0]-tau)) +
+				tau*z[1]/(d[1]*(d[1]-tau)) +
+				tau*z[2]/(d[2]*(d[2]-tau))
+			if temp <= 0 {
+				lbd = tau
+			} else {
+				ubd = tau
+			}
+			if math.Abs(finit) <= math.Abs(temp) {
+				tau = 0
+			}
+		}
+	}
+
+	// Get machine parameters for possible scaling to avoid overflow.
+	eps := dlamchE
+	small1 := math.Pow(dlamchB, math.Trunc(math.Log(dlamchS)/math.Log(dlamchB)/3))
+	sminv1 := 1 / small1
+	small2 := small1 * small1
+	sminv2 := sminv1 * sminv1
+
+	// Determine if scaling of inputs is necessary to avoid overflow when
+	// computing 1/temp^3.
+	var temp float64
+	if orgati {
+		temp = math.Min(math.Abs(d[1]-tau), math.Abs(d[2]-tau))
+	} else {
+		temp = math.Min(math.Abs(d[0]-tau), math.Abs(d[1]-tau))
+	}
+	var dscale, zscale [3]float64
+	scale := temp <= small1
+	var sclinv float64
+	if scale {
+		sclfac := sminv1
+		sclinv = small1
+		if temp <= small2 {
+			sclfac = sminv2
+			sclinv = small2
+		}
+		for i := 0; i < 3; i++ {
+			dscale[i] = d[i] * sclfac
+			zscale[i] = z[i] * sclfac
+		}
+		tau *= sclfac
+		lbd *= sclfac
+		ubd *= sclfac
+	} else {
+		copy(dscale[:], d[:3])
+		copy(zscale[:], z[:3])
+	}
+
+	var fc, df, ddf float64
+	for i := 0; i < 3; i++ {
+		temp := 1 / (dscale[i] - tau)
+		temp1 := zscale[i] * temp
+		temp2 := temp1 * temp
+		temp3 := temp2 * temp
+		fc += temp1 / dscale[i]
+		df += temp2
+		ddf += temp3
+	}
+	f := finit + tau*fc
+
+	ok = math.Abs(f) <= 0
+	if !ok {
+		if f <= 0 {
+			lbd = tau
+		} else {
+			ubd = tau
+		}
+
+		// Iteration begins.
+		//
+		// It is not hard to see that
+		//  1) Iterations will go up monotonically if finit < 0;
+		//  2) Iterations will go down monotonically if finit > 0.
+	loop:
+		for niter := 1; niter < maxit; niter++ {
+			var temp1, temp2 float64
+			if orgati {
+				temp1 = dscale[1] - tau
+				temp2 = dscale[2] - tau
+			} else {
+				temp1 = dscale[0] - tau
+				temp2 = dscale[1] - tau
+			}
+			a := (temp1+temp2)*f - temp1*temp2*df
+			b := temp1 * temp2 * f
+			c := f - (temp1+temp2)*df + temp1*temp2*ddf
+			temp := math.Max(math.Abs(a), math.Max(math.Abs(b), math.Abs(c)))
+			a /= temp
+			b /= temp
+			c /= temp
+			eta := dlaed6Root(a, b, c)
+			if f*eta >= 0 {
+				eta = -f / df
+			}
+
+			tau += eta
+			if tau < lbd || tau > ubd {
+				tau = (lbd + ubd) / 2
+			}
+
+			fc, df, ddf = 0, 0, 0
+			var erretm float64
+			for i := 0; i < 3; i++ {
+				if dscale[i]-tau == 0 {
+					ok = true
+					break loop
+				}
+				temp := 1 / (dscale[i] - tau)
+				temp1 := zscale[i] * temp
+				temp2 := temp1 * temp
+				temp3 := temp2 * temp
+				temp4 := temp1 / dscale[i]
+				fc += temp4
+				erretm += math.Abs(temp4)
+				df += temp2
+				ddf += temp3
+			}
+			f = finit + tau*fc
+			erretm = 8*(math.Abs(finit)+math.Abs(tau)*erretm) + math.Abs(tau)*df
+			if math.Abs(f) <= 4*eps*erretm || ubd-lbd <= 4*eps*math.Abs(tau) {
+				ok = true
+				break
+			}
+			if f <= 0 {
+				lbd = tau
+			} else {
+				ubd = tau
+			}
+		}
+	}
+
+	// Undo scaling.
+	if scale {
+		tau *= sclinv
+	}
+	return tau, ok
+}
+
+// dlaed6Root returns the root of c*x^2 - a*x + b = 0 that is used as the
+// update in the secular equation solvers, computed with the formula that
+// avoids cancellation.
+func dlaed6Root(a, b, c float64) float64 {
+	switch {
+	case c == 0:
+		return b / a
+	case a <= 0:
+		return (a - math.Sqrt(math.Abs(a*a-4*b*c))) / (2 * c)
+	default:
+		return 2 * b / (a + math.Sqrt(math.Abs(a*a-4*b*c)))
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlag2 computes the eigenvalues of a 2×2 generalized eigenvalue problem
+//  A - w*B,
+// with scaling as necessary to avoid over-/underflow. B must be upper
+// triangular, only the upper triangle of b is referenced.
+//
+// The scaling factor s results in a modified eigenvalue equation
+//  s*A - w*B,
+// where s is a non-negative scaling factor chosen so that w, w*B and s*A do
+// not overflow and, if possible, do not underflow, either.
+//
+// safmin is the smallest positive number s.t. 1/safmin does not overflow. It
+// is used to perturb the diagonal elements of B when they are too small
+// relative to the other elements of B, so that B is not singular.
+//
+// On return, scale1 and scale2 are the scaling factors used to avoid
+// over-/underflow in the eigenvalue equation which defines the first and
+// second eigenvalue, respectively. If the eigenvalues are complex, scale2 is
+// equal to scale1.
+//
+// wr1 and wr2 are the first and second eigenvalue, respectively, if the
+// eigenvalues are real. If the eigenvalues are complex, wr1 and wr2 are both
+// equal to the real part of the eigenvalues. If the eigenvalues are real, wr1
+// is the eigenvalue closer to the [1,1] element of A*inv(B).
+//
+// wi is zero if the eigenvalues are real. Otherwise the eigenvalues are
+//  (wr1 ± wi*i)/scale1.
+//
+// Dlag2 is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlag2(a []float64, lda int, b []float64, ldb int, safmin float64) (scale1, scale2, wr1, wr2, wi float64) {
+	switch {
+	case lda < 2:
+		panic(badLdA)
+	case ldb < 2:
+		panic(badLdB)
+	case len(a) < lda+2:
+		panic(shortA)
+	case len(b) < ldb+2:
+		panic(shortB)
+	}
+
+	const fuzzy1 = 1 + 1e-5
+
+	rtmin := math.Sqrt(safmin)
+	rtmax := 1 / rtmin
+	safmax := 1 / safmin
+
+	// Scale A.
+	anorm := math.Max(math.Max(math.Abs(a[0])+math.Abs(a[lda]), math.Abs(a[1])+math.Abs(a[lda+1])), safmin)
+	ascale := 1 / anorm
+	a11 := ascale * a[0]
+	a21 := ascale * a[lda]
+	a12 := ascale * a[1]
+	a22 := ascale * a[lda+1]
+
+	// Perturb B if necessary to ensure non-singularity.
+	b11 := b[0]
+	b12 := b[1]
+	b22 := b[ldb+1]
+	bmin := rtmin * math.Max(math.Max(math.Abs(b11), math.Abs(b12)), math.Max(math.Abs(b22), rtmin))
+	if math.Abs(b11) < bmin {
+		b11 = math.Copysign(bmin, b11)
+	}
+	if math.Abs(b22) < bmin {
+		b22 = math.Copysign(bmin, b22)
+	}
+
+	// Scale B.
+	bnorm := math.Max(math.Max(math.Abs(b11), math.Abs(b12)+math.Abs(b22)), safmin)
+	bsize := math.Max(math.Abs(b11), math.Abs(b22))
+	bscale := 1 / bsize
+	b11 *= bscale
+	b12 *= bscale
+	b22 *= bscale
+
+	// Compute the larger eigenvalue by the method described by C. van
+	// Loan. AS is A shifted by -shift*B.
+	binv11 := 1 / b11
+	binv22 := 1 / b22
+	s1 := a11 * binv11
+	s2 := a22 * binv22
+	var as12, abi22, pp, shift, ss float64
+	if math.Abs(s1) <= math.Abs(s2) {
+		as12 = a12 - s1*b12
+		as22 := a22 - s1*b22
+		ss = a21 * (binv11 * binv22)
+		abi22 = as22*binv22 - ss*b12
+		pp = 0.5 * abi22
+		shift = s1
+	} else {
+		as12 = a12 - s2*b12
+		as11 := a11 - s2*b11
+		ss = a21 * (binv11 * binv22)
+		abi22 = -ss * b12
+		pp = 0.5 * (as11*binv11 + abi22)
+		shift = s2
+	}
+	qq := ss * as12
+	var discr, r float64
+	if math.Abs(pp*rtmin) >= 1 {
+		discr = (rtmin*pp)*(rtmin*pp) + qq*safmin
+		r = math.Sqrt(math.Abs(discr)) * rtmax
+	} else if pp*pp+math.Abs(qq) <= safmin {
+		discr = (rtmax*pp)*(rtmax*pp) + qq*safmax
+		r = math.Sqrt(math.Abs(discr)) * rtmin
+	} else {
+		discr = pp*pp + qq
+		r = math.Sqrt(math.Abs(discr))
+	}
+
+	// The test of r in the following condition covers the case when discr
+	// is small and negative and is flushed to zero during the calculation
+	// of r.
+	if discr >= 0 || r == 0 {
+		sum := pp + math.Copysign(r, pp)
+		diff := pp - math.Copysign(r, pp)
+		wbig := shift + sum
+
+		// Compute the smaller eigenvalue.
+		wsmall := shift + diff
+		if 0.5*math.Abs(wbig) > math.Max(math.Abs(wsmall), safmin) {
+			wdet := (a11*a22 - a12*a21) * (binv11 * binv22)
+			wsmall = wdet / wbig
+		}
+
+		// Choose the real eigenvalue closest to the [1,1] element of
+		// A*inv(B) for wr1.
+		if pp > abi22 {
+			wr1 = math.Min(wbig, wsmall)
+			wr2 = math.Max(wbig, wsmall)
+		} else {
+			wr1 = math.Max(wbig, wsmall)
+			wr2 = math.Min(wbig, wsmall)
+		}
+	} else {
+		// Complex eigenvalues.
+		wr1 = shift + pp
+		wr2 = wr1
+		wi = r
+	}
+
+	// Further scaling to avoid underflow and overflow in computing scale1
+	// and overflow in computing w*B.
+	//
+	// This scale factor (wscale) is bounded from above using c1 and c2,
+	// and from below using c3 and c4:
+	//  c1 implements the condition s*A must never overflow,
+	//  c2 implements the condition w*B must never overflow,
+	//  c3, with c2, implement the condition that s*A - w*B must never overflow,
+	//  c4 implements the condition s should not underflow,
+	//  c5 implements the condition max(s,|w|) should be at least 2.
+	c1 := bsize * (safmin * math.Max(1, ascale))
+	c2 := safmin * math.Max(1, bnorm)
+	c3 := bsize * safmin
+	c4 := 1.0
+	if ascale <= 1 && bsize <= 1 {
+		c4 = math.Min(1, (ascale/safmin)*bsize)
+	}
+	c5 := 1.0
+	if ascale <= 1 || bsize <= 1 {
+		c5 = math.Min(1, ascale*bsize)
+	}
+
+	// Scale the first eigenvalue.
+	wabs := math.Abs(wr1) + math.Abs(wi)
+	wsize := math.Max(math.Max(safmin, c1), math.Max(fuzzy1*(wabs*c2+c3), math.Min(c4, 0.5*math.Max(wabs, c5))))
+	if wsize != 1 {
+		wscale := 1 / wsize
+		if wsize > 1 {
+			scale1 = (math.Max(ascale, bsize) * wscale) * math.Min(ascale, bsize)
+		} else {
+			scale1 = (math.Min(ascale, bsize) * wscale) * math.Max(ascale, bsize)
+		}
+		wr1 *= wscale
+		if wi != 0 {
+			wi *= wscale
+			wr2 = wr1
+			scale2 = scale1
+		}
+	} else {
+		scale1 = ascale * bsize
+		scale2 = scale1
+	}
+
+	// Scale the second eigenvalue if it is real.
+	if wi == 0 {
+		wsize = math.Max(math.Max(safmin, c1), math.Max(fuzzy1*(math.Abs(wr2)*c2+c3), math.Min(c4, 0.5*math.Max(math.Abs(wr2), c5))))
+		if wsize != 1 {
+			wscale := 1 / wsize
+			if wsize > 1 {
+				scale2 = (math.Max(ascale, bsize) * wscale) * math.Min(ascale, bsize)
+			} else {
+				scale2 = (math.Min(ascale, bsize) * wscale) * math.Max(ascale, bsize)
+			}
+			wr2 *= wscale
+		} else {
+			scale2 = ascale * bsize
+		}
+	}
+	return scale1, scale2, wr1, wr2, wi
+}
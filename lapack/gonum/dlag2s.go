@@ -0,0 +1,22 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// dlag2s converts the m×n float64 matrix A to the float32 matrix SA. It
+// returns false if an element of A is outside the range of float32, in which
+// case the conversion is not completed.
+func dlag2s(m, n int, a []float64, lda int, sa []float32, ldsa int) (ok bool) {
+	for i := 0; i < m; i++ {
+		for j, v := range a[i*lda : i*lda+n] {
+			if v < -math.MaxFloat32 || math.MaxFloat32 < v {
+				return false
+			}
+			sa[i*ldsa+j] = float32(v)
+		}
+	}
+	return true
+}
@@ -0,0 +1,125 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlagtf factorizes the matrix (T - lambda*I), where T is an n×n tridiagonal
+// matrix and lambda is a scalar, as
+//  T - lambda*I = P*L*U,
+// where P is a permutation matrix, L is a unit lower tridiagonal matrix with at
+// most one non-zero sub-diagonal element per column and U is an upper
+// triangular matrix with at most two non-zero super-diagonal elements per
+// column. The factorization is obtained by Gaussian elimination with partial
+// pivoting and implicit row scaling.
+//
+// On entry, a contains the diagonal elements of T, b contains the n-1
+// super-diagonal elements of T and c contains the n-1 sub-diagonal elements of
+// T. On return, a contains the n diagonal elements of U, b contains the n-1
+// super-diagonal elements of U, c contains the n-1 sub-diagonal elements of L
+// and d contains the n-2 elements of the second super-diagonal of U.
+//
+// tol is a relative tolerance used to indicate whether or not the matrix
+// (T - lambda*I) is nearly singular. tol should normally be chosen as
+// approximately the largest relative error in the elements of T. If tol is
+// smaller than machine precision, then machine precision is used instead.
+//
+// On return, in[0:n-1] contains details of the permutation matrix P. If an
+// interchange occurred at the k-th step of the elimination, then in[k] is 1,
+// otherwise in[k] is 0. The element in[n-1] is set to the smallest index k such
+// that
+//  |u[k,k]| <= ||(T - lambda*I)[k]||*tol,
+// where ||A[k]|| denotes the 1-norm of the k-th row of A, or to -1 if there is
+// no such k. If in[n-1] is not -1, U is nearly singular.
+//
+// a must have length n, b, c must have length at least n-1, d must have length
+// at least n-2 and in must have length n, otherwise Dlagtf will panic.
+//
+// Dlagtf is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlagtf(n int, a []float64, lambda float64, b, c []float64, tol float64, d []float64, in []int) {
+	if n < 0 {
+		panic(nLT0)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < n:
+		panic(shortA)
+	case len(b) < n-1:
+		panic(shortB)
+	case len(c) < n-1:
+		panic(shortC)
+	case len(d) < n-2:
+		panic(shortD)
+	case len(in) < n:
+		panic(shortIn)
+	}
+
+	a[0] -= lambda
+	in[n-1] = -1
+	if n == 1 {
+		if a[0] == 0 {
+			in[0] = 0
+		}
+		return
+	}
+
+	tl := math.Max(tol, dlamchE)
+	scale1 := math.Abs(a[0]) + math.Abs(b[0])
+	for k := 0; k < n-1; k++ {
+		a[k+1] -= lambda
+		scale2 := math.Abs(c[k]) + math.Abs(a[k+1])
+		if k < n-2 {
+			scale2 += math.Abs(b[k+1])
+		}
+		var piv1 float64
+		if a[k] != 0 {
+			piv1 = math.Abs(a[k]) / scale1
+		}
+		var piv2 float64
+		if c[k] == 0 {
+			in[k] = 0
+			scale1 = scale2
+			if k < n-2 {
+				d[k] = 0
+			}
+		} else {
+			piv2 = math.Abs(c[k]) / scale2
+			if piv2 <= piv1 {
+				// No interchange.
+				in[k] = 0
+				scale1 = scale2
+				c[k] /= a[k]
+				a[k+1] -= c[k] * b[k]
+				if k < n-2 {
+					d[k] = 0
+				}
+			} else {
+				// Interchange rows k and k+1.
+				in[k] = 1
+				mult := a[k] / c[k]
+				a[k] = c[k]
+				tmp := a[k+1]
+				a[k+1] = b[k] - mult*tmp
+				if k < n-2 {
+					d[k] = b[k+1]
+					b[k+1] = -mult * d[k]
+				}
+				b[k] = tmp
+				c[k] = mult
+			}
+		}
+		if math.Max(piv1, piv2) <= tl && in[n-1] == -1 {
+			in[n-1] = k
+		}
+	}
+	if math.Abs(a[n-1]) <= scale1*tl && in[n-1] == -1 {
+		in[n-1] = n - 1
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math"
+
+// Dlagts solves one of the systems of equations
+//  (T - lambda*I) * x = y    if job == ±1,
+//  (T - lambda*I)^T * x = y  if job == ±2,
+// where T is an n×n tridiagonal matrix and lambda is a scalar, using the
+// factorization (T - lambda*I) = P*L*U computed by Dlagtf. a, b, c, d and in
+// must contain the output of Dlagtf.
+//
+// job specifies the system to be solved and how to treat small pivots:
+//  job > 0: the diagonal elements of U are not perturbed and Dlagts returns
+//           ok == false if overflow would otherwise occur;
+//  job < 0: if overflow would otherwise occur, the diagonal elements of U are
+//           perturbed by increasingly larger multiples of tol until the
+//           system can be solved.
+// Any other value of job will cause Dlagts to panic.
+//
+// On entry, y contains the right-hand side vector and on return it is
+// overwritten by the solution vector x.
+//
+// If job is negative and tol is not positive, tol is computed as
+//  tol = eps * max |T_{i,j}|,
+// where eps is the machine precision, and returned as tolOut. Otherwise tol is
+// returned unchanged.
+//
+// a must have length n, b must have length at least n-1, c must have length at
+// least n-1, d must have length at least n-2, in must have length at least n
+// and y must have length n, otherwise Dlagts will panic.
+//
+// Dlagts is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlagts(job, n int, a, b, c, d []float64, in []int, y []float64, tol float64) (tolOut float64, ok bool) {
+	switch {
+	case job != 1 && job != -1 && job != 2 && job != -2:
+		panic(badJob)
+	case n < 0:
+		panic(nLT0)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return tol, true
+	}
+
+	switch {
+	case len(a) < n:
+		panic(shortA)
+	case len(b) < n-1:
+		panic(shortB)
+	case len(c) < n-1:
+		panic(shortC)
+	case len(d) < n-2:
+		panic(shortD)
+	case len(in) < n:
+		panic(shortIn)
+	case len(y) < n:
+		panic(shortY)
+	}
+
+	eps := dlamchE
+	sfmin := dlamchS
+	bignum := 1 / sfmin
+
+	perturb := job < 0
+	if perturb && tol <= 0 {
+		tol = math.Abs(a[0])
+		if n > 1 {
+			tol = math.Max(tol, math.Max(math.Abs(a[1]), math.Abs(b[0])))
+		}
+		for k := 2; k < n; k++ {
+			tol = math.Max(tol, math.Max(math.Abs(a[k]), math.Max(math.Abs(b[k-1]), math.Abs(d[k-2]))))
+		}
+		tol *= eps
+		if tol == 0 {
+			tol = eps
+		}
+	}
+
+	// div computes temp/ak guarding against overflow. If perturb is true,
+	// ak is perturbed until the division is safe, otherwise div returns
+	// false if the division would overflow.
+	div := func(temp, ak float64) (float64, bool) {
+		pert := math.Copysign(tol, ak)
+		for {
+			absak := math.Abs(ak)
+			if absak >= 1 {
+				return temp / ak, true
+			}
+			if absak < sfmin {
+				if absak != 0 && math.Abs(temp)*sfmin <= absak {
+					return (temp * bignum) / (ak * bignum), true
+				}
+			} else if math.Abs(temp) <= absak*bignum {
+				return temp / ak, true
+			}
+			if !perturb {
+				return 0, false
+			}
+			ak += pert
+			pert *= 2
+		}
+	}
+
+	if job == 1 || job == -1 {
+		// Solve L*z = P^T*y.
+		for k := 1; k < n; k++ {
+			if in[k-1] == 0 {
+				y[k] -= c[k-1] * y[k-1]
+			} else {
+				y[k-1], y[k] = y[k], y[k-1]-c[k-1]*y[k]
+			}
+		}
+		// Solve U*x = z.
+		for k := n - 1; k >= 0; k-- {
+			temp := y[k]
+			if k < n-1 {
+				temp -= b[k] * y[k+1]
+			}
+			if k < n-2 {
+				temp -= d[k] * y[k+2]
+			}
+			var ok bool
+			y[k], ok = div(temp, a[k])
+			if !ok {
+				return tol, false
+			}
+		}
+		return tol, true
+	}
+
+	// Solve U^T*z = y.
+	for k := 0; k < n; k++ {
+		temp := y[k]
+		if k > 0 {
+			temp -= b[k-1] * y[k-1]
+		}
+		if k > 1 {
+			temp -= d[k-2] * y[k-2]
+		}
+		var ok bool
+		y[k], ok = div(temp, a[k])
+		if !ok {
+			return tol, false
+		}
+	}
+	// Solve L^T*P^T*x = z.
+	for k := n - 1; k > 0; k-- {
+		if in[k-1] == 0 {
+			y[k-1] -= c[k-1] * y[k]
+		} else {
+			y[k-1], y[k] = y[k], y[k-1]-c[k-1]*y[k]
+		}
+	}
+	return tol, true
+}
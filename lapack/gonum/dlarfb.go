@@ -108,8 +108,12 @@ func (Implementation) Dlarfb(side blas.Side, trans blas.Transpose, direct lapack
 		panic(shortWork)
 	}
 
-	bi := blas64.Implementation()
+	dlarfb(blas64.Implementation(), side, trans, direct, store, m, n, k, v, ldv, t, ldt, c, ldc, work, ldwork)
+}
 
+// dlarfb applies a block reflector to c as described for Dlarfb using the
+// BLAS implementation bi. The arguments are not checked.
+func dlarfb(bi blas.Float64, side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, m, n, k int, v []float64, ldv int, t []float64, ldt int, c []float64, ldc int, work []float64, ldwork int) {
 	transt := blas.Trans
 	if trans == blas.Trans {
 		transt = blas.NoTrans
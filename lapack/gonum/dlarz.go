@@ -0,0 +1,90 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlarz applies an elementary reflector H to an m×n matrix C from either the
+// left or the right. It computes
+//  C = H * C  if side == blas.Left,
+//  C = C * H  if side == blas.Right,
+// where
+//  H = I - tau * u * u^T
+// is the reflector defined by Dtzrzf. If side == blas.Left, u is a vector of
+// length m with u[0] = 1, u[1:m-l] = 0 and u[m-l:m] = v, and if
+// side == blas.Right, u is a vector of length n with u[0] = 1, u[1:n-l] = 0 and
+// u[n-l:n] = v. The vector v of length l is stored in v with increment incv.
+//
+// work must have length at least n if side == blas.Left and at least m if
+// side == blas.Right, otherwise Dlarz will panic.
+//
+// Dlarz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlarz(side blas.Side, m, n, l int, v []float64, incv int, tau float64, c []float64, ldc int, work []float64) {
+	left := side == blas.Left
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case l < 0:
+		panic(lLT0)
+	case left && l > m:
+		panic(lGTM)
+	case !left && l > n:
+		panic(lGTN)
+	case incv == 0:
+		panic(zeroIncV)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || tau == 0 {
+		return
+	}
+
+	switch {
+	case len(v) < 1+(l-1)*abs(incv):
+		panic(shortV)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case left && len(work) < n:
+		panic(shortWork)
+	case !left && len(work) < m:
+		panic(shortWork)
+	}
+
+	bi := blas64.Implementation()
+
+	if left {
+		// Form H * C.
+
+		// w[0:n] = C[0,0:n].
+		bi.Dcopy(n, c, 1, work, 1)
+		// w[0:n] += C[m-l:m,0:n]^T * v.
+		bi.Dgemv(blas.Trans, l, n, 1, c[(m-l)*ldc:], ldc, v, incv, 1, work, 1)
+		// C[0,0:n] -= tau * w.
+		bi.Daxpy(n, -tau, work, 1, c, 1)
+		// C[m-l:m,0:n] -= tau * v * w^T.
+		bi.Dger(l, n, -tau, v, incv, work, 1, c[(m-l)*ldc:], ldc)
+		return
+	}
+
+	// Form C * H.
+
+	// w[0:m] = C[0:m,0].
+	bi.Dcopy(m, c, ldc, work, 1)
+	// w[0:m] += C[0:m,n-l:n] * v.
+	bi.Dgemv(blas.NoTrans, m, l, 1, c[n-l:], ldc, v, incv, 1, work, 1)
+	// C[0:m,0] -= tau * w.
+	bi.Daxpy(m, -tau, work, 1, c, ldc)
+	// C[0:m,n-l:n] -= tau * w * v^T.
+	bi.Dger(m, l, -tau, work, 1, v, incv, c[n-l:], ldc)
+}
@@ -0,0 +1,151 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlarzb applies a block reflector H or its transpose H^T to an m×n matrix C
+// from either the left or the right. It computes
+//  C = H * C    if side == blas.Left and trans == blas.NoTrans,
+//  C = H^T * C  if side == blas.Left and trans == blas.Trans,
+//  C = C * H    if side == blas.Right and trans == blas.NoTrans,
+//  C = C * H^T  if side == blas.Right and trans == blas.Trans.
+// H is a product of k elementary reflectors as returned by Dtzrzf,
+//  H = I - V^T * T * V,
+// where the k×l matrix V contains the non-trivial part of the reflectors and
+// T is the k×k lower triangular factor computed by Dlarzt. Only
+// direct == lapack.Backward and store == lapack.RowWise are currently
+// supported, and Dlarzb will panic otherwise.
+//
+// work is a temporary matrix with stride ldwork. It must have n rows if
+// side == blas.Left and m rows if side == blas.Right, and ldwork must be at
+// least k.
+//
+// Dlarzb is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlarzb(side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, m, n, k, l int, v []float64, ldv int, t []float64, ldt int, c []float64, ldc int, work []float64, ldwork int) {
+	left := side == blas.Left
+	nw := m
+	if left {
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.Trans && trans != blas.NoTrans:
+		panic(badTrans)
+	case direct != lapack.Backward:
+		panic(badDirect)
+	case store != lapack.RowWise:
+		panic(badStoreV)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case l < 0:
+		panic(lLT0)
+	case left && l > m:
+		panic(lGTM)
+	case !left && l > n:
+		panic(lGTN)
+	case ldv < max(1, l):
+		panic(badLdV)
+	case ldt < max(1, k):
+		panic(badLdT)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case ldwork < max(1, k):
+		panic(badLdWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		return
+	}
+
+	switch {
+	case len(v) < (k-1)*ldv+l:
+		panic(shortV)
+	case len(t) < (k-1)*ldt+k:
+		panic(shortT)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(work) < (nw-1)*ldwork+k:
+		panic(shortWork)
+	}
+
+	bi := blas64.Implementation()
+
+	transt := blas.Trans
+	if trans == blas.Trans {
+		transt = blas.NoTrans
+	}
+
+	if left {
+		// Form H * C or H^T * C.
+
+		// W[0:n,0:k] = C[0:k,0:n]^T.
+		for j := 0; j < k; j++ {
+			bi.Dcopy(n, c[j*ldc:], 1, work[j:], ldwork)
+		}
+		// W[0:n,0:k] += C[m-l:m,0:n]^T * V[0:k,0:l]^T.
+		if l > 0 {
+			bi.Dgemm(blas.Trans, blas.Trans, n, k, l,
+				1, c[(m-l)*ldc:], ldc, v, ldv,
+				1, work, ldwork)
+		}
+		// W[0:n,0:k] = W[0:n,0:k] * T^T or W[0:n,0:k] * T.
+		bi.Dtrmm(blas.Right, blas.Lower, transt, blas.NonUnit, n, k,
+			1, t, ldt,
+			work, ldwork)
+		// C[0:k,0:n] -= W[0:n,0:k]^T.
+		for i := 0; i < k; i++ {
+			for j := 0; j < n; j++ {
+				c[i*ldc+j] -= work[j*ldwork+i]
+			}
+		}
+		// C[m-l:m,0:n] -= V[0:k,0:l]^T * W[0:n,0:k]^T.
+		if l > 0 {
+			bi.Dgemm(blas.Trans, blas.Trans, l, n, k,
+				-1, v, ldv, work, ldwork,
+				1, c[(m-l)*ldc:], ldc)
+		}
+		return
+	}
+
+	// Form C * H or C * H^T.
+
+	// W[0:m,0:k] = C[0:m,0:k].
+	for j := 0; j < k; j++ {
+		bi.Dcopy(m, c[j:], ldc, work[j:], ldwork)
+	}
+	// W[0:m,0:k] += C[0:m,n-l:n] * V[0:k,0:l]^T.
+	if l > 0 {
+		bi.Dgemm(blas.NoTrans, blas.Trans, m, k, l,
+			1, c[n-l:], ldc, v, ldv,
+			1, work, ldwork)
+	}
+	// W[0:m,0:k] = W[0:m,0:k] * T or W[0:m,0:k] * T^T.
+	bi.Dtrmm(blas.Right, blas.Lower, trans, blas.NonUnit, m, k,
+		1, t, ldt,
+		work, ldwork)
+	// C[0:m,0:k] -= W[0:m,0:k].
+	for i := 0; i < m; i++ {
+		for j := 0; j < k; j++ {
+			c[i*ldc+j] -= work[i*ldwork+j]
+		}
+	}
+	// C[0:m,n-l:n] -= W[0:m,0:k] * V[0:k,0:l].
+	if l > 0 {
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, m, l, k,
+			-1, work, ldwork, v, ldv,
+			1, c[n-l:], ldc)
+	}
+}
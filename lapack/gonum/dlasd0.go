@@ -0,0 +1,148 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dlasd0 computes, using a divide and conquer approach, the singular value
+// decomposition of a real upper bidiagonal n×m matrix B with diagonal d and
+// off-diagonal e, where m = n+sqre. The algorithm computes orthogonal
+// matrices U and VT such that B = U * S * VT. The singular values S are
+// overwritten on d.
+//
+// sqre must be 0 or 1. If sqre is 0, B is square, otherwise B has one more
+// column than rows.
+//
+// On entry, d contains the main diagonal of the bidiagonal matrix and e
+// contains its m-1 off-diagonal elements. On return, d contains the singular
+// values of B and e has been destroyed. d must have length at least n and e
+// must have length at least m-1.
+//
+// On return, u contains the n×n matrix of left singular vectors and vt
+// contains the m×m matrix of transposed right singular vectors.
+//
+// iwork must have length at least 8*n and work must have length at least
+// 3*m*m+2*m.
+//
+// Dlasd0 returns whether all the singular values were found.
+//
+// Dlasd0 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd0(n, sqre int, d, e, u []float64, ldu int, vt []float64, ldvt int, iwork []int, work []float64) (ok bool) {
+	m := n + sqre
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case ldu < max(1, n):
+		panic(badLdU)
+	case ldvt < max(1, m):
+		panic(badLdVT)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < m-1:
+		panic(shortE)
+	case len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case len(vt) < (m-1)*ldvt+m:
+		panic(shortVT)
+	case len(iwork) < 8*n:
+		panic(shortIWork)
+	case len(work) < 3*m*m+2*m:
+		panic(shortWork)
+	}
+
+	smlsiz := impl.Ilaenv(9, "DBDSDC", " ", 0, 0, 0, 0)
+
+	// If the input matrix is too small, call Dlasdq to find the SVD.
+	if n <= smlsiz {
+		return impl.Dlasdq(blas.Upper, sqre, n, m, n, 0, d, e, vt, ldvt, u, ldu, u, ldu, work)
+	}
+
+	// Set up the computation tree.
+	inode := 0
+	ndiml := inode + n
+	ndimr := ndiml + n
+	idxq := ndimr + n
+	iwk := idxq + n
+	nlvl, nd := impl.Dlasdt(n, iwork[inode:ndiml], iwork[ndiml:ndimr], iwork[ndimr:idxq], smlsiz)
+
+	// For the nodes on the bottom level of the tree, solve their
+	// subproblems by Dlasdq.
+	ndb1 := (nd + 1) / 2
+	for i := ndb1 - 1; i < nd; i++ {
+		// ic is the center row of each node, nl and nr are the number
+		// of rows of the left and right subproblems, and nlf and nrf are
+		// the starting rows of the left and right subproblems.
+		ic := iwork[inode+i]
+		nl := iwork[ndiml+i]
+		nlp1 := nl + 1
+		nr := iwork[ndimr+i]
+		nlf := ic - nl
+		nrf := ic + 1
+		ok = impl.Dlasdq(blas.Upper, 1, nl, nlp1, nl, 0, d[nlf:], e[nlf:], vt[nlf*ldvt+nlf:], ldvt,
+			u[nlf*ldu+nlf:], ldu, u[nlf*ldu+nlf:], ldu, work)
+		if !ok {
+			return false
+		}
+		itemp := idxq + nlf
+		for j := 0; j < nl; j++ {
+			iwork[itemp+j] = j
+		}
+
+		sqrei := 1
+		if i == nd-1 {
+			sqrei = sqre
+		}
+		nrp1 := nr + sqrei
+		ok = impl.Dlasdq(blas.Upper, sqrei, nr, nrp1, nr, 0, d[nrf:], e[nrf:], vt[nrf*ldvt+nrf:], ldvt,
+			u[nrf*ldu+nrf:], ldu, u[nrf*ldu+nrf:], ldu, work)
+		if !ok {
+			return false
+		}
+		itemp = idxq + nrf
+		for j := 0; j < nr; j++ {
+			iwork[itemp+j] = j
+		}
+	}
+
+	// Now conquer each subproblem bottom-up.
+	for lvl := nlvl; lvl >= 1; lvl-- {
+		// Find the first node lf and last node ll on the current level
+		// lvl.
+		lf := 1
+		ll := 1
+		if lvl > 1 {
+			lf = 1 << uint(lvl-1)
+			ll = 2*lf - 1
+		}
+		for i := lf - 1; i < ll; i++ {
+			ic := iwork[inode+i]
+			nl := iwork[ndiml+i]
+			nr := iwork[ndimr+i]
+			nlf := ic - nl
+			sqrei := 1
+			if sqre == 0 && i == ll-1 {
+				sqrei = sqre
+			}
+			alpha := d[ic]
+			beta := e[ic]
+			ok = impl.Dlasd1(nl, nr, sqrei, d[nlf:], alpha, beta, u[nlf*ldu+nlf:], ldu, vt[nlf*ldvt+nlf:], ldvt,
+				iwork[idxq+nlf:], iwork[iwk:], work)
+			if !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,161 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasd1 computes the singular value decomposition of an upper bidiagonal
+// n×m matrix B, where n = nl+nr+1 and m = n+sqre. It is used when B has been
+// split into two subblocks whose singular value decompositions are already
+// known, and is called by Dlasd0.
+//
+// Dlasd1 computes the SVD as follows:
+//
+//              ( D1(in)  0    0       0 )
+//  B = U(in) * (  Z1^T   a   Z2^T    b ) * VT(in)
+//              (  0      0   D2(in)  0 )
+//
+//    = U(out) * ( D(out) 0) * VT(out)
+//
+// where Z^T = (Z1^T a Z2^T b) = u^T VT^T, and u is a vector of dimension m with
+// alpha and beta in the nl-th and (nl+1)-th entries and zeros elsewhere; and
+// the entry b is empty if sqre == 0.
+//
+// The left singular vectors of the original matrix are stored in U, and the
+// transpose of the right singular vectors are stored in VT, and the singular
+// values are in d. The algorithm consists of three stages:
+//
+// The first stage consists of deflating the size of the problem when there
+// are multiple singular values or when there are zeros in the z vector. For
+// each such occurrence the dimension of the secular equation problem is
+// reduced by one. This stage is performed by the routine Dlasd2.
+//
+// The second stage consists of calculating the updated singular values. This
+// is done by finding the square roots of the roots of the secular equation via
+// the routine Dlasd4 (as called by Dlasd3). This routine also calculates the
+// singular vectors of the current problem.
+//
+// The final stage consists of computing the updated singular vectors directly
+// using the updated singular values. The singular vectors for the current
+// problem are multiplied with the singular vectors from the overall problem.
+//
+// nl and nr are the row dimensions of the upper and lower blocks and must be
+// at least 1. sqre is 0 if the lower block is square and 1 if it has one more
+// column than rows.
+//
+// On entry, d contains the singular values of the upper block in its first nl
+// elements and those of the lower block in its last nr elements. On return, d
+// contains the singular values of the modified matrix. d must have length at
+// least n.
+//
+// alpha and beta contain the diagonal and off-diagonal elements associated
+// with the added row.
+//
+// On entry, u contains the left singular vectors of the upper block in its
+// leading nl×nl block and those of the lower block in its trailing nr×nr block.
+// On return, u contains the left singular vectors of the bidiagonal matrix.
+// u is n×n.
+//
+// On entry, vt contains the transposed right singular vectors of the upper
+// block in its leading (nl+1)×(nl+1) block and those of the lower block in its
+// trailing (nr+sqre)×(nr+sqre) block. On return, vt contains the transposed
+// right singular vectors of the bidiagonal matrix. vt is m×m.
+//
+// On entry, idxq contains the permutations which separately sort the two
+// subproblems in d into ascending order. On return, it contains the
+// permutation which will reintegrate the subproblems just solved back into
+// sorted order, i.e. d[idxq[0:n]] will be in ascending order. idxq must have
+// length at least n.
+//
+// iwork must have length at least 4*n and work must have length at least
+// 3*m*m+2*m.
+//
+// Dlasd1 returns whether all the singular values were found.
+//
+// Dlasd1 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd1(nl, nr, sqre int, d []float64, alpha, beta float64, u []float64, ldu int, vt []float64, ldvt int, idxq, iwork []int, work []float64) (ok bool) {
+	n := nl + nr + 1
+	m := n + sqre
+	switch {
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case ldu < n:
+		panic(badLdU)
+	case ldvt < m:
+		panic(badLdVT)
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case len(vt) < (m-1)*ldvt+m:
+		panic(shortVT)
+	case len(idxq) < n:
+		panic(shortIdxq)
+	case len(iwork) < 4*n:
+		panic(shortIWork)
+	case len(work) < 3*m*m+2*m:
+		panic(shortWork)
+	}
+
+	// The following values are for bookkeeping purposes only. They are
+	// indices into the workspace used by particular arrays in Dlasd2 and
+	// Dlasd3.
+	ldu2 := n
+	ldvt2 := m
+
+	iz := 0
+	isigma := iz + m
+	iu2 := isigma + n
+	ivt2 := iu2 + ldu2*n
+	iq := ivt2 + ldvt2*m
+
+	idx := 0
+	idxc := idx + n
+	coltyp := idxc + n
+	idxp := coltyp + n
+
+	// Scale.
+	orgnrm := math.Max(math.Abs(alpha), math.Abs(beta))
+	d[nl] = 0
+	for i := 0; i < n; i++ {
+		if math.Abs(d[i]) > orgnrm {
+			orgnrm = math.Abs(d[i])
+		}
+	}
+	impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, n, 1, d, 1)
+	alpha /= orgnrm
+	beta /= orgnrm
+
+	// Deflate singular values.
+	k := impl.Dlasd2(nl, nr, sqre, d, work[iz:isigma], alpha, beta, u, ldu, vt, ldvt,
+		work[isigma:iu2], work[iu2:ivt2], ldu2, work[ivt2:iq], ldvt2,
+		iwork[idxp:idxp+n], iwork[idx:idx+n], iwork[idxc:idxc+n], idxq, iwork[coltyp:])
+
+	// Solve the secular equation and update the singular vectors.
+	ldq := k
+	ok = impl.Dlasd3(nl, nr, sqre, k, d, work[iq:], ldq, work[isigma:iu2], u, ldu, work[iu2:ivt2], ldu2,
+		vt, ldvt, work[ivt2:iq], ldvt2, iwork[idxc:idxc+n], iwork[coltyp:coltyp+4], work[iz:isigma])
+	if !ok {
+		return false
+	}
+
+	// Unscale.
+	impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, n, 1, d, 1)
+
+	// Prepare the idxq sorting permutation.
+	impl.Dlamrg(k, n-k, d, 1, -1, idxq)
+	return true
+}
@@ -0,0 +1,222 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasd3 finds all the square roots of the roots of the secular equation, as
+// defined by the values in dsigma and z. It makes the appropriate calls to
+// Dlasd4 and then updates the singular vectors by matrix multiplication.
+//
+// nl and nr are the row dimensions of the upper and lower blocks, and sqre is
+// 0 if the lower block is square and 1 if it has one more column than rows.
+// The bidiagonal matrix has n = nl+nr+1 rows and m = n+sqre columns. k is the
+// size of the secular equation as returned by Dlasd2, 1 <= k <= n.
+//
+// On return, d contains the square roots of the roots of the secular equation
+// in ascending order. d must have length at least k.
+//
+// q is workspace of size at least k×k with leading dimension ldq >= k.
+//
+// dsigma contains the first k elements of the deflation-adjusted diagonal
+// matrix, the poles of the secular equation, and z contains the components of
+// the deflation-adjusted updating row vector. z is destroyed on return.
+//
+// On entry, u2 contains the first k left singular vectors of the combined
+// subproblems as returned by Dlasd2, and on return u contains the first k left
+// singular vectors of the updated matrix. u and u2 are n×n.
+//
+// On entry, vt2 contains the first k right singular vectors of the combined
+// subproblems as returned by Dlasd2, and on return vt contains the first k
+// right singular vectors of the updated matrix. vt and vt2 are m×m.
+//
+// idxc is the permutation used to arrange the columns of u and the rows of vt
+// into the column types defined by Dlasd2, and ctot contains the number of
+// columns of each type. idxc must have length at least k and ctot must have
+// length at least 4.
+//
+// Dlasd3 returns whether all the singular values were found.
+//
+// Dlasd3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasd3(nl, nr, sqre, k int, d, q []float64, ldq int, dsigma, u []float64, ldu int, u2 []float64, ldu2 int, vt []float64, ldvt int, vt2 []float64, ldvt2 int, idxc, ctot []int, z []float64) (ok bool) {
+	n := nl + nr + 1
+	m := n + sqre
+	nlp1 := nl + 1
+	switch {
+	case nl < 1:
+		panic(nlLT1)
+	case nr < 1:
+		panic(nrLT1)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case k < 1:
+		panic(kLT1)
+	case k > n:
+		panic(kGTN)
+	case ldq < k:
+		panic(badLdQ)
+	case ldu < n:
+		panic(badLdU)
+	case ldu2 < n:
+		panic(badLdU2)
+	case ldvt < m:
+		panic(badLdVT)
+	case ldvt2 < m:
+		panic(badLdVT2)
+	}
+
+	switch {
+	case len(d) < k:
+		panic(shortD)
+	case len(q) < (k-1)*ldq+k:
+		panic(shortQ)
+	case len(dsigma) < k:
+		panic(shortDsigma)
+	case len(u) < (n-1)*ldu+n:
+		panic(shortU)
+	case len(u2) < (n-1)*ldu2+n:
+		panic(shortU2)
+	case len(vt) < (m-1)*ldvt+m:
+		panic(shortVT)
+	case len(vt2) < (m-1)*ldvt2+m:
+		panic(shortVT2)
+	case len(idxc) < k:
+		panic(shortIdxc)
+	case len(ctot) < 4:
+		panic(shortCtot)
+	case len(z) < k:
+		panic(shortZ)
+	}
+
+	bi := blas64.Implementation()
+
+	// Quick return if possible.
+	if k == 1 {
+		d[0] = math.Abs(z[0])
+		bi.Dcopy(m, vt2, 1, vt, 1)
+		if z[0] > 0 {
+			bi.Dcopy(n, u2, ldu2, u, ldu)
+		} else {
+			for i := 0; i < n; i++ {
+				u[i*ldu] = -u2[i*ldu2]
+			}
+		}
+		return true
+	}
+
+	// Keep a copy of z in the first column of q.
+	bi.Dcopy(k, z, 1, q, ldq)
+
+	// Normalize z.
+	rho := bi.Dnrm2(k, z, 1)
+	impl.Dlascl(lapack.General, 0, 0, rho, 1, k, 1, z, 1)
+	rho *= rho
+
+	// Find the new singular values. The differences dsigma[i]-d[j] and the
+	// sums dsigma[i]+d[j] for the j-th singular value are stored in the j-th
+	// rows of the leading k×k blocks of u and vt respectively.
+	for j := 0; j < k; j++ {
+		d[j], ok = impl.Dlasd4(k, j, dsigma, z, u[j*ldu:], rho, vt[j*ldvt:])
+		if !ok {
+			return false
+		}
+	}
+
+	// Compute updated z.
+	for i := 0; i < k; i++ {
+		zi := u[(k-1)*ldu+i] * vt[(k-1)*ldvt+i]
+		for j := 0; j < i; j++ {
+			zi *= u[j*ldu+i] * vt[j*ldvt+i] / (dsigma[i] - dsigma[j]) / (dsigma[i] + dsigma[j])
+		}
+		for j := i; j < k-1; j++ {
+			zi *= u[j*ldu+i] * vt[j*ldvt+i] / (dsigma[i] - dsigma[j+1]) / (dsigma[i] + dsigma[j+1])
+		}
+		z[i] = math.Copysign(math.Sqrt(math.Abs(zi)), q[i*ldq])
+	}
+
+	// Compute left singular vectors of the modified diagonal matrix, and
+	// store related information for the right singular vectors.
+	for i := 0; i < k; i++ {
+		ui := u[i*ldu : i*ldu+k]
+		vti := vt[i*ldvt : i*ldvt+k]
+		vti[0] = z[0] / ui[0] / vti[0]
+		ui[0] = -1
+		for j := 1; j < k; j++ {
+			vti[j] = z[j] / ui[j] / vti[j]
+			ui[j] = dsigma[j] * vti[j]
+		}
+		temp := bi.Dnrm2(k, ui, 1)
+		q[i] = ui[0] / temp
+		for j := 1; j < k; j++ {
+			jc := idxc[j]
+			q[j*ldq+i] = ui[jc] / temp
+		}
+	}
+
+	// Update the left singular vector matrix.
+	if k == 2 {
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n, k, k, 1, u2, ldu2, q, ldq, 0, u, ldu)
+	} else {
+		if ctot[0] > 0 {
+			bi.Dgemm(blas.NoTrans, blas.NoTrans, nl, k, ctot[0], 1, u2[1:], ldu2, q[ldq:], ldq, 0, u, ldu)
+			if ctot[2] > 0 {
+				ktemp := 1 + ctot[0] + ctot[1]
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, nl, k, ctot[2], 1, u2[ktemp:], ldu2, q[ktemp*ldq:], ldq, 1, u, ldu)
+			}
+		} else if ctot[2] > 0 {
+			ktemp := 1 + ctot[0] + ctot[1]
+			bi.Dgemm(blas.NoTrans, blas.NoTrans, nl, k, ctot[2], 1, u2[ktemp:], ldu2, q[ktemp*ldq:], ldq, 0, u, ldu)
+		} else {
+			impl.Dlacpy(blas.All, nl, k, u2, ldu2, u, ldu)
+		}
+		bi.Dcopy(k, q, 1, u[nl*ldu:], 1)
+		ktemp := 1 + ctot[0]
+		ctemp := ctot[1] + ctot[2]
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, nr, k, ctemp, 1, u2[nlp1*ldu2+ktemp:], ldu2, q[ktemp*ldq:], ldq, 0, u[nlp1*ldu:], ldu)
+	}
+
+	// Generate the right singular vectors.
+	for i := 0; i < k; i++ {
+		vti := vt[i*ldvt : i*ldvt+k]
+		temp := bi.Dnrm2(k, vti, 1)
+		q[i*ldq] = vti[0] / temp
+		for j := 1; j < k; j++ {
+			jc := idxc[j]
+			q[i*ldq+j] = vti[jc] / temp
+		}
+	}
+
+	// Update the right singular vector matrix.
+	if k == 2 {
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, k, m, k, 1, q, ldq, vt2, ldvt2, 0, vt, ldvt)
+		return true
+	}
+	ktemp := 1 + ctot[0]
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, k, nlp1, ktemp, 1, q, ldq, vt2, ldvt2, 0, vt, ldvt)
+	ktemp = 1 + ctot[0] + ctot[1]
+	if ktemp < ldvt2 {
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, k, nlp1, ctot[2], 1, q[ktemp:], ldq, vt2[ktemp*ldvt2:], ldvt2, 1, vt, ldvt)
+	}
+
+	ktemp = ctot[0]
+	nrp1 := nr + sqre
+	if ktemp > 0 {
+		for i := 0; i < k; i++ {
+			q[i*ldq+ktemp] = q[i*ldq]
+		}
+		for i := nlp1; i < m; i++ {
+			vt2[ktemp*ldvt2+i] = vt2[i]
+		}
+	}
+	ctemp := 1 + ctot[1] + ctot[2]
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, k, nrp1, ctemp, 1, q[ktemp:], ldq, vt2[ktemp*ldvt2+nlp1:], ldvt2, 0, vt[nlp1:], ldvt)
+	return true
+}
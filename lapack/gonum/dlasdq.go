@@ -0,0 +1,194 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dlasdq computes the singular value decomposition of a real upper or lower
+// bidiagonal matrix B with diagonal d and off-diagonal e. B is n×n if sqre is
+// 0, and n×(n+1) if uplo == blas.Upper and sqre is 1, or (n+1)×n if
+// uplo == blas.Lower and sqre is 1.
+//
+// The SVD of B is
+//  B = Q * S * P^T
+// where S is a diagonal matrix of singular values, Q is an orthogonal matrix of
+// left singular vectors, and P is an orthogonal matrix of right singular
+// vectors.
+//
+// d must have length at least n and e must have length at least n-1+sqre. On
+// return, d contains the singular values of B in ascending order and e has been
+// destroyed.
+//
+// VT is a matrix of size (n+sqre)×ncvt whose elements are stored in vt. On
+// return, VT has been overwritten by P^T * VT. VT is not used if ncvt == 0.
+//
+// U is a matrix of size nru×(n+sqre) whose elements are stored in u. On
+// return, U has been overwritten by U * Q. U is not used if nru == 0.
+//
+// C is a matrix of size (n+sqre)×ncc whose elements are stored in c. On
+// return, C has been overwritten by Q^T * C. C is not used if ncc == 0.
+//
+// work must have length at least 4*n.
+//
+// Dlasdq returns whether the decomposition was successful.
+//
+// Dlasdq is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlasdq(uplo blas.Uplo, sqre, n, ncvt, nru, ncc int, d, e, vt []float64, ldvt int, u []float64, ldu int, c []float64, ldc int, work []float64) (ok bool) {
+	np1 := n + 1
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case sqre != 0 && sqre != 1:
+		panic(badSqre)
+	case n < 0:
+		panic(nLT0)
+	case ncvt < 0:
+		panic(ncvtLT0)
+	case nru < 0:
+		panic(nruLT0)
+	case ncc < 0:
+		panic(nccLT0)
+	case ldvt < max(1, ncvt):
+		panic(badLdVT)
+	case ldu < 1, nru > 0 && ldu < n+sqre:
+		panic(badLdU)
+	case ldc < max(1, ncc):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1+sqre:
+		panic(shortE)
+	case ncvt > 0 && len(vt) < (n+sqre-1)*ldvt+ncvt:
+		panic(shortVT)
+	case nru > 0 && len(u) < (nru-1)*ldu+n+sqre:
+		panic(shortU)
+	case ncc > 0 && len(c) < (n+sqre-1)*ldc+ncc:
+		panic(shortC)
+	case len(work) < 4*n:
+		panic(shortWork)
+	}
+
+	// rotate is true if any singular vectors are desired.
+	rotate := ncvt > 0 || nru > 0 || ncc > 0
+	lower := uplo == blas.Lower
+	sqre1 := sqre
+
+	// If matrix non-square upper bidiagonal, rotate to be lower bidiagonal.
+	// The rotations are on the right.
+	if !lower && sqre1 == 1 {
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if rotate {
+				work[i] = cs
+				work[n+i] = sn
+			}
+		}
+		cs, sn, r := impl.Dlartg(d[n-1], e[n-1])
+		d[n-1] = r
+		e[n-1] = 0
+		if rotate {
+			work[n-1] = cs
+			work[2*n-1] = sn
+		}
+		lower = true
+		sqre1 = 0
+
+		// Update singular vectors if desired.
+		if ncvt > 0 {
+			impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, np1, ncvt, work[:n], work[n:], vt, ldvt)
+		}
+	}
+
+	// If matrix lower bidiagonal, rotate to be upper bidiagonal by applying
+	// Givens rotations on the left.
+	if lower {
+		for i := 0; i < n-1; i++ {
+			cs, sn, r := impl.Dlartg(d[i], e[i])
+			d[i] = r
+			e[i] = sn * d[i+1]
+			d[i+1] *= cs
+			if rotate {
+				work[i] = cs
+				work[n+i] = sn
+			}
+		}
+
+		// If matrix (n+1)×n lower bidiagonal, one additional rotation is
+		// needed.
+		if sqre1 == 1 {
+			cs, sn, r := impl.Dlartg(d[n-1], e[n-1])
+			d[n-1] = r
+			if rotate {
+				work[n-1] = cs
+				work[2*n-1] = sn
+			}
+		}
+
+		// Update singular vectors if desired.
+		if nru > 0 {
+			if sqre1 == 0 {
+				impl.Dlasr(blas.Right, lapack.Variable, lapack.Forward, nru, n, work[:n], work[n:], u, ldu)
+			} else {
+				impl.Dlasr(blas.Right, lapack.Variable, lapack.Forward, nru, np1, work[:n], work[n:], u, ldu)
+			}
+		}
+		if ncc > 0 {
+			if sqre1 == 0 {
+				impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, n, ncc, work[:n], work[n:], c, ldc)
+			} else {
+				impl.Dlasr(blas.Left, lapack.Variable, lapack.Forward, np1, ncc, work[:n], work[n:], c, ldc)
+			}
+		}
+	}
+
+	// Call Dbdsqr to compute the SVD of the reduced real n×n upper
+	// bidiagonal matrix.
+	ok = impl.Dbdsqr(blas.Upper, n, ncvt, nru, ncc, d, e, vt, ldvt, u, ldu, c, ldc, work)
+
+	// Sort the singular values into ascending order (insertion sort on
+	// singular values, but only one transposition per singular vector).
+	bi := blas64.Implementation()
+	for i := 0; i < n; i++ {
+		// Scan for smallest d[i].
+		isub := i
+		smin := d[i]
+		for j := i + 1; j < n; j++ {
+			if d[j] < smin {
+				isub = j
+				smin = d[j]
+			}
+		}
+		if isub != i {
+			// Swap singular values and vectors.
+			d[isub] = d[i]
+			d[i] = smin
+			if ncvt > 0 {
+				bi.Dswap(ncvt, vt[isub*ldvt:], 1, vt[i*ldvt:], 1)
+			}
+			if nru > 0 {
+				bi.Dswap(nru, u[isub:], ldu, u[i:], ldu)
+			}
+			if ncc > 0 {
+				bi.Dswap(ncc, c[isub*ldc:], 1, c[i*ldc:], 1)
+			}
+		}
+	}
+	return ok
+}
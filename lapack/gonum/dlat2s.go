@@ -0,0 +1,32 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// dlat2s converts the upper or lower triangle of the n×n float64 matrix A to
+// the float32 matrix SA. It returns false if an element of the triangle is
+// outside the range of float32, in which case the conversion is not
+// completed.
+func dlat2s(uplo blas.Uplo, n int, a []float64, lda int, sa []float32, ldsa int) (ok bool) {
+	for i := 0; i < n; i++ {
+		j0, j1 := i, n
+		if uplo == blas.Lower {
+			j0, j1 = 0, i+1
+		}
+		for j := j0; j < j1; j++ {
+			v := a[i*lda+j]
+			if v < -math.MaxFloat32 || math.MaxFloat32 < v {
+				return false
+			}
+			sa[i*ldsa+j] = float32(v)
+		}
+	}
+	return true
+}
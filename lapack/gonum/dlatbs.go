@@ -0,0 +1,384 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dlatbs solves a triangular banded system of equations scaled to prevent
+// overflow. It solves
+//  A * x = scale * b if trans == blas.NoTrans
+//  A^T * x = scale * b if trans == blas.Trans
+// where the scale s is set for numeric stability.
+//
+// A is an n×n triangular band matrix with kd super-diagonals if uplo is
+// blas.Upper or kd sub-diagonals if uplo is blas.Lower, stored in ab in the
+// same band format as used by Dpbtf2. On entry, the slice x contains the
+// values of b, and on exit it contains the solution vector x.
+//
+// If normin == true, cnorm is an input and cnorm[j] contains the norm of the off-diagonal
+// part of the j^th column of A. If trans == blas.NoTrans, cnorm[j] must be greater
+// than or equal to the infinity norm, and greater than or equal to the one-norm
+// otherwise. If normin == false, then cnorm is treated as an output, and is set
+// to contain the 1-norm of the off-diagonal part of the j^th column of A.
+//
+// Dlatbs is an internal routine. It is exported for testing purposes.
+func (Implementation) Dlatbs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, normin bool, n, kd int, ab []float64, ldab int, x []float64, cnorm []float64) (scale float64) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case diag != blas.Unit && diag != blas.NonUnit:
+		panic(badDiag)
+	case n < 0:
+		panic(nLT0)
+	case kd < 0:
+		panic(kdLT0)
+	case ldab < kd+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kd+1:
+		panic(shortAB)
+	case len(x) < n:
+		panic(shortX)
+	case len(cnorm) < n:
+		panic(shortCNorm)
+	}
+
+	upper := uplo == blas.Upper
+	nonUnit := diag == blas.NonUnit
+
+	smlnum := dlamchS / dlamchP
+	bignum := 1 / smlnum
+	scale = 1
+
+	bi := blas64.Implementation()
+
+	// The diagonal of A is stored in the first column of ab if A is upper
+	// triangular and in column kd otherwise. The off-diagonal part of the
+	// j-th column of A has length offLen(j) and is stored starting at
+	// ab[offStart(j)] with stride ldab-1.
+	kdiag := 0
+	if !upper {
+		kdiag = kd
+	}
+	offLen := func(j int) int {
+		if upper {
+			return min(kd, j)
+		}
+		return min(kd, n-j-1)
+	}
+	offStart := func(j int) int {
+		if upper {
+			jlen := min(kd, j)
+			return (j-jlen)*ldab + jlen
+		}
+		return (j+1)*ldab + kd - 1
+	}
+	// xoff returns the index of the first element of x that pairs with the
+	// off-diagonal part of the j-th column of A.
+	xoff := func(j int) int {
+		if upper {
+			return j - min(kd, j)
+		}
+		return j + 1
+	}
+	inc := max(1, ldab-1)
+
+	if !normin {
+		for j := 0; j < n; j++ {
+			cnorm[j] = 0
+			if jlen := offLen(j); jlen > 0 {
+				cnorm[j] = bi.Dasum(jlen, ab[offStart(j):], inc)
+			}
+		}
+	}
+	// Scale the column norms by tscal if the maximum element in cnorm is greater than bignum.
+	imax := bi.Idamax(n, cnorm, 1)
+	tmax := cnorm[imax]
+	var tscal float64
+	if tmax <= bignum {
+		tscal = 1
+	} else {
+		tscal = 1 / (smlnum * tmax)
+		bi.Dscal(n, tscal, cnorm, 1)
+	}
+
+	// Compute a bound on the computed solution vector to see if bi.Dtrsv can be used.
+	j := bi.Idamax(n, x, 1)
+	xmax := math.Abs(x[j])
+	xbnd := xmax
+	var grow float64
+	var jfirst, jlast, jinc int
+	if trans == blas.NoTrans {
+		if upper {
+			jfirst = n - 1
+			jlast = -1
+			jinc = -1
+		} else {
+			jfirst = 0
+			jlast = n
+			jinc = 1
+		}
+		// Compute the growth in A * x = b.
+		if tscal != 1 {
+			grow = 0
+			goto Solve
+		}
+		if nonUnit {
+			grow = 1 / math.Max(xbnd, smlnum)
+			xbnd = grow
+			for j := jfirst; j != jlast; j += jinc {
+				if grow <= smlnum {
+					goto Solve
+				}
+				tjj := math.Abs(ab[j*ldab+kdiag])
+				xbnd = math.Min(xbnd, math.Min(1, tjj)*grow)
+				if tjj+cnorm[j] >= smlnum {
+					grow *= tjj / (tjj + cnorm[j])
+				} else {
+					grow = 0
+				}
+			}
+			grow = xbnd
+		} else {
+			grow = math.Min(1, 1/math.Max(xbnd, smlnum))
+			for j := jfirst; j != jlast; j += jinc {
+				if grow <= smlnum {
+					goto Solve
+				}
+				grow *= 1 / (1 + cnorm[j])
+			}
+		}
+	} else {
+		if upper {
+			jfirst = 0
+			jlast = n
+			jinc = 1
+		} else {
+			jfirst = n - 1
+			jlast = -1
+			jinc = -1
+		}
+		if tscal != 1 {
+			grow = 0
+			goto Solve
+		}
+		if nonUnit {
+			grow = 1 / (math.Max(xbnd, smlnum))
+			xbnd = grow
+			for j := jfirst; j != jlast; j += jinc {
+				if grow <= smlnum {
+					goto Solve
+				}
+				xj := 1 + cnorm[j]
+				grow = math.Min(grow, xbnd/xj)
+				tjj := math.Abs(ab[j*ldab+kdiag])
+				if xj > tjj {
+					xbnd *= tjj / xj
+				}
+			}
+			grow = math.Min(grow, xbnd)
+		} else {
+			grow = math.Min(1, 1/math.Max(xbnd, smlnum))
+			for j := jfirst; j != jlast; j += jinc {
+				if grow <= smlnum {
+					goto Solve
+				}
+				xj := 1 + cnorm[j]
+				grow /= xj
+			}
+		}
+	}
+
+Solve:
+	if grow*tscal > smlnum {
+		// Use the Level 2 BLAS solve if the reciprocal of the bound on
+		// elements of X is not too small.
+		bi.Dtbsv(uplo, trans, diag, n, kd, ab, ldab, x, 1)
+		if tscal != 1 {
+			bi.Dscal(n, 1/tscal, cnorm, 1)
+		}
+		return scale
+	}
+
+	// Use a Level 1 BLAS solve, scaling intermediate results.
+	if xmax > bignum {
+		scale = bignum / xmax
+		bi.Dscal(n, scale, x, 1)
+		xmax = bignum
+	}
+	if trans == blas.NoTrans {
+		for j := jfirst; j != jlast; j += jinc {
+			xj := math.Abs(x[j])
+			var tjj, tjjs float64
+			if nonUnit {
+				tjjs = ab[j*ldab+kdiag] * tscal
+			} else {
+				tjjs = tscal
+				if tscal == 1 {
+					goto Skip1
+				}
+			}
+			tjj = math.Abs(tjjs)
+			if tjj > smlnum {
+				if tjj < 1 {
+					if xj > tjj*bignum {
+						rec := 1 / xj
+						bi.Dscal(n, rec, x, 1)
+						scale *= rec
+						xmax *= rec
+					}
+				}
+				x[j] /= tjjs
+				xj = math.Abs(x[j])
+			} else if tjj > 0 {
+				if xj > tjj*bignum {
+					rec := (tjj * bignum) / xj
+					if cnorm[j] > 1 {
+						rec /= cnorm[j]
+					}
+					bi.Dscal(n, rec, x, 1)
+					scale *= rec
+					xmax *= rec
+				}
+				x[j] /= tjjs
+				xj = math.Abs(x[j])
+			} else {
+				for i := 0; i < n; i++ {
+					x[i] = 0
+				}
+				x[j] = 1
+				xj = 1
+				scale = 0
+				xmax = 0
+			}
+		Skip1:
+			if xj > 1 {
+				rec := 1 / xj
+				if cnorm[j] > (bignum-xmax)*rec {
+					rec *= 0.5
+					bi.Dscal(n, rec, x, 1)
+					scale *= rec
+				}
+			} else if xj*cnorm[j] > bignum-xmax {
+				bi.Dscal(n, 0.5, x, 1)
+				scale *= 0.5
+			}
+			if jlen := offLen(j); jlen > 0 {
+				bi.Daxpy(jlen, -x[j]*tscal, ab[offStart(j):], inc, x[xoff(j):], 1)
+			}
+			if upper {
+				if j > 0 {
+					i := bi.Idamax(j, x, 1)
+					xmax = math.Abs(x[i])
+				}
+			} else {
+				if j < n-1 {
+					i := j + 1 + bi.Idamax(n-j-1, x[j+1:], 1)
+					xmax = math.Abs(x[i])
+				}
+			}
+		}
+	} else {
+		for j := jfirst; j != jlast; j += jinc {
+			xj := math.Abs(x[j])
+			uscal := tscal
+			rec := 1 / math.Max(xmax, 1)
+			var tjjs float64
+			if cnorm[j] > (bignum-xj)*rec {
+				rec *= 0.5
+				if nonUnit {
+					tjjs = ab[j*ldab+kdiag] * tscal
+				} else {
+					tjjs = tscal
+				}
+				tjj := math.Abs(tjjs)
+				if tjj > 1 {
+					rec = math.Min(1, rec*tjj)
+					uscal /= tjjs
+				}
+				if rec < 1 {
+					bi.Dscal(n, rec, x, 1)
+					scale *= rec
+					xmax *= rec
+				}
+			}
+			var sumj float64
+			if jlen := offLen(j); jlen > 0 {
+				off := offStart(j)
+				xo := xoff(j)
+				if uscal == 1 {
+					sumj = bi.Ddot(jlen, ab[off:], inc, x[xo:], 1)
+				} else {
+					for i := 0; i < jlen; i++ {
+						sumj += (ab[off+i*inc] * uscal) * x[xo+i]
+					}
+				}
+			}
+			if uscal == tscal {
+				x[j] -= sumj
+				xj := math.Abs(x[j])
+				var tjjs float64
+				if nonUnit {
+					tjjs = ab[j*ldab+kdiag] * tscal
+				} else {
+					tjjs = tscal
+					if tscal == 1 {
+						goto Skip2
+					}
+				}
+				tjj := math.Abs(tjjs)
+				if tjj > smlnum {
+					if tjj < 1 {
+						if xj > tjj*bignum {
+							rec = 1 / xj
+							bi.Dscal(n, rec, x, 1)
+							scale *= rec
+							xmax *= rec
+						}
+					}
+					x[j] /= tjjs
+				} else if tjj > 0 {
+					if xj > tjj*bignum {
+						rec = (tjj * bignum) / xj
+						bi.Dscal(n, rec, x, 1)
+						scale *= rec
+						xmax *= rec
+					}
+					x[j] /= tjjs
+				} else {
+					for i := 0; i < n; i++ {
+						x[i] = 0
+					}
+					x[j] = 1
+					scale = 0
+					xmax = 0
+				}
+			} else {
+				x[j] = x[j]/tjjs - sumj
+			}
+		Skip2:
+			xmax = math.Max(xmax, math.Abs(x[j]))
+		}
+	}
+	scale /= tscal
+	if tscal != 1 {
+		bi.Dscal(n, 1/tscal, cnorm, 1)
+	}
+	return scale
+}
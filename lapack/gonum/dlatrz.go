@@ -0,0 +1,69 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dlatrz reduces the m×n upper trapezoidal matrix
+//  [ A1 A2 ] = [ A[0:m,0:n-l] A[0:m,n-l:n] ]
+// to upper triangular form by means of orthogonal transformations. A1 is an
+// m×(n-l) upper triangular matrix and A2 is an m×l matrix. The upper
+// trapezoidal matrix is factorized as
+//  [ A1 A2 ] = [ R 0 ] * Z,
+// where Z is an n×n orthogonal matrix and R is an m×m upper triangular matrix.
+// See Dtzrzf for the representation of Z. This is the unblocked version of the
+// algorithm.
+//
+// On return, the upper triangle of A[0:m,0:m] contains R and A[0:m,n-l:n]
+// together with tau represent Z.
+//
+// tau must have length at least m and work must have length at least m,
+// otherwise Dlatrz will panic.
+//
+// Dlatrz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dlatrz(m, n, l int, a []float64, lda int, tau, work []float64) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < m:
+		panic(nLTM)
+	case l < 0:
+		panic(lLT0)
+	case l > n-m:
+		panic(badL)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < m:
+		panic(shortTau)
+	case len(work) < m:
+		panic(shortWork)
+	}
+
+	if m == n {
+		for i := range tau[:m] {
+			tau[i] = 0
+		}
+		return
+	}
+
+	for i := m - 1; i >= 0; i-- {
+		// Generate elementary reflector H_i to annihilate
+		//  [ A[i,i] A[i,n-l:n] ].
+		a[i*lda+i], tau[i] = impl.Dlarfg(l+1, a[i*lda+i], a[i*lda+n-l:], 1)
+
+		// Apply H_i to A[0:i,i:n] from the right.
+		impl.Dlarz(blas.Right, i, n-i, l, a[i*lda+n-l:], 1, tau[i], a[i:], lda, work)
+	}
+}
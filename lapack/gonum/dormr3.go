@@ -0,0 +1,100 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dormr3 multiplies an m×n matrix C by the orthogonal matrix Z defined by the
+// reflectors returned by Dtzrzf. It computes
+//  C = Z * C    if side == blas.Left and trans == blas.NoTrans,
+//  C = Z^T * C  if side == blas.Left and trans == blas.Trans,
+//  C = C * Z    if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Z^T  if side == blas.Right and trans == blas.Trans,
+// where
+//  Z = Z_0 * Z_1 * ... * Z_{k-1}
+// is of order m if side == blas.Left and of order n if side == blas.Right.
+// This is the unblocked version of the algorithm.
+//
+// The k elementary reflectors are stored in the last l columns of the rows of
+// a as returned by Dtzrzf. a must have k rows and m columns if
+// side == blas.Left and n columns if side == blas.Right. tau contains the
+// scalar factors of the reflectors and must have length at least k.
+//
+// work must have length at least n if side == blas.Left and at least m if
+// side == blas.Right, otherwise Dormr3 will panic.
+//
+// Dormr3 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dormr3(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case l < 0:
+		panic(lLT0)
+	case left && l > m:
+		panic(lGTM)
+	case !left && l > n:
+		panic(lGTN)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (k-1)*lda+nq:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(work) < nw:
+		panic(shortWork)
+	}
+
+	ja := nq - l
+	if left == (trans == blas.Trans) {
+		for i := 0; i < k; i++ {
+			if left {
+				// Z_i or Z_i^T is applied to C[i:m,0:n].
+				impl.Dlarz(side, m-i, n, l, a[i*lda+ja:], 1, tau[i], c[i*ldc:], ldc, work)
+			} else {
+				// Z_i or Z_i^T is applied to C[0:m,i:n].
+				impl.Dlarz(side, m, n-i, l, a[i*lda+ja:], 1, tau[i], c[i:], ldc, work)
+			}
+		}
+		return
+	}
+	for i := k - 1; i >= 0; i-- {
+		if left {
+			impl.Dlarz(side, m-i, n, l, a[i*lda+ja:], 1, tau[i], c[i*ldc:], ldc, work)
+		} else {
+			impl.Dlarz(side, m, n-i, l, a[i*lda+ja:], 1, tau[i], c[i:], ldc, work)
+		}
+	}
+}
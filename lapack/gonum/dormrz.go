@@ -0,0 +1,154 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dormrz multiplies an m×n matrix C by the orthogonal matrix Z defined by the
+// reflectors returned by Dtzrzf. It computes
+//  C = Z * C    if side == blas.Left and trans == blas.NoTrans,
+//  C = Z^T * C  if side == blas.Left and trans == blas.Trans,
+//  C = C * Z    if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Z^T  if side == blas.Right and trans == blas.Trans,
+// where
+//  Z = Z_0 * Z_1 * ... * Z_{k-1}
+// is of order m if side == blas.Left and of order n if side == blas.Right.
+//
+// The k elementary reflectors are stored in the last l columns of the rows of
+// a as returned by Dtzrzf. a must have k rows and m columns if
+// side == blas.Left and n columns if side == blas.Right. tau contains the
+// scalar factors of the reflectors and must have length at least k.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n if
+// side == blas.Left and at least m if side == blas.Right, otherwise Dormrz will
+// panic. For optimal performance lwork should be larger. If lwork == -1,
+// instead of performing Dormrz, the optimal work length will be stored into
+// work[0].
+func (impl Implementation) Dormrz(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case left && k > m:
+		panic(kGTM)
+	case !left && k > n:
+		panic(kGTN)
+	case l < 0:
+		panic(lLT0)
+	case left && l > m:
+		panic(lGTM)
+	case !left && l > n:
+		panic(lGTN)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		work[0] = 1
+		return
+	}
+
+	const (
+		nbmax = 64
+		ldt   = nbmax
+		tsize = nbmax * ldt
+	)
+	opts := string(side) + string(trans)
+	nb := min(nbmax, impl.Ilaenv(1, "DORMRQ", opts, m, n, k, -1))
+	lworkopt := nw*nb + tsize
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return
+	}
+
+	switch {
+	case len(a) < (k-1)*lda+nq:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	nbmin := 2
+	if 1 < nb && nb < k {
+		iws := nw*nb + tsize
+		if lwork < iws {
+			nb = (lwork - tsize) / nw
+			nbmin = max(2, impl.Ilaenv(2, "DORMRQ", opts, m, n, k, -1))
+		}
+	}
+	if nb < nbmin || k <= nb {
+		// Call unblocked code.
+		impl.Dormr3(side, trans, m, n, k, l, a, lda, tau, c, ldc, work)
+		work[0] = float64(lworkopt)
+		return
+	}
+
+	t := work[:tsize]
+	wrk := work[tsize:]
+	ldwrk := nb
+
+	transt := blas.NoTrans
+	if trans == blas.NoTrans {
+		transt = blas.Trans
+	}
+
+	ja := nq - l
+	apply := func(i, ib int) {
+		// Form the triangular factor of the block reflector
+		//  H = H_{i+ib-1} * ... * H_{i+1} * H_i.
+		impl.Dlarzt(lapack.Backward, lapack.RowWise, l, ib, a[i*lda+ja:], lda, tau[i:], t, ldt)
+		if left {
+			// Apply H or H^T to C[i:m,0:n].
+			impl.Dlarzb(side, transt, lapack.Backward, lapack.RowWise, m-i, n, ib, l,
+				a[i*lda+ja:], lda,
+				t, ldt,
+				c[i*ldc:], ldc,
+				wrk, ldwrk)
+		} else {
+			// Apply H or H^T to C[0:m,i:n].
+			impl.Dlarzb(side, transt, lapack.Backward, lapack.RowWise, m, n-i, ib, l,
+				a[i*lda+ja:], lda,
+				t, ldt,
+				c[i:], ldc,
+				wrk, ldwrk)
+		}
+	}
+	if left == (trans == blas.Trans) {
+		for i := 0; i < k; i += nb {
+			apply(i, min(nb, k-i))
+		}
+	} else {
+		for i := ((k - 1) / nb) * nb; i >= 0; i -= nb {
+			apply(i, min(nb, k-i))
+		}
+	}
+	work[0] = float64(lworkopt)
+}
@@ -0,0 +1,126 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dormtr multiplies an m×n general matrix C with an nq×nq orthogonal matrix Q
+//  Q * C,    if side == blas.Left and trans == blas.NoTrans,
+//  Q^T * C,  if side == blas.Left and trans == blas.Trans,
+//  C * Q,    if side == blas.Right and trans == blas.NoTrans,
+//  C * Q^T,  if side == blas.Right and trans == blas.Trans,
+// where nq == m if side == blas.Left and nq == n if side == blas.Right.
+//
+// Q is defined implicitly as the product of nq-1 elementary reflectors, as
+// returned by Dsytrd:
+//  Q = H_{nq-2} * ... * H_1 * H_0  if uplo == blas.Upper,
+//  Q = H_0 * H_1 * ... * H_{nq-2}  if uplo == blas.Lower.
+// uplo must have the same value as in the previous call of Dsytrd.
+//
+// a and lda represent an m×m matrix if side == blas.Left and an n×n matrix if
+// side == blas.Right. The matrix contains vectors which define the elementary
+// reflectors, as returned by Dsytrd.
+//
+// tau contains the scalar factors of the elementary reflectors, as returned by
+// Dsytrd. tau must have length at least nq-1.
+//
+// c and ldc represent the m×n matrix C. On return, c is overwritten by the
+// product with Q.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,n), if side == blas.Left, and max(1,m), if side == blas.Right. For
+// optimum performance lwork should be at least n*nb if side == blas.Left and
+// m*nb if side == blas.Right, where nb is the optimal block size. On return,
+// work[0] will contain the optimal value of lwork.
+//
+// If lwork == -1, instead of performing Dormtr, only the optimal value of lwork
+// will be stored in work[0].
+//
+// If any requirement on input sizes is not met, Dormtr will panic.
+//
+// Dormtr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dormtr(side blas.Side, uplo blas.Uplo, trans blas.Transpose, m, n int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	left := side == blas.Left
+	nq := n // The order of Q.
+	nw := m // The minimum length of work.
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(badSide)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(badTrans)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, nq):
+		panic(badLdA)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		work[0] = 1
+		return
+	}
+
+	// The reflectors are applied to the trailing or leading (nq-1)×(nq-1)
+	// part of Q.
+	mi, ni := m, n
+	if left {
+		mi--
+	} else {
+		ni--
+	}
+	opts := string(side) + string(trans)
+	var nb int
+	if uplo == blas.Upper {
+		nb = impl.Ilaenv(1, "DORMQL", opts, mi, ni, nq-1, -1)
+	} else {
+		nb = impl.Ilaenv(1, "DORMQR", opts, mi, ni, nq-1, -1)
+	}
+	lworkopt := max(1, nw) * nb
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return
+	}
+
+	if nq == 1 {
+		work[0] = 1
+		return
+	}
+
+	switch {
+	case len(a) < (nq-1)*lda+nq:
+		panic(shortA)
+	case len(tau) < nq-1:
+		panic(shortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	if uplo == blas.Upper {
+		// Q was determined by a call to Dsytrd with uplo == blas.Upper.
+		impl.Dormql(side, trans, mi, ni, nq-1, a[1:], lda, tau[:nq-1], c, ldc, work, lwork)
+	} else {
+		// Q was determined by a call to Dsytrd with uplo == blas.Lower.
+		if left {
+			impl.Dormqr(side, trans, mi, ni, nq-1, a[lda:], lda, tau[:nq-1], c[ldc:], ldc, work, lwork)
+		} else {
+			impl.Dormqr(side, trans, mi, ni, nq-1, a[lda:], lda, tau[:nq-1], c[1:], ldc, work, lwork)
+		}
+	}
+	work[0] = float64(lworkopt)
+}
@@ -14,6 +14,12 @@ import (
 // and a = U^T U is stored in place into a. If ul == blas.Lower, then a = L L^T
 // is computed and stored in-place into a. If a is not positive definite, false
 // is returned. This is the blocked version of the algorithm.
+//
+// If more than one worker is available, a large matrix is partitioned into
+// square tiles and factorized by a task-based algorithm in which the
+// factorization, triangular solve and update of independent tiles are computed
+// concurrently. See Implementation for how the number of workers and the
+// block size are chosen.
 func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok bool) {
 	switch {
 	case ul != blas.Upper && ul != blas.Lower:
@@ -37,6 +43,10 @@ func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok
 	if nb <= 1 || n <= nb {
 		return impl.Dpotf2(ul, n, a, lda)
 	}
+	nw := impl.Ilaenv(7, "DPOTRF", string(ul), n, -1, -1, -1)
+	if nw > 1 && (n+nb-1)/nb >= minParTiles {
+		return impl.dpotrfTiled(ul, n, a, lda, nb, nw)
+	}
 	bi := blas64.Implementation()
 	if ul == blas.Upper {
 		for j := 0; j < n; j += nb {
@@ -79,3 +89,100 @@ func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok
 	}
 	return true
 }
+
+// dpotrfTiled computes the Cholesky factorization of a by partitioning it into
+// nb×nb tiles and executing the tile operations concurrently using at most
+// workers goroutines.
+func (impl Implementation) dpotrfTiled(ul blas.Uplo, n int, a []float64, lda int, nb, workers int) (ok bool) {
+	bi := serialBLAS
+	nt := (n + nb - 1) / nb
+	tile := func(i, j int) int { return i*nt + j }
+
+	// Tasks are prioritized by the step at which their result is next used,
+	// so that the factorization of the next tile column is not delayed by
+	// the updates of the trailing tiles.
+	g := newTaskGraph()
+	for k := 0; k < nt; k++ {
+		k0 := k * nb
+		kb := min(nb, n-k0)
+		akk := a[k0*lda+k0:]
+		g.add(k, func() bool {
+			return impl.Dpotf2(ul, kb, akk, lda)
+		}, nil, []int{tile(k, k)})
+
+		for i := k + 1; i < nt; i++ {
+			i0 := i * nb
+			ib := min(nb, n-i0)
+			if ul == blas.Upper {
+				// Compute U_ki = U_kk^-T * A_ki.
+				aki := a[k0*lda+i0:]
+				g.add(k, func() bool {
+					bi.Dtrsm(blas.Left, blas.Upper, blas.Trans, blas.NonUnit, kb, ib,
+						1, akk, lda,
+						aki, lda)
+					return true
+				}, []int{tile(k, k)}, []int{tile(k, i)})
+			} else {
+				// Compute L_ik = A_ik * L_kk^-T.
+				aik := a[i0*lda+k0:]
+				g.add(k, func() bool {
+					bi.Dtrsm(blas.Right, blas.Lower, blas.Trans, blas.NonUnit, ib, kb,
+						1, akk, lda,
+						aik, lda)
+					return true
+				}, []int{tile(k, k)}, []int{tile(i, k)})
+			}
+		}
+
+		for j := k + 1; j < nt; j++ {
+			j0 := j * nb
+			jb := min(nb, n-j0)
+			for i := j; i < nt; i++ {
+				i0 := i * nb
+				ib := min(nb, n-i0)
+				if ul == blas.Upper {
+					// Update A_ji -= U_kj^T * U_ki.
+					akj := a[k0*lda+j0:]
+					aki := a[k0*lda+i0:]
+					aji := a[j0*lda+i0:]
+					if i == j {
+						g.add(j, func() bool {
+							bi.Dsyrk(blas.Upper, blas.Trans, ib, kb,
+								-1, aki, lda,
+								1, aji, lda)
+							return true
+						}, []int{tile(k, i)}, []int{tile(i, i)})
+						continue
+					}
+					g.add(j, func() bool {
+						bi.Dgemm(blas.Trans, blas.NoTrans, jb, ib, kb,
+							-1, akj, lda, aki, lda,
+							1, aji, lda)
+						return true
+					}, []int{tile(k, j), tile(k, i)}, []int{tile(j, i)})
+					continue
+				}
+				// Update A_ij -= L_ik * L_jk^T.
+				aik := a[i0*lda+k0:]
+				ajk := a[j0*lda+k0:]
+				aij := a[i0*lda+j0:]
+				if i == j {
+					g.add(j, func() bool {
+						bi.Dsyrk(blas.Lower, blas.NoTrans, ib, kb,
+							-1, aik, lda,
+							1, aij, lda)
+						return true
+					}, []int{tile(i, k)}, []int{tile(i, i)})
+					continue
+				}
+				g.add(j, func() bool {
+					bi.Dgemm(blas.NoTrans, blas.Trans, ib, jb, kb,
+						-1, aik, lda, ajk, lda,
+						1, aij, lda)
+					return true
+				}, []int{tile(i, k), tile(j, k)}, []int{tile(i, j)})
+			}
+		}
+	}
+	return g.run(workers)
+}
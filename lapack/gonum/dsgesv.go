@@ -0,0 +1,193 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsgesv computes the solution to a system of linear equations
+//  A * X = B,
+// where A is an n×n matrix and X and B are n×nrhs matrices, using mixed
+// precision iterative refinement.
+//
+// The LU factorization with partial pivoting of A is computed in single
+// precision and used to compute an approximate solution, which is then
+// refined using residuals computed in double precision until it is accurate
+// to double precision. Since the factorization dominates the cost for large
+// n, this is typically faster than Dgetrf and Dgetrs, while giving a solution
+// of the same accuracy when A is not too ill-conditioned.
+//
+// If A or a residual cannot be represented in single precision, the
+// reciprocal condition number of A estimated from the single precision
+// factorization is too small for the refinement to converge, or the
+// refinement does not converge, Dsgesv falls back to computing the LU
+// factorization and the solution in double precision.
+//
+// The returned value iter is the number of refinement iterations if the
+// mixed precision solution succeeded. Otherwise iter is negative and
+// indicates the reason for the fall back:
+//  -2: an element of A, B or a residual is outside the range of float32,
+//  -3: the single precision factorization of A is exactly singular,
+//  -4: the estimated condition number of A is too large,
+//  -31: the refinement did not converge within 30 iterations.
+// If iter >= 0, a is not modified and ipiv contains the pivot indices of the
+// single precision factorization. If iter < 0, a and ipiv contain the LU
+// factorization of A computed by Dgetrf.
+//
+// On return, x contains the n×nrhs solution matrix X. b is not modified.
+// Dsgesv returns whether the solution was computed. The solution is not
+// computed if the double precision factorization of A is exactly singular.
+//
+// ipiv must have length n, work must have length at least n*nrhs, swork must
+// have length at least n*(n+nrhs+4) and iwork must have length at least n, and
+// Dsgesv will panic otherwise.
+func (impl Implementation) Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iwork []int) (iter int, ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < n*nrhs:
+		panic(shortWork)
+	case len(swork) < n*(n+nrhs+4):
+		panic(shortSWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	iter = impl.dsgesvRefine(n, nrhs, a, lda, ipiv, b, ldb, x, ldx, work, swork, iwork)
+	if iter >= 0 {
+		return iter, true
+	}
+
+	// Compute the solution in double precision.
+	impl.Dlacpy(blas.All, n, nrhs, b, ldb, x, ldx)
+	if !impl.Dgetrf(n, n, a, lda, ipiv) {
+		return iter, false
+	}
+	impl.Dgetrs(blas.NoTrans, n, nrhs, a, lda, ipiv, x, ldx)
+	return iter, true
+}
+
+// dsgesvRefine computes the solution of A * X = B by iterative refinement
+// using a single precision LU factorization of A. It returns the number of
+// iterations on success and the negative status described in Dsgesv on
+// failure.
+func (impl Implementation) dsgesvRefine(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iwork []int) int {
+	const (
+		itermax = 30
+		bwdmax  = 1.0
+	)
+	bi := blas64.Implementation()
+
+	anrm := impl.Dlange(lapack.MaxRowSum, n, n, a, lda, nil)
+	cte := anrm * dlamchE * math.Sqrt(float64(n)) * bwdmax
+
+	// The single precision factorization of A is stored in sa and the
+	// single precision right-hand sides and corrections in sx.
+	sa := swork[:n*n]
+	sx := swork[n*n : n*(n+nrhs)]
+	ldr := nrhs
+	r := work[:n*nrhs]
+
+	if !dlag2s(n, n, a, lda, sa, n) {
+		return -2
+	}
+	if !impl.Sgetrf(n, n, sa, n, ipiv) {
+		return -3
+	}
+	rcond := impl.Sgecon(lapack.MaxRowSum, n, sa, n, float32(anrm), swork[n*(n+nrhs):], iwork)
+	if rcond < minRcondMixed*slamchE {
+		return -4
+	}
+	if !dlag2s(n, nrhs, b, ldb, sx, nrhs) {
+		return -2
+	}
+
+	// Compute the initial solution X and its residual R = B - A*X.
+	impl.Sgetrs(blas.NoTrans, n, nrhs, sa, n, ipiv, sx, nrhs)
+	slag2d(n, nrhs, sx, nrhs, x, ldx)
+	impl.Dlacpy(blas.All, n, nrhs, b, ldb, r, ldr)
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, n, nrhs, n,
+		-1, a, lda, x, ldx,
+		1, r, ldr)
+	if mixedConverged(n, nrhs, x, ldx, r, ldr, cte) {
+		return 0
+	}
+
+	for iter := 1; iter <= itermax; iter++ {
+		// Solve A * C = R for the correction C in single precision and
+		// update X += C.
+		if !dlag2s(n, nrhs, r, ldr, sx, nrhs) {
+			return -2
+		}
+		impl.Sgetrs(blas.NoTrans, n, nrhs, sa, n, ipiv, sx, nrhs)
+		for i := 0; i < n; i++ {
+			for j, v := range sx[i*nrhs : i*nrhs+nrhs] {
+				x[i*ldx+j] += float64(v)
+			}
+		}
+
+		impl.Dlacpy(blas.All, n, nrhs, b, ldb, r, ldr)
+		bi.Dgemm(blas.NoTrans, blas.NoTrans, n, nrhs, n,
+			-1, a, lda, x, ldx,
+			1, r, ldr)
+		if mixedConverged(n, nrhs, x, ldx, r, ldr, cte) {
+			return iter
+		}
+	}
+	return -itermax - 1
+}
+
+// minRcondMixed is the smallest reciprocal condition number, relative to the
+// single precision machine epsilon, for which the mixed precision solvers
+// attempt iterative refinement. Each refinement iteration reduces the error
+// by a factor of roughly κ(A)*eps, so refinement of more ill-conditioned
+// matrices converges too slowly to be worthwhile.
+const minRcondMixed = 16
+
+// mixedConverged returns whether each column of the n×nrhs residual matrix R
+// is small compared to the corresponding column of the solution X, that is
+// whether
+//  max_i |R_ij| <= cte * max_i |X_ij|
+// for all j.
+func mixedConverged(n, nrhs int, x []float64, ldx int, r []float64, ldr int, cte float64) bool {
+	bi := blas64.Implementation()
+	for j := 0; j < nrhs; j++ {
+		xnrm := math.Abs(x[bi.Idamax(n, x[j:], ldx)*ldx+j])
+		rnrm := math.Abs(r[bi.Idamax(n, r[j:], ldr)*ldr+j])
+		if rnrm > xnrm*cte {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,168 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsposv computes the solution to a system of linear equations
+//  A * X = B,
+// where A is an n×n symmetric positive definite matrix and X and B are n×nrhs
+// matrices, using mixed precision iterative refinement.
+//
+// The Cholesky factorization of A is computed in single precision and used
+// to compute an approximate solution, which is then refined using residuals
+// computed in double precision until it is accurate to double precision.
+// Only the upper or lower triangle of A is referenced, as specified by uplo.
+//
+// If A or a residual cannot be represented in single precision, A is not
+// positive definite in single precision, the reciprocal condition number of A
+// estimated from the single precision factorization is too small for the
+// refinement to converge, or the refinement does not converge, Dsposv falls
+// back to computing the Cholesky factorization and the solution in double
+// precision.
+//
+// The returned value iter is the number of refinement iterations if the
+// mixed precision solution succeeded. Otherwise iter is negative and
+// indicates the reason for the fall back:
+//  -2: an element of A, B or a residual is outside the range of float32,
+//  -3: the single precision factorization of A failed,
+//  -4: the estimated condition number of A is too large,
+//  -31: the refinement did not converge within 30 iterations.
+// If iter >= 0, a is not modified. If iter < 0, the triangle of a specified by
+// uplo contains the Cholesky factorization of A computed by Dpotrf.
+//
+// On return, x contains the n×nrhs solution matrix X. b is not modified.
+// Dsposv returns whether the solution was computed. The solution is not
+// computed if A is not positive definite.
+//
+// work must have length at least n*nrhs, swork must have length at least
+// n*(n+nrhs+3) and iwork must have length at least n, and Dsposv will panic
+// otherwise.
+func (impl Implementation) Dsposv(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iwork []int) (iter int, ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	case ldx < max(1, nrhs):
+		panic(badLdX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(shortX)
+	case len(work) < n*nrhs:
+		panic(shortWork)
+	case len(swork) < n*(n+nrhs+3):
+		panic(shortSWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	}
+
+	iter = impl.dsposvRefine(uplo, n, nrhs, a, lda, b, ldb, x, ldx, work, swork, iwork)
+	if iter >= 0 {
+		return iter, true
+	}
+
+	// Compute the solution in double precision.
+	impl.Dlacpy(blas.All, n, nrhs, b, ldb, x, ldx)
+	if !impl.Dpotrf(uplo, n, a, lda) {
+		return iter, false
+	}
+	impl.Dpotrs(uplo, n, nrhs, a, lda, x, ldx)
+	return iter, true
+}
+
+// dsposvRefine computes the solution of A * X = B by iterative refinement
+// using a single precision Cholesky factorization of A. It returns the number
+// of iterations on success and the negative status described in Dsposv on
+// failure.
+func (impl Implementation) dsposvRefine(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iwork []int) int {
+	const (
+		itermax = 30
+		bwdmax  = 1.0
+	)
+	bi := blas64.Implementation()
+
+	// work has length at least n, which is sufficient for Dlansy.
+	anrm := impl.Dlansy(lapack.MaxRowSum, uplo, n, a, lda, work)
+	cte := anrm * dlamchE * math.Sqrt(float64(n)) * bwdmax
+
+	// The single precision factorization of A is stored in sa and the
+	// single precision right-hand sides and corrections in sx.
+	sa := swork[:n*n]
+	sx := swork[n*n : n*(n+nrhs)]
+	ldr := nrhs
+	r := work[:n*nrhs]
+
+	if !dlat2s(uplo, n, a, lda, sa, n) {
+		return -2
+	}
+	if !impl.Spotrf(uplo, n, sa, n) {
+		return -3
+	}
+	rcond := impl.Spocon(uplo, n, sa, n, float32(anrm), swork[n*(n+nrhs):], iwork)
+	if rcond < minRcondMixed*slamchE {
+		return -4
+	}
+	if !dlag2s(n, nrhs, b, ldb, sx, nrhs) {
+		return -2
+	}
+
+	// Compute the initial solution X and its residual R = B - A*X.
+	impl.Spotrs(uplo, n, nrhs, sa, n, sx, nrhs)
+	slag2d(n, nrhs, sx, nrhs, x, ldx)
+	impl.Dlacpy(blas.All, n, nrhs, b, ldb, r, ldr)
+	bi.Dsymm(blas.Left, uplo, n, nrhs,
+		-1, a, lda, x, ldx,
+		1, r, ldr)
+	if mixedConverged(n, nrhs, x, ldx, r, ldr, cte) {
+		return 0
+	}
+
+	for iter := 1; iter <= itermax; iter++ {
+		// Solve A * C = R for the correction C in single precision and
+		// update X += C.
+		if !dlag2s(n, nrhs, r, ldr, sx, nrhs) {
+			return -2
+		}
+		impl.Spotrs(uplo, n, nrhs, sa, n, sx, nrhs)
+		for i := 0; i < n; i++ {
+			for j, v := range sx[i*nrhs : i*nrhs+nrhs] {
+				x[i*ldx+j] += float64(v)
+			}
+		}
+
+		impl.Dlacpy(blas.All, n, nrhs, b, ldb, r, ldr)
+		bi.Dsymm(blas.Left, uplo, n, nrhs,
+			-1, a, lda, x, ldx,
+			1, r, ldr)
+		if mixedConverged(n, nrhs, x, ldx, r, ldr, cte) {
+			return iter
+		}
+	}
+	return -itermax - 1
+}
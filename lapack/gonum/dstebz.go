@@ -0,0 +1,405 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dstebz computes the eigenvalues of an n×n symmetric tridiagonal matrix T
+// using bisection. The diagonal elements of T are stored in d and the
+// off-diagonal elements in e.
+//
+// rng specifies which eigenvalues are computed:
+//  lapack.EVRangeAll:   all eigenvalues,
+//  lapack.EVRangeValue: the eigenvalues in the half-open interval (vl, vu],
+//  lapack.EVRangeIndex: the il-th through iu-th eigenvalues, counted from the
+//                       smallest starting at zero.
+// vl and vu are only referenced if rng is lapack.EVRangeValue in which case it
+// must hold that vl < vu. il and iu are only referenced if rng is
+// lapack.EVRangeIndex in which case it must hold that 0 <= il <= iu < n if
+// n > 0, and il = 0, iu = -1 if n == 0.
+//
+// abstol is the absolute tolerance for the eigenvalues. An eigenvalue or
+// cluster is considered to be located if it has been determined to lie in an
+// interval whose width is abstol or less. If abstol is not positive, then
+// ulp*|T| will be used, where |T| is the 1-norm of T. Eigenvalues will be
+// computed most accurately when abstol is set to twice the underflow threshold
+// 2*dlamch('S'), not zero.
+//
+// If byBlock is true, the eigenvalues are ordered from smallest to largest
+// within each diagonal block of T, and the blocks are ordered from top to
+// bottom. Otherwise the eigenvalues of the entire matrix are ordered from
+// smallest to largest.
+//
+// On return, m is the number of eigenvalues found and they are stored in
+// w[:m]. iblock[i] is the index of the diagonal block of T containing the
+// eigenvalue w[i], and nsplit is the number of diagonal blocks in T. The k-th
+// block consists of the rows and columns isplit[k-1]+1 through isplit[k] of T
+// where isplit[-1] is taken to be -1.
+//
+// d must have length n, e must have length at least n-1, w, iblock and isplit
+// must have length at least n, work must have length at least 4*n and iwork
+// must have length at least 3*n, otherwise Dstebz will panic.
+//
+// Dstebz returns ok == false if some eigenvalues failed to converge or if, in
+// case rng is lapack.EVRangeIndex, it was not possible to isolate exactly the
+// requested eigenvalues because of multiple eigenvalues at the ends of the
+// range. In either case the computed eigenvalues are still returned in w.
+//
+// Dstebz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstebz(rng lapack.EVRange, byBlock bool, n int, vl, vu float64, il, iu int, abstol float64, d, e, w []float64, iblock, isplit []int, work []float64, iwork []int) (m, nsplit int, ok bool) {
+	switch {
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case n < 0:
+		panic(nLT0)
+	case rng == lapack.EVRangeValue && vu <= vl:
+		panic(vuLEvl)
+	case rng == lapack.EVRangeIndex && (il < 0 || il > max(0, n-1)):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || iu > n-1):
+		panic(badIu)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, 0, true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(w) < n:
+		panic(shortW)
+	case len(iblock) < n:
+		panic(shortIblock)
+	case len(isplit) < n:
+		panic(shortIsplit)
+	case len(work) < 4*n:
+		panic(shortWork)
+	case len(iwork) < 3*n:
+		panic(shortIWork)
+	}
+
+	const (
+		fudge  = 2.1
+		relfac = 2.0
+	)
+
+	if rng == lapack.EVRangeIndex && il == 0 && iu == n-1 {
+		rng = lapack.EVRangeAll
+	}
+
+	safmin := dlamchS
+	ulp := dlamchP
+	rtoli := ulp * relfac
+
+	// Special case when n == 1.
+	if n == 1 {
+		isplit[0] = 0
+		if rng == lapack.EVRangeValue && (vl >= d[0] || vu < d[0]) {
+			return 0, 1, true
+		}
+		w[0] = d[0]
+		iblock[0] = 0
+		return 1, 1, true
+	}
+
+	// Compute the squares of the off-diagonal elements in work[:n-1] and
+	// find the splitting points of T.
+	e2 := work[:n-1]
+	pivmin := 1.0
+	for j := 1; j < n; j++ {
+		tmp := e[j-1] * e[j-1]
+		if math.Abs(d[j]*d[j-1])*ulp*ulp+safmin > tmp {
+			isplit[nsplit] = j - 1
+			nsplit++
+			e2[j-1] = 0
+		} else {
+			e2[j-1] = tmp
+			pivmin = math.Max(pivmin, tmp)
+		}
+	}
+	isplit[nsplit] = n - 1
+	nsplit++
+	pivmin *= safmin
+
+	// count returns the number of eigenvalues of T[i0:i1+1,i0:i1+1] that
+	// are not greater than x using the Sturm sequence of T - x*I.
+	count := func(i0, i1 int, x float64) int {
+		var cnt int
+		tmp := d[i0] - x
+		if math.Abs(tmp) < pivmin {
+			tmp = -pivmin
+		}
+		if tmp <= 0 {
+			cnt++
+		}
+		for j := i0 + 1; j <= i1; j++ {
+			tmp = d[j] - e2[j-1]/tmp - x
+			if math.Abs(tmp) < pivmin {
+				tmp = -pivmin
+			}
+			if tmp <= 0 {
+				cnt++
+			}
+		}
+		return cnt
+	}
+
+	// gershgorin returns an interval containing the eigenvalues of
+	// T[i0:i1+1,i0:i1+1] enlarged to account for rounding errors.
+	gershgorin := func(i0, i1 int) (gl, gu float64) {
+		gl = d[i0]
+		gu = d[i0]
+		var tmp1 float64
+		for j := i0; j < i1; j++ {
+			tmp2 := math.Sqrt(e2[j])
+			gu = math.Max(gu, d[j]+tmp1+tmp2)
+			gl = math.Min(gl, d[j]-tmp1-tmp2)
+			tmp1 = tmp2
+		}
+		gu = math.Max(gu, d[i1]+tmp1)
+		gl = math.Min(gl, d[i1]-tmp1)
+		tnorm := math.Max(math.Abs(gl), math.Abs(gu))
+		bnd := fudge * tnorm * ulp * float64(i1-i0+1)
+		return gl - bnd - 2*fudge*pivmin, gu + bnd + fudge*pivmin
+	}
+
+	// bisect refines the interval [a,b] that satisfies
+	// count(i0,i1,a) <= c < count(i0,i1,b) until its width is below the
+	// tolerance or count(i0,i1,x) == c for some x in the interval. It
+	// returns the final interval and the eigenvalue counts at its end
+	// points.
+	bisect := func(i0, i1 int, a, b float64, na, nb, c int, atoli float64, itmax int) (float64, float64, int, int, bool) {
+		for it := 0; it <= itmax; it++ {
+			tol := math.Max(math.Max(atoli, pivmin), rtoli*math.Max(math.Abs(a), math.Abs(b)))
+			if b-a < tol {
+				return a, b, na, nb, true
+			}
+			x := 0.5 * (a + b)
+			nx := count(i0, i1, x)
+			switch {
+			case nx == c:
+				return x, x, nx, nx, true
+			case nx < c:
+				a, na = x, nx
+			default:
+				b, nb = x, nx
+			}
+		}
+		return a, b, na, nb, false
+	}
+
+	// Compute the interval (wl, wu] containing the wanted eigenvalues.
+	var (
+		wl, wu   float64
+		nwl, nwu int
+		atoli    float64
+	)
+	ok = true
+	switch rng {
+	case lapack.EVRangeValue:
+		wl = vl
+		wu = vu
+		atoli = abstol
+	case lapack.EVRangeIndex:
+		gl, gu := gershgorin(0, n-1)
+		tnorm := math.Max(math.Abs(gl), math.Abs(gu))
+		itmax := int((math.Log(tnorm+pivmin)-math.Log(pivmin))/math.Log(2)) + 2
+		if abstol <= 0 {
+			atoli = ulp * tnorm
+		} else {
+			atoli = abstol
+		}
+		var okl, oku bool
+		wl, _, nwl, _, okl = bisect(0, n-1, gl, gu, 0, n, il, atoli, itmax)
+		_, wu, _, nwu, oku = bisect(0, n-1, gl, gu, 0, n, iu+1, atoli, itmax)
+		if !okl || !oku || nwl < 0 || nwl >= n || nwu < 1 || nwu > n {
+			return 0, nsplit, false
+		}
+	}
+
+	// Find the eigenvalues of each block of T.
+	var (
+		lo   = work[n : 2*n]
+		hi   = work[2*n : 3*n]
+		nlo  = iwork[:n]
+		nhi  = iwork[n : 2*n]
+		iter = iwork[2*n : 3*n]
+	)
+	nwl, nwu = 0, 0
+	iend := -1
+	for jb := 0; jb < nsplit; jb++ {
+		ibegin := iend + 1
+		iend = isplit[jb]
+		in := iend - ibegin + 1
+
+		if in == 1 {
+			// Special case for a block of size 1.
+			if rng == lapack.EVRangeAll || wl >= d[ibegin]-pivmin {
+				nwl++
+			}
+			if rng == lapack.EVRangeAll || wu >= d[ibegin]-pivmin {
+				nwu++
+			}
+			if rng == lapack.EVRangeAll || (wl < d[ibegin]-pivmin && wu >= d[ibegin]-pivmin) {
+				w[m] = d[ibegin]
+				iblock[m] = jb
+				m++
+			}
+			continue
+		}
+
+		gl, gu := gershgorin(ibegin, iend)
+		tnorm := math.Max(math.Abs(gl), math.Abs(gu))
+		if abstol <= 0 {
+			atoli = ulp * tnorm
+		} else {
+			atoli = abstol
+		}
+		if rng != lapack.EVRangeAll {
+			if gu < wl {
+				nwl += in
+				nwu += in
+				continue
+			}
+			gl = math.Max(gl, wl)
+			gu = math.Min(gu, wu)
+			if gl >= gu {
+				continue
+			}
+		}
+		itmax := int((math.Log(tnorm+pivmin)-math.Log(pivmin))/math.Log(2)) + 2
+
+		// Count the eigenvalues of the block in [gl, gu).
+		ngl := count(ibegin, iend, gl)
+		ngu := count(ibegin, iend, gu)
+		nwl += ngl
+		nwu += ngu
+		if ngl >= ngu {
+			continue
+		}
+
+		// Bisect the interval [gl, gu) splitting it into subintervals
+		// until each contains a single eigenvalue or is narrower than
+		// the tolerance. The intervals still to be processed are kept
+		// in a stack which never holds more than in entries because
+		// they are disjoint and each contains at least one eigenvalue.
+		lo[0], hi[0] = gl, gu
+		nlo[0], nhi[0] = ngl, ngu
+		iter[0] = 0
+		top := 1
+		for top > 0 {
+			top--
+			a, b := lo[top], hi[top]
+			na, nb := nlo[top], nhi[top]
+			it := iter[top]
+			for {
+				tol := math.Max(math.Max(atoli, pivmin), rtoli*math.Max(math.Abs(a), math.Abs(b)))
+				if b-a < tol || it > itmax {
+					if it > itmax {
+						ok = false
+					}
+					// Accept the midpoint for all eigenvalues in
+					// the interval.
+					x := 0.5 * (a + b)
+					for k := na; k < nb; k++ {
+						w[m+k-ngl] = x
+						iblock[m+k-ngl] = jb
+					}
+					break
+				}
+				it++
+				x := 0.5 * (a + b)
+				nx := count(ibegin, iend, x)
+				nx = max(na, min(nb, nx))
+				switch nx {
+				case na:
+					a = x
+				case nb:
+					b = x
+				default:
+					// Both halves contain eigenvalues. Push the
+					// upper half onto the stack and continue
+					// with the lower half.
+					lo[top], hi[top] = x, b
+					nlo[top], nhi[top] = nx, nb
+					iter[top] = it
+					top++
+					b, nb = x, nx
+				}
+			}
+		}
+		m += ngu - ngl
+	}
+
+	// If rng is lapack.EVRangeIndex, remove the unwanted eigenvalues at the
+	// ends of the interval (wl, wu].
+	if rng == lapack.EVRangeIndex {
+		idiscl := il - nwl
+		idiscu := nwu - (iu + 1)
+		if idiscl < 0 || idiscu < 0 {
+			ok = false
+		}
+		if idiscl > 0 || idiscu > 0 {
+			for ; idiscl > 0; idiscl-- {
+				jdisc := -1
+				for j := 0; j < m; j++ {
+					if iblock[j] >= 0 && (jdisc < 0 || w[j] < w[jdisc]) {
+						jdisc = j
+					}
+				}
+				if jdisc < 0 {
+					break
+				}
+				iblock[jdisc] = -1
+			}
+			for ; idiscu > 0; idiscu-- {
+				jdisc := -1
+				for j := 0; j < m; j++ {
+					if iblock[j] >= 0 && (jdisc < 0 || w[j] >= w[jdisc]) {
+						jdisc = j
+					}
+				}
+				if jdisc < 0 {
+					break
+				}
+				iblock[jdisc] = -1
+			}
+			im := 0
+			for j := 0; j < m; j++ {
+				if iblock[j] >= 0 {
+					w[im] = w[j]
+					iblock[im] = iblock[j]
+					im++
+				}
+			}
+			m = im
+		}
+	}
+
+	// If byBlock is false, sort all eigenvalues into increasing order.
+	if !byBlock && nsplit > 1 {
+		for j := 0; j < m-1; j++ {
+			ie := j
+			for jj := j + 1; jj < m; jj++ {
+				if w[jj] < w[ie] {
+					ie = jj
+				}
+			}
+			if ie != j {
+				w[j], w[ie] = w[ie], w[j]
+				iblock[j], iblock[ie] = iblock[ie], iblock[j]
+			}
+		}
+	}
+	return m, nsplit, ok
+}
@@ -0,0 +1,236 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dstedc computes all eigenvalues and, optionally, eigenvectors of a symmetric
+// tridiagonal matrix using the divide and conquer method. The eigenvectors of a
+// full or band symmetric matrix can also be found if Dsytrd has been used to
+// reduce this matrix to tridiagonal form.
+//
+// compz specifies the computation of eigenvectors:
+//  lapack.EVCompNone: only eigenvalues are computed,
+//  lapack.EVTridiag:  eigenvalues and eigenvectors of the tridiagonal matrix
+//                     are computed,
+//  lapack.EVOrig:     eigenvalues and eigenvectors of the original symmetric
+//                     matrix are computed. On entry, z must contain the
+//                     orthogonal matrix used to reduce the original matrix to
+//                     tridiagonal form.
+// For other values of compz Dstedc will panic.
+//
+// d, on entry, contains the diagonal elements of the tridiagonal matrix. On
+// return, d contains the eigenvalues in ascending order. d must have length n.
+//
+// e, on entry, contains the n-1 off-diagonal elements of the tridiagonal
+// matrix. On return, e has been destroyed.
+//
+// On return, if compz is not lapack.EVCompNone, z contains the orthonormal
+// eigenvectors. z is not referenced if compz == lapack.EVCompNone.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+//  1,                                 if compz == lapack.EVCompNone or n <= 1,
+//  2*(n-1),                           if n <= smlsiz,
+//  1 + 3*n + 2*n*lg(n) + 4*n*n,       if compz == lapack.EVOrig,
+//  1 + 4*n + n*n,                     if compz == lapack.EVTridiag,
+// where smlsiz is the maximum size of the subproblems at the bottom of the
+// computation tree, returned by Ilaenv(9, ...), and lg(n) is the smallest
+// integer k such that 2^k >= n.
+//
+// iwork must have length at least max(1,liwork), and liwork must be at least
+//  1,                            if compz == lapack.EVCompNone or n <= smlsiz,
+//  6 + 6*n + 5*n*lg(n),          if compz == lapack.EVOrig,
+//  3 + 5*n,                      if compz == lapack.EVTridiag.
+//
+// If lwork == -1 or liwork == -1, instead of computing the eigen decomposition,
+// Dstedc only calculates the minimal lengths of work and iwork and stores them
+// in work[0] and iwork[0].
+//
+// Dstedc returns whether all the eigenvalues were found.
+func (impl Implementation) Dstedc(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	wantz := compz != lapack.EVCompNone
+	switch {
+	case compz != lapack.EVCompNone && compz != lapack.EVTridiag && compz != lapack.EVOrig:
+		panic(badEVComp)
+	case n < 0:
+		panic(nLT0)
+	case ldz < 1, wantz && ldz < n:
+		panic(badLdZ)
+	}
+
+	// Compute the workspace requirements.
+	smlsiz := impl.Ilaenv(9, "DSTEDC", " ", 0, 0, 0, 0)
+	var lwmin, liwmin int
+	switch {
+	case n <= 1 || !wantz:
+		lwmin = 1
+		liwmin = 1
+	case n <= smlsiz:
+		lwmin = 2 * (n - 1)
+		liwmin = 1
+	case compz == lapack.EVOrig:
+		lgn := 0
+		for 1<<uint(lgn) < n {
+			lgn++
+		}
+		lwmin = 1 + 3*n + 2*n*lgn + 4*n*n
+		liwmin = 6 + 6*n + 5*n*lgn
+	default:
+		lwmin = 1 + 4*n + n*n
+		liwmin = 3 + 5*n
+	}
+	query := lwork == -1 || liwork == -1
+	switch {
+	case lwork < lwmin && !query:
+		panic(badLWork)
+	case liwork < liwmin && !query:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	if query {
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case wantz && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		if wantz {
+			z[0] = 1
+		}
+		return true
+	}
+
+	// If the eigenvectors are not wanted, use Dsterf to find the
+	// eigenvalues.
+	if !wantz {
+		return impl.Dsterf(n, d, e)
+	}
+
+	// If n is smaller than the minimum divide size (smlsiz+1), then solve
+	// the problem with another solver.
+	if n <= smlsiz {
+		return impl.Dsteqr(compz, n, d, e, z, ldz, work)
+	}
+
+	bi := blas64.Implementation()
+
+	if compz == lapack.EVTridiag {
+		impl.Dlaset(blas.All, n, n, 0, 1, z, ldz)
+	}
+
+	// Scale.
+	orgnrm := impl.Dlanst(lapack.MaxAbs, n, d, e)
+	if orgnrm == 0 {
+		return true
+	}
+
+	eps := dlamchE
+	start := 0
+	for start < n {
+		// Let finish be the position of the next subdiagonal entry such
+		// that e[finish] <= tiny or finish = n-1 if no such subdiagonal
+		// exists. The matrix identified by the elements between start and
+		// finish constitutes an independent sub-problem.
+		finish := start
+		for finish < n-1 {
+			tiny := eps * math.Sqrt(math.Abs(d[finish])) * math.Sqrt(math.Abs(d[finish+1]))
+			if math.Abs(e[finish]) <= tiny {
+				break
+			}
+			finish++
+		}
+
+		// (Sub) problem determined. Compute its size and solve it.
+		m := finish - start + 1
+		if m == 1 {
+			start = finish + 1
+			continue
+		}
+
+		if m > smlsiz {
+			// Scale.
+			orgnrm = impl.Dlanst(lapack.MaxAbs, m, d[start:], e[start:])
+			impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, m, 1, d[start:], 1)
+			impl.Dlascl(lapack.General, 0, 0, orgnrm, 1, m-1, 1, e[start:], 1)
+		}
+
+		if compz == lapack.EVOrig {
+			// Solve the tridiagonal sub-problem in the workspace and
+			// multiply its eigenvectors back into z.
+			if m > smlsiz {
+				ok = impl.Dlaed0(m, d[start:], e[start:], work, m, work[m*m:], iwork)
+			} else {
+				ok = impl.Dsteqr(lapack.EVTridiag, m, d[start:], e[start:], work, m, work[m*m:])
+			}
+			if !ok {
+				return false
+			}
+			impl.Dlacpy(blas.All, n, m, z[start:], ldz, work[m*m:], m)
+			bi.Dgemm(blas.NoTrans, blas.NoTrans, n, m, m, 1, work[m*m:], m, work, m, 0, z[start:], ldz)
+		} else {
+			zsub := z[start*ldz+start:]
+			if m > smlsiz {
+				ok = impl.Dlaed0(m, d[start:], e[start:], zsub, ldz, work, iwork)
+			} else {
+				ok = impl.Dsteqr(lapack.EVTridiag, m, d[start:], e[start:], zsub, ldz, work)
+			}
+			if !ok {
+				return false
+			}
+		}
+
+		if m > smlsiz {
+			// Scale back.
+			impl.Dlascl(lapack.General, 0, 0, 1, orgnrm, m, 1, d[start:], 1)
+		}
+		start = finish + 1
+	}
+
+	// Use selection sort to minimize swaps of eigenvectors.
+	for ii := 1; ii < n; ii++ {
+		i := ii - 1
+		k := i
+		p := d[i]
+		for j := ii; j < n; j++ {
+			if d[j] < p {
+				k = j
+				p = d[j]
+			}
+		}
+		if k != i {
+			d[k] = d[i]
+			d[i] = p
+			bi.Dswap(n, z[i:], ldz, z[k:], ldz)
+		}
+	}
+
+	work[0] = float64(lwmin)
+	iwork[0] = liwmin
+	return true
+}
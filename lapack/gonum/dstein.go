@@ -0,0 +1,232 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dstein computes the eigenvectors of an n×n symmetric tridiagonal matrix T
+// corresponding to specified eigenvalues, using inverse iteration. The
+// diagonal elements of T are stored in d and the off-diagonal elements in e.
+//
+// The m eigenvalues for which the eigenvectors are computed are stored in
+// w[:m]. The eigenvalues must be grouped by the diagonal block of T to which
+// they belong, with the blocks ordered from top to bottom and the eigenvalues
+// ordered from smallest to largest within each block. iblock[i] must contain
+// the index of the block containing w[i] and isplit must describe the splitting
+// of T into blocks, as returned by Dstebz with byBlock == true.
+//
+// On return, the columns of the n×m matrix Z contain the computed eigenvectors
+// normalized to unit length. The eigenvector in the j-th column of Z
+// corresponds to the eigenvalue w[j]. Any vector which fails to converge is set
+// to its current iterate after the maximum number of iterations.
+//
+// d must have length n, e must have length at least n-1, w must have length at
+// least m, iblock must have length at least m, isplit must have length at least
+// the number of blocks of T, z must have length at least (n-1)*ldz+m, work must
+// have length at least 5*n, iwork must have length at least n and ifail must
+// have length at least m, otherwise Dstein will panic.
+//
+// Dstein returns the number of eigenvectors that failed to converge in the
+// maximum number of iterations. Their indices are stored in ifail[:nfail].
+//
+// Dstein is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int, z []float64, ldz int, work []float64, iwork, ifail []int) (nfail int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case m < 0:
+		panic(mLT0)
+	case m > n:
+		panic(mGTN)
+	case ldz < max(1, m):
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 || m == 0 {
+		return 0
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(w) < m:
+		panic(shortW)
+	case len(iblock) < m:
+		panic(shortIblock)
+	case len(isplit) < iblock[m-1]+1:
+		panic(shortIsplit)
+	case len(z) < (n-1)*ldz+m:
+		panic(shortZ)
+	case len(work) < 5*n:
+		panic(shortWork)
+	case len(iwork) < n:
+		panic(shortIWork)
+	case len(ifail) < m:
+		panic(shortIfail)
+	}
+
+	const (
+		// maxits is the maximum number of iterations of inverse
+		// iteration.
+		maxits = 5
+		// extra is the number of additional iterations performed
+		// after the stopping criterion is satisfied.
+		extra = 2
+	)
+
+	bi := blas64.Implementation()
+	eps := dlamchP
+
+	// Split the workspace into the iterate y, copies of the super- and
+	// sub-diagonals and of the diagonal of T that are overwritten by the
+	// LU factorization in Dlagtf, and the second super-diagonal of U.
+	var (
+		y  = work[:n]
+		ub = work[n : 2*n]
+		lc = work[2*n : 3*n]
+		ua = work[3*n : 4*n]
+		ud = work[4*n : 5*n]
+	)
+
+	// Use a simple linear congruential generator for the starting vectors
+	// so that the results are reproducible.
+	var seed uint64 = 1
+
+	j1 := 0
+	for nblk := 0; nblk <= iblock[m-1]; nblk++ {
+		// Find the starting and ending indices of the block nblk.
+		var b1 int
+		if nblk > 0 {
+			b1 = isplit[nblk-1] + 1
+		}
+		bn := isplit[nblk]
+		blksiz := bn - b1 + 1
+
+		var (
+			gpind           int
+			ortol, dtpcrt   float64
+			onenrm, xj, xjm float64
+		)
+		if blksiz > 1 {
+			gpind = j1
+
+			// Compute the reorthogonalization criterion and the
+			// stopping criterion.
+			onenrm = math.Abs(d[b1]) + math.Abs(e[b1])
+			onenrm = math.Max(onenrm, math.Abs(d[bn])+math.Abs(e[bn-1]))
+			for i := b1 + 1; i < bn; i++ {
+				onenrm = math.Max(onenrm, math.Abs(d[i])+math.Abs(e[i-1])+math.Abs(e[i]))
+			}
+			ortol = 1e-3 * onenrm
+			dtpcrt = math.Sqrt(0.1 / float64(blksiz))
+		}
+
+		// Loop through the eigenvalues of the block nblk.
+		jblk := 0
+		j := j1
+		for ; j < m && iblock[j] == nblk; j++ {
+			jblk++
+			xj = w[j]
+
+			if blksiz == 1 {
+				// The eigenvector of a 1×1 block is trivial.
+				y[0] = 1
+			} else {
+				// If eigenvalues j and j-1 are too close, add a
+				// relatively small perturbation.
+				if jblk > 1 {
+					pertol := 10 * math.Abs(eps*xj)
+					if xj-xjm < pertol {
+						xj = xjm + pertol
+					}
+				}
+
+				// Get a random starting vector with elements
+				// uniformly distributed in (-1,1).
+				for i := range y[:blksiz] {
+					seed = (0x5DEECE66D*seed + 0xB) & (1<<48 - 1)
+					y[i] = 2*float64(seed)/(1<<48) - 1
+				}
+
+				// Compute the LU factorization with partial pivoting
+				// of T - xj*I.
+				copy(ua[:blksiz], d[b1:bn+1])
+				copy(ub[:blksiz-1], e[b1:bn])
+				copy(lc[:blksiz-1], e[b1:bn])
+				impl.Dlagtf(blksiz, ua, xj, ub, lc, 0, ud, iwork)
+
+				var (
+					tol       float64
+					converged bool
+					nrmchk    int
+				)
+				for its := 0; its < maxits; its++ {
+					// Normalize and scale the right-hand side vector.
+					jmax := bi.Idamax(blksiz, y, 1)
+					scl := float64(blksiz) * onenrm * math.Max(eps, math.Abs(ua[blksiz-1])) / math.Abs(y[jmax])
+					bi.Dscal(blksiz, scl, y, 1)
+
+					// Solve the system LU = Pb.
+					tol, _ = impl.Dlagts(-1, blksiz, ua, ub, lc, ud, iwork, y, tol)
+
+					// Reorthogonalize by modified Gram-Schmidt if the
+					// eigenvalues are close enough.
+					if jblk > 1 {
+						if math.Abs(xj-xjm) > ortol {
+							gpind = j
+						}
+						for i := gpind; i < j; i++ {
+							ztr := -bi.Ddot(blksiz, y, 1, z[b1*ldz+i:], ldz)
+							bi.Daxpy(blksiz, ztr, z[b1*ldz+i:], ldz, y, 1)
+						}
+					}
+
+					// Check the infinity norm of the iterate and
+					// continue for additional iterations after it
+					// reaches the stopping criterion.
+					jmax = bi.Idamax(blksiz, y, 1)
+					if math.Abs(y[jmax]) < dtpcrt {
+						continue
+					}
+					nrmchk++
+					if nrmchk > extra {
+						converged = true
+						break
+					}
+				}
+				if !converged {
+					ifail[nfail] = j
+					nfail++
+				}
+
+				// Accept the iterate as the j-th eigenvector.
+				scl := 1 / bi.Dnrm2(blksiz, y, 1)
+				jmax := bi.Idamax(blksiz, y, 1)
+				if y[jmax] < 0 {
+					scl = -scl
+				}
+				bi.Dscal(blksiz, scl, y, 1)
+			}
+
+			for i := 0; i < n; i++ {
+				z[i*ldz+j] = 0
+			}
+			bi.Dcopy(blksiz, y, 1, z[b1*ldz+j:], ldz)
+
+			// Save the shift to check the eigenvalue spacing at the
+			// next iteration.
+			xjm = xj
+		}
+		j1 = j
+	}
+	return nfail
+}
@@ -0,0 +1,177 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsyevd computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A. If eigenvectors are desired, it uses a divide and
+// conquer algorithm, which is typically much faster than the QL/QR algorithm
+// used by Dsyev for large matrices.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Dsyevd will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+//  1,               if n <= 1,
+//  2*n + 1,         if jobz == lapack.EVNone,
+//  1 + 6*n + 2*n*n, if jobz == lapack.EVCompute,
+// otherwise Dsyevd will panic.
+//
+// iwork must have length at least max(1,liwork), and liwork must be at least 1
+// if n <= 1 or jobz == lapack.EVNone, and at least 3 + 5*n if
+// jobz == lapack.EVCompute, otherwise Dsyevd will panic.
+//
+// If lwork == -1 or liwork == -1, instead of computing Dsyevd the optimal
+// length of work and the minimal length of iwork are stored into work[0] and
+// iwork[0].
+//
+// Dsyevd returns whether all the eigenvalues were found.
+func (impl Implementation) Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	wantz := jobz == lapack.EVCompute
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	var lwmin, liwmin int
+	switch {
+	case n <= 1:
+		lwmin = 1
+		liwmin = 1
+	case wantz:
+		lwmin = 1 + 6*n + 2*n*n
+		liwmin = 3 + 5*n
+	default:
+		lwmin = 2*n + 1
+		liwmin = 1
+	}
+	query := lwork == -1 || liwork == -1
+	switch {
+	case lwork < lwmin && !query:
+		panic(badLWork)
+	case liwork < liwmin && !query:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	var opts string
+	if uplo == blas.Upper {
+		opts = "U"
+	} else {
+		opts = "L"
+	}
+	nb := impl.Ilaenv(1, "DSYTRD", opts, n, -1, -1, -1)
+	lworkopt := max(lwmin, 2*n+n*nb)
+	if query {
+		work[0] = float64(lworkopt)
+		iwork[0] = liwmin
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	}
+
+	if n == 1 {
+		w[0] = a[0]
+		if wantz {
+			a[0] = 1
+		}
+		return true
+	}
+
+	// Get machine constants.
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Sqrt(bignum)
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := impl.Dlansy(lapack.MaxAbs, uplo, n, a, lda, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Dlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+	}
+
+	inde := 0
+	indtau := inde + n
+	indwrk := indtau + n
+	llwork := lwork - indwrk
+	indwk2 := indwrk + n*n
+	llwrk2 := lwork - indwk2
+
+	// Reduce A to symmetric tridiagonal form.
+	impl.Dsytrd(uplo, n, a, lda, w, work[inde:], work[indtau:], work[indwrk:], llwork)
+
+	// For eigenvalues only, call Dsterf. For eigenvectors, first call
+	// Dstedc to compute the eigenvectors of the tridiagonal matrix, then
+	// multiply them by the orthogonal matrix generated by Dorgtr.
+	if !wantz {
+		ok = impl.Dsterf(n, w, work[inde:])
+	} else {
+		ok = impl.Dstedc(lapack.EVTridiag, n, w, work[inde:], work[indwrk:], n, work[indwk2:], llwrk2, iwork, liwork)
+		if ok {
+			impl.Dorgtr(uplo, n, a, lda, work[indtau:], work[indwk2:], llwrk2)
+			bi := blas64.Implementation()
+			bi.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n, 1, a, lda, work[indwrk:], n, 0, work[indwk2:], n)
+			impl.Dlacpy(blas.All, n, n, work[indwk2:], n, a, lda)
+		}
+	}
+	if !ok {
+		return false
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi := blas64.Implementation()
+		bi.Dscal(n, 1/sigma, w, 1)
+	}
+	work[0] = float64(lworkopt)
+	iwork[0] = liwmin
+	return true
+}
@@ -0,0 +1,273 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsyevr computes selected eigenvalues and, optionally, eigenvectors of an n×n
+// real symmetric matrix A. Eigenvalues and eigenvectors can be selected by
+// specifying either a range of values or a range of indices for the desired
+// eigenvalues.
+//
+// A is first reduced to symmetric tridiagonal form. If all eigenvalues are
+// requested, they are computed using the Pal-Walker-Kahan variant of the QL/QR
+// algorithm and the eigenvectors, if desired, using the divide and conquer
+// method. Otherwise the selected eigenvalues are computed by bisection and the
+// corresponding eigenvectors by inverse iteration, which is typically much
+// faster than computing the full decomposition when only a few eigenpairs are
+// needed.
+//
+// rng specifies which eigenvalues are computed:
+//  lapack.EVRangeAll:   all eigenvalues,
+//  lapack.EVRangeValue: the eigenvalues in the half-open interval (vl, vu],
+//  lapack.EVRangeIndex: the il-th through iu-th eigenvalues, counted from the
+//                       smallest starting at zero.
+// vl and vu are only referenced if rng is lapack.EVRangeValue in which case it
+// must hold that vl < vu. il and iu are only referenced if rng is
+// lapack.EVRangeIndex in which case it must hold that 0 <= il <= iu < n if
+// n > 0, and il = 0, iu = -1 if n == 0.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. On return, the specified triangular region of a is
+// overwritten.
+//
+// abstol is the absolute error tolerance for the eigenvalues. An approximate
+// eigenvalue is accepted as converged when it is determined to lie in an
+// interval of width abstol or less. If abstol is not positive, eps*|T| is used
+// where eps is the machine precision and |T| is the 1-norm of the tridiagonal
+// matrix obtained by reducing A to tridiagonal form. abstol is only used when
+// not all eigenvalues are requested.
+//
+// On return, m is the total number of eigenvalues found and w[:m] contains the
+// selected eigenvalues in ascending order. w must have length at least n.
+//
+// If jobz == lapack.EVCompute, the first m columns of the n×m matrix Z contain
+// on return the orthonormal eigenvectors of A corresponding to the selected
+// eigenvalues, with the i-th column of Z holding the eigenvector associated
+// with w[i]. z must have length at least (n-1)*ldz+ncol and ldz must be at
+// least max(1,ncol), where ncol is iu-il+1 if rng is lapack.EVRangeIndex and n
+// otherwise. If jobz == lapack.EVNone, z is not referenced.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+//  1,               if n <= 1,
+//  1 + 7*n + n*n,   if all eigenvalues and the eigenvectors are computed,
+//  8*n,             otherwise.
+// For optimum performance lwork should be larger. iwork must have length at
+// least max(1,liwork), and liwork must be at least
+//  1,               if n <= 1,
+//  3 + 5*n,         if all eigenvalues and the eigenvectors are computed,
+//  5*n,             otherwise.
+// If lwork == -1 or liwork == -1, instead of computing Dsyevr the optimal length
+// of work and the minimal length of iwork are stored into work[0] and iwork[0].
+//
+// Dsyevr returns whether all the selected eigenvalues and eigenvectors were
+// computed successfully.
+func (impl Implementation) Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	ncol := n
+	if rng == lapack.EVRangeIndex {
+		ncol = iu - il + 1
+	}
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case rng == lapack.EVRangeValue && vu <= vl:
+		panic(vuLEvl)
+	case rng == lapack.EVRangeIndex && (il < 0 || il > max(0, n-1)):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && (iu < min(n-1, il) || iu > n-1):
+		panic(badIu)
+	case wantz && ldz < max(1, ncol):
+		panic(badLdZ)
+	}
+
+	alleig := rng == lapack.EVRangeAll || (rng == lapack.EVRangeIndex && il == 0 && iu == n-1)
+
+	var lwmin, liwmin int
+	switch {
+	case n <= 1:
+		lwmin = 1
+		liwmin = 1
+	case wantz && alleig:
+		lwmin = 1 + 7*n + n*n
+		liwmin = 3 + 5*n
+	default:
+		lwmin = 8 * n
+		liwmin = 5 * n
+	}
+	query := lwork == -1 || liwork == -1
+	switch {
+	case lwork < lwmin && !query:
+		panic(badLWork)
+	case liwork < liwmin && !query:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	var opts string
+	if uplo == blas.Upper {
+		opts = "U"
+	} else {
+		opts = "L"
+	}
+	nb := impl.Ilaenv(1, "DSYTRD", opts, n, -1, -1, -1)
+	nb = max(nb, impl.Ilaenv(1, "DORMTR", opts, n, -1, -1, -1))
+	lworkopt := max(lwmin, 3*n+n*nb)
+	if query {
+		work[0] = float64(lworkopt)
+		iwork[0] = liwmin
+		return 0, true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+ncol:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		if rng == lapack.EVRangeValue && (a[0] <= vl || vu < a[0]) {
+			return 0, true
+		}
+		w[0] = a[0]
+		if wantz {
+			z[0] = 1
+		}
+		return 1, true
+	}
+
+	// Get machine constants.
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Min(math.Sqrt(bignum), 1/math.Sqrt(math.Sqrt(safmin)))
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := impl.Dlansy(lapack.MaxAbs, uplo, n, a, lda, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Dlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+		if abstol > 0 {
+			abstol *= sigma
+		}
+		if rng == lapack.EVRangeValue {
+			vl *= sigma
+			vu *= sigma
+		}
+	}
+
+	indtau := 0
+	indd := indtau + n
+	inde := indd + n
+	indwk := inde + n
+	llwork := lwork - indwk
+	d := work[indd:inde]
+	e := work[inde : indwk-1]
+	tau := work[indtau : indtau+n-1]
+
+	// Reduce A to symmetric tridiagonal form.
+	impl.Dsytrd(uplo, n, a, lda, d, e, tau, work[indwk:], llwork)
+
+	if alleig {
+		// Compute all eigenvalues using Dsterf or Dstedc which
+		// destroy the tridiagonal matrix.
+		m = n
+		copy(w, d)
+		if !wantz {
+			ok = impl.Dsterf(n, w, e)
+		} else {
+			ok = impl.Dstedc(lapack.EVTridiag, n, w, e, z, ldz, work[indwk:], llwork, iwork, liwork)
+			if ok {
+				// Apply the orthogonal matrix used in the reduction
+				// to tridiagonal form to the eigenvectors.
+				impl.Dormtr(blas.Left, uplo, blas.NoTrans, n, n, a, lda, tau, z, ldz, work[indwk:], llwork)
+			}
+		}
+	} else {
+		// Compute the selected eigenvalues by bisection and, if
+		// desired, the corresponding eigenvectors by inverse
+		// iteration.
+		iblock := iwork[:n]
+		isplit := iwork[n : 2*n]
+		m, _, ok = impl.Dstebz(rng, wantz, n, vl, vu, il, iu, abstol, d, e, w, iblock, isplit, work[indwk:], iwork[2*n:])
+		if wantz {
+			nfail := impl.Dstein(n, d, e, m, w, iblock, isplit, z, ldz, work[indwk:], iwork[2*n:3*n], iwork[3*n:4*n])
+			if nfail > 0 {
+				ok = false
+			}
+			// Apply the orthogonal matrix used in the reduction
+			// to tridiagonal form to the eigenvectors.
+			impl.Dormtr(blas.Left, uplo, blas.NoTrans, n, m, a, lda, tau, z, ldz, work[indwk:], llwork)
+		}
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi := blas64.Implementation()
+		bi.Dscal(m, 1/sigma, w, 1)
+	}
+
+	// If eigenvalues are not in order, then sort them along with the
+	// eigenvectors. This can only happen when the eigenvectors were
+	// computed by inverse iteration.
+	if wantz && !alleig {
+		bi := blas64.Implementation()
+		for j := 0; j < m-1; j++ {
+			i := j
+			for jj := j + 1; jj < m; jj++ {
+				if w[jj] < w[i] {
+					i = jj
+				}
+			}
+			if i != j {
+				w[i], w[j] = w[j], w[i]
+				bi.Dswap(n, z[i:], ldz, z[j:], ldz)
+			}
+		}
+	}
+
+	work[0] = float64(lworkopt)
+	iwork[0] = liwmin
+	return m, ok
+}
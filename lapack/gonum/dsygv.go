@@ -0,0 +1,125 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsygv computes all the eigenvalues and, optionally, the eigenvectors of a
+// real generalized symmetric-definite eigenproblem of the form
+//  A*x = λ*B*x  if itype == lapack.GenEVAxBx,
+//  A*B*x = λ*x  if itype == lapack.GenEVABx,
+//  B*A*x = λ*x  if itype == lapack.GenEVBAx,
+// where A and B are n×n symmetric matrices and B is also positive definite.
+//
+// On entry, a and b contain the triangles of A and B specified by uplo. On
+// return, b contains the triangular factor U or L from the Cholesky
+// factorization of B
+//  B = U^T*U  if uplo == blas.Upper,
+//  B = L*L^T  if uplo == blas.Lower.
+//
+// If jobz == lapack.EVCompute, a contains on return the matrix Z of
+// eigenvectors with the i-th column of Z holding the eigenvector associated
+// with w[i]. The eigenvectors are normalized as follows:
+//  Z^T*B*Z = I       if itype == lapack.GenEVAxBx or lapack.GenEVABx,
+//  Z^T*inv(B)*Z = I  if itype == lapack.GenEVBAx.
+// If jobz == lapack.EVNone, the specified triangle of a is destroyed.
+//
+// On return, w contains the eigenvalues in ascending order. w must have length
+// at least n.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,3*n-1). For optimum performance lwork should be larger. If lwork == -1,
+// instead of computing Dsygv the optimal work length is stored into work[0].
+//
+// Dsygv returns whether B is positive definite and the eigenvalue computation
+// converged. If B is not positive definite, the eigenvalues and eigenvectors
+// are not computed.
+func (impl Implementation) Dsygv(itype lapack.GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool) {
+	switch {
+	case itype != lapack.GenEVAxBx && itype != lapack.GenEVABx && itype != lapack.GenEVBAx:
+		panic(badGenEVType)
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case lwork < max(1, 3*n-1) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	var opts string
+	if uplo == blas.Upper {
+		opts = "U"
+	} else {
+		opts = "L"
+	}
+	nb := impl.Ilaenv(1, "DSYTRD", opts, n, -1, -1, -1)
+	lworkopt := max(1, (nb+2)*n)
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(w) < n:
+		panic(shortW)
+	}
+
+	// Form the Cholesky factorization of B.
+	ok = impl.Dpotrf(uplo, n, b, ldb)
+	if !ok {
+		return false
+	}
+
+	// Transform the problem to the standard eigenvalue problem and solve it.
+	impl.Dsygst(itype, uplo, n, a, lda, b, ldb)
+	ok = impl.Dsyev(jobz, uplo, n, a, lda, w, work, lwork)
+
+	if jobz == lapack.EVCompute && ok {
+		// Backtransform the eigenvectors to the eigenvectors of the
+		// original problem.
+		bi := blas64.Implementation()
+		if itype == lapack.GenEVAxBx || itype == lapack.GenEVABx {
+			// For A*x = λ*B*x and A*B*x = λ*x the eigenvectors are
+			//  x = inv(L)^T*y  or  inv(U)*y.
+			trans := blas.NoTrans
+			if uplo == blas.Lower {
+				trans = blas.Trans
+			}
+			bi.Dtrsm(blas.Left, uplo, trans, blas.NonUnit, n, n, 1, b, ldb, a, lda)
+		} else {
+			// For B*A*x = λ*x the eigenvectors are
+			//  x = L*y  or  U^T*y.
+			trans := blas.Trans
+			if uplo == blas.Lower {
+				trans = blas.NoTrans
+			}
+			bi.Dtrmm(blas.Left, uplo, trans, blas.NonUnit, n, n, 1, b, ldb, a, lda)
+		}
+	}
+
+	work[0] = float64(lworkopt)
+	return ok
+}
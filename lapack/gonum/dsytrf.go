@@ -0,0 +1,304 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dsytrf computes the factorization of an n×n symmetric matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The factorization has the form
+//  A = U * D * U^T  if uplo == blas.Upper, or
+//  A = L * D * L^T  if uplo == blas.Lower,
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks. This is the unblocked version of the algorithm.
+//
+// If uplo == blas.Upper, then
+//  U = P(n-1) * U(n-1) * ... * P(k) * U(k) * ...,
+// where k decreases from n-1 to 0 in steps of 1 or 2. P(k) is a permutation
+// matrix as defined by ipiv[k] and U(k) is a unit upper triangular matrix, such
+// that if the diagonal block D(k) is of order s (s = 1 or 2), then
+//          (   I    v    0   )   k-s+1
+//  U(k) =  (   0    I    0   )   s
+//          (   0    0    I   )   n-k-1
+//              k-s+1 s  n-k-1
+// If s == 1, D(k) overwrites a[k*lda+k] and v overwrites the elements of column
+// k of A above the diagonal. If s == 2, the upper triangle of D(k) overwrites
+// a[(k-1)*lda+k-1], a[(k-1)*lda+k] and a[k*lda+k], and v overwrites the
+// elements of columns k-1 and k of A above the block.
+//
+// If uplo == blas.Lower, then
+//  L = P(0) * L(0) * ... * P(k) * L(k) * ...,
+// where k increases from 0 to n-1 in steps of 1 or 2. P(k) is a permutation
+// matrix as defined by ipiv[k] and L(k) is a unit lower triangular matrix, such
+// that if the diagonal block D(k) is of order s (s = 1 or 2), then
+//          (   I    0     0   )  k
+//  L(k) =  (   0    I     0   )  s
+//          (   0    v     I   )  n-k-s
+//              k    s  n-k-s
+// If s == 1, D(k) overwrites a[k*lda+k] and v overwrites the elements of column
+// k of A below the diagonal. If s == 2, the lower triangle of D(k) overwrites
+// a[k*lda+k], a[(k+1)*lda+k] and a[(k+1)*lda+k+1], and v overwrites the
+// elements of columns k and k+1 of A below the block.
+//
+// ipiv contains details of the interchanges and the block structure of D and
+// must have length n, otherwise Dsytrf will panic. If ipiv[k] >= 0, then rows
+// and columns k and ipiv[k] were interchanged and D(k) is a 1×1 diagonal
+// block. If uplo == blas.Upper and ipiv[k] = ipiv[k-1] < 0, then rows and
+// columns k-1 and -ipiv[k]-1 were interchanged and D(k-1:k,k-1:k) is a 2×2
+// diagonal block. If uplo == blas.Lower and ipiv[k] = ipiv[k+1] < 0, then rows
+// and columns k+1 and -ipiv[k]-1 were interchanged and D(k:k+1,k:k+1) is a 2×2
+// diagonal block. ipiv is zero-indexed.
+//
+// Dsytrf returns whether the matrix A is nonsingular. The factorization is
+// always completed even if ok is false, but D is exactly singular in that case
+// and it must not be used to solve a system of equations.
+func (Implementation) Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	bi := blas64.Implementation()
+
+	// Initialize alpha for use in choosing the pivot block size.
+	alpha := (1 + math.Sqrt(17)) / 8
+
+	ok = true
+	if uplo == blas.Upper {
+		// Factorize A as U*D*U^T using the upper triangle of A.
+		// k is the main loop index, decreasing from n-1 to 0 in steps of 1
+		// or 2.
+		for k := n - 1; k >= 0; {
+			kstep := 1
+
+			// Determine rows and columns to be interchanged and whether a
+			// 1×1 or 2×2 pivot block will be used.
+			absakk := math.Abs(a[k*lda+k])
+			// imax is the row index of the largest off-diagonal element in
+			// column k, and colmax is its absolute value.
+			var imax int
+			var colmax float64
+			if k > 0 {
+				imax = bi.Idamax(k, a[k:], lda)
+				colmax = math.Abs(a[imax*lda+k])
+			}
+
+			var kp int
+			if math.Max(absakk, colmax) == 0 || math.IsNaN(absakk) {
+				// Column k is zero or contains a NaN.
+				ok = false
+				kp = k
+			} else {
+				if absakk >= alpha*colmax {
+					// No interchange, use 1×1 pivot block.
+					kp = k
+				} else {
+					// jmax is the column index of the largest off-diagonal
+					// element in row imax, and rowmax is its absolute value.
+					jmax := imax + 1 + bi.Idamax(k-imax, a[imax*lda+imax+1:], 1)
+					rowmax := math.Abs(a[imax*lda+jmax])
+					if imax > 0 {
+						jmax = bi.Idamax(imax, a[imax:], lda)
+						rowmax = math.Max(rowmax, math.Abs(a[jmax*lda+imax]))
+					}
+					switch {
+					case absakk >= alpha*colmax*(colmax/rowmax):
+						// No interchange, use 1×1 pivot block.
+						kp = k
+					case math.Abs(a[imax*lda+imax]) >= alpha*rowmax:
+						// Interchange rows and columns k and imax, use 1×1
+						// pivot block.
+						kp = imax
+					default:
+						// Interchange rows and columns k-1 and imax, use 2×2
+						// pivot block.
+						kp = imax
+						kstep = 2
+					}
+				}
+
+				kk := k - kstep + 1
+				if kp != kk {
+					// Interchange rows and columns kk and kp in the leading
+					// submatrix A[0:k+1,0:k+1].
+					bi.Dswap(kp, a[kk:], lda, a[kp:], lda)
+					bi.Dswap(kk-kp-1, a[(kp+1)*lda+kk:], lda, a[kp*lda+kp+1:], 1)
+					a[kk*lda+kk], a[kp*lda+kp] = a[kp*lda+kp], a[kk*lda+kk]
+					if kstep == 2 {
+						a[(k-1)*lda+k], a[kp*lda+k] = a[kp*lda+k], a[(k-1)*lda+k]
+					}
+				}
+
+				// Update the leading submatrix.
+				if kstep == 1 {
+					// Perform a rank-1 update of A[0:k,0:k] as
+					//  A := A - U(k)*D(k)*U(k)^T = A - W(k)*1/D(k)*W(k)^T
+					// and store U(k) in column k.
+					r1 := 1 / a[k*lda+k]
+					bi.Dsyr(uplo, k, -r1, a[k:], lda, a, lda)
+					bi.Dscal(k, r1, a[k:], lda)
+				} else if k > 1 {
+					// Perform a rank-2 update of A[0:k-1,0:k-1] as
+					//  A := A - ( U(k-1) U(k) )*D(k)*( U(k-1) U(k) )^T
+					//     = A - ( W(k-1) W(k) )*inv(D(k))*( W(k-1) W(k) )^T
+					// and store U(k) and U(k-1) in columns k and k-1.
+					d12 := a[(k-1)*lda+k]
+					d22 := a[(k-1)*lda+k-1] / d12
+					d11 := a[k*lda+k] / d12
+					t := 1 / (d11*d22 - 1)
+					d12 = t / d12
+					for j := k - 2; j >= 0; j-- {
+						wkm1 := d12 * (d11*a[j*lda+k-1] - a[j*lda+k])
+						wk := d12 * (d22*a[j*lda+k] - a[j*lda+k-1])
+						for i := j; i >= 0; i-- {
+							a[i*lda+j] -= a[i*lda+k]*wk + a[i*lda+k-1]*wkm1
+						}
+						a[j*lda+k] = wk
+						a[j*lda+k-1] = wkm1
+					}
+				}
+			}
+
+			// Store details of the interchanges in ipiv.
+			if kstep == 1 {
+				ipiv[k] = kp
+			} else {
+				ipiv[k] = -kp - 1
+				ipiv[k-1] = -kp - 1
+			}
+			k -= kstep
+		}
+		return ok
+	}
+
+	// Factorize A as L*D*L^T using the lower triangle of A.
+	// k is the main loop index, increasing from 0 to n-1 in steps of 1 or 2.
+	for k := 0; k < n; {
+		kstep := 1
+
+		// Determine rows and columns to be interchanged and whether a 1×1
+		// or 2×2 pivot block will be used.
+		absakk := math.Abs(a[k*lda+k])
+		// imax is the row index of the largest off-diagonal element in
+		// column k, and colmax is its absolute value.
+		var imax int
+		var colmax float64
+		if k < n-1 {
+			imax = k + 1 + bi.Idamax(n-k-1, a[(k+1)*lda+k:], lda)
+			colmax = math.Abs(a[imax*lda+k])
+		}
+
+		var kp int
+		if math.Max(absakk, colmax) == 0 || math.IsNaN(absakk) {
+			// Column k is zero or contains a NaN.
+			ok = false
+			kp = k
+		} else {
+			if absakk >= alpha*colmax {
+				// No interchange, use 1×1 pivot block.
+				kp = k
+			} else {
+				// jmax is the column index of the largest off-diagonal
+				// element in row imax, and rowmax is its absolute value.
+				jmax := k + bi.Idamax(imax-k, a[imax*lda+k:], 1)
+				rowmax := math.Abs(a[imax*lda+jmax])
+				if imax < n-1 {
+					jmax = imax + 1 + bi.Idamax(n-imax-1, a[(imax+1)*lda+imax:], lda)
+					rowmax = math.Max(rowmax, math.Abs(a[jmax*lda+imax]))
+				}
+				switch {
+				case absakk >= alpha*colmax*(colmax/rowmax):
+					// No interchange, use 1×1 pivot block.
+					kp = k
+				case math.Abs(a[imax*lda+imax]) >= alpha*rowmax:
+					// Interchange rows and columns k and imax, use 1×1
+					// pivot block.
+					kp = imax
+				default:
+					// Interchange rows and columns k+1 and imax, use 2×2
+					// pivot block.
+					kp = imax
+					kstep = 2
+				}
+			}
+
+			kk := k + kstep - 1
+			if kp != kk {
+				// Interchange rows and columns kk and kp in the trailing
+				// submatrix A[k:n,k:n].
+				if kp < n-1 {
+					bi.Dswap(n-kp-1, a[(kp+1)*lda+kk:], lda, a[(kp+1)*lda+kp:], lda)
+				}
+				bi.Dswap(kp-kk-1, a[(kk+1)*lda+kk:], lda, a[kp*lda+kk+1:], 1)
+				a[kk*lda+kk], a[kp*lda+kp] = a[kp*lda+kp], a[kk*lda+kk]
+				if kstep == 2 {
+					a[(k+1)*lda+k], a[kp*lda+k] = a[kp*lda+k], a[(k+1)*lda+k]
+				}
+			}
+
+			// Update the trailing submatrix.
+			if kstep == 1 {
+				if k < n-1 {
+					// Perform a rank-1 update of A[k+1:n,k+1:n] as
+					//  A := A - L(k)*D(k)*L(k)^T = A - W(k)*(1/D(k))*W(k)^T
+					// and store L(k) in column k.
+					d11 := 1 / a[k*lda+k]
+					bi.Dsyr(uplo, n-k-1, -d11, a[(k+1)*lda+k:], lda, a[(k+1)*lda+k+1:], lda)
+					bi.Dscal(n-k-1, d11, a[(k+1)*lda+k:], lda)
+				}
+			} else if k < n-2 {
+				// Perform a rank-2 update of A[k+2:n,k+2:n] as
+				//  A := A - ( L(k) L(k+1) )*D(k)*( L(k) L(k+1) )^T
+				//     = A - ( W(k) W(k+1) )*inv(D(k))*( W(k) W(k+1) )^T
+				// and store L(k) and L(k+1) in columns k and k+1.
+				d21 := a[(k+1)*lda+k]
+				d11 := a[(k+1)*lda+k+1] / d21
+				d22 := a[k*lda+k] / d21
+				t := 1 / (d11*d22 - 1)
+				d21 = t / d21
+				for j := k + 2; j < n; j++ {
+					wk := d21 * (d11*a[j*lda+k] - a[j*lda+k+1])
+					wkp1 := d21 * (d22*a[j*lda+k+1] - a[j*lda+k])
+					for i := j; i < n; i++ {
+						a[i*lda+j] -= a[i*lda+k]*wk + a[i*lda+k+1]*wkp1
+					}
+					a[j*lda+k] = wk
+					a[j*lda+k+1] = wkp1
+				}
+			}
+		}
+
+		// Store details of the interchanges in ipiv.
+		if kstep == 1 {
+			ipiv[k] = kp
+		} else {
+			ipiv[k] = -kp - 1
+			ipiv[k+1] = -kp - 1
+		}
+		k += kstep
+	}
+	return ok
+}
@@ -0,0 +1,689 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtgevc computes some or all of the right and/or left eigenvectors of a pair
+// of n×n real matrices (S,P), where S is upper quasi-triangular and P is upper
+// triangular. Matrix pairs of this type are produced by the generalized Schur
+// factorization of a real matrix pair (A,B)
+//  A = Q*S*Z^T,  B = Q*P*Z^T,
+// as computed by Dhgeqz.
+//
+// The right eigenvector x and the left eigenvector y of (S,P) corresponding to
+// an eigenvalue λ are defined by
+//  S*x = λ*P*x,
+//  y^H*S = λ*y^H*P,
+// where y^H denotes the conjugate transpose of y.
+//
+// The eigenvalues are not input to this routine, but are computed directly
+// from the diagonal blocks of S and P. It is assumed that the 2×2 diagonal
+// blocks of P corresponding to the 2×2 blocks of S are diagonal, as returned by
+// Dhgeqz.
+//
+// This routine returns the matrices X and/or Y of right and left eigenvectors
+// of (S,P), or the products Z*X and/or Q*Y, where Z and Q are input matrices.
+// If Q and Z are the orthogonal factors from the generalized Schur
+// factorization of a matrix pair (A,B), then Z*X and Q*Y are the matrices of
+// right and left eigenvectors of (A,B).
+//
+// If side == lapack.EVRight, only right eigenvectors will be computed.
+// If side == lapack.EVLeft, only left eigenvectors will be computed.
+// If side == lapack.EVBoth, both right and left eigenvectors will be computed.
+// For other values of side, Dtgevc will panic.
+//
+// If howmny == lapack.EVAll, all right and/or left eigenvectors will be
+// computed.
+// If howmny == lapack.EVAllMulQ, all right and/or left eigenvectors will be
+// computed and multiplied from left by the matrices in VR and/or VL.
+// If howmny == lapack.EVSelected, right and/or left eigenvectors will be
+// computed as indicated by selected.
+// For other values of howmny, Dtgevc will panic.
+//
+// selected specifies which eigenvectors will be computed. It must have length n
+// if howmny == lapack.EVSelected, and it is not referenced otherwise. If λ_j is
+// a real eigenvalue, the corresponding real eigenvector will be computed if
+// selected[j] is true. If λ_j and λ_{j+1} are a complex conjugate pair of
+// eigenvalues, the corresponding complex eigenvector is computed if either
+// selected[j] or selected[j+1] is true.
+//
+// VL and VR are n×mm matrices. If howmny is lapack.EVAll or lapack.EVAllMulQ,
+// mm must be at least n. If howmny is lapack.EVSelected, mm must be large
+// enough to store the selected eigenvectors. Each selected real eigenvector
+// occupies one column and each selected complex eigenvector occupies two
+// columns. If mm is not sufficiently large, Dtgevc will panic.
+//
+// On entry, if howmny is lapack.EVAllMulQ, it is assumed that VL (if side is
+// lapack.EVLeft or lapack.EVBoth) contains an n×n matrix Q, and that VR (if
+// side is lapack.EVRight or lapack.EVBoth) contains an n×n matrix Z. Q and Z
+// are typically the orthogonal matrices of left and right Schur vectors
+// returned by Dhgeqz.
+//
+// On return, if side is lapack.EVLeft or lapack.EVBoth, VL will contain:
+//  if howmny == lapack.EVAll,      the matrix Y of left eigenvectors of (S,P),
+//  if howmny == lapack.EVAllMulQ,  the matrix Q*Y,
+//  if howmny == lapack.EVSelected, the left eigenvectors of (S,P) specified by
+//                                  selected, stored consecutively in the
+//                                  columns of VL, in the same order as their
+//                                  eigenvalues.
+// VL is not referenced if side == lapack.EVRight.
+//
+// On return, if side is lapack.EVRight or lapack.EVBoth, VR will contain:
+//  if howmny == lapack.EVAll,      the matrix X of right eigenvectors of (S,P),
+//  if howmny == lapack.EVAllMulQ,  the matrix Z*X,
+//  if howmny == lapack.EVSelected, the right eigenvectors of (S,P) specified by
+//                                  selected, stored consecutively in the
+//                                  columns of VR, in the same order as their
+//                                  eigenvalues.
+// VR is not referenced if side == lapack.EVLeft.
+//
+// Complex eigenvectors corresponding to a complex eigenvalue are stored in VL
+// and VR in two consecutive columns, the first holding the real part, and the
+// second the imaginary part. The stored eigenvector corresponds to the
+// eigenvalue with the positive imaginary part.
+//
+// Each eigenvector will be normalized so that the element of largest magnitude
+// has magnitude 1. Here the magnitude of a complex number (x,y) is taken to be
+// |x| + |y|.
+//
+// work must have length at least 6*n, otherwise Dtgevc will panic.
+//
+// Dtgevc returns the number of columns in VL and/or VR actually used to store
+// the eigenvectors. ok will be false if a 2×2 diagonal block of (S,P) does not
+// have a pair of complex conjugate eigenvalues, in which case the computation
+// of eigenvectors is terminated.
+//
+// Dtgevc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dtgevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr int, mm int, work []float64) (m int, ok bool) {
+	bothv := side == lapack.EVBoth
+	rightv := side == lapack.EVRight || bothv
+	leftv := side == lapack.EVLeft || bothv
+	switch {
+	case !rightv && !leftv:
+		panic(badEVSide)
+	case howmny != lapack.EVAll && howmny != lapack.EVAllMulQ && howmny != lapack.EVSelected:
+		panic(badEVHowMany)
+	case n < 0:
+		panic(nLT0)
+	case lds < max(1, n):
+		panic(badLdS)
+	case ldp < max(1, n):
+		panic(badLdP)
+	case mm < 0:
+		panic(mmLT0)
+	case ldvl < 1, leftv && ldvl < mm:
+		panic(badLdVL)
+	case ldvr < 1, rightv && ldvr < mm:
+		panic(badLdVR)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(s) < (n-1)*lds+n:
+		panic(shortS)
+	case len(p) < (n-1)*ldp+n:
+		panic(shortP)
+	case len(work) < 6*n:
+		panic(shortWork)
+	}
+
+	if howmny == lapack.EVSelected {
+		if len(selected) != n {
+			panic(badLenSelected)
+		}
+		// Set m to the number of columns required to store the selected
+		// eigenvectors. Each selected real eigenvector occupies one
+		// column and each selected complex eigenvector occupies two
+		// columns.
+		for j := 0; j < n; {
+			if j == n-1 || s[(j+1)*lds+j] == 0 {
+				if selected[j] {
+					m++
+				}
+				j++
+			} else {
+				if selected[j] || selected[j+1] {
+					m += 2
+				}
+				j += 2
+			}
+		}
+	} else {
+		m = n
+	}
+	if mm < m {
+		panic(badMm)
+	}
+
+	// Quick return if no eigenvectors were selected.
+	if m == 0 {
+		return 0, true
+	}
+
+	switch {
+	case leftv && len(vl) < (n-1)*ldvl+mm:
+		panic(shortVL)
+	case rightv && len(vr) < (n-1)*ldvr+mm:
+		panic(shortVR)
+	}
+
+	const safety = 100
+
+	// Machine constants.
+	safmin := dlamchS
+	ulp := dlamchP
+	small := safmin * float64(n) / ulp
+	big := 1 / small
+	bignum := 1 / (safmin * float64(n))
+
+	// Split work into the column norms of S and P, the current eigenvector
+	// stored with interleaved real and imaginary parts, and space for the
+	// back-transformed eigenvector.
+	anorms := work[:n]
+	bnorms := work[n : 2*n]
+	x := work[2*n : 4*n]
+	xback := work[4*n : 6*n]
+
+	// Compute the 1-norm of each column of the strictly upper triangular
+	// part of S and P to check for possible overflow in the triangular
+	// solver.
+	anorm := math.Abs(s[0])
+	if n > 1 {
+		anorm += math.Abs(s[lds])
+	}
+	bnorm := math.Abs(p[0])
+	anorms[0] = 0
+	bnorms[0] = 0
+	for j := 1; j < n; j++ {
+		iend := j
+		if s[j*lds+j-1] != 0 {
+			iend = j - 1
+		}
+		var temp, temp2 float64
+		for i := 0; i < iend; i++ {
+			temp += math.Abs(s[i*lds+j])
+		}
+		for i := 0; i < j; i++ {
+			temp2 += math.Abs(p[i*ldp+j])
+		}
+		anorms[j] = temp
+		bnorms[j] = temp2
+		for i := iend; i < min(j+2, n); i++ {
+			temp += math.Abs(s[i*lds+j])
+		}
+		anorm = math.Max(anorm, temp)
+		bnorm = math.Max(bnorm, temp2+math.Abs(p[j*ldp+j]))
+	}
+	ascale := 1 / math.Max(anorm, safmin)
+	bscale := 1 / math.Max(bnorm, safmin)
+
+	// The eigenvector being computed satisfies
+	//  (a*S - b*P)*x = 0   or   (a*S - b*P)^T*y = 0,
+	// where a is acoef and b is bcoefr + i*bcoefi.
+	var acoef, acoefa, bcoefr, bcoefi, bcoefa float64
+
+	// realCoef computes a and b for the real eigenvalue in position j
+	// scaled to avoid underflow.
+	realCoef := func(j int) {
+		temp := 1 / math.Max(math.Max(math.Abs(s[j*lds+j])*ascale, math.Abs(p[j*ldp+j])*bscale), safmin)
+		salfar := (temp * s[j*lds+j]) * ascale
+		sbeta := (temp * p[j*ldp+j]) * bscale
+		acoef = sbeta * ascale
+		bcoefr = salfar * bscale
+		bcoefi = 0
+
+		// Scale to avoid underflow.
+		scale := 1.0
+		lsa := math.Abs(sbeta) >= safmin && math.Abs(acoef) < small
+		lsb := math.Abs(salfar) >= safmin && math.Abs(bcoefr) < small
+		if lsa {
+			scale = (small / math.Abs(sbeta)) * math.Min(anorm, big)
+		}
+		if lsb {
+			scale = math.Max(scale, (small/math.Abs(salfar))*math.Min(bnorm, big))
+		}
+		if lsa || lsb {
+			scale = math.Min(scale, 1/(safmin*math.Max(1, math.Max(math.Abs(acoef), math.Abs(bcoefr)))))
+			if lsa {
+				acoef = ascale * (scale * sbeta)
+			} else {
+				acoef *= scale
+			}
+			if lsb {
+				bcoefr = bscale * (scale * salfar)
+			} else {
+				bcoefr *= scale
+			}
+		}
+		acoefa = math.Abs(acoef)
+		bcoefa = math.Abs(bcoefr)
+	}
+
+	// complexCoef computes a and b for the complex eigenvalue of the 2×2
+	// block starting in position j scaled to avoid over- and underflow. It
+	// returns false if the block does not have complex eigenvalues.
+	complexCoef := func(j int, left bool) bool {
+		acoef, _, bcoefr, _, bcoefi = impl.Dlag2(s[j*lds+j:], lds, p[j*ldp+j:], ldp, safmin*safety)
+		if left {
+			bcoefi = -bcoefi
+		}
+		if bcoefi == 0 {
+			return false
+		}
+
+		// Scale to avoid over- and underflow.
+		acoefa = math.Abs(acoef)
+		bcoefa = math.Abs(bcoefr) + math.Abs(bcoefi)
+		scale := 1.0
+		if acoefa*ulp < safmin && acoefa >= safmin {
+			scale = (safmin / ulp) / acoefa
+		}
+		if bcoefa*ulp < safmin && bcoefa >= safmin {
+			scale = math.Max(scale, (safmin/ulp)/bcoefa)
+		}
+		if safmin*acoefa > ascale {
+			scale = ascale / (safmin * acoefa)
+		}
+		if safmin*bcoefa > bscale {
+			scale = math.Min(scale, bscale/(safmin*bcoefa))
+		}
+		if scale != 1 {
+			acoef *= scale
+			acoefa = math.Abs(acoef)
+			bcoefr *= scale
+			bcoefi *= scale
+			bcoefa = math.Abs(bcoefr) + math.Abs(bcoefi)
+		}
+		return true
+	}
+
+	// scaleX multiplies the elements [lo:hi] of the current eigenvector
+	// by alpha.
+	scaleX := func(lo, hi int, alpha float64) {
+		for jr := lo; jr < hi; jr++ {
+			x[2*jr] *= alpha
+			x[2*jr+1] *= alpha
+		}
+	}
+
+	bi := blas64.Implementation()
+	ilback := howmny == lapack.EVAllMulQ
+
+	if leftv {
+		// Compute the left eigenvectors.
+		var ieig, nw int
+		for je := 0; je < n; je += nw {
+			ilcplx := je < n-1 && s[(je+1)*lds+je] != 0
+			nw = 1
+			if ilcplx {
+				nw = 2
+			}
+			switch {
+			case howmny != lapack.EVSelected:
+			case ilcplx:
+				if !selected[je] && !selected[je+1] {
+					continue
+				}
+			default:
+				if !selected[je] {
+					continue
+				}
+			}
+
+			if !ilcplx && math.Abs(s[je*lds+je]) <= safmin && math.Abs(p[je*ldp+je]) <= safmin {
+				// Singular matrix pencil, return unit eigenvector.
+				for jr := 0; jr < n; jr++ {
+					vl[jr*ldvl+ieig] = 0
+				}
+				vl[ieig*ldvl+ieig] = 1
+				ieig++
+				continue
+			}
+
+			// Clear the vector.
+			for i := range x {
+				x[i] = 0
+			}
+
+			var xmax float64
+			if !ilcplx {
+				// Real eigenvalue.
+				realCoef(je)
+				// First component is 1.
+				x[2*je] = 1
+				xmax = 1
+			} else {
+				// Complex eigenvalue.
+				if !complexCoef(je, true) {
+					return m, false
+				}
+
+				// Compute the first two components of the
+				// eigenvector.
+				temp := acoef * s[(je+1)*lds+je]
+				temp2r := acoef*s[je*lds+je] - bcoefr*p[je*ldp+je]
+				temp2i := -bcoefi * p[je*ldp+je]
+				if math.Abs(temp) > math.Abs(temp2r)+math.Abs(temp2i) {
+					x[2*je] = 1
+					x[2*je+1] = 0
+					x[2*je+2] = -temp2r / temp
+					x[2*je+3] = -temp2i / temp
+				} else {
+					x[2*je+2] = 1
+					x[2*je+3] = 0
+					temp = acoef * s[je*lds+je+1]
+					x[2*je] = (bcoefr*p[(je+1)*ldp+je+1] - acoef*s[(je+1)*lds+je+1]) / temp
+					x[2*je+1] = bcoefi * p[(je+1)*ldp+je+1] / temp
+				}
+				xmax = math.Max(math.Abs(x[2*je])+math.Abs(x[2*je+1]), math.Abs(x[2*je+2])+math.Abs(x[2*je+3]))
+			}
+			dmin := math.Max(math.Max(ulp*acoefa*anorm, ulp*bcoefa*bnorm), safmin)
+
+			// Triangular solve of (a*S - b*P)^T*y = 0, rowwise in
+			// (a*S - b*P)^T, or columnwise in (a*S - b*P).
+			var na int
+			for j := je + nw; j < n; j += na {
+				na = 1
+				bdiag1 := p[j*ldp+j]
+				var bdiag2 float64
+				if j < n-1 && s[(j+1)*lds+j] != 0 {
+					na = 2
+					bdiag2 = p[(j+1)*ldp+j+1]
+				}
+
+				// Check whether scaling is necessary for dot
+				// products.
+				xscale := 1 / math.Max(1, xmax)
+				temp := math.Max(math.Max(anorms[j], bnorms[j]), acoefa*anorms[j]+bcoefa*bnorms[j])
+				if na == 2 {
+					temp = math.Max(temp, math.Max(math.Max(anorms[j+1], bnorms[j+1]), acoefa*anorms[j+1]+bcoefa*bnorms[j+1]))
+				}
+				if temp > bignum*xscale {
+					scaleX(je, j, xscale)
+					xmax *= xscale
+				}
+
+				// Compute the dot products
+				//  sum = \sum_{k=je}^{j-1} conj(a*S[k,j] - b*P[k,j])*x[k].
+				// To reduce the op count, this is done as
+				//  a*conj(\sum S[k,j]*x[k]) - b*conj(\sum P[k,j]*x[k]),
+				// which may cause underflow problems if S or P
+				// are close to underflow.
+				var sums, sump [2][2]float64
+				for jw := 0; jw < nw; jw++ {
+					for ja := 0; ja < na; ja++ {
+						for jr := je; jr < j; jr++ {
+							sums[ja][jw] += s[jr*lds+j+ja] * x[2*jr+jw]
+							sump[ja][jw] += p[jr*ldp+j+ja] * x[2*jr+jw]
+						}
+					}
+				}
+				var sum [4]float64
+				for ja := 0; ja < na; ja++ {
+					if ilcplx {
+						sum[2*ja] = -acoef*sums[ja][0] + bcoefr*sump[ja][0] - bcoefi*sump[ja][1]
+						sum[2*ja+1] = -acoef*sums[ja][1] + bcoefr*sump[ja][1] + bcoefi*sump[ja][0]
+					} else {
+						sum[2*ja] = -acoef*sums[ja][0] + bcoefr*sump[ja][0]
+					}
+				}
+
+				// Solve (a*S - b*P)^T*y = sum with scaling and
+				// perturbation of the denominator.
+				scale, xnorm, _ := impl.Dlaln2(true, na, nw, dmin, acoef, s[j*lds+j:], lds, bdiag1, bdiag2,
+					sum[:], 2, bcoefr, bcoefi, x[2*j:], 2)
+				if scale < 1 {
+					scaleX(je, j, scale)
+					xmax *= scale
+				}
+				xmax = math.Max(xmax, xnorm)
+			}
+
+			// Copy the eigenvector to VL, back-transforming if
+			// howmny == lapack.EVAllMulQ.
+			var ibeg int
+			if ilback {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dgemv(blas.NoTrans, n, n-je, 1, vl[je:], ldvl, x[2*je+jw:], 2, 0, xback[jw*n:(jw+1)*n], 1)
+				}
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, xback[jw*n:], 1, vl[je+jw:], ldvl)
+				}
+			} else {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, x[jw:], 2, vl[ieig+jw:], ldvl)
+				}
+				ibeg = je
+			}
+
+			// Scale the eigenvector.
+			dtgevcNormalize(n, ibeg, nw, vl[ieig:], ldvl, safmin)
+			ieig += nw
+		}
+	}
+
+	if rightv {
+		// Compute the right eigenvectors.
+		ieig := m
+		var nw int
+		for je := n - 1; je >= 0; je -= nw {
+			ilcplx := je > 0 && s[je*lds+je-1] != 0
+			nw = 1
+			if ilcplx {
+				nw = 2
+			}
+			switch {
+			case howmny != lapack.EVSelected:
+			case ilcplx:
+				if !selected[je] && !selected[je-1] {
+					continue
+				}
+			default:
+				if !selected[je] {
+					continue
+				}
+			}
+
+			if !ilcplx && math.Abs(s[je*lds+je]) <= safmin && math.Abs(p[je*ldp+je]) <= safmin {
+				// Singular matrix pencil, return unit eigenvector.
+				ieig--
+				for jr := 0; jr < n; jr++ {
+					vr[jr*ldvr+ieig] = 0
+				}
+				vr[ieig*ldvr+ieig] = 1
+				continue
+			}
+
+			// Clear the vector.
+			for i := range x {
+				x[i] = 0
+			}
+
+			var xmax float64
+			if !ilcplx {
+				// Real eigenvalue.
+				realCoef(je)
+				xmax = 1
+				x[2*je] = 1
+
+				// Compute the contribution from column je of S
+				// and P to the sum.
+				for jr := 0; jr < je; jr++ {
+					x[2*jr] = bcoefr*p[jr*ldp+je] - acoef*s[jr*lds+je]
+				}
+			} else {
+				// Complex eigenvalue.
+				if !complexCoef(je-1, false) {
+					return m, false
+				}
+
+				// Compute the first two components of the
+				// eigenvector and the contribution to the sums.
+				temp := acoef * s[je*lds+je-1]
+				temp2r := acoef*s[je*lds+je] - bcoefr*p[je*ldp+je]
+				temp2i := -bcoefi * p[je*ldp+je]
+				if math.Abs(temp) >= math.Abs(temp2r)+math.Abs(temp2i) {
+					x[2*je] = 1
+					x[2*je+1] = 0
+					x[2*je-2] = -temp2r / temp
+					x[2*je-1] = -temp2i / temp
+				} else {
+					x[2*je-2] = 1
+					x[2*je-1] = 0
+					temp = acoef * s[(je-1)*lds+je]
+					x[2*je] = (bcoefr*p[(je-1)*ldp+je-1] - acoef*s[(je-1)*lds+je-1]) / temp
+					x[2*je+1] = bcoefi * p[(je-1)*ldp+je-1] / temp
+				}
+				xmax = math.Max(math.Abs(x[2*je])+math.Abs(x[2*je+1]), math.Abs(x[2*je-2])+math.Abs(x[2*je-1]))
+
+				// Compute the contribution from columns je and
+				// je-1 of S and P to the sums.
+				creala := acoef * x[2*je-2]
+				cimaga := acoef * x[2*je-1]
+				crealb := bcoefr*x[2*je-2] - bcoefi*x[2*je-1]
+				cimagb := bcoefi*x[2*je-2] + bcoefr*x[2*je-1]
+				cre2a := acoef * x[2*je]
+				cim2a := acoef * x[2*je+1]
+				cre2b := bcoefr*x[2*je] - bcoefi*x[2*je+1]
+				cim2b := bcoefi*x[2*je] + bcoefr*x[2*je+1]
+				for jr := 0; jr < je-1; jr++ {
+					x[2*jr] = -creala*s[jr*lds+je-1] + crealb*p[jr*ldp+je-1] - cre2a*s[jr*lds+je] + cre2b*p[jr*ldp+je]
+					x[2*jr+1] = -cimaga*s[jr*lds+je-1] + cimagb*p[jr*ldp+je-1] - cim2a*s[jr*lds+je] + cim2b*p[jr*ldp+je]
+				}
+			}
+			dmin := math.Max(math.Max(ulp*acoefa*anorm, ulp*bcoefa*bnorm), safmin)
+
+			// Columnwise triangular solve of (a*S - b*P)*x = 0.
+			var il2by2 bool
+			for j := je - nw; j >= 0; j-- {
+				// If a 2×2 block is in position j-1:j+1, wait
+				// until the next iteration to process it (when
+				// it will be j:j+2).
+				if !il2by2 && j > 0 && s[j*lds+j-1] != 0 {
+					il2by2 = true
+					continue
+				}
+				na := 1
+				bdiag1 := p[j*ldp+j]
+				var bdiag2 float64
+				if il2by2 {
+					na = 2
+					bdiag2 = p[(j+1)*ldp+j+1]
+				}
+
+				// Compute x[j] (and x[j+1], if 2×2 block).
+				var sum [4]float64
+				scale, xnorm, _ := impl.Dlaln2(false, na, nw, dmin, acoef, s[j*lds+j:], lds, bdiag1, bdiag2,
+					x[2*j:], 2, bcoefr, bcoefi, sum[:], 2)
+				if scale < 1 {
+					scaleX(0, je+1, scale)
+				}
+				xmax = math.Max(scale*xmax, xnorm)
+				for jw := 0; jw < nw; jw++ {
+					for ja := 0; ja < na; ja++ {
+						x[2*(j+ja)+jw] = sum[2*ja+jw]
+					}
+				}
+
+				// w = w + x[j]*(a*S[:,j] - b*P[:,j]) with scaling.
+				if j > 0 {
+					// Check whether scaling is necessary for
+					// the sum.
+					xscale := 1 / math.Max(1, xmax)
+					temp := acoefa*anorms[j] + bcoefa*bnorms[j]
+					if il2by2 {
+						temp = math.Max(temp, acoefa*anorms[j+1]+bcoefa*bnorms[j+1])
+					}
+					temp = math.Max(temp, math.Max(acoefa, bcoefa))
+					if temp > bignum*xscale {
+						scaleX(0, je+1, xscale)
+						xmax *= xscale
+					}
+
+					// Compute the contributions of the
+					// off-diagonals of column j (and j+1, if
+					// 2×2 block) of S and P to the sums.
+					for ja := 0; ja < na; ja++ {
+						creala := acoef * x[2*(j+ja)]
+						crealb := bcoefr * x[2*(j+ja)]
+						if ilcplx {
+							cimaga := acoef * x[2*(j+ja)+1]
+							crealb -= bcoefi * x[2*(j+ja)+1]
+							cimagb := bcoefi*x[2*(j+ja)] + bcoefr*x[2*(j+ja)+1]
+							for jr := 0; jr < j; jr++ {
+								x[2*jr] += -creala*s[jr*lds+j+ja] + crealb*p[jr*ldp+j+ja]
+								x[2*jr+1] += -cimaga*s[jr*lds+j+ja] + cimagb*p[jr*ldp+j+ja]
+							}
+						} else {
+							for jr := 0; jr < j; jr++ {
+								x[2*jr] += -creala*s[jr*lds+j+ja] + crealb*p[jr*ldp+j+ja]
+							}
+						}
+					}
+				}
+				il2by2 = false
+			}
+
+			// Copy the eigenvector to VR, back-transforming if
+			// howmny == lapack.EVAllMulQ.
+			ieig -= nw
+			iend := je + 1
+			if ilback {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dgemv(blas.NoTrans, n, je+1, 1, vr, ldvr, x[jw:], 2, 0, xback[jw*n:(jw+1)*n], 1)
+				}
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, xback[jw*n:], 1, vr[ieig+jw:], ldvr)
+				}
+				iend = n
+			} else {
+				for jw := 0; jw < nw; jw++ {
+					bi.Dcopy(n, x[jw:], 2, vr[ieig+jw:], ldvr)
+				}
+			}
+
+			// Scale the eigenvector.
+			dtgevcNormalize(iend, 0, nw, vr[ieig:], ldvr, safmin)
+		}
+	}
+
+	return m, true
+}
+
+// dtgevcNormalize scales the real (nw == 1) or complex (nw == 2)
+// eigenvector stored in rows [ibeg:n] of the first nw columns of v so that its
+// element of largest magnitude has magnitude 1, where the magnitude of a complex
+// number (x,y) is taken to be |x| + |y|. The vector is not scaled if its
+// largest magnitude is not greater than safmin.
+func dtgevcNormalize(n, ibeg, nw int, v []float64, ldv int, safmin float64) {
+	var xmax float64
+	for j := ibeg; j < n; j++ {
+		if nw == 2 {
+			xmax = math.Max(xmax, math.Abs(v[j*ldv])+math.Abs(v[j*ldv+1]))
+		} else {
+			xmax = math.Max(xmax, math.Abs(v[j*ldv]))
+		}
+	}
+	if xmax <= safmin {
+		return
+	}
+	xscale := 1 / xmax
+	for jw := 0; jw < nw; jw++ {
+		for jr := ibeg; jr < n; jr++ {
+			v[jr*ldv+jw] *= xscale
+		}
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtrsen reorders the real Schur factorization of an n×n real matrix
+//  A = Q*T*Q^T
+// so that a selected cluster of eigenvalues appears in the leading diagonal
+// blocks of the upper quasi-triangular matrix T, and the leading columns of Q
+// form an orthonormal basis of the corresponding right invariant subspace.
+// Optionally, Dtrsen computes the reciprocal condition numbers of the cluster
+// of eigenvalues and of the invariant subspace.
+//
+// On entry, T must be in Schur canonical form, that is, block upper triangular
+// with 1×1 and 2×2 diagonal blocks; each 2×2 diagonal block has its diagonal
+// elements equal and its off-diagonal elements of opposite sign. On return, T
+// will be overwritten by the reordered matrix, again in Schur canonical form,
+// with the selected eigenvalues in the leading diagonal blocks.
+//
+// If compq is lapack.UpdateSchur, on return the matrix Q of Schur vectors will
+// be updated by post-multiplying it with the orthogonal transformation matrix
+// which reorders T. If compq is lapack.UpdateSchurNone, Q is not referenced.
+// For other values of compq Dtrsen will panic.
+//
+// selected specifies the eigenvalues in the selected cluster and must have
+// length n, otherwise Dtrsen will panic. To select a real eigenvalue w[j],
+// selected[j] must be set to true. To select a complex conjugate pair of
+// eigenvalues w[j] and w[j+1], corresponding to a 2×2 diagonal block, either
+// selected[j] or selected[j+1] or both must be set to true; a complex
+// conjugate pair of eigenvalues must be either both included in the cluster
+// or both excluded.
+//
+// job specifies which reciprocal condition numbers are computed:
+//  - lapack.SchurCondNone: none,
+//  - lapack.SchurCondEigenvalues: for the cluster of eigenvalues only (s),
+//  - lapack.SchurCondSubspace: for the invariant subspace only (sep),
+//  - lapack.SchurCondBoth: for both the cluster and the subspace.
+// For other values of job Dtrsen will panic. If job is such that s or sep is
+// not computed, the corresponding return value is zero.
+//
+// On return, wr and wi will contain the real and imaginary parts,
+// respectively, of the reordered eigenvalues of T. The eigenvalues are stored
+// in the same order as on the diagonal of T, with wr[i] = T[i,i] and, if
+// T[i:i+2,i:i+2] is a 2×2 diagonal block, wi[i] > 0 and wi[i+1] = -wi[i].
+// wr and wi must have length n, otherwise Dtrsen will panic.
+//
+// m is the dimension of the specified invariant subspace, that is, the number
+// of selected eigenvalues counting each complex conjugate pair as two.
+//
+// s is a lower bound on the reciprocal condition number of the selected
+// cluster of eigenvalues. s cannot underestimate the true reciprocal condition
+// number by more than a factor of sqrt(n). If m == 0 or m == n, s is 1.
+//
+// sep is the estimated reciprocal condition number of the specified invariant
+// subspace. If m == 0 or m == n, sep is the 1-norm of T.
+//
+// work must have length at least lwork and lwork must be at least
+//  max(1, n)                 if job == lapack.SchurCondNone,
+//  max(1, n, m*(n-m))        if job == lapack.SchurCondEigenvalues,
+//  max(1, n, 2*m*(n-m))      if job == lapack.SchurCondSubspace or lapack.SchurCondBoth,
+// and iwork must have length at least liwork and liwork must be at least
+//  1                         if job == lapack.SchurCondNone or lapack.SchurCondEigenvalues,
+//  max(1, m*(n-m))           if job == lapack.SchurCondSubspace or lapack.SchurCondBoth,
+// otherwise Dtrsen will panic.
+//
+// If lwork == -1 or liwork == -1, instead of performing Dtrsen, the function
+// only calculates the minimum values of lwork and liwork, and stores them into
+// work[0] and iwork[0], respectively.
+//
+// ok will be false if the reordering failed because some eigenvalues are too
+// close to separate (the problem is very ill-conditioned). In this case T may
+// have been partially reordered, and s and sep will be set to zero.
+func (impl Implementation) Dtrsen(job lapack.SchurCond, compq lapack.UpdateSchurComp, selected []bool, n int, t []float64, ldt int, q []float64, ldq int, wr, wi, work []float64, lwork int, iwork []int, liwork int) (m int, s, sep float64, ok bool) {
+	wants := job == lapack.SchurCondEigenvalues || job == lapack.SchurCondBoth
+	wantsp := job == lapack.SchurCondSubspace || job == lapack.SchurCondBoth
+	wantq := compq == lapack.UpdateSchur
+	switch {
+	case job != lapack.SchurCondNone && !wants && !wantsp:
+		panic(badSchurCond)
+	case compq != lapack.UpdateSchur && compq != lapack.UpdateSchurNone:
+		panic(badUpdateSchurComp)
+	case n < 0:
+		panic(nLT0)
+	case ldt < max(1, n):
+		panic(badLdT)
+	case ldq < 1, wantq && ldq < n:
+		panic(badLdQ)
+	case len(selected) != n:
+		panic(badLenSelected)
+	case len(t) < (n-1)*ldt+n:
+		panic(shortT)
+	}
+
+	// Set m to the dimension of the specified invariant subspace.
+	for k := 0; k < n; k++ {
+		if k < n-1 && t[(k+1)*ldt+k] != 0 {
+			// 2×2 diagonal block.
+			if selected[k] || selected[k+1] {
+				m += 2
+			}
+			k++
+			continue
+		}
+		if selected[k] {
+			m++
+		}
+	}
+
+	n1 := m
+	n2 := n - m
+	nn := n1 * n2
+	lwmin := max(1, n)
+	liwmin := 1
+	switch {
+	case wantsp:
+		lwmin = max(lwmin, 2*nn)
+		liwmin = max(1, nn)
+	case wants:
+		lwmin = max(lwmin, nn)
+	}
+
+	switch {
+	case lwork < lwmin && lwork != -1 && liwork != -1:
+		panic(badLWork)
+	case liwork < liwmin && lwork != -1 && liwork != -1:
+		panic(badLIWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	case len(iwork) < max(1, liwork):
+		panic(shortIWork)
+	}
+
+	if lwork == -1 || liwork == -1 {
+		work[0] = float64(lwmin)
+		iwork[0] = liwmin
+		return m, 0, 0, true
+	}
+
+	switch {
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(wr) != n:
+		panic(badLenWr)
+	case len(wi) != n:
+		panic(badLenWi)
+	}
+
+	ok = true
+	if m == 0 || m == n {
+		// Quick return if possible.
+		if wants {
+			s = 1
+		}
+		if wantsp && n > 0 {
+			sep = impl.Dlange(lapack.MaxColumnSum, n, n, t, ldt, work)
+		}
+	} else {
+		// Collect the selected blocks at the top-left corner of T.
+		var ks int
+		for k := 0; k < n; k++ {
+			swap := selected[k]
+			pair := k < n-1 && t[(k+1)*ldt+k] != 0
+			if pair {
+				swap = swap || selected[k+1]
+			}
+			if swap {
+				if k != ks {
+					// Swap the k-th block to position ks.
+					_, _, ok = impl.Dtrexc(compq, n, t, ldt, q, ldq, k, ks, work)
+				}
+				if !ok {
+					// Blocks too close to swap.
+					break
+				}
+				ks++
+				if pair {
+					ks++
+				}
+			}
+			if pair {
+				k++
+			}
+		}
+
+		if ok {
+			if wants {
+				// Solve the Sylvester equation for R:
+				//  T11*R - R*T22 = scale*T12.
+				impl.Dlacpy(blas.All, n1, n2, t[n1:], ldt, work, n2)
+				scale, _ := impl.Dtrsyl(blas.NoTrans, blas.NoTrans, -1, n1, n2, t, ldt, t[n1*ldt+n1:], ldt, work, n2)
+
+				// Estimate the reciprocal of the condition number of
+				// the cluster of eigenvalues.
+				rnorm := impl.Dlange(lapack.Frobenius, n1, n2, work, n2, nil)
+				if rnorm == 0 {
+					s = 1
+				} else {
+					s = scale / (math.Sqrt(scale*scale/rnorm+rnorm) * math.Sqrt(rnorm))
+				}
+			}
+
+			if wantsp {
+				// Estimate sep(T11,T22).
+				var (
+					isave [3]int
+					est   float64
+					kase  int
+					scale float64
+				)
+				for {
+					est, kase = impl.Dlacn2(nn, work[nn:2*nn], work[:nn], iwork, est, kase, &isave)
+					if kase == 0 {
+						break
+					}
+					if kase == 1 {
+						// Solve T11*R - R*T22 = scale*X.
+						scale, _ = impl.Dtrsyl(blas.NoTrans, blas.NoTrans, -1, n1, n2, t, ldt, t[n1*ldt+n1:], ldt, work, n2)
+					} else {
+						// Solve T11^T*R - R*T22^T = scale*X.
+						scale, _ = impl.Dtrsyl(blas.Trans, blas.Trans, -1, n1, n2, t, ldt, t[n1*ldt+n1:], ldt, work, n2)
+					}
+				}
+				sep = scale / est
+			}
+		}
+	}
+
+	// Store the output eigenvalues in wr and wi.
+	for k := 0; k < n; k++ {
+		wr[k] = t[k*ldt+k]
+		wi[k] = 0
+	}
+	for k := 0; k < n-1; k++ {
+		if t[(k+1)*ldt+k] != 0 {
+			wi[k] = math.Sqrt(math.Abs(t[k*ldt+k+1])) * math.Sqrt(math.Abs(t[(k+1)*ldt+k]))
+			wi[k+1] = -wi[k]
+		}
+	}
+
+	if !ok {
+		s = 0
+		sep = 0
+	}
+	work[0] = float64(lwmin)
+	iwork[0] = liwmin
+	return m, s, sep, ok
+}
@@ -0,0 +1,217 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtrsyl solves the real Sylvester matrix equation
+//  op(A)*X + isgn*X*op(B) = scale*C,
+// where op(A) = A or A^T depending on trana, op(B) = B or B^T depending on
+// tranb, A is an m×m and B is an n×n upper quasi-triangular matrix in Schur
+// canonical form, and X and C are m×n matrices. isgn must be 1 or -1.
+//
+// Schur canonical form means that the matrix is block upper triangular with
+// 1×1 and 2×2 diagonal blocks where each 2×2 diagonal block has its diagonal
+// elements equal and its off-diagonal elements of opposite sign. Such
+// matrices are returned, for example, by Dhseqr and Dgees.
+//
+// trana and tranb must be blas.NoTrans or blas.Trans, otherwise Dtrsyl will
+// panic.
+//
+// On return, C will be overwritten by the solution matrix X. scale is a
+// scaling factor less than or equal to 1, chosen to avoid overflow in X.
+//
+// ok will be false if A and -isgn*B have common or very close eigenvalues and
+// perturbed values were used to solve the equation. In this case the
+// solution is an approximate solution of a slightly perturbed system.
+func (impl Implementation) Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool) {
+	switch {
+	case trana != blas.NoTrans && trana != blas.Trans:
+		panic(badTrans)
+	case tranb != blas.NoTrans && tranb != blas.Trans:
+		panic(badTrans)
+	case isgn != 1 && isgn != -1:
+		panic(badIsgn)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, m):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 1, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+m:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	}
+
+	notrna := trana == blas.NoTrans
+	notrnb := tranb == blas.NoTrans
+
+	// Set constants to control overflow.
+	eps := dlamchP
+	smlnum := dlamchS * float64(m*n) / eps
+	bignum := 1 / smlnum
+	smin := math.Max(smlnum, eps*impl.Dlange(lapack.MaxAbs, m, m, a, lda, nil))
+	smin = math.Max(smin, eps*impl.Dlange(lapack.MaxAbs, n, n, b, ldb, nil))
+
+	sgn := float64(isgn)
+	bi := blas64.Implementation()
+
+	// The solution X is computed block by block, where the blocks are
+	// defined by the 1×1 and 2×2 diagonal blocks of A and B. The order in
+	// which the blocks are traversed depends on trana and tranb so that
+	// X[k,l] depends only on blocks of X that have already been computed:
+	//  - if op(A) == A, block rows are traversed from bottom to top,
+	//    otherwise from top to bottom,
+	//  - if op(B) == B, block columns are traversed from left to right,
+	//    otherwise from right to left.
+	ok = true
+	scale = 1
+	var vec, x [4]float64
+	for lcount := 0; lcount < n; {
+		// Determine the current block column [l1,l2] of X.
+		var l1, l2 int
+		if notrnb {
+			l1 = lcount
+			l2 = l1
+			if l1 < n-1 && b[(l1+1)*ldb+l1] != 0 {
+				l2++
+			}
+		} else {
+			l2 = n - 1 - lcount
+			l1 = l2
+			if l2 > 0 && b[l2*ldb+l2-1] != 0 {
+				l1--
+			}
+		}
+		lcount += l2 - l1 + 1
+
+		for kcount := 0; kcount < m; {
+			// Determine the current block row [k1,k2] of X.
+			var k1, k2 int
+			if notrna {
+				k2 = m - 1 - kcount
+				k1 = k2
+				if k2 > 0 && a[k2*lda+k2-1] != 0 {
+					k1--
+				}
+			} else {
+				k1 = kcount
+				k2 = k1
+				if k1 < m-1 && a[(k1+1)*lda+k1] != 0 {
+					k2++
+				}
+			}
+			kcount += k2 - k1 + 1
+
+			// Compute the right-hand side of the equation for the
+			// current block, that is, C[k,l] minus the contributions
+			// of the blocks of X that have already been computed.
+			for i := k1; i <= k2; i++ {
+				for j := l1; j <= l2; j++ {
+					var suml, sumr float64
+					if notrna {
+						if k2 < m-1 {
+							suml = bi.Ddot(m-k2-1, a[i*lda+k2+1:], 1, c[(k2+1)*ldc+j:], ldc)
+						}
+					} else {
+						suml = bi.Ddot(k1, a[i:], lda, c[j:], ldc)
+					}
+					if notrnb {
+						sumr = bi.Ddot(l1, c[i*ldc:], 1, b[j:], ldb)
+					} else {
+						sumr = bi.Ddot(n-l2-1, c[i*ldc+l2+1:], 1, b[j*ldb+l2+1:], 1)
+					}
+					vec[(i-k1)*2+j-l1] = c[i*ldc+j] - (suml + sgn*sumr)
+				}
+			}
+
+			scaloc := 1.0
+			switch {
+			case k1 == k2 && l1 == l2:
+				a11 := a[k1*lda+k1] + sgn*b[l1*ldb+l1]
+				da11 := math.Abs(a11)
+				if da11 <= smin {
+					a11 = smin
+					da11 = smin
+					ok = false
+				}
+				db := math.Abs(vec[0])
+				if da11 < 1 && db > 1 && db > bignum*da11 {
+					scaloc = 1 / db
+				}
+				x[0] = vec[0] * scaloc / a11
+			case k1 == k2 && l1 != l2:
+				// X[k,l] is a 1×2 row vector. Solve the transposed
+				// system
+				//  sgn*op(B[l,l])^T*x + a*x = sgn*r.
+				vec[0] *= sgn
+				vec[1] *= sgn
+				var lok bool
+				scaloc, _, lok = impl.Dlaln2(notrnb, 2, 1, smin, 1, b[l1*ldb+l1:], ldb, 1, 1, vec[:2], 1, -sgn*a[k1*lda+k1], 0, x[:2], 1)
+				if !lok {
+					ok = false
+				}
+			case k1 != k2 && l1 == l2:
+				// X[k,l] is a 2×1 column vector.
+				vec[1] = vec[2]
+				var lok bool
+				scaloc, _, lok = impl.Dlaln2(!notrna, 2, 1, smin, 1, a[k1*lda+k1:], lda, 1, 1, vec[:2], 1, -sgn*b[l1*ldb+l1], 0, x[:2], 1)
+				if !lok {
+					ok = false
+				}
+			default:
+				var lok bool
+				scaloc, _, lok = impl.Dlasy2(!notrna, !notrnb, isgn, 2, 2, a[k1*lda+k1:], lda, b[l1*ldb+l1:], ldb, vec[:], 2, x[:], 2)
+				if !lok {
+					ok = false
+				}
+			}
+
+			if scaloc != 1 {
+				for i := 0; i < m; i++ {
+					bi.Dscal(n, scaloc, c[i*ldc:], 1)
+				}
+				scale *= scaloc
+			}
+			switch {
+			case k1 == k2 && l1 == l2:
+				c[k1*ldc+l1] = x[0]
+			case k1 == k2:
+				c[k1*ldc+l1] = x[0]
+				c[k1*ldc+l2] = x[1]
+			case l1 == l2:
+				c[k1*ldc+l1] = x[0]
+				c[k2*ldc+l1] = x[1]
+			default:
+				c[k1*ldc+l1] = x[0]
+				c[k1*ldc+l2] = x[1]
+				c[k2*ldc+l1] = x[2]
+				c[k2*ldc+l2] = x[3]
+			}
+		}
+	}
+	return scale, ok
+}
@@ -0,0 +1,134 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtzrzf reduces the m×n (m <= n) upper trapezoidal matrix A to upper
+// triangular form by means of orthogonal transformations. The upper
+// trapezoidal matrix A is factorized as
+//  A = [ R 0 ] * Z,
+// where Z is an n×n orthogonal matrix and R is an m×m upper triangular matrix.
+//
+// On return, the upper triangle of A[0:m,0:m] contains R and the remaining
+// elements of A, with tau, represent Z as a product of m elementary
+// reflectors
+//  Z = Z_0 * Z_1 * ... * Z_{m-1}.
+// Each Z_k has the form
+//  Z_k = I - tau[k] * u * u^T,
+// where u is a vector of length n with u[k] = 1, u[0:k] = 0, u[k+1:m] = 0 and
+// u[m:n] stored on exit in A[k,m:n].
+//
+// tau must have length at least m.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,m), otherwise Dtzrzf will panic. For optimal performance lwork should
+// be at least m*nb, where nb is the optimal block size. On return, work[0]
+// will contain the optimal value of lwork.
+//
+// If lwork == -1, instead of performing Dtzrzf, only the optimal value of lwork
+// will be stored in work[0].
+func (impl Implementation) Dtzrzf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < m:
+		panic(nLTM)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, m) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 {
+		work[0] = 1
+		return
+	}
+
+	nb := impl.Ilaenv(1, "DGERQF", " ", m, n, -1, -1)
+	if lwork == -1 {
+		work[0] = float64(m * nb)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < m:
+		panic(shortTau)
+	}
+
+	if m == n {
+		for i := range tau[:m] {
+			tau[i] = 0
+		}
+		work[0] = 1
+		return
+	}
+
+	nbmin := 2
+	nx := 1
+	iws := m
+	var ldwork int
+	if 1 < nb && nb < m {
+		// Determine when to cross over from blocked to unblocked code.
+		nx = max(0, impl.Ilaenv(3, "DGERQF", " ", m, n, -1, -1))
+		if nx < m {
+			// Determine whether workspace is large enough for blocked code.
+			iws = m * nb
+			if lwork < iws {
+				// Not enough workspace to use optimal nb. Reduce
+				// nb and determine the minimum value of nb.
+				nb = lwork / m
+				nbmin = max(2, impl.Ilaenv(2, "DGERQF", " ", m, n, -1, -1))
+			}
+			ldwork = nb
+		}
+	}
+
+	var mu int
+	if nbmin <= nb && nb < m && nx < m {
+		// Use blocked code initially.
+		// The last kk rows are handled by the block method.
+		ki := ((m - nx - 1) / nb) * nb
+		kk := min(m, ki+nb)
+
+		var i int
+		for i = m - kk + ki; i >= m-kk; i -= nb {
+			ib := min(m-i, nb)
+
+			// Compute the TZ factorization of the current block
+			// A[i:i+ib,i:n].
+			impl.Dlatrz(ib, n-i, n-m, a[i*lda+i:], lda, tau[i:], work)
+			if i > 0 {
+				// Form the triangular factor of the block reflector
+				//  H = H_{i+ib-1} * ... * H_{i+1} * H_i.
+				impl.Dlarzt(lapack.Backward, lapack.RowWise, n-m, ib, a[i*lda+m:], lda, tau[i:], work, ldwork)
+
+				// Apply H to A[0:i,i:n] from the right.
+				impl.Dlarzb(blas.Right, blas.NoTrans, lapack.Backward, lapack.RowWise,
+					i, n-i, ib, n-m, a[i*lda+m:], lda,
+					work, ldwork,
+					a[i:], lda,
+					work[ib*ldwork:], ldwork)
+			}
+		}
+		mu = i + nb
+	} else {
+		mu = m
+	}
+
+	// Use unblocked code to factor the last or only block.
+	if mu > 0 {
+		impl.Dlatrz(mu, n, n-m, a, lda, tau, work)
+	}
+	work[0] = float64(iws)
+}
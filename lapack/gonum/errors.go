@@ -8,14 +8,17 @@ package gonum
 const (
 	// Panic strings for bad enumeration values.
 	badApplyOrtho      = "lapack: bad ApplyOrtho"
+	badBDComp          = "lapack: bad BDComp"
 	badBalanceJob      = "lapack: bad BalanceJob"
 	badDiag            = "lapack: bad Diag"
 	badDirect          = "lapack: bad Direct"
 	badEVComp          = "lapack: bad EVComp"
 	badEVHowMany       = "lapack: bad EVHowMany"
 	badEVJob           = "lapack: bad EVJob"
+	badEVRange         = "lapack: bad EVRange"
 	badEVSide          = "lapack: bad EVSide"
 	badGSVDJob         = "lapack: bad GSVDJob"
+	badGenEVType       = "lapack: bad GenEVType"
 	badGenOrtho        = "lapack: bad GenOrtho"
 	badLeftEVJob       = "lapack: bad LeftEVJob"
 	badMatrixType      = "lapack: bad MatrixType"
@@ -24,6 +27,7 @@ const (
 	badRightEVJob      = "lapack: bad RightEVJob"
 	badSVDJob          = "lapack: bad SVDJob"
 	badSchurComp       = "lapack: bad SchurComp"
+	badSchurCond       = "lapack: bad SchurCond"
 	badSchurJob        = "lapack: bad SchurJob"
 	badSide            = "lapack: bad Side"
 	badSort            = "lapack: bad Sort"
@@ -34,21 +38,31 @@ const (
 	bothSVDOver        = "lapack: both jobU and jobVT are lapack.SVDOverwrite"
 
 	// Panic strings for bad numerical and string values.
+	badCutpnt   = "lapack: cutpnt out of range"
+	badDtrd1    = "lapack: dtrd1 must be 1 or -1"
+	badDtrd2    = "lapack: dtrd2 must be 1 or -1"
+	badI        = "lapack: i out of range"
 	badIfst     = "lapack: ifst out of range"
 	badIhi      = "lapack: ihi out of range"
 	badIhiz     = "lapack: ihiz out of range"
+	badIl       = "lapack: il out of range"
 	badIlo      = "lapack: ilo out of range"
 	badIloz     = "lapack: iloz out of range"
 	badIlst     = "lapack: ilst out of range"
 	badIsave    = "lapack: bad isave value"
+	badIsgn     = "lapack: isgn must be 1 or -1"
 	badIspec    = "lapack: bad ispec value"
+	badIu       = "lapack: iu out of range"
 	badJ1       = "lapack: j1 out of range"
+	badJob      = "lapack: bad job value"
 	badJpvt     = "lapack: bad element of jpvt"
 	badK1       = "lapack: k1 out of range"
 	badK2       = "lapack: k2 out of range"
 	badKacc22   = "lapack: invalid value of kacc22"
 	badKbot     = "lapack: kbot out of range"
 	badKtop     = "lapack: ktop out of range"
+	badL        = "lapack: l out of range"
+	badLIWork   = "lapack: insufficient declared integer workspace length"
 	badLWork    = "lapack: insufficient declared workspace length"
 	badMm       = "lapack: mm out of range"
 	badN1       = "lapack: bad value of n1"
@@ -59,12 +73,18 @@ const (
 	badNw       = "lapack: bad value of nw"
 	badPp       = "lapack: bad value of pp"
 	badShifts   = "lapack: bad shifts"
+	badSqre     = "lapack: sqre must be 0 or 1"
 	i0LT0       = "lapack: i0 < 0"
 	kGTM        = "lapack: k > m"
 	kGTN        = "lapack: k > n"
 	kLT0        = "lapack: k < 0"
 	kLT1        = "lapack: k < 1"
 	kdLT0       = "lapack: kd < 0"
+	klLT0       = "lapack: kl < 0"
+	kuLT0       = "lapack: ku < 0"
+	lGTM        = "lapack: l > m"
+	lGTN        = "lapack: l > n"
+	lLT0        = "lapack: l < 0"
 	mGTN        = "lapack: m > n"
 	mLT0        = "lapack: m < 0"
 	mmLT0       = "lapack: mm < 0"
@@ -73,6 +93,7 @@ const (
 	nLT0        = "lapack: n < 0"
 	nLT1        = "lapack: n < 1"
 	nLTM        = "lapack: n < m"
+	msubLT1     = "lapack: msub < 1"
 	nanCFrom    = "lapack: cfrom is NaN"
 	nanCTo      = "lapack: cto is NaN"
 	nbGTM       = "lapack: nb > m"
@@ -83,7 +104,9 @@ const (
 	negANorm    = "lapack: anorm < 0"
 	negZ        = "lapack: negative z value"
 	nhLT0       = "lapack: nh < 0"
+	nlLT1       = "lapack: nl < 1"
 	notIsolated = "lapack: block is not isolated"
+	nrLT1       = "lapack: nr < 1"
 	nrhsLT0     = "lapack: nrhs < 0"
 	nruLT0      = "lapack: nru < 0"
 	nshftsLT0   = "lapack: nshfts < 0"
@@ -93,10 +116,13 @@ const (
 	offsetLT0   = "lapack: offset < 0"
 	pLT0        = "lapack: p < 0"
 	recurLT0    = "lapack: recur < 0"
+	vuLEvl      = "lapack: vu <= vl"
 	zeroCFrom   = "lapack: zero cfrom"
 
 	// Panic strings for bad slice lengths.
 	badLenAlpha    = "lapack: bad length of alpha"
+	badLenAlphai   = "lapack: bad length of alphai"
+	badLenAlphar   = "lapack: bad length of alphar"
 	badLenBeta     = "lapack: bad length of beta"
 	badLenIpiv     = "lapack: bad length of ipiv"
 	badLenJpvt     = "lapack: bad length of jpvt"
@@ -106,44 +132,77 @@ const (
 	badLenSr       = "lapack: bad length of sr"
 	badLenTau      = "lapack: bad length of tau"
 	badLenWi       = "lapack: bad length of wi"
+	badLenW        = "lapack: bad length of w"
 	badLenWr       = "lapack: bad length of wr"
 
 	// Panic strings for insufficient slice lengths.
-	shortA     = "lapack: insufficient length of a"
-	shortAB    = "lapack: insufficient length of ab"
-	shortAuxv  = "lapack: insufficient length of auxv"
-	shortB     = "lapack: insufficient length of b"
-	shortC     = "lapack: insufficient length of c"
-	shortCNorm = "lapack: insufficient length of cnorm"
-	shortD     = "lapack: insufficient length of d"
-	shortE     = "lapack: insufficient length of e"
-	shortF     = "lapack: insufficient length of f"
-	shortH     = "lapack: insufficient length of h"
-	shortIWork = "lapack: insufficient length of iwork"
-	shortIsgn  = "lapack: insufficient length of isgn"
-	shortQ     = "lapack: insufficient length of q"
-	shortS     = "lapack: insufficient length of s"
-	shortScale = "lapack: insufficient length of scale"
-	shortT     = "lapack: insufficient length of t"
-	shortTau   = "lapack: insufficient length of tau"
-	shortTauP  = "lapack: insufficient length of tauP"
-	shortTauQ  = "lapack: insufficient length of tauQ"
-	shortU     = "lapack: insufficient length of u"
-	shortV     = "lapack: insufficient length of v"
-	shortVL    = "lapack: insufficient length of vl"
-	shortVR    = "lapack: insufficient length of vr"
-	shortVT    = "lapack: insufficient length of vt"
-	shortVn1   = "lapack: insufficient length of vn1"
-	shortVn2   = "lapack: insufficient length of vn2"
-	shortW     = "lapack: insufficient length of w"
-	shortWH    = "lapack: insufficient length of wh"
-	shortWV    = "lapack: insufficient length of wv"
-	shortWi    = "lapack: insufficient length of wi"
-	shortWork  = "lapack: insufficient length of work"
-	shortWr    = "lapack: insufficient length of wr"
-	shortX     = "lapack: insufficient length of x"
-	shortY     = "lapack: insufficient length of y"
-	shortZ     = "lapack: insufficient length of z"
+	shortA      = "lapack: insufficient length of a"
+	shortAB     = "lapack: insufficient length of ab"
+	shortAuxv   = "lapack: insufficient length of auxv"
+	shortB      = "lapack: insufficient length of b"
+	shortC      = "lapack: insufficient length of c"
+	shortCNorm  = "lapack: insufficient length of cnorm"
+	shortCtot   = "lapack: insufficient length of ctot"
+	shortColtyp = "lapack: insufficient length of coltyp"
+	shortD      = "lapack: insufficient length of d"
+	shortDelta  = "lapack: insufficient length of delta"
+	shortDlamda = "lapack: insufficient length of dlamda"
+	shortDsigma = "lapack: insufficient length of dsigma"
+	shortE      = "lapack: insufficient length of e"
+	shortF      = "lapack: insufficient length of f"
+	shortH      = "lapack: insufficient length of h"
+	shortIWork  = "lapack: insufficient length of iwork"
+	shortIblock = "lapack: insufficient length of iblock"
+	shortIdxc   = "lapack: insufficient length of idxc"
+	shortIdxp   = "lapack: insufficient length of idxp"
+	shortIdxq   = "lapack: insufficient length of idxq"
+	shortIdx    = "lapack: insufficient length of idx"
+	shortIfail  = "lapack: insufficient length of ifail"
+	shortIn     = "lapack: insufficient length of in"
+	shortIndex  = "lapack: insufficient length of index"
+	shortIndxc  = "lapack: insufficient length of indxc"
+	shortIndxp  = "lapack: insufficient length of indxp"
+	shortIndxq  = "lapack: insufficient length of indxq"
+	shortIndx   = "lapack: insufficient length of indx"
+	shortInode  = "lapack: insufficient length of inode"
+	shortIsgn   = "lapack: insufficient length of isgn"
+	shortIsplit = "lapack: insufficient length of isplit"
+	shortLscale = "lapack: insufficient length of lscale"
+	shortNdiml  = "lapack: insufficient length of ndiml"
+	shortNdimr  = "lapack: insufficient length of ndimr"
+	shortP      = "lapack: insufficient length of p"
+	shortQ      = "lapack: insufficient length of q"
+	shortQ2     = "lapack: insufficient length of q2"
+	shortRscale = "lapack: insufficient length of rscale"
+	shortRWork  = "lapack: insufficient length of rwork"
+	shortS      = "lapack: insufficient length of s"
+	shortScale  = "lapack: insufficient length of scale"
+	shortSWork  = "lapack: insufficient length of swork"
+	shortT      = "lapack: insufficient length of t"
+	shortTau    = "lapack: insufficient length of tau"
+	shortTauP   = "lapack: insufficient length of tauP"
+	shortTauQ   = "lapack: insufficient length of tauQ"
+	shortU      = "lapack: insufficient length of u"
+	shortU2     = "lapack: insufficient length of u2"
+	shortV      = "lapack: insufficient length of v"
+	shortVL     = "lapack: insufficient length of vl"
+	shortVR     = "lapack: insufficient length of vr"
+	shortVS     = "lapack: insufficient length of vs"
+	shortVSL    = "lapack: insufficient length of vsl"
+	shortVSR    = "lapack: insufficient length of vsr"
+	shortVT     = "lapack: insufficient length of vt"
+	shortVT2    = "lapack: insufficient length of vt2"
+	shortVn1    = "lapack: insufficient length of vn1"
+	shortVn2    = "lapack: insufficient length of vn2"
+	shortW      = "lapack: insufficient length of w"
+	shortWH     = "lapack: insufficient length of wh"
+	shortWV     = "lapack: insufficient length of wv"
+	shortWi     = "lapack: insufficient length of wi"
+	shortWork   = "lapack: insufficient length of work"
+	shortWr     = "lapack: insufficient length of wr"
+	shortX      = "lapack: insufficient length of x"
+	shortY      = "lapack: insufficient length of y"
+	shortZ      = "lapack: insufficient length of z"
 
 	// Panic strings for bad leading dimensions of matrices.
 	badLdA    = "lapack: bad leading dimension of A"
@@ -151,13 +210,20 @@ const (
 	badLdC    = "lapack: bad leading dimension of C"
 	badLdF    = "lapack: bad leading dimension of F"
 	badLdH    = "lapack: bad leading dimension of H"
+	badLdP    = "lapack: bad leading dimension of P"
 	badLdQ    = "lapack: bad leading dimension of Q"
+	badLdS    = "lapack: bad leading dimension of S"
 	badLdT    = "lapack: bad leading dimension of T"
 	badLdU    = "lapack: bad leading dimension of U"
+	badLdU2   = "lapack: bad leading dimension of U2"
 	badLdV    = "lapack: bad leading dimension of V"
+	badLdVS   = "lapack: bad leading dimension of VS"
+	badLdVSL  = "lapack: bad leading dimension of VSL"
+	badLdVSR  = "lapack: bad leading dimension of VSR"
 	badLdVL   = "lapack: bad leading dimension of VL"
 	badLdVR   = "lapack: bad leading dimension of VR"
 	badLdVT   = "lapack: bad leading dimension of VT"
+	badLdVT2  = "lapack: bad leading dimension of VT2"
 	badLdW    = "lapack: bad leading dimension of W"
 	badLdWH   = "lapack: bad leading dimension of WH"
 	badLdWV   = "lapack: bad leading dimension of WV"
@@ -171,4 +237,5 @@ const (
 	badIncX      = "lapack: incX <= 0"
 	badIncY      = "lapack: incY <= 0"
 	zeroIncV     = "lapack: incv == 0"
+	zeroIncX     = "lapack: incX == 0"
 )
@@ -13,7 +13,8 @@ package gonum
 //  4: The number of shifts.
 //  5: The minimum column dimension for blocking to be used.
 //  6: The crossover point for SVD (to use QR factorization or not).
-//  7: The number of processors.
+//  7: The number of processors, the maximum number of goroutines used by the
+//     tiled factorizations.
 //  8: The crossover point for multi-shift in QR and QZ methods for non-symmetric eigenvalue problems.
 //  9: Maximum size of the subproblems in divide-and-conquer algorithms.
 //  10: ieee NaN arithmetic can be trusted not to trap.
@@ -37,6 +38,12 @@ func (impl Implementation) Ilaenv(ispec int, name string, opts string, n1, n2, n
 	default:
 		panic(badIspec)
 	case 1:
+		if impl.BlockSize > 0 {
+			switch c2 + c3 {
+			case "GETRF", "GEQRF", "POTRF":
+				return impl.BlockSize
+			}
+		}
 		switch c2 {
 		default:
 			panic(badName)
@@ -366,8 +373,8 @@ func (impl Implementation) Ilaenv(ispec int, name string, opts string, n1, n2, n
 		// Used by xGELSS and xGESVD
 		return int(float64(min(n1, n2)) * 1.6)
 	case 7:
-		// Not used
-		return 1
+		// Used by xGETRF, xGEQRF and xPOTRF
+		return impl.workers()
 	case 8:
 		// Used by xHSEQR
 		return 50
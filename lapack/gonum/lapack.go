@@ -2,16 +2,61 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+//go:generate ./single_precision.bash
+
 package gonum
 
-import "gonum.org/v1/gonum/lapack"
+import (
+	"math"
+	"runtime"
+
+	blasgonum "gonum.org/v1/gonum/blas/gonum"
+	"gonum.org/v1/gonum/lapack"
+)
 
 // Implementation is the native Go implementation of LAPACK routines. It
-// is built on top of calls to the return of blas64.Implementation(), so while
-// this code is in pure Go, the underlying BLAS implementation may not be.
-type Implementation struct{}
+// is built on top of calls to the return of blas64.Implementation() and
+// blas32.Implementation(), so while this code is in pure Go, the underlying
+// BLAS implementation may not be.
+//
+// The Cholesky, LU and QR factorizations Dpotrf, Dgetrf and Dgeqrf of large
+// matrices are computed by tiled algorithms whose tasks are executed
+// concurrently. Workers specifies the maximum number of goroutines used. If
+// Workers is zero or negative, runtime.GOMAXPROCS(0) goroutines are used, and
+// if Workers is one, the factorizations are computed serially. BlockSize, if
+// positive, overrides the block size of these factorizations that is otherwise
+// returned by Ilaenv. Both values are reported by Ilaenv. Since the tasks are
+// already executed concurrently, they call the native BLAS implementation with
+// a single worker instead of blas64.Implementation() and
+// blas32.Implementation().
+type Implementation struct {
+	Workers   int
+	BlockSize int
+}
+
+var (
+	_ lapack.Float32    = Implementation{}
+	_ lapack.Float64    = Implementation{}
+	_ lapack.Complex128 = Implementation{}
+)
+
+// serialBLAS is the BLAS implementation called by the tasks of the tiled
+// factorizations. It does not start goroutines, so that the number of
+// goroutines running is bounded by the number of workers.
+var serialBLAS = blasgonum.Implementation{Workers: 1}
+
+// minParTiles is the minimum number of block columns for which a tiled
+// factorization is computed concurrently.
+const minParTiles = 4
 
-var _ lapack.Float64 = Implementation{}
+// workers returns the maximum number of goroutines used by the tiled
+// factorizations.
+func (impl Implementation) workers() int {
+	if impl.Workers > 0 {
+		return impl.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
 
 func min(a, b int) int {
 	if a < b {
@@ -34,6 +79,19 @@ func abs(a int) int {
 	return a
 }
 
+// float32s implements sort.Interface for a []float32, sorting in increasing
+// order.
+type float32s []float32
+
+func (f float32s) Len() int           { return len(f) }
+func (f float32s) Less(i, j int) bool { return f[i] < f[j] || (f[i] != f[i] && f[j] == f[j]) }
+func (f float32s) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+// cabs1 returns |real(z)| + |imag(z)|.
+func cabs1(z complex128) float64 {
+	return math.Abs(real(z)) + math.Abs(imag(z))
+}
+
 const (
 	// dlamchE is the machine epsilon. For IEEE this is 2^{-53}.
 	dlamchE = 1.0 / (1 << 53)
@@ -49,3 +107,19 @@ const (
 	// For IEEE this is 2^{-1022}.
 	dlamchS = 1.0 / (1 << 256) / (1 << 256) / (1 << 256) / (1 << 254)
 )
+
+const (
+	// slamchE is the machine epsilon. For IEEE this is 2^{-24}.
+	slamchE float32 = 1.0 / (1 << 24)
+
+	// slamchB is the radix of the machine (the base of the number system).
+	slamchB float32 = 2
+
+	// slamchP is base * eps.
+	slamchP = slamchB * slamchE
+
+	// slamchS is the "safe minimum", that is, the lowest number such that
+	// 1/slamchS does not overflow, or also the smallest normal number.
+	// For IEEE this is 2^{-126}.
+	slamchS float32 = 1.0 / (1 << 126)
+)
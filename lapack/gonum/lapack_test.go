@@ -12,6 +12,14 @@ import (
 
 var impl = Implementation{}
 
+// parallelImpl uses a small block size so that the tiled factorizations
+// are computed concurrently for the matrix sizes used in the tests.
+var parallelImpl = Implementation{Workers: 4, BlockSize: 7}
+
+func TestDbdsdc(t *testing.T) {
+	testlapack.DbdsdcTest(t, impl)
+}
+
 func TestDbdsqr(t *testing.T) {
 	testlapack.DbdsqrTest(t, impl)
 }
@@ -24,6 +32,18 @@ func TestDgebak(t *testing.T) {
 	testlapack.DgebakTest(t, impl)
 }
 
+func TestDgbcon(t *testing.T) {
+	testlapack.DgbconTest(t, impl)
+}
+
+func TestDgbtrf(t *testing.T) {
+	testlapack.DgbtrfTest(t, impl)
+}
+
+func TestDgbtrs(t *testing.T) {
+	testlapack.DgbtrsTest(t, impl)
+}
+
 func TestDgebal(t *testing.T) {
 	testlapack.DgebalTest(t, impl)
 }
@@ -40,6 +60,10 @@ func TestDgecon(t *testing.T) {
 	testlapack.DgeconTest(t, impl)
 }
 
+func TestDgees(t *testing.T) {
+	testlapack.DgeesTest(t, impl)
+}
+
 func TestDgeev(t *testing.T) {
 	testlapack.DgeevTest(t, impl)
 }
@@ -68,6 +92,10 @@ func TestDgels(t *testing.T) {
 	testlapack.DgelsTest(t, impl)
 }
 
+func TestDgelsParallel(t *testing.T) {
+	testlapack.DgelsTest(t, parallelImpl)
+}
+
 func TestDgerq2(t *testing.T) {
 	testlapack.Dgerq2Test(t, impl)
 }
@@ -84,10 +112,18 @@ func TestDgeqrf(t *testing.T) {
 	testlapack.DgeqrfTest(t, impl)
 }
 
+func TestDgeqrfParallel(t *testing.T) {
+	testlapack.DgeqrfTest(t, parallelImpl)
+}
+
 func TestDgerqf(t *testing.T) {
 	testlapack.DgerqfTest(t, impl)
 }
 
+func TestDgesdd(t *testing.T) {
+	testlapack.DgesddTest(t, impl)
+}
+
 func TestDgesvd(t *testing.T) {
 	testlapack.DgesvdTest(t, impl)
 }
@@ -104,10 +140,30 @@ func TestDgetrf(t *testing.T) {
 	testlapack.DgetrfTest(t, impl)
 }
 
+func TestDgetrfParallel(t *testing.T) {
+	testlapack.DgetrfTest(t, parallelImpl)
+}
+
 func TestDgetrs(t *testing.T) {
 	testlapack.DgetrsTest(t, impl)
 }
 
+func TestDggbal(t *testing.T) {
+	testlapack.DggbalTest(t, impl)
+}
+
+func TestDgges(t *testing.T) {
+	testlapack.DggesTest(t, impl)
+}
+
+func TestDggev(t *testing.T) {
+	testlapack.DggevTest(t, impl)
+}
+
+func TestDgghrd(t *testing.T) {
+	testlapack.DgghrdTest(t, impl)
+}
+
 func TestDggsvd3(t *testing.T) {
 	testlapack.Dggsvd3Test(t, impl)
 }
@@ -116,6 +172,10 @@ func TestDggsvp3(t *testing.T) {
 	testlapack.Dggsvp3Test(t, impl)
 }
 
+func TestDhgeqz(t *testing.T) {
+	testlapack.DhgeqzTest(t, impl)
+}
+
 func TestDlabrd(t *testing.T) {
 	testlapack.DlabrdTest(t, impl)
 }
@@ -140,10 +200,18 @@ func TestDlaexc(t *testing.T) {
 	testlapack.DlaexcTest(t, impl)
 }
 
+func TestDlag2(t *testing.T) {
+	testlapack.Dlag2Test(t, impl)
+}
+
 func TestDlags2(t *testing.T) {
 	testlapack.Dlags2Test(t, impl)
 }
 
+func TestDlagts(t *testing.T) {
+	testlapack.DlagtsTest(t, impl)
+}
+
 func TestDlahqr(t *testing.T) {
 	testlapack.DlahqrTest(t, impl)
 }
@@ -292,10 +360,18 @@ func TestDlatrd(t *testing.T) {
 	testlapack.DlatrdTest(t, impl)
 }
 
+func TestDlatbs(t *testing.T) {
+	testlapack.DlatbsTest(t, impl)
+}
+
 func TestDlatrs(t *testing.T) {
 	testlapack.DlatrsTest(t, impl)
 }
 
+func TestDlatrz(t *testing.T) {
+	testlapack.DlatrzTest(t, impl)
+}
+
 func TestDlauu2(t *testing.T) {
 	testlapack.Dlauu2Test(t, impl)
 }
@@ -348,6 +424,10 @@ func TestDormhr(t *testing.T) {
 	testlapack.DormhrTest(t, impl)
 }
 
+func TestDormtr(t *testing.T) {
+	testlapack.DormtrTest(t, impl)
+}
+
 func TestDorml2(t *testing.T) {
 	testlapack.Dorml2Test(t, impl)
 }
@@ -364,6 +444,10 @@ func TestDormr2(t *testing.T) {
 	testlapack.Dormr2Test(t, impl)
 }
 
+func TestDormrz(t *testing.T) {
+	testlapack.DormrzTest(t, impl)
+}
+
 func TestDorm2r(t *testing.T) {
 	testlapack.Dorm2rTest(t, impl)
 }
@@ -384,6 +468,10 @@ func TestDpotrf(t *testing.T) {
 	testlapack.DpotrfTest(t, impl)
 }
 
+func TestDpotrfParallel(t *testing.T) {
+	testlapack.DpotrfTest(t, parallelImpl)
+}
+
 func TestDpotri(t *testing.T) {
 	testlapack.DpotriTest(t, impl)
 }
@@ -396,6 +484,26 @@ func TestDrscl(t *testing.T) {
 	testlapack.DrsclTest(t, impl)
 }
 
+func TestDstebz(t *testing.T) {
+	testlapack.DstebzTest(t, impl)
+}
+
+func TestDsgesv(t *testing.T) {
+	testlapack.DsgesvTest(t, impl)
+}
+
+func TestDsposv(t *testing.T) {
+	testlapack.DsposvTest(t, impl)
+}
+
+func TestDstedc(t *testing.T) {
+	testlapack.DstedcTest(t, impl)
+}
+
+func TestDstein(t *testing.T) {
+	testlapack.DsteinTest(t, impl)
+}
+
 func TestDsteqr(t *testing.T) {
 	testlapack.DsteqrTest(t, impl)
 }
@@ -404,10 +512,30 @@ func TestDsterf(t *testing.T) {
 	testlapack.DsterfTest(t, impl)
 }
 
+func TestDsycon(t *testing.T) {
+	testlapack.DsyconTest(t, impl)
+}
+
 func TestDsyev(t *testing.T) {
 	testlapack.DsyevTest(t, impl)
 }
 
+func TestDsyevd(t *testing.T) {
+	testlapack.DsyevdTest(t, impl)
+}
+
+func TestDsyevr(t *testing.T) {
+	testlapack.DsyevrTest(t, impl)
+}
+
+func TestDsygst(t *testing.T) {
+	testlapack.DsygstTest(t, impl)
+}
+
+func TestDsygv(t *testing.T) {
+	testlapack.DsygvTest(t, impl)
+}
+
 func TestDsytd2(t *testing.T) {
 	testlapack.Dsytd2Test(t, impl)
 }
@@ -416,6 +544,18 @@ func TestDsytrd(t *testing.T) {
 	testlapack.DsytrdTest(t, impl)
 }
 
+func TestDsytrf(t *testing.T) {
+	testlapack.DsytrfTest(t, impl)
+}
+
+func TestDsytrs(t *testing.T) {
+	testlapack.DsytrsTest(t, impl)
+}
+
+func TestDtgevc(t *testing.T) {
+	testlapack.DtgevcTest(t, impl)
+}
+
 func TestDtgsja(t *testing.T) {
 	testlapack.DtgsjaTest(t, impl)
 }
@@ -432,6 +572,14 @@ func TestDtrexc(t *testing.T) {
 	testlapack.DtrexcTest(t, impl)
 }
 
+func TestDtrsen(t *testing.T) {
+	testlapack.DtrsenTest(t, impl)
+}
+
+func TestDtrsyl(t *testing.T) {
+	testlapack.DtrsylTest(t, impl)
+}
+
 func TestDtrti2(t *testing.T) {
 	testlapack.Dtrti2Test(t, impl)
 }
@@ -440,6 +588,10 @@ func TestDtrtri(t *testing.T) {
 	testlapack.DtrtriTest(t, impl)
 }
 
+func TestDtzrzf(t *testing.T) {
+	testlapack.DtzrzfTest(t, impl)
+}
+
 func TestIladlc(t *testing.T) {
 	testlapack.IladlcTest(t, impl)
 }
@@ -447,3 +599,43 @@ func TestIladlc(t *testing.T) {
 func TestIladlr(t *testing.T) {
 	testlapack.IladlrTest(t, impl)
 }
+
+func TestZgeqrf(t *testing.T) {
+	testlapack.ZgeqrfTest(t, impl)
+}
+
+func TestZgesvd(t *testing.T) {
+	testlapack.ZgesvdTest(t, impl)
+}
+
+func TestZgetrf(t *testing.T) {
+	testlapack.ZgetrfTest(t, impl)
+}
+
+func TestZgetrs(t *testing.T) {
+	testlapack.ZgetrsTest(t, impl)
+}
+
+func TestZpotrf(t *testing.T) {
+	testlapack.ZpotrfTest(t, impl)
+}
+
+func TestZtrcon(t *testing.T) {
+	testlapack.ZtrconTest(t, impl)
+}
+
+func TestZunmqr(t *testing.T) {
+	testlapack.ZunmqrTest(t, impl)
+}
+
+func TestZheev(t *testing.T) {
+	testlapack.ZheevTest(t, impl)
+}
+
+func TestZgecon(t *testing.T) {
+	testlapack.ZgeconTest(t, impl)
+}
+
+func TestZgeev(t *testing.T) {
+	testlapack.ZgeevTest(t, impl)
+}
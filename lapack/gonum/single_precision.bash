@@ -0,0 +1,89 @@
+#!/usr/bin/env bash
+
+# Copyright ©2019 The Gonum Authors. All rights reserved.
+# Use of this source code is governed by a BSD-style
+# license that can be found in the LICENSE file.
+
+# single_precision.bash generates the float32 LAPACK routines from their
+# float64 counterparts. Each d*.go source file (and the Iladl* helpers)
+# is translated into the corresponding s*.go file.
+
+WARNING='//\
+// Float32 implementations are autogenerated and not directly tested.\
+'
+
+# Collect the names of the float64 LAPACK routines and the float64 BLAS
+# routines that they call, and build the renaming rules.
+RENAME=''
+for name in $(grep -ho '^func ([a-z ]*Implementation) [DId][a-zA-Z0-9]*' d*.go iladl*.go | awk '{print $NF}' | sort -u); do
+	case $name in
+	Iladl*)
+		RENAME="${RENAME}s/\<${name}\>/Ilasl${name#Iladl}/g;"
+		;;
+	D*)
+		RENAME="${RENAME}s/\<${name}\>/S${name#D}/g;"
+		;;
+	d*)
+		RENAME="${RENAME}s/\<${name}\>/s${name#d}/g;"
+		;;
+	esac
+done
+for name in $(grep -ho '^func d[a-zA-Z0-9]*' d*.go | awk '{print $2}' | sort -u); do
+	RENAME="${RENAME}s/\<${name}\>/s${name#d}/g;"
+done
+for name in $(grep -o '^	\(Id\|D\)[a-z0-9]*(' ../../blas/blas.go | tr -d '\t(' | sort -u); do
+	case $name in
+	Id*)
+		RENAME="${RENAME}s/\<${name}\>/Is${name#Id}/g;"
+		;;
+	D*)
+		RENAME="${RENAME}s/\<${name}\>/S${name#D}/g;"
+		;;
+	esac
+done
+
+for src in d*.go iladl*.go; do
+	case $src in
+	*_test.go|doc.go)
+		continue
+		;;
+	dlag2s.go|dlat2s.go|dsgesv.go|dsposv.go)
+		# The mixed precision routines have no float32 counterparts.
+		continue
+		;;
+	iladl*)
+		dst=ilasl${src#iladl}
+		;;
+	*)
+		dst=s${src#d}
+		;;
+	esac
+
+	echo Generating $dst
+	echo -e '// Code generated by "go generate gonum.org/v1/gonum/lapack/gonum”; DO NOT EDIT.\n' > $dst
+	cat $src \
+	| gofmt -r 'float64 -> float32' \
+	\
+	| gofmt -r 'blas64.Implementation -> blas32.Implementation' \
+	| gofmt -r 'blas.Float64 -> blas.Float32' \
+	\
+	| gofmt -r 'dlamchB -> slamchB' \
+	| gofmt -r 'dlamchE -> slamchE' \
+	| gofmt -r 'dlamchP -> slamchP' \
+	| gofmt -r 'dlamchS -> slamchS' \
+	| gofmt -r 'noSVDO -> noSSVDO' \
+	\
+	| gofmt -r 'sort.Float64s(x) -> sort.Sort(float32s(x))' \
+	| gofmt -r 'sort.Float64Slice(x) -> float32s(x)' \
+	\
+	| sed -e "$RENAME" \
+	      -e "s_^\(func ([a-z ]*Implementation) \)S\(.*\)\$_$WARNING\1S\2_" \
+	      -e "s_^\(func ([a-z ]*Implementation) \)Ilasl\(.*\)\$_$WARNING\1Ilasl\2_" \
+	      -e 's_"D\([A-Z0-9]*\)"_"S\1"_g' \
+	      -e 's_ := \(-\?[0-9]*\.[0-9]*\)$_ := float32(\1)_' \
+	      -e 's_"dgesvd: _"sgesvd: _' \
+	      -e 's_"gonum.org/v1/gonum/blas/blas64"_"gonum.org/v1/gonum/blas/blas32"_' \
+	      -e 's_"math"_math "gonum.org/v1/gonum/internal/math32"_' \
+	| gofmt \
+	>> $dst
+done
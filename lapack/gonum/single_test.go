@@ -0,0 +1,187 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// The float32 routines are generated from the float64 routines, so the tests
+// below only check that the generated code agrees with the float64 code on
+// a few well conditioned problems.
+
+const singleTol = 1e-3
+
+func randomSingle(m, n int, rnd *rand.Rand) ([]float32, []float64) {
+	a32 := make([]float32, m*n)
+	a64 := make([]float64, m*n)
+	for i := range a32 {
+		a32[i] = float32(rnd.NormFloat64())
+		a64[i] = float64(a32[i])
+	}
+	return a32, a64
+}
+
+func maxAbsDiffSingle(a []float32, b []float64) float64 {
+	var d float64
+	for i, v := range a {
+		d = math.Max(d, math.Abs(float64(v)-b[i]))
+	}
+	return d
+}
+
+func TestSingleGetrfGetrs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10, 50} {
+		a32, a64 := randomSingle(n, n, rnd)
+		for i := 0; i < n; i++ {
+			a32[i*n+i] += float32(n)
+			a64[i*n+i] += float64(n)
+		}
+		b32, b64 := randomSingle(n, 2, rnd)
+		ipiv32 := make([]int, n)
+		ipiv64 := make([]int, n)
+		impl.Sgetrf(n, n, a32, n, ipiv32)
+		impl.Dgetrf(n, n, a64, n, ipiv64)
+		impl.Sgetrs(blas.NoTrans, n, 2, a32, n, ipiv32, b32, 2)
+		impl.Dgetrs(blas.NoTrans, n, 2, a64, n, ipiv64, b64, 2)
+		if d := maxAbsDiffSingle(b32, b64); d > singleTol {
+			t.Errorf("n=%d: solution mismatch, |X32-X64|=%v", n, d)
+		}
+	}
+}
+
+func TestSinglePotrf(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10, 50} {
+		b32, _ := randomSingle(n, n, rnd)
+		a32 := make([]float32, n*n)
+		a64 := make([]float64, n*n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				var s float32
+				for k := 0; k < n; k++ {
+					s += b32[k*n+i] * b32[k*n+j]
+				}
+				if i == j {
+					s += float32(n)
+				}
+				a32[i*n+j] = s
+				a64[i*n+j] = float64(s)
+			}
+		}
+		ok32 := impl.Spotrf(blas.Upper, n, a32, n)
+		ok64 := impl.Dpotrf(blas.Upper, n, a64, n)
+		if !ok32 || !ok64 {
+			t.Errorf("n=%d: unexpected factorization failure", n)
+			continue
+		}
+		if d := maxAbsDiffSingle(a32, a64); d > singleTol*float64(n) {
+			t.Errorf("n=%d: Cholesky factor mismatch, |U32-U64|=%v", n, d)
+		}
+	}
+}
+
+func TestSingleSyev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10, 50} {
+		a32, a64 := randomSingle(n, n, rnd)
+		w32 := make([]float32, n)
+		w64 := make([]float64, n)
+		work32 := make([]float32, 1)
+		impl.Ssyev(lapack.EVCompute, blas.Upper, n, a32, n, w32, work32, -1)
+		work32 = make([]float32, int(work32[0]))
+		work64 := make([]float64, 1)
+		impl.Dsyev(lapack.EVCompute, blas.Upper, n, a64, n, w64, work64, -1)
+		work64 = make([]float64, int(work64[0]))
+		ok32 := impl.Ssyev(lapack.EVCompute, blas.Upper, n, a32, n, w32, work32, len(work32))
+		ok64 := impl.Dsyev(lapack.EVCompute, blas.Upper, n, a64, n, w64, work64, len(work64))
+		if !ok32 || !ok64 {
+			t.Errorf("n=%d: unexpected eigendecomposition failure", n)
+			continue
+		}
+		if d := maxAbsDiffSingle(w32, w64); d > singleTol*float64(n) {
+			t.Errorf("n=%d: eigenvalue mismatch, |W32-W64|=%v", n, d)
+		}
+	}
+}
+
+func TestSingleGesvd(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {2, 5}, {5, 2}, {10, 10}, {30, 20}} {
+		m, n := dims[0], dims[1]
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		a32, a64 := randomSingle(m, n, rnd)
+		s32 := make([]float32, min(m, n))
+		s64 := make([]float64, min(m, n))
+		work32 := make([]float32, 1)
+		impl.Sgesvd(lapack.SVDNone, lapack.SVDNone, m, n, a32, n, s32, nil, 1, nil, 1, work32, -1)
+		work32 = make([]float32, int(work32[0]))
+		work64 := make([]float64, 1)
+		impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, a64, n, s64, nil, 1, nil, 1, work64, -1)
+		work64 = make([]float64, int(work64[0]))
+		ok32 := impl.Sgesvd(lapack.SVDNone, lapack.SVDNone, m, n, a32, n, s32, nil, 1, nil, 1, work32, len(work32))
+		ok64 := impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, a64, n, s64, nil, 1, nil, 1, work64, len(work64))
+		if !ok32 || !ok64 {
+			t.Errorf("%v: unexpected SVD failure", name)
+			continue
+		}
+		if d := maxAbsDiffSingle(s32, s64); d > singleTol*float64(max(m, n)) {
+			t.Errorf("%v: singular value mismatch, |S32-S64|=%v", name, d)
+		}
+	}
+}
+
+func TestSingleGeev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10, 30} {
+		a32, a64 := randomSingle(n, n, rnd)
+		wr32 := make([]float32, n)
+		wi32 := make([]float32, n)
+		wr64 := make([]float64, n)
+		wi64 := make([]float64, n)
+		work32 := make([]float32, 1)
+		impl.Sgeev(lapack.LeftEVNone, lapack.RightEVNone, n, a32, n, wr32, wi32, nil, 1, nil, 1, work32, -1)
+		work32 = make([]float32, int(work32[0]))
+		work64 := make([]float64, 1)
+		impl.Dgeev(lapack.LeftEVNone, lapack.RightEVNone, n, a64, n, wr64, wi64, nil, 1, nil, 1, work64, -1)
+		work64 = make([]float64, int(work64[0]))
+		first32 := impl.Sgeev(lapack.LeftEVNone, lapack.RightEVNone, n, a32, n, wr32, wi32, nil, 1, nil, 1, work32, len(work32))
+		first64 := impl.Dgeev(lapack.LeftEVNone, lapack.RightEVNone, n, a64, n, wr64, wi64, nil, 1, nil, 1, work64, len(work64))
+		if first32 != 0 || first64 != 0 {
+			t.Errorf("n=%d: unexpected eigenvalue failure", n)
+			continue
+		}
+		got := make([]complex128, n)
+		want := make([]complex128, n)
+		for i := range got {
+			got[i] = complex(float64(wr32[i]), float64(wi32[i]))
+			want[i] = complex(wr64[i], wi64[i])
+		}
+		byReIm := func(z []complex128) func(i, j int) bool {
+			return func(i, j int) bool {
+				if math.Abs(real(z[i])-real(z[j])) > singleTol {
+					return real(z[i]) < real(z[j])
+				}
+				return imag(z[i]) < imag(z[j])
+			}
+		}
+		sort.Slice(got, byReIm(got))
+		sort.Slice(want, byReIm(want))
+		for i := range got {
+			if d := math.Hypot(real(got[i]-want[i]), imag(got[i]-want[i])); d > singleTol*float64(n) {
+				t.Errorf("n=%d: eigenvalue %d mismatch: got %v, want %v", n, i, got[i], want[i])
+			}
+		}
+	}
+}
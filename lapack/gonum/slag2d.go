@@ -0,0 +1,14 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+// slag2d converts the m×n float32 matrix SA to the float64 matrix A.
+func slag2d(m, n int, sa []float32, ldsa int, a []float64, lda int) {
+	for i := 0; i < m; i++ {
+		for j, v := range sa[i*ldsa : i*ldsa+n] {
+			a[i*lda+j] = float64(v)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// taskGraph is a directed acyclic graph of tasks that operate on the blocks
+// of a matrix. Tasks are added in the order of a serial algorithm together
+// with the blocks that they read and write, and the dependencies between them
+// are inferred from these accesses. Executing the graph with run computes
+// independent tasks concurrently while giving the same result as executing
+// the tasks in the order in which they were added.
+type taskGraph struct {
+	tasks []*task
+
+	// last holds the most recently added task that writes each block.
+	last map[int]*task
+	// readers holds the tasks that read each block since it was last
+	// written.
+	readers map[int][]*task
+}
+
+// task is a node of a taskGraph.
+type task struct {
+	fn func() bool
+
+	// prio is the priority of the task. Ready tasks with a lower prio are
+	// executed first, and ties are broken by the order in which the tasks
+	// were added.
+	prio  int
+	index int
+
+	deps int // Number of unfinished predecessors.
+	succ []*task
+}
+
+func newTaskGraph() *taskGraph {
+	return &taskGraph{
+		last:    make(map[int]*task),
+		readers: make(map[int][]*task),
+	}
+}
+
+// add adds a task with the given priority that reads the blocks in reads and
+// writes the blocks in writes. The task depends on the last task that writes
+// any of its blocks, and a task that writes a block also depends on all the
+// tasks that read the block since then. If fn returns false, the execution of
+// the graph is stopped.
+func (g *taskGraph) add(prio int, fn func() bool, reads, writes []int) {
+	t := &task{fn: fn, prio: prio, index: len(g.tasks)}
+	pred := make(map[*task]struct{})
+	for _, b := range reads {
+		if w, ok := g.last[b]; ok {
+			pred[w] = struct{}{}
+		}
+	}
+	for _, b := range writes {
+		if w, ok := g.last[b]; ok {
+			pred[w] = struct{}{}
+		}
+		for _, r := range g.readers[b] {
+			pred[r] = struct{}{}
+		}
+	}
+	for p := range pred {
+		p.succ = append(p.succ, t)
+		t.deps++
+	}
+	for _, b := range reads {
+		g.readers[b] = append(g.readers[b], t)
+	}
+	for _, b := range writes {
+		g.last[b] = t
+		delete(g.readers, b)
+	}
+	g.tasks = append(g.tasks, t)
+}
+
+// run executes the tasks of the graph using at most workers goroutines. It
+// returns false if a task returned false, in which case the tasks that
+// depend on it are not executed.
+func (g *taskGraph) run(workers int) (ok bool) {
+	var (
+		mu      sync.Mutex
+		cond    = sync.NewCond(&mu)
+		ready   taskQueue
+		left    = len(g.tasks)
+		aborted bool
+	)
+	for _, t := range g.tasks {
+		if t.deps == 0 {
+			ready = append(ready, t)
+		}
+	}
+	heap.Init(&ready)
+
+	var wg sync.WaitGroup
+	workers = min(workers, len(g.tasks))
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			mu.Lock()
+			defer mu.Unlock()
+			for {
+				for len(ready) == 0 && left > 0 && !aborted {
+					cond.Wait()
+				}
+				if left == 0 || aborted {
+					return
+				}
+				t := heap.Pop(&ready).(*task)
+				mu.Unlock()
+				ok := t.fn()
+				mu.Lock()
+				left--
+				if !ok {
+					aborted = true
+					cond.Broadcast()
+					return
+				}
+				for _, s := range t.succ {
+					s.deps--
+					if s.deps == 0 {
+						heap.Push(&ready, s)
+						cond.Signal()
+					}
+				}
+				if left == 0 {
+					cond.Broadcast()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return !aborted
+}
+
+// taskQueue is a priority queue of ready tasks.
+type taskQueue []*task
+
+func (q taskQueue) Len() int { return len(q) }
+func (q taskQueue) Less(i, j int) bool {
+	if q[i].prio != q[j].prio {
+		return q[i].prio < q[j].prio
+	}
+	return q[i].index < q[j].index
+}
+func (q taskQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *taskQueue) Push(x interface{}) { *q = append(*q, x.(*task)) }
+func (q *taskQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	t := old[n-1]
+	*q = old[:n-1]
+	return t
+}
+
+// blockColumns returns the boundaries of the block columns of width at most
+// nb that partition the first n columns of a matrix. The block columns do not
+// straddle column k, so that the first columns of width k can be factorized
+// block by block.
+func blockColumns(k, n, nb int) []int {
+	var cols []int
+	for j := 0; j < k; j += nb {
+		cols = append(cols, j)
+	}
+	for j := k; j < n; j += nb {
+		cols = append(cols, j)
+	}
+	return append(cols, n)
+}
@@ -0,0 +1,122 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// Zgebd2 reduces a complex m×n matrix A to upper or lower real bidiagonal form
+// by a unitary transformation.
+//  Q^H * A * P = B
+// if m >= n, B is upper bidiagonal, otherwise B is lower bidiagonal.
+// d is the diagonal, len = min(m,n)
+// e is the off-diagonal len = min(m,n)-1
+//
+// Q and P are represented as products of elementary reflectors
+//  Q = H_0 * H_1 * ... * H_{nq-1},  P = G_0 * G_1 * ... * G_{np-1},
+// where each reflector has the form I - tau * v * v^H with the scalar factors
+// stored in tauQ and tauP. On return, the vectors defining H_i are stored in a
+// below the diagonal (m >= n) or subdiagonal (m < n) in column i, and the
+// conjugates of the vectors defining G_i are stored to the right of the
+// superdiagonal (m >= n) or diagonal (m < n) in row i.
+//
+// tauQ and tauP must have length at least min(m,n), and work must have length
+// at least max(m,n), otherwise Zgebd2 will panic.
+//
+// Zgebd2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zgebd2(m, n int, a []complex128, lda int, d, e []float64, tauQ, tauP, work []complex128) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	minmn := min(m, n)
+	if minmn == 0 {
+		return
+	}
+
+	switch {
+	case len(d) < minmn:
+		panic(shortD)
+	case len(e) < minmn-1:
+		panic(shortE)
+	case len(tauQ) < minmn:
+		panic(shortTauQ)
+	case len(tauP) < minmn:
+		panic(shortTauP)
+	case len(work) < max(m, n):
+		panic(shortWork)
+	}
+
+	if m >= n {
+		// Reduce to upper bidiagonal form.
+		for i := 0; i < n; i++ {
+			// Generate elementary reflector H_i to annihilate A[i+1:m, i].
+			var beta complex128
+			beta, tauQ[i] = impl.Zlarfg(m-i, a[i*lda+i], a[min(i+1, m-1)*lda+i:], lda)
+			d[i] = real(beta)
+
+			// Apply H_i^H to A[i:m, i+1:n] from the left.
+			if i < n-1 {
+				a[i*lda+i] = 1
+				impl.Zlarf(blas.Left, m-i, n-i-1, a[i*lda+i:], lda, cmplx.Conj(tauQ[i]), a[i*lda+i+1:], lda, work)
+			}
+			a[i*lda+i] = complex(d[i], 0)
+
+			if i < n-1 {
+				// Generate elementary reflector G_i to annihilate A[i, i+2:n].
+				impl.Zlacgv(n-i-1, a[i*lda+i+1:], 1)
+				beta, tauP[i] = impl.Zlarfg(n-i-1, a[i*lda+i+1], a[i*lda+min(i+2, n-1):], 1)
+				e[i] = real(beta)
+
+				// Apply G_i to A[i+1:m, i+1:n] from the right.
+				a[i*lda+i+1] = 1
+				impl.Zlarf(blas.Right, m-i-1, n-i-1, a[i*lda+i+1:], 1, tauP[i], a[(i+1)*lda+i+1:], lda, work)
+				impl.Zlacgv(n-i-1, a[i*lda+i+1:], 1)
+				a[i*lda+i+1] = complex(e[i], 0)
+			} else {
+				tauP[i] = 0
+			}
+		}
+		return
+	}
+	// Reduce to lower bidiagonal form.
+	for i := 0; i < m; i++ {
+		// Generate elementary reflector G_i to annihilate A[i, i+1:n].
+		impl.Zlacgv(n-i, a[i*lda+i:], 1)
+		var beta complex128
+		beta, tauP[i] = impl.Zlarfg(n-i, a[i*lda+i], a[i*lda+min(i+1, n-1):], 1)
+		d[i] = real(beta)
+
+		// Apply G_i to A[i+1:m, i:n] from the right.
+		if i < m-1 {
+			a[i*lda+i] = 1
+			impl.Zlarf(blas.Right, m-i-1, n-i, a[i*lda+i:], 1, tauP[i], a[(i+1)*lda+i:], lda, work)
+		}
+		impl.Zlacgv(n-i, a[i*lda+i:], 1)
+		a[i*lda+i] = complex(d[i], 0)
+
+		if i < m-1 {
+			// Generate elementary reflector H_i to annihilate A[i+2:m, i].
+			beta, tauQ[i] = impl.Zlarfg(m-i-1, a[(i+1)*lda+i], a[min(i+2, m-1)*lda+i:], lda)
+			e[i] = real(beta)
+
+			// Apply H_i^H to A[i+1:m, i+1:n] from the left.
+			a[(i+1)*lda+i] = 1
+			impl.Zlarf(blas.Left, m-i-1, n-i-1, a[(i+1)*lda+i:], lda, cmplx.Conj(tauQ[i]), a[(i+1)*lda+i+1:], lda, work)
+			a[(i+1)*lda+i] = complex(e[i], 0)
+		} else {
+			tauQ[i] = 0
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zgecon estimates the reciprocal of the condition number of the complex n×n
+// matrix A given the LU decomposition of the matrix. The condition number
+// computed may be based on the 1-norm or the ∞-norm.
+//
+// The slice a contains the result of the LU decomposition of A as computed by Zgetrf.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Zgecon will panic otherwise.
+//
+// If U has a zero on its diagonal, Zgecon returns 0.
+func (impl Implementation) Zgecon(norm lapack.MatrixNorm, n int, a []complex128, lda int, anorm float64, work []complex128) float64 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case anorm < 0:
+		panic(negANorm)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(work) < 2*n:
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if anorm == 0 {
+		return 0
+	}
+	for i := 0; i < n; i++ {
+		if a[i*lda+i] == 0 {
+			return 0
+		}
+	}
+
+	bi := cblas128.Implementation()
+	var rcond, ainvnm float64
+	var kase int
+	var isave [3]int
+	kase1 := 2
+	if norm == lapack.MaxColumnSum {
+		kase1 = 1
+	}
+	for {
+		ainvnm, kase = impl.Zlacn2(n, work[n:], work, ainvnm, kase, &isave)
+		if kase == 0 {
+			if ainvnm != 0 && !math.IsNaN(ainvnm) {
+				rcond = (1 / ainvnm) / anorm
+			}
+			return rcond
+		}
+		if kase == kase1 {
+			// Multiply by inv(L) and then inv(U).
+			bi.Ztrsv(blas.Lower, blas.NoTrans, blas.Unit, n, a, lda, work, 1)
+			bi.Ztrsv(blas.Upper, blas.NoTrans, blas.NonUnit, n, a, lda, work, 1)
+		} else {
+			// Multiply by inv(U^H) and then inv(L^H).
+			bi.Ztrsv(blas.Upper, blas.ConjTrans, blas.NonUnit, n, a, lda, work, 1)
+			bi.Ztrsv(blas.Lower, blas.ConjTrans, blas.Unit, n, a, lda, work, 1)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+const noZSVDO = "zgesvd: not coded for overwrite"
+
+// Zgesvd computes the singular value decomposition of the complex input matrix A.
+//
+// The singular value decomposition is
+//  A = U * Sigma * V^H
+// where Sigma is an m×n real diagonal matrix containing the singular values of
+// A, U is an m×m unitary matrix and V is an n×n unitary matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobU and jobVT are options for computing the singular vectors. The behavior
+// is as follows
+//  jobU == lapack.SVDAll       All m columns of U are returned in u
+//  jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
+//  jobU == lapack.SVDNone      The columns of U are not computed.
+// The behavior is the same for jobVT and the rows of V^H. lapack.SVDOverwrite
+// is not supported and Zgesvd will panic if either job is lapack.SVDOverwrite.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Zgesvd
+// the data is overwritten.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored column-wise. If
+// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
+// of size m×min(m,n). If jobU == lapack.SVDNone, u is not used.
+//
+// vt contains the conjugate transposed right singular vectors on exit, stored
+// row-wise. If jobVT == lapack.SVDAll, vt is of size n×n. If
+// jobVT == lapack.SVDStore vt is of size min(m,n)×n. If jobVT == lapack.SVDNone,
+// vt is not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least 2*min(m,n)+max(m,n) if no singular vectors
+// are computed and at least min(m,n)*(max(m,n)+2)+max(m,n) otherwise.
+// If lwork == -1, instead of performing Zgesvd, the optimal work length will be
+// stored into work[0]. Zgesvd will panic if the working memory has insufficient
+// storage.
+//
+// rwork is real temporary storage. It must have length at least 5*min(m,n) if
+// no singular vectors are computed and at least
+//  min(m,n)*(2*min(m,n)+2*max(m,n)+1)
+// otherwise.
+//
+// Zgesvd returns whether the decomposition successfully completed.
+func (impl Implementation) Zgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) (ok bool) {
+	if jobU == lapack.SVDOverwrite || jobVT == lapack.SVDOverwrite {
+		panic(noZSVDO)
+	}
+
+	wantua := jobU == lapack.SVDAll
+	wantus := jobU == lapack.SVDStore
+	wantuas := wantua || wantus
+	wantun := jobU == lapack.SVDNone
+	if !(wantua || wantus || wantun) {
+		panic(badSVDJob)
+	}
+
+	wantva := jobVT == lapack.SVDAll
+	wantvs := jobVT == lapack.SVDStore
+	wantvas := wantva || wantvs
+	wantvn := jobVT == lapack.SVDNone
+	if !(wantva || wantvs || wantvn) {
+		panic(badSVDJob)
+	}
+
+	minmn := min(m, n)
+	maxmn := max(m, n)
+	minwork := 1
+	minrwork := 1
+	if minmn > 0 {
+		minwork = 2*minmn + maxmn
+		minrwork = 5 * minmn
+		if wantuas || wantvas {
+			minwork += minmn * maxmn
+			minrwork = minmn * (2*minmn + 2*maxmn + 1)
+		}
+	}
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
+		panic(badLdU)
+	case ldvt < 1 || (wantvas && ldvt < n):
+		panic(badLdVT)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		work[0] = complex(float64(minwork), 0)
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(s) < minmn:
+		panic(shortS)
+	case (len(u) < (m-1)*ldu+m && wantua) || (len(u) < (m-1)*ldu+minmn && wantus):
+		panic(shortU)
+	case (len(vt) < (n-1)*ldvt+n && wantva) || (len(vt) < (minmn-1)*ldvt+n && wantvs):
+		panic(shortVT)
+	case len(rwork) < minrwork:
+		panic(shortRWork)
+	}
+
+	// Perform decomposition.
+	eps := dlamchE
+	smlnum := math.Sqrt(dlamchS) / eps
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum, bignum].
+	anrm := impl.Zlange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iscl bool
+	if anrm > 0 && anrm < smlnum {
+		iscl = true
+		impl.Zlascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+	} else if anrm > bignum {
+		iscl = true
+		impl.Zlascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+	}
+
+	// Partition the workspace.
+	itauq := 0
+	itaup := itauq + minmn
+	iwork := itaup + minmn
+	ie := 0
+	iur := ie + minmn
+	ivtr := iur
+	if wantuas {
+		ivtr += minmn * minmn
+	}
+	irwork := ivtr
+	if wantvas {
+		irwork += minmn * minmn
+	}
+
+	// Reduce A to real bidiagonal form, A = Q * B * P^H.
+	impl.Zgebd2(m, n, a, lda, s, rwork[ie:], work[itauq:], work[itaup:], work[iwork:])
+
+	ucol := minmn
+	if wantua {
+		ucol = m
+	}
+	vtrow := minmn
+	if wantva {
+		vtrow = n
+	}
+	if wantuas {
+		// Copy the vectors defining Q from the lower part of A into U
+		// and generate the left bidiagonalizing vectors.
+		impl.Zlacpy(blas.Lower, m, minmn, a, lda, u, ldu)
+		impl.Zungbr(lapack.GenerateQ, m, ucol, n, u, ldu, work[itauq:itauq+minmn], work[iwork:], lwork-iwork)
+	}
+	if wantvas {
+		// Copy the vectors defining P^H from the upper part of A into VT
+		// and generate the right bidiagonalizing vectors.
+		impl.Zlacpy(blas.Upper, minmn, n, a, lda, vt, ldvt)
+		impl.Zungbr(lapack.GeneratePT, vtrow, n, m, vt, ldvt, work[itaup:itaup+minmn], work[iwork:], lwork-iwork)
+	}
+
+	// Compute the singular value decomposition of the real bidiagonal
+	// matrix B = Ur * S * VTr.
+	uplo := blas.Upper
+	if m < n {
+		uplo = blas.Lower
+	}
+	var nru, ncvt int
+	if wantuas {
+		nru = minmn
+		impl.Dlaset(blas.All, minmn, minmn, 0, 1, rwork[iur:], minmn)
+	}
+	if wantvas {
+		ncvt = minmn
+		impl.Dlaset(blas.All, minmn, minmn, 0, 1, rwork[ivtr:], minmn)
+	}
+	ok = impl.Dbdsqr(uplo, minmn, ncvt, nru, 0, s, rwork[ie:], rwork[ivtr:], minmn, rwork[iur:], minmn, nil, 1, rwork[irwork:])
+
+	// Form the singular vectors of A,
+	//  U[:, 0:minmn] = Q[:, 0:minmn] * Ur,
+	//  VT[0:minmn, :] = VTr * P^H[0:minmn, :].
+	if wantuas {
+		impl.Zlacrm(m, minmn, u, ldu, rwork[iur:], minmn, work[iwork:], minmn, rwork[irwork:])
+		impl.Zlacpy(blas.All, m, minmn, work[iwork:], minmn, u, ldu)
+	}
+	if wantvas {
+		impl.Zlarcm(minmn, n, rwork[ivtr:], minmn, vt, ldvt, work[iwork:], n, rwork[irwork:])
+		impl.Zlacpy(blas.All, minmn, n, work[iwork:], n, vt, ldvt)
+	}
+
+	// Undo scaling if necessary.
+	if iscl {
+		if anrm > bignum {
+			impl.Dlascl(lapack.General, 0, 0, bignum, anrm, 1, minmn, s, minmn)
+		}
+		if anrm < smlnum {
+			impl.Dlascl(lapack.General, 0, 0, smlnum, anrm, 1, minmn, s, minmn)
+		}
+	}
+	work[0] = complex(float64(minwork), 0)
+	return ok
+}
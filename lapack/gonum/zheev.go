@@ -0,0 +1,149 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zheev computes all eigenvalues and, optionally, the eigenvectors of a
+// complex Hermitian matrix A.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Zheev will panic otherwise.
+//
+// On entry, a contains the elements of the Hermitian matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= max(1,2*n-1) if jobz == lapack.EVNone and
+// lwork >= max(1,2*n-1,n*n) if jobz == lapack.EVCompute, and Zheev will panic
+// otherwise. If lwork == -1, instead of computing Zheev the optimal work length
+// is stored into work[0].
+//
+// rwork is real temporary storage. It must have length at least max(1,n-1) if
+// jobz == lapack.EVNone and at least n+3*n*n if jobz == lapack.EVCompute, and
+// Zheev will panic otherwise.
+//
+// Zheev returns whether the computation of the eigenvalues converged.
+func (impl Implementation) Zheev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool) {
+	wantz := jobz == lapack.EVCompute
+	minwork := max(1, 2*n-1)
+	if wantz {
+		minwork = max(minwork, n*n)
+	}
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < minwork && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	if lwork == -1 {
+		work[0] = complex(float64(minwork), 0)
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	minrwork := max(1, n-1)
+	if wantz {
+		minrwork = n + 3*n*n
+	}
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	case len(rwork) < minrwork:
+		panic(shortRWork)
+	}
+
+	if n == 1 {
+		w[0] = real(a[0])
+		work[0] = 1
+		if wantz {
+			a[0] = 1
+		}
+		return true
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Sqrt(bignum)
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := impl.Zlanhe(lapack.MaxAbs, uplo, n, a, lda, nil)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Zlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+	}
+
+	// Reduce A to real symmetric tridiagonal form.
+	var inde int
+	var indtau int
+	indwork := indtau + n
+	impl.Zhetd2(uplo, n, a, lda, w, rwork[inde:], work[indtau:])
+
+	// For eigenvalues only, call Dsterf. For eigenvectors, first call Zungtr
+	// to generate the unitary matrix, then compute the eigenvectors of the
+	// tridiagonal matrix with Dsteqr and apply them to Q.
+	if !wantz {
+		ok = impl.Dsterf(n, w, rwork[inde:])
+	} else {
+		impl.Zungtr(uplo, n, a, lda, work[indtau:], work[indwork:], lwork-indwork)
+		indz := inde + n
+		indrwork := indz + n*n
+		ok = impl.Dsteqr(lapack.EVTridiag, n, w, rwork[inde:], rwork[indz:], n, rwork[indrwork:])
+		if ok {
+			// Form the eigenvectors of A as Q * Z.
+			impl.Zlacrm(n, n, a, lda, rwork[indz:], n, work, n, rwork[indrwork:])
+			impl.Zlacpy(blas.All, n, n, work, n, a, lda)
+		}
+	}
+	if !ok {
+		return false
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi := blas64.Implementation()
+		bi.Dscal(n, 1/sigma, w, 1)
+	}
+	work[0] = complex(float64(minwork), 0)
+	return true
+}
@@ -0,0 +1,35 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "math/cmplx"
+
+// Zlacgv conjugates the n elements of the complex vector x with increment
+// incX in place.
+//
+// Zlacgv is an internal routine. It is exported for testing purposes.
+func (Implementation) Zlacgv(n int, x []complex128, incX int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case incX == 0:
+		panic(zeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	if incX < 0 {
+		incX = -incX
+	}
+	if len(x) < 1+(n-1)*incX {
+		panic(shortX)
+	}
+	for i := 0; i < n*incX; i += incX {
+		x[i] = cmplx.Conj(x[i])
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlacn2 estimates the 1-norm of a complex n×n matrix A using sequential
+// updates with matrix-vector products provided externally.
+//
+// Zlacn2 is called sequentially and it returns the value of est and kase to be
+// used on the next call.
+// On the initial call, kase must be 0.
+// In between calls, x must be overwritten by
+//  A * X    if kase was returned as 1,
+//  A^H * X  if kase was returned as 2,
+// and all other parameters must not be changed.
+// On the final return, kase is returned as 0, v contains A*W where W is a
+// vector, and est = norm(V)/norm(W) is a lower bound for 1-norm of A.
+//
+// v and x must both have length n and n must be at least 1, otherwise Zlacn2
+// will panic. isave is used for temporary storage.
+//
+// Zlacn2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlacn2(n int, v, x []complex128, est float64, kase int, isave *[3]int) (float64, int) {
+	switch {
+	case n < 1:
+		panic(nLT1)
+	case len(v) < n:
+		panic(shortV)
+	case len(x) < n:
+		panic(shortX)
+	case isave[0] < 0 || 5 < isave[0]:
+		panic(badIsave)
+	case isave[0] == 0 && kase != 0:
+		panic(badIsave)
+	}
+
+	const itmax = 5
+	bi := cblas128.Implementation()
+
+	// sign replaces each element of x by x/|x|, or by 1 if it is too small.
+	sign := func() {
+		for i, xi := range x[:n] {
+			absxi := cmplx.Abs(xi)
+			if absxi > dlamchS {
+				x[i] = xi / complex(absxi, 0)
+			} else {
+				x[i] = 1
+			}
+		}
+	}
+
+	if kase == 0 {
+		for i := 0; i < n; i++ {
+			x[i] = complex(1/float64(n), 0)
+		}
+		kase = 1
+		isave[0] = 1
+		return est, kase
+	}
+	switch isave[0] {
+	default:
+		panic(badIsave)
+	case 1:
+		// X has been overwritten by A * X.
+		if n == 1 {
+			v[0] = x[0]
+			est = cmplx.Abs(v[0])
+			kase = 0
+			return est, kase
+		}
+		est = zsum1(n, x)
+		sign()
+		kase = 2
+		isave[0] = 2
+		return est, kase
+	case 2:
+		// X has been overwritten by A^H * X.
+		isave[1] = izmax1(n, x)
+		isave[2] = 2
+		for i := 0; i < n; i++ {
+			x[i] = 0
+		}
+		x[isave[1]] = 1
+		kase = 1
+		isave[0] = 3
+		return est, kase
+	case 3:
+		// X has been overwritten by A * X.
+		bi.Zcopy(n, x, 1, v, 1)
+		estold := est
+		est = zsum1(n, v)
+		if est > estold {
+			sign()
+			kase = 2
+			isave[0] = 4
+			return est, kase
+		}
+	case 4:
+		// X has been overwritten by A^H * X.
+		jlast := isave[1]
+		isave[1] = izmax1(n, x)
+		if cmplx.Abs(x[jlast]) != cmplx.Abs(x[isave[1]]) && isave[2] < itmax {
+			isave[2]++
+			for i := 0; i < n; i++ {
+				x[i] = 0
+			}
+			x[isave[1]] = 1
+			kase = 1
+			isave[0] = 3
+			return est, kase
+		}
+	case 5:
+		// X has been overwritten by A * X.
+		temp := 2 * zsum1(n, x) / float64(3*n)
+		if temp > est {
+			bi.Zcopy(n, x, 1, v, 1)
+			est = temp
+		}
+		kase = 0
+		return est, kase
+	}
+	// Iteration complete. Final stage.
+	altsgn := 1.0
+	for i := 0; i < n; i++ {
+		x[i] = complex(altsgn*(1+float64(i)/float64(n-1)), 0)
+		altsgn = -altsgn
+	}
+	kase = 1
+	isave[0] = 5
+	return est, kase
+}
+
+// zsum1 returns the sum of the absolute values of the elements of x.
+func zsum1(n int, x []complex128) float64 {
+	var sum float64
+	for _, v := range x[:n] {
+		sum += cmplx.Abs(v)
+	}
+	return sum
+}
+
+// izmax1 returns the index of the first element of x with the largest
+// absolute value.
+func izmax1(n int, x []complex128) int {
+	var idx int
+	var max float64
+	for i, v := range x[:n] {
+		if a := cmplx.Abs(v); a > max {
+			idx, max = i, a
+		}
+	}
+	return idx
+}
@@ -0,0 +1,75 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Zlacrm computes the product of a complex m×n matrix A and a real n×n
+// matrix B,
+//  C = A * B,
+// storing the result in the complex m×n matrix C. C must not overlap A.
+//
+// rwork must have length at least 2*m*n, otherwise Zlacrm will panic.
+//
+// Zlacrm is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlacrm(m, n int, a []complex128, lda int, b []float64, ldb int, c []complex128, ldc int, rwork []float64) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldc < max(1, n):
+		panic(badLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(rwork) < 2*m*n:
+		panic(shortRWork)
+	}
+
+	bi := blas64.Implementation()
+	l := m * n
+	for i := 0; i < m; i++ {
+		for j, v := range a[i*lda : i*lda+n] {
+			rwork[i*n+j] = real(v)
+		}
+	}
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, rwork, n, b, ldb, 0, rwork[l:], n)
+	for i := 0; i < m; i++ {
+		for j := range c[i*ldc : i*ldc+n] {
+			c[i*ldc+j] = complex(rwork[l+i*n+j], 0)
+		}
+	}
+
+	for i := 0; i < m; i++ {
+		for j, v := range a[i*lda : i*lda+n] {
+			rwork[i*n+j] = imag(v)
+		}
+	}
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, rwork, n, b, ldb, 0, rwork[l:], n)
+	for i := 0; i < m; i++ {
+		for j, v := range c[i*ldc : i*ldc+n] {
+			c[i*ldc+j] = complex(real(v), rwork[l+i*n+j])
+		}
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlahqr computes the eigenvalues and Schur factorization of a block of an n×n
+// complex upper Hessenberg matrix H, using the single-shift QR algorithm.
+//
+// h and ldh represent the matrix H. Zlahqr works primarily with the Hessenberg
+// submatrix H[ilo:ihi+1,ilo:ihi+1], but applies transformations to all of H if
+// wantt is true. It is assumed that H[ihi+1:n,ihi+1:n] is already upper
+// triangular, although this is not checked.
+//
+// It must hold that
+//  0 <= ilo <= max(0,ihi), and ihi < n,
+// and that
+//  H[ilo,ilo-1] == 0,  if ilo > 0,
+// otherwise Zlahqr will panic.
+//
+// If unconverged is zero on return, w[ilo:ihi+1] will contain the computed
+// eigenvalues ilo to ihi. If wantt is true, the eigenvalues are stored in the
+// same order as on the diagonal of the Schur form returned in H, with
+// w[i] = H[i,i].
+//
+// w must have length ihi+1.
+//
+// z and ldz represent an n×n matrix Z. If wantz is true, the transformations
+// will be applied to the submatrix Z[iloz:ihiz+1,ilo:ihi+1] and it must hold that
+//  0 <= iloz <= ilo, and ihi <= ihiz < n.
+// If wantz is false, z is not referenced.
+//
+// unconverged indicates whether Zlahqr computed all the eigenvalues ilo to ihi
+// in a total of 30 iterations per eigenvalue.
+//
+// If unconverged is zero, all the eigenvalues ilo to ihi have been computed and
+// will be stored on return in w[ilo:ihi+1].
+//
+// If unconverged is zero and wantt is true, H[ilo:ihi+1,ilo:ihi+1] will be
+// overwritten on return by the upper triangular Schur form.
+//
+// If unconverged is zero and if wantt is false, the contents of h on return is
+// unspecified.
+//
+// If unconverged is positive, some eigenvalues have not converged, and
+// w[unconverged:ihi+1] contains those eigenvalues which have been successfully
+// computed.
+//
+// If unconverged is positive and wantt is true, then on return
+//  (initial H)*U = U*(final H),   (*)
+// where U is a unitary matrix. The final H is upper Hessenberg and
+// H[unconverged:ihi+1,unconverged:ihi+1] is upper triangular.
+//
+// If unconverged is positive and wantt is false, on return the remaining
+// unconverged eigenvalues are the eigenvalues of the upper Hessenberg matrix
+// H[ilo:unconverged,ilo:unconverged].
+//
+// If unconverged is positive and wantz is true, then on return
+//  (final Z) = (initial Z)*U,
+// where U is the unitary matrix in (*) regardless of the value of wantt.
+//
+// Zlahqr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlahqr(wantt, wantz bool, n, ilo, ihi int, h []complex128, ldh int, w []complex128, iloz, ihiz int, z []complex128, ldz int) (unconverged int) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case ilo < 0, max(0, ihi) < ilo:
+		panic(badIlo)
+	case ihi >= n:
+		panic(badIhi)
+	case ldh < max(1, n):
+		panic(badLdH)
+	case wantz && (iloz < 0 || ilo < iloz):
+		panic(badIloz)
+	case wantz && (ihiz < ihi || n <= ihiz):
+		panic(badIhiz)
+	case ldz < 1, wantz && ldz < n:
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(h) < (n-1)*ldh+n:
+		panic(shortH)
+	case len(w) != ihi+1:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	case ilo > 0 && h[ilo*ldh+ilo-1] != 0:
+		panic(notIsolated)
+	}
+
+	if ilo == ihi {
+		w[ilo] = h[ilo*ldh+ilo]
+		return 0
+	}
+
+	// Clear out the trash.
+	for j := ilo; j < ihi-2; j++ {
+		h[(j+2)*ldh+j] = 0
+		h[(j+3)*ldh+j] = 0
+	}
+	if ilo <= ihi-2 {
+		h[ihi*ldh+ihi-2] = 0
+	}
+
+	bi := cblas128.Implementation()
+
+	// Ensure that the subdiagonal entries are real.
+	var jlo, jhi int
+	if wantt {
+		jlo = 0
+		jhi = n - 1
+	} else {
+		jlo = ilo
+		jhi = ihi
+	}
+	for i := ilo + 1; i <= ihi; i++ {
+		if imag(h[i*ldh+i-1]) != 0 {
+			sc := h[i*ldh+i-1] / complex(cabs1(h[i*ldh+i-1]), 0)
+			sc = cmplx.Conj(sc) / complex(cmplx.Abs(sc), 0)
+			h[i*ldh+i-1] = complex(cmplx.Abs(h[i*ldh+i-1]), 0)
+			bi.Zscal(jhi-i+1, sc, h[i*ldh+i:], 1)
+			bi.Zscal(min(jhi, i+1)-jlo+1, cmplx.Conj(sc), h[jlo*ldh+i:], ldh)
+			if wantz {
+				bi.Zscal(ihiz-iloz+1, cmplx.Conj(sc), z[iloz*ldz+i:], ldz)
+			}
+		}
+	}
+
+	nh := ihi - ilo + 1
+	nz := ihiz - iloz + 1
+
+	// Set machine-dependent constants for the stopping criterion.
+	ulp := dlamchP
+	smlnum := float64(nh) / ulp * dlamchS
+
+	// i1 and i2 are the indices of the first row and last column of H to
+	// which transformations must be applied. If eigenvalues only are being
+	// computed, i1 and i2 are set inside the main loop.
+	var i1, i2 int
+	if wantt {
+		i1 = 0
+		i2 = n - 1
+	}
+
+	itmax := 30 * max(10, nh)
+
+	const (
+		dat1  = 0.75
+		kexsh = 10
+	)
+
+	// kdefl counts the number of iterations since a deflation.
+	var kdefl int
+
+	// The main loop begins here. i is the loop index and decreases from ihi
+	// to ilo in steps of 1. Each iteration of the loop works with the active
+	// submatrix in rows and columns l to i. Eigenvalues i+1 to ihi have
+	// already converged. Either l = ilo, or H[l,l-1] is negligible so that
+	// the matrix splits.
+	i := ihi
+	for i >= ilo {
+		l := ilo
+
+		// Perform QR iterations on rows and columns ilo to i until a
+		// submatrix of order 1 splits off at the bottom because a
+		// subdiagonal element has become negligible.
+		converged := false
+		for its := 0; its <= itmax; its++ {
+			// Look for a single small subdiagonal element.
+			var k int
+			for k = i; k > l; k-- {
+				if cabs1(h[k*ldh+k-1]) <= smlnum {
+					break
+				}
+				tst := cabs1(h[(k-1)*ldh+k-1]) + cabs1(h[k*ldh+k])
+				if tst == 0 {
+					if k-2 >= ilo {
+						tst += math.Abs(real(h[(k-1)*ldh+k-2]))
+					}
+					if k+1 <= ihi {
+						tst += math.Abs(real(h[(k+1)*ldh+k]))
+					}
+				}
+				// The following is a conservative small subdiagonal
+				// deflation criterion due to Ahues & Kahan (1997). It has
+				// better mathematical foundation and improves accuracy in
+				// some examples.
+				if math.Abs(real(h[k*ldh+k-1])) <= ulp*tst {
+					v1 := cabs1(h[k*ldh+k-1])
+					v2 := cabs1(h[(k-1)*ldh+k])
+					ab := math.Max(v1, v2)
+					ba := math.Min(v1, v2)
+					v1 = cabs1(h[k*ldh+k])
+					v2 = cabs1(h[(k-1)*ldh+k-1] - h[k*ldh+k])
+					aa := math.Max(v1, v2)
+					bb := math.Min(v1, v2)
+					s := aa + ab
+					if ab/s*ba <= math.Max(smlnum, aa/s*bb*ulp) {
+						break
+					}
+				}
+			}
+			l = k
+			if l > ilo {
+				// H[l,l-1] is negligible.
+				h[l*ldh+l-1] = 0
+			}
+			if l >= i {
+				// A submatrix of order 1 has split off.
+				converged = true
+				break
+			}
+			kdefl++
+
+			// Now the active submatrix is in rows and columns l to i. If
+			// eigenvalues only are being computed, only the active
+			// submatrix need be transformed.
+			if !wantt {
+				i1 = l
+				i2 = i
+			}
+
+			var t complex128
+			switch {
+			case kdefl%(2*kexsh) == 0:
+				// Exceptional shift.
+				s := dat1 * math.Abs(real(h[i*ldh+i-1]))
+				t = complex(s, 0) + h[i*ldh+i]
+			case kdefl%kexsh == 0:
+				// Exceptional shift.
+				s := dat1 * math.Abs(real(h[(l+1)*ldh+l]))
+				t = complex(s, 0) + h[l*ldh+l]
+			default:
+				// Wilkinson's shift.
+				t = h[i*ldh+i]
+				u := cmplx.Sqrt(h[(i-1)*ldh+i]) * cmplx.Sqrt(h[i*ldh+i-1])
+				s := cabs1(u)
+				if s != 0 {
+					x := 0.5 * (h[(i-1)*ldh+i-1] - t)
+					sx := cabs1(x)
+					s = math.Max(s, sx)
+					cs := complex(s, 0)
+					y := cs * cmplx.Sqrt((x/cs)*(x/cs)+(u/cs)*(u/cs))
+					if sx > 0 {
+						xs := x / complex(sx, 0)
+						if real(xs)*real(y)+imag(xs)*imag(y) < 0 {
+							y = -y
+						}
+					}
+					t -= u * (u / (x + y))
+				}
+			}
+
+			// Look for two consecutive small subdiagonal elements.
+			var m int
+			var v [2]complex128
+			for m = i - 1; m >= l; m-- {
+				// Determine the effect of starting the single-shift QR
+				// iteration at row m, and see if this would make H[m,m-1]
+				// negligible.
+				h11 := h[m*ldh+m]
+				h22 := h[(m+1)*ldh+m+1]
+				h11s := h11 - t
+				h21 := real(h[(m+1)*ldh+m])
+				s := cabs1(h11s) + math.Abs(h21)
+				h11s /= complex(s, 0)
+				h21 /= s
+				v[0] = h11s
+				v[1] = complex(h21, 0)
+				if m == l {
+					break
+				}
+				h10 := real(h[m*ldh+m-1])
+				if math.Abs(h10)*math.Abs(h21) <= ulp*(cabs1(h11s)*(cabs1(h11)+cabs1(h22))) {
+					break
+				}
+			}
+
+			// Single-shift QR step.
+			for k := m; k < i; k++ {
+				// The first iteration of this loop determines a reflection G
+				// from the vector v and applies it from left and right to H,
+				// thus creating a non-zero bulge below the subdiagonal.
+				//
+				// Each subsequent iteration determines a reflection G to
+				// restore the Hessenberg form in the (k-1)th column, and thus
+				// chases the bulge one step toward the bottom of the active
+				// submatrix.
+				//
+				// v[1] is always real before the call to Zlarfg, and hence
+				// after the call t2 (= t1*v[1]) is also real.
+				if k > m {
+					v[0] = h[k*ldh+k-1]
+					v[1] = h[(k+1)*ldh+k-1]
+				}
+				var t1 complex128
+				v[0], t1 = impl.Zlarfg(2, v[0], v[1:], 1)
+				if k > m {
+					h[k*ldh+k-1] = v[0]
+					h[(k+1)*ldh+k-1] = 0
+				}
+				v2 := v[1]
+				t2 := real(t1 * v2)
+
+				// Apply G from the left to transform the rows of the matrix
+				// in columns k to i2.
+				for j := k; j <= i2; j++ {
+					sum := cmplx.Conj(t1)*h[k*ldh+j] + complex(t2, 0)*h[(k+1)*ldh+j]
+					h[k*ldh+j] -= sum
+					h[(k+1)*ldh+j] -= sum * v2
+				}
+
+				// Apply G from the right to transform the columns of the
+				// matrix in rows i1 to min(k+2,i).
+				for j := i1; j <= min(k+2, i); j++ {
+					sum := t1*h[j*ldh+k] + complex(t2, 0)*h[j*ldh+k+1]
+					h[j*ldh+k] -= sum
+					h[j*ldh+k+1] -= sum * cmplx.Conj(v2)
+				}
+
+				if wantz {
+					// Accumulate transformations in the matrix Z.
+					for j := iloz; j <= ihiz; j++ {
+						sum := t1*z[j*ldz+k] + complex(t2, 0)*z[j*ldz+k+1]
+						z[j*ldz+k] -= sum
+						z[j*ldz+k+1] -= sum * cmplx.Conj(v2)
+					}
+				}
+
+				if k == m && m > l {
+					// If the QR step was started at row m > l because two
+					// consecutive small subdiagonals in rows m-1 and m were
+					// found, then extra scaling must be performed to ensure
+					// that H[m,m-1] remains real.
+					temp := 1 - t1
+					temp /= complex(cmplx.Abs(temp), 0)
+					h[(m+1)*ldh+m] *= cmplx.Conj(temp)
+					if m+2 <= i {
+						h[(m+2)*ldh+m+1] *= temp
+					}
+					for j := m; j <= i; j++ {
+						if j == m+1 {
+							continue
+						}
+						if i2 > j {
+							bi.Zscal(i2-j, temp, h[j*ldh+j+1:], 1)
+						}
+						bi.Zscal(j-i1, cmplx.Conj(temp), h[i1*ldh+j:], ldh)
+						if wantz {
+							bi.Zscal(nz, cmplx.Conj(temp), z[iloz*ldz+j:], ldz)
+						}
+					}
+				}
+			}
+
+			// Ensure that H[i,i-1] is real.
+			temp := h[i*ldh+i-1]
+			if imag(temp) != 0 {
+				rtemp := cmplx.Abs(temp)
+				h[i*ldh+i-1] = complex(rtemp, 0)
+				temp /= complex(rtemp, 0)
+				if i2 > i {
+					bi.Zscal(i2-i, cmplx.Conj(temp), h[i*ldh+i+1:], 1)
+				}
+				bi.Zscal(i-i1, temp, h[i1*ldh+i:], ldh)
+				if wantz {
+					bi.Zscal(nz, temp, z[iloz*ldz+i:], ldz)
+				}
+			}
+		}
+
+		if !converged {
+			// The QR iteration finished without splitting off a
+			// submatrix of order 1.
+			return i + 1
+		}
+
+		// H[i,i-1] is negligible: one eigenvalue has converged.
+		w[i] = h[i*ldh+i]
+
+		// Reset the deflation counter.
+		kdefl = 0
+
+		// Return to start of the main loop with new value of i.
+		i = l - 1
+	}
+	return 0
+}
@@ -0,0 +1,108 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zlange computes the matrix norm of the general complex m×n matrix a. The
+// input norm specifies the norm computed.
+//  lapack.MaxAbs: the maximum absolute value of an element.
+//  lapack.MaxColumnSum: the maximum column sum of the absolute values of the entries.
+//  lapack.MaxRowSum: the maximum row sum of the absolute values of the entries.
+//  lapack.Frobenius: the square root of the sum of the squares of the entries.
+// If norm == lapack.MaxColumnSum, work must be of length n, and this function will panic otherwise.
+// There are no restrictions on work for the other matrix norms.
+func (impl Implementation) Zlange(norm lapack.MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(badNorm)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case norm == lapack.MaxColumnSum && len(work) < n:
+		panic(shortWork)
+	}
+
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		for i := 0; i < m; i++ {
+			for _, v := range a[i*lda : i*lda+n] {
+				value = math.Max(value, cmplx.Abs(v))
+			}
+		}
+	case lapack.MaxColumnSum:
+		for j := 0; j < n; j++ {
+			work[j] = 0
+		}
+		for i := 0; i < m; i++ {
+			for j, v := range a[i*lda : i*lda+n] {
+				work[j] += cmplx.Abs(v)
+			}
+		}
+		for _, v := range work[:n] {
+			value = math.Max(value, v)
+		}
+	case lapack.MaxRowSum:
+		for i := 0; i < m; i++ {
+			var sum float64
+			for _, v := range a[i*lda : i*lda+n] {
+				sum += cmplx.Abs(v)
+			}
+			value = math.Max(value, sum)
+		}
+	case lapack.Frobenius:
+		scale, sum := 0.0, 1.0
+		for i := 0; i < m; i++ {
+			scale, sum = zlassq(n, a[i*lda:], 1, scale, sum)
+		}
+		value = scale * math.Sqrt(sum)
+	}
+	return value
+}
+
+// zlassq returns the values scl and smsq such that
+//  scl^2 * smsq = X[0]^2 + ... + X[n-1]^2 + scale^2 * sumsq,
+// where the squares of the complex elements of x are taken to be the sums of
+// the squares of their real and imaginary parts. The value of sumsq is
+// assumed to be at least one.
+func zlassq(n int, x []complex128, incx int, scale float64, sumsq float64) (scl, smsq float64) {
+	for ix := 0; ix < n*incx; ix += incx {
+		for _, v := range [2]float64{real(x[ix]), imag(x[ix])} {
+			if v == 0 {
+				continue
+			}
+			absxi := math.Abs(v)
+			if math.IsNaN(absxi) {
+				return math.NaN(), math.NaN()
+			}
+			if scale < absxi {
+				sumsq = 1 + sumsq*(scale/absxi)*(scale/absxi)
+				scale = absxi
+			} else {
+				sumsq += (absxi / scale) * (absxi / scale)
+			}
+		}
+	}
+	return scale, sumsq
+}
@@ -0,0 +1,108 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zlanhe computes the specified norm of an n×n Hermitian matrix. Only the
+// triangle of a specified by uplo is referenced and the imaginary parts of the
+// diagonal elements are assumed to be zero. If norm == lapack.MaxColumnSum or
+// norm == lapack.MaxRowSum work must have length at least n, otherwise work
+// is unused.
+func (impl Implementation) Zlanhe(norm lapack.MatrixNorm, uplo blas.Uplo, n int, a []complex128, lda int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(badNorm)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case (norm == lapack.MaxColumnSum || norm == lapack.MaxRowSum) && len(work) < n:
+		panic(shortWork)
+	}
+
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		for i := 0; i < n; i++ {
+			value = math.Max(value, math.Abs(real(a[i*lda+i])))
+			var off []complex128
+			if uplo == blas.Upper {
+				off = a[i*lda+i+1 : i*lda+n]
+			} else {
+				off = a[i*lda : i*lda+i]
+			}
+			for _, v := range off {
+				value = math.Max(value, cmplx.Abs(v))
+			}
+		}
+	case lapack.MaxColumnSum, lapack.MaxRowSum:
+		// The one and infinity norms of a Hermitian matrix are equal.
+		for i := 0; i < n; i++ {
+			work[i] = math.Abs(real(a[i*lda+i]))
+		}
+		for i := 0; i < n; i++ {
+			if uplo == blas.Upper {
+				for j := i + 1; j < n; j++ {
+					v := cmplx.Abs(a[i*lda+j])
+					work[i] += v
+					work[j] += v
+				}
+			} else {
+				for j := 0; j < i; j++ {
+					v := cmplx.Abs(a[i*lda+j])
+					work[i] += v
+					work[j] += v
+				}
+			}
+		}
+		for _, v := range work[:n] {
+			value = math.Max(value, v)
+		}
+	case lapack.Frobenius:
+		scale, sum := 0.0, 1.0
+		for i := 0; i < n; i++ {
+			if uplo == blas.Upper {
+				scale, sum = zlassq(n-i-1, a[i*lda+i+1:], 1, scale, sum)
+			} else {
+				scale, sum = zlassq(i, a[i*lda:], 1, scale, sum)
+			}
+		}
+		// The off-diagonal elements are counted twice.
+		sum *= 2
+		for i := 0; i < n; i++ {
+			aii := math.Abs(real(a[i*lda+i]))
+			if aii == 0 {
+				continue
+			}
+			if scale < aii {
+				sum = 1 + sum*(scale/aii)*(scale/aii)
+				scale = aii
+			} else {
+				sum += (aii / scale) * (aii / scale)
+			}
+		}
+		value = scale * math.Sqrt(sum)
+	}
+	return value
+}
@@ -0,0 +1,122 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zlantr computes the specified norm of a complex m×n trapezoidal matrix A. If
+// norm == lapack.MaxColumnSum work must have length at least n, otherwise work
+// is unused.
+func (impl Implementation) Zlantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []complex128, lda int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(badNorm)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case diag != blas.Unit && diag != blas.NonUnit:
+		panic(badDiag)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if min(m, n) == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case norm == lapack.MaxColumnSum && len(work) < n:
+		panic(shortWork)
+	}
+
+	// row returns the stored part of row i of the trapezoid, excluding
+	// the diagonal element if A is unit triangular, and the column index of
+	// its first element.
+	row := func(i int) ([]complex128, int) {
+		var jlo, jhi int
+		if uplo == blas.Upper {
+			jlo, jhi = i, n
+		} else {
+			jlo, jhi = 0, min(i+1, n)
+		}
+		if diag == blas.Unit && i < n {
+			if uplo == blas.Upper {
+				jlo++
+			} else {
+				jhi--
+			}
+		}
+		if jlo >= jhi {
+			return nil, jlo
+		}
+		return a[i*lda+jlo : i*lda+jhi], jlo
+	}
+	unit := diag == blas.Unit
+
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		if unit {
+			value = 1
+		}
+		for i := 0; i < m; i++ {
+			r, _ := row(i)
+			for _, v := range r {
+				value = math.Max(value, cmplx.Abs(v))
+			}
+		}
+	case lapack.MaxColumnSum:
+		for j := 0; j < n; j++ {
+			work[j] = 0
+		}
+		for i := 0; i < m; i++ {
+			r, jlo := row(i)
+			for j, v := range r {
+				work[jlo+j] += cmplx.Abs(v)
+			}
+			if unit && i < n {
+				work[i]++
+			}
+		}
+		for _, v := range work[:n] {
+			value = math.Max(value, v)
+		}
+	case lapack.MaxRowSum:
+		for i := 0; i < m; i++ {
+			var sum float64
+			if unit && i < n {
+				sum = 1
+			}
+			r, _ := row(i)
+			for _, v := range r {
+				sum += cmplx.Abs(v)
+			}
+			value = math.Max(value, sum)
+		}
+	case lapack.Frobenius:
+		scale, sum := 0.0, 1.0
+		if unit {
+			scale, sum = 1, float64(min(m, n))
+		}
+		for i := 0; i < m; i++ {
+			r, _ := row(i)
+			scale, sum = zlassq(len(r), r, 1, scale, sum)
+		}
+		value = scale * math.Sqrt(sum)
+	}
+	return value
+}
@@ -0,0 +1,173 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zlarfb applies a complex block reflector H or its conjugate transpose H^H
+// to the m×n matrix C:
+//  C = H * C    if side == blas.Left and trans == blas.NoTrans
+//  C = C * H    if side == blas.Right and trans == blas.NoTrans
+//  C = H^H * C  if side == blas.Left and trans == blas.ConjTrans
+//  C = C * H^H  if side == blas.Right and trans == blas.ConjTrans
+// H is the product of k elementary reflectors
+//  H = I - V * T * V^H = H_0 * H_1 * ... * H_{k-1},
+// where T is the upper triangular k×k matrix computed by Zlarft and the
+// columns of V hold the vectors defining the reflectors, with the unit
+// diagonal implicitly represented as described in the documentation of
+// Dlarfb. V is an m×k matrix if side == blas.Left and an n×k matrix
+// otherwise.
+//
+// Only direct == lapack.Forward and store == lapack.ColumnWise are currently
+// supported, and Zlarfb will panic otherwise.
+//
+// work must be a matrix of size n×k if side == blas.Left and m×k if
+// side == blas.Right, with stride ldwork.
+//
+// Zlarfb is an internal routine. It is exported for testing purposes.
+func (Implementation) Zlarfb(side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, m, n, k int, v []complex128, ldv int, t []complex128, ldt int, c []complex128, ldc int, work []complex128, ldwork int) {
+	nv := m
+	if side == blas.Right {
+		nv = n
+	}
+	switch {
+	case side != blas.Left && side != blas.Right:
+		panic(badSide)
+	case trans != blas.NoTrans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case direct != lapack.Forward:
+		panic(badDirect)
+	case store != lapack.ColumnWise:
+		panic(badStoreV)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case side == blas.Left && k > m:
+		panic(kGTM)
+	case side == blas.Right && k > n:
+		panic(kGTN)
+	case ldv < max(1, k):
+		panic(badLdV)
+	case ldt < max(1, k):
+		panic(badLdT)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case ldwork < max(1, k):
+		panic(badLdWork)
+	}
+
+	if m == 0 || n == 0 {
+		return
+	}
+
+	nw := n
+	if side == blas.Right {
+		nw = m
+	}
+	switch {
+	case len(v) < (nv-1)*ldv+k:
+		panic(shortV)
+	case len(t) < (k-1)*ldt+k:
+		panic(shortT)
+	case len(c) < (m-1)*ldc+n:
+		panic(shortC)
+	case len(work) < (nw-1)*ldwork+k:
+		panic(shortWork)
+	}
+
+	bi := cblas128.Implementation()
+
+	// transt is the operation applied to T.
+	transt := blas.ConjTrans
+	if trans == blas.ConjTrans {
+		transt = blas.NoTrans
+	}
+
+	if side == blas.Left {
+		// Form H * C or H^H * C where C = [C1; C2] and V = [V1; V2]
+		// with V1 unit lower triangular.
+
+		// W = C^H * V = C1^H * V1 + C2^H * V2, stored in work.
+		for j := 0; j < k; j++ {
+			for i := 0; i < n; i++ {
+				work[i*ldwork+j] = cmplx.Conj(c[j*ldc+i])
+			}
+		}
+		bi.Ztrmm(blas.Right, blas.Lower, blas.NoTrans, blas.Unit, n, k,
+			1, v, ldv,
+			work, ldwork)
+		if m > k {
+			bi.Zgemm(blas.ConjTrans, blas.NoTrans, n, k, m-k,
+				1, c[k*ldc:], ldc, v[k*ldv:], ldv,
+				1, work, ldwork)
+		}
+		// W = W * T^H or W * T.
+		bi.Ztrmm(blas.Right, blas.Upper, transt, blas.NonUnit, n, k,
+			1, t, ldt,
+			work, ldwork)
+		// C = C - V * W^H.
+		if m > k {
+			bi.Zgemm(blas.NoTrans, blas.ConjTrans, m-k, n, k,
+				-1, v[k*ldv:], ldv, work, ldwork,
+				1, c[k*ldc:], ldc)
+		}
+		// W = W * V1^H.
+		bi.Ztrmm(blas.Right, blas.Lower, blas.ConjTrans, blas.Unit, n, k,
+			1, v, ldv,
+			work, ldwork)
+		// C1 = C1 - W^H.
+		for i := 0; i < n; i++ {
+			for j := 0; j < k; j++ {
+				c[j*ldc+i] -= cmplx.Conj(work[i*ldwork+j])
+			}
+		}
+		return
+	}
+
+	// Form C * H or C * H^H where C = [C1 C2] and V = [V1; V2] with V1
+	// unit lower triangular.
+
+	// W = C * V = C1 * V1 + C2 * V2, stored in work.
+	for i := 0; i < m; i++ {
+		copy(work[i*ldwork:i*ldwork+k], c[i*ldc:i*ldc+k])
+	}
+	bi.Ztrmm(blas.Right, blas.Lower, blas.NoTrans, blas.Unit, m, k,
+		1, v, ldv,
+		work, ldwork)
+	if n > k {
+		bi.Zgemm(blas.NoTrans, blas.NoTrans, m, k, n-k,
+			1, c[k:], ldc, v[k*ldv:], ldv,
+			1, work, ldwork)
+	}
+	// W = W * T or W * T^H.
+	bi.Ztrmm(blas.Right, blas.Upper, trans, blas.NonUnit, m, k,
+		1, t, ldt,
+		work, ldwork)
+	// C = C - W * V^H.
+	if n > k {
+		bi.Zgemm(blas.NoTrans, blas.ConjTrans, m, n-k, k,
+			-1, work, ldwork, v[k*ldv:], ldv,
+			1, c[k:], ldc)
+	}
+	// W = W * V1^H.
+	bi.Ztrmm(blas.Right, blas.Lower, blas.ConjTrans, blas.Unit, m, k,
+		1, v, ldv,
+		work, ldwork)
+	// C1 = C1 - W.
+	for i := 0; i < m; i++ {
+		for j := 0; j < k; j++ {
+			c[i*ldc+j] -= work[i*ldwork+j]
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zlarfg generates a complex elementary reflector for a Householder matrix. It
+// creates a transformation
+//  H^H * [alpha] = [beta]
+//        [    x]   [   0]
+// where beta is real and the elementary reflector is defined by
+//  H = I - tau * [1] * [1 v^H],  H^H * H = I.
+//                [v]
+// Note that H is not Hermitian.
+//
+// If x is zero and alpha is real, tau is zero and H is the identity. Otherwise
+// 1 <= real(tau) <= 2 and |tau-1| <= 1.
+//
+// On entry, x contains the vector x, on exit it contains v.
+//
+// Zlarfg is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlarfg(n int, alpha complex128, x []complex128, incX int) (beta, tau complex128) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case incX <= 0:
+		panic(badIncX)
+	}
+
+	if n <= 0 {
+		return alpha, 0
+	}
+
+	if n > 1 && len(x) < 1+(n-2)*incX {
+		panic(shortX)
+	}
+
+	bi := cblas128.Implementation()
+
+	var xnorm float64
+	if n > 1 {
+		xnorm = bi.Dznrm2(n-1, x, incX)
+	}
+	alphr, alphi := real(alpha), imag(alpha)
+	if xnorm == 0 && alphi == 0 {
+		return alpha, 0
+	}
+	b := -math.Copysign(dlapy3(alphr, alphi, xnorm), alphr)
+	safmin := dlamchS / dlamchE
+	rsafmn := 1 / safmin
+	var knt int
+	if math.Abs(b) < safmin {
+		// xnorm and beta may be inaccurate, scale x and recompute.
+		for {
+			knt++
+			bi.Zdscal(n-1, rsafmn, x, incX)
+			b *= rsafmn
+			alphr *= rsafmn
+			alphi *= rsafmn
+			if math.Abs(b) >= safmin || knt >= 20 {
+				break
+			}
+		}
+		xnorm = bi.Dznrm2(n-1, x, incX)
+		b = -math.Copysign(dlapy3(alphr, alphi, xnorm), alphr)
+	}
+	tau = complex((b-alphr)/b, -alphi/b)
+	bi.Zscal(n-1, 1/(complex(alphr, alphi)-complex(b, 0)), x, incX)
+	for j := 0; j < knt; j++ {
+		b *= safmin
+	}
+	return complex(b, 0), tau
+}
+
+// dlapy3 returns sqrt(x^2 + y^2 + z^2), avoiding unnecessary overflow.
+func dlapy3(x, y, z float64) float64 {
+	x, y, z = math.Abs(x), math.Abs(y), math.Abs(z)
+	w := math.Max(x, math.Max(y, z))
+	if w == 0 || w > math.MaxFloat64 {
+		// w can be zero for max(0,nan,0) and adding all three
+		// values gives NaN and not zero.
+		return x + y + z
+	}
+	x /= w
+	y /= w
+	z /= w
+	return w * math.Sqrt(x*x+y*y+z*z)
+}
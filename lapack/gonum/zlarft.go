@@ -0,0 +1,84 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Zlarft forms the upper triangular factor T of a complex block reflector H,
+// storing the answer in t,
+//  H = I - V * T * V^H,
+// where H is defined by the product of the elementary reflectors
+//  H = H_0 * H_1 * ... * H_{k-1}.
+// The n×k matrix V holds the vectors defining the elementary reflectors in its
+// columns as described in the documentation of Dlarfb. The upper part of V
+// is not referenced.
+//
+// tau contains the scalar factors of the elementary reflectors H_i. t must be
+// a k×k matrix with stride ldt.
+//
+// Only direct == lapack.Forward and store == lapack.ColumnWise are currently
+// supported, and Zlarft will panic otherwise.
+//
+// Zlarft is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zlarft(direct lapack.Direct, store lapack.StoreV, n, k int, v []complex128, ldv int, tau []complex128, t []complex128, ldt int) {
+	switch {
+	case direct != lapack.Forward:
+		panic(badDirect)
+	case store != lapack.ColumnWise:
+		panic(badStoreV)
+	case n < 0:
+		panic(nLT0)
+	case k < 1:
+		panic(kLT1)
+	case ldv < max(1, k):
+		panic(badLdV)
+	case len(tau) < k:
+		panic(shortTau)
+	case ldt < max(1, k):
+		panic(badLdT)
+	}
+
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(v) < (n-1)*ldv+k:
+		panic(shortV)
+	case len(t) < (k-1)*ldt+k:
+		panic(shortT)
+	}
+
+	bi := cblas128.Implementation()
+	for i := 0; i < k; i++ {
+		if tau[i] == 0 {
+			// H_i = I.
+			for j := 0; j <= i; j++ {
+				t[j*ldt+i] = 0
+			}
+			continue
+		}
+		// T[0:i,i] = -tau[i] * V[i:n,0:i]^H * V[i:n,i], where the unit
+		// element V[i,i] is handled explicitly.
+		for j := 0; j < i; j++ {
+			t[j*ldt+i] = -tau[i] * cmplx.Conj(v[i*ldv+j])
+		}
+		if i < n-1 {
+			bi.Zgemv(blas.ConjTrans, n-i-1, i,
+				-tau[i], v[(i+1)*ldv:], ldv,
+				v[(i+1)*ldv+i:], ldv,
+				1, t[i:], ldt)
+		}
+		// T[0:i,i] = T[0:i,0:i] * T[0:i,i].
+		bi.Ztrmv(blas.Upper, blas.NoTrans, blas.NonUnit, i, t, ldt, t[i:], ldt)
+		t[i*ldt+i] = tau[i]
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zpocon estimates the reciprocal of the condition number of a Hermitian
+// positive-definite matrix A given the Cholesky decomposition of A. The
+// condition number computed is based on the 1-norm and the ∞-norm.
+//
+// anorm is the 1-norm and the ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Zpocon will panic otherwise.
+func (impl Implementation) Zpocon(uplo blas.Uplo, n int, a []complex128, lda int, anorm float64, work []complex128) float64 {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case anorm < 0:
+		panic(negANorm)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(work) < 2*n:
+		panic(shortWork)
+	}
+
+	if anorm == 0 {
+		return 0
+	}
+
+	bi := cblas128.Implementation()
+
+	var (
+		rcond  float64
+		ainvnm float64
+		kase   int
+		isave  [3]int
+	)
+	for {
+		ainvnm, kase = impl.Zlacn2(n, work[n:], work, ainvnm, kase, &isave)
+		if kase == 0 {
+			if ainvnm != 0 && !math.IsNaN(ainvnm) {
+				rcond = (1 / ainvnm) / anorm
+			}
+			return rcond
+		}
+		// A is Hermitian so A^-H = A^-1 and both kinds of product are
+		// computed in the same way.
+		if uplo == blas.Upper {
+			bi.Ztrsv(blas.Upper, blas.ConjTrans, blas.NonUnit, n, a, lda, work, 1)
+			bi.Ztrsv(blas.Upper, blas.NoTrans, blas.NonUnit, n, a, lda, work, 1)
+		} else {
+			bi.Ztrsv(blas.Lower, blas.NoTrans, blas.NonUnit, n, a, lda, work, 1)
+			bi.Ztrsv(blas.Lower, blas.ConjTrans, blas.NonUnit, n, a, lda, work, 1)
+		}
+	}
+}
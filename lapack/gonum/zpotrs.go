@@ -0,0 +1,62 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zpotrs solves a system of n linear equations A*X = B where A is an n×n
+// Hermitian positive definite matrix and B is an n×nrhs matrix. The matrix A is
+// represented by its Cholesky factorization
+//  A = U^H*U  if uplo == blas.Upper
+//  A = L*L^H  if uplo == blas.Lower
+// as computed by Zpotrf. On entry, B contains the right-hand side matrix B, on
+// return it contains the solution matrix X.
+func (Implementation) Zpotrs(uplo blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	bi := cblas128.Implementation()
+
+	if uplo == blas.Upper {
+		// Solve U^H * U * X = B where U is stored in the upper triangle of A.
+
+		// Solve U^H * X = B, overwriting B with X.
+		bi.Ztrsm(blas.Left, blas.Upper, blas.ConjTrans, blas.NonUnit, n, nrhs, 1, a, lda, b, ldb)
+		// Solve U * X = B, overwriting B with X.
+		bi.Ztrsm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, n, nrhs, 1, a, lda, b, ldb)
+	} else {
+		// Solve L * L^H * X = B where L is stored in the lower triangle of A.
+
+		// Solve L * X = B, overwriting B with X.
+		bi.Ztrsm(blas.Left, blas.Lower, blas.NoTrans, blas.NonUnit, n, nrhs, 1, a, lda, b, ldb)
+		// Solve L^H * X = B, overwriting B with X.
+		bi.Ztrsm(blas.Left, blas.Lower, blas.ConjTrans, blas.NonUnit, n, nrhs, 1, a, lda, b, ldb)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Ztrcon estimates the reciprocal of the condition number of a complex
+// triangular matrix A. The condition number computed may be based on the
+// 1-norm or the ∞-norm.
+//
+// work is a temporary data slice of length at least 2*n and Ztrcon will panic otherwise.
+//
+// rwork is a temporary data slice of length at least n and Ztrcon will panic otherwise.
+func (impl Implementation) Ztrcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []complex128, lda int, work []complex128, rwork []float64) float64 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(badDiag)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(work) < 2*n:
+		panic(shortWork)
+	case len(rwork) < n:
+		panic(shortRWork)
+	}
+
+	anorm := impl.Zlantr(norm, uplo, diag, n, n, a, lda, rwork)
+	if anorm <= 0 {
+		return 0
+	}
+	if diag == blas.NonUnit {
+		for i := 0; i < n; i++ {
+			if a[i*lda+i] == 0 {
+				return 0
+			}
+		}
+	}
+
+	bi := cblas128.Implementation()
+	var rcond, ainvnm float64
+	var kase int
+	var isave [3]int
+	kase1 := 2
+	if norm == lapack.MaxColumnSum {
+		kase1 = 1
+	}
+	for {
+		ainvnm, kase = impl.Zlacn2(n, work[n:], work, ainvnm, kase, &isave)
+		if kase == 0 {
+			if ainvnm != 0 && !math.IsNaN(ainvnm) {
+				rcond = (1 / anorm) / ainvnm
+			}
+			return rcond
+		}
+		if kase == kase1 {
+			// Multiply by inv(A).
+			bi.Ztrsv(uplo, blas.NoTrans, diag, n, a, lda, work, 1)
+		} else {
+			// Multiply by inv(A^H).
+			bi.Ztrsv(uplo, blas.ConjTrans, diag, n, a, lda, work, 1)
+		}
+	}
+}
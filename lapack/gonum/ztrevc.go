@@ -0,0 +1,280 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Ztrevc computes some or all of the right and/or left eigenvectors of an n×n
+// complex upper triangular matrix T. Matrices of this type are produced by the
+// Schur factorization of a complex general matrix A
+//  A = Q T Q^H,
+// as computed by Zlahqr.
+//
+// The right eigenvector x of T corresponding to an eigenvalue λ is defined by
+//  T x = λ x,
+// and the left eigenvector y is defined by
+//  y^H T = λ y^H.
+//
+// The eigenvalues are read directly from the diagonal of T.
+//
+// This routine returns the matrices X and/or Y of right and left eigenvectors
+// of T, or the products Q*X and/or Q*Y, where Q is an input matrix. If Q is the
+// unitary factor that reduces a matrix A to Schur form T, then Q*X and Q*Y are
+// the matrices of right and left eigenvectors of A.
+//
+// If side == lapack.EVRight, only right eigenvectors will be computed.
+// If side == lapack.EVLeft, only left eigenvectors will be computed.
+// If side == lapack.EVBoth, both right and left eigenvectors will be computed.
+// For other values of side, Ztrevc will panic.
+//
+// If howmny == lapack.EVAll, all right and/or left eigenvectors will be
+// computed.
+// If howmny == lapack.EVAllMulQ, all right and/or left eigenvectors will be
+// computed and multiplied from left by the matrices in VR and/or VL.
+// If howmny == lapack.EVSelected, right and/or left eigenvectors will be
+// computed as indicated by selected.
+// For other values of howmny, Ztrevc will panic.
+//
+// selected specifies which eigenvectors will be computed. It must have length n
+// if howmny == lapack.EVSelected, and it is not referenced otherwise. The
+// eigenvector corresponding to the j-th eigenvalue is computed if selected[j]
+// is true.
+//
+// VL and VR are n×mm matrices. If howmny is lapack.EVAll or
+// lapack.EVAllMulQ, mm must be at least n. If howmny is lapack.EVSelected, mm
+// must be at least the number of selected eigenvectors. If mm is not
+// sufficiently large, Ztrevc will panic.
+//
+// On entry, if howmny is lapack.EVAllMulQ, it is assumed that VL (if side
+// is lapack.EVLeft or lapack.EVBoth) contains an n×n matrix QL,
+// and that VR (if side is lapack.EVRight or lapack.EVBoth) contains
+// an n×n matrix QR. QL and QR are typically the unitary matrix Q of Schur
+// vectors returned by Zlahqr.
+//
+// On return, if side is lapack.EVLeft or lapack.EVBoth,
+// VL will contain:
+//  if howmny == lapack.EVAll,      the matrix Y of left eigenvectors of T,
+//  if howmny == lapack.EVAllMulQ,  the matrix Q*Y,
+//  if howmny == lapack.EVSelected, the left eigenvectors of T specified by
+//                                  selected, stored consecutively in the
+//                                  columns of VL, in the same order as their
+//                                  eigenvalues.
+// VL is not referenced if side == lapack.EVRight.
+//
+// On return, if side is lapack.EVRight or lapack.EVBoth,
+// VR will contain:
+//  if howmny == lapack.EVAll,      the matrix X of right eigenvectors of T,
+//  if howmny == lapack.EVAllMulQ,  the matrix Q*X,
+//  if howmny == lapack.EVSelected, the right eigenvectors of T specified by
+//                                  selected, stored consecutively in the
+//                                  columns of VR, in the same order as their
+//                                  eigenvalues.
+// VR is not referenced if side == lapack.EVLeft.
+//
+// Each eigenvector will be normalized so that the element of largest magnitude
+// has magnitude 1. Here the magnitude of a complex number (x,y) is taken to be
+// |x| + |y|.
+//
+// work must have length at least 2*n, otherwise Ztrevc will panic.
+//
+// Ztrevc returns the number of columns in VL and/or VR actually used to store
+// the eigenvectors.
+//
+// Ztrevc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Ztrevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, t []complex128, ldt int, vl []complex128, ldvl int, vr []complex128, ldvr int, mm int, work []complex128) (m int) {
+	bothv := side == lapack.EVBoth
+	rightv := side == lapack.EVRight || bothv
+	leftv := side == lapack.EVLeft || bothv
+	switch {
+	case !rightv && !leftv:
+		panic(badEVSide)
+	case howmny != lapack.EVAll && howmny != lapack.EVAllMulQ && howmny != lapack.EVSelected:
+		panic(badEVHowMany)
+	case n < 0:
+		panic(nLT0)
+	case ldt < max(1, n):
+		panic(badLdT)
+	case mm < 0:
+		panic(mmLT0)
+	case ldvl < 1:
+		panic(badLdVL)
+	case ldvr < 1:
+		panic(badLdVR)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(t) < (n-1)*ldt+n:
+		panic(shortT)
+	case len(work) < 2*n:
+		panic(shortWork)
+	}
+
+	if howmny == lapack.EVSelected {
+		if len(selected) != n {
+			panic(badLenSelected)
+		}
+		for _, v := range selected {
+			if v {
+				m++
+			}
+		}
+	} else {
+		m = n
+	}
+	if mm < m {
+		panic(badMm)
+	}
+
+	// Quick return if no eigenvectors were selected.
+	if m == 0 {
+		return 0
+	}
+
+	switch {
+	case leftv && ldvl < mm:
+		panic(badLdVL)
+	case leftv && len(vl) < (n-1)*ldvl+mm:
+		panic(shortVL)
+	case rightv && ldvr < mm:
+		panic(badLdVR)
+	case rightv && len(vr) < (n-1)*ldvr+mm:
+		panic(shortVR)
+	}
+
+	// Set the constants to control overflow.
+	ulp := dlamchP
+	smlnum := float64(n) / ulp * dlamchS
+
+	// Split work into a vector for the right-hand side and a copy of the
+	// diagonal of T.
+	x := work[:n]
+	diag := work[n : 2*n]
+	for i := 0; i < n; i++ {
+		diag[i] = t[i*ldt+i]
+	}
+
+	bi := cblas128.Implementation()
+
+	if rightv {
+		// Compute right eigenvectors.
+		is := m - 1
+		for ki := n - 1; ki >= 0; ki-- {
+			if howmny == lapack.EVSelected && !selected[ki] {
+				continue
+			}
+			smin := math.Max(ulp*cabs1(t[ki*ldt+ki]), smlnum)
+
+			// Form the right-hand side.
+			x[ki] = 1
+			for k := 0; k < ki; k++ {
+				x[k] = -t[k*ldt+ki]
+			}
+
+			// Solve the upper triangular system
+			//  (T[0:ki,0:ki] - T[ki,ki])*x = rhs,
+			// perturbing small diagonal elements to avoid division by zero.
+			for k := 0; k < ki; k++ {
+				t[k*ldt+k] -= t[ki*ldt+ki]
+				if cabs1(t[k*ldt+k]) < smin {
+					t[k*ldt+k] = complex(smin, 0)
+				}
+			}
+			if ki > 0 {
+				bi.Ztrsv(blas.Upper, blas.NoTrans, blas.NonUnit, ki, t, ldt, x, 1)
+			}
+
+			// Copy the vector x or Q*x to VR and normalize.
+			if howmny != lapack.EVAllMulQ {
+				bi.Zcopy(ki+1, x, 1, vr[is:], ldvr)
+				ii := bi.Izamax(ki+1, vr[is:], ldvr)
+				remax := 1 / cabs1(vr[ii*ldvr+is])
+				bi.Zdscal(ki+1, remax, vr[is:], ldvr)
+				for k := ki + 1; k < n; k++ {
+					vr[k*ldvr+is] = 0
+				}
+			} else {
+				if ki > 0 {
+					bi.Zgemv(blas.NoTrans, n, ki, 1, vr, ldvr, x, 1, 1, vr[ki:], ldvr)
+				}
+				ii := bi.Izamax(n, vr[ki:], ldvr)
+				remax := 1 / cabs1(vr[ii*ldvr+ki])
+				bi.Zdscal(n, remax, vr[ki:], ldvr)
+			}
+
+			// Restore the original diagonal elements of T.
+			for k := 0; k < ki; k++ {
+				t[k*ldt+k] = diag[k]
+			}
+			is--
+		}
+	}
+
+	if leftv {
+		// Compute left eigenvectors.
+		is := 0
+		for ki := 0; ki < n; ki++ {
+			if howmny == lapack.EVSelected && !selected[ki] {
+				continue
+			}
+			smin := math.Max(ulp*cabs1(t[ki*ldt+ki]), smlnum)
+
+			// Form the right-hand side.
+			x[ki] = 1
+			for k := ki + 1; k < n; k++ {
+				x[k] = -cmplx.Conj(t[ki*ldt+k])
+			}
+
+			// Solve the upper triangular system
+			//  (T[ki+1:n,ki+1:n] - T[ki,ki])^H*x = rhs,
+			// perturbing small diagonal elements to avoid division by zero.
+			for k := ki + 1; k < n; k++ {
+				t[k*ldt+k] -= t[ki*ldt+ki]
+				if cabs1(t[k*ldt+k]) < smin {
+					t[k*ldt+k] = complex(smin, 0)
+				}
+			}
+			if ki < n-1 {
+				bi.Ztrsv(blas.Upper, blas.ConjTrans, blas.NonUnit, n-ki-1, t[(ki+1)*ldt+ki+1:], ldt, x[ki+1:], 1)
+			}
+
+			// Copy the vector x or Q*x to VL and normalize.
+			if howmny != lapack.EVAllMulQ {
+				bi.Zcopy(n-ki, x[ki:], 1, vl[ki*ldvl+is:], ldvl)
+				ii := bi.Izamax(n-ki, vl[ki*ldvl+is:], ldvl) + ki
+				remax := 1 / cabs1(vl[ii*ldvl+is])
+				bi.Zdscal(n-ki, remax, vl[ki*ldvl+is:], ldvl)
+				for k := 0; k < ki; k++ {
+					vl[k*ldvl+is] = 0
+				}
+			} else {
+				if ki < n-1 {
+					bi.Zgemv(blas.NoTrans, n, n-ki-1, 1, vl[ki+1:], ldvl, x[ki+1:], 1, 1, vl[ki:], ldvl)
+				}
+				ii := bi.Izamax(n, vl[ki:], ldvl)
+				remax := 1 / cabs1(vl[ii*ldvl+ki])
+				bi.Zdscal(n, remax, vl[ki:], ldvl)
+			}
+
+			// Restore the original diagonal elements of T.
+			for k := ki + 1; k < n; k++ {
+				t[k*ldt+k] = diag[k]
+			}
+			is++
+		}
+	}
+	return m
+}
@@ -0,0 +1,122 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/lapack"
+
+// Zungbr generates one of the unitary matrices Q or P^H computed by Zgebd2.
+// See Zgebd2 for the description of Q and P^H.
+//
+// If vect == lapack.GenerateQ, then a is assumed to have been an m×k matrix and
+// Q is of order m. If m >= k, then Zungbr returns the first n columns of Q
+// where m >= n >= k. If m < k, then Zungbr returns Q as an m×m matrix.
+//
+// If vect == lapack.GeneratePT, then A is assumed to have been a k×n matrix, and
+// P^H is of order n. If k < n, then Zungbr returns the first m rows of P^H,
+// where n >= m >= k. If k >= n, then Zungbr returns P^H as an n×n matrix.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= max(1, min(m,n)), and Zungbr will panic otherwise. If
+// lwork == -1, instead of performing Zungbr the optimal work length is
+// stored into work[0].
+//
+// Zungbr is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zungbr(vect lapack.GenOrtho, m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	wantq := vect == lapack.GenerateQ
+	mn := min(m, n)
+	switch {
+	case vect != lapack.GenerateQ && vect != lapack.GeneratePT:
+		panic(badGenOrtho)
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case wantq && n > m:
+		panic(nGTM)
+	case wantq && n < min(m, k):
+		panic("lapack: n < min(m,k)")
+	case !wantq && m > n:
+		panic(mGTN)
+	case !wantq && m < min(n, k):
+		panic("lapack: m < min(n,k)")
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < max(1, mn) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	work[0] = 1
+	if m == 0 || n == 0 {
+		return
+	}
+
+	lworkopt := max(1, mn)
+	if lwork == -1 {
+		work[0] = complex(float64(lworkopt), 0)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case wantq && len(tau) < min(m, k):
+		panic(shortTau)
+	case !wantq && len(tau) < min(n, k):
+		panic(shortTau)
+	}
+
+	if wantq {
+		// Form Q, determined by a call to Zgebd2 to reduce an m×k matrix.
+		if m >= k {
+			impl.Zung2r(m, n, k, a, lda, tau, work)
+		} else {
+			// Shift the vectors which define the elementary reflectors one
+			// column to the right, and set the first row and column of Q to
+			// those of the unit matrix.
+			for j := m - 1; j >= 1; j-- {
+				a[j] = 0
+				for i := j + 1; i < m; i++ {
+					a[i*lda+j] = a[i*lda+j-1]
+				}
+			}
+			a[0] = 1
+			for i := 1; i < m; i++ {
+				a[i*lda] = 0
+			}
+			if m > 1 {
+				// Form Q[1:m-1, 1:m-1]
+				impl.Zung2r(m-1, m-1, m-1, a[lda+1:], lda, tau, work)
+			}
+		}
+	} else {
+		// Form P^H, determined by a call to Zgebd2 to reduce a k×n matrix.
+		if k < n {
+			impl.Zungl2(m, n, k, a, lda, tau, work)
+		} else {
+			// Shift the vectors which define the elementary reflectors one
+			// row downward, and set the first row and column of P^H to
+			// those of the unit matrix.
+			a[0] = 1
+			for i := 1; i < n; i++ {
+				a[i*lda] = 0
+			}
+			for j := 1; j < n; j++ {
+				for i := j - 1; i >= 1; i-- {
+					a[i*lda+j] = a[(i-1)*lda+j]
+				}
+				a[j] = 0
+			}
+			if n > 1 {
+				impl.Zungl2(n-1, n-1, n-1, a[lda+1:], lda, tau, work)
+			}
+		}
+	}
+	work[0] = complex(float64(lworkopt), 0)
+}
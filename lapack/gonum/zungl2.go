@@ -0,0 +1,83 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Zungl2 generates an m×n complex matrix Q with orthonormal rows defined as
+// the first m rows of a product of k elementary reflectors of order n
+//  Q = H_{k-1}^H * ... * H_1^H * H_0^H
+// as returned by an LQ factorization. Row i of A must contain the conjugate of
+// the vector which defines the elementary reflector H_i, as computed for
+// example by Zgebd2 for the matrix P^H.
+// len(tau) >= k, 0 <= k <= m, 0 <= m <= n, len(work) >= m.
+// Zungl2 will panic if these conditions are not met.
+//
+// Zungl2 is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Zungl2(m, n, k int, a []complex128, lda int, tau, work []complex128) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < m:
+		panic(nLTM)
+	case k < 0:
+		panic(kLT0)
+	case k > m:
+		panic(kGTM)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	if m == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(shortA)
+	case len(tau) < k:
+		panic(shortTau)
+	case len(work) < m:
+		panic(shortWork)
+	}
+
+	bi := cblas128.Implementation()
+
+	// Initialise rows k:m to rows of the unit matrix.
+	if k < m {
+		for i := k; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+			a[i*lda+i] = 1
+		}
+	}
+	for i := k - 1; i >= 0; i-- {
+		// Apply H_i^H to A[i:m, i:n] from the right.
+		if i < n-1 {
+			impl.Zlacgv(n-i-1, a[i*lda+i+1:], 1)
+			if i < m-1 {
+				a[i*lda+i] = 1
+				impl.Zlarf(blas.Right, m-i-1, n-i,
+					a[i*lda+i:], 1,
+					cmplx.Conj(tau[i]),
+					a[(i+1)*lda+i:], lda,
+					work)
+			}
+			bi.Zscal(n-i-1, -tau[i], a[i*lda+i+1:], 1)
+			impl.Zlacgv(n-i-1, a[i*lda+i+1:], 1)
+		}
+		a[i*lda+i] = 1 - cmplx.Conj(tau[i])
+		// Set A[i, 0:i] to zero.
+		for l := 0; l < i; l++ {
+			a[i*lda+l] = 0
+		}
+	}
+}
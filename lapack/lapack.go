@@ -7,19 +7,90 @@ package lapack
 import "gonum.org/v1/gonum/blas"
 
 // Complex128 defines the public complex128 LAPACK API supported by gonum/lapack.
-type Complex128 interface{}
+type Complex128 interface {
+	Zgecon(norm MatrixNorm, n int, a []complex128, lda int, anorm float64, work []complex128) float64
+	Zgeev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []complex128, lda int, w []complex128, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int, rwork []float64) (first int)
+	Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Zgesvd(jobU, jobVT SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) (ok bool)
+	Zgetrf(m, n int, a []complex128, lda int, ipiv []int) (ok bool)
+	Zgetrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int)
+	Zheev(jobz EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool)
+	Zlange(norm MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64
+	Zlanhe(norm MatrixNorm, uplo blas.Uplo, n int, a []complex128, lda int, work []float64) float64
+	Zlantr(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []complex128, lda int, work []float64) float64
+	Zpocon(uplo blas.Uplo, n int, a []complex128, lda int, anorm float64, work []complex128) float64
+	Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+	Zpotrs(ul blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int)
+	Ztrcon(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []complex128, lda int, work []complex128, rwork []float64) float64
+	Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int)
+}
+
+// Float32 defines the public float32 LAPACK API supported by gonum/lapack.
+type Float32 interface {
+	Sgbcon(norm MatrixNorm, n, kl, ku int, ab []float32, ldab int, ipiv []int, anorm float32, work []float32, iwork []int) float32
+	Sgbtrf(m, n, kl, ku int, ab []float32, ldab int, ipiv []int) (ok bool)
+	Sgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []int, b []float32, ldb int)
+	Sgecon(norm MatrixNorm, n int, a []float32, lda int, anorm float32, work []float32, iwork []int) float32
+	Sgees(jobvs SchurComp, n int, a []float32, lda int, wr, wi, vs []float32, ldvs int, work []float32, lwork int) (first int)
+	Sgeev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []float32, lda int, wr, wi []float32, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) (first int)
+	Sgels(trans blas.Transpose, m, n, nrhs int, a []float32, lda int, b []float32, ldb int, work []float32, lwork int) bool
+	Sgelqf(m, n int, a []float32, lda int, tau, work []float32, lwork int)
+	Sgeqp3(m, n int, a []float32, lda int, jpvt []int, tau, work []float32, lwork int)
+	Sgeqrf(m, n int, a []float32, lda int, tau, work []float32, lwork int)
+	Sgesdd(jobz SVDJob, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int, iwork []int) (ok bool)
+	Sgesvd(jobU, jobVT SVDJob, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int) (ok bool)
+	Sgetrf(m, n int, a []float32, lda int, ipiv []int) (ok bool)
+	Sgetri(n int, a []float32, lda int, ipiv []int, work []float32, lwork int) (ok bool)
+	Sgetrs(trans blas.Transpose, n, nrhs int, a []float32, lda int, ipiv []int, b []float32, ldb int)
+	Sgges(jobvsl, jobvsr SchurComp, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, work []float32, lwork int) (first int)
+	Sggev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []float32, lda int, b []float32, ldb int, alphar, alphai, beta, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) (first int, ok bool)
+	Sggsvd3(jobU, jobV, jobQ GSVDJob, m, n, p int, a []float32, lda int, b []float32, ldb int, alpha, beta, u []float32, ldu int, v []float32, ldv int, q []float32, ldq int, work []float32, lwork int, iwork []int) (k, l int, ok bool)
+	Slantr(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []float32, lda int, work []float32) float32
+	Slange(norm MatrixNorm, m, n int, a []float32, lda int, work []float32) float32
+	Slansy(norm MatrixNorm, uplo blas.Uplo, n int, a []float32, lda int, work []float32) float32
+	Slapmt(forward bool, m, n int, x []float32, ldx int, k []int)
+	Sormqr(side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int)
+	Sormlq(side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int)
+	Sormrz(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int)
+	Spocon(uplo blas.Uplo, n int, a []float32, lda int, anorm float32, work []float32, iwork []int) float32
+	Spotrf(ul blas.Uplo, n int, a []float32, lda int) (ok bool)
+	Spotri(ul blas.Uplo, n int, a []float32, lda int) (ok bool)
+	Spotrs(ul blas.Uplo, n, nrhs int, a []float32, lda int, b []float32, ldb int)
+	Sstedc(compz EVComp, n int, d, e, z []float32, ldz int, work []float32, lwork int, iwork []int, liwork int) (ok bool)
+	Ssycon(uplo blas.Uplo, n int, a []float32, lda int, ipiv []int, anorm float32, work []float32, iwork []int) float32
+	Ssyev(jobz EVJob, uplo blas.Uplo, n int, a []float32, lda int, w, work []float32, lwork int) (ok bool)
+	Ssyevd(jobz EVJob, uplo blas.Uplo, n int, a []float32, lda int, w, work []float32, lwork int, iwork []int, liwork int) (ok bool)
+	Ssyevr(jobz EVJob, rng EVRange, uplo blas.Uplo, n int, a []float32, lda int, vl, vu float32, il, iu int, abstol float32, w, z []float32, ldz int, work []float32, lwork int, iwork []int, liwork int) (m int, ok bool)
+	Ssygv(itype GenEVType, jobz EVJob, uplo blas.Uplo, n int, a []float32, lda int, b []float32, ldb int, w, work []float32, lwork int) (ok bool)
+	Ssytrf(uplo blas.Uplo, n int, a []float32, lda int, ipiv []int) (ok bool)
+	Ssytrs(uplo blas.Uplo, n, nrhs int, a []float32, lda int, ipiv []int, b []float32, ldb int)
+	Strcon(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float32, lda int, work []float32, iwork []int) float32
+	Strsen(job SchurCond, compq UpdateSchurComp, selected []bool, n int, t []float32, ldt int, q []float32, ldq int, wr, wi, work []float32, lwork int, iwork []int, liwork int) (m int, s, sep float32, ok bool)
+	Strsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int) (scale float32, ok bool)
+	Strtri(uplo blas.Uplo, diag blas.Diag, n int, a []float32, lda int) (ok bool)
+	Strtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, nrhs int, a []float32, lda int, b []float32, ldb int) (ok bool)
+	Stzrzf(m, n int, a []float32, lda int, tau, work []float32, lwork int)
+}
 
 // Float64 defines the public float64 LAPACK API supported by gonum/lapack.
 type Float64 interface {
+	Dgbcon(norm MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+	Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+	Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int)
 	Dgecon(norm MatrixNorm, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64
+	Dgees(jobvs SchurComp, n int, a []float64, lda int, wr, wi, vs []float64, ldvs int, work []float64, lwork int) (first int)
 	Dgeev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []float64, lda int, wr, wi []float64, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (first int)
 	Dgels(trans blas.Transpose, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, work []float64, lwork int) bool
 	Dgelqf(m, n int, a []float64, lda int, tau, work []float64, lwork int)
+	Dgeqp3(m, n int, a []float64, lda int, jpvt []int, tau, work []float64, lwork int)
 	Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int)
+	Dgesdd(jobz SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool)
 	Dgesvd(jobU, jobVT SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) (ok bool)
 	Dgetrf(m, n int, a []float64, lda int, ipiv []int) (ok bool)
 	Dgetri(n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool)
 	Dgetrs(trans blas.Transpose, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
+	Dgges(jobvsl, jobvsr SchurComp, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int) (first int)
+	Dggev(jobvl LeftEVJob, jobvr RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (first int, ok bool)
 	Dggsvd3(jobU, jobV, jobQ GSVDJob, m, n, p int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []int) (k, l int, ok bool)
 	Dlantr(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []float64, lda int, work []float64) float64
 	Dlange(norm MatrixNorm, m, n int, a []float64, lda int, work []float64) float64
@@ -27,14 +98,27 @@ type Float64 interface {
 	Dlapmt(forward bool, m, n int, x []float64, ldx int, k []int)
 	Dormqr(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int)
 	Dormlq(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int)
+	Dormrz(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int)
 	Dpocon(uplo blas.Uplo, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64
 	Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok bool)
 	Dpotri(ul blas.Uplo, n int, a []float64, lda int) (ok bool)
 	Dpotrs(ul blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int)
+	Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iwork []int) (iter int, ok bool)
+	Dsposv(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iwork []int) (iter int, ok bool)
+	Dstedc(compz EVComp, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+	Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64
 	Dsyev(jobz EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int) (ok bool)
+	Dsyevd(jobz EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+	Dsyevr(jobz EVJob, rng EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool)
+	Dsygv(itype GenEVType, jobz EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool)
+	Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) (ok bool)
+	Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
 	Dtrcon(norm MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int, work []float64, iwork []int) float64
+	Dtrsen(job SchurCond, compq UpdateSchurComp, selected []bool, n int, t []float64, ldt int, q []float64, ldq int, wr, wi, work []float64, lwork int, iwork []int, liwork int) (m int, s, sep float64, ok bool)
+	Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool)
 	Dtrtri(uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int) (ok bool)
 	Dtrtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, nrhs int, a []float64, lda int, b []float64, ldb int) (ok bool)
+	Dtzrzf(m, n int, a []float64, lda int, tau, work []float64, lwork int)
 }
 
 // Direct specifies the direction of the multiplication for the Householder matrix.
@@ -115,6 +199,14 @@ const (
 	SVDNone      SVDJob = 'N' // Do not compute singular vectors.
 )
 
+// BDComp specifies how singular vectors are computed in Dbdsdc.
+type BDComp byte
+
+const (
+	BDCompute BDComp = 'I' // Compute the singular vectors of the bidiagonal matrix.
+	BDNone    BDComp = 'N' // Do not compute singular vectors.
+)
+
 // GSVDJob specifies the singular vector computation type for Generalized SVD.
 type GSVDJob byte
 
@@ -143,7 +235,26 @@ const (
 	EVNone    EVJob = 'N' // Do not compute eigenvectors.
 )
 
-// LeftEVJob specifies whether left eigenvectors are computed in Dgeev.
+// EVRange specifies which eigenvalues are computed in Dsyevr and Dstebz.
+type EVRange byte
+
+const (
+	EVRangeAll   EVRange = 'A' // Compute all eigenvalues.
+	EVRangeValue EVRange = 'V' // Compute eigenvalues in the half-open interval (vl, vu].
+	EVRangeIndex EVRange = 'I' // Compute eigenvalues with indices il through iu.
+)
+
+// GenEVType specifies the form of the generalized symmetric-definite
+// eigenvalue problem solved in Dsygv and Dsygst.
+type GenEVType byte
+
+const (
+	GenEVAxBx GenEVType = 1 // A*x = λ*B*x.
+	GenEVABx  GenEVType = 2 // A*B*x = λ*x.
+	GenEVBAx  GenEVType = 3 // B*A*x = λ*x.
+)
+
+// LeftEVJob specifies whether left eigenvectors are computed in Dgeev and Dggev.
 type LeftEVJob byte
 
 const (
@@ -151,7 +262,7 @@ const (
 	LeftEVNone    LeftEVJob = 'N' // Do not compute left eigenvectors.
 )
 
-// RightEVJob specifies whether right eigenvectors are computed in Dgeev.
+// RightEVJob specifies whether right eigenvectors are computed in Dgeev and Dggev.
 type RightEVJob byte
 
 const (
@@ -169,7 +280,7 @@ const (
 	BalanceNone  BalanceJob = 'N'
 )
 
-// SchurJob specifies whether the Schur form is computed in Dhseqr.
+// SchurJob specifies whether the Schur form is computed in Dhseqr and Dhgeqz.
 type SchurJob byte
 
 const (
@@ -177,7 +288,8 @@ const (
 	EigenvaluesAndSchur SchurJob = 'S'
 )
 
-// SchurComp specifies whether and how the Schur vectors are computed in Dhseqr.
+// SchurComp specifies whether and how the Schur vectors are computed in Dhseqr,
+// Dgghrd, Dhgeqz and Dgges.
 type SchurComp byte
 
 const (
@@ -194,7 +306,17 @@ const (
 	UpdateSchurNone UpdateSchurComp = 'N' // Do not update the matrix of Schur vectors.
 )
 
-// EVSide specifies what eigenvectors are computed in Dtrevc3.
+// SchurCond specifies which condition numbers are computed in Dtrsen.
+type SchurCond byte
+
+const (
+	SchurCondNone        SchurCond = 'N' // Do not compute condition numbers.
+	SchurCondEigenvalues SchurCond = 'E' // Compute the condition number of the selected cluster of eigenvalues.
+	SchurCondSubspace    SchurCond = 'V' // Compute the condition number of the selected invariant subspace.
+	SchurCondBoth        SchurCond = 'B' // Compute both condition numbers.
+)
+
+// EVSide specifies what eigenvectors are computed in Dtrevc3 and Dtgevc.
 type EVSide byte
 
 const (
@@ -203,7 +325,7 @@ const (
 	EVBoth  EVSide = 'B' // Compute both right and left eigenvectors.
 )
 
-// EVHowMany specifies which eigenvectors are computed in Dtrevc3 and how.
+// EVHowMany specifies which eigenvectors Dtrevc3 and Dtgevc compute and how.
 type EVHowMany byte
 
 const (
@@ -0,0 +1,16 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package lapack128 provides a set of convenient wrapper functions for the
+// complex128 LAPACK calls, as specified in the netlib standard (www.netlib.org).
+//
+// The native Go routines are used by default, and the Use function can be used
+// to set an alternative implementation.
+//
+// If the type of matrix (General, Hermitian, etc.) is known and fixed, it is
+// used in the wrapper signature. In many cases, however, the type of the matrix
+// changes during the call to the routine, for example the matrix is Hermitian on
+// entry and is triangular on exit. In these cases the correct types should be checked
+// in the documentation.
+package lapack128 // import "gonum.org/v1/gonum/lapack/lapack128"
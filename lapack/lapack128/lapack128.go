@@ -0,0 +1,302 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack128
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
+)
+
+var lapack128 lapack.Complex128 = gonum.Implementation{}
+
+// Use sets the LAPACK complex128 implementation to be used by subsequent BLAS calls.
+// The default implementation is gonum.Implementation.
+func Use(l lapack.Complex128) {
+	lapack128 = l
+}
+
+// Potrf computes the Cholesky factorization of a.
+// The factorization has the form
+//  A = U^H * U if a.Uplo == blas.Upper, or
+//  A = L * L^H if a.Uplo == blas.Lower,
+// where U is an upper triangular matrix and L is lower triangular.
+// The triangular matrix is returned in t, and the underlying data between
+// a and t is shared. The returned bool indicates whether a is positive
+// definite and the factorization could be finished.
+func Potrf(a cblas128.Hermitian) (t cblas128.Triangular, ok bool) {
+	ok = lapack128.Zpotrf(a.Uplo, a.N, a.Data, a.Stride)
+	t.Uplo = a.Uplo
+	t.N = a.N
+	t.Data = a.Data
+	t.Stride = a.Stride
+	t.Diag = blas.NonUnit
+	return
+}
+
+// Potrs solves a system of n linear equations A*X = B where A is an n×n
+// Hermitian positive definite matrix and B is an n×nrhs matrix, using the
+// Cholesky factorization A = U^H*U or A = L*L^H. t contains the corresponding
+// triangular factor as returned by Potrf. On entry, B contains the right-hand
+// side matrix B, on return it contains the solution matrix X.
+func Potrs(t cblas128.Triangular, b cblas128.General) {
+	lapack128.Zpotrs(t.Uplo, t.N, b.Cols, t.Data, t.Stride, b.Data, b.Stride)
+}
+
+// Pocon estimates the reciprocal of the condition number of a Hermitian
+// positive definite matrix A given the Cholesky decomposition of A. The
+// condition number computed is based on the 1-norm and the ∞-norm.
+//
+// anorm is the 1-norm and the ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Pocon will panic otherwise.
+func Pocon(a cblas128.Hermitian, anorm float64, work []complex128) float64 {
+	return lapack128.Zpocon(a.Uplo, a.N, a.Data, a.Stride, anorm, work)
+}
+
+// Gecon estimates the reciprocal of the condition number of the n×n matrix A
+// given the LU decomposition of the matrix. The condition number computed may
+// be based on the 1-norm or the ∞-norm.
+//
+// a contains the result of the LU decomposition of A as computed by Getrf.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Gecon will panic otherwise.
+func Gecon(norm lapack.MatrixNorm, a cblas128.General, anorm float64, work []complex128) float64 {
+	return lapack128.Zgecon(norm, a.Cols, a.Data, a.Stride, anorm, work)
+}
+
+// Geqrf computes the QR factorization of the m×n matrix A using a blocked
+// algorithm. A is modified to contain the information to construct Q and R.
+// The upper triangle of a contains the matrix R. The lower triangular elements
+// (not including the diagonal) contain the elementary reflectors. tau is modified
+// to contain the reflector scales. tau must have length at least min(m,n), and
+// this function will panic otherwise.
+//
+// The ith elementary reflector can be explicitly constructed by first extracting
+// the
+//  v[j] = 0           j < i
+//  v[j] = 1           j == i
+//  v[j] = a[j*lda+i]  j > i
+// and computing H_i = I - tau[i] * v * v^H.
+//
+// The unitary matrix Q can be constucted from a product of these elementary
+// reflectors, Q = H_0 * H_1 * ... * H_{k-1}, where k = min(m,n).
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= n and this function will panic otherwise.
+// Geqrf is a blocked QR factorization, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Geqrf,
+// the optimal work length will be stored into work[0].
+func Geqrf(a cblas128.General, tau, work []complex128, lwork int) {
+	lapack128.Zgeqrf(a.Rows, a.Cols, a.Data, a.Stride, tau, work, lwork)
+}
+
+// Gesvd computes the singular value decomposition of the input matrix A.
+//
+// The singular value decomposition is
+//  A = U * Sigma * V^H
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m unitary matrix and V is an n×n unitary matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobU and jobVT are options for computing the singular vectors. The behavior
+// is as follows
+//  jobU == lapack.SVDAll       All m columns of U are returned in u
+//  jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
+//  jobU == lapack.SVDNone      The columns of U are not computed.
+// The behavior is the same for jobVT and the rows of V^H.
+// lapack.SVDOverwrite is not supported.
+//
+// On entry, a contains the data for the m×n matrix A. On exit the contents of
+// a are destroyed.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. If lwork == -1, instead of performing Gesvd, the optimal work
+// length will be stored into work[0]. rwork is real temporary storage. See the
+// documentation of gonum.Implementation.Zgesvd for the required lengths of
+// work and rwork. Gesvd will panic if the working memory has insufficient
+// storage.
+//
+// Gesvd returns whether the decomposition successfully completed.
+func Gesvd(jobU, jobVT lapack.SVDJob, a, u, vt cblas128.General, s []float64, work []complex128, lwork int, rwork []float64) (ok bool) {
+	return lapack128.Zgesvd(jobU, jobVT, a.Rows, a.Cols, a.Data, a.Stride, s, u.Data, u.Stride, vt.Data, vt.Stride, work, lwork, rwork)
+}
+
+// Getrf computes the LU decomposition of the m×n matrix A.
+// The LU decomposition is a factorization of A into
+//  A = P * L * U
+// where P is a permutation matrix, L is a unit lower triangular matrix, and
+// U is a (usually) non-unit upper triangular matrix. On exit, L and U are stored
+// in place into a.
+//
+// ipiv is a permutation vector. It indicates that row i of the matrix was
+// changed with ipiv[i]. ipiv must have length at least min(m,n), and will panic
+// otherwise. ipiv is zero-indexed.
+//
+// Getrf returns whether the matrix A is nonsingular. The LU decomposition will
+// be computed regardless of the singularity of A, but division by zero
+// will occur if false is returned and the result is used to solve a
+// system of equations.
+func Getrf(a cblas128.General, ipiv []int) bool {
+	return lapack128.Zgetrf(a.Rows, a.Cols, a.Data, a.Stride, ipiv)
+}
+
+// Getrs solves a system of equations using an LU factorization.
+// The system of equations solved is
+//  A * X = B    if trans == blas.NoTrans
+//  A^T * X = B  if trans == blas.Trans
+//  A^H * X = B  if trans == blas.ConjTrans
+// A is a general n×n matrix with stride lda. B is a general matrix of size n×nrhs.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+//
+// a and ipiv contain the LU factorization of A and the permutation indices as
+// computed by Getrf. ipiv is zero-indexed.
+func Getrs(trans blas.Transpose, a cblas128.General, b cblas128.General, ipiv []int) {
+	lapack128.Zgetrs(trans, a.Cols, b.Cols, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+}
+
+// Heev computes all eigenvalues and, optionally, the eigenvectors of a complex
+// Hermitian matrix A.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Heev will panic otherwise.
+//
+// On entry, a contains the elements of the Hermitian matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// Work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= 2*n-1, and additionally lwork >= n*n if
+// jobz == lapack.EVCompute, and Heev will panic otherwise. If lwork == -1,
+// instead of computing Heev the optimal work length is stored into work[0].
+//
+// rwork is real temporary storage. See the documentation of
+// gonum.Implementation.Zheev for the required length.
+func Heev(jobz lapack.EVJob, a cblas128.Hermitian, w []float64, work []complex128, lwork int, rwork []float64) (ok bool) {
+	return lapack128.Zheev(jobz, a.Uplo, a.N, a.Data, a.Stride, w, work, lwork, rwork)
+}
+
+// Lange computes the matrix norm of the general m×n matrix A. The input norm
+// specifies the norm computed.
+//  lapack.MaxAbs: the maximum absolute value of an element.
+//  lapack.MaxColumnSum: the maximum column sum of the absolute values of the entries.
+//  lapack.MaxRowSum: the maximum row sum of the absolute values of the entries.
+//  lapack.Frobenius: the square root of the sum of the squares of the entries.
+// If norm == lapack.MaxColumnSum, work must be of length n, and this function will panic otherwise.
+// There are no restrictions on work for the other matrix norms.
+func Lange(norm lapack.MatrixNorm, a cblas128.General, work []float64) float64 {
+	return lapack128.Zlange(norm, a.Rows, a.Cols, a.Data, a.Stride, work)
+}
+
+// Lanhe computes the specified norm of an n×n Hermitian matrix. If
+// norm == lapack.MaxColumnSum or norm == lapack.MaxRowSum work must have length
+// at least n and this function will panic otherwise.
+// There are no restrictions on work for the other matrix norms.
+func Lanhe(norm lapack.MatrixNorm, a cblas128.Hermitian, work []float64) float64 {
+	return lapack128.Zlanhe(norm, a.Uplo, a.N, a.Data, a.Stride, work)
+}
+
+// Lantr computes the specified norm of an n×n triangular matrix A. If
+// norm == lapack.MaxColumnSum work must have length at least n and this function
+// will panic otherwise. There are no restrictions on work for the other matrix norms.
+func Lantr(norm lapack.MatrixNorm, a cblas128.Triangular, work []float64) float64 {
+	return lapack128.Zlantr(norm, a.Uplo, a.Diag, a.N, a.N, a.Data, a.Stride, work)
+}
+
+// Trcon estimates the reciprocal of the condition number of a triangular matrix A.
+// The condition number computed may be based on the 1-norm or the ∞-norm.
+//
+// work is a temporary data slice of length at least 2*n and Trcon will panic otherwise.
+//
+// rwork is a temporary data slice of length at least n and Trcon will panic otherwise.
+func Trcon(norm lapack.MatrixNorm, a cblas128.Triangular, work []complex128, rwork []float64) float64 {
+	return lapack128.Ztrcon(norm, a.Uplo, a.Diag, a.N, a.Data, a.Stride, work, rwork)
+}
+
+// Unmqr multiplies an m×n matrix C by a unitary matrix Q as
+//  C = Q * C,    if side == blas.Left  and trans == blas.NoTrans,
+//  C = Q^H * C,  if side == blas.Left  and trans == blas.ConjTrans,
+//  C = C * Q,    if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Q^H,  if side == blas.Right and trans == blas.ConjTrans,
+// where Q is defined as the product of k elementary reflectors
+//  Q = H_0 * H_1 * ... * H_{k-1}.
+//
+// If side == blas.Left, A is an m×k matrix and 0 <= k <= m.
+// If side == blas.Right, A is an n×k matrix and 0 <= k <= n.
+// The ith column of A contains the vector which defines the elementary
+// reflector H_i and tau[i] contains its scalar factor. tau must have length k
+// and Unmqr will panic otherwise. Geqrf returns A and tau in the required
+// form.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= n if side == blas.Left and lwork >= m if side ==
+// blas.Right, and this function will panic otherwise. Larger values of lwork
+// will generally give better performance. On return, work[0] will contain the
+// optimal value of lwork.
+//
+// If lwork is -1, instead of performing Unmqr, the optimal workspace size will
+// be stored into work[0].
+func Unmqr(side blas.Side, trans blas.Transpose, a cblas128.General, tau []complex128, c cblas128.General, work []complex128, lwork int) {
+	lapack128.Zunmqr(side, trans, c.Rows, c.Cols, a.Cols, a.Data, a.Stride, tau, c.Data, c.Stride, work, lwork)
+}
+
+// Geev computes the eigenvalues and, optionally, the left and/or right
+// eigenvectors for an n×n complex nonsymmetric matrix A.
+//
+// The right eigenvector v_j of A corresponding to an eigenvalue λ_j
+// is defined by
+//  A v_j = λ_j v_j,
+// and the left eigenvector u_j corresponding to an eigenvalue λ_j is defined by
+//  u_j^H A = λ_j u_j^H,
+// where u_j^H is the conjugate transpose of u_j.
+//
+// On return, A will be overwritten and the left and right eigenvectors will be
+// stored, respectively, in the columns of the n×n matrices VL and VR in the
+// same order as their eigenvalues. The computed eigenvectors are normalized to
+// have Euclidean norm equal to 1 and largest component real.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Geev will panic.
+//
+// w contains the computed eigenvalues and must have length n, otherwise Geev
+// will panic.
+//
+// work must have length at least lwork and lwork must be at least max(1,3*n) if
+// the left or right eigenvectors are computed, and at least max(1,2*n) if no
+// eigenvectors are computed. If lwork == -1, instead of performing Geev, the
+// function only calculates the optimal value of lwork and stores it into
+// work[0].
+//
+// rwork is real temporary storage and must have length at least 2*n, otherwise
+// Geev will panic.
+//
+// On return, first will be the index of the first valid eigenvalue.
+// If first == 0, all eigenvalues and eigenvectors have been computed.
+// If first is positive, Geev failed to compute all the eigenvalues, no
+// eigenvectors have been computed and w[first:] contains those eigenvalues
+// which have converged.
+func Geev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a cblas128.General, w []complex128, vl, vr cblas128.General, work []complex128, lwork int, rwork []float64) (first int) {
+	n := a.Rows
+	if a.Cols != n {
+		panic("lapack128: matrix not square")
+	}
+	if jobvl == lapack.LeftEVCompute && (vl.Rows != n || vl.Cols != n) {
+		panic("lapack128: bad size of VL")
+	}
+	if jobvr == lapack.RightEVCompute && (vr.Rows != n || vr.Cols != n) {
+		panic("lapack128: bad size of VR")
+	}
+	return lapack128.Zgeev(jobvl, jobvr, n, a.Data, a.Stride, w, vl.Data, vl.Stride, vr.Data, vr.Stride, work, lwork, rwork)
+}
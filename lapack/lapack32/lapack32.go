@@ -0,0 +1,1046 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
+)
+
+var lapack32 lapack.Float32 = gonum.Implementation{}
+
+// Use sets the LAPACK float32 implementation to be used by subsequent BLAS calls.
+// The default implementation is gonum.Implementation.
+func Use(l lapack.Float32) {
+	lapack32 = l
+}
+
+// Potrf computes the Cholesky factorization of a.
+// The factorization has the form
+//  A = U^T * U if a.Uplo == blas.Upper, or
+//  A = L * L^T if a.Uplo == blas.Lower,
+// where U is an upper triangular matrix and L is lower triangular.
+// The triangular matrix is returned in t, and the underlying data between
+// a and t is shared. The returned bool indicates whether a is positive
+// definite and the factorization could be finished.
+func Potrf(a blas32.Symmetric) (t blas32.Triangular, ok bool) {
+	ok = lapack32.Spotrf(a.Uplo, a.N, a.Data, a.Stride)
+	t.Uplo = a.Uplo
+	t.N = a.N
+	t.Data = a.Data
+	t.Stride = a.Stride
+	t.Diag = blas.NonUnit
+	return
+}
+
+// Potri computes the inverse of a real symmetric positive definite matrix A
+// using its Cholesky factorization.
+//
+// On entry, t contains the triangular factor U or L from the Cholesky
+// factorization A = U^T*U or A = L*L^T, as computed by Potrf.
+//
+// On return, the upper or lower triangle of the (symmetric) inverse of A is
+// stored in t, overwriting the input factor U or L, and also returned in a. The
+// underlying data between a and t is shared.
+//
+// The returned bool indicates whether the inverse was computed successfully.
+func Potri(t blas32.Triangular) (a blas32.Symmetric, ok bool) {
+	ok = lapack32.Spotri(t.Uplo, t.N, t.Data, t.Stride)
+	a.Uplo = t.Uplo
+	a.N = t.N
+	a.Data = t.Data
+	a.Stride = t.Stride
+	return
+}
+
+// Potrs solves a system of n linear equations A*X = B where A is an n×n
+// symmetric positive definite matrix and B is an n×nrhs matrix, using the
+// Cholesky factorization A = U^T*U or A = L*L^T. t contains the corresponding
+// triangular factor as returned by Potrf. On entry, B contains the right-hand
+// side matrix B, on return it contains the solution matrix X.
+func Potrs(t blas32.Triangular, b blas32.General) {
+	lapack32.Spotrs(t.Uplo, t.N, b.Cols, t.Data, t.Stride, b.Data, b.Stride)
+}
+
+// Gbcon estimates the reciprocal of the condition number of the n×n band
+// matrix A, in either the 1-norm or the ∞-norm, using the LU factorization
+// computed by Gbtrf. a and ipiv contain the LU factorization of A and the pivot
+// indices as computed by Gbtrf.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 3*n and Gbcon will panic
+// otherwise.
+//
+// iwork is a temporary data slice of length at least n and Gbcon will panic
+// otherwise.
+func Gbcon(norm lapack.MatrixNorm, a blas32.Band, ipiv []int, anorm float32, work []float32, iwork []int) float32 {
+	if a.Rows != a.Cols {
+		panic("lapack32: matrix not square")
+	}
+	return lapack32.Sgbcon(norm, a.Cols, a.KL, a.KU, a.Data, a.Stride, ipiv, anorm, work, iwork)
+}
+
+// Gbtrf computes an LU factorization of the m×n band matrix A using partial
+// pivoting with row interchanges. The factorization has the form
+//  A = P * L * U,
+// where P is a permutation matrix, L is a unit lower triangular band matrix
+// with a.KL sub-diagonals and U is an upper triangular band matrix with
+// a.KL+a.KU super-diagonals.
+//
+// a.Stride must be at least 2*a.KL+a.KU+1 to leave room for the fill-in created
+// by the row interchanges. On return, U and the multipliers of L are stored in
+// a as described in the documentation of Sgbtrf.
+//
+// ipiv is a permutation vector. It indicates that row i of the matrix was
+// interchanged with row ipiv[i]. ipiv must have length min(m,n), and Gbtrf will
+// panic otherwise. ipiv is zero-indexed.
+//
+// Gbtrf returns whether the matrix A is nonsingular. The LU factorization will
+// be computed regardless of the singularity of A, but it must not be used to
+// solve a system of equations if false is returned.
+func Gbtrf(a blas32.Band, ipiv []int) (ok bool) {
+	return lapack32.Sgbtrf(a.Rows, a.Cols, a.KL, a.KU, a.Data, a.Stride, ipiv)
+}
+
+// Gbtrs solves a system of linear equations
+//  A * X = B   if trans == blas.NoTrans
+//  A^T * X = B if trans == blas.Trans or blas.ConjTrans
+// with an n×n band matrix A using the LU factorization computed by Gbtrf. a and
+// ipiv contain the LU factorization of A and the pivot indices as computed by
+// Gbtrf.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+func Gbtrs(trans blas.Transpose, a blas32.Band, ipiv []int, b blas32.General) {
+	if a.Rows != a.Cols {
+		panic("lapack32: matrix not square")
+	}
+	if b.Rows != a.Rows {
+		panic("lapack32: bad size of B")
+	}
+	lapack32.Sgbtrs(trans, a.Cols, a.KL, a.KU, b.Cols, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+}
+
+// Gecon estimates the reciprocal of the condition number of the n×n matrix A
+// given the LU decomposition of the matrix. The condition number computed may
+// be based on the 1-norm or the ∞-norm.
+//
+// a contains the result of the LU decomposition of A as computed by Getrf.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 4*n and Gecon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Gecon will panic otherwise.
+func Gecon(norm lapack.MatrixNorm, a blas32.General, anorm float32, work []float32, iwork []int) float32 {
+	return lapack32.Sgecon(norm, a.Cols, a.Data, a.Stride, anorm, work, iwork)
+}
+
+// Gels finds a minimum-norm solution based on the matrices A and B using the
+// QR or LQ factorization. Gels returns false if the matrix
+// A is singular, and true if this solution was successfully found.
+//
+// The minimization problem solved depends on the input parameters.
+//
+//  1. If m >= n and trans == blas.NoTrans, Gels finds X such that || A*X - B||_2
+//     is minimized.
+//  2. If m < n and trans == blas.NoTrans, Gels finds the minimum norm solution of
+//     A * X = B.
+//  3. If m >= n and trans == blas.Trans, Gels finds the minimum norm solution of
+//     A^T * X = B.
+//  4. If m < n and trans == blas.Trans, Gels finds X such that || A*X - B||_2
+//     is minimized.
+// Note that the least-squares solutions (cases 1 and 3) perform the minimization
+// per column of B. This is not the same as finding the minimum-norm matrix.
+//
+// The matrix A is a general matrix of size m×n and is modified during this call.
+// The input matrix B is of size max(m,n)×nrhs, and serves two purposes. On entry,
+// the elements of b specify the input matrix B. B has size m×nrhs if
+// trans == blas.NoTrans, and n×nrhs if trans == blas.Trans. On exit, the
+// leading submatrix of b contains the solution vectors X. If trans == blas.NoTrans,
+// this submatrix is of size n×nrhs, and of size m×nrhs otherwise.
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= max(m,n) + max(m,n,nrhs), and this function will panic
+// otherwise. A longer work will enable blocked algorithms to be called.
+// In the special case that lwork == -1, work[0] will be set to the optimal working
+// length.
+func Gels(trans blas.Transpose, a blas32.General, b blas32.General, work []float32, lwork int) bool {
+	return lapack32.Sgels(trans, a.Rows, a.Cols, b.Cols, a.Data, a.Stride, b.Data, b.Stride, work, lwork)
+}
+
+// Geqp3 computes a QR factorization with column pivoting of the m×n matrix A,
+//  A*P = Q*R,
+// where P is a permutation matrix, Q is an orthogonal matrix and R is an upper
+// trapezoidal matrix. The diagonal elements of R have non-increasing absolute
+// values, so the factorization reveals the numerical rank of A.
+//
+// On return, the upper triangle of a contains R and the elements below the
+// diagonal together with tau represent Q as described in Geqrf. tau must have
+// length min(m,n), and Geqp3 will panic otherwise.
+//
+// jpvt must have length n, and Geqp3 will panic otherwise. On entry, if
+// jpvt[j] >= 0, the jth column of A is permuted to the front of A*P, and if
+// jpvt[j] == -1, the jth column of A is a free column. On return, the jth
+// column of A*P was the jpvt[j] column of A.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// 3*n+1, otherwise Geqp3 will panic. If lwork == -1, instead of performing
+// Geqp3, the optimal work length will be stored into work[0].
+func Geqp3(a blas32.General, jpvt []int, tau, work []float32, lwork int) {
+	lapack32.Sgeqp3(a.Rows, a.Cols, a.Data, a.Stride, jpvt, tau, work, lwork)
+}
+
+// Geqrf computes the QR factorization of the m×n matrix A using a blocked
+// algorithm. A is modified to contain the information to construct Q and R.
+// The upper triangle of a contains the matrix R. The lower triangular elements
+// (not including the diagonal) contain the elementary reflectors. tau is modified
+// to contain the reflector scales. tau must have length at least min(m,n), and
+// this function will panic otherwise.
+//
+// The ith elementary reflector can be explicitly constructed by first extracting
+// the
+//  v[j] = 0           j < i
+//  v[j] = 1           j == i
+//  v[j] = a[j*lda+i]  j > i
+// and computing H_i = I - tau[i] * v * v^T.
+//
+// The orthonormal matrix Q can be constucted from a product of these elementary
+// reflectors, Q = H_0 * H_1 * ... * H_{k-1}, where k = min(m,n).
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= m and this function will panic otherwise.
+// Geqrf is a blocked QR factorization, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Geqrf,
+// the optimal work length will be stored into work[0].
+func Geqrf(a blas32.General, tau, work []float32, lwork int) {
+	lapack32.Sgeqrf(a.Rows, a.Cols, a.Data, a.Stride, tau, work, lwork)
+}
+
+// Gelqf computes the LQ factorization of the m×n matrix A using a blocked
+// algorithm. A is modified to contain the information to construct L and Q. The
+// lower triangle of a contains the matrix L. The elements above the diagonal
+// and the slice tau represent the matrix Q. tau is modified to contain the
+// reflector scales. tau must have length at least min(m,n), and this function
+// will panic otherwise.
+//
+// See Geqrf for a description of the elementary reflectors and orthonormal
+// matrix Q. Q is constructed as a product of these elementary reflectors,
+// Q = H_{k-1} * ... * H_1 * H_0.
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= m and this function will panic otherwise.
+// Gelqf is a blocked LQ factorization, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Gelqf,
+// the optimal work length will be stored into work[0].
+func Gelqf(a blas32.General, tau, work []float32, lwork int) {
+	lapack32.Sgelqf(a.Rows, a.Cols, a.Data, a.Stride, tau, work, lwork)
+}
+
+// Gesdd computes the singular value decomposition of the input matrix A
+// using a divide and conquer method. Gesdd is usually substantially faster
+// than Gesvd for large matrices when the singular vectors are required.
+//
+// The singular value decomposition is
+//  A = U * Sigma * V^T
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobz specifies which singular vectors are computed. The behavior is as
+// follows
+//  jobz == lapack.SVDAll   All m columns of U and all n rows of V^T are
+//                          returned in u and vt.
+//  jobz == lapack.SVDStore The first min(m,n) columns of U and the first
+//                          min(m,n) rows of V^T are returned in u and vt.
+//  jobz == lapack.SVDNone  Neither U nor V^T are computed.
+// jobz == lapack.SVDOverwrite is not supported and Gesdd will panic.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Gesdd
+// the data is overwritten.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. If jobz == lapack.SVDNone, lwork must be at least
+// 3*min(m,n)+max(max(m,n), 4*min(m,n)). Otherwise, lwork must be at least
+// 3*min(m,n)*min(m,n)+max(max(m,n), 4*min(m,n)*min(m,n)+4*min(m,n)).
+// If lwork == -1, instead of performing Gesdd, the optimal work length will be
+// stored into work[0]. Gesdd will panic if the working memory has insufficient
+// storage.
+//
+// iwork must have length at least 8*min(m,n).
+//
+// Gesdd returns whether the decomposition successfully completed.
+func Gesdd(jobz lapack.SVDJob, a, u, vt blas32.General, s, work []float32, lwork int, iwork []int) (ok bool) {
+	return lapack32.Sgesdd(jobz, a.Rows, a.Cols, a.Data, a.Stride, s, u.Data, u.Stride, vt.Data, vt.Stride, work, lwork, iwork)
+}
+
+// Gesvd computes the singular value decomposition of the input matrix A.
+//
+// The singular value decomposition is
+//  A = U * Sigma * V^T
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobU and jobVT are options for computing the singular vectors. The behavior
+// is as follows
+//  jobU == lapack.SVDAll       All m columns of U are returned in u
+//  jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
+//  jobU == lapack.SVDOverwrite The first min(m,n) columns of U are written into a
+//  jobU == lapack.SVDNone      The columns of U are not computed.
+// The behavior is the same for jobVT and the rows of V^T. At most one of jobU
+// and jobVT can equal lapack.SVDOverwrite, and Gesvd will panic otherwise.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Gesvd
+// the data is overwritten. On exit, A contains the appropriate singular vectors
+// if either job is lapack.SVDOverwrite.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored columnwise. If
+// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
+// of size m×min(m,n). If jobU == lapack.SVDOverwrite or lapack.SVDNone, u is
+// not used.
+//
+// vt contains the left singular vectors on exit, stored rowwise. If
+// jobV == lapack.SVDAll, vt is of size n×m. If jobVT == lapack.SVDStore vt is
+// of size min(m,n)×n. If jobVT == lapack.SVDOverwrite or lapack.SVDNone, vt is
+// not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least max(5*min(m,n), 3*min(m,n)+max(m,n)).
+// If lwork == -1, instead of performing Gesvd, the optimal work length will be
+// stored into work[0]. Gesvd will panic if the working memory has insufficient
+// storage.
+//
+// Gesvd returns whether the decomposition successfully completed.
+func Gesvd(jobU, jobVT lapack.SVDJob, a, u, vt blas32.General, s, work []float32, lwork int) (ok bool) {
+	return lapack32.Sgesvd(jobU, jobVT, a.Rows, a.Cols, a.Data, a.Stride, s, u.Data, u.Stride, vt.Data, vt.Stride, work, lwork)
+}
+
+// Getrf computes the LU decomposition of the m×n matrix A.
+// The LU decomposition is a factorization of A into
+//  A = P * L * U
+// where P is a permutation matrix, L is a unit lower triangular matrix, and
+// U is a (usually) non-unit upper triangular matrix. On exit, L and U are stored
+// in place into a.
+//
+// ipiv is a permutation vector. It indicates that row i of the matrix was
+// changed with ipiv[i]. ipiv must have length at least min(m,n), and will panic
+// otherwise. ipiv is zero-indexed.
+//
+// Getrf is the blocked version of the algorithm.
+//
+// Getrf returns whether the matrix A is singular. The LU decomposition will
+// be computed regardless of the singularity of A, but division by zero
+// will occur if the false is returned and the result is used to solve a
+// system of equations.
+func Getrf(a blas32.General, ipiv []int) bool {
+	return lapack32.Sgetrf(a.Rows, a.Cols, a.Data, a.Stride, ipiv)
+}
+
+// Getri computes the inverse of the matrix A using the LU factorization computed
+// by Getrf. On entry, a contains the PLU decomposition of A as computed by
+// Getrf and on exit contains the reciprocal of the original matrix.
+//
+// Getri will not perform the inversion if the matrix is singular, and returns
+// a boolean indicating whether the inversion was successful.
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= n and this function will panic otherwise.
+// Getri is a blocked inversion, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Getri,
+// the optimal work length will be stored into work[0].
+func Getri(a blas32.General, ipiv []int, work []float32, lwork int) (ok bool) {
+	return lapack32.Sgetri(a.Cols, a.Data, a.Stride, ipiv, work, lwork)
+}
+
+// Getrs solves a system of equations using an LU factorization.
+// The system of equations solved is
+//  A * X = B if trans == blas.Trans
+//  A^T * X = B if trans == blas.NoTrans
+// A is a general n×n matrix with stride lda. B is a general matrix of size n×nrhs.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+//
+// a and ipiv contain the LU factorization of A and the permutation indices as
+// computed by Getrf. ipiv is zero-indexed.
+func Getrs(trans blas.Transpose, a blas32.General, b blas32.General, ipiv []int) {
+	lapack32.Sgetrs(trans, a.Cols, b.Cols, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+}
+
+// Ggsvd3 computes the generalized singular value decomposition (GSVD)
+// of an m×n matrix A and p×n matrix B:
+//  U^T*A*Q = D1*[ 0 R ]
+//
+//  V^T*B*Q = D2*[ 0 R ]
+// where U, V and Q are orthogonal matrices.
+//
+// Ggsvd3 returns k and l, the dimensions of the sub-blocks. k+l
+// is the effective numerical rank of the (m+p)×n matrix [ A^T B^T ]^T.
+// R is a (k+l)×(k+l) nonsingular upper triangular matrix, D1 and
+// D2 are m×(k+l) and p×(k+l) diagonal matrices and of the following
+// structures, respectively:
+//
+// If m-k-l >= 0,
+//
+//                    k  l
+//       D1 =     k [ I  0 ]
+//                l [ 0  C ]
+//            m-k-l [ 0  0 ]
+//
+//                  k  l
+//       D2 = l   [ 0  S ]
+//            p-l [ 0  0 ]
+//
+//               n-k-l  k    l
+//  [ 0 R ] = k [  0   R11  R12 ] k
+//            l [  0    0   R22 ] l
+//
+// where
+//
+//  C = diag( alpha_k, ... , alpha_{k+l} ),
+//  S = diag( beta_k,  ... , beta_{k+l} ),
+//  C^2 + S^2 = I.
+//
+// R is stored in
+//  A[0:k+l, n-k-l:n]
+// on exit.
+//
+// If m-k-l < 0,
+//
+//                 k m-k k+l-m
+//      D1 =   k [ I  0    0  ]
+//           m-k [ 0  C    0  ]
+//
+//                   k m-k k+l-m
+//      D2 =   m-k [ 0  S    0  ]
+//           k+l-m [ 0  0    I  ]
+//             p-l [ 0  0    0  ]
+//
+//                 n-k-l  k   m-k  k+l-m
+//  [ 0 R ] =    k [ 0    R11  R12  R13 ]
+//             m-k [ 0     0   R22  R23 ]
+//           k+l-m [ 0     0    0   R33 ]
+//
+// where
+//  C = diag( alpha_k, ... , alpha_m ),
+//  S = diag( beta_k,  ... , beta_m ),
+//  C^2 + S^2 = I.
+//
+//  R = [ R11 R12 R13 ] is stored in A[1:m, n-k-l+1:n]
+//      [  0  R22 R23 ]
+// and R33 is stored in
+//  B[m-k:l, n+m-k-l:n] on exit.
+//
+// Ggsvd3 computes C, S, R, and optionally the orthogonal transformation
+// matrices U, V and Q.
+//
+// jobU, jobV and jobQ are options for computing the orthogonal matrices. The behavior
+// is as follows
+//  jobU == lapack.GSVDU        Compute orthogonal matrix U
+//  jobU == lapack.GSVDNone     Do not compute orthogonal matrix.
+// The behavior is the same for jobV and jobQ with the exception that instead of
+// lapack.GSVDU these accept lapack.GSVDV and lapack.GSVDQ respectively.
+// The matrices U, V and Q must be m×m, p×p and n×n respectively unless the
+// relevant job parameter is lapack.GSVDNone.
+//
+// alpha and beta must have length n or Ggsvd3 will panic. On exit, alpha and
+// beta contain the generalized singular value pairs of A and B
+//   alpha[0:k] = 1,
+//   beta[0:k]  = 0,
+// if m-k-l >= 0,
+//   alpha[k:k+l] = diag(C),
+//   beta[k:k+l]  = diag(S),
+// if m-k-l < 0,
+//   alpha[k:m]= C, alpha[m:k+l]= 0
+//   beta[k:m] = S, beta[m:k+l] = 1.
+// if k+l < n,
+//   alpha[k+l:n] = 0 and
+//   beta[k+l:n]  = 0.
+//
+// On exit, iwork contains the permutation required to sort alpha descending.
+//
+// iwork must have length n, work must have length at least max(1, lwork), and
+// lwork must be -1 or greater than n, otherwise Ggsvd3 will panic. If
+// lwork is -1, work[0] holds the optimal lwork on return, but Ggsvd3 does
+// not perform the GSVD.
+func Ggsvd3(jobU, jobV, jobQ lapack.GSVDJob, a, b blas32.General, alpha, beta []float32, u, v, q blas32.General, work []float32, lwork int, iwork []int) (k, l int, ok bool) {
+	return lapack32.Sggsvd3(jobU, jobV, jobQ, a.Rows, a.Cols, b.Rows, a.Data, a.Stride, b.Data, b.Stride, alpha, beta, u.Data, u.Stride, v.Data, v.Stride, q.Data, q.Stride, work, lwork, iwork)
+}
+
+// Lange computes the matrix norm of the general m×n matrix A. The input norm
+// specifies the norm computed.
+//  lapack.MaxAbs: the maximum absolute value of an element.
+//  lapack.MaxColumnSum: the maximum column sum of the absolute values of the entries.
+//  lapack.MaxRowSum: the maximum row sum of the absolute values of the entries.
+//  lapack.Frobenius: the square root of the sum of the squares of the entries.
+// If norm == lapack.MaxColumnSum, work must be of length n, and this function will panic otherwise.
+// There are no restrictions on work for the other matrix norms.
+func Lange(norm lapack.MatrixNorm, a blas32.General, work []float32) float32 {
+	return lapack32.Slange(norm, a.Rows, a.Cols, a.Data, a.Stride, work)
+}
+
+// Lansy computes the specified norm of an n×n symmetric matrix. If
+// norm == lapack.MaxColumnSum or norm == lapackMaxRowSum work must have length
+// at least n and this function will panic otherwise.
+// There are no restrictions on work for the other matrix norms.
+func Lansy(norm lapack.MatrixNorm, a blas32.Symmetric, work []float32) float32 {
+	return lapack32.Slansy(norm, a.Uplo, a.N, a.Data, a.Stride, work)
+}
+
+// Lantr computes the specified norm of an m×n trapezoidal matrix A. If
+// norm == lapack.MaxColumnSum work must have length at least n and this function
+// will panic otherwise. There are no restrictions on work for the other matrix norms.
+func Lantr(norm lapack.MatrixNorm, a blas32.Triangular, work []float32) float32 {
+	return lapack32.Slantr(norm, a.Uplo, a.Diag, a.N, a.N, a.Data, a.Stride, work)
+}
+
+// Lapmt rearranges the columns of the m×n matrix X as specified by the
+// permutation k_0, k_1, ..., k_{n-1} of the integers 0, ..., n-1.
+//
+// If forward is true a forward permutation is performed:
+//
+//  X[0:m, k[j]] is moved to X[0:m, j] for j = 0, 1, ..., n-1.
+//
+// otherwise a backward permutation is performed:
+//
+//  X[0:m, j] is moved to X[0:m, k[j]] for j = 0, 1, ..., n-1.
+//
+// k must have length n, otherwise Lapmt will panic. k is zero-indexed.
+func Lapmt(forward bool, x blas32.General, k []int) {
+	lapack32.Slapmt(forward, x.Rows, x.Cols, x.Data, x.Stride, k)
+}
+
+// Ormlq multiplies the matrix C by the othogonal matrix Q defined by
+// A and tau. A and tau are as returned from Gelqf.
+//  C = Q * C    if side == blas.Left and trans == blas.NoTrans
+//  C = Q^T * C  if side == blas.Left and trans == blas.Trans
+//  C = C * Q    if side == blas.Right and trans == blas.NoTrans
+//  C = C * Q^T  if side == blas.Right and trans == blas.Trans
+// If side == blas.Left, A is a matrix of side k×m, and if side == blas.Right
+// A is of size k×n. This uses a blocked algorithm.
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= m if side == blas.Left and lwork >= n if side == blas.Right,
+// and this function will panic otherwise.
+// Ormlq uses a block algorithm, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Ormlq,
+// the optimal work length will be stored into work[0].
+//
+// Tau contains the Householder scales and must have length at least k, and
+// this function will panic otherwise.
+func Ormlq(side blas.Side, trans blas.Transpose, a blas32.General, tau []float32, c blas32.General, work []float32, lwork int) {
+	lapack32.Sormlq(side, trans, c.Rows, c.Cols, a.Rows, a.Data, a.Stride, tau, c.Data, c.Stride, work, lwork)
+}
+
+// Ormqr multiplies an m×n matrix C by an orthogonal matrix Q as
+//  C = Q * C,    if side == blas.Left  and trans == blas.NoTrans,
+//  C = Q^T * C,  if side == blas.Left  and trans == blas.Trans,
+//  C = C * Q,    if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Q^T,  if side == blas.Right and trans == blas.Trans,
+// where Q is defined as the product of k elementary reflectors
+//  Q = H_0 * H_1 * ... * H_{k-1}.
+//
+// If side == blas.Left, A is an m×k matrix and 0 <= k <= m.
+// If side == blas.Right, A is an n×k matrix and 0 <= k <= n.
+// The ith column of A contains the vector which defines the elementary
+// reflector H_i and tau[i] contains its scalar factor. tau must have length k
+// and Ormqr will panic otherwise. Geqrf returns A and tau in the required
+// form.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n if
+// side == blas.Left and at least m if side == blas.Right, otherwise Ormqr will
+// panic.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= m if side == blas.Left and lwork >= n if side ==
+// blas.Right, and this function will panic otherwise. Larger values of lwork
+// will generally give better performance. On return, work[0] will contain the
+// optimal value of lwork.
+//
+// If lwork is -1, instead of performing Ormqr, the optimal workspace size will
+// be stored into work[0].
+func Ormqr(side blas.Side, trans blas.Transpose, a blas32.General, tau []float32, c blas32.General, work []float32, lwork int) {
+	lapack32.Sormqr(side, trans, c.Rows, c.Cols, a.Cols, a.Data, a.Stride, tau, c.Data, c.Stride, work, lwork)
+}
+
+// Ormrz multiplies an m×n matrix C by an orthogonal matrix Z as
+//  C = Z * C,    if side == blas.Left  and trans == blas.NoTrans,
+//  C = Z^T * C,  if side == blas.Left  and trans == blas.Trans,
+//  C = C * Z,    if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Z^T,  if side == blas.Right and trans == blas.Trans,
+// where Z is defined as the product of k elementary reflectors as returned by
+// Tzrzf.
+//
+// If side == blas.Left, A is a k×m matrix and 0 <= k <= m.
+// If side == blas.Right, A is a k×n matrix and 0 <= k <= n.
+// l is the number of columns of A containing the meaningful part of the
+// Householder vectors. tau must have length k and Ormrz will panic otherwise.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n if
+// side == blas.Left and at least m if side == blas.Right, otherwise Ormrz will
+// panic. If lwork is -1, instead of performing Ormrz, the optimal workspace
+// size will be stored into work[0].
+func Ormrz(side blas.Side, trans blas.Transpose, a blas32.General, l int, tau []float32, c blas32.General, work []float32, lwork int) {
+	lapack32.Sormrz(side, trans, c.Rows, c.Cols, a.Rows, l, a.Data, a.Stride, tau, c.Data, c.Stride, work, lwork)
+}
+
+// Pocon estimates the reciprocal of the condition number of a positive-definite
+// matrix A given the Cholesky decmposition of A. The condition number computed
+// is based on the 1-norm and the ∞-norm.
+//
+// anorm is the 1-norm and the ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 3*n and Pocon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Pocon will panic otherwise.
+func Pocon(a blas32.Symmetric, anorm float32, work []float32, iwork []int) float32 {
+	return lapack32.Spocon(a.Uplo, a.N, a.Data, a.Stride, anorm, work, iwork)
+}
+
+// Syev computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Syev will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// Work is temporary storage, and lwork specifies the usable memory length. At minimum,
+// lwork >= 3*n-1, and Syev will panic otherwise. The amount of blocking is
+// limited by the usable length. If lwork == -1, instead of computing Syev the
+// optimal work length is stored into work[0].
+func Syev(jobz lapack.EVJob, a blas32.Symmetric, w, work []float32, lwork int) (ok bool) {
+	return lapack32.Ssyev(jobz, a.Uplo, a.N, a.Data, a.Stride, w, work, lwork)
+}
+
+// Syevd computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A. If eigenvectors are desired, it uses a divide and
+// conquer algorithm, which is typically much faster than Syev for large
+// matrices.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Syevd will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+//  1,               if n <= 1,
+//  2*n + 1,         if jobz == lapack.EVNone,
+//  1 + 6*n + 2*n*n, if jobz == lapack.EVCompute,
+// otherwise Syevd will panic.
+//
+// iwork must have length at least max(1,liwork), and liwork must be at least 1
+// if n <= 1 or jobz == lapack.EVNone, and at least 3 + 5*n if
+// jobz == lapack.EVCompute, otherwise Syevd will panic.
+//
+// If lwork == -1 or liwork == -1, instead of computing Syevd the optimal
+// length of work and the minimal length of iwork are stored into work[0] and
+// iwork[0].
+func Syevd(jobz lapack.EVJob, a blas32.Symmetric, w, work []float32, lwork int, iwork []int, liwork int) (ok bool) {
+	return lapack32.Ssyevd(jobz, a.Uplo, a.N, a.Data, a.Stride, w, work, lwork, iwork, liwork)
+}
+
+// Syevr computes selected eigenvalues and, optionally, eigenvectors of the
+// symmetric n×n matrix A. Eigenvalues and eigenvectors can be selected by
+// specifying either a range of values or a range of indices for the desired
+// eigenvalues.
+//
+// rng specifies which eigenvalues are computed:
+//  lapack.EVRangeAll:   all eigenvalues,
+//  lapack.EVRangeValue: the eigenvalues in the half-open interval (vl, vu],
+//  lapack.EVRangeIndex: the il-th through iu-th eigenvalues, counted from the
+//                       smallest starting at zero.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by a.Uplo. On return, the specified triangular region of a
+// is overwritten.
+//
+// abstol is the absolute error tolerance for the eigenvalues. If abstol is not
+// positive, a default tolerance based on the machine precision is used.
+//
+// On return, m is the number of eigenvalues found and w[:m] contains the
+// selected eigenvalues in ascending order. If jobz == lapack.EVCompute, the
+// first m columns of z contain the corresponding orthonormal eigenvectors. z
+// must have at least iu-il+1 columns if rng == lapack.EVRangeIndex and n
+// columns otherwise. If jobz == lapack.EVNone, z is not referenced.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+//  1,               if n <= 1,
+//  1 + 7*n + n*n,   if all eigenvalues and the eigenvectors are computed,
+//  8*n,             otherwise.
+// iwork must have length at least max(1,liwork), and liwork must be at least
+//  1,               if n <= 1,
+//  3 + 5*n,         if all eigenvalues and the eigenvectors are computed,
+//  5*n,             otherwise.
+// If lwork == -1 or liwork == -1, instead of computing Syevr the optimal length
+// of work and the minimal length of iwork are stored into work[0] and iwork[0].
+//
+// Syevr returns whether all the selected eigenvalues and eigenvectors were
+// computed successfully.
+func Syevr(jobz lapack.EVJob, rng lapack.EVRange, a blas32.Symmetric, vl, vu float32, il, iu int, abstol float32, w []float32, z blas32.General, work []float32, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	return lapack32.Ssyevr(jobz, rng, a.Uplo, a.N, a.Data, a.Stride, vl, vu, il, iu, abstol, w, z.Data, z.Stride, work, lwork, iwork, liwork)
+}
+
+// Sygv computes all the eigenvalues and, optionally, the eigenvectors of a
+// real generalized symmetric-definite eigenproblem of the form
+//  A*x = λ*B*x  if itype == lapack.GenEVAxBx,
+//  A*B*x = λ*x  if itype == lapack.GenEVABx,
+//  B*A*x = λ*x  if itype == lapack.GenEVBAx,
+// where A and B are n×n symmetric matrices and B is also positive definite.
+// The triangles of a and b specified by a.Uplo are referenced, and a.Uplo must
+// be equal to b.Uplo.
+//
+// On return, b contains the triangular factor from the Cholesky factorization
+// of B. If jobz == lapack.EVCompute, a contains the matrix Z of eigenvectors
+// with the i-th column of Z holding the eigenvector associated with w[i]. The
+// eigenvectors are normalized as follows:
+//  Z^T*B*Z = I       if itype == lapack.GenEVAxBx or lapack.GenEVABx,
+//  Z^T*inv(B)*Z = I  if itype == lapack.GenEVBAx.
+//
+// On return, w contains the eigenvalues in ascending order.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,3*n-1). For optimum performance lwork should be larger. If lwork == -1,
+// instead of computing Sygv the optimal work length is stored into work[0].
+//
+// Sygv returns whether B is positive definite and the eigenvalue computation
+// converged.
+func Sygv(itype lapack.GenEVType, jobz lapack.EVJob, a, b blas32.Symmetric, w, work []float32, lwork int) (ok bool) {
+	if a.Uplo != b.Uplo {
+		panic("lapack32: mismatched uplo")
+	}
+	return lapack32.Ssygv(itype, jobz, a.Uplo, a.N, a.Data, a.Stride, b.Data, b.Stride, w, work, lwork)
+}
+
+// Sycon estimates the reciprocal of the condition number of the symmetric
+// matrix A using the factorization computed by Sytrf. a and ipiv contain the
+// factorization and the pivot indices as returned by Sytrf. The condition
+// number computed is based on the 1-norm and the ∞-norm.
+//
+// anorm is the 1-norm and the ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Sycon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Sycon will panic otherwise.
+func Sycon(a blas32.Symmetric, ipiv []int, anorm float32, work []float32, iwork []int) float32 {
+	return lapack32.Ssycon(a.Uplo, a.N, a.Data, a.Stride, ipiv, anorm, work, iwork)
+}
+
+// Sytrf computes the factorization of the symmetric matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The factorization has the form
+//  A = U * D * U^T  if a.Uplo == blas.Upper, or
+//  A = L * D * L^T  if a.Uplo == blas.Lower,
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks. On return, the factorization is stored in the triangle of a
+// specified by a.Uplo as described in the documentation of Ssytrf.
+//
+// ipiv contains details of the interchanges and the block structure of D and
+// must have length n, otherwise Sytrf will panic.
+//
+// Sytrf returns whether the matrix A is nonsingular. The factorization is
+// always completed even if ok is false, but it must not be used to solve a
+// system of equations in that case.
+func Sytrf(a blas32.Symmetric, ipiv []int) (ok bool) {
+	return lapack32.Ssytrf(a.Uplo, a.N, a.Data, a.Stride, ipiv)
+}
+
+// Sytrs solves a system of linear equations A*X = B with a symmetric matrix A
+// using the factorization computed by Sytrf. a and ipiv contain the
+// factorization and the pivot indices as returned by Sytrf.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+func Sytrs(a blas32.Symmetric, ipiv []int, b blas32.General) {
+	if b.Rows != a.N {
+		panic("lapack32: bad size of B")
+	}
+	lapack32.Ssytrs(a.Uplo, a.N, b.Cols, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+}
+
+// Trcon estimates the reciprocal of the condition number of a triangular matrix A.
+// The condition number computed may be based on the 1-norm or the ∞-norm.
+//
+// work is a temporary data slice of length at least 3*n and Trcon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Trcon will panic otherwise.
+func Trcon(norm lapack.MatrixNorm, a blas32.Triangular, work []float32, iwork []int) float32 {
+	return lapack32.Strcon(norm, a.Uplo, a.Diag, a.N, a.Data, a.Stride, work, iwork)
+}
+
+// Trtri computes the inverse of a triangular matrix, storing the result in place
+// into a.
+//
+// Trtri will not perform the inversion if the matrix is singular, and returns
+// a boolean indicating whether the inversion was successful.
+func Trtri(a blas32.Triangular) (ok bool) {
+	return lapack32.Strtri(a.Uplo, a.Diag, a.N, a.Data, a.Stride)
+}
+
+// Trtrs solves a triangular system of the form A * X = B or A^T * X = B. Trtrs
+// returns whether the solve completed successfully. If A is singular, no solve is performed.
+func Trtrs(trans blas.Transpose, a blas32.Triangular, b blas32.General) (ok bool) {
+	return lapack32.Strtrs(a.Uplo, trans, a.Diag, a.N, b.Cols, a.Data, a.Stride, b.Data, b.Stride)
+}
+
+// Tzrzf reduces the m×n (m <= n) upper trapezoidal matrix A to upper triangular
+// form by means of orthogonal transformations,
+//  A = [R 0] * Z,
+// where R is an m×m upper triangular matrix and Z is an n×n orthogonal matrix.
+//
+// On return, the upper triangle of the first m columns of a contains R, and the
+// last n-m columns of a together with tau represent Z as a product of m
+// elementary reflectors. tau must have length m, and Tzrzf will panic
+// otherwise.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,m), otherwise Tzrzf will panic. If lwork == -1, instead of performing
+// Tzrzf, the optimal work length will be stored into work[0].
+func Tzrzf(a blas32.General, tau, work []float32, lwork int) {
+	lapack32.Stzrzf(a.Rows, a.Cols, a.Data, a.Stride, tau, work, lwork)
+}
+
+// Geev computes the eigenvalues and, optionally, the left and/or right
+// eigenvectors for an n×n real nonsymmetric matrix A.
+//
+// The right eigenvector v_j of A corresponding to an eigenvalue λ_j
+// is defined by
+//  A v_j = λ_j v_j,
+// and the left eigenvector u_j corresponding to an eigenvalue λ_j is defined by
+//  u_j^H A = λ_j u_j^H,
+// where u_j^H is the conjugate transpose of u_j.
+//
+// On return, A will be overwritten and the left and right eigenvectors will be
+// stored, respectively, in the columns of the n×n matrices VL and VR in the
+// same order as their eigenvalues. If the j-th eigenvalue is real, then
+//  u_j = VL[:,j],
+//  v_j = VR[:,j],
+// and if it is not real, then j and j+1 form a complex conjugate pair and the
+// eigenvectors can be recovered as
+//  u_j     = VL[:,j] + i*VL[:,j+1],
+//  u_{j+1} = VL[:,j] - i*VL[:,j+1],
+//  v_j     = VR[:,j] + i*VR[:,j+1],
+//  v_{j+1} = VR[:,j] - i*VR[:,j+1],
+// where i is the imaginary unit. The computed eigenvectors are normalized to
+// have Euclidean norm equal to 1 and largest component real.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Geev will panic.
+//
+// On return, wr and wi will contain the real and imaginary parts, respectively,
+// of the computed eigenvalues. Complex conjugate pairs of eigenvalues appear
+// consecutively with the eigenvalue having the positive imaginary part first.
+// wr and wi must have length n, and Geev will panic otherwise.
+//
+// work must have length at least lwork and lwork must be at least max(1,4*n) if
+// the left or right eigenvectors are computed, and at least max(1,3*n) if no
+// eigenvectors are computed. For good performance, lwork must generally be
+// larger. On return, optimal value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Geev, the function only calculates the
+// optimal vaule of lwork and stores it into work[0].
+//
+// On return, first will be the index of the first valid eigenvalue.
+// If first == 0, all eigenvalues and eigenvectors have been computed.
+// If first is positive, Geev failed to compute all the eigenvalues, no
+// eigenvectors have been computed and wr[first:] and wi[first:] contain those
+// eigenvalues which have converged.
+func Geev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a blas32.General, wr, wi []float32, vl, vr blas32.General, work []float32, lwork int) (first int) {
+	n := a.Rows
+	if a.Cols != n {
+		panic("lapack32: matrix not square")
+	}
+	if jobvl == lapack.LeftEVCompute && (vl.Rows != n || vl.Cols != n) {
+		panic("lapack32: bad size of VL")
+	}
+	if jobvr == lapack.RightEVCompute && (vr.Rows != n || vr.Cols != n) {
+		panic("lapack32: bad size of VR")
+	}
+	return lapack32.Sgeev(jobvl, jobvr, n, a.Data, a.Stride, wr, wi, vl.Data, vl.Stride, vr.Data, vr.Stride, work, lwork)
+}
+
+// Ggev computes for a pair of n×n real nonsymmetric matrices (A,B) the
+// generalized eigenvalues and, optionally, the left and/or right generalized
+// eigenvectors.
+//
+// The right generalized eigenvector v_j corresponding to the generalized
+// eigenvalue λ_j of (A,B) satisfies
+//  A * v_j = λ_j * B * v_j,
+// and the left generalized eigenvector u_j satisfies
+//  u_j^H * A = λ_j * u_j^H * B,
+// where u_j^H is the conjugate transpose of u_j.
+//
+// On return, A and B will be overwritten. alphar, alphai and beta must have
+// length n, and the generalized eigenvalues will be
+//  λ_j = (alphar[j] + alphai[j]*i) / beta[j].
+// If beta[j] is zero, λ_j is infinite. Complex conjugate pairs of eigenvalues
+// appear consecutively with the eigenvalue having the positive imaginary part
+// first.
+//
+// The left and right eigenvectors are stored in vl and vr in the same way as
+// in Geev, except that each eigenvector is scaled so that its largest
+// component has |real part| + |imag. part| = 1. vl and vr are referenced only
+// if jobvl == lapack.LeftEVCompute and jobvr == lapack.RightEVCompute,
+// respectively.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n).
+// For optimum performance lwork should be larger. If lwork == -1, instead of
+// performing Ggev, the optimal value of lwork is stored into work[0].
+//
+// first is the index of the first valid eigenvalue. If first is positive, the
+// QZ iteration failed to compute all the eigenvalues, no eigenvectors have been
+// computed and alphar[first:], alphai[first:] and beta[first:] contain those
+// eigenvalues which have converged. ok is false if the QZ iteration or the
+// computation of the eigenvectors failed.
+func Ggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a, b blas32.General, alphar, alphai, beta []float32, vl, vr blas32.General, work []float32, lwork int) (first int, ok bool) {
+	n := a.Rows
+	if a.Cols != n || b.Rows != n || b.Cols != n {
+		panic("lapack32: bad matrix size")
+	}
+	if jobvl == lapack.LeftEVCompute && (vl.Rows != n || vl.Cols != n) {
+		panic("lapack32: bad size of VL")
+	}
+	if jobvr == lapack.RightEVCompute && (vr.Rows != n || vr.Cols != n) {
+		panic("lapack32: bad size of VR")
+	}
+	return lapack32.Sggev(jobvl, jobvr, n, a.Data, a.Stride, b.Data, b.Stride, alphar, alphai, beta, vl.Data, vl.Stride, vr.Data, vr.Stride, work, lwork)
+}
+
+// Gges computes for a pair of n×n real nonsymmetric matrices (A,B) the
+// generalized eigenvalues, the generalized real Schur form (S,T) and,
+// optionally, the left and/or right matrices of Schur vectors VSL and VSR,
+// so that
+//  A = VSL*S*VSR^T,  B = VSL*T*VSR^T.
+// The eigenvalues are not reordered.
+//
+// On return, A and B are overwritten by S and T, respectively. alphar, alphai
+// and beta must have length n and contain the generalized eigenvalues as in
+// Ggev. vsl and vsr are referenced only if jobvsl and jobvsr, respectively,
+// are lapack.SchurOrig, and they must be lapack.SchurOrig or lapack.SchurNone.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n).
+// For optimum performance lwork should be larger. If lwork == -1, instead of
+// performing Gges, the optimal value of lwork is stored into work[0].
+//
+// first is the index of the first valid eigenvalue. If first is positive, the
+// QZ iteration failed, (A,B) are not in Schur form, and alphar[first:],
+// alphai[first:] and beta[first:] contain those eigenvalues which have
+// converged.
+func Gges(jobvsl, jobvsr lapack.SchurComp, a, b blas32.General, alphar, alphai, beta []float32, vsl, vsr blas32.General, work []float32, lwork int) (first int) {
+	n := a.Rows
+	if a.Cols != n || b.Rows != n || b.Cols != n {
+		panic("lapack32: bad matrix size")
+	}
+	if jobvsl == lapack.SchurOrig && (vsl.Rows != n || vsl.Cols != n) {
+		panic("lapack32: bad size of VSL")
+	}
+	if jobvsr == lapack.SchurOrig && (vsr.Rows != n || vsr.Cols != n) {
+		panic("lapack32: bad size of VSR")
+	}
+	return lapack32.Sgges(jobvsl, jobvsr, n, a.Data, a.Stride, b.Data, b.Stride, alphar, alphai, beta, vsl.Data, vsl.Stride, vsr.Data, vsr.Stride, work, lwork)
+}
+
+// Gees computes for an n×n real nonsymmetric matrix A the eigenvalues, the
+// real Schur form T, and, optionally, the matrix of Schur vectors Z, so that
+//  A = Z*T*Z^T.
+// T is upper quasi-triangular in Schur canonical form and the eigenvalues are
+// not reordered.
+//
+// On return, A is overwritten by T. wr and wi must have length n and contain
+// the real and imaginary parts of the eigenvalues in the order in which they
+// appear on the diagonal of T. vs is referenced only if jobvs is
+// lapack.SchurOrig, and jobvs must be lapack.SchurOrig or lapack.SchurNone.
+//
+// work must have length at least lwork and lwork must be at least max(1,3*n).
+// For optimum performance lwork should be larger. If lwork == -1, instead of
+// performing Gees, the optimal value of lwork is stored into work[0].
+//
+// first is the index of the first valid eigenvalue. If first is positive, the
+// QR algorithm failed, A is not in Schur form, and wr[first:] and wi[first:]
+// contain those eigenvalues which have converged.
+func Gees(jobvs lapack.SchurComp, a blas32.General, wr, wi []float32, vs blas32.General, work []float32, lwork int) (first int) {
+	n := a.Rows
+	if a.Cols != n {
+		panic("lapack32: matrix not square")
+	}
+	if jobvs == lapack.SchurOrig && (vs.Rows != n || vs.Cols != n) {
+		panic("lapack32: bad size of VS")
+	}
+	return lapack32.Sgees(jobvs, n, a.Data, a.Stride, wr, wi, vs.Data, vs.Stride, work, lwork)
+}
+
+// Trsen reorders the real Schur factorization of an n×n real matrix
+//  A = Q*T*Q^T
+// so that a selected cluster of eigenvalues appears in the leading diagonal
+// blocks of the upper quasi-triangular matrix T, and the leading columns of Q
+// form an orthonormal basis of the corresponding right invariant subspace.
+// Optionally, the reciprocal condition numbers of the cluster of eigenvalues
+// (s) and of the invariant subspace (sep) are computed as specified by job.
+//
+// T must be in Schur canonical form. q is referenced only if compq is
+// lapack.UpdateSchur. selected, wr and wi must have length n. On return, wr and
+// wi contain the reordered eigenvalues and m is the dimension of the selected
+// invariant subspace.
+//
+// If lwork == -1 or liwork == -1, instead of performing Trsen, the minimum
+// values of lwork and liwork are stored into work[0] and iwork[0],
+// respectively. See the documentation of Strsen for the required lengths.
+//
+// ok will be false if some eigenvalues were too close to reorder.
+func Trsen(job lapack.SchurCond, compq lapack.UpdateSchurComp, selected []bool, t, q blas32.General, wr, wi, work []float32, lwork int, iwork []int, liwork int) (m int, s, sep float32, ok bool) {
+	n := t.Rows
+	if t.Cols != n {
+		panic("lapack32: matrix not square")
+	}
+	if compq == lapack.UpdateSchur && (q.Rows != n || q.Cols != n) {
+		panic("lapack32: bad size of Q")
+	}
+	return lapack32.Strsen(job, compq, selected, n, t.Data, t.Stride, q.Data, q.Stride, wr, wi, work, lwork, iwork, liwork)
+}
+
+// Trsyl solves the real Sylvester matrix equation
+//  op(A)*X + isgn*X*op(B) = scale*C,
+// where A is an m×m and B an n×n upper quasi-triangular matrix in Schur
+// canonical form, C and X are m×n, op(A) is A or A^T as specified by trana,
+// op(B) is B or B^T as specified by tranb, and isgn is 1 or -1. A and B are
+// typically obtained from Gees.
+//
+// On return, C is overwritten by the solution X. scale is a scaling factor in
+// (0,1] chosen to avoid overflow in X.
+//
+// ok will be false if op(A) and -isgn*op(B) have common or very close
+// eigenvalues, in which case perturbed values were used to solve the equation.
+func Trsyl(trana, tranb blas.Transpose, isgn int, a, b, c blas32.General) (scale float32, ok bool) {
+	m := a.Rows
+	n := b.Rows
+	if a.Cols != m || b.Cols != n {
+		panic("lapack32: matrix not square")
+	}
+	if c.Rows != m || c.Cols != n {
+		panic("lapack32: bad size of C")
+	}
+	return lapack32.Strsyl(trana, tranb, isgn, m, n, a.Data, a.Stride, b.Data, b.Stride, c.Data, c.Stride)
+}
@@ -66,6 +66,66 @@ func Potrs(t blas64.Triangular, b blas64.General) {
 	lapack64.Dpotrs(t.Uplo, t.N, b.Cols, t.Data, t.Stride, b.Data, b.Stride)
 }
 
+// Gbcon estimates the reciprocal of the condition number of the n×n band
+// matrix A, in either the 1-norm or the ∞-norm, using the LU factorization
+// computed by Gbtrf. a and ipiv contain the LU factorization of A and the pivot
+// indices as computed by Gbtrf.
+//
+// anorm is the corresponding 1-norm or ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 3*n and Gbcon will panic
+// otherwise.
+//
+// iwork is a temporary data slice of length at least n and Gbcon will panic
+// otherwise.
+func Gbcon(norm lapack.MatrixNorm, a blas64.Band, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	return lapack64.Dgbcon(norm, a.Cols, a.KL, a.KU, a.Data, a.Stride, ipiv, anorm, work, iwork)
+}
+
+// Gbtrf computes an LU factorization of the m×n band matrix A using partial
+// pivoting with row interchanges. The factorization has the form
+//  A = P * L * U,
+// where P is a permutation matrix, L is a unit lower triangular band matrix
+// with a.KL sub-diagonals and U is an upper triangular band matrix with
+// a.KL+a.KU super-diagonals.
+//
+// a.Stride must be at least 2*a.KL+a.KU+1 to leave room for the fill-in created
+// by the row interchanges. On return, U and the multipliers of L are stored in
+// a as described in the documentation of Dgbtrf.
+//
+// ipiv is a permutation vector. It indicates that row i of the matrix was
+// interchanged with row ipiv[i]. ipiv must have length min(m,n), and Gbtrf will
+// panic otherwise. ipiv is zero-indexed.
+//
+// Gbtrf returns whether the matrix A is nonsingular. The LU factorization will
+// be computed regardless of the singularity of A, but it must not be used to
+// solve a system of equations if false is returned.
+func Gbtrf(a blas64.Band, ipiv []int) (ok bool) {
+	return lapack64.Dgbtrf(a.Rows, a.Cols, a.KL, a.KU, a.Data, a.Stride, ipiv)
+}
+
+// Gbtrs solves a system of linear equations
+//  A * X = B   if trans == blas.NoTrans
+//  A^T * X = B if trans == blas.Trans or blas.ConjTrans
+// with an n×n band matrix A using the LU factorization computed by Gbtrf. a and
+// ipiv contain the LU factorization of A and the pivot indices as computed by
+// Gbtrf.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+func Gbtrs(trans blas.Transpose, a blas64.Band, ipiv []int, b blas64.General) {
+	if a.Rows != a.Cols {
+		panic("lapack64: matrix not square")
+	}
+	if b.Rows != a.Rows {
+		panic("lapack64: bad size of B")
+	}
+	lapack64.Dgbtrs(trans, a.Cols, a.KL, a.KU, b.Cols, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+}
+
 // Gecon estimates the reciprocal of the condition number of the n×n matrix A
 // given the LU decomposition of the matrix. The condition number computed may
 // be based on the 1-norm or the ∞-norm.
@@ -114,6 +174,28 @@ func Gels(trans blas.Transpose, a blas64.General, b blas64.General, work []float
 	return lapack64.Dgels(trans, a.Rows, a.Cols, b.Cols, a.Data, a.Stride, b.Data, b.Stride, work, lwork)
 }
 
+// Geqp3 computes a QR factorization with column pivoting of the m×n matrix A,
+//  A*P = Q*R,
+// where P is a permutation matrix, Q is an orthogonal matrix and R is an upper
+// trapezoidal matrix. The diagonal elements of R have non-increasing absolute
+// values, so the factorization reveals the numerical rank of A.
+//
+// On return, the upper triangle of a contains R and the elements below the
+// diagonal together with tau represent Q as described in Geqrf. tau must have
+// length min(m,n), and Geqp3 will panic otherwise.
+//
+// jpvt must have length n, and Geqp3 will panic otherwise. On entry, if
+// jpvt[j] >= 0, the jth column of A is permuted to the front of A*P, and if
+// jpvt[j] == -1, the jth column of A is a free column. On return, the jth
+// column of A*P was the jpvt[j] column of A.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// 3*n+1, otherwise Geqp3 will panic. If lwork == -1, instead of performing
+// Geqp3, the optimal work length will be stored into work[0].
+func Geqp3(a blas64.General, jpvt []int, tau, work []float64, lwork int) {
+	lapack64.Dgeqp3(a.Rows, a.Cols, a.Data, a.Stride, jpvt, tau, work, lwork)
+}
+
 // Geqrf computes the QR factorization of the m×n matrix A using a blocked
 // algorithm. A is modified to contain the information to construct Q and R.
 // The upper triangle of a contains the matrix R. The lower triangular elements
@@ -160,6 +242,47 @@ func Gelqf(a blas64.General, tau, work []float64, lwork int) {
 	lapack64.Dgelqf(a.Rows, a.Cols, a.Data, a.Stride, tau, work, lwork)
 }
 
+// Gesdd computes the singular value decomposition of the input matrix A
+// using a divide and conquer method. Gesdd is usually substantially faster
+// than Gesvd for large matrices when the singular vectors are required.
+//
+// The singular value decomposition is
+//  A = U * Sigma * V^T
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobz specifies which singular vectors are computed. The behavior is as
+// follows
+//  jobz == lapack.SVDAll   All m columns of U and all n rows of V^T are
+//                          returned in u and vt.
+//  jobz == lapack.SVDStore The first min(m,n) columns of U and the first
+//                          min(m,n) rows of V^T are returned in u and vt.
+//  jobz == lapack.SVDNone  Neither U nor V^T are computed.
+// jobz == lapack.SVDOverwrite is not supported and Gesdd will panic.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Gesdd
+// the data is overwritten.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. If jobz == lapack.SVDNone, lwork must be at least
+// 3*min(m,n)+max(max(m,n), 4*min(m,n)). Otherwise, lwork must be at least
+// 3*min(m,n)*min(m,n)+max(max(m,n), 4*min(m,n)*min(m,n)+4*min(m,n)).
+// If lwork == -1, instead of performing Gesdd, the optimal work length will be
+// stored into work[0]. Gesdd will panic if the working memory has insufficient
+// storage.
+//
+// iwork must have length at least 8*min(m,n).
+//
+// Gesdd returns whether the decomposition successfully completed.
+func Gesdd(jobz lapack.SVDJob, a, u, vt blas64.General, s, work []float64, lwork int, iwork []int) (ok bool) {
+	return lapack64.Dgesdd(jobz, a.Rows, a.Cols, a.Data, a.Stride, s, u.Data, u.Stride, vt.Data, vt.Stride, work, lwork, iwork)
+}
+
 // Gesvd computes the singular value decomposition of the input matrix A.
 //
 // The singular value decomposition is
@@ -454,6 +577,27 @@ func Ormqr(side blas.Side, trans blas.Transpose, a blas64.General, tau []float64
 	lapack64.Dormqr(side, trans, c.Rows, c.Cols, a.Cols, a.Data, a.Stride, tau, c.Data, c.Stride, work, lwork)
 }
 
+// Ormrz multiplies an m×n matrix C by an orthogonal matrix Z as
+//  C = Z * C,    if side == blas.Left  and trans == blas.NoTrans,
+//  C = Z^T * C,  if side == blas.Left  and trans == blas.Trans,
+//  C = C * Z,    if side == blas.Right and trans == blas.NoTrans,
+//  C = C * Z^T,  if side == blas.Right and trans == blas.Trans,
+// where Z is defined as the product of k elementary reflectors as returned by
+// Tzrzf.
+//
+// If side == blas.Left, A is a k×m matrix and 0 <= k <= m.
+// If side == blas.Right, A is a k×n matrix and 0 <= k <= n.
+// l is the number of columns of A containing the meaningful part of the
+// Householder vectors. tau must have length k and Ormrz will panic otherwise.
+//
+// work must have length at least max(1,lwork), and lwork must be at least n if
+// side == blas.Left and at least m if side == blas.Right, otherwise Ormrz will
+// panic. If lwork is -1, instead of performing Ormrz, the optimal workspace
+// size will be stored into work[0].
+func Ormrz(side blas.Side, trans blas.Transpose, a blas64.General, l int, tau []float64, c blas64.General, work []float64, lwork int) {
+	lapack64.Dormrz(side, trans, c.Rows, c.Cols, a.Rows, l, a.Data, a.Stride, tau, c.Data, c.Stride, work, lwork)
+}
+
 // Pocon estimates the reciprocal of the condition number of a positive-definite
 // matrix A given the Cholesky decmposition of A. The condition number computed
 // is based on the 1-norm and the ∞-norm.
@@ -467,6 +611,46 @@ func Pocon(a blas64.Symmetric, anorm float64, work []float64, iwork []int) float
 	return lapack64.Dpocon(a.Uplo, a.N, a.Data, a.Stride, anorm, work, iwork)
 }
 
+// Dsgesv computes the solution to the system of linear equations
+//  A * X = B,
+// where A is an n×n matrix, using an LU factorization of A computed in single
+// precision followed by iterative refinement of the solution to double
+// precision. If the refinement cannot succeed, the solution is computed using
+// an LU factorization in double precision.
+//
+// The returned value iter is the number of refinement iterations, or negative
+// if the double precision fall back was used. ok is false if A is exactly
+// singular, in which case x is not computed. If iter is negative, a and ipiv
+// contain the double precision LU factorization of A on return, otherwise a
+// is not modified. See lapack.Float64.Dsgesv for the full documentation.
+//
+// ipiv must have length n, work must have length at least n*nrhs, swork must
+// have length at least n*(n+nrhs+4) and iwork must have length at least n.
+func Dsgesv(a blas64.General, ipiv []int, b, x blas64.General, work []float64, swork []float32, iwork []int) (iter int, ok bool) {
+	return lapack64.Dsgesv(a.Cols, b.Cols, a.Data, a.Stride, ipiv, b.Data, b.Stride, x.Data, x.Stride, work, swork, iwork)
+}
+
+// Dsposv computes the solution to the system of linear equations
+//  A * X = B,
+// where A is an n×n symmetric positive definite matrix, using a Cholesky
+// factorization of A computed in single precision followed by iterative
+// refinement of the solution to double precision. If the refinement cannot
+// succeed, the solution is computed using a Cholesky factorization in double
+// precision.
+//
+// The returned value iter is the number of refinement iterations, or negative
+// if the double precision fall back was used. ok is false if A is not
+// positive definite, in which case x is not computed. If iter is negative,
+// a contains the double precision Cholesky factorization of A on return,
+// otherwise a is not modified. See lapack.Float64.Dsposv for the full
+// documentation.
+//
+// work must have length at least n*nrhs, swork must have length at least
+// n*(n+nrhs+3) and iwork must have length at least n.
+func Dsposv(a blas64.Symmetric, b, x blas64.General, work []float64, swork []float32, iwork []int) (iter int, ok bool) {
+	return lapack64.Dsposv(a.Uplo, a.N, b.Cols, a.Data, a.Stride, b.Data, b.Stride, x.Data, x.Stride, work, swork, iwork)
+}
+
 // Syev computes all eigenvalues and, optionally, the eigenvectors of a real
 // symmetric matrix A.
 //
@@ -486,6 +670,154 @@ func Syev(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int) (
 	return lapack64.Dsyev(jobz, a.Uplo, a.N, a.Data, a.Stride, w, work, lwork)
 }
 
+// Syevd computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A. If eigenvectors are desired, it uses a divide and
+// conquer algorithm, which is typically much faster than Syev for large
+// matrices.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Syevd will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+//  1,               if n <= 1,
+//  2*n + 1,         if jobz == lapack.EVNone,
+//  1 + 6*n + 2*n*n, if jobz == lapack.EVCompute,
+// otherwise Syevd will panic.
+//
+// iwork must have length at least max(1,liwork), and liwork must be at least 1
+// if n <= 1 or jobz == lapack.EVNone, and at least 3 + 5*n if
+// jobz == lapack.EVCompute, otherwise Syevd will panic.
+//
+// If lwork == -1 or liwork == -1, instead of computing Syevd the optimal
+// length of work and the minimal length of iwork are stored into work[0] and
+// iwork[0].
+func Syevd(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	return lapack64.Dsyevd(jobz, a.Uplo, a.N, a.Data, a.Stride, w, work, lwork, iwork, liwork)
+}
+
+// Syevr computes selected eigenvalues and, optionally, eigenvectors of the
+// symmetric n×n matrix A. Eigenvalues and eigenvectors can be selected by
+// specifying either a range of values or a range of indices for the desired
+// eigenvalues.
+//
+// rng specifies which eigenvalues are computed:
+//  lapack.EVRangeAll:   all eigenvalues,
+//  lapack.EVRangeValue: the eigenvalues in the half-open interval (vl, vu],
+//  lapack.EVRangeIndex: the il-th through iu-th eigenvalues, counted from the
+//                       smallest starting at zero.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by a.Uplo. On return, the specified triangular region of a
+// is overwritten.
+//
+// abstol is the absolute error tolerance for the eigenvalues. If abstol is not
+// positive, a default tolerance based on the machine precision is used.
+//
+// On return, m is the number of eigenvalues found and w[:m] contains the
+// selected eigenvalues in ascending order. If jobz == lapack.EVCompute, the
+// first m columns of z contain the corresponding orthonormal eigenvectors. z
+// must have at least iu-il+1 columns if rng == lapack.EVRangeIndex and n
+// columns otherwise. If jobz == lapack.EVNone, z is not referenced.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+//  1,               if n <= 1,
+//  1 + 7*n + n*n,   if all eigenvalues and the eigenvectors are computed,
+//  8*n,             otherwise.
+// iwork must have length at least max(1,liwork), and liwork must be at least
+//  1,               if n <= 1,
+//  3 + 5*n,         if all eigenvalues and the eigenvectors are computed,
+//  5*n,             otherwise.
+// If lwork == -1 or liwork == -1, instead of computing Syevr the optimal length
+// of work and the minimal length of iwork are stored into work[0] and iwork[0].
+//
+// Syevr returns whether all the selected eigenvalues and eigenvectors were
+// computed successfully.
+func Syevr(jobz lapack.EVJob, rng lapack.EVRange, a blas64.Symmetric, vl, vu float64, il, iu int, abstol float64, w []float64, z blas64.General, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	return lapack64.Dsyevr(jobz, rng, a.Uplo, a.N, a.Data, a.Stride, vl, vu, il, iu, abstol, w, z.Data, z.Stride, work, lwork, iwork, liwork)
+}
+
+// Sygv computes all the eigenvalues and, optionally, the eigenvectors of a
+// real generalized symmetric-definite eigenproblem of the form
+//  A*x = λ*B*x  if itype == lapack.GenEVAxBx,
+//  A*B*x = λ*x  if itype == lapack.GenEVABx,
+//  B*A*x = λ*x  if itype == lapack.GenEVBAx,
+// where A and B are n×n symmetric matrices and B is also positive definite.
+// The triangles of a and b specified by a.Uplo are referenced, and a.Uplo must
+// be equal to b.Uplo.
+//
+// On return, b contains the triangular factor from the Cholesky factorization
+// of B. If jobz == lapack.EVCompute, a contains the matrix Z of eigenvectors
+// with the i-th column of Z holding the eigenvector associated with w[i]. The
+// eigenvectors are normalized as follows:
+//  Z^T*B*Z = I       if itype == lapack.GenEVAxBx or lapack.GenEVABx,
+//  Z^T*inv(B)*Z = I  if itype == lapack.GenEVBAx.
+//
+// On return, w contains the eigenvalues in ascending order.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,3*n-1). For optimum performance lwork should be larger. If lwork == -1,
+// instead of computing Sygv the optimal work length is stored into work[0].
+//
+// Sygv returns whether B is positive definite and the eigenvalue computation
+// converged.
+func Sygv(itype lapack.GenEVType, jobz lapack.EVJob, a, b blas64.Symmetric, w, work []float64, lwork int) (ok bool) {
+	if a.Uplo != b.Uplo {
+		panic("lapack64: mismatched uplo")
+	}
+	return lapack64.Dsygv(itype, jobz, a.Uplo, a.N, a.Data, a.Stride, b.Data, b.Stride, w, work, lwork)
+}
+
+// Sycon estimates the reciprocal of the condition number of the symmetric
+// matrix A using the factorization computed by Sytrf. a and ipiv contain the
+// factorization and the pivot indices as returned by Sytrf. The condition
+// number computed is based on the 1-norm and the ∞-norm.
+//
+// anorm is the 1-norm and the ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Sycon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Sycon will panic otherwise.
+func Sycon(a blas64.Symmetric, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	return lapack64.Dsycon(a.Uplo, a.N, a.Data, a.Stride, ipiv, anorm, work, iwork)
+}
+
+// Sytrf computes the factorization of the symmetric matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The factorization has the form
+//  A = U * D * U^T  if a.Uplo == blas.Upper, or
+//  A = L * D * L^T  if a.Uplo == blas.Lower,
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks. On return, the factorization is stored in the triangle of a
+// specified by a.Uplo as described in the documentation of Dsytrf.
+//
+// ipiv contains details of the interchanges and the block structure of D and
+// must have length n, otherwise Sytrf will panic.
+//
+// Sytrf returns whether the matrix A is nonsingular. The factorization is
+// always completed even if ok is false, but it must not be used to solve a
+// system of equations in that case.
+func Sytrf(a blas64.Symmetric, ipiv []int) (ok bool) {
+	return lapack64.Dsytrf(a.Uplo, a.N, a.Data, a.Stride, ipiv)
+}
+
+// Sytrs solves a system of linear equations A*X = B with a symmetric matrix A
+// using the factorization computed by Sytrf. a and ipiv contain the
+// factorization and the pivot indices as returned by Sytrf.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+func Sytrs(a blas64.Symmetric, ipiv []int, b blas64.General) {
+	if b.Rows != a.N {
+		panic("lapack64: bad size of B")
+	}
+	lapack64.Dsytrs(a.Uplo, a.N, b.Cols, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+}
+
 // Trcon estimates the reciprocal of the condition number of a triangular matrix A.
 // The condition number computed may be based on the 1-norm or the ∞-norm.
 //
@@ -511,6 +843,23 @@ func Trtrs(trans blas.Transpose, a blas64.Triangular, b blas64.General) (ok bool
 	return lapack64.Dtrtrs(a.Uplo, trans, a.Diag, a.N, b.Cols, a.Data, a.Stride, b.Data, b.Stride)
 }
 
+// Tzrzf reduces the m×n (m <= n) upper trapezoidal matrix A to upper triangular
+// form by means of orthogonal transformations,
+//  A = [R 0] * Z,
+// where R is an m×m upper triangular matrix and Z is an n×n orthogonal matrix.
+//
+// On return, the upper triangle of the first m columns of a contains R, and the
+// last n-m columns of a together with tau represent Z as a product of m
+// elementary reflectors. tau must have length m, and Tzrzf will panic
+// otherwise.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,m), otherwise Tzrzf will panic. If lwork == -1, instead of performing
+// Tzrzf, the optimal work length will be stored into work[0].
+func Tzrzf(a blas64.General, tau, work []float64, lwork int) {
+	lapack64.Dtzrzf(a.Rows, a.Cols, a.Data, a.Stride, tau, work, lwork)
+}
+
 // Geev computes the eigenvalues and, optionally, the left and/or right
 // eigenvectors for an n×n real nonsymmetric matrix A.
 //
@@ -572,3 +921,166 @@ func Geev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a blas64.General, wr,
 	}
 	return lapack64.Dgeev(jobvl, jobvr, n, a.Data, a.Stride, wr, wi, vl.Data, vl.Stride, vr.Data, vr.Stride, work, lwork)
 }
+
+// Ggev computes for a pair of n×n real nonsymmetric matrices (A,B) the
+// generalized eigenvalues and, optionally, the left and/or right generalized
+// eigenvectors.
+//
+// The right generalized eigenvector v_j corresponding to the generalized
+// eigenvalue λ_j of (A,B) satisfies
+//  A * v_j = λ_j * B * v_j,
+// and the left generalized eigenvector u_j satisfies
+//  u_j^H * A = λ_j * u_j^H * B,
+// where u_j^H is the conjugate transpose of u_j.
+//
+// On return, A and B will be overwritten. alphar, alphai and beta must have
+// length n, and the generalized eigenvalues will be
+//  λ_j = (alphar[j] + alphai[j]*i) / beta[j].
+// If beta[j] is zero, λ_j is infinite. Complex conjugate pairs of eigenvalues
+// appear consecutively with the eigenvalue having the positive imaginary part
+// first.
+//
+// The left and right eigenvectors are stored in vl and vr in the same way as
+// in Geev, except that each eigenvector is scaled so that its largest
+// component has |real part| + |imag. part| = 1. vl and vr are referenced only
+// if jobvl == lapack.LeftEVCompute and jobvr == lapack.RightEVCompute,
+// respectively.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n).
+// For optimum performance lwork should be larger. If lwork == -1, instead of
+// performing Ggev, the optimal value of lwork is stored into work[0].
+//
+// first is the index of the first valid eigenvalue. If first is positive, the
+// QZ iteration failed to compute all the eigenvalues, no eigenvectors have been
+// computed and alphar[first:], alphai[first:] and beta[first:] contain those
+// eigenvalues which have converged. ok is false if the QZ iteration or the
+// computation of the eigenvectors failed.
+func Ggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a, b blas64.General, alphar, alphai, beta []float64, vl, vr blas64.General, work []float64, lwork int) (first int, ok bool) {
+	n := a.Rows
+	if a.Cols != n || b.Rows != n || b.Cols != n {
+		panic("lapack64: bad matrix size")
+	}
+	if jobvl == lapack.LeftEVCompute && (vl.Rows != n || vl.Cols != n) {
+		panic("lapack64: bad size of VL")
+	}
+	if jobvr == lapack.RightEVCompute && (vr.Rows != n || vr.Cols != n) {
+		panic("lapack64: bad size of VR")
+	}
+	return lapack64.Dggev(jobvl, jobvr, n, a.Data, a.Stride, b.Data, b.Stride, alphar, alphai, beta, vl.Data, vl.Stride, vr.Data, vr.Stride, work, lwork)
+}
+
+// Gges computes for a pair of n×n real nonsymmetric matrices (A,B) the
+// generalized eigenvalues, the generalized real Schur form (S,T) and,
+// optionally, the left and/or right matrices of Schur vectors VSL and VSR,
+// so that
+//  A = VSL*S*VSR^T,  B = VSL*T*VSR^T.
+// The eigenvalues are not reordered.
+//
+// On return, A and B are overwritten by S and T, respectively. alphar, alphai
+// and beta must have length n and contain the generalized eigenvalues as in
+// Ggev. vsl and vsr are referenced only if jobvsl and jobvsr, respectively,
+// are lapack.SchurOrig, and they must be lapack.SchurOrig or lapack.SchurNone.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n).
+// For optimum performance lwork should be larger. If lwork == -1, instead of
+// performing Gges, the optimal value of lwork is stored into work[0].
+//
+// first is the index of the first valid eigenvalue. If first is positive, the
+// QZ iteration failed, (A,B) are not in Schur form, and alphar[first:],
+// alphai[first:] and beta[first:] contain those eigenvalues which have
+// converged.
+func Gges(jobvsl, jobvsr lapack.SchurComp, a, b blas64.General, alphar, alphai, beta []float64, vsl, vsr blas64.General, work []float64, lwork int) (first int) {
+	n := a.Rows
+	if a.Cols != n || b.Rows != n || b.Cols != n {
+		panic("lapack64: bad matrix size")
+	}
+	if jobvsl == lapack.SchurOrig && (vsl.Rows != n || vsl.Cols != n) {
+		panic("lapack64: bad size of VSL")
+	}
+	if jobvsr == lapack.SchurOrig && (vsr.Rows != n || vsr.Cols != n) {
+		panic("lapack64: bad size of VSR")
+	}
+	return lapack64.Dgges(jobvsl, jobvsr, n, a.Data, a.Stride, b.Data, b.Stride, alphar, alphai, beta, vsl.Data, vsl.Stride, vsr.Data, vsr.Stride, work, lwork)
+}
+
+// Gees computes for an n×n real nonsymmetric matrix A the eigenvalues, the
+// real Schur form T, and, optionally, the matrix of Schur vectors Z, so that
+//  A = Z*T*Z^T.
+// T is upper quasi-triangular in Schur canonical form and the eigenvalues are
+// not reordered.
+//
+// On return, A is overwritten by T. wr and wi must have length n and contain
+// the real and imaginary parts of the eigenvalues in the order in which they
+// appear on the diagonal of T. vs is referenced only if jobvs is
+// lapack.SchurOrig, and jobvs must be lapack.SchurOrig or lapack.SchurNone.
+//
+// work must have length at least lwork and lwork must be at least max(1,3*n).
+// For optimum performance lwork should be larger. If lwork == -1, instead of
+// performing Gees, the optimal value of lwork is stored into work[0].
+//
+// first is the index of the first valid eigenvalue. If first is positive, the
+// QR algorithm failed, A is not in Schur form, and wr[first:] and wi[first:]
+// contain those eigenvalues which have converged.
+func Gees(jobvs lapack.SchurComp, a blas64.General, wr, wi []float64, vs blas64.General, work []float64, lwork int) (first int) {
+	n := a.Rows
+	if a.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if jobvs == lapack.SchurOrig && (vs.Rows != n || vs.Cols != n) {
+		panic("lapack64: bad size of VS")
+	}
+	return lapack64.Dgees(jobvs, n, a.Data, a.Stride, wr, wi, vs.Data, vs.Stride, work, lwork)
+}
+
+// Trsen reorders the real Schur factorization of an n×n real matrix
+//  A = Q*T*Q^T
+// so that a selected cluster of eigenvalues appears in the leading diagonal
+// blocks of the upper quasi-triangular matrix T, and the leading columns of Q
+// form an orthonormal basis of the corresponding right invariant subspace.
+// Optionally, the reciprocal condition numbers of the cluster of eigenvalues
+// (s) and of the invariant subspace (sep) are computed as specified by job.
+//
+// T must be in Schur canonical form. q is referenced only if compq is
+// lapack.UpdateSchur. selected, wr and wi must have length n. On return, wr and
+// wi contain the reordered eigenvalues and m is the dimension of the selected
+// invariant subspace.
+//
+// If lwork == -1 or liwork == -1, instead of performing Trsen, the minimum
+// values of lwork and liwork are stored into work[0] and iwork[0],
+// respectively. See the documentation of Dtrsen for the required lengths.
+//
+// ok will be false if some eigenvalues were too close to reorder.
+func Trsen(job lapack.SchurCond, compq lapack.UpdateSchurComp, selected []bool, t, q blas64.General, wr, wi, work []float64, lwork int, iwork []int, liwork int) (m int, s, sep float64, ok bool) {
+	n := t.Rows
+	if t.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if compq == lapack.UpdateSchur && (q.Rows != n || q.Cols != n) {
+		panic("lapack64: bad size of Q")
+	}
+	return lapack64.Dtrsen(job, compq, selected, n, t.Data, t.Stride, q.Data, q.Stride, wr, wi, work, lwork, iwork, liwork)
+}
+
+// Trsyl solves the real Sylvester matrix equation
+//  op(A)*X + isgn*X*op(B) = scale*C,
+// where A is an m×m and B an n×n upper quasi-triangular matrix in Schur
+// canonical form, C and X are m×n, op(A) is A or A^T as specified by trana,
+// op(B) is B or B^T as specified by tranb, and isgn is 1 or -1. A and B are
+// typically obtained from Gees.
+//
+// On return, C is overwritten by the solution X. scale is a scaling factor in
+// (0,1] chosen to avoid overflow in X.
+//
+// ok will be false if op(A) and -isgn*op(B) have common or very close
+// eigenvalues, in which case perturbed values were used to solve the equation.
+func Trsyl(trana, tranb blas.Transpose, isgn int, a, b, c blas64.General) (scale float64, ok bool) {
+	m := a.Rows
+	n := b.Rows
+	if a.Cols != m || b.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if c.Rows != m || c.Cols != n {
+		panic("lapack64: bad size of C")
+	}
+	return lapack64.Dtrsyl(trana, tranb, isgn, m, n, a.Data, a.Stride, b.Data, b.Stride, c.Data, c.Stride)
+}
@@ -0,0 +1,124 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dbdsdcer interface {
+	Dbdsdc(uplo blas.Uplo, compq lapack.BDComp, n int, d, e, u []float64, ldu int, vt []float64, ldvt int, work []float64, iwork []int) (ok bool)
+}
+
+func DbdsdcTest(t *testing.T, impl Dbdsdcer) {
+	rnd := rand.New(rand.NewSource(1))
+	bi := blas64.Implementation()
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 25, 26, 30, 60, 101, 200} {
+			for _, ld := range []int{0, 5} {
+				// Matrix types:
+				//  0: random diagonal and off-diagonal,
+				//  1: random with some zero off-diagonal elements, so
+				//     that the matrix splits,
+				//  2: clustered diagonal with small off-diagonal elements.
+				for mtype := 0; mtype < 3; mtype++ {
+					d := make([]float64, n)
+					e := make([]float64, max(0, n-1))
+					for i := range d {
+						d[i] = rnd.NormFloat64()
+						if mtype == 2 {
+							d[i] = float64(i%3 + 1)
+						}
+					}
+					for i := range e {
+						e[i] = rnd.NormFloat64()
+						if mtype == 1 && i%7 == 3 {
+							e[i] = 0
+						}
+						if mtype == 2 {
+							e[i] *= 1e-3
+						}
+					}
+					dCopy := make([]float64, len(d))
+					copy(dCopy, d)
+					eCopy := make([]float64, len(e))
+					copy(eCopy, e)
+
+					ldu := n + ld
+					ldvt := n + 2*ld
+					u := nanSlice(max(0, (n-1)*ldu+n))
+					vt := nanSlice(max(0, (n-1)*ldvt+n))
+					work := nanSlice(3*n*n + 4*n)
+					iwork := make([]int, 8*n)
+
+					errStr := fmt.Sprintf("uplo = %c, n = %v, ld = %v, mtype = %v", uplo, n, ld, mtype)
+
+					ok := impl.Dbdsdc(uplo, lapack.BDCompute, n, d, e, u, max(1, ldu), vt, max(1, ldvt), work, iwork)
+					if !ok {
+						t.Errorf("Unexpected Dbdsdc failure: %s", errStr)
+						continue
+					}
+					if n == 0 {
+						continue
+					}
+
+					// Check that the singular values are non-negative and
+					// sorted in decreasing order.
+					if !sort.IsSorted(sort.Reverse(sort.Float64Slice(d))) {
+						t.Errorf("Singular values not sorted: %s", errStr)
+					}
+					if floats.Min(d) < 0 {
+						t.Errorf("Negative singular value: %s", errStr)
+					}
+
+					U := blas64.General{Rows: n, Cols: n, Stride: ldu, Data: u}
+					VT := blas64.General{Rows: n, Cols: n, Stride: ldvt, Data: vt}
+					if !isOrthogonal(U) {
+						t.Errorf("U is not orthogonal: %s", errStr)
+					}
+					if !isOrthogonal(VT) {
+						t.Errorf("VT is not orthogonal: %s", errStr)
+					}
+
+					// Check that U * S * VT reconstructs the bidiagonal
+					// matrix.
+					bMat := constructBidiagonal(uplo, n, dCopy, eCopy)
+					us := make([]float64, n*n)
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							us[i*n+j] = u[i*ldu+j] * d[j]
+						}
+					}
+					ans := make([]float64, n*n)
+					bi.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n, 1, us, n, vt, ldvt, 0, ans, n)
+					if !equalApprox(n, n, ans, n, bMat.Data, 1e-12*float64(n)) {
+						t.Errorf("Bidiagonal matrix not recovered: %s", errStr)
+					}
+
+					// Check that the singular values are the same when the
+					// singular vectors are not computed.
+					copy(work, nanSlice(len(work)))
+					dAns := make([]float64, len(d))
+					copy(dAns, d)
+					copy(d, dCopy)
+					copy(e, eCopy)
+					impl.Dbdsdc(uplo, lapack.BDNone, n, d, e, nil, 1, nil, 1, work, iwork)
+					if !floats.EqualApprox(d, dAns, 1e-12*float64(n)) {
+						t.Errorf("Singular value mismatch when vectors not computed: %s", errStr)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbconer interface {
+	Dgbtrser
+	Dgbcon(norm lapack.MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+}
+
+func DgbconTest(t *testing.T, impl Dgbconer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 21} {
+			for _, kl := range []int{0, 1, 2, 3, 5, 10} {
+				for _, ku := range []int{0, 1, 2, 3, 5, 10} {
+					for _, extra := range []int{0, 3} {
+						for cas := 0; cas < 3; cas++ {
+							dgbconTest(t, impl, norm, n, kl, ku, extra, rnd)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbconTest(t *testing.T, impl Dgbconer, norm lapack.MatrixNorm, n, kl, ku, extra int, rnd *rand.Rand) {
+	const tol = 1e-10
+
+	name := fmt.Sprintf("norm=%c,n=%v,kl=%v,ku=%v,extra=%v", norm, n, kl, ku, extra)
+
+	ldab := 2*kl + ku + 1 + extra
+	ab := randomBandLU(n, n, kl, ku, ldab, rnd)
+	a := bandLUToGeneral(n, n, kl, ku, ab, ldab)
+	anorm := dlange(norm, n, n, a.Data, max(1, a.Stride))
+
+	ipiv := make([]int, n)
+	ok := impl.Dgbtrf(n, n, kl, ku, ab, ldab, ipiv)
+	if !ok {
+		t.Logf("%v: skipping singular matrix", name)
+		return
+	}
+
+	work := nanSlice(3 * n)
+	iwork := make([]int, n)
+	got := impl.Dgbcon(norm, n, kl, ku, ab, ldab, ipiv, anorm, work, iwork)
+	if n == 0 {
+		if got != 1 {
+			t.Errorf("%v: unexpected rcond for empty matrix: got %v, want 1", name, got)
+		}
+		return
+	}
+
+	// Compute the exact reciprocal condition number from the explicit
+	// inverse of A.
+	ainv := eye(n, n)
+	impl.Dgbtrs(blas.NoTrans, n, kl, ku, n, ab, ldab, ipiv, ainv.Data, ainv.Stride)
+	want := 1 / (anorm * dlange(norm, n, n, ainv.Data, ainv.Stride))
+
+	// The estimate of the norm of A^-1 is a lower bound so the estimate of
+	// rcond must not be smaller than the exact value. It is usually within
+	// a small factor of the exact value.
+	if got < want*(1-tol) || got > 10*want || math.IsNaN(got) {
+		t.Errorf("%v: unexpected rcond: got %v, want %v", name, got, want)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbtrfer interface {
+	Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+}
+
+func DgbtrfTest(t *testing.T, impl Dgbtrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 21} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 21} {
+			for _, kl := range []int{0, 1, 2, 3, 4, 5, 10} {
+				for _, ku := range []int{0, 1, 2, 3, 4, 5, 10} {
+					for _, extra := range []int{0, 3} {
+						dgbtrfTest(t, impl, m, n, kl, ku, extra, rnd)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrfTest(t *testing.T, impl Dgbtrfer, m, n, kl, ku, extra int, rnd *rand.Rand) {
+	const tol = 1e-14
+
+	name := fmt.Sprintf("m=%v,n=%v,kl=%v,ku=%v,extra=%v", m, n, kl, ku, extra)
+
+	// Generate a random band matrix with NaN in the unused elements and
+	// in the fill-in space.
+	ldab := 2*kl + ku + 1 + extra
+	ab := randomBandLU(m, n, kl, ku, ldab, rnd)
+	a := bandLUToGeneral(m, n, kl, ku, ab, ldab)
+
+	mn := min(m, n)
+	ipiv := make([]int, mn)
+	for i := range ipiv {
+		ipiv[i] = -1
+	}
+	ok := impl.Dgbtrf(m, n, kl, ku, ab, ldab, ipiv)
+	if !ok {
+		t.Errorf("%v: unexpected singular matrix", name)
+	}
+	if mn == 0 {
+		return
+	}
+
+	for i, p := range ipiv {
+		if p < i || p > min(i+kl, m-1) {
+			t.Errorf("%v: pivot index %v out of range: %v", name, i, p)
+			return
+		}
+	}
+
+	// Check that the elements outside the factors have not been modified.
+	for i := 0; i < min(m, n+kl); i++ {
+		for k := 0; k < ldab; k++ {
+			j := i - kl + k
+			inFactors := k < 2*kl+ku+1 && 0 <= j && j < n
+			if !inFactors && !math.IsNaN(ab[i*ldab+k]) {
+				t.Errorf("%v: out-of-range write to ab[%v,%v]", name, i, k)
+				return
+			}
+		}
+	}
+
+	// Reconstruct A from its factors and check the residual.
+	got := reconstructBandLU(m, n, kl, ku, ab, ldab, ipiv)
+	anorm := dlange(lapack.MaxColumnSum, m, n, a.Data, a.Stride)
+	for i := range got.Data {
+		got.Data[i] -= a.Data[i]
+	}
+	resid := dlange(lapack.MaxColumnSum, m, n, got.Data, got.Stride)
+	if anorm != 0 {
+		resid /= anorm
+	}
+	if resid > tol*float64(max(m, n)) {
+		t.Errorf("%v: |P*L*U - A|/|A| = %v", name, resid)
+	}
+}
+
+// randomBandLU returns an m×n band matrix with kl sub-diagonals and ku
+// super-diagonals in the band storage used by Dgbtrf with random elements in
+// the band and NaN elsewhere, including the fill-in space.
+func randomBandLU(m, n, kl, ku, ldab int, rnd *rand.Rand) []float64 {
+	ab := nanSlice(max(0, min(m, n+kl)*ldab))
+	for i := 0; i < min(m, n+kl); i++ {
+		for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+			ab[i*ldab+kl+j-i] = rnd.NormFloat64()
+		}
+	}
+	return ab
+}
+
+// bandLUToGeneral returns the m×n matrix stored in ab in the band storage
+// used by Dgbtrf as a general matrix.
+func bandLUToGeneral(m, n, kl, ku int, ab []float64, ldab int) blas64.General {
+	a := zeros(m, n, max(1, n))
+	for i := 0; i < min(m, n+kl); i++ {
+		for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+			a.Data[i*a.Stride+j] = ab[i*ldab+kl+j-i]
+		}
+	}
+	return a
+}
+
+// reconstructBandLU returns P*L*U where the factors are stored in ab and ipiv
+// as computed by Dgbtrf.
+func reconstructBandLU(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) blas64.General {
+	mn := min(m, n)
+	kv := kl + ku
+	a := zeros(m, n, max(1, n))
+	for i := 0; i < mn; i++ {
+		for j := i; j <= min(n-1, i+kv); j++ {
+			a.Data[i*a.Stride+j] = ab[i*ldab+kl+j-i]
+		}
+	}
+	// L is the product of elementary transformations applied in reverse
+	// order, each followed by its row interchange.
+	for j := mn - 1; j >= 0; j-- {
+		km := min(kl, m-j-1)
+		for r := 1; r <= km; r++ {
+			l := ab[(j+r)*ldab+kl-r]
+			for c := 0; c < n; c++ {
+				a.Data[(j+r)*a.Stride+c] += l * a.Data[j*a.Stride+c]
+			}
+		}
+		if p := ipiv[j]; p != j {
+			for c := 0; c < n; c++ {
+				a.Data[j*a.Stride+c], a.Data[p*a.Stride+c] = a.Data[p*a.Stride+c], a.Data[j*a.Stride+c]
+			}
+		}
+	}
+	return a
+}
@@ -0,0 +1,93 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgbtrser interface {
+	Dgbtrfer
+	Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int)
+}
+
+func DgbtrsTest(t *testing.T, impl Dgbtrser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 21} {
+			for _, kl := range []int{0, 1, 2, 3, 5, 10} {
+				for _, ku := range []int{0, 1, 2, 3, 5, 10} {
+					for _, nrhs := range []int{0, 1, 2, 5} {
+						for _, extra := range []int{0, 3} {
+							dgbtrsTest(t, impl, trans, n, kl, ku, nrhs, extra, rnd)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrsTest(t *testing.T, impl Dgbtrser, trans blas.Transpose, n, kl, ku, nrhs, extra int, rnd *rand.Rand) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("trans=%v,n=%v,kl=%v,ku=%v,nrhs=%v,extra=%v", trans, n, kl, ku, nrhs, extra)
+
+	// Generate a random band matrix with a dominant diagonal so that it is
+	// well conditioned.
+	ldab := 2*kl + ku + 1 + extra
+	ab := randomBandLU(n, n, kl, ku, ldab, rnd)
+	for i := 0; i < n; i++ {
+		ab[i*ldab+kl] += float64(kl + ku + 1)
+	}
+	a := bandLUToGeneral(n, n, kl, ku, ab, ldab)
+
+	// Generate the solution X and compute the corresponding right-hand
+	// side B = op(A)*X.
+	ldb := nrhs + extra
+	x := randomGeneral(n, nrhs, ldb, rnd)
+	b := zeros(n, nrhs, ldb)
+	if n > 0 && nrhs > 0 {
+		blas64.Gemm(trans, blas.NoTrans, 1, a, x, 0, b)
+	}
+
+	ipiv := make([]int, n)
+	impl.Dgbtrf(n, n, kl, ku, ab, ldab, ipiv)
+	abCopy := make([]float64, len(ab))
+	copy(abCopy, ab)
+
+	impl.Dgbtrs(trans, n, kl, ku, nrhs, ab, ldab, ipiv, b.Data, max(1, b.Stride))
+
+	for i, v := range ab {
+		if v != abCopy[i] && !(math.IsNaN(v) && math.IsNaN(abCopy[i])) {
+			t.Errorf("%v: unexpected modification of ab", name)
+			break
+		}
+	}
+	if !generalOutsideAllNaN(b) {
+		t.Errorf("%v: out-of-range write to B", name)
+	}
+	if n == 0 || nrhs == 0 {
+		return
+	}
+	xnorm := dlange(lapack.MaxColumnSum, n, nrhs, x.Data, x.Stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			b.Data[i*b.Stride+j] -= x.Data[i*x.Stride+j]
+		}
+	}
+	resid := dlange(lapack.MaxColumnSum, n, nrhs, b.Data, b.Stride) / xnorm
+	if resid > tol {
+		t.Errorf("%v: unexpected solution: |X - X_computed|/|X| = %v", name, resid)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgeeser interface {
+	Dgees(jobvs lapack.SchurComp, n int, a []float64, lda int, wr, wi, vs []float64, ldvs int, work []float64, lwork int) (first int)
+}
+
+func DgeesTest(t *testing.T, impl Dgeeser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 18, 31, 50} {
+		for _, extra := range []int{0, 3} {
+			for _, wl := range []worklen{minimumWork, optimumWork} {
+				for cas := 0; cas < 10; cas++ {
+					a := randomGeneral(n, n, n+extra, rnd)
+					if cas%5 == 4 {
+						// Scale A so that it has to be scaled back
+						// into the safe range.
+						for i := range a.Data {
+							a.Data[i] *= 1e-300
+						}
+					}
+					dgeesTest(t, impl, a, wl)
+				}
+			}
+		}
+	}
+}
+
+func dgeesTest(t *testing.T, impl Dgeeser, a blas64.General, wl worklen) {
+	const tol = 1e-13
+
+	n := a.Rows
+	extra := a.Stride - n
+	var wrWant, wiWant []float64
+	for _, jobvs := range []lapack.SchurComp{lapack.SchurNone, lapack.SchurOrig} {
+		name := fmt.Sprintf("n=%v,extra=%v,jobvs=%c,work=%v", n, extra, jobvs, wl)
+
+		tmat := cloneGeneral(a)
+		wr := nanSlice(n)
+		wi := nanSlice(n)
+		vs := nanGeneral(n, n, n+extra)
+
+		var lwork int
+		switch wl {
+		case minimumWork:
+			lwork = max(1, 3*n)
+		case optimumWork:
+			work := make([]float64, 1)
+			impl.Dgees(jobvs, n, nil, max(1, tmat.Stride), nil, nil, nil, max(1, vs.Stride), work, -1)
+			lwork = int(work[0])
+		}
+		work := nanSlice(max(1, lwork))
+
+		first := impl.Dgees(jobvs, n, tmat.Data, max(1, tmat.Stride), wr, wi, vs.Data, max(1, vs.Stride), work, len(work))
+		if first != 0 {
+			t.Errorf("%v: unexpected failure, first=%v", name, first)
+			continue
+		}
+
+		if !generalOutsideAllNaN(tmat) {
+			t.Errorf("%v: out-of-range write to T", name)
+		}
+		if jobvs == lapack.SchurOrig && !generalOutsideAllNaN(vs) {
+			t.Errorf("%v: out-of-range write to VS", name)
+		}
+
+		// Check that T is upper quasi-triangular in Schur canonical form.
+		for i := 0; i < n; i++ {
+			for j := 0; j < i-1; j++ {
+				if tmat.Data[i*tmat.Stride+j] != 0 {
+					t.Errorf("%v: T is not upper quasi-triangular", name)
+					i = n
+					break
+				}
+			}
+		}
+		if !isSchurCanonicalGeneral(tmat) {
+			t.Errorf("%v: T is not in Schur canonical form", name)
+		}
+
+		// Check that the eigenvalues match the diagonal blocks of T.
+		for i := 0; i < n; {
+			size, _ := schurBlockSize(tmat, i)
+			if size == 1 {
+				if wr[i] != tmat.Data[i*tmat.Stride+i] || wi[i] != 0 {
+					t.Errorf("%v: eigenvalue %v does not match the diagonal of T", name, i)
+				}
+				i++
+				continue
+			}
+			a11, a12, a21, a22 := extract2x2Block(tmat.Data[i*tmat.Stride+i:], tmat.Stride)
+			// Avoid underflow of a12*a21 for matrices with tiny elements.
+			im := math.Sqrt(math.Abs(a12)) * math.Sqrt(math.Abs(a21))
+			if wr[i] != a11 || wr[i+1] != a22 || wi[i] <= 0 || wi[i+1] != -wi[i] ||
+				math.Abs(wi[i]-im) > tol*wi[i] {
+				t.Errorf("%v: eigenvalue pair %v does not match the diagonal block of T", name, i)
+			}
+			i += 2
+		}
+
+		// Check that the eigenvalues do not depend on whether the Schur
+		// vectors are computed.
+		if jobvs == lapack.SchurNone {
+			wrWant = wr
+			wiWant = wi
+		} else {
+			for i := range wr {
+				if wr[i] != wrWant[i] || wi[i] != wiWant[i] {
+					t.Errorf("%v: eigenvalues differ when Schur vectors are computed", name)
+					break
+				}
+			}
+		}
+
+		if jobvs != lapack.SchurOrig || n == 0 {
+			continue
+		}
+		if !isOrthogonal(vs) {
+			t.Errorf("%v: VS is not orthogonal", name)
+		}
+
+		// Check that A = VS*T*VS^T.
+		vst := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, vs, tmat, 0, vst)
+		r := cloneGeneral(a)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, vst, vs, -1, r)
+		anorm := dlange(lapack.MaxAbs, n, n, a.Data, a.Stride)
+		resid := dlange(lapack.MaxAbs, n, n, r.Data, r.Stride)
+		if anorm != 0 {
+			resid /= anorm * float64(n)
+		}
+		if resid > tol {
+			t.Errorf("%v: |A - VS*T*VS^T|/(n*|A|) = %v", name, resid)
+		}
+	}
+}
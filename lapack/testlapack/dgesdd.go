@@ -0,0 +1,207 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgesdder interface {
+	Dgesdd(jobz lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int, iwork []int) (ok bool)
+}
+
+func DgesddTest(t *testing.T, impl Dgesdder) {
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 50, 150, 300} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50, 150} {
+			for _, mtype := range []int{1, 2, 3, 4, 5} {
+				dgesddTest(t, impl, m, n, mtype)
+			}
+		}
+	}
+}
+
+// dgesddTest tests a Dgesdd implementation on an m×n matrix A generated
+// according to mtype as:
+//  - the zero matrix if mtype == 1,
+//  - the identity matrix if mtype == 2,
+//  - a random matrix with a given condition number and singular values if mtype == 3, 4, or 5.
+// For each of the jobs lapack.SVDAll, lapack.SVDStore and lapack.SVDNone it
+// computes the SVD  A = U*Sigma*V^T  and checks that
+//  - U has orthonormal columns, and V^T has orthonormal rows,
+//  - U*Sigma*V^T multiply back to A,
+//  - the singular values are non-negative and sorted in decreasing order,
+//  - the singular values match those computed with lapack.SVDAll.
+func dgesddTest(t *testing.T, impl Dgesdder, m, n, mtype int) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+
+	// Use a fixed leading dimension to reduce testing time.
+	lda := n + 3
+	ldu := m + 5
+	ldvt := n + 7
+
+	minmn := min(m, n)
+
+	a := make([]float64, m*lda)
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+
+	var aNorm float64
+	switch mtype {
+	default:
+		panic("unknown test matrix type")
+	case 1:
+		// Zero matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+		}
+		aNorm = 0
+	case 2:
+		// Identity matrix.
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if i == j {
+					a[i*lda+i] = 1
+				} else {
+					a[i*lda+j] = 0
+				}
+			}
+		}
+		aNorm = 1
+	case 3, 4, 5:
+		// Scaled random matrix.
+		s := make([]float64, minmn)
+		Dlatm1(s, 4, float64(max(1, minmn)), false, 1, rnd)
+		ulp := dlamchP
+		unfl := dlamchS
+		ovfl := 1 / unfl
+		aNorm = 1
+		if mtype == 4 {
+			aNorm = unfl / ulp
+		}
+		if mtype == 5 {
+			aNorm = ovfl * ulp
+		}
+		floats.Scale(aNorm, s)
+		Dlagge(m, n, max(0, m-1), max(0, n-1), s, a, lda, rnd, make([]float64, m+n))
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	iwork := make([]int, 8*minmn)
+	for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+		var sAll []float64
+		for _, jobz := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDNone} {
+			prefix := fmt.Sprintf("m=%v,n=%v,work=%v,mtype=%v,job=%v", m, n, wl, mtype, svdJobString(jobz))
+
+			// Restore A because Dgesdd overwrites it.
+			copy(a, aCopy)
+
+			u := make([]float64, m*ldu)
+			for i := range u {
+				u[i] = rnd.NormFloat64()
+			}
+			vt := make([]float64, n*ldvt)
+			for i := range vt {
+				vt[i] = rnd.NormFloat64()
+			}
+			s := make([]float64, minmn)
+			for i := range s {
+				s[i] = math.NaN()
+			}
+
+			// Determine workspace size based on wl.
+			minwork := 1
+			if minmn > 0 {
+				if jobz == lapack.SVDNone {
+					minwork = 3*minmn + max(max(m, n), 4*minmn)
+				} else {
+					minwork = 3*minmn*minmn + max(max(m, n), 4*minmn*minmn+4*minmn)
+				}
+			}
+			var lwork int
+			switch wl {
+			case minimumWork:
+				lwork = minwork
+			case mediumWork:
+				work := make([]float64, 1)
+				impl.Dgesdd(jobz, m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, iwork)
+				lwork = (int(work[0]) + minwork) / 2
+			case optimumWork:
+				work := make([]float64, 1)
+				impl.Dgesdd(jobz, m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, iwork)
+				lwork = int(work[0])
+			}
+			work := make([]float64, max(1, lwork))
+			for i := range work {
+				work[i] = math.NaN()
+			}
+
+			ok := impl.Dgesdd(jobz, m, n, a, lda, s, u, ldu, vt, ldvt, work, len(work), iwork)
+			if !ok {
+				t.Fatalf("Case %v: unexpected failure in Dgesdd", prefix)
+			}
+			if minmn == 0 {
+				continue
+			}
+
+			// Check that singular values are decreasing.
+			if !sort.IsSorted(sort.Reverse(sort.Float64Slice(s))) {
+				t.Errorf("Case %v: singular values are not decreasing", prefix)
+			}
+			// Check that singular values are non-negative.
+			if floats.Min(s) < 0 {
+				t.Errorf("Case %v: some singular values are negative", prefix)
+			}
+
+			switch jobz {
+			case lapack.SVDAll:
+				sAll = s
+				if !hasOrthonormalColumns(blas64.General{Rows: m, Cols: m, Data: u, Stride: ldu}) {
+					t.Errorf("Case %v: U is not orthogonal", prefix)
+				}
+				if !hasOrthonormalRows(blas64.General{Rows: n, Cols: n, Data: vt, Stride: ldvt}) {
+					t.Errorf("Case %v: VT is not orthogonal", prefix)
+				}
+			case lapack.SVDStore:
+				if !hasOrthonormalColumns(blas64.General{Rows: m, Cols: minmn, Data: u, Stride: ldu}) {
+					t.Errorf("Case %v: columns of U are not orthogonal", prefix)
+				}
+				if !hasOrthonormalRows(blas64.General{Rows: minmn, Cols: n, Data: vt, Stride: ldvt}) {
+					t.Errorf("Case %v: rows of VT are not orthogonal", prefix)
+				}
+			}
+			if jobz != lapack.SVDNone {
+				// Check that U, S, and VT multiply back to A by computing
+				// a residual
+				//  |A - U*S*VT| / (n*aNorm)
+				if resid := svdFullResidual(m, n, aNorm, aCopy, lda, u, ldu, s, vt, ldvt); resid > tol {
+					t.Errorf("Case %v: original matrix not recovered, |A - U*D*VT|=%v", prefix, resid)
+				}
+			}
+			// Check that the singular values match those computed with
+			// lapack.SVDAll relative to the largest singular value.
+			for i := range s {
+				if math.Abs(s[i]-sAll[i]) > tol*sAll[0] {
+					t.Errorf("Case %v: singular values differ from those computed with SVDAll\n%v\n%v", prefix, s, sAll)
+					break
+				}
+			}
+		}
+	}
+}
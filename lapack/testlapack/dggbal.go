@@ -0,0 +1,118 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dggbaler interface {
+	Dggbal(job lapack.BalanceJob, n int, a []float64, lda int, b []float64, ldb int, lscale, rscale, work []float64) (ilo, ihi int)
+	Dggbak(job lapack.BalanceJob, side lapack.EVSide, n, ilo, ihi int, lscale, rscale []float64, m int, v []float64, ldv int)
+}
+
+func DggbalTest(t *testing.T, impl Dggbaler) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, job := range []lapack.BalanceJob{lapack.BalanceNone, lapack.Permute, lapack.Scale, lapack.PermuteScale} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 18, 31, 50} {
+			for _, extra := range []int{0, 5} {
+				for cas := 0; cas < 10; cas++ {
+					var a, b blas64.General
+					if cas%2 == 0 {
+						a = unbalancedSparseGeneral(n, n, n+extra, n*n/4, rnd)
+						b = unbalancedSparseGeneral(n, n, n+extra, n*n/4, rnd)
+					} else {
+						a = randomGeneral(n, n, n+extra, rnd)
+						b = randomGeneral(n, n, n+extra, rnd)
+					}
+					dggbalTest(t, impl, job, a, b)
+				}
+			}
+		}
+	}
+}
+
+func dggbalTest(t *testing.T, impl Dggbaler, job lapack.BalanceJob, a, b blas64.General) {
+	const tol = 1e-13
+
+	n := a.Rows
+	extra := a.Stride - n
+	name := fmt.Sprintf("job=%c,n=%v,extra=%v", job, n, extra)
+
+	aBal := cloneGeneral(a)
+	bBal := cloneGeneral(b)
+	lscale := nanSlice(n)
+	rscale := nanSlice(n)
+	var work []float64
+	if job == lapack.Scale || job == lapack.PermuteScale {
+		work = nanSlice(6 * n)
+	}
+	ilo, ihi := impl.Dggbal(job, n, aBal.Data, max(1, aBal.Stride), bBal.Data, max(1, bBal.Stride), lscale, rscale, work)
+	if n == 0 {
+		if ilo != 0 || ihi != -1 {
+			t.Errorf("%v: unexpected ilo and ihi: got (%v,%v), want (0,-1)", name, ilo, ihi)
+		}
+		return
+	}
+
+	if ilo < 0 || ihi < ilo-1 || n <= ihi {
+		t.Errorf("%v: invalid ilo and ihi: (%v,%v)", name, ilo, ihi)
+		return
+	}
+	if (job == lapack.BalanceNone || job == lapack.Scale) && (ilo != 0 || ihi != n-1) {
+		t.Errorf("%v: unexpected ilo and ihi: got (%v,%v), want (0,%v)", name, ilo, ihi, n-1)
+	}
+	if job == lapack.BalanceNone {
+		if !equalApproxGeneral(aBal, a, 0) || !equalApproxGeneral(bBal, b, 0) {
+			t.Errorf("%v: unexpected modification of A or B", name)
+		}
+	}
+
+	// Check that the isolated eigenvalues are exposed.
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if j >= ilo && i <= ihi {
+				continue
+			}
+			if aBal.Data[i*aBal.Stride+j] != 0 || bBal.Data[i*bBal.Stride+j] != 0 {
+				t.Errorf("%v: unexpected non-zero element at (%v,%v) outside of the balanced block", name, i, j)
+				return
+			}
+		}
+	}
+
+	// Form the transformation matrices
+	//  L = Pl*Dl and R = Pr*Dr
+	// by applying Dggbak to the identity and check that
+	//  L^T*A*R = A_bal and L^T*B*R = B_bal.
+	l := eye(n, n)
+	impl.Dggbak(job, lapack.EVLeft, n, ilo, ihi, lscale, rscale, n, l.Data, l.Stride)
+	r := eye(n, n)
+	impl.Dggbak(job, lapack.EVRight, n, ilo, ihi, lscale, rscale, n, r.Data, r.Stride)
+	for _, m := range []struct {
+		orig, bal blas64.General
+		label     string
+	}{
+		{a, aBal, "A"},
+		{b, bBal, "B"},
+	} {
+		mr := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, m.orig, r, 0, mr)
+		lmr := zeros(n, n, n)
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, l, mr, 0, lmr)
+		scale := math.Max(1, dlange(lapack.MaxAbs, n, n, m.bal.Data, m.bal.Stride))
+		if !equalApproxGeneral(lmr, m.bal, tol*scale) {
+			t.Errorf("%v: L^T*%v*R not equal to balanced %v", name, m.label, m.label)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dggeser interface {
+	Dgges(jobvsl, jobvsr lapack.SchurComp, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int) (first int)
+}
+
+func DggesTest(t *testing.T, impl Dggeser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 18, 31, 50} {
+		for _, extra := range []int{0, 3} {
+			for _, wl := range []worklen{minimumWork, optimumWork} {
+				for cas := 0; cas < 10; cas++ {
+					a := randomGeneral(n, n, n+extra, rnd)
+					b := randomGeneral(n, n, n+extra, rnd)
+					if cas%5 == 4 && n > 1 {
+						// Make B singular.
+						for j := 0; j < n; j++ {
+							b.Data[(n-1)*b.Stride+j] = 0
+						}
+					}
+					dggesTest(t, impl, a, b, wl)
+				}
+			}
+		}
+	}
+}
+
+func dggesTest(t *testing.T, impl Dggeser, a, b blas64.General, wl worklen) {
+	const tol = 1e-13
+
+	n := a.Rows
+	extra := a.Stride - n
+	for _, jobvsl := range []lapack.SchurComp{lapack.SchurNone, lapack.SchurOrig} {
+		for _, jobvsr := range []lapack.SchurComp{lapack.SchurNone, lapack.SchurOrig} {
+			name := fmt.Sprintf("n=%v,extra=%v,jobvsl=%c,jobvsr=%c,work=%v", n, extra, jobvsl, jobvsr, wl)
+
+			s := cloneGeneral(a)
+			p := cloneGeneral(b)
+			alphar := nanSlice(n)
+			alphai := nanSlice(n)
+			beta := nanSlice(n)
+			vsl := nanGeneral(n, n, n+extra)
+			vsr := nanGeneral(n, n, n+extra)
+
+			var lwork int
+			switch wl {
+			case minimumWork:
+				lwork = max(1, 8*n)
+			case optimumWork:
+				work := make([]float64, 1)
+				impl.Dgges(jobvsl, jobvsr, n, nil, max(1, s.Stride), nil, max(1, p.Stride), nil, nil, nil, nil, max(1, vsl.Stride), nil, max(1, vsr.Stride), work, -1)
+				lwork = int(work[0])
+			}
+			work := nanSlice(max(1, lwork))
+
+			first := impl.Dgges(jobvsl, jobvsr, n, s.Data, max(1, s.Stride), p.Data, max(1, p.Stride),
+				alphar, alphai, beta, vsl.Data, max(1, vsl.Stride), vsr.Data, max(1, vsr.Stride), work, len(work))
+			if first != 0 {
+				t.Errorf("%v: unexpected failure, first=%v", name, first)
+				continue
+			}
+
+			// Check that (S,P) is in generalized real Schur form and
+			// that the eigenvalues match its diagonal blocks.
+			if !isGeneralizedSchur(s, p) {
+				t.Errorf("%v: (S,P) not in generalized real Schur form", name)
+			}
+			for j := 0; j < n; j++ {
+				if beta[j] < 0 {
+					t.Errorf("%v: beta[%v] is negative", name, j)
+				}
+				if alphai[j] != 0 {
+					// Complex pair, check that the 2×2 block is
+					// singular for the computed eigenvalue.
+					if j == n-1 || alphai[j] < 0 || alphai[j+1] > 0 {
+						t.Errorf("%v: unexpected ordering of complex eigenvalue %v", name, j)
+						break
+					}
+					a11 := s.Data[j*s.Stride+j]
+					a12 := s.Data[j*s.Stride+j+1]
+					a21 := s.Data[(j+1)*s.Stride+j]
+					a22 := s.Data[(j+1)*s.Stride+j+1]
+					b11 := p.Data[j*p.Stride+j]
+					b22 := p.Data[(j+1)*p.Stride+j+1]
+					lambda := complex(alphar[j], alphai[j]) / complex(beta[j], 0)
+					det := (complex(a11, 0)-lambda*complex(b11, 0))*(complex(a22, 0)-lambda*complex(b22, 0)) - complex(a12*a21, 0)
+					scale := (math.Abs(a11) + math.Abs(a12) + math.Abs(a21) + math.Abs(a22) + cmplx.Abs(lambda)*(b11+b22))
+					if cmplx.Abs(det) > tol*scale*scale {
+						t.Errorf("%v: eigenvalue %v does not match 2×2 block: |det|=%v", name, j, cmplx.Abs(det))
+					}
+					j++
+					continue
+				}
+				// Real eigenvalue.
+				if math.Abs(alphar[j]-s.Data[j*s.Stride+j]) > tol*math.Max(1, math.Abs(alphar[j])) ||
+					math.Abs(beta[j]-p.Data[j*p.Stride+j]) > tol*math.Max(1, beta[j]) {
+					t.Errorf("%v: real eigenvalue %v does not match the diagonal of (S,P)", name, j)
+				}
+			}
+
+			if jobvsl == lapack.SchurOrig && !isOrthogonal(vsl) {
+				t.Errorf("%v: VSL is not orthogonal", name)
+			}
+			if jobvsr == lapack.SchurOrig && !isOrthogonal(vsr) {
+				t.Errorf("%v: VSR is not orthogonal", name)
+			}
+			if jobvsl != lapack.SchurOrig || jobvsr != lapack.SchurOrig || n == 0 {
+				continue
+			}
+
+			// Check that A = VSL*S*VSR^T and B = VSL*P*VSR^T.
+			resid := residualGeneralizedSchur(a, vsl, s, vsr)
+			if resid > tol {
+				t.Errorf("%v: |A - VSL*S*VSR^T|/(n*|A|) = %v", name, resid)
+			}
+			resid = residualGeneralizedSchur(b, vsl, p, vsr)
+			if resid > tol {
+				t.Errorf("%v: |B - VSL*P*VSR^T|/(n*|B|) = %v", name, resid)
+			}
+		}
+	}
+}
+
+// isGeneralizedSchur returns whether the pair (S,P) is in generalized real
+// Schur form, that is, S is upper quasi-triangular with 2×2 diagonal blocks not
+// following each other, P is upper triangular with non-negative diagonal and
+// with the 2×2 blocks corresponding to the 2×2 blocks of S diagonal.
+func isGeneralizedSchur(s, p blas64.General) bool {
+	n := s.Rows
+	if !isUpperTriangular(p) {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		if p.Data[i*p.Stride+i] < 0 {
+			return false
+		}
+		for j := 0; j < i-1; j++ {
+			if s.Data[i*s.Stride+j] != 0 {
+				return false
+			}
+		}
+	}
+	for i := 0; i < n-1; i++ {
+		if s.Data[(i+1)*s.Stride+i] == 0 {
+			continue
+		}
+		if i < n-2 && s.Data[(i+2)*s.Stride+i+1] != 0 {
+			// Two consecutive non-zero subdiagonal elements.
+			return false
+		}
+		if p.Data[i*p.Stride+i+1] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// residualGeneralizedSchur returns |A - Q*S*Z^T|/(n*|A|) in the max norm.
+func residualGeneralizedSchur(a, q, s, z blas64.General) float64 {
+	n := a.Rows
+	qs := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, q, s, 0, qs)
+	r := cloneGeneral(a)
+	blas64.Gemm(blas.NoTrans, blas.Trans, -1, qs, z, 1, r)
+	anorm := math.Max(dlange(lapack.MaxAbs, n, n, a.Data, a.Stride), 1)
+	return dlange(lapack.MaxAbs, n, n, r.Data, r.Stride) / (float64(n) * anorm)
+}
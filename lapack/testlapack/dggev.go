@@ -0,0 +1,277 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dggever interface {
+	Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (first int, ok bool)
+}
+
+func DggevTest(t *testing.T, impl Dggever) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 18, 31, 50} {
+		for _, extra := range []int{0, 3} {
+			for _, wl := range []worklen{minimumWork, optimumWork} {
+				for cas := 0; cas < 10; cas++ {
+					a := randomGeneral(n, n, n+extra, rnd)
+					b := randomGeneral(n, n, n+extra, rnd)
+					if cas%5 == 3 {
+						// Make the first column of A and B
+						// and the last row of A and B zero
+						// except on the diagonal so that
+						// eigenvalues can be isolated by
+						// permutation.
+						for i := 1; i < n; i++ {
+							a.Data[i*a.Stride] = 0
+							b.Data[i*b.Stride] = 0
+						}
+						for j := 0; j < n-1; j++ {
+							a.Data[(n-1)*a.Stride+j] = 0
+							b.Data[(n-1)*b.Stride+j] = 0
+						}
+					}
+					if cas%5 == 4 && n > 1 {
+						// Make B singular so that some
+						// eigenvalues are infinite.
+						for j := 0; j < n; j++ {
+							b.Data[(n-1)*b.Stride+j] = 0
+						}
+					}
+					dggevTest(t, impl, a, b, wl)
+				}
+			}
+		}
+	}
+}
+
+func dggevTest(t *testing.T, impl Dggever, a, b blas64.General, wl worklen) {
+	const tol = 1e-12
+
+	n := a.Rows
+	extra := a.Stride - n
+
+	// Compute the eigenvalues without the eigenvectors for reference.
+	alpharWant := nanSlice(n)
+	alphaiWant := nanSlice(n)
+	betaWant := nanSlice(n)
+	work := make([]float64, 1)
+	impl.Dggev(lapack.LeftEVNone, lapack.RightEVNone, n, nil, max(1, a.Stride), nil, max(1, b.Stride), nil, nil, nil, nil, 1, nil, 1, work, -1)
+	work = nanSlice(max(1, int(work[0])))
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	first, ok := impl.Dggev(lapack.LeftEVNone, lapack.RightEVNone, n, aCopy.Data, aCopy.Stride, bCopy.Data, bCopy.Stride,
+		alpharWant, alphaiWant, betaWant, nil, 1, nil, 1, work, len(work))
+	if !ok || first != 0 {
+		t.Errorf("n=%v: eigenvalue-only computation failed, first=%v", n, first)
+		return
+	}
+
+	for _, jobvl := range []lapack.LeftEVJob{lapack.LeftEVNone, lapack.LeftEVCompute} {
+		for _, jobvr := range []lapack.RightEVJob{lapack.RightEVNone, lapack.RightEVCompute} {
+			name := fmt.Sprintf("n=%v,extra=%v,jobvl=%c,jobvr=%c,work=%v", n, extra, jobvl, jobvr, wl)
+
+			aCopy := cloneGeneral(a)
+			bCopy := cloneGeneral(b)
+			alphar := nanSlice(n)
+			alphai := nanSlice(n)
+			beta := nanSlice(n)
+			vl := nanGeneral(n, n, n+extra)
+			vr := nanGeneral(n, n, n+extra)
+
+			var lwork int
+			switch wl {
+			case minimumWork:
+				lwork = max(1, 8*n)
+			case optimumWork:
+				work := make([]float64, 1)
+				impl.Dggev(jobvl, jobvr, n, nil, max(1, a.Stride), nil, max(1, b.Stride), nil, nil, nil, nil, max(1, vl.Stride), nil, max(1, vr.Stride), work, -1)
+				lwork = int(work[0])
+			}
+			work := nanSlice(max(1, lwork))
+
+			first, ok := impl.Dggev(jobvl, jobvr, n, aCopy.Data, aCopy.Stride, bCopy.Data, bCopy.Stride,
+				alphar, alphai, beta, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, len(work))
+			if !ok || first != 0 {
+				t.Errorf("%v: unexpected failure, first=%v", name, first)
+				continue
+			}
+
+			// The eigenvalues must be the same as those computed
+			// without the eigenvectors.
+			for j := 0; j < n; j++ {
+				if math.Abs(alphar[j]-alpharWant[j]) > tol || math.Abs(alphai[j]-alphaiWant[j]) > tol || math.Abs(beta[j]-betaWant[j]) > tol {
+					t.Errorf("%v: eigenvalue %v does not match: got (%v,%v,%v), want (%v,%v,%v)",
+						name, j, alphar[j], alphai[j], beta[j], alpharWant[j], alphaiWant[j], betaWant[j])
+					break
+				}
+			}
+
+			// Check the structure of the eigenvalues.
+			for j := 0; j < n; j++ {
+				if beta[j] < 0 {
+					t.Errorf("%v: beta[%v] is negative", name, j)
+				}
+				if alphai[j] > 0 {
+					if j == n-1 || alphai[j+1] >= 0 ||
+						math.Abs(alphar[j]*beta[j+1]-alphar[j+1]*beta[j]) > tol*(math.Abs(alphar[j])+math.Abs(beta[j])) ||
+						math.Abs(alphai[j]*beta[j+1]+alphai[j+1]*beta[j]) > tol*(math.Abs(alphai[j])+math.Abs(beta[j])) {
+						t.Errorf("%v: complex eigenvalue %v is not followed by its conjugate", name, j)
+					}
+					j++
+				} else if alphai[j] < 0 {
+					t.Errorf("%v: unexpected negative imaginary part of eigenvalue %v", name, j)
+				}
+			}
+
+			if jobvr == lapack.RightEVCompute {
+				resid := dggevResidual(false, a, b, alphar, alphai, beta, vr)
+				if resid > tol {
+					t.Errorf("%v: residual for right eigenvectors too large: %v", name, resid)
+				}
+				if !isNormalizedEigenvectors(alphai, vr, tol) {
+					t.Errorf("%v: right eigenvectors not normalized", name)
+				}
+			}
+			if jobvl == lapack.LeftEVCompute {
+				resid := dggevResidual(true, a, b, alphar, alphai, beta, vl)
+				if resid > tol {
+					t.Errorf("%v: residual for left eigenvectors too large: %v", name, resid)
+				}
+				if !isNormalizedEigenvectors(alphai, vl, tol) {
+					t.Errorf("%v: left eigenvectors not normalized", name)
+				}
+			}
+		}
+	}
+}
+
+// dggevResidual returns the maximum over all eigenvalues of the relative
+// residual
+//  |beta*A*v - alpha*B*v| / ((|beta|*|A| + |alpha|*|B|) * |v|)
+// for right eigenvectors, or of the corresponding residual
+//  |beta*u^H*A - alpha*u^H*B| / ((|beta|*|A| + |alpha|*|B|) * |u|)
+// for left eigenvectors. The eigenvectors are stored in v as returned by Dggev.
+func dggevResidual(left bool, a, b blas64.General, alphar, alphai, beta []float64, v blas64.General) float64 {
+	n := a.Rows
+	anorm := math.Max(dlange(lapack.MaxAbs, n, n, a.Data, a.Stride), 1e-300)
+	bnorm := math.Max(dlange(lapack.MaxAbs, n, n, b.Data, b.Stride), 1e-300)
+	var resid float64
+	for j := 0; j < n; j++ {
+		x := make([]complex128, n)
+		alpha := complex(alphar[j], alphai[j])
+		switch {
+		case alphai[j] == 0:
+			for i := range x {
+				x[i] = complex(v.Data[i*v.Stride+j], 0)
+			}
+		case alphai[j] > 0:
+			for i := range x {
+				x[i] = complex(v.Data[i*v.Stride+j], v.Data[i*v.Stride+j+1])
+			}
+		default:
+			for i := range x {
+				x[i] = complex(v.Data[i*v.Stride+j-1], -v.Data[i*v.Stride+j])
+			}
+		}
+		if left {
+			// u^H*A - λ*u^H*B = 0 is equivalent to
+			// A^T*conj(u) - λ*B^T*conj(u) = 0.
+			for i := range x {
+				x[i] = cmplx.Conj(x[i])
+			}
+		}
+		var xnorm float64
+		for _, xi := range x {
+			xnorm = math.Max(xnorm, cmplx.Abs(xi))
+		}
+		var r float64
+		for i := 0; i < n; i++ {
+			var ax, bx complex128
+			for k := 0; k < n; k++ {
+				aik := a.Data[i*a.Stride+k]
+				bik := b.Data[i*b.Stride+k]
+				if left {
+					aik = a.Data[k*a.Stride+i]
+					bik = b.Data[k*b.Stride+i]
+				}
+				ax += complex(aik, 0) * x[k]
+				bx += complex(bik, 0) * x[k]
+			}
+			r = math.Max(r, cmplx.Abs(complex(beta[j], 0)*ax-alpha*bx))
+		}
+		denom := (math.Abs(beta[j])*anorm + cmplx.Abs(alpha)*bnorm) * xnorm * float64(n)
+		resid = math.Max(resid, r/denom)
+	}
+	return resid
+}
+
+// isNormalizedEigenvectors returns whether each eigenvector stored in v as
+// returned by Dggev has its largest component with |real part| + |imag. part|
+// equal to 1.
+func isNormalizedEigenvectors(alphai []float64, v blas64.General, tol float64) bool {
+	n := v.Rows
+	for j := 0; j < n; j++ {
+		var vmax float64
+		switch {
+		case alphai[j] == 0:
+			for i := 0; i < n; i++ {
+				vmax = math.Max(vmax, math.Abs(v.Data[i*v.Stride+j]))
+			}
+		case alphai[j] > 0:
+			for i := 0; i < n; i++ {
+				vmax = math.Max(vmax, math.Abs(v.Data[i*v.Stride+j])+math.Abs(v.Data[i*v.Stride+j+1]))
+			}
+		default:
+			continue
+		}
+		if math.Abs(vmax-1) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+// dlange returns the value of the given norm of the m×n matrix A.
+func dlange(norm lapack.MatrixNorm, m, n int, a []float64, lda int) float64 {
+	var value float64
+	switch norm {
+	case lapack.MaxAbs:
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				value = math.Max(value, math.Abs(a[i*lda+j]))
+			}
+		}
+	case lapack.MaxColumnSum:
+		for j := 0; j < n; j++ {
+			var sum float64
+			for i := 0; i < m; i++ {
+				sum += math.Abs(a[i*lda+j])
+			}
+			value = math.Max(value, sum)
+		}
+	case lapack.MaxRowSum:
+		for i := 0; i < m; i++ {
+			var sum float64
+			for j := 0; j < n; j++ {
+				sum += math.Abs(a[i*lda+j])
+			}
+			value = math.Max(value, sum)
+		}
+	default:
+		panic("testlapack: unsupported norm")
+	}
+	return value
+}
@@ -0,0 +1,103 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dgghrder interface {
+	Dgghrd(compq, compz lapack.SchurComp, n, ilo, ihi int, a []float64, lda int, b []float64, ldb int, q []float64, ldq int, z []float64, ldz int)
+}
+
+func DgghrdTest(t *testing.T, impl Dgghrder) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, compq := range []lapack.SchurComp{lapack.SchurNone, lapack.SchurHess, lapack.SchurOrig} {
+		for _, compz := range []lapack.SchurComp{lapack.SchurNone, lapack.SchurHess, lapack.SchurOrig} {
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 18, 31, 50} {
+				for _, extra := range []int{0, 5} {
+					for cas := 0; cas < 5; cas++ {
+						dgghrdTest(t, impl, rnd, compq, compz, n, extra)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgghrdTest(t *testing.T, impl Dgghrder, rnd *rand.Rand, compq, compz lapack.SchurComp, n, extra int) {
+	const tol = 1e-13
+
+	var ilo, ihi int
+	if n > 0 {
+		ihi = rnd.Intn(n)
+		ilo = rnd.Intn(ihi + 1)
+	} else {
+		ihi = -1
+	}
+	name := fmt.Sprintf("compq=%c,compz=%c,n=%v,ilo=%v,ihi=%v,extra=%v", compq, compz, n, ilo, ihi, extra)
+
+	// Generate a general matrix A that is upper triangular outside of the
+	// block [ilo:ihi+1,ilo:ihi+1] and an upper triangular matrix B.
+	a := randomGeneral(n, n, n+extra, rnd)
+	b := randomGeneral(n, n, n+extra, rnd)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			b.Data[i*b.Stride+j] = 0
+			if j < ilo || ihi < i {
+				a.Data[i*a.Stride+j] = 0
+			}
+		}
+	}
+
+	h := cloneGeneral(a)
+	tr := cloneGeneral(b)
+	// For lapack.SchurOrig, Q and Z are initialized to the identity so
+	// that in all cases Q and Z are the transformation matrices Q1 and Z1.
+	q := nanGeneral(n, n, n+extra)
+	if compq == lapack.SchurOrig {
+		q = eye(n, n+extra)
+	}
+	z := nanGeneral(n, n, n+extra)
+	if compz == lapack.SchurOrig {
+		z = eye(n, n+extra)
+	}
+	impl.Dgghrd(compq, compz, n, ilo, ihi, h.Data, max(1, h.Stride), tr.Data, max(1, tr.Stride),
+		q.Data, max(1, q.Stride), z.Data, max(1, z.Stride))
+	if n == 0 {
+		return
+	}
+
+	if !isUpperHessenberg(h) {
+		t.Errorf("%v: H is not upper Hessenberg", name)
+	}
+	if !isUpperTriangular(tr) {
+		t.Errorf("%v: T is not upper triangular", name)
+	}
+	if compq != lapack.SchurNone && !isOrthogonal(q) {
+		t.Errorf("%v: Q is not orthogonal", name)
+	}
+	if compz != lapack.SchurNone && !isOrthogonal(z) {
+		t.Errorf("%v: Z is not orthogonal", name)
+	}
+	if compq == lapack.SchurNone || compz == lapack.SchurNone {
+		return
+	}
+
+	// Check that A = Q*H*Z^T and B = Q*T*Z^T.
+	resid := residualGeneralizedSchur(a, q, h, z)
+	if resid > tol {
+		t.Errorf("%v: |A - Q*H*Z^T|/(n*|A|) = %v", name, resid)
+	}
+	resid = residualGeneralizedSchur(b, q, tr, z)
+	if resid > tol {
+		t.Errorf("%v: |B - Q*T*Z^T|/(n*|B|) = %v", name, resid)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dhgeqzer interface {
+	Dhgeqz(job lapack.SchurJob, compq, compz lapack.SchurComp, n, ilo, ihi int, h []float64, ldh int, t []float64, ldt int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int, work []float64, lwork int) (first int)
+}
+
+func DhgeqzTest(t *testing.T, impl Dhgeqzer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 18, 31, 50} {
+		for _, extra := range []int{0, 5} {
+			for cas := 0; cas < 10; cas++ {
+				h, tr := randomGeneralizedHessenberg(n, n+extra, rnd)
+				if cas%5 == 4 && n > 2 {
+					// Make T singular so that some
+					// eigenvalues are infinite.
+					k := rnd.Intn(n)
+					tr.Data[k*tr.Stride+k] = 0
+				}
+				dhgeqzTest(t, impl, h, tr)
+			}
+		}
+	}
+}
+
+func dhgeqzTest(t *testing.T, impl Dhgeqzer, h, tr blas64.General) {
+	const tol = 1e-13
+
+	n := h.Rows
+	extra := h.Stride - n
+	name := fmt.Sprintf("n=%v,extra=%v", n, extra)
+
+	// Compute the eigenvalues only.
+	hCopy := cloneGeneral(h)
+	tCopy := cloneGeneral(tr)
+	alpharWant := nanSlice(n)
+	alphaiWant := nanSlice(n)
+	betaWant := nanSlice(n)
+	work := nanSlice(max(1, n))
+	first := impl.Dhgeqz(lapack.EigenvaluesOnly, lapack.SchurNone, lapack.SchurNone, n, 0, n-1,
+		hCopy.Data, max(1, hCopy.Stride), tCopy.Data, max(1, tCopy.Stride), alpharWant, alphaiWant, betaWant,
+		nil, 1, nil, 1, work, len(work))
+	if first != 0 {
+		t.Errorf("%v: eigenvalue-only computation failed, first=%v", name, first)
+		return
+	}
+
+	// Compute the generalized Schur form and the Schur vectors.
+	s := cloneGeneral(h)
+	p := cloneGeneral(tr)
+	alphar := nanSlice(n)
+	alphai := nanSlice(n)
+	beta := nanSlice(n)
+	q := nanGeneral(n, n, n+extra)
+	z := nanGeneral(n, n, n+extra)
+	first = impl.Dhgeqz(lapack.EigenvaluesAndSchur, lapack.SchurHess, lapack.SchurHess, n, 0, n-1,
+		s.Data, max(1, s.Stride), p.Data, max(1, p.Stride), alphar, alphai, beta,
+		q.Data, max(1, q.Stride), z.Data, max(1, z.Stride), work, len(work))
+	if first != 0 {
+		t.Errorf("%v: unexpected failure, first=%v", name, first)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// The eigenvalues must not depend on whether the Schur form is
+	// computed. They are compared as points on the projective line.
+	for j := 0; j < n; j++ {
+		got := complex(alphar[j], alphai[j])
+		want := complex(alpharWant[j], alphaiWant[j])
+		scale := math.Max(1, math.Max(math.Hypot(alphar[j], alphai[j])+beta[j], math.Hypot(alpharWant[j], alphaiWant[j])+betaWant[j]))
+		diff := got*complex(betaWant[j], 0) - want*complex(beta[j], 0)
+		if math.Hypot(real(diff), imag(diff)) > 1e-10*scale*scale {
+			t.Errorf("%v: eigenvalue %v does not match: got (%v,%v,%v), want (%v,%v,%v)",
+				name, j, alphar[j], alphai[j], beta[j], alpharWant[j], alphaiWant[j], betaWant[j])
+			break
+		}
+	}
+
+	if !isGeneralizedSchur(s, p) {
+		t.Errorf("%v: (S,P) not in generalized real Schur form", name)
+	}
+	if !isOrthogonal(q) {
+		t.Errorf("%v: Q is not orthogonal", name)
+	}
+	if !isOrthogonal(z) {
+		t.Errorf("%v: Z is not orthogonal", name)
+	}
+	resid := residualGeneralizedSchur(h, q, s, z)
+	if resid > tol {
+		t.Errorf("%v: |H - Q*S*Z^T|/(n*|H|) = %v", name, resid)
+	}
+	resid = residualGeneralizedSchur(tr, q, p, z)
+	if resid > tol {
+		t.Errorf("%v: |T - Q*P*Z^T|/(n*|T|) = %v", name, resid)
+	}
+}
+
+// randomGeneralizedHessenberg returns a random n×n upper Hessenberg matrix H
+// and a random n×n upper triangular matrix T.
+func randomGeneralizedHessenberg(n, stride int, rnd *rand.Rand) (h, t blas64.General) {
+	h = randomHessenberg(n, stride, rnd)
+	t = randomGeneral(n, n, stride, rnd)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			t.Data[i*t.Stride+j] = 0
+		}
+	}
+	return h, t
+}
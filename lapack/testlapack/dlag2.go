@@ -0,0 +1,111 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dlag2er interface {
+	Dlag2(a []float64, lda int, b []float64, ldb int, safmin float64) (scale1, scale2, wr1, wr2, wi float64)
+}
+
+func Dlag2Test(t *testing.T, impl Dlag2er) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, lda := range []int{2, 5} {
+		for _, ldb := range []int{2, 5} {
+			for cas := 0; cas < 1000; cas++ {
+				a := randomGeneral(2, 2, lda, rnd)
+				b := randomGeneral(2, 2, ldb, rnd)
+				b.Data[ldb] = math.NaN()
+				switch cas % 10 {
+				case 7:
+					// Singular B.
+					b.Data[ldb+1] = 0
+				case 8:
+					// Tiny elements on the diagonal of B.
+					b.Data[0] *= 1e-300
+				case 9:
+					// Badly scaled A.
+					a.Data[1] *= 1e150
+					a.Data[lda] *= 1e-150
+				}
+				dlag2Test(t, impl, a.Data, lda, b.Data, ldb)
+			}
+		}
+	}
+}
+
+func dlag2Test(t *testing.T, impl Dlag2er, a []float64, lda int, b []float64, ldb int) {
+	const tol = 1e-12
+
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+	bCopy := make([]float64, len(b))
+	copy(bCopy, b)
+
+	safmin := dlamchS
+	scale1, scale2, wr1, wr2, wi := impl.Dlag2(a, lda, b, ldb, safmin)
+
+	name := fmt.Sprintf("lda=%v,ldb=%v,A=[%v,%v;%v,%v],B=[%v,%v;0,%v]", lda, ldb,
+		a[0], a[1], a[lda], a[lda+1], b[0], b[1], b[ldb+1])
+
+	if !floats.Same(a, aCopy) || !floats.Same(b, bCopy) {
+		t.Errorf("%v: unexpected modification of A or B", name)
+	}
+	if scale1 < 0 || scale2 < 0 {
+		t.Errorf("%v: negative scaling factor", name)
+	}
+	if wi < 0 {
+		t.Errorf("%v: negative imaginary part", name)
+	}
+	if wi != 0 && (wr1 != wr2 || scale1 != scale2) {
+		t.Errorf("%v: complex eigenvalues are not a conjugate pair", name)
+	}
+
+	// Each eigenvalue w must satisfy det(s*A - w*B) = 0 where B is perturbed
+	// as in Dlag2.
+	bnorm := math.Max(math.Abs(b[0]), math.Max(math.Abs(b[1]), math.Abs(b[ldb+1])))
+	rtmin := math.Sqrt(safmin)
+	b11 := b[0]
+	if math.Abs(b11) < rtmin*math.Max(bnorm, rtmin) {
+		b11 = math.Copysign(rtmin*math.Max(bnorm, rtmin), b11)
+	}
+	b22 := b[ldb+1]
+	if math.Abs(b22) < rtmin*math.Max(bnorm, rtmin) {
+		b22 = math.Copysign(rtmin*math.Max(bnorm, rtmin), b22)
+	}
+	for _, ev := range []struct {
+		s float64
+		w complex128
+	}{
+		{scale1, complex(wr1, wi)},
+		{scale2, complex(wr2, -wi)},
+	} {
+		s := complex(ev.s, 0)
+		w := ev.w
+		m11 := s*complex(a[0], 0) - w*complex(b11, 0)
+		m12 := s*complex(a[1], 0) - w*complex(b[1], 0)
+		m21 := s * complex(a[lda], 0)
+		m22 := s*complex(a[lda+1], 0) - w*complex(b22, 0)
+		det := m11*m22 - m12*m21
+		norm := math.Max(cmplx.Abs(m11)+cmplx.Abs(m12), cmplx.Abs(m21)+cmplx.Abs(m22))
+		scale := math.Max(norm, ev.s*math.Max(math.Abs(a[0])+math.Abs(a[1]), math.Abs(a[lda])+math.Abs(a[lda+1])))
+		scale = math.Max(scale, cmplx.Abs(w)*math.Max(math.Abs(b11)+math.Abs(b[1]), math.Abs(b22)))
+		if scale == 0 {
+			continue
+		}
+		if cmplx.Abs(det) > tol*scale*scale {
+			t.Errorf("%v: det(s*A - w*B) = %v is not zero for s=%v, w=%v", name, det, ev.s, w)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dlagtser interface {
+	Dlagtf(n int, a []float64, lambda float64, b, c []float64, tol float64, d []float64, in []int)
+	Dlagts(job, n int, a, b, c, d []float64, in []int, y []float64, tol float64) (tolOut float64, ok bool)
+}
+
+func DlagtsTest(t *testing.T, impl Dlagtser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, job := range []int{-2, -1, 1, 2} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 25} {
+			for cas := 0; cas < 10; cas++ {
+				dlagtsTest(t, impl, rnd, job, n)
+			}
+		}
+	}
+}
+
+func dlagtsTest(t *testing.T, impl Dlagtser, rnd *rand.Rand, job, n int) {
+	const tol = 1e-12
+
+	// Generate a random tridiagonal matrix T and a shift lambda such that
+	// T - lambda*I is diagonally dominant, and hence well-conditioned.
+	a := make([]float64, n)
+	for i := range a {
+		a[i] = 4 + rnd.Float64()
+		if rnd.Intn(2) == 0 {
+			a[i] *= -1
+		}
+	}
+	b := make([]float64, max(0, n-1))
+	c := make([]float64, max(0, n-1))
+	for i := range b {
+		b[i] = 2*rnd.Float64() - 1
+		c[i] = 2*rnd.Float64() - 1
+	}
+	lambda := 2*rnd.Float64() - 1
+
+	// Construct T - lambda*I explicitly.
+	tm := blas64.General{
+		Rows:   n,
+		Cols:   n,
+		Stride: max(1, n),
+		Data:   make([]float64, n*n),
+	}
+	for i := 0; i < n; i++ {
+		tm.Data[i*tm.Stride+i] = a[i] - lambda
+		if i < n-1 {
+			tm.Data[i*tm.Stride+i+1] = b[i]
+			tm.Data[(i+1)*tm.Stride+i] = c[i]
+		}
+	}
+
+	// Generate a random solution x and compute the right-hand side.
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+	y := make([]float64, n)
+	trans := blas.NoTrans
+	if job == 2 || job == -2 {
+		trans = blas.Trans
+	}
+	if n > 0 {
+		blas64.Gemv(trans, 1, tm, blas64.Vector{Inc: 1, Data: x}, 0, blas64.Vector{Inc: 1, Data: y})
+	}
+
+	d := make([]float64, max(0, n-2))
+	in := make([]int, n)
+	impl.Dlagtf(n, a, lambda, b, c, 0, d, in)
+	if n > 0 && in[n-1] != -1 {
+		t.Errorf("job=%v,n=%v: unexpected small pivot at %v", job, n, in[n-1])
+	}
+
+	_, ok := impl.Dlagts(job, n, a, b, c, d, in, y, 0)
+	if !ok {
+		t.Errorf("job=%v,n=%v: unexpected failure", job, n)
+		return
+	}
+	if !floats.EqualApprox(y, x, tol) {
+		t.Errorf("job=%v,n=%v: unexpected solution\nwant %v\ngot  %v", job, n, x, y)
+	}
+}
@@ -54,6 +54,19 @@ func Dlanv2Test(t *testing.T, impl Dlanv2er) {
 			dlanv2Test(t, impl, a, b, c, d)
 		}
 	})
+	t.Run("Tiny", func(t *testing.T) {
+		// Elements of the order of the square root of the underflow
+		// threshold exercise the code path for real eigenvalues that
+		// are detected only after equalizing the diagonal.
+		const scale = 1e-140
+		for i := 0; i < 100; i++ {
+			a := scale * rnd.NormFloat64()
+			b := scale * rnd.NormFloat64()
+			c := scale * rnd.NormFloat64()
+			d := scale * rnd.NormFloat64()
+			dlanv2Test(t, impl, a, b, c, d)
+		}
+	})
 }
 
 func dlanv2Test(t *testing.T, impl Dlanv2er, a, b, c, d float64) {
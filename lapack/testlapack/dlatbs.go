@@ -0,0 +1,98 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Dlatbser interface {
+	Dlatbs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, normin bool, n, kd int, ab []float64, ldab int, x []float64, cnorm []float64) (scale float64)
+}
+
+func DlatbsTest(t *testing.T, impl Dlatbser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, trans := range []blas.Transpose{blas.Trans, blas.NoTrans} {
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 7, 10, 20, 50} {
+				for _, kd := range []int{0, 1, 2, 3, 5, 10, 60} {
+					for _, extra := range []int{0, 3} {
+						imats := []int{7, 11, 12, 13, 14, 15, 16, 17, 18}
+						if n < 6 {
+							imats = append(imats, 19)
+						}
+						for _, imat := range imats {
+							testDlatbs(t, impl, imat, uplo, trans, n, kd, extra, rnd)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func testDlatbs(t *testing.T, impl Dlatbser, imat int, uplo blas.Uplo, trans blas.Transpose, n, kd, extra int, rnd *rand.Rand) {
+	const tol = 1e-14
+
+	// Generate a triangular test matrix and right hand side, and keep
+	// only the kd diagonals closest to the main diagonal.
+	lda := max(1, n)
+	a := nanSlice(n * lda)
+	b := nanSlice(n)
+	work := make([]float64, 3*n)
+	diag := dlattr(imat, uplo, trans, n, a, lda, b, work, rnd)
+	if imat <= 10 {
+		// b has not been generated.
+		dlarnv(b, 3, rnd)
+	}
+	ldab := kd + 1 + extra
+	ab := nanSlice(n * ldab)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			switch {
+			case uplo == blas.Upper && i <= j && j <= i+kd:
+				ab[i*ldab+j-i] = a[i*lda+j]
+			case uplo == blas.Lower && i-kd <= j && j <= i:
+				ab[i*ldab+kd+j-i] = a[i*lda+j]
+			case uplo == blas.Upper && i <= j, uplo == blas.Lower && j <= i:
+				a[i*lda+j] = 0
+			}
+		}
+	}
+
+	for _, normin := range []bool{false, true} {
+		prefix := fmt.Sprintf("Case imat=%v (n=%v,kd=%v,extra=%v,trans=%v,uplo=%v,diag=%v,normin=%v)", imat, n, kd, extra, trans, uplo, diag, normin)
+
+		// For normin == true, cnorm has been computed in the previous
+		// iteration.
+		var cnorm []float64
+		if !normin {
+			cnorm = nanSlice(n)
+		} else {
+			cnorm = work[2*n:]
+		}
+		x := make([]float64, n)
+		copy(x, b)
+		scale := impl.Dlatbs(uplo, trans, diag, normin, n, kd, ab, ldab, x, cnorm)
+		for i, v := range cnorm {
+			if math.IsNaN(v) {
+				t.Errorf("%v: cnorm[%v] not computed (scale=%v)", prefix, i, scale)
+			}
+		}
+		resid, hasNaN := dlatrsResidual(uplo, trans, diag, n, a, lda, scale, cnorm, x, b, work[:n])
+		if hasNaN {
+			t.Errorf("%v: unexpected NaN (scale=%v)", prefix, scale)
+		} else if resid > tol {
+			t.Errorf("%v: residual %v too large (scale=%v)", prefix, resid, scale)
+		}
+		copy(work[2*n:], cnorm)
+	}
+}
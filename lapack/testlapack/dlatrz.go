@@ -0,0 +1,52 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type Dlatrzer interface {
+	Dlatrz(m, n, l int, a []float64, lda int, tau, work []float64)
+}
+
+func DlatrzTest(t *testing.T, impl Dlatrzer) {
+	const tol = 1e-13
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 5, 10, 25} {
+		for _, dn := range []int{0, 1, 2, 5, 13} {
+			n := m + dn
+			for _, extra := range []int{0, 4} {
+				lda := max(1, n+extra)
+				name := fmt.Sprintf("m=%v,n=%v,lda=%v", m, n, lda)
+
+				// Generate a random upper trapezoidal matrix A.
+				a := randomGeneral(m, n, lda, rnd)
+				for i := 0; i < m; i++ {
+					for j := 0; j < i; j++ {
+						a.Data[i*lda+j] = 0
+					}
+				}
+				aCopy := cloneGeneral(a)
+
+				tau := nanSlice(m)
+				work := nanSlice(m)
+				impl.Dlatrz(m, n, n-m, a.Data, lda, tau, work)
+
+				if !generalOutsideAllNaN(a) {
+					t.Errorf("%v: out-of-range write to A", name)
+				}
+				if m == 0 {
+					continue
+				}
+				checkTzrzf(t, name, m, n, a, aCopy, tau, tol)
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dormrzer interface {
+	Dtzrzfer
+	Dormrz(side blas.Side, trans blas.Transpose, m, n, k, l int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int)
+}
+
+func DormrzTest(t *testing.T, impl Dormrzer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, test := range []struct {
+				m, n, k int
+			}{
+				{0, 0, 0},
+				{1, 1, 1},
+				{3, 4, 1},
+				{3, 4, 3},
+				{4, 3, 1},
+				{4, 3, 3},
+				{10, 10, 0},
+				{10, 10, 4},
+				{10, 10, 10},
+				{70, 60, 50},
+				{60, 70, 50},
+			} {
+				for _, extra := range []int{0, 5} {
+					dormrzTest(t, impl, rnd, side, trans, test.m, test.n, test.k, extra)
+				}
+			}
+		}
+	}
+}
+
+func dormrzTest(t *testing.T, impl Dormrzer, rnd *rand.Rand, side blas.Side, trans blas.Transpose, m, n, k, extra int) {
+	const tol = 1e-13
+
+	nq := n
+	nw := m
+	if side == blas.Left {
+		nq = m
+		nw = n
+	}
+	if k > nq {
+		return
+	}
+
+	// Compute the RZ factorization of a random upper trapezoidal k×nq
+	// matrix.
+	lda := max(1, nq+extra)
+	a := randomGeneral(k, nq, lda, rnd)
+	for i := 0; i < k; i++ {
+		for j := 0; j < i; j++ {
+			a.Data[i*lda+j] = 0
+		}
+	}
+	tau := make([]float64, k)
+	work := make([]float64, max(1, k))
+	impl.Dtzrzf(k, nq, a.Data, lda, tau, work, len(work))
+
+	ldc := max(1, n+extra)
+	c := randomGeneral(m, n, ldc, rnd)
+	cCopy := cloneGeneral(c)
+
+	// Compute the expected result with the explicit matrix Z.
+	z := constructZ(k, nq, a.Data, lda, tau)
+	want := zeros(m, n, max(1, n))
+	if m > 0 && n > 0 {
+		switch {
+		case side == blas.Left && trans == blas.NoTrans:
+			blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, z, cCopy, 0, want)
+		case side == blas.Left && trans == blas.Trans:
+			blas64.Gemm(blas.Trans, blas.NoTrans, 1, z, cCopy, 0, want)
+		case side == blas.Right && trans == blas.NoTrans:
+			blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, cCopy, z, 0, want)
+		case side == blas.Right && trans == blas.Trans:
+			blas64.Gemm(blas.NoTrans, blas.Trans, 1, cCopy, z, 0, want)
+		}
+	}
+
+	work = make([]float64, 1)
+	impl.Dormrz(side, trans, m, n, k, nq-k, a.Data, lda, tau, c.Data, ldc, work, -1)
+	lwkopt := int(work[0])
+	for _, lwork := range []int{max(1, nw), lwkopt - 1, lwkopt} {
+		if lwork < max(1, nw) {
+			continue
+		}
+		name := fmt.Sprintf("side=%v,trans=%v,m=%v,n=%v,k=%v,extra=%v,lwork=%v", side, trans, m, n, k, extra, lwork)
+
+		copyGeneral(c, cCopy)
+		work = nanSlice(lwork)
+		impl.Dormrz(side, trans, m, n, k, nq-k, a.Data, lda, tau, c.Data, ldc, work, lwork)
+
+		if !generalOutsideAllNaN(c) {
+			t.Errorf("%v: out-of-range write to C", name)
+		}
+		if !equalApprox(m, n, c.Data, ldc, want.Data, tol*float64(nq)) {
+			t.Errorf("%v: unexpected result", name)
+		}
+	}
+}
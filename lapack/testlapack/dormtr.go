@@ -0,0 +1,135 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dormtrer interface {
+	Dormtr(side blas.Side, uplo blas.Uplo, trans blas.Transpose, m, n int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int)
+	Dorgtrer
+}
+
+func DormtrTest(t *testing.T, impl Dormtrer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				for _, test := range []struct {
+					m, n, lda, ldc int
+				}{
+					{0, 0, 0, 0},
+					{1, 1, 0, 0},
+					{1, 5, 0, 0},
+					{5, 1, 0, 0},
+					{5, 5, 0, 0},
+					{10, 20, 0, 0},
+					{20, 10, 0, 0},
+					{70, 80, 0, 0},
+					{80, 70, 0, 0},
+
+					{5, 5, 10, 7},
+					{10, 20, 30, 25},
+					{70, 80, 90, 85},
+				} {
+					for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+						dormtrTest(t, impl, rnd, side, uplo, trans, test.m, test.n, test.lda, test.ldc, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dormtrTest(t *testing.T, impl Dormtrer, rnd *rand.Rand, side blas.Side, uplo blas.Uplo, trans blas.Transpose, m, n, lda, ldc int, wl worklen) {
+	const tol = 1e-13
+
+	nq := n
+	nw := m
+	if side == blas.Left {
+		nq = m
+		nw = n
+	}
+	if lda == 0 {
+		lda = max(1, nq)
+	}
+	if ldc == 0 {
+		ldc = max(1, n)
+	}
+
+	// Reduce a random symmetric matrix to tridiagonal form.
+	a := make([]float64, max(0, (nq-1)*lda+nq))
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	d := make([]float64, nq)
+	e := make([]float64, max(0, nq-1))
+	tau := make([]float64, max(0, nq-1))
+	work := make([]float64, 1)
+	impl.Dsytrd(uplo, nq, a, lda, d, e, tau, work, -1)
+	work = make([]float64, int(work[0]))
+	impl.Dsytrd(uplo, nq, a, lda, d, e, tau, work, len(work))
+
+	// Generate the orthogonal matrix Q explicitly.
+	q := blas64.General{
+		Rows:   nq,
+		Cols:   nq,
+		Stride: max(1, nq),
+		Data:   make([]float64, nq*nq),
+	}
+	for i := 0; i < nq; i++ {
+		copy(q.Data[i*q.Stride:i*q.Stride+nq], a[i*lda:i*lda+nq])
+	}
+	if nq > 0 {
+		work = make([]float64, 1)
+		impl.Dorgtr(uplo, nq, q.Data, q.Stride, tau, work, -1)
+		work = make([]float64, int(work[0]))
+		impl.Dorgtr(uplo, nq, q.Data, q.Stride, tau, work, len(work))
+	}
+
+	// Compute the product with Q using Dgemm.
+	c := make([]float64, max(0, (m-1)*ldc+n))
+	for i := range c {
+		c[i] = rnd.NormFloat64()
+	}
+	C := blas64.General{Rows: m, Cols: n, Stride: ldc, Data: c}
+	want := blas64.General{Rows: m, Cols: n, Stride: max(1, n), Data: make([]float64, m*n)}
+	if m > 0 && n > 0 {
+		if side == blas.Left {
+			blas64.Gemm(trans, blas.NoTrans, 1, q, C, 0, want)
+		} else {
+			blas64.Gemm(blas.NoTrans, trans, 1, C, q, 0, want)
+		}
+	}
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, nw)
+	case mediumWork:
+		work := make([]float64, 1)
+		impl.Dormtr(side, uplo, trans, m, n, a, lda, tau, c, ldc, work, -1)
+		lwork = (int(work[0]) + max(1, nw)) / 2
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dormtr(side, uplo, trans, m, n, a, lda, tau, c, ldc, work, -1)
+		lwork = int(work[0])
+	}
+	lwork = max(1, lwork)
+	work = nanSlice(lwork)
+
+	impl.Dormtr(side, uplo, trans, m, n, a, lda, tau, c, ldc, work, lwork)
+
+	if !equalApprox(m, n, c, ldc, want.Data, tol) {
+		t.Errorf("side=%c,uplo=%c,trans=%c,m=%v,n=%v,lda=%v,ldc=%v,work=%v: unexpected result",
+			side, uplo, trans, m, n, lda, ldc, wl)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsgesver interface {
+	Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iwork []int) (iter int, ok bool)
+}
+
+func DsgesvTest(t *testing.T, impl Dsgesver) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 5, 10, 50, 100} {
+		for _, nrhs := range []int{0, 1, 3} {
+			for _, extra := range []int{0, 3} {
+				for _, kind := range []mixedKind{mixedWellConditioned, mixedIllConditioned, mixedOverflow, mixedSingular} {
+					dsgesvTest(t, impl, n, nrhs, extra, kind, rnd)
+				}
+			}
+		}
+	}
+}
+
+// mixedKind specifies the kind of matrix used to test the mixed precision
+// solvers and thus the expected path taken by the solver.
+type mixedKind int
+
+const (
+	mixedWellConditioned mixedKind = iota // Refinement converges.
+	mixedIllConditioned                   // Condition number too large.
+	mixedOverflow                         // Elements outside the range of float32.
+	mixedSingular                         // Not invertible or not positive definite.
+)
+
+func (k mixedKind) String() string {
+	switch k {
+	case mixedWellConditioned:
+		return "WellConditioned"
+	case mixedIllConditioned:
+		return "IllConditioned"
+	case mixedOverflow:
+		return "Overflow"
+	case mixedSingular:
+		return "Singular"
+	}
+	return "unknown"
+}
+
+func dsgesvTest(t *testing.T, impl Dsgesver, n, nrhs, extra int, kind mixedKind, rnd *rand.Rand) {
+	if n < 2 && (kind == mixedIllConditioned || kind == mixedSingular) {
+		return
+	}
+	name := fmt.Sprintf("n=%v,nrhs=%v,extra=%v,kind=%v", n, nrhs, extra, kind)
+
+	// Generate a random matrix A with the requested condition number.
+	cond := 10.0
+	if kind == mixedIllConditioned {
+		cond = 1e12
+	}
+	lda := n + extra
+	a := nanGeneral(n, n, lda)
+	if n > 0 {
+		d := make([]float64, n)
+		Dlatm1(d, 3, cond, false, 1, rnd)
+		Dlagge(n, n, n-1, n-1, d, a.Data, a.Stride, rnd, make([]float64, 2*n))
+	}
+	switch kind {
+	case mixedOverflow:
+		for i := range a.Data {
+			a.Data[i] *= 1e300
+		}
+	case mixedSingular:
+		for j := 0; j < n; j++ {
+			a.Data[(n/2)*lda+j] = 0
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	ldb := nrhs + extra
+	b := randomGeneral(n, nrhs, ldb, rnd)
+	bCopy := cloneGeneral(b)
+	x := nanGeneral(n, nrhs, ldb)
+
+	ipiv := make([]int, n)
+	work := make([]float64, n*nrhs)
+	swork := make([]float32, n*(n+nrhs+4))
+	iwork := make([]int, n)
+	iter, ok := impl.Dsgesv(n, nrhs, a.Data, max(1, lda), ipiv, b.Data, max(1, ldb), x.Data, max(1, ldb), work, swork, iwork)
+
+	if !equalApproxGeneral(b, bCopy, 0) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	if !generalOutsideAllNaN(x) {
+		t.Errorf("%v: out-of-range write to X", name)
+	}
+	if n == 0 || nrhs == 0 {
+		if iter != 0 || !ok {
+			t.Errorf("%v: unexpected result for quick return: iter=%v,ok=%v", name, iter, ok)
+		}
+		return
+	}
+
+	switch kind {
+	case mixedWellConditioned:
+		if iter < 0 {
+			t.Errorf("%v: unexpected fall back to double precision: iter=%v", name, iter)
+		}
+	case mixedIllConditioned:
+		// The single precision factorization of an ill-conditioned
+		// matrix may be exactly singular.
+		if iter != -3 && iter != -4 {
+			t.Errorf("%v: unexpected iter: got %v, want -3 or -4", name, iter)
+		}
+	case mixedOverflow:
+		if iter != -2 {
+			t.Errorf("%v: unexpected iter: got %v, want -2", name, iter)
+		}
+	case mixedSingular:
+		if iter != -3 {
+			t.Errorf("%v: unexpected iter: got %v, want -3", name, iter)
+		}
+		if ok {
+			t.Errorf("%v: unexpected success for singular matrix", name)
+		}
+		return
+	}
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if iter >= 0 && !equalApproxGeneral(a, aCopy, 0) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+
+	resid := mixedResidual(aCopy, x, bCopy)
+	if resid > 1e-14 {
+		t.Errorf("%v: unexpected residual: |B - A*X|/(n*|A|*|X|) = %v", name, resid)
+	}
+}
+
+// mixedResidual returns
+//  |B - A*X|_1 / (n * |A|_1 * |X|_1)
+// for the n×n matrix A.
+func mixedResidual(a, x, b blas64.General) float64 {
+	n := a.Rows
+	nrhs := x.Cols
+	r := cloneGeneral(b)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, -1, a, x, 1, r)
+	anorm := dlange(lapack.MaxColumnSum, n, n, a.Data, a.Stride)
+	xnorm := dlange(lapack.MaxColumnSum, n, nrhs, x.Data, x.Stride)
+	rnorm := dlange(lapack.MaxColumnSum, n, nrhs, r.Data, r.Stride)
+	if rnorm == 0 {
+		return 0
+	}
+	return rnorm / anorm / xnorm / float64(n)
+}
@@ -0,0 +1,149 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Dsposver interface {
+	Dsposv(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32, iwork []int) (iter int, ok bool)
+}
+
+func DsposvTest(t *testing.T, impl Dsposver) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 50, 100} {
+			for _, nrhs := range []int{0, 1, 3} {
+				for _, extra := range []int{0, 3} {
+					for _, kind := range []mixedKind{mixedWellConditioned, mixedIllConditioned, mixedOverflow, mixedSingular} {
+						dsposvTest(t, impl, uplo, n, nrhs, extra, kind, rnd)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsposvTest(t *testing.T, impl Dsposver, uplo blas.Uplo, n, nrhs, extra int, kind mixedKind, rnd *rand.Rand) {
+	if n < 2 && kind == mixedIllConditioned {
+		return
+	}
+	name := fmt.Sprintf("uplo=%v,n=%v,nrhs=%v,extra=%v,kind=%v", string(uplo), n, nrhs, extra, kind)
+
+	// Generate a random symmetric positive definite matrix A with the
+	// requested condition number.
+	cond := 10.0
+	if kind == mixedIllConditioned {
+		cond = 1e12
+	}
+	lda := n + extra
+	a := nanGeneral(n, n, lda)
+	if n > 0 {
+		d := make([]float64, n)
+		Dlatm1(d, 3, cond, false, 1, rnd)
+		if kind == mixedSingular {
+			// Make A indefinite.
+			d[n-1] = -d[n-1]
+		}
+		Dlagsy(n, 0, d, a.Data, a.Stride, rnd, make([]float64, 2*n))
+	}
+	if kind == mixedOverflow {
+		for i := range a.Data {
+			a.Data[i] *= 1e300
+		}
+	}
+	// Only the triangle specified by uplo may be referenced.
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+				a.Data[i*lda+j] = math.NaN()
+			}
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	ldb := nrhs + extra
+	b := randomGeneral(n, nrhs, ldb, rnd)
+	bCopy := cloneGeneral(b)
+	x := nanGeneral(n, nrhs, ldb)
+
+	work := make([]float64, n*nrhs)
+	swork := make([]float32, n*(n+nrhs+3))
+	iwork := make([]int, n)
+	iter, ok := impl.Dsposv(uplo, n, nrhs, a.Data, max(1, lda), b.Data, max(1, ldb), x.Data, max(1, ldb), work, swork, iwork)
+
+	if !equalApproxGeneral(b, bCopy, 0) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	if !generalOutsideAllNaN(x) {
+		t.Errorf("%v: out-of-range write to X", name)
+	}
+	if n == 0 || nrhs == 0 {
+		if iter != 0 || !ok {
+			t.Errorf("%v: unexpected result for quick return: iter=%v,ok=%v", name, iter, ok)
+		}
+		return
+	}
+
+	switch kind {
+	case mixedWellConditioned:
+		if iter < 0 {
+			t.Errorf("%v: unexpected fall back to double precision: iter=%v", name, iter)
+		}
+	case mixedIllConditioned:
+		// The single precision factorization of an ill-conditioned
+		// matrix may fail before its condition number is estimated.
+		if iter != -3 && iter != -4 {
+			t.Errorf("%v: unexpected iter: got %v, want -3 or -4", name, iter)
+		}
+	case mixedOverflow:
+		if iter != -2 {
+			t.Errorf("%v: unexpected iter: got %v, want -2", name, iter)
+		}
+	case mixedSingular:
+		if iter != -3 {
+			t.Errorf("%v: unexpected iter: got %v, want -3", name, iter)
+		}
+		if ok {
+			t.Errorf("%v: unexpected success for indefinite matrix", name)
+		}
+		return
+	}
+	if !ok {
+		t.Errorf("%v: unexpected failure", name)
+		return
+	}
+	if iter >= 0 {
+		for i, v := range a.Data {
+			if v != aCopy.Data[i] && !(math.IsNaN(v) && math.IsNaN(aCopy.Data[i])) {
+				t.Errorf("%v: unexpected modification of A", name)
+				break
+			}
+		}
+	}
+
+	// Fill in the other triangle of the original matrix to compute the
+	// residual.
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if uplo == blas.Upper {
+				aCopy.Data[i*lda+j] = aCopy.Data[j*lda+i]
+			} else {
+				aCopy.Data[j*lda+i] = aCopy.Data[i*lda+j]
+			}
+		}
+	}
+	resid := mixedResidual(aCopy, x, bCopy)
+	if resid > 1e-14 {
+		t.Errorf("%v: unexpected residual: |B - A*X|/(n*|A|*|X|) = %v", name, resid)
+	}
+}
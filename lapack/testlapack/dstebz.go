@@ -0,0 +1,190 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstebzer interface {
+	Dstebz(rng lapack.EVRange, byBlock bool, n int, vl, vu float64, il, iu int, abstol float64, d, e, w []float64, iblock, isplit []int, work []float64, iwork []int) (m, nsplit int, ok bool)
+	Dsterfer
+}
+
+func DstebzTest(t *testing.T, impl Dstebzer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 21, 50, 101} {
+		for mtype := 0; mtype < 4; mtype++ {
+			for _, byBlock := range []bool{false, true} {
+				dstebzTest(t, impl, rnd, n, mtype, byBlock)
+			}
+		}
+	}
+}
+
+// tridiagTestMatrix returns the diagonal and off-diagonal elements of an n×n
+// symmetric tridiagonal test matrix generated according to mtype as:
+//  0: random diagonal and off-diagonal,
+//  1: random with some zero off-diagonal elements, so that the matrix splits,
+//  2: unit diagonal with tiny off-diagonal elements, so that the eigenvalues
+//     are tightly clustered,
+//  3: the Wilkinson matrix which has pairs of very close eigenvalues.
+func tridiagTestMatrix(n, mtype int, rnd *rand.Rand) (d, e []float64) {
+	d = make([]float64, n)
+	e = make([]float64, max(0, n-1))
+	switch mtype {
+	default:
+		panic("unknown test matrix type")
+	case 0, 1:
+		for i := range d {
+			d[i] = rnd.NormFloat64()
+		}
+		for i := range e {
+			e[i] = rnd.NormFloat64()
+			if mtype == 1 && i%5 == 2 {
+				e[i] = 0
+			}
+		}
+	case 2:
+		for i := range d {
+			d[i] = 1
+		}
+		for i := range e {
+			e[i] = 1e-8 * rnd.NormFloat64()
+		}
+	case 3:
+		for i := range d {
+			d[i] = math.Abs(float64(i) - float64(n-1)/2)
+		}
+		for i := range e {
+			e[i] = 1
+		}
+	}
+	return d, e
+}
+
+func dstebzTest(t *testing.T, impl Dstebzer, rnd *rand.Rand, n, mtype int, byBlock bool) {
+	d, e := tridiagTestMatrix(n, mtype, rnd)
+
+	// Compute all eigenvalues with Dsterf as a reference.
+	wAll := make([]float64, n)
+	copy(wAll, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+	if !impl.Dsterf(n, wAll, eCopy) {
+		panic("Dsterf failed")
+	}
+	tnorm := 1.0
+	if n > 0 {
+		tnorm = math.Max(math.Abs(wAll[0]), math.Abs(wAll[n-1]))
+	}
+	tol := 1e-13 * math.Max(1, tnorm)
+
+	w := make([]float64, n)
+	iblock := make([]int, n)
+	isplit := make([]int, n)
+	work := nanSlice(4 * n)
+	iwork := make([]int, 3*n)
+
+	check := func(rng lapack.EVRange, vl, vu float64, il, iu int, want []float64) {
+		errStr := fmt.Sprintf("n=%v,mtype=%v,byBlock=%v,rng=%c,vl=%v,vu=%v,il=%v,iu=%v",
+			n, mtype, byBlock, rng, vl, vu, il, iu)
+		for i := range w {
+			w[i] = math.NaN()
+		}
+		m, nsplit, ok := impl.Dstebz(rng, byBlock, n, vl, vu, il, iu, 0, d, e, w, iblock, isplit, work, iwork)
+		if !ok {
+			t.Errorf("%s: unexpected failure", errStr)
+			return
+		}
+		if m != len(want) {
+			t.Errorf("%s: unexpected number of eigenvalues, got %v, want %v", errStr, m, len(want))
+			return
+		}
+		if n > 0 && (nsplit < 1 || isplit[nsplit-1] != n-1) {
+			t.Errorf("%s: unexpected splitting", errStr)
+			return
+		}
+		got := make([]float64, m)
+		copy(got, w[:m])
+		if byBlock {
+			// Check that the eigenvalues are sorted within each block.
+			for i := 1; i < m; i++ {
+				if iblock[i] < iblock[i-1] {
+					t.Errorf("%s: blocks not in increasing order", errStr)
+					return
+				}
+				if iblock[i] == iblock[i-1] && got[i] < got[i-1] {
+					t.Errorf("%s: eigenvalues not sorted within block", errStr)
+					return
+				}
+			}
+			sort.Float64s(got)
+		} else if !sort.Float64sAreSorted(got) {
+			t.Errorf("%s: eigenvalues not sorted", errStr)
+			return
+		}
+		for i := 0; i < m; i++ {
+			if iblock[i] < 0 || nsplit <= iblock[i] {
+				t.Errorf("%s: iblock out of range", errStr)
+				return
+			}
+		}
+		if !floats.EqualApprox(got, want, tol) {
+			t.Errorf("%s: unexpected eigenvalues\ngot  %v\nwant %v", errStr, got, want)
+		}
+	}
+
+	// All eigenvalues.
+	check(lapack.EVRangeAll, 0, 0, 0, 0, wAll)
+
+	if n == 0 {
+		check(lapack.EVRangeIndex, 0, 0, 0, -1, nil)
+		return
+	}
+
+	// Eigenvalues by index.
+	for cas := 0; cas < 5; cas++ {
+		il := rnd.Intn(n)
+		iu := il + rnd.Intn(n-il)
+		check(lapack.EVRangeIndex, 0, 0, il, iu, wAll[il:iu+1])
+	}
+	check(lapack.EVRangeIndex, 0, 0, 0, 0, wAll[:1])
+	check(lapack.EVRangeIndex, 0, 0, n-1, n-1, wAll[n-1:])
+
+	// Eigenvalues in a half-open interval. The interval ends are placed
+	// between well-separated eigenvalues so that the expected result is
+	// unambiguous.
+	gap := func(i int) float64 {
+		// Returns a point between wAll[i-1] and wAll[i].
+		switch {
+		case i == 0:
+			return wAll[0] - 1
+		case i == n:
+			return wAll[n-1] + 1
+		}
+		return (wAll[i-1] + wAll[i]) / 2
+	}
+	separated := func(i int) bool {
+		return i == 0 || i == n || wAll[i]-wAll[i-1] > 1e-6*math.Max(1, tnorm)
+	}
+	for cas := 0; cas < 5; cas++ {
+		lo := rnd.Intn(n + 1)
+		hi := lo + rnd.Intn(n+1-lo)
+		if !separated(lo) || !separated(hi) || lo == hi {
+			continue
+		}
+		check(lapack.EVRangeValue, gap(lo), gap(hi), 0, 0, wAll[lo:hi])
+	}
+	check(lapack.EVRangeValue, wAll[n-1]+1, wAll[n-1]+2, 0, 0, nil)
+}
@@ -0,0 +1,156 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstedcer interface {
+	Dstedc(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+	Dorgtrer
+}
+
+func DstedcTest(t *testing.T, impl Dstedcer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, compz := range []lapack.EVComp{lapack.EVOrig, lapack.EVTridiag} {
+		for _, n := range []int{0, 1, 2, 5, 10, 25, 26, 27, 50, 100, 201} {
+			for _, ld := range []int{0, 5} {
+				// Matrix types:
+				//  0: random diagonal and off-diagonal,
+				//  1: constant diagonal with random off-diagonal,
+				//  2: random with some zero off-diagonal elements, so
+				//     that the matrix splits.
+				for mtype := 0; mtype < 3; mtype++ {
+					dstedcTest(t, impl, rnd, compz, n, n+ld, mtype)
+				}
+			}
+		}
+	}
+}
+
+func dstedcTest(t *testing.T, impl Dstedcer, rnd *rand.Rand, compz lapack.EVComp, n, lda, mtype int) {
+	errStr := fmt.Sprintf("compz = %c, n = %v, lda = %v, mtype = %v", compz, n, lda, mtype)
+	lda = max(1, lda)
+
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = rnd.NormFloat64()
+		if mtype == 1 {
+			d[i] = 1
+		}
+	}
+	e := make([]float64, max(0, n-1))
+	for i := range e {
+		e[i] = rnd.NormFloat64()
+		if mtype == 2 && i%7 == 0 {
+			e[i] = 0
+		}
+	}
+	a := make([]float64, max(0, (n-1)*lda+n))
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	if compz == lapack.EVOrig {
+		// Reduce A to symmetric tridiagonal form and compute the
+		// orthogonal matrix Q.
+		uplo := blas.Upper
+		tau := make([]float64, n)
+		work := make([]float64, 1)
+		impl.Dsytrd(uplo, n, a, lda, d, e, tau, work, -1)
+		work = make([]float64, int(work[0]))
+		impl.Dsytrd(uplo, n, a, lda, d, e, tau, work, len(work))
+		impl.Dorgtr(uplo, n, a, lda, tau, work, len(work))
+	}
+	dCopy := make([]float64, len(d))
+	copy(dCopy, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+
+	work := make([]float64, 1)
+	iwork := make([]int, 1)
+	impl.Dstedc(compz, n, d, e, a, lda, work, -1, iwork, -1)
+	work = nanSlice(int(work[0]))
+	iwork = make([]int, iwork[0])
+
+	ok := impl.Dstedc(compz, n, d, e, a, lda, work, len(work), iwork, len(iwork))
+	if !ok {
+		t.Errorf("Unexpected Dstedc failure: %s", errStr)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if !sort.Float64sAreSorted(d) {
+		t.Errorf("Eigenvalues not sorted: %s", errStr)
+	}
+
+	var truth blas64.General
+	if compz == lapack.EVOrig {
+		truth = blas64.General{
+			Rows:   n,
+			Cols:   n,
+			Stride: n,
+			Data:   make([]float64, n*n),
+		}
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				v := aCopy[i*lda+j]
+				truth.Data[i*truth.Stride+j] = v
+				truth.Data[j*truth.Stride+i] = v
+			}
+		}
+	} else {
+		truth = blas64.General{
+			Rows:   n,
+			Cols:   n,
+			Stride: n,
+			Data:   make([]float64, n*n),
+		}
+		for i := 0; i < n; i++ {
+			truth.Data[i*truth.Stride+i] = dCopy[i]
+			if i != n-1 {
+				truth.Data[(i+1)*truth.Stride+i] = eCopy[i]
+				truth.Data[i*truth.Stride+i+1] = eCopy[i]
+			}
+		}
+	}
+	V := blas64.General{
+		Rows:   n,
+		Cols:   n,
+		Stride: lda,
+		Data:   a,
+	}
+	if !isOrthogonal(V) {
+		t.Errorf("Eigenvectors not orthogonal: %s", errStr)
+	}
+	if !eigenDecompCorrect(d, truth, V) {
+		t.Errorf("Eigen reconstruction mismatch: %s", errStr)
+	}
+
+	// Compare eigenvalues when not computing eigenvectors.
+	dAns := make([]float64, len(d))
+	copy(dAns, d)
+	copy(d, dCopy)
+	copy(e, eCopy)
+	work = nanSlice(max(1, 2*(n-1)))
+	impl.Dstedc(lapack.EVCompNone, n, d, e, nil, 1, work, len(work), iwork, 1)
+	if !floats.EqualApprox(d, dAns, 1e-12) {
+		t.Errorf("Eigenvalue mismatch when eigenvectors not computed: %s", errStr)
+	}
+}
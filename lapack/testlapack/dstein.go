@@ -0,0 +1,96 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsteiner interface {
+	Dstein(n int, d, e []float64, m int, w []float64, iblock, isplit []int, z []float64, ldz int, work []float64, iwork, ifail []int) (nfail int)
+	Dstebzer
+}
+
+func DsteinTest(t *testing.T, impl Dsteiner) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 21, 50, 101} {
+		for mtype := 0; mtype < 4; mtype++ {
+			for _, ldz := range []int{0, 5} {
+				dsteinTest(t, impl, rnd, n, mtype, ldz)
+			}
+		}
+	}
+}
+
+func dsteinTest(t *testing.T, impl Dsteiner, rnd *rand.Rand, n, mtype, extra int) {
+	const tol = 1e-12
+
+	d, e := tridiagTestMatrix(n, mtype, rnd)
+
+	// Compute a subset of eigenvalues with Dstebz ordered by block.
+	il, iu := 0, n-1
+	if n > 2 {
+		il = rnd.Intn(n / 2)
+		iu = n/2 + rnd.Intn(n-n/2)
+	}
+	w := make([]float64, n)
+	iblock := make([]int, n)
+	isplit := make([]int, n)
+	m, _, ok := impl.Dstebz(lapack.EVRangeIndex, true, n, 0, 0, il, iu, 2*dlamchS, d, e, w, iblock, isplit, nanSlice(4*n), make([]int, 3*n))
+	if !ok {
+		t.Errorf("n=%v,mtype=%v: unexpected Dstebz failure", n, mtype)
+		return
+	}
+
+	ldz := max(1, m+extra)
+	z := nanSlice(n * ldz)
+	ifail := make([]int, m)
+	nfail := impl.Dstein(n, d, e, m, w, iblock, isplit, z, ldz, nanSlice(5*n), make([]int, n), ifail)
+
+	errStr := fmt.Sprintf("n=%v,mtype=%v,m=%v,ldz=%v", n, mtype, m, ldz)
+	if nfail != 0 {
+		t.Errorf("%s: %v eigenvectors failed to converge: %v", errStr, nfail, ifail[:nfail])
+	}
+	if m == 0 {
+		return
+	}
+
+	Z := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+	if !hasOrthonormalColumns(Z) {
+		t.Errorf("%s: eigenvectors not orthonormal", errStr)
+	}
+
+	// Check that T*z = w*z for each eigenpair.
+	tnorm := 0.0
+	for i := 0; i < n; i++ {
+		tnorm = math.Max(tnorm, math.Abs(d[i]))
+		if i < n-1 {
+			tnorm = math.Max(tnorm, math.Abs(e[i]))
+		}
+	}
+	var resid float64
+	for j := 0; j < m; j++ {
+		for i := 0; i < n; i++ {
+			r := (d[i] - w[j]) * z[i*ldz+j]
+			if i > 0 {
+				r += e[i-1] * z[(i-1)*ldz+j]
+			}
+			if i < n-1 {
+				r += e[i] * z[(i+1)*ldz+j]
+			}
+			resid = math.Max(resid, math.Abs(r))
+		}
+	}
+	if resid > tol*math.Max(1, tnorm)*float64(n) {
+		t.Errorf("%s: residual |T*z - w*z| too large: %v", errStr, resid)
+	}
+}
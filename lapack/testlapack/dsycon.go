@@ -0,0 +1,78 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyconer interface {
+	Dsytrser
+	Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+}
+
+func DsyconTest(t *testing.T, impl Dsyconer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 50} {
+			for _, lda := range []int{n, n + 5} {
+				for _, zeroDiag := range []bool{false, true} {
+					if zeroDiag && n == 1 {
+						// A 1×1 matrix with zero diagonal is singular.
+						continue
+					}
+					dsyconTest(t, impl, uplo, n, lda, zeroDiag, rnd)
+				}
+			}
+		}
+	}
+}
+
+func dsyconTest(t *testing.T, impl Dsyconer, uplo blas.Uplo, n, lda int, zeroDiag bool, rnd *rand.Rand) {
+	const tol = 1e-10
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,zeroDiag=%v", string(uplo), n, lda, zeroDiag)
+
+	lda = max(1, lda)
+	a := randomSymmetricIndefinite(n, lda, zeroDiag, rnd)
+	anorm := dlange(lapack.MaxColumnSum, n, n, a.Data, lda)
+
+	ipiv := make([]int, n)
+	ok := impl.Dsytrf(uplo, n, a.Data, lda, ipiv)
+	if !ok {
+		t.Errorf("%v: unexpected singular matrix", name)
+		return
+	}
+
+	work := nanSlice(2 * n)
+	iwork := make([]int, n)
+	got := impl.Dsycon(uplo, n, a.Data, lda, ipiv, anorm, work, iwork)
+	if n == 0 {
+		if got != 1 {
+			t.Errorf("%v: unexpected rcond for empty matrix: got %v, want 1", name, got)
+		}
+		return
+	}
+
+	// Compute the exact reciprocal condition number from the explicit
+	// inverse of A.
+	ainv := eye(n, n)
+	impl.Dsytrs(uplo, n, n, a.Data, lda, ipiv, ainv.Data, ainv.Stride)
+	want := 1 / (anorm * dlange(lapack.MaxColumnSum, n, n, ainv.Data, ainv.Stride))
+
+	// The estimate of the norm of A^-1 is a lower bound so the estimate of
+	// rcond must not be smaller than the exact value. It is usually within
+	// a small factor of the exact value.
+	if got < want*(1-tol) || got > 10*want || math.IsNaN(got) {
+		t.Errorf("%v: unexpected rcond: got %v, want %v", name, got, want)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevder interface {
+	Dsyevd(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool)
+}
+
+func DsyevdTest(t *testing.T, impl Dsyevder) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Lower, blas.Upper} {
+		for _, test := range []struct {
+			n, lda int
+		}{
+			{0, 0},
+			{1, 0},
+			{2, 0},
+			{5, 0},
+			{10, 0},
+			{26, 0},
+			{100, 0},
+
+			{1, 5},
+			{2, 5},
+			{5, 10},
+			{10, 20},
+			{26, 30},
+			{100, 110},
+		} {
+			for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+				n := test.n
+				lda := test.lda
+				if lda == 0 {
+					lda = max(1, n)
+				}
+				a := make([]float64, n*lda)
+				for i := range a {
+					a[i] = rnd.NormFloat64()
+				}
+				aCopy := make([]float64, len(a))
+				copy(aCopy, a)
+				w := make([]float64, n)
+				for i := range w {
+					w[i] = rnd.NormFloat64()
+				}
+
+				var lwmin, liwmin int
+				switch {
+				case n <= 1:
+					lwmin, liwmin = 1, 1
+				default:
+					lwmin, liwmin = 1+6*n+2*n*n, 3+5*n
+				}
+				work := make([]float64, 1)
+				iwork := make([]int, 1)
+				impl.Dsyevd(lapack.EVCompute, uplo, n, a, lda, w, work, -1, iwork, -1)
+				var lwork int
+				switch wl {
+				case minimumWork:
+					lwork = lwmin
+				case mediumWork:
+					lwork = (int(work[0]) + lwmin) / 2
+				case optimumWork:
+					lwork = int(work[0])
+				}
+				if iwork[0] != liwmin {
+					t.Errorf("Unexpected liwork: got %v, want %v", iwork[0], liwmin)
+				}
+				work = nanSlice(lwork)
+				iwork = make([]int, liwmin)
+
+				errStr := fmt.Sprintf("uplo = %c, n = %v, lda = %v, work = %v", uplo, n, lda, wl)
+
+				ok := impl.Dsyevd(lapack.EVCompute, uplo, n, a, lda, w, work, len(work), iwork, len(iwork))
+				if !ok {
+					t.Errorf("Unexpected Dsyevd failure: %s", errStr)
+					continue
+				}
+
+				// Check that the decomposition is correct.
+				orig := blas64.General{
+					Rows:   n,
+					Cols:   n,
+					Stride: max(1, n),
+					Data:   make([]float64, n*n),
+				}
+				if uplo == blas.Upper {
+					for i := 0; i < n; i++ {
+						for j := i; j < n; j++ {
+							v := aCopy[i*lda+j]
+							orig.Data[i*orig.Stride+j] = v
+							orig.Data[j*orig.Stride+i] = v
+						}
+					}
+				} else {
+					for i := 0; i < n; i++ {
+						for j := 0; j <= i; j++ {
+							v := aCopy[i*lda+j]
+							orig.Data[i*orig.Stride+j] = v
+							orig.Data[j*orig.Stride+i] = v
+						}
+					}
+				}
+
+				V := blas64.General{
+					Rows:   n,
+					Cols:   n,
+					Stride: lda,
+					Data:   a,
+				}
+				if !isOrthogonal(V) {
+					t.Errorf("Eigenvectors not orthogonal: %s", errStr)
+				}
+				if !eigenDecompCorrect(w, orig, V) {
+					t.Errorf("Decomposition mismatch: %s", errStr)
+				}
+
+				// Check that the eigenvalues are the same when the
+				// eigenvectors are not computed.
+				wAns := make([]float64, len(w))
+				copy(wAns, w)
+				copy(a, aCopy)
+				for i := range w {
+					w[i] = rnd.Float64()
+				}
+				work = nanSlice(max(1, 2*n+1))
+				impl.Dsyevd(lapack.EVNone, uplo, n, a, lda, w, work, len(work), iwork, 1)
+				if !floats.EqualApprox(w, wAns, 1e-8) {
+					t.Errorf("Eigenvalue mismatch when vectors not computed: %s", errStr)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevrer interface {
+	Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool)
+	Dsyever
+}
+
+func DsyevrTest(t *testing.T, impl Dsyevrer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 26, 50, 100} {
+			for _, ld := range []int{0, 5} {
+				for _, wl := range []worklen{minimumWork, optimumWork} {
+					dsyevrTest(t, impl, rnd, uplo, n, ld, wl)
+				}
+			}
+		}
+	}
+}
+
+func dsyevrTest(t *testing.T, impl Dsyevrer, rnd *rand.Rand, uplo blas.Uplo, n, ld int, wl worklen) {
+	const tol = 1e-12
+
+	lda := max(1, n+ld)
+	a := make([]float64, n*lda)
+	for i := range a {
+		a[i] = rnd.NormFloat64()
+	}
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+
+	// Construct the full symmetric matrix for checking the results.
+	orig := blas64.General{
+		Rows:   n,
+		Cols:   n,
+		Stride: max(1, n),
+		Data:   make([]float64, n*n),
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := aCopy[i*lda+j]
+			if uplo == blas.Lower {
+				v = aCopy[j*lda+i]
+			}
+			orig.Data[i*orig.Stride+j] = v
+			orig.Data[j*orig.Stride+i] = v
+		}
+	}
+
+	// Compute all eigenvalues with Dsyev as a reference.
+	wAll := make([]float64, n)
+	work := make([]float64, 1)
+	impl.Dsyev(lapack.EVNone, uplo, n, a, lda, wAll, work, -1)
+	work = make([]float64, max(1, int(work[0])))
+	impl.Dsyev(lapack.EVNone, uplo, n, a, lda, wAll, work, len(work))
+	anorm := 1.0
+	if n > 0 {
+		anorm = math.Max(1, math.Max(math.Abs(wAll[0]), math.Abs(wAll[n-1])))
+	}
+
+	type rangeCase struct {
+		rng    lapack.EVRange
+		vl, vu float64
+		il, iu int
+		want   []float64
+	}
+	cases := []rangeCase{
+		{rng: lapack.EVRangeAll, want: wAll},
+	}
+	if n == 0 {
+		cases = append(cases, rangeCase{rng: lapack.EVRangeIndex, il: 0, iu: -1})
+	} else {
+		cases = append(cases,
+			rangeCase{rng: lapack.EVRangeIndex, il: 0, iu: n - 1, want: wAll},
+			rangeCase{rng: lapack.EVRangeIndex, il: 0, iu: 0, want: wAll[:1]},
+			rangeCase{rng: lapack.EVRangeIndex, il: n - 1, iu: n - 1, want: wAll[n-1:]},
+			rangeCase{rng: lapack.EVRangeIndex, il: n / 3, iu: n / 2, want: wAll[n/3 : n/2+1]},
+			rangeCase{rng: lapack.EVRangeValue, vl: wAll[0] - 1, vu: wAll[n-1] + 1, want: wAll},
+			rangeCase{rng: lapack.EVRangeValue, vl: wAll[n-1] + 1, vu: wAll[n-1] + 2},
+		)
+		if n > 2 {
+			// Place the interval ends between eigenvalues.
+			lo, hi := max(1, n/4), (3*n)/4
+			cases = append(cases, rangeCase{
+				rng:  lapack.EVRangeValue,
+				vl:   (wAll[lo-1] + wAll[lo]) / 2,
+				vu:   (wAll[hi-1] + wAll[hi]) / 2,
+				want: wAll[lo:hi],
+			})
+		}
+	}
+
+	for _, jobz := range []lapack.EVJob{lapack.EVCompute, lapack.EVNone} {
+		wantz := jobz == lapack.EVCompute
+		for _, test := range cases {
+			errStr := fmt.Sprintf("uplo=%c,n=%v,lda=%v,work=%v,jobz=%c,rng=%c,vl=%v,vu=%v,il=%v,iu=%v",
+				uplo, n, lda, wl, jobz, test.rng, test.vl, test.vu, test.il, test.iu)
+
+			alleig := test.rng == lapack.EVRangeAll || (test.rng == lapack.EVRangeIndex && test.il == 0 && test.iu == n-1)
+			ncol := n
+			if test.rng == lapack.EVRangeIndex {
+				ncol = test.iu - test.il + 1
+			}
+			ldz := max(1, ncol+ld)
+			z := nanSlice(n * ldz)
+			w := nanSlice(n)
+
+			var lwmin, liwmin int
+			switch {
+			case n <= 1:
+				lwmin, liwmin = 1, 1
+			case wantz && alleig:
+				lwmin, liwmin = 1+7*n+n*n, 3+5*n
+			default:
+				lwmin, liwmin = 8*n, 5*n
+			}
+			work := make([]float64, 1)
+			iwork := make([]int, 1)
+			impl.Dsyevr(jobz, test.rng, uplo, n, a, lda, test.vl, test.vu, test.il, test.iu, 0, w, z, ldz, work, -1, iwork, -1)
+			if iwork[0] != liwmin {
+				t.Errorf("%s: unexpected liwork: got %v, want %v", errStr, iwork[0], liwmin)
+			}
+			lwork := lwmin
+			if wl == optimumWork {
+				lwork = int(work[0])
+			}
+			work = nanSlice(lwork)
+			iwork = make([]int, liwmin)
+
+			copy(a, aCopy)
+			m, ok := impl.Dsyevr(jobz, test.rng, uplo, n, a, lda, test.vl, test.vu, test.il, test.iu, 0, w, z, ldz, work, len(work), iwork, len(iwork))
+			if !ok {
+				t.Errorf("%s: unexpected failure", errStr)
+				continue
+			}
+			if m != len(test.want) {
+				t.Errorf("%s: unexpected number of eigenvalues: got %v, want %v", errStr, m, len(test.want))
+				continue
+			}
+			if !floats.EqualApprox(w[:m], test.want, tol*anorm) {
+				t.Errorf("%s: unexpected eigenvalues\ngot  %v\nwant %v", errStr, w[:m], test.want)
+			}
+			if !wantz || m == 0 {
+				continue
+			}
+
+			Z := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+			if !hasOrthonormalColumns(Z) {
+				t.Errorf("%s: eigenvectors not orthonormal", errStr)
+			}
+			// Check that A*Z = Z*diag(w).
+			az := blas64.General{Rows: n, Cols: m, Stride: m, Data: make([]float64, n*m)}
+			blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, orig, Z, 0, az)
+			var resid float64
+			for i := 0; i < n; i++ {
+				for j := 0; j < m; j++ {
+					resid = math.Max(resid, math.Abs(az.Data[i*m+j]-w[j]*z[i*ldz+j]))
+				}
+			}
+			if resid > tol*anorm*float64(n) {
+				t.Errorf("%s: residual |A*Z - Z*W| too large: %v", errStr, resid)
+			}
+		}
+	}
+}
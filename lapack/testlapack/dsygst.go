@@ -0,0 +1,108 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsygster interface {
+	Dsygst(itype lapack.GenEVType, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int)
+	Dpotrfer
+}
+
+func DsygstTest(t *testing.T, impl Dsygster) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, itype := range []lapack.GenEVType{lapack.GenEVAxBx, lapack.GenEVABx, lapack.GenEVBAx} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50, 64, 65, 100, 150} {
+				for _, extra := range []int{0, 7} {
+					dsygstTest(t, impl, rnd, itype, uplo, n, extra)
+				}
+			}
+		}
+	}
+}
+
+func dsygstTest(t *testing.T, impl Dsygster, rnd *rand.Rand, itype lapack.GenEVType, uplo blas.Uplo, n, extra int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("itype=%v,uplo=%c,n=%v,extra=%v", itype, uplo, n, extra)
+
+	a := randomSymmetric(n, max(1, n+extra), rnd)
+	b := randomSPD(n, max(1, n+extra), rnd)
+	ok := impl.Dpotrf(uplo, n, b.Data, b.Stride)
+	if !ok {
+		t.Fatalf("%v: bad test matrix, B not positive definite", name)
+	}
+
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	impl.Dsygst(itype, uplo, n, a.Data, a.Stride, b.Data, b.Stride)
+	if n == 0 {
+		return
+	}
+
+	if !equalApproxGeneral(b, bCopy, 0) {
+		t.Errorf("%v: B modified", name)
+	}
+
+	// Compute the reference result explicitly from the full matrix A and
+	// the triangular Cholesky factor of B.
+	want := cloneGeneral(aCopy)
+	tri := blas64.Triangular{
+		Uplo:   uplo,
+		Diag:   blas.NonUnit,
+		N:      n,
+		Data:   bCopy.Data,
+		Stride: bCopy.Stride,
+	}
+	switch {
+	case itype == lapack.GenEVAxBx && uplo == blas.Upper:
+		// inv(U^T)*A*inv(U).
+		blas64.Trsm(blas.Left, blas.Trans, 1, tri, want)
+		blas64.Trsm(blas.Right, blas.NoTrans, 1, tri, want)
+	case itype == lapack.GenEVAxBx && uplo == blas.Lower:
+		// inv(L)*A*inv(L^T).
+		blas64.Trsm(blas.Left, blas.NoTrans, 1, tri, want)
+		blas64.Trsm(blas.Right, blas.Trans, 1, tri, want)
+	case uplo == blas.Upper:
+		// U*A*U^T.
+		blas64.Trmm(blas.Left, blas.NoTrans, 1, tri, want)
+		blas64.Trmm(blas.Right, blas.Trans, 1, tri, want)
+	default:
+		// L^T*A*L.
+		blas64.Trmm(blas.Left, blas.Trans, 1, tri, want)
+		blas64.Trmm(blas.Right, blas.NoTrans, 1, tri, want)
+	}
+
+	scale := math.Max(1, dlange(lapack.MaxAbs, n, n, want.Data, want.Stride))
+	var diff float64
+	for i := 0; i < n; i++ {
+		jmin, jmax := 0, i+1
+		if uplo == blas.Upper {
+			jmin, jmax = i, n
+		}
+		for j := jmin; j < jmax; j++ {
+			diff = math.Max(diff, math.Abs(a.Data[i*a.Stride+j]-want.Data[i*want.Stride+j]))
+		}
+	}
+	if diff > tol*scale || math.IsNaN(diff) {
+		t.Errorf("%v: unexpected result, max difference %v", name, diff)
+	}
+	// The opposite triangle of A must not be referenced.
+	if uplo == blas.Upper && !sameLowerTri(n, a.Data, a.Stride, aCopy.Data, aCopy.Stride) ||
+		uplo == blas.Lower && !sameUpperTri(n, a.Data, a.Stride, aCopy.Data, aCopy.Stride) {
+		t.Errorf("%v: unexpected modification of the opposite triangle of A", name)
+	}
+}
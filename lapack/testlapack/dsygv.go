@@ -0,0 +1,183 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsygver interface {
+	Dsygv(itype lapack.GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool)
+}
+
+func DsygvTest(t *testing.T, impl Dsygver) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, itype := range []lapack.GenEVType{lapack.GenEVAxBx, lapack.GenEVABx, lapack.GenEVBAx} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 33, 70, 100} {
+				for _, extra := range []int{0, 5} {
+					for _, wl := range []worklen{minimumWork, optimumWork} {
+						dsygvTest(t, impl, rnd, itype, uplo, n, extra, wl)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsygvTest(t *testing.T, impl Dsygver, rnd *rand.Rand, itype lapack.GenEVType, uplo blas.Uplo, n, extra int, wl worklen) {
+	const tol = 1e-12
+
+	// Generate a random symmetric matrix A and a random symmetric positive
+	// definite matrix B.
+	a := randomSymmetric(n, max(1, n+extra), rnd)
+	b := randomSPD(n, max(1, n+extra), rnd)
+
+	name := fmt.Sprintf("itype=%v,uplo=%c,n=%v,extra=%v,work=%v", itype, uplo, n, extra, wl)
+
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, 3*n-1)
+	case optimumWork:
+		work := make([]float64, 1)
+		impl.Dsygv(itype, lapack.EVCompute, uplo, n, nil, a.Stride, nil, b.Stride, nil, work, -1)
+		lwork = int(work[0])
+	}
+	work := nanSlice(lwork)
+
+	// Compute the eigenvalues only.
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	wWant := nanSlice(n)
+	ok := impl.Dsygv(itype, lapack.EVNone, uplo, n, aCopy.Data, aCopy.Stride, bCopy.Data, bCopy.Stride, wWant, work, lwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure for jobz=EVNone", name)
+		return
+	}
+
+	// Compute the eigenvalues and eigenvectors.
+	z := cloneGeneral(a)
+	bCopy = cloneGeneral(b)
+	w := nanSlice(n)
+	ok = impl.Dsygv(itype, lapack.EVCompute, uplo, n, z.Data, z.Stride, bCopy.Data, bCopy.Stride, w, work, lwork)
+	if !ok {
+		t.Errorf("%v: unexpected failure for jobz=EVCompute", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	wnorm := math.Max(1, math.Max(math.Abs(w[0]), math.Abs(w[n-1])))
+	if !floats.EqualApprox(w, wWant, tol*wnorm) {
+		t.Errorf("%v: eigenvalues differ between jobz=EVNone and jobz=EVCompute", name)
+	}
+	for i := 1; i < n; i++ {
+		if w[i] < w[i-1] {
+			t.Errorf("%v: eigenvalues not sorted in ascending order", name)
+			break
+		}
+	}
+
+	// Check the eigendecomposition.
+	zLambda := cloneGeneral(z)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			zLambda.Data[i*zLambda.Stride+j] *= w[j]
+		}
+	}
+	lhs := zeros(n, n, n)
+	rhs := zeros(n, n, n)
+	switch itype {
+	case lapack.GenEVAxBx:
+		// A*Z = B*Z*Λ.
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, z, 0, lhs)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, b, zLambda, 0, rhs)
+	case lapack.GenEVABx:
+		// A*B*Z = Z*Λ.
+		bz := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, b, z, 0, bz)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, bz, 0, lhs)
+		copyGeneral(rhs, zLambda)
+	case lapack.GenEVBAx:
+		// B*A*Z = Z*Λ.
+		az := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, z, 0, az)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, b, az, 0, lhs)
+		copyGeneral(rhs, zLambda)
+	}
+	scale := math.Max(1, dlange(lapack.MaxAbs, n, n, rhs.Data, rhs.Stride))
+	if !equalApproxGeneral(lhs, rhs, tol*scale) {
+		t.Errorf("%v: unexpected eigendecomposition", name)
+	}
+
+	// Check the normalization of the eigenvectors.
+	switch itype {
+	case lapack.GenEVAxBx, lapack.GenEVABx:
+		// Z^T*B*Z = I.
+		bz := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, b, z, 0, bz)
+		ztbz := zeros(n, n, n)
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, z, bz, 0, ztbz)
+		if !equalApproxGeneral(ztbz, eye(n, n), tol) {
+			t.Errorf("%v: Z^T*B*Z is not the identity", name)
+		}
+	case lapack.GenEVBAx:
+		// Z^T*inv(B)*Z = I, equivalently Z*Z^T = B.
+		zzt := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, z, z, 0, zzt)
+		if !equalApproxGeneral(zzt, b, tol*math.Max(1, dlange(lapack.MaxAbs, n, n, b.Data, b.Stride))) {
+			t.Errorf("%v: Z*Z^T is not equal to B", name)
+		}
+	}
+
+	// Check that a matrix B that is not positive definite is detected.
+	bNotPD := cloneGeneral(b)
+	bNotPD.Data[(n-1)*bNotPD.Stride+n-1] = -1
+	aCopy = cloneGeneral(a)
+	ok = impl.Dsygv(itype, lapack.EVCompute, uplo, n, aCopy.Data, aCopy.Stride, bNotPD.Data, bNotPD.Stride, w, work, lwork)
+	if ok {
+		t.Errorf("%v: B not positive definite but ok=true", name)
+	}
+}
+
+// randomSymmetric returns an n×n random symmetric matrix with both triangles
+// filled.
+func randomSymmetric(n, stride int, rnd *rand.Rand) blas64.General {
+	a := zeros(n, n, stride)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := rnd.NormFloat64()
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+	return a
+}
+
+// randomSPD returns an n×n random symmetric positive definite matrix with both
+// triangles filled.
+func randomSPD(n, stride int, rnd *rand.Rand) blas64.General {
+	m := randomGeneral(n, n, max(1, n), rnd)
+	a := zeros(n, n, stride)
+	if n == 0 {
+		return a
+	}
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, m, m, 0, a)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] += float64(n)
+	}
+	return a
+}
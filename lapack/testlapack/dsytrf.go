@@ -0,0 +1,184 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsytrfer interface {
+	Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) (ok bool)
+}
+
+func DsytrfTest(t *testing.T, impl Dsytrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 50} {
+			for _, lda := range []int{n, n + 5} {
+				for _, zeroDiag := range []bool{false, true} {
+					if zeroDiag && n == 1 {
+						// A 1×1 matrix with zero diagonal is singular.
+						continue
+					}
+					dsytrfTest(t, impl, uplo, n, lda, zeroDiag, rnd)
+				}
+			}
+		}
+	}
+}
+
+func dsytrfTest(t *testing.T, impl Dsytrfer, uplo blas.Uplo, n, lda int, zeroDiag bool, rnd *rand.Rand) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("uplo=%v,n=%v,lda=%v,zeroDiag=%v", string(uplo), n, lda, zeroDiag)
+
+	// Generate a random symmetric indefinite matrix. A zero diagonal forces
+	// the use of 2×2 pivot blocks.
+	a := randomSymmetricIndefinite(n, max(1, lda), zeroDiag, rnd)
+	aCopy := cloneGeneral(a)
+
+	ipiv := make([]int, n)
+	ok := impl.Dsytrf(uplo, n, a.Data, max(1, lda), ipiv)
+	if !ok {
+		t.Errorf("%v: unexpected singular matrix", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// Check that the pivot indices describe a valid block structure.
+	for k := 0; k < n; {
+		if ipiv[k] >= 0 {
+			if ipiv[k] >= n {
+				t.Fatalf("%v: ipiv[%v] out of range", name, k)
+			}
+			k++
+			continue
+		}
+		if k == n-1 || ipiv[k+1] != ipiv[k] || -ipiv[k]-1 >= n {
+			t.Fatalf("%v: invalid 2×2 pivot block at %v", name, k)
+		}
+		k += 2
+	}
+
+	// Check that the factors are stored in the correct triangle.
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+				if a.Data[i*a.Stride+j] != aCopy.Data[i*aCopy.Stride+j] {
+					t.Errorf("%v: unexpected modification outside of the triangle", name)
+					i, j = n, n
+				}
+			}
+		}
+	}
+
+	// Check that A = U*D*U^T or A = L*D*L^T.
+	got := constructSytrf(uplo, n, a.Data, a.Stride, ipiv)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			got.Data[i*got.Stride+j] -= aCopy.Data[i*aCopy.Stride+j]
+		}
+	}
+	resid := dlange(lapack.MaxColumnSum, n, n, got.Data, got.Stride) / dlange(lapack.MaxColumnSum, n, n, aCopy.Data, aCopy.Stride)
+	if resid > tol*float64(n) {
+		t.Errorf("%v: unexpected factorization: |A - reconstructed|/|A| = %v", name, resid)
+	}
+}
+
+// randomSymmetricIndefinite returns a random n×n symmetric matrix in general
+// storage. If zeroDiag is true, the diagonal of the matrix is zero.
+func randomSymmetricIndefinite(n, lda int, zeroDiag bool, rnd *rand.Rand) blas64.General {
+	a := nanGeneral(n, n, lda)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := rnd.NormFloat64()
+			if i == j && zeroDiag {
+				v = 0
+			}
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+	return a
+}
+
+// constructSytrf returns the n×n symmetric matrix U*D*U^T or L*D*L^T from the
+// factorization computed by Dsytrf.
+func constructSytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) blas64.General {
+	// Construct the block diagonal matrix D.
+	m := zeros(n, n, n)
+	for k := 0; k < n; k++ {
+		m.Data[k*n+k] = a[k*lda+k]
+	}
+	if uplo == blas.Upper {
+		for k := n - 1; k >= 0; k-- {
+			if ipiv[k] < 0 {
+				m.Data[(k-1)*n+k] = a[(k-1)*lda+k]
+				m.Data[k*n+k-1] = a[(k-1)*lda+k]
+				k--
+			}
+		}
+	} else {
+		for k := 0; k < n; k++ {
+			if ipiv[k] < 0 {
+				m.Data[(k+1)*n+k] = a[(k+1)*lda+k]
+				m.Data[k*n+k+1] = a[(k+1)*lda+k]
+				k++
+			}
+		}
+	}
+
+	// apply computes m = P(k)*U(k) * m * (P(k)*U(k))^T where U(k) is
+	// the identity matrix except for the columns k0 to k0+s-1, which contain
+	// the elements of A in the rows r0 to r1-1, and P(k) interchanges the rows
+	// kk and kp.
+	apply := func(k0, s, r0, r1, kk, kp int) {
+		pu := eye(n, n)
+		for j := k0; j < k0+s; j++ {
+			for i := r0; i < r1; i++ {
+				pu.Data[i*n+j] = a[i*lda+j]
+			}
+		}
+		if kp != kk {
+			for j := 0; j < n; j++ {
+				pu.Data[kk*n+j], pu.Data[kp*n+j] = pu.Data[kp*n+j], pu.Data[kk*n+j]
+			}
+		}
+		tmp := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, pu, m, 0, tmp)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, tmp, pu, 0, m)
+	}
+
+	// The factors are applied starting from the innermost one.
+	if uplo == blas.Upper {
+		for k := 0; k < n; k++ {
+			if ipiv[k] >= 0 {
+				apply(k, 1, 0, k, k, ipiv[k])
+			} else {
+				apply(k, 2, 0, k, k, -ipiv[k]-1)
+				k++
+			}
+		}
+	} else {
+		for k := n - 1; k >= 0; k-- {
+			if ipiv[k] >= 0 {
+				apply(k, 1, k+1, n, k, ipiv[k])
+			} else {
+				apply(k-1, 2, k+1, n, k, -ipiv[k]-1)
+				k--
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,96 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsytrser interface {
+	Dsytrfer
+	Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
+}
+
+func DsytrsTest(t *testing.T, impl Dsytrser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 50} {
+			for _, nrhs := range []int{0, 1, 2, 5} {
+				for _, extra := range []int{0, 3} {
+					for _, zeroDiag := range []bool{false, true} {
+						if zeroDiag && n == 1 {
+							// A 1×1 matrix with zero diagonal is singular.
+							continue
+						}
+						dsytrsTest(t, impl, uplo, n, nrhs, extra, zeroDiag, rnd)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsytrsTest(t *testing.T, impl Dsytrser, uplo blas.Uplo, n, nrhs, extra int, zeroDiag bool, rnd *rand.Rand) {
+	const tol = 1e-11
+
+	name := fmt.Sprintf("uplo=%v,n=%v,nrhs=%v,extra=%v,zeroDiag=%v", string(uplo), n, nrhs, extra, zeroDiag)
+
+	lda := max(1, n+extra)
+	a := randomSymmetricIndefinite(n, lda, zeroDiag, rnd)
+
+	// Generate the solution X and compute the corresponding right-hand
+	// side B = A*X.
+	ldb := max(1, nrhs+extra)
+	x := randomGeneral(n, nrhs, ldb, rnd)
+	b := zeros(n, nrhs, ldb)
+	if n > 0 && nrhs > 0 {
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, x, 0, b)
+	}
+	anorm := dlange(lapack.MaxColumnSum, n, n, a.Data, lda)
+
+	ipiv := make([]int, n)
+	ok := impl.Dsytrf(uplo, n, a.Data, lda, ipiv)
+	if !ok {
+		t.Errorf("%v: unexpected singular matrix", name)
+		return
+	}
+	aCopy := cloneGeneral(a)
+
+	impl.Dsytrs(uplo, n, nrhs, a.Data, lda, ipiv, b.Data, ldb)
+
+	if !equalApproxGeneral(a, aCopy, 0) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !generalOutsideAllNaN(b) {
+		t.Errorf("%v: out-of-range write to B", name)
+	}
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	// The error in X is bounded by the condition number of A which is
+	// estimated from the factorization.
+	ainv := eye(n, n)
+	impl.Dsytrs(uplo, n, n, a.Data, lda, ipiv, ainv.Data, ainv.Stride)
+	cond := anorm * dlange(lapack.MaxColumnSum, n, n, ainv.Data, ainv.Stride)
+
+	xnorm := dlange(lapack.MaxColumnSum, n, nrhs, x.Data, x.Stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			b.Data[i*b.Stride+j] -= x.Data[i*x.Stride+j]
+		}
+	}
+	resid := dlange(lapack.MaxColumnSum, n, nrhs, b.Data, b.Stride) / xnorm / cond
+	if resid > tol {
+		t.Errorf("%v: unexpected solution: |X - X_computed|/(|X|*cond(A)) = %v", name, resid)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtgevcer interface {
+	Dtgevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr int, mm int, work []float64) (m int, ok bool)
+	Dggeser
+}
+
+func DtgevcTest(t *testing.T, impl Dtgevcer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 18, 31, 50} {
+		for _, extra := range []int{0, 3} {
+			for cas := 0; cas < 10; cas++ {
+				dtgevcTest(t, impl, n, extra, rnd)
+			}
+		}
+	}
+}
+
+func dtgevcTest(t *testing.T, impl Dtgevcer, n, extra int, rnd *rand.Rand) {
+	const tol = 1e-12
+
+	// Generate a random matrix pair in generalized real Schur form.
+	s := randomGeneral(n, n, n+extra, rnd)
+	p := randomGeneral(n, n, n+extra, rnd)
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta := make([]float64, n)
+	work := make([]float64, max(1, 8*n))
+	first := impl.Dgges(lapack.SchurNone, lapack.SchurNone, n, s.Data, max(1, s.Stride), p.Data, max(1, p.Stride),
+		alphar, alphai, beta, nil, 1, nil, 1, work, len(work))
+	if first != 0 {
+		t.Fatalf("n=%v: Dgges failed", n)
+	}
+
+	for _, side := range []lapack.EVSide{lapack.EVLeft, lapack.EVRight, lapack.EVBoth} {
+		name := fmt.Sprintf("n=%v,extra=%v,side=%c", n, extra, side)
+
+		// Compute all eigenvectors.
+		vl := nanGeneral(n, n, n+extra)
+		vr := nanGeneral(n, n, n+extra)
+		work := nanSlice(6 * n)
+		m, ok := impl.Dtgevc(side, lapack.EVAll, nil, n, s.Data, max(1, s.Stride), p.Data, max(1, p.Stride),
+			vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), n, work)
+		if !ok {
+			t.Errorf("%v: unexpected failure", name)
+			continue
+		}
+		if m != n {
+			t.Errorf("%v: unexpected value of m: got %v, want %v", name, m, n)
+		}
+		if side != lapack.EVLeft {
+			resid := dggevResidual(false, s, p, alphar, alphai, beta, vr)
+			if resid > tol {
+				t.Errorf("%v: residual for right eigenvectors too large: %v", name, resid)
+			}
+			if !isNormalizedEigenvectors(alphai, vr, tol) {
+				t.Errorf("%v: right eigenvectors not normalized", name)
+			}
+		}
+		if side != lapack.EVRight {
+			resid := dggevResidual(true, s, p, alphar, alphai, beta, vl)
+			if resid > tol {
+				t.Errorf("%v: residual for left eigenvectors too large: %v", name, resid)
+			}
+			if !isNormalizedEigenvectors(alphai, vl, tol) {
+				t.Errorf("%v: left eigenvectors not normalized", name)
+			}
+		}
+
+		// Compute a random selection of the eigenvectors and compare
+		// them with the corresponding columns of the full matrices.
+		selected := make([]bool, n)
+		var cols []int
+		var mWant int
+		for j := 0; j < n; j++ {
+			selected[j] = rnd.Intn(2) == 0
+			if alphai[j] == 0 {
+				if selected[j] {
+					cols = append(cols, j)
+					mWant++
+				}
+				continue
+			}
+			// Complex eigenvalue, select the pair by either of
+			// its elements.
+			if rnd.Intn(2) == 0 {
+				selected[j] = false
+				selected[j+1] = rnd.Intn(2) == 0
+			}
+			if selected[j] || selected[j+1] {
+				cols = append(cols, j, j+1)
+				mWant += 2
+			}
+			j++
+		}
+		selectedCopy := make([]bool, n)
+		copy(selectedCopy, selected)
+		mm := mWant + rnd.Intn(3)
+		vlSel := nanGeneral(n, mm, mm+extra)
+		vrSel := nanGeneral(n, mm, mm+extra)
+		m, ok = impl.Dtgevc(side, lapack.EVSelected, selected, n, s.Data, max(1, s.Stride), p.Data, max(1, p.Stride),
+			vlSel.Data, max(1, vlSel.Stride), vrSel.Data, max(1, vrSel.Stride), mm, work)
+		if !ok {
+			t.Errorf("%v: unexpected failure for selected eigenvectors", name)
+			continue
+		}
+		if m != mWant {
+			t.Errorf("%v: unexpected number of selected eigenvectors: got %v, want %v", name, m, mWant)
+			continue
+		}
+		for k := range selected {
+			if selected[k] != selectedCopy[k] {
+				t.Errorf("%v: selected modified", name)
+				break
+			}
+		}
+		for k, j := range cols {
+			for i := 0; i < n; i++ {
+				if side != lapack.EVLeft && !sameFloat64(vrSel.Data[i*vrSel.Stride+k], vr.Data[i*vr.Stride+j]) {
+					t.Errorf("%v: selected right eigenvector %v does not match", name, j)
+					return
+				}
+				if side != lapack.EVRight && !sameFloat64(vlSel.Data[i*vlSel.Stride+k], vl.Data[i*vl.Stride+j]) {
+					t.Errorf("%v: selected left eigenvector %v does not match", name, j)
+					return
+				}
+			}
+		}
+	}
+}
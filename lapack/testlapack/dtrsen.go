@@ -0,0 +1,213 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtrsener interface {
+	Dtrsen(job lapack.SchurCond, compq lapack.UpdateSchurComp, selected []bool, n int, t []float64, ldt int, q []float64, ldq int, wr, wi, work []float64, lwork int, iwork []int, liwork int) (m int, s, sep float64, ok bool)
+}
+
+func DtrsenTest(t *testing.T, impl Dtrsener) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, job := range []lapack.SchurCond{lapack.SchurCondNone, lapack.SchurCondEigenvalues, lapack.SchurCondSubspace, lapack.SchurCondBoth} {
+		for _, compq := range []lapack.UpdateSchurComp{lapack.UpdateSchurNone, lapack.UpdateSchur} {
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 18, 31} {
+				for _, extra := range []int{0, 3} {
+					for _, wl := range []worklen{minimumWork, optimumWork} {
+						for cas := 0; cas < 10; cas++ {
+							tmat := randomSchurCanonical(n, n+extra, rnd)
+							selected := make([]bool, n)
+							for i := range selected {
+								selected[i] = rnd.Float64() < 0.5
+							}
+							dtrsenTest(t, impl, job, compq, selected, tmat, wl, rnd)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dtrsenTest(t *testing.T, impl Dtrsener, job lapack.SchurCond, compq lapack.UpdateSchurComp, selected []bool, tmat blas64.General, wl worklen, rnd *rand.Rand) {
+	const tol = 1e-13
+
+	n := tmat.Rows
+	extra := tmat.Stride - n
+	name := fmt.Sprintf("job=%c,compq=%c,n=%v,extra=%v,work=%v", job, compq, n, extra, wl)
+
+	// Compute the expected dimension of the invariant subspace and the
+	// selected eigenvalues.
+	var mWant int
+	var evSel []complex128
+	for i := 0; i < n; {
+		size, _ := schurBlockSize(tmat, i)
+		if selected[i] || (size == 2 && selected[i+1]) {
+			mWant += size
+			if size == 1 {
+				evSel = append(evSel, complex(tmat.Data[i*tmat.Stride+i], 0))
+			} else {
+				a, b, c, d := extract2x2Block(tmat.Data[i*tmat.Stride+i:], tmat.Stride)
+				ev1, ev2 := schurBlockEigenvalues(a, b, c, d)
+				evSel = append(evSel, ev1, ev2)
+			}
+		}
+		i += size
+	}
+
+	wantq := compq == lapack.UpdateSchur
+	var q blas64.General
+	if wantq {
+		q = randomOrthogonal(n, rnd)
+		// Use the requested stride.
+		qs := nanGeneral(n, n, n+extra)
+		for i := 0; i < n; i++ {
+			copy(qs.Data[i*qs.Stride:i*qs.Stride+n], q.Data[i*q.Stride:i*q.Stride+n])
+		}
+		q = qs
+	} else {
+		q.Stride = 1
+	}
+
+	tOrig := cloneGeneral(tmat)
+	qOrig := cloneGeneral(q)
+	wr := nanSlice(n)
+	wi := nanSlice(n)
+
+	var lwork, liwork int
+	switch wl {
+	case minimumWork:
+		nn := mWant * (n - mWant)
+		lwork = max(1, n)
+		liwork = 1
+		switch job {
+		case lapack.SchurCondEigenvalues:
+			lwork = max(lwork, nn)
+		case lapack.SchurCondSubspace, lapack.SchurCondBoth:
+			lwork = max(lwork, 2*nn)
+			liwork = max(1, nn)
+		}
+	case optimumWork:
+		work := make([]float64, 1)
+		iwork := make([]int, 1)
+		impl.Dtrsen(job, compq, selected, n, tmat.Data, max(1, tmat.Stride), nil, max(1, q.Stride), nil, nil, work, -1, iwork, -1)
+		lwork = int(work[0])
+		liwork = iwork[0]
+	}
+	work := nanSlice(lwork)
+	iwork := make([]int, liwork)
+
+	m, s, sep, ok := impl.Dtrsen(job, compq, selected, n, tmat.Data, max(1, tmat.Stride), q.Data, max(1, q.Stride),
+		wr, wi, work, lwork, iwork, liwork)
+
+	if !generalOutsideAllNaN(tmat) {
+		t.Errorf("%v: out-of-range write to T", name)
+	}
+	if wantq && !generalOutsideAllNaN(q) {
+		t.Errorf("%v: out-of-range write to Q", name)
+	}
+	if m != mWant {
+		t.Errorf("%v: unexpected value of m: got %v, want %v", name, m, mWant)
+	}
+	if !ok {
+		// The reordering failed because the problem is very
+		// ill-conditioned, nothing more to check.
+		t.Logf("%v: Dtrsen returned ok=false", name)
+		return
+	}
+
+	if !isSchurCanonicalGeneral(tmat) {
+		t.Errorf("%v: T is not in Schur canonical form", name)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < i-1; j++ {
+			if tmat.Data[i*tmat.Stride+j] != 0 {
+				t.Errorf("%v: T is not upper quasi-triangular", name)
+				i = n
+				break
+			}
+		}
+	}
+	if m > 0 && m < n && tmat.Data[m*tmat.Stride+m-1] != 0 {
+		t.Errorf("%v: selected cluster not separated from the rest of T", name)
+	}
+
+	// Check that wr and wi contain the eigenvalues on the diagonal of T.
+	for i := 0; i < n; {
+		size, _ := schurBlockSize(tmat, i)
+		if size == 1 {
+			if wr[i] != tmat.Data[i*tmat.Stride+i] || wi[i] != 0 {
+				t.Errorf("%v: unexpected eigenvalue %v", name, i)
+			}
+			i++
+			continue
+		}
+		a, b, c, d := extract2x2Block(tmat.Data[i*tmat.Stride+i:], tmat.Stride)
+		ev1, _ := schurBlockEigenvalues(a, b, c, d)
+		if wr[i] != a || wr[i+1] != d || math.Abs(wi[i]-imag(ev1)) > tol*math.Abs(wi[i]) || wi[i+1] != -wi[i] {
+			t.Errorf("%v: unexpected eigenvalue pair %v", name, i)
+		}
+		i += 2
+	}
+
+	// Check that the leading m eigenvalues are the selected ones.
+	for i := 0; i < m; i++ {
+		ev := complex(wr[i], wi[i])
+		found, idx := containsComplex(evSel, ev, 1e-10*math.Max(1, cmplx.Abs(ev)))
+		if !found {
+			t.Errorf("%v: eigenvalue %v not in the selected cluster", name, ev)
+			continue
+		}
+		evSel = append(evSel[:idx], evSel[idx+1:]...)
+	}
+
+	if wants := job == lapack.SchurCondEigenvalues || job == lapack.SchurCondBoth; wants {
+		if s <= 0 || s > 1 {
+			t.Errorf("%v: unexpected reciprocal condition number of the cluster: %v", name, s)
+		}
+	} else if s != 0 {
+		t.Errorf("%v: s computed but not requested", name)
+	}
+	if wantsp := job == lapack.SchurCondSubspace || job == lapack.SchurCondBoth; wantsp {
+		if n > 0 && sep <= 0 {
+			t.Errorf("%v: unexpected reciprocal condition number of the subspace: %v", name, sep)
+		}
+	} else if sep != 0 {
+		t.Errorf("%v: sep computed but not requested", name)
+	}
+
+	if !wantq || n == 0 {
+		return
+	}
+	if !isOrthogonal(q) {
+		t.Errorf("%v: Q is not orthogonal", name)
+	}
+
+	// Check that Q*T*Q^T is unchanged by the reordering.
+	qt := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, q, tmat, 0, qt)
+	got := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, qt, q, 0, got)
+	qt = zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, qOrig, tOrig, 0, qt)
+	want := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, qt, qOrig, 0, want)
+	tnorm := math.Max(1, dlange(lapack.MaxAbs, n, n, tOrig.Data, tOrig.Stride))
+	if !equalApproxGeneral(got, want, tol*float64(n)*tnorm) {
+		t.Errorf("%v: Q*T*Q^T changed by the reordering", name)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtrsyler interface {
+	Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool)
+}
+
+func DtrsylTest(t *testing.T, impl Dtrsyler) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, trana := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, tranb := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, isgn := range []int{1, -1} {
+				for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+					for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 23} {
+						for _, extra := range []int{0, 3} {
+							for cas := 0; cas < 5; cas++ {
+								dtrsylTest(t, impl, trana, tranb, isgn, m, n, extra, rnd)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dtrsylTest(t *testing.T, impl Dtrsyler, trana, tranb blas.Transpose, isgn, m, n, extra int, rnd *rand.Rand) {
+	const tol = 1e-12
+
+	name := fmt.Sprintf("trana=%v,tranb=%v,isgn=%v,m=%v,n=%v,extra=%v", trana, tranb, isgn, m, n, extra)
+
+	// Generate A and B in Schur canonical form with the spectra of A and
+	// -isgn*B well separated so that the equation is well conditioned.
+	const shift = 10
+	a := randomSchurCanonical(m, m+extra, rnd)
+	for i := 0; i < m; i++ {
+		a.Data[i*a.Stride+i] += shift
+	}
+	b := randomSchurCanonical(n, n+extra, rnd)
+	for i := 0; i < n; i++ {
+		b.Data[i*b.Stride+i] += float64(isgn) * shift
+	}
+
+	// Generate the solution X and compute the corresponding right-hand
+	// side C = op(A)*X + isgn*X*op(B).
+	x := randomGeneral(m, n, n+extra, rnd)
+	c := zeros(m, n, n+extra)
+	if m > 0 && n > 0 {
+		blas64.Gemm(trana, blas.NoTrans, 1, a, x, 0, c)
+		blas64.Gemm(blas.NoTrans, tranb, float64(isgn), x, b, 1, c)
+	}
+	cCopy := cloneGeneral(c)
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+
+	scale, ok := impl.Dtrsyl(trana, tranb, isgn, m, n, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), c.Data, max(1, c.Stride))
+
+	if !equalApproxGeneral(a, aCopy, 0) {
+		t.Errorf("%v: unexpected modification of A", name)
+	}
+	if !equalApproxGeneral(b, bCopy, 0) {
+		t.Errorf("%v: unexpected modification of B", name)
+	}
+	if !generalOutsideAllNaN(c) {
+		t.Errorf("%v: out-of-range write to C", name)
+	}
+	if !ok {
+		t.Errorf("%v: unexpected perturbation of the equation", name)
+	}
+	if scale != 1 {
+		t.Errorf("%v: unexpected scale: got %v, want 1", name, scale)
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// Check the residual |op(A)*X + isgn*X*op(B) - scale*C|.
+	r := cloneGeneral(cCopy)
+	blas64.Gemm(trana, blas.NoTrans, 1, a, c, -scale, r)
+	blas64.Gemm(blas.NoTrans, tranb, float64(isgn), c, b, 1, r)
+	anorm := dlange(lapack.MaxAbs, m, m, a.Data, a.Stride)
+	bnorm := dlange(lapack.MaxAbs, n, n, b.Data, b.Stride)
+	xnorm := dlange(lapack.MaxAbs, m, n, c.Data, c.Stride)
+	resid := dlange(lapack.MaxAbs, m, n, r.Data, r.Stride) / ((anorm + bnorm) * math.Max(1, xnorm) * float64(max(m, n)))
+	if resid > tol {
+		t.Errorf("%v: unexpected residual: |op(A)*X + isgn*X*op(B) - scale*C| = %v", name, resid)
+	}
+
+	// Check that the computed solution is close to the generated one.
+	for i := range x.Data {
+		x.Data[i] *= scale
+	}
+	if !equalApproxGeneral(c, x, tol*float64(max(m, n))) {
+		t.Errorf("%v: unexpected solution", name)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dtzrzfer interface {
+	Dtzrzf(m, n int, a []float64, lda int, tau, work []float64, lwork int)
+}
+
+func DtzrzfTest(t *testing.T, impl Dtzrzfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{0, 0},
+		{0, 3},
+		{1, 1},
+		{1, 5},
+		{2, 2},
+		{2, 5},
+		{3, 4},
+		{5, 12},
+		{10, 10},
+		{10, 30},
+		{40, 60},
+		{150, 160},
+		{150, 200},
+	} {
+		m, n := test.m, test.n
+		for _, extra := range []int{0, 11} {
+			lda := max(1, n+extra)
+			work := make([]float64, 1)
+			impl.Dtzrzf(m, n, nil, lda, nil, work, -1)
+			lwkopt := int(work[0])
+			for _, lwork := range []int{max(1, m), lwkopt - 1, lwkopt} {
+				if lwork < max(1, m) {
+					continue
+				}
+				dtzrzfTest(t, impl, rnd, m, n, lda, lwork)
+			}
+		}
+	}
+}
+
+func dtzrzfTest(t *testing.T, impl Dtzrzfer, rnd *rand.Rand, m, n, lda, lwork int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("m=%v,n=%v,lda=%v,lwork=%v", m, n, lda, lwork)
+
+	// Generate a random upper trapezoidal matrix A.
+	a := randomGeneral(m, n, lda, rnd)
+	for i := 0; i < m; i++ {
+		for j := 0; j < i; j++ {
+			a.Data[i*lda+j] = 0
+		}
+	}
+	aCopy := cloneGeneral(a)
+
+	tau := nanSlice(m)
+	work := nanSlice(lwork)
+	impl.Dtzrzf(m, n, a.Data, lda, tau, work, lwork)
+
+	if !generalOutsideAllNaN(a) {
+		t.Errorf("%v: out-of-range write to A", name)
+	}
+	if m == 0 {
+		return
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < i; j++ {
+			if a.Data[i*lda+j] != 0 {
+				t.Errorf("%v: unexpected modification below the diagonal", name)
+				i = m
+				break
+			}
+		}
+	}
+
+	checkTzrzf(t, name, m, n, a, aCopy, tau, tol)
+}
+
+// checkTzrzf checks that the factorization A = [R 0]*Z computed by Dtzrzf or
+// Dlatrz is correct.
+func checkTzrzf(t *testing.T, name string, m, n int, a, aCopy blas64.General, tau []float64, tol float64) {
+	z := constructZ(m, n, a.Data, a.Stride, tau)
+	if !isOrthogonal(z) {
+		t.Errorf("%v: Z is not orthogonal", name)
+	}
+
+	// Check that A = [R 0]*Z.
+	r := zeros(m, n, n)
+	for i := 0; i < m; i++ {
+		for j := i; j < m; j++ {
+			r.Data[i*n+j] = a.Data[i*a.Stride+j]
+		}
+	}
+	rz := zeros(m, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, r, z, 0, rz)
+	if !equalApproxGeneral(rz, aCopy, tol*float64(n)) {
+		t.Errorf("%v: A != [R 0]*Z", name)
+	}
+}
+
+// constructZ returns the n×n orthogonal matrix Z defined by the m elementary
+// reflectors computed by Dtzrzf,
+//  Z = Z_0 * Z_1 * ... * Z_{m-1}.
+func constructZ(m, n int, a []float64, lda int, tau []float64) blas64.General {
+	z := eye(n, n)
+	for k := 0; k < m; k++ {
+		// Z_k = I - tau[k] * u * u^T, where u[k] = 1, u[m:n] = A[k,m:n] and
+		// all other elements of u are zero.
+		u := make([]float64, n)
+		u[k] = 1
+		copy(u[m:], a[k*lda+m:k*lda+n])
+		zk := eye(n, n)
+		blas64.Ger(-tau[k], blas64.Vector{N: n, Data: u, Inc: 1}, blas64.Vector{N: n, Data: u, Inc: 1}, zk)
+		tmp := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, z, zk, 0, tmp)
+		z = tmp
+	}
+	return z
+}
@@ -0,0 +1,89 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"strconv"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// factorBenchSizes are the matrix orders used by the factorization benchmarks.
+var factorBenchSizes = []int{100, 500, 1000}
+
+func DpotrfBenchmark(b *testing.B, impl Dpotrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range factorBenchSizes {
+		// Construct a diagonally dominant symmetric matrix.
+		aCopy := make([]float64, n*n)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				v := rnd.Float64()
+				aCopy[i*n+j] = v
+				aCopy[j*n+i] = v
+			}
+			aCopy[i*n+i] += float64(n)
+		}
+		a := make([]float64, len(aCopy))
+		b.Run(benchName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(a, aCopy)
+				b.StartTimer()
+				impl.Dpotrf(blas.Lower, n, a, n)
+			}
+		})
+	}
+}
+
+func DgetrfBenchmark(b *testing.B, impl Dgetrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range factorBenchSizes {
+		aCopy := make([]float64, n*n)
+		for i := range aCopy {
+			aCopy[i] = rnd.NormFloat64()
+		}
+		a := make([]float64, len(aCopy))
+		ipiv := make([]int, n)
+		b.Run(benchName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(a, aCopy)
+				b.StartTimer()
+				impl.Dgetrf(n, n, a, n, ipiv)
+			}
+		})
+	}
+}
+
+func DgeqrfBenchmark(b *testing.B, impl Dgeqrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range factorBenchSizes {
+		aCopy := make([]float64, n*n)
+		for i := range aCopy {
+			aCopy[i] = rnd.NormFloat64()
+		}
+		a := make([]float64, len(aCopy))
+		tau := make([]float64, n)
+		work := make([]float64, 1)
+		impl.Dgeqrf(n, n, a, n, tau, work, -1)
+		work = make([]float64, int(work[0]))
+		b.Run(benchName(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(a, aCopy)
+				b.StartTimer()
+				impl.Dgeqrf(n, n, a, n, tau, work, len(work))
+			}
+		})
+	}
+}
+
+func benchName(n int) string {
+	return "N" + strconv.Itoa(n)
+}
@@ -0,0 +1,71 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zgeconer interface {
+	Zgetrser
+	Zlange(norm lapack.MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64
+	Zgecon(norm lapack.MatrixNorm, n int, a []complex128, lda int, anorm float64, work []complex128) float64
+}
+
+func ZgeconTest(t *testing.T, impl Zgeconer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 50, 100} {
+		for _, lda := range []int{n, n + 3} {
+			for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+				zgeconTest(t, impl, rnd, n, lda, norm)
+			}
+		}
+	}
+
+	// A singular matrix must have zero reciprocal condition number.
+	a := []complex128{
+		1, 2i,
+		2, 4i,
+	}
+	ipiv := make([]int, 2)
+	anorm := impl.Zlange(lapack.MaxColumnSum, 2, 2, a, 2, make([]float64, 2))
+	impl.Zgetrf(2, 2, a, 2, ipiv)
+	if rcond := impl.Zgecon(lapack.MaxColumnSum, 2, a, 2, anorm, make([]complex128, 4)); rcond != 0 {
+		t.Errorf("unexpected rcond for singular matrix: got %v, want 0", rcond)
+	}
+}
+
+func zgeconTest(t *testing.T, impl Zgeconer, rnd *rand.Rand, n, lda int, norm lapack.MatrixNorm) {
+	name := fmt.Sprintf("n=%d,lda=%d,norm=%v", n, lda, string(norm))
+
+	a := randomCGeneral(n, n, lda, rnd)
+	work := make([]float64, n)
+	anorm := impl.Zlange(norm, n, n, a.Data, lda, work)
+
+	ipiv := make([]int, n)
+	impl.Zgetrf(n, n, a.Data, lda, ipiv)
+
+	// Compute the inverse of A explicitly to obtain the exact condition
+	// number.
+	ainv := eyeC(n, n)
+	impl.Zgetrs(blas.NoTrans, n, n, a.Data, lda, ipiv, ainv.Data, ainv.Stride)
+	ainvnorm := impl.Zlange(norm, n, n, ainv.Data, ainv.Stride, work)
+	want := 1 / anorm / ainvnorm
+
+	got := impl.Zgecon(norm, n, a.Data, lda, anorm, nanCSlice(2*n))
+
+	// The estimate of the norm of the inverse is a lower bound, so the
+	// estimated reciprocal condition number cannot be much smaller than the
+	// exact value, and in practice it is within a small factor.
+	if got < want*(1-1e-12) || got > 10*want {
+		t.Errorf("%v: unexpected reciprocal condition number: got %v, want %v", name, got, want)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zgeever interface {
+	Zgeev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []complex128, lda int, w []complex128, vl []complex128, ldvl int, vr []complex128, ldvr int, work []complex128, lwork int, rwork []float64) (first int)
+}
+
+func ZgeevTest(t *testing.T, impl Zgeever) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 50, 100} {
+		for _, kind := range []string{"random", "triangular", "block", "zero"} {
+			var a cblas128.General
+			switch kind {
+			case "random":
+				a = randomCGeneral(n, n, n+3, rnd)
+			case "triangular":
+				// An upper triangular matrix has all eigenvalues isolated
+				// by the balancing permutation.
+				a = randomCGeneral(n, n, n+3, rnd)
+				for i := 0; i < n; i++ {
+					for j := 0; j < i; j++ {
+						a.Data[i*a.Stride+j] = 0
+					}
+				}
+			case "block":
+				// A matrix with a zero off-diagonal block so that some
+				// eigenvalues are isolated and some are not.
+				a = randomCGeneral(n, n, n+3, rnd)
+				for i := n / 2; i < n; i++ {
+					for j := 0; j < n/2; j++ {
+						a.Data[i*a.Stride+j] = 0
+					}
+				}
+			case "zero":
+				a = zeroCGeneral(n, n, n+3)
+			}
+			zgeevTest(t, impl, rnd, kind, a)
+		}
+	}
+}
+
+func zgeevTest(t *testing.T, impl Zgeever, rnd *rand.Rand, kind string, a cblas128.General) {
+	const tol = 1e-12
+
+	n := a.Rows
+	name := fmt.Sprintf("kind=%v,n=%d", kind, n)
+	anorm := math.Max(1, zmaxAbs(n, n, a.Data, a.Stride))
+
+	// Compute the eigenvalues and both left and right eigenvectors.
+	aCopy := cloneCGeneral(a)
+	w := make([]complex128, n)
+	vl := nanCGeneral(n, n, n+5)
+	vr := nanCGeneral(n, n, n+7)
+	work := make([]complex128, 1)
+	impl.Zgeev(lapack.LeftEVCompute, lapack.RightEVCompute, n, aCopy.Data, aCopy.Stride, w, vl.Data, vl.Stride, vr.Data, vr.Stride, work, -1, nil)
+	work = nanCSlice(max(1, int(real(work[0]))))
+	rwork := make([]float64, 2*n)
+	first := impl.Zgeev(lapack.LeftEVCompute, lapack.RightEVCompute, n, aCopy.Data, aCopy.Stride, w, vl.Data, vl.Stride, vr.Data, vr.Stride, work, len(work), rwork)
+	if first != 0 {
+		t.Errorf("%v: Zgeev did not converge, first = %d", name, first)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	// Check that A*VR = VR*W.
+	avr := zmul(blas.NoTrans, a, blas.NoTrans, vr)
+	for j := 0; j < n; j++ {
+		for i := 0; i < n; i++ {
+			avr.Data[i*avr.Stride+j] -= vr.Data[i*vr.Stride+j] * w[j]
+		}
+	}
+	if resid := zmaxAbs(n, n, avr.Data, avr.Stride); resid > tol*float64(n)*anorm {
+		t.Errorf("%v: A*VR != VR*W, |resid| = %v", name, resid)
+	}
+
+	// Check that VL^H*A = W*VL^H.
+	vlha := zmul(blas.ConjTrans, vl, blas.NoTrans, a)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			vlha.Data[i*vlha.Stride+j] -= w[i] * cmplx.Conj(vl.Data[j*vl.Stride+i])
+		}
+	}
+	if resid := zmaxAbs(n, n, vlha.Data, vlha.Stride); resid > tol*float64(n)*anorm {
+		t.Errorf("%v: VL^H*A != W*VL^H, |resid| = %v", name, resid)
+	}
+
+	// Check the normalization of the eigenvectors.
+	for _, v := range []cblas128.General{vl, vr} {
+		for j := 0; j < n; j++ {
+			var nrm, vmax float64
+			imax := 0
+			for i := 0; i < n; i++ {
+				a := cmplx.Abs(v.Data[i*v.Stride+j])
+				nrm += a * a
+				if a > vmax {
+					vmax = a
+					imax = i
+				}
+			}
+			if math.Abs(math.Sqrt(nrm)-1) > tol {
+				t.Errorf("%v: eigenvector %d not normalized", name, j)
+			}
+			if im := imag(v.Data[imax*v.Stride+j]); math.Abs(im) > tol {
+				t.Errorf("%v: largest component of eigenvector %d not real", name, j)
+			}
+		}
+	}
+
+	// Check that the eigenvalues computed alone match.
+	aCopy = cloneCGeneral(a)
+	wNone := make([]complex128, n)
+	work = nanCSlice(max(1, 2*n))
+	first = impl.Zgeev(lapack.LeftEVNone, lapack.RightEVNone, n, aCopy.Data, aCopy.Stride, wNone, nil, 1, nil, 1, work, len(work), rwork)
+	if first != 0 {
+		t.Errorf("%v: Zgeev did not converge without eigenvectors, first = %d", name, first)
+		return
+	}
+	used := make([]bool, n)
+	for _, want := range w {
+		found := false
+		for j, got := range wNone {
+			if !used[j] && cmplx.Abs(got-want) <= 1e-10*anorm {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%v: eigenvalue %v not found when computing without eigenvectors", name, want)
+		}
+	}
+}
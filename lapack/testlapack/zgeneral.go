@@ -0,0 +1,219 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"math"
+	"math/cmplx"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// nanCSlice allocates a new slice of length n filled with NaN.
+func nanCSlice(n int) []complex128 {
+	s := make([]complex128, n)
+	for i := range s {
+		s[i] = cmplx.NaN()
+	}
+	return s
+}
+
+// nanCGeneral allocates a new r×c complex general matrix filled with NaN
+// values.
+func nanCGeneral(r, c, stride int) cblas128.General {
+	if r < 0 || c < 0 {
+		panic("bad matrix size")
+	}
+	if r == 0 || c == 0 {
+		return cblas128.General{Stride: max(1, stride)}
+	}
+	if stride < c {
+		panic("bad stride")
+	}
+	return cblas128.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: stride,
+		Data:   nanCSlice((r-1)*stride + c),
+	}
+}
+
+// randomCGeneral allocates a new r×c complex general matrix filled with
+// random numbers. Out-of-range elements are filled with NaN values.
+func randomCGeneral(r, c, stride int, rnd *rand.Rand) cblas128.General {
+	ans := nanCGeneral(r, c, stride)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			ans.Data[i*ans.Stride+j] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+	}
+	return ans
+}
+
+// randomHPD allocates a new n×n Hermitian positive definite matrix with
+// well-conditioned random elements. Out-of-range elements are filled with NaN
+// values.
+func randomHPD(n, stride int, rnd *rand.Rand) cblas128.General {
+	b := randomCGeneral(n, n, n, rnd)
+	a := nanCGeneral(n, n, stride)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Data[i*a.Stride+j] = 0
+		}
+	}
+	if n == 0 {
+		return a
+	}
+	cblas128.Gemm(blas.ConjTrans, blas.NoTrans, 1, b, b, 0, a)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] = complex(real(a.Data[i*a.Stride+i])+float64(n), 0)
+	}
+	return a
+}
+
+// randomHermitian allocates a new n×n Hermitian matrix with random elements.
+// Out-of-range elements are filled with NaN values.
+func randomHermitian(n, stride int, rnd *rand.Rand) cblas128.General {
+	a := nanCGeneral(n, n, stride)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] = complex(rnd.NormFloat64(), 0)
+		for j := i + 1; j < n; j++ {
+			v := complex(rnd.NormFloat64(), rnd.NormFloat64())
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = cmplx.Conj(v)
+		}
+	}
+	return a
+}
+
+// cloneCGeneral allocates and returns an exact copy of the given complex
+// general matrix.
+func cloneCGeneral(a cblas128.General) cblas128.General {
+	c := a
+	c.Data = make([]complex128, len(a.Data))
+	copy(c.Data, a.Data)
+	return c
+}
+
+// zeroCGeneral allocates a new r×c complex general matrix filled with zeros.
+func zeroCGeneral(r, c, stride int) cblas128.General {
+	return cblas128.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: max(1, stride),
+		Data:   make([]complex128, max(0, (r-1)*max(1, stride)+c)),
+	}
+}
+
+// eyeC returns an n×n complex identity matrix with the given stride.
+func eyeC(n, stride int) cblas128.General {
+	a := zeroCGeneral(n, n, stride)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] = 1
+	}
+	return a
+}
+
+// zmul returns the product op(a) * op(b) where op is determined by ta and tb.
+func zmul(ta blas.Transpose, a cblas128.General, tb blas.Transpose, b cblas128.General) cblas128.General {
+	m, k := a.Rows, a.Cols
+	if ta != blas.NoTrans {
+		m, k = k, m
+	}
+	n := b.Cols
+	if tb != blas.NoTrans {
+		n = b.Rows
+	}
+	c := zeroCGeneral(m, n, n)
+	if m == 0 || n == 0 || k == 0 {
+		return c
+	}
+	cblas128.Gemm(ta, tb, 1, a, b, 0, c)
+	return c
+}
+
+// zdistFromIdentity returns the maximum element-wise distance of the n×n
+// complex matrix A from the identity.
+func zdistFromIdentity(n int, a []complex128, lda int) float64 {
+	var dist float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aij := a[i*lda+j]
+			if cmplx.IsNaN(aij) {
+				return math.Inf(1)
+			}
+			if i == j {
+				dist = math.Max(dist, cmplx.Abs(aij-1))
+			} else {
+				dist = math.Max(dist, cmplx.Abs(aij))
+			}
+		}
+	}
+	return dist
+}
+
+// hasOrthonormalCColumns returns whether the columns of the complex matrix Q
+// are orthonormal.
+func hasOrthonormalCColumns(q cblas128.General, tol float64) bool {
+	if q.Cols > q.Rows {
+		return false
+	}
+	qhq := zmul(blas.ConjTrans, q, blas.NoTrans, q)
+	return zdistFromIdentity(q.Cols, qhq.Data, qhq.Stride) <= tol
+}
+
+// hasOrthonormalCRows returns whether the rows of the complex matrix Q are
+// orthonormal.
+func hasOrthonormalCRows(q cblas128.General, tol float64) bool {
+	if q.Rows > q.Cols {
+		return false
+	}
+	qqh := zmul(blas.NoTrans, q, blas.ConjTrans, q)
+	return zdistFromIdentity(q.Rows, qqh.Data, qqh.Stride) <= tol
+}
+
+// zmaxAbsDiff returns the maximum absolute difference between the elements of
+// the m×n complex matrices A and B.
+func zmaxAbsDiff(m, n int, a []complex128, lda int, b []complex128, ldb int) float64 {
+	var diff float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			d := cmplx.Abs(a[i*lda+j] - b[i*ldb+j])
+			if math.IsNaN(d) {
+				return math.Inf(1)
+			}
+			diff = math.Max(diff, d)
+		}
+	}
+	return diff
+}
+
+// zmaxAbs returns the largest absolute value of the elements of the m×n
+// complex matrix A.
+func zmaxAbs(m, n int, a []complex128, lda int) float64 {
+	var v float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			v = math.Max(v, cmplx.Abs(a[i*lda+j]))
+		}
+	}
+	return v
+}
+
+// cgeneralOutsideAllNaN returns whether all elements in the padding of the
+// complex matrix A are NaN.
+func cgeneralOutsideAllNaN(a cblas128.General) bool {
+	for i := 0; i < a.Rows-1; i++ {
+		for _, v := range a.Data[i*a.Stride+a.Cols : (i+1)*a.Stride] {
+			if !cmplx.IsNaN(v) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zgeqrfer interface {
+	Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Zung2r(m, n, k int, a []complex128, lda int, tau, work []complex128)
+}
+
+func ZgeqrfTest(t *testing.T, impl Zgeqrfer) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, lda int
+	}{
+		{0, 0, 0},
+		{1, 1, 0},
+		{10, 5, 0},
+		{5, 10, 0},
+		{10, 10, 0},
+		{300, 5, 0},
+		{3, 500, 0},
+		{200, 200, 0},
+		{300, 200, 0},
+		{204, 300, 0},
+		{10, 5, 20},
+		{5, 10, 20},
+		{200, 200, 300},
+		{204, 300, 400},
+	} {
+		m := test.m
+		n := test.n
+		lda := test.lda
+		if lda == 0 {
+			lda = max(1, n)
+		}
+		k := min(m, n)
+		name := fmt.Sprintf("m=%d,n=%d,lda=%d", m, n, lda)
+
+		a := randomCGeneral(m, n, lda, rnd)
+		aCopy := cloneCGeneral(a)
+		tau := nanCSlice(k)
+
+		// Query and allocate the optimal workspace.
+		work := make([]complex128, 1)
+		impl.Zgeqrf(m, n, a.Data, lda, tau, work, -1)
+		lwork := int(real(work[0]))
+		work = nanCSlice(lwork)
+
+		impl.Zgeqrf(m, n, a.Data, lda, tau, work, lwork)
+
+		if !cgeneralOutsideAllNaN(a) {
+			t.Errorf("%v: out-of-range elements of A modified", name)
+		}
+		if k == 0 {
+			continue
+		}
+
+		// Extract R.
+		r := zeroCGeneral(k, n, n)
+		for i := 0; i < k; i++ {
+			copy(r.Data[i*n+i:i*n+n], a.Data[i*lda+i:i*lda+n])
+		}
+
+		// Generate the first k columns of Q.
+		q := zeroCGeneral(m, k, k)
+		for i := 0; i < m; i++ {
+			for j := 0; j < k; j++ {
+				q.Data[i*k+j] = a.Data[i*lda+j]
+			}
+		}
+		impl.Zung2r(m, k, k, q.Data, q.Stride, tau, make([]complex128, k))
+		if !hasOrthonormalCColumns(q, tol*float64(m)) {
+			t.Errorf("%v: Q does not have orthonormal columns", name)
+		}
+
+		qr := zmul(blas.NoTrans, q, blas.NoTrans, r)
+		diff := zmaxAbsDiff(m, n, qr.Data, qr.Stride, aCopy.Data, aCopy.Stride)
+		if diff > tol*float64(max(m, n)) {
+			t.Errorf("%v: Q*R does not match A, |Q*R - A| = %v", name, diff)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zgesvder interface {
+	Zgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) (ok bool)
+}
+
+func ZgesvdTest(t *testing.T, impl Zgesvder) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 50, 120} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+			zgesvdTest(t, impl, rnd, m, n)
+		}
+	}
+}
+
+// zgesvdTest tests a Zgesvd implementation on a random m×n complex matrix A.
+// It first computes the full SVD A = U*Sigma*V^H and checks that
+//  - U and V are unitary,
+//  - U*Sigma*V^H multiply back to A,
+//  - the singular values are non-negative and sorted in decreasing order.
+// Then all combinations of partial SVD results are computed and checked
+// whether they match the full SVD result.
+func zgesvdTest(t *testing.T, impl Zgesvder, rnd *rand.Rand, m, n int) {
+	const tol = 1e-12
+
+	lda := n + 3
+	ldu := m + 5
+	ldvt := n + 7
+	minmn := min(m, n)
+
+	a := randomCGeneral(m, n, lda, rnd)
+	aNorm := zmaxAbs(m, n, a.Data, lda)
+
+	// Compute the full SVD.
+	aCopy := cloneCGeneral(a)
+	s := make([]float64, minmn)
+	u := nanCGeneral(m, m, ldu)
+	vt := nanCGeneral(n, n, ldvt)
+	work, rwork := zgesvdWork(impl, lapack.SVDAll, lapack.SVDAll, m, n)
+	ok := impl.Zgesvd(lapack.SVDAll, lapack.SVDAll, m, n, aCopy.Data, lda, s, u.Data, ldu, vt.Data, ldvt, work, len(work), rwork)
+	prefix := fmt.Sprintf("m=%d,n=%d", m, n)
+	if !ok {
+		t.Errorf("%v: Zgesvd did not converge", prefix)
+		return
+	}
+	if minmn == 0 {
+		return
+	}
+
+	if !hasOrthonormalCColumns(u, tol*float64(m)) {
+		t.Errorf("%v: U is not unitary", prefix)
+	}
+	if !hasOrthonormalCRows(vt, tol*float64(n)) {
+		t.Errorf("%v: V^H is not unitary", prefix)
+	}
+
+	// Check that U*Sigma*V^H multiply back to A.
+	us := zeroCGeneral(m, n, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < minmn; j++ {
+			us.Data[i*n+j] = u.Data[i*ldu+j] * complex(s[j], 0)
+		}
+	}
+	usvh := zmul(blas.NoTrans, us, blas.NoTrans, vt)
+	resid := zmaxAbsDiff(m, n, usvh.Data, usvh.Stride, a.Data, lda)
+	if resid > tol*float64(max(m, n))*math.Max(1, aNorm) {
+		t.Errorf("%v: U*Sigma*V^H does not match A, |diff| = %v", prefix, resid)
+	}
+
+	for i, v := range s {
+		if v < 0 {
+			t.Errorf("%v: singular value %d is negative: %v", prefix, i, v)
+		}
+		if i > 0 && v > s[i-1] {
+			t.Errorf("%v: singular values not sorted in decreasing order", prefix)
+			break
+		}
+	}
+
+	// Check that all partial combinations return the same results.
+	for _, jobU := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDNone} {
+		for _, jobVT := range []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDNone} {
+			name := fmt.Sprintf("%v,jobU=%v,jobVT=%v", prefix, svdJobString(jobU), svdJobString(jobVT))
+
+			aPartial := cloneCGeneral(a)
+			sPartial := make([]float64, minmn)
+			var uPartial, vtPartial []complex128
+			ucol := 0
+			switch jobU {
+			case lapack.SVDAll:
+				ucol = m
+			case lapack.SVDStore:
+				ucol = minmn
+			}
+			if ucol > 0 {
+				uPartial = nanCGeneral(m, ucol, ldu).Data
+			}
+			vtrow := 0
+			switch jobVT {
+			case lapack.SVDAll:
+				vtrow = n
+			case lapack.SVDStore:
+				vtrow = minmn
+			}
+			if vtrow > 0 {
+				vtPartial = nanCGeneral(vtrow, n, ldvt).Data
+			}
+			work, rwork := zgesvdWork(impl, jobU, jobVT, m, n)
+			ok := impl.Zgesvd(jobU, jobVT, m, n, aPartial.Data, lda, sPartial, uPartial, ldu, vtPartial, ldvt, work, len(work), rwork)
+			if !ok {
+				t.Errorf("%v: Zgesvd did not converge", name)
+				continue
+			}
+
+			for i := range s {
+				if math.Abs(s[i]-sPartial[i]) > tol*math.Max(1, s[0]) {
+					t.Errorf("%v: singular values differ from full SVD", name)
+					break
+				}
+			}
+			// The singular vectors are unique up to a unit-modulus factor
+			// for distinct singular values. Since the computation is the
+			// same, they are compared directly.
+			if ucol > 0 {
+				if diff := zmaxAbsDiff(m, ucol, uPartial, ldu, u.Data, ldu); diff > tol {
+					t.Errorf("%v: U differs from full SVD, |diff| = %v", name, diff)
+				}
+			}
+			if vtrow > 0 {
+				if diff := zmaxAbsDiff(vtrow, n, vtPartial, ldvt, vt.Data, ldvt); diff > tol {
+					t.Errorf("%v: V^H differs from full SVD, |diff| = %v", name, diff)
+				}
+			}
+		}
+	}
+}
+
+// zgesvdWork allocates the optimal work and rwork slices for Zgesvd.
+func zgesvdWork(impl Zgesvder, jobU, jobVT lapack.SVDJob, m, n int) (work []complex128, rwork []float64) {
+	minmn := min(m, n)
+	maxmn := max(m, n)
+	work = make([]complex128, 1)
+	impl.Zgesvd(jobU, jobVT, m, n, nil, max(1, n), nil, nil, max(1, m), nil, max(1, n), work, -1, nil)
+	work = nanCSlice(max(1, int(real(work[0]))))
+	if jobU == lapack.SVDNone && jobVT == lapack.SVDNone {
+		rwork = make([]float64, max(1, 5*minmn))
+	} else {
+		rwork = make([]float64, max(1, minmn*(2*minmn+2*maxmn+1)))
+	}
+	return work, rwork
+}
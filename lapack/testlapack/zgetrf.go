@@ -0,0 +1,118 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+type Zgetrfer interface {
+	Zgetrf(m, n int, a []complex128, lda int, ipiv []int) bool
+}
+
+func ZgetrfTest(t *testing.T, impl Zgetrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, lda int
+	}{
+		{0, 0, 0},
+		{1, 1, 0},
+		{10, 5, 0},
+		{5, 10, 0},
+		{10, 10, 0},
+		{300, 5, 0},
+		{3, 500, 0},
+		{300, 200, 0},
+		{204, 300, 0},
+		{10, 5, 20},
+		{5, 10, 20},
+		{10, 10, 20},
+		{200, 200, 300},
+		{204, 300, 400},
+	} {
+		m := test.m
+		n := test.n
+		lda := test.lda
+		if lda == 0 {
+			lda = max(1, n)
+		}
+		a := randomCGeneral(m, n, lda, rnd)
+		aCopy := cloneCGeneral(a)
+		ipiv := make([]int, min(m, n))
+		for i := range ipiv {
+			ipiv[i] = rnd.Int()
+		}
+		ok := impl.Zgetrf(m, n, a.Data, a.Stride, ipiv)
+		name := fmt.Sprintf("m=%d,n=%d,lda=%d", m, n, lda)
+		checkZPLU(t, name, ok, a, aCopy, ipiv, 1e-12)
+	}
+}
+
+// checkZPLU checks that the complex PLU factorization contained in
+// factorized matches the original matrix.
+func checkZPLU(t *testing.T, name string, ok bool, factorized, original cblas128.General, ipiv []int, tol float64) {
+	m, n := factorized.Rows, factorized.Cols
+	mn := min(m, n)
+	lda := factorized.Stride
+
+	var hasZeroDiagonal bool
+	for i := 0; i < mn; i++ {
+		if factorized.Data[i*lda+i] == 0 {
+			hasZeroDiagonal = true
+			break
+		}
+	}
+	if hasZeroDiagonal && ok {
+		t.Errorf("%v: has a zero diagonal but returned ok", name)
+	}
+	if !hasZeroDiagonal && !ok {
+		t.Errorf("%v: non-zero diagonal but returned !ok", name)
+	}
+	if !cgeneralOutsideAllNaN(factorized) {
+		t.Errorf("%v: out-of-range elements of A modified", name)
+	}
+	if mn == 0 {
+		return
+	}
+
+	l := zeroCGeneral(m, mn, mn)
+	u := zeroCGeneral(mn, n, n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			v := factorized.Data[i*lda+j]
+			switch {
+			case i == j:
+				l.Data[i*l.Stride+i] = 1
+				u.Data[i*u.Stride+i] = v
+			case i > j:
+				l.Data[i*l.Stride+j] = v
+			default:
+				u.Data[i*u.Stride+j] = v
+			}
+		}
+	}
+	lu := zmul(blas.NoTrans, l, blas.NoTrans, u)
+
+	// Apply the row interchanges in reverse order to recover P*L*U.
+	for i := len(ipiv) - 1; i >= 0; i-- {
+		v := ipiv[i]
+		if v < 0 || v >= m {
+			t.Errorf("%v: invalid pivot index %d at %d", name, v, i)
+			return
+		}
+		cblas128.Swap(n, cblas128.Vector{Inc: 1, Data: lu.Data[i*lu.Stride:]},
+			cblas128.Vector{Inc: 1, Data: lu.Data[v*lu.Stride:]})
+	}
+	diff := zmaxAbsDiff(m, n, lu.Data, lu.Stride, original.Data, original.Stride)
+	if diff > tol*float64(max(m, n)) {
+		t.Errorf("%v: PLU multiplication does not match original matrix, |P*L*U - A| = %v", name, diff)
+	}
+}
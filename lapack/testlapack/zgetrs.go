@@ -0,0 +1,76 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zgetrser interface {
+	Zgetrfer
+	Zgetrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int)
+}
+
+func ZgetrsTest(t *testing.T, impl Zgetrser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+		for _, test := range []struct {
+			n, nrhs, lda, ldb int
+		}{
+			{1, 1, 0, 0},
+			{3, 3, 0, 0},
+			{3, 5, 0, 0},
+			{5, 3, 0, 0},
+			{10, 2, 0, 0},
+			{100, 10, 0, 0},
+			{3, 3, 8, 10},
+			{3, 5, 8, 10},
+			{5, 3, 8, 10},
+			{100, 10, 120, 30},
+		} {
+			n := test.n
+			nrhs := test.nrhs
+			lda := test.lda
+			if lda == 0 {
+				lda = n
+			}
+			ldb := test.ldb
+			if ldb == 0 {
+				ldb = nrhs
+			}
+			name := fmt.Sprintf("trans=%v,n=%d,nrhs=%d,lda=%d,ldb=%d", trans, n, nrhs, lda, ldb)
+
+			a := randomCGeneral(n, n, lda, rnd)
+			// Make A diagonally dominant so that it is well-conditioned.
+			for i := 0; i < n; i++ {
+				a.Data[i*lda+i] += complex(float64(2*n), 0)
+			}
+			aCopy := cloneCGeneral(a)
+			want := randomCGeneral(n, nrhs, ldb, rnd)
+			b := zmul(trans, aCopy, blas.NoTrans, want)
+			bPad := nanCGeneral(n, nrhs, ldb)
+			for i := 0; i < n; i++ {
+				copy(bPad.Data[i*ldb:i*ldb+nrhs], b.Data[i*b.Stride:])
+			}
+
+			ipiv := make([]int, n)
+			impl.Zgetrf(n, n, a.Data, lda, ipiv)
+			impl.Zgetrs(trans, n, nrhs, a.Data, lda, ipiv, bPad.Data, ldb)
+
+			if !cgeneralOutsideAllNaN(bPad) {
+				t.Errorf("%v: out-of-range elements of B modified", name)
+			}
+			diff := zmaxAbsDiff(n, nrhs, bPad.Data, ldb, want.Data, ldb)
+			if diff > 1e-12 {
+				t.Errorf("%v: unexpected solution, |X - want| = %v", name, diff)
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Zheever interface {
+	Zheev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool)
+}
+
+func ZheevTest(t *testing.T, impl Zheever) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20, 50, 100} {
+			for _, lda := range []int{n, n + 5} {
+				zheevTest(t, impl, rnd, uplo, n, max(1, lda))
+			}
+		}
+	}
+}
+
+func zheevTest(t *testing.T, impl Zheever, rnd *rand.Rand, uplo blas.Uplo, n, lda int) {
+	const tol = 1e-12
+	name := fmt.Sprintf("uplo=%v,n=%d,lda=%d", uplo, n, lda)
+
+	a := randomHermitian(n, lda, rnd)
+	// Destroy the triangle that should not be referenced.
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+				a.Data[i*lda+j] = complex(math.NaN(), math.NaN())
+			}
+		}
+	}
+	aCopy := cloneCGeneral(a)
+	// Recover the full Hermitian matrix for the residual checks.
+	full := zeroCGeneral(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i) {
+				full.Data[i*n+j] = a.Data[i*lda+j]
+				full.Data[j*n+i] = complex(real(a.Data[i*lda+j]), -imag(a.Data[i*lda+j]))
+			}
+		}
+	}
+
+	// Compute the eigenvalues and eigenvectors.
+	w := make([]float64, n)
+	work := make([]complex128, 1)
+	impl.Zheev(lapack.EVCompute, uplo, n, a.Data, lda, w, work, -1, nil)
+	work = nanCSlice(int(real(work[0])))
+	rwork := make([]float64, n+3*n*n)
+	ok := impl.Zheev(lapack.EVCompute, uplo, n, a.Data, lda, w, work, len(work), rwork)
+	if !ok {
+		t.Errorf("%v: Zheev did not converge", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	for i := 1; i < n; i++ {
+		if w[i] < w[i-1] {
+			t.Errorf("%v: eigenvalues not sorted in ascending order", name)
+			break
+		}
+	}
+
+	z := cloneCGeneral(a)
+	if !hasOrthonormalCColumns(z, tol*float64(n)) {
+		t.Errorf("%v: eigenvectors are not orthonormal", name)
+	}
+
+	// Check that A * Z = Z * diag(w).
+	az := zmul(blas.NoTrans, full, blas.NoTrans, z)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			z.Data[i*z.Stride+j] *= complex(w[j], 0)
+		}
+	}
+	resid := zmaxAbsDiff(n, n, az.Data, az.Stride, z.Data, z.Stride)
+	if resid > tol*float64(n)*math.Max(1, zmaxAbs(n, n, full.Data, n)) {
+		t.Errorf("%v: A*Z != Z*W, |diff| = %v", name, resid)
+	}
+
+	// Check that the eigenvalues computed without eigenvectors match.
+	wNone := make([]float64, n)
+	work = make([]complex128, max(1, 2*n-1))
+	rwork = make([]float64, max(1, n-1))
+	ok = impl.Zheev(lapack.EVNone, uplo, n, aCopy.Data, lda, wNone, work, len(work), rwork)
+	if !ok {
+		t.Errorf("%v: Zheev did not converge without eigenvectors", name)
+		return
+	}
+	for i := range w {
+		if math.Abs(w[i]-wNone[i]) > tol*math.Max(1, math.Abs(w[i])) {
+			t.Errorf("%v: eigenvalue mismatch at %d: %v vs %v", name, i, w[i], wNone[i])
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zpotrfer interface {
+	Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+}
+
+func ZpotrfTest(t *testing.T, impl Zpotrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 31, 64, 65, 100, 200} {
+			for _, lda := range []int{n, n + 7} {
+				lda = max(1, lda)
+				zpotrfTest(t, impl, rnd, uplo, n, lda)
+			}
+		}
+	}
+
+	// Check that a non-positive-definite matrix is detected.
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		a := []complex128{
+			1, 2 + 1i,
+			2 - 1i, 1,
+		}
+		if impl.Zpotrf(uplo, 2, a, 2) {
+			t.Errorf("uplo=%v: Zpotrf returned ok for an indefinite matrix", uplo)
+		}
+	}
+}
+
+func zpotrfTest(t *testing.T, impl Zpotrfer, rnd *rand.Rand, uplo blas.Uplo, n, lda int) {
+	name := fmt.Sprintf("uplo=%v,n=%d,lda=%d", uplo, n, lda)
+
+	a := randomHPD(n, lda, rnd)
+	aCopy := cloneCGeneral(a)
+
+	ok := impl.Zpotrf(uplo, n, a.Data, lda)
+	if !ok {
+		t.Errorf("%v: unexpected failure for a positive definite matrix", name)
+		return
+	}
+	if !cgeneralOutsideAllNaN(a) {
+		t.Errorf("%v: out-of-range elements of A modified", name)
+	}
+	if n == 0 {
+		return
+	}
+
+	// Extract the triangular factor, zeroing the opposite triangle.
+	f := zeroCGeneral(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i) {
+				f.Data[i*n+j] = a.Data[i*lda+j]
+			}
+		}
+		if imag(f.Data[i*n+i]) != 0 || real(f.Data[i*n+i]) <= 0 {
+			t.Errorf("%v: diagonal element %d not real positive: %v", name, i, f.Data[i*n+i])
+		}
+	}
+
+	// Reconstruct A as U^H*U or L*L^H.
+	var got = f
+	if uplo == blas.Upper {
+		got = zmul(blas.ConjTrans, f, blas.NoTrans, f)
+	} else {
+		got = zmul(blas.NoTrans, f, blas.ConjTrans, f)
+	}
+	var diff float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+				continue
+			}
+			if d := cmplx.Abs(got.Data[i*n+j] - aCopy.Data[i*lda+j]); d > diff {
+				diff = d
+			}
+		}
+	}
+	if diff > 1e-12*float64(n) {
+		t.Errorf("%v: factorization does not reconstruct A, |diff| = %v", name, diff)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Ztrconer interface {
+	Zlange(norm lapack.MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64
+	Ztrcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []complex128, lda int, work []complex128, rwork []float64) float64
+}
+
+func ZtrconTest(t *testing.T, impl Ztrconer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 5, 10, 50} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+					ztrconTest(t, impl, rnd, n, n+2, uplo, diag, norm)
+				}
+			}
+		}
+	}
+}
+
+func ztrconTest(t *testing.T, impl Ztrconer, rnd *rand.Rand, n, lda int, uplo blas.Uplo, diag blas.Diag, norm lapack.MatrixNorm) {
+	name := fmt.Sprintf("n=%d,uplo=%v,diag=%v,norm=%v", n, uplo, diag, string(norm))
+
+	a := randomCGeneral(n, n, lda, rnd)
+	// Make the triangle diagonally dominant and form the explicit
+	// triangular matrix.
+	tri := zeroCGeneral(n, n, n)
+	for i := 0; i < n; i++ {
+		a.Data[i*lda+i] += complex(float64(n), 0)
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && j > i) || (uplo == blas.Lower && j < i) {
+				tri.Data[i*n+j] = a.Data[i*lda+j]
+			}
+		}
+		if diag == blas.Unit {
+			tri.Data[i*n+i] = 1
+		} else {
+			tri.Data[i*n+i] = a.Data[i*lda+i]
+		}
+	}
+
+	work := make([]float64, n)
+	anorm := impl.Zlange(norm, n, n, tri.Data, n, work)
+	ainv := eyeC(n, n)
+	cblas128.Trsm(blas.Left, blas.NoTrans, 1, cblas128.Triangular{
+		N:      n,
+		Stride: n,
+		Data:   tri.Data,
+		Uplo:   uplo,
+		Diag:   blas.NonUnit,
+	}, ainv)
+	ainvnorm := impl.Zlange(norm, n, n, ainv.Data, n, work)
+	want := 1 / anorm / ainvnorm
+
+	got := impl.Ztrcon(norm, uplo, diag, n, a.Data, lda, nanCSlice(2*n), make([]float64, n))
+	if got < want*(1-1e-12) || got > 10*want {
+		t.Errorf("%v: unexpected reciprocal condition number: got %v, want %v", name, got, want)
+	}
+}
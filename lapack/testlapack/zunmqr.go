@@ -0,0 +1,106 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Zunmqrer interface {
+	Zgeqr2(m, n int, a []complex128, lda int, tau, work []complex128)
+	Zung2r(m, n, k int, a []complex128, lda int, tau, work []complex128)
+	Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int)
+}
+
+func ZunmqrTest(t *testing.T, impl Zunmqrer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, side := range []blas.Side{blas.Left, blas.Right} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+			for _, test := range []struct {
+				common, adim, k int
+			}{
+				{3, 4, 2},
+				{5, 4, 3},
+				{10, 8, 4},
+				{150, 140, 100},
+				{250, 200, 130},
+				{160, 170, 60},
+				{200, 130, 130},
+			} {
+				for _, wl := range []string{"minimum", "optimum"} {
+					zunmqrTest(t, impl, rnd, side, trans, test.common, test.adim, test.k, wl)
+				}
+			}
+		}
+	}
+}
+
+func zunmqrTest(t *testing.T, impl Zunmqrer, rnd *rand.Rand, side blas.Side, trans blas.Transpose, common, adim, k int, wl string) {
+	const tol = 1e-12
+
+	// Q is nq×nq where nq is the order of the orthogonal factor.
+	var m, n, nq int
+	if side == blas.Left {
+		m, n, nq = common, adim, common
+	} else {
+		m, n, nq = adim, common, common
+	}
+	name := fmt.Sprintf("side=%v,trans=%v,m=%d,n=%d,k=%d,work=%v", side, trans, m, n, k, wl)
+
+	lda := k + 3
+	ldc := n + 5
+
+	a := randomCGeneral(nq, k, lda, rnd)
+	tau := make([]complex128, k)
+	impl.Zgeqr2(nq, k, a.Data, lda, tau, make([]complex128, k))
+
+	// Form the full Q explicitly.
+	q := zeroCGeneral(nq, nq, nq)
+	for i := 0; i < nq; i++ {
+		for j := 0; j < k; j++ {
+			q.Data[i*nq+j] = a.Data[i*lda+j]
+		}
+	}
+	impl.Zung2r(nq, nq, k, q.Data, nq, tau, make([]complex128, nq))
+
+	c := randomCGeneral(m, n, ldc, rnd)
+	cCopy := cloneCGeneral(c)
+
+	var want = c
+	if side == blas.Left {
+		want = zmul(trans, q, blas.NoTrans, cCopy)
+	} else {
+		want = zmul(blas.NoTrans, cCopy, trans, q)
+	}
+
+	var lwork int
+	switch wl {
+	case "minimum":
+		if side == blas.Left {
+			lwork = max(1, n)
+		} else {
+			lwork = max(1, m)
+		}
+	case "optimum":
+		work := make([]complex128, 1)
+		impl.Zunmqr(side, trans, m, n, k, a.Data, lda, tau, c.Data, ldc, work, -1)
+		lwork = int(real(work[0]))
+	}
+	work := nanCSlice(lwork)
+	impl.Zunmqr(side, trans, m, n, k, a.Data, lda, tau, c.Data, ldc, work, lwork)
+
+	if !cgeneralOutsideAllNaN(c) {
+		t.Errorf("%v: out-of-range elements of C modified", name)
+	}
+	diff := zmaxAbsDiff(m, n, c.Data, ldc, want.Data, want.Stride)
+	if diff > tol*float64(nq) {
+		t.Errorf("%v: unexpected result, |got - want| = %v", name, diff)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+// BiCGStab implements the BiConjugate Gradient Stabilized method with
+// right preconditioning for solving systems of linear equations
+//  A * x = b,
+// where A is a general non-singular matrix.
+//
+// BiCGStab does not require multiplication by A^T and has fixed storage
+// and work per iteration. Its convergence may be irregular and the method
+// may break down.
+//
+// References:
+//  - Barrett, R. et al. (1994). Section 2.3.8 BiConjugate Gradient Stabilized
+//    (Bi-CGSTAB). In Templates for the Solution of Linear Systems: Building
+//    Blocks for Iterative Methods (2nd ed.) (pp. 24-25). Philadelphia, PA:
+//    SIAM. Retrieved from http://www.netlib.org/templates/templates.pdf
+//  - van der Vorst, H. (1992). Bi-CGSTAB: A fast and smoothly converging
+//    variant of Bi-CG for the solution of nonsymmetric linear systems. SIAM
+//    J. Sci. Stat. Comput., 13(2), 631. https://doi.org/10.1137/0913035
+type BiCGStab struct {
+	x, r, rt, p, v, s, phat, shat mat.VecDense
+
+	rho, rhoPrev float64
+	alpha, omega float64
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface
+// for more details.
+func (b *BiCGStab) Init(x, residual mat.Vector) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("bicgstab: vector length mismatch")
+	}
+
+	b.x.CloneVec(x)
+	b.r.CloneVec(residual)
+	b.rt.CloneVec(residual)
+	resetVec(&b.p, dim)
+	resetVec(&b.v, dim)
+	resetVec(&b.s, dim)
+	resetVec(&b.phat, dim)
+	resetVec(&b.shat, dim)
+
+	b.rhoPrev = 1
+	b.alpha = 0
+	b.omega = 1
+	b.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method
+// interface for more details.
+//
+// BiCGStab will command the following operations:
+//  MulVec
+//  PreconSolve
+//  CheckResidualNorm
+//  MajorIteration
+func (b *BiCGStab) Iterate(ctx *Context) (Operation, error) {
+	switch b.resume {
+	case 1:
+		b.rho = mat.Dot(&b.rt, &b.r)
+		if b.rho == 0 {
+			b.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		// p_i = r_{i-1} + β (p_{i-1} - ω_{i-1} v_{i-1})
+		beta := (b.rho / b.rhoPrev) * (b.alpha / b.omega)
+		b.p.AddScaledVec(&b.p, -b.omega, &b.v)
+		b.p.AddScaledVec(&b.r, beta, &b.p)
+		ctx.Src.CopyVec(&b.p)
+		b.resume = 2
+		// Solve M p̂_i = p_i.
+		return PreconSolve, nil
+	case 2:
+		b.phat.CopyVec(ctx.Dst)
+		ctx.Src.CopyVec(&b.phat)
+		b.resume = 3
+		// Compute v_i = A p̂_i.
+		return MulVec, nil
+	case 3:
+		b.v.CopyVec(ctx.Dst)
+		rtv := mat.Dot(&b.rt, &b.v)
+		if rtv == 0 {
+			b.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		b.alpha = b.rho / rtv
+		b.s.AddScaledVec(&b.r, -b.alpha, &b.v)
+		ctx.ResidualNorm = mat.Norm(&b.s, 2)
+		b.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		if ctx.Converged {
+			b.x.AddScaledVec(&b.x, b.alpha, &b.phat)
+			ctx.X.CopyVec(&b.x)
+			b.resume = 0
+			return MajorIteration, nil
+		}
+		ctx.Src.CopyVec(&b.s)
+		b.resume = 5
+		// Solve M ŝ = s.
+		return PreconSolve, nil
+	case 5:
+		b.shat.CopyVec(ctx.Dst)
+		ctx.Src.CopyVec(&b.shat)
+		b.resume = 6
+		// Compute t = A ŝ.
+		return MulVec, nil
+	case 6:
+		t := ctx.Dst
+		tt := mat.Dot(t, t)
+		if tt == 0 {
+			b.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		b.omega = mat.Dot(t, &b.s) / tt
+		b.x.AddScaledVec(&b.x, b.alpha, &b.phat)
+		b.x.AddScaledVec(&b.x, b.omega, &b.shat)
+		b.r.AddScaledVec(&b.s, -b.omega, t)
+		ctx.ResidualNorm = mat.Norm(&b.r, 2)
+		b.resume = 7
+		return CheckResidualNorm, nil
+	case 7:
+		if b.omega == 0 && !ctx.Converged {
+			b.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		ctx.X.CopyVec(&b.x)
+		b.rhoPrev = b.rho
+		b.resume = 1
+		return MajorIteration, nil
+
+	default:
+		panic("bicgstab: Init not called")
+	}
+}
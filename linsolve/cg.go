@@ -0,0 +1,94 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+// CG implements the Conjugate Gradient iterative method with
+// preconditioning for solving systems of linear equations
+//  A * x = b,
+// where A is a symmetric positive definite matrix. The preconditioner
+// must also be symmetric positive definite.
+//
+// References:
+//  - Barrett, R. et al. (1994). Section 2.3.1 Conjugate Gradient Method (CG).
+//    In Templates for the Solution of Linear Systems: Building Blocks
+//    for Iterative Methods (2nd ed.) (pp. 12-15). Philadelphia, PA: SIAM.
+//    Retrieved from http://www.netlib.org/templates/templates.pdf
+type CG struct {
+	x, r, p, z mat.VecDense
+
+	rho, rhoPrev float64
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface
+// for more details.
+func (cg *CG) Init(x, residual mat.Vector) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("cg: vector length mismatch")
+	}
+
+	cg.x.CloneVec(x)
+	cg.r.CloneVec(residual)
+	resetVec(&cg.p, dim)
+	resetVec(&cg.z, dim)
+
+	cg.rhoPrev = 1
+	cg.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method
+// interface for more details.
+//
+// CG will command the following operations:
+//  MulVec
+//  PreconSolve
+//  CheckResidualNorm
+//  MajorIteration
+func (cg *CG) Iterate(ctx *Context) (Operation, error) {
+	switch cg.resume {
+	case 1:
+		ctx.Src.CopyVec(&cg.r)
+		cg.resume = 2
+		// Solve M z = r_{i-1}.
+		return PreconSolve, nil
+	case 2:
+		cg.z.CopyVec(ctx.Dst)
+		cg.rho = mat.Dot(&cg.r, &cg.z) // ρ_i = r_{i-1} · z
+		beta := cg.rho / cg.rhoPrev    // β = ρ_i / ρ_{i-1}
+		cg.p.AddScaledVec(&cg.z, beta, &cg.p)
+		ctx.Src.CopyVec(&cg.p)
+		cg.resume = 3
+		// Compute A p_i.
+		return MulVec, nil
+	case 3:
+		ap := ctx.Dst
+		pap := mat.Dot(&cg.p, ap)
+		if pap <= 0 {
+			// A or M is not positive definite.
+			cg.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		alpha := cg.rho / pap // α = ρ_i / (p_i · A p_i)
+		cg.x.AddScaledVec(&cg.x, alpha, &cg.p)
+		cg.r.AddScaledVec(&cg.r, -alpha, ap)
+		ctx.ResidualNorm = mat.Norm(&cg.r, 2)
+		cg.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		ctx.X.CopyVec(&cg.x)
+		cg.rhoPrev = cg.rho
+		cg.resume = 1
+		return MajorIteration, nil
+
+	default:
+		panic("cg: Init not called")
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package linsolve provides iterative methods for solving linear systems.
+//
+// Background
+//
+// A system of linear equations can be written as
+//  A * x = b,
+// where A is a given n×n non-singular matrix, b is a given n-vector (the
+// right-hand side), and x is an unknown n-vector.
+//
+// Direct methods such as the LU or QR decomposition compute (in the absence of
+// roundoff errors) the exact solution after a finite number of steps. For a
+// general matrix A they require O(n^2) storage and O(n^3) arithmetic
+// operations, which is prohibitive for large n.
+//
+// Iterative methods compute a sequence of approximations x_1, x_2, ... to the
+// solution. They only require the ability to multiply A, and possibly A^T, by
+// a vector, so A can be stored in a sparse format or not stored at all. The
+// methods in this package are Krylov subspace methods: they find the
+// approximation x_k in the affine subspace
+//  x_0 + span{r_0, A*r_0, ..., A^{k-1}*r_0},
+// where x_0 is the initial guess and r_0 = b - A*x_0 is the initial residual.
+//
+// Choice of method
+//
+// The method should be chosen according to the properties of A. CG is the
+// method of choice for symmetric positive definite matrices. MINRES can be
+// used for symmetric matrices that are indefinite. GMRES and BiCGStab can be
+// used for general non-symmetric matrices. GMRES minimizes the residual norm
+// over the Krylov subspace, but its storage and cost per iteration grow with
+// the number of iterations and so it is restarted periodically. BiCGStab has
+// fixed storage and cost per iteration but its convergence can be erratic.
+//
+// Preconditioning
+//
+// The convergence of Krylov methods depends on the spectral properties of A
+// and can be accelerated by preconditioning, that is by solving an equivalent
+// system whose matrix has more favorable properties. A preconditioner M is an
+// approximation to A such that systems M * z = r are cheap to solve. The
+// preconditioner is given by the PreconSolve field of Settings. This package
+// provides the Jacobi, SSOR, IC0 and ILU0 preconditioners that can be
+// constructed from a sparse matrix.
+//
+// References
+//
+// Further details about iterative methods for linear systems can be found in
+//  - Barrett, R. et al. (1994). Templates for the Solution of Linear Systems:
+//    Building Blocks for Iterative Methods (2nd ed.). Philadelphia, PA: SIAM.
+//    Retrieved from http://www.netlib.org/templates/templates.pdf
+//  - Saad, Y. (2003). Iterative methods for sparse linear systems (2nd ed.).
+//    Philadelphia, PA: SIAM. Retrieved from
+//    https://www-users.cs.umn.edu/~saad/IterMethBook_2ndEd.pdf
+package linsolve // import "gonum.org/v1/gonum/linsolve"
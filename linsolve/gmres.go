@@ -0,0 +1,217 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/mat"
+)
+
+// GMRES implements the Generalized Minimum Residual method with right
+// preconditioning and restarts for solving systems of linear equations
+//  A * x = b,
+// where A is a general non-singular matrix.
+//
+// GMRES finds the approximate solution that minimizes the norm of the
+// residual over the Krylov subspace. Storage and work per iteration grow
+// with the dimension of the subspace and so the method is restarted after
+// Restart iterations using the current approximate solution as the new
+// initial guess.
+//
+// References:
+//  - Barrett, R. et al. (1994). Section 2.3.4 Generalized Minimal Residual
+//    (GMRES). In Templates for the Solution of Linear Systems: Building
+//    Blocks for Iterative Methods (2nd ed.) (pp. 17-19). Philadelphia, PA:
+//    SIAM. Retrieved from http://www.netlib.org/templates/templates.pdf
+//  - Saad, Y., and Schultz, M. (1986). GMRES: A generalized minimal residual
+//    algorithm for solving nonsymmetric linear systems. SIAM J. Sci. Stat.
+//    Comput., 7(3), 856. https://doi.org/10.1137/0907058
+type GMRES struct {
+	// Restart is the restart parameter, that is the maximum dimension of
+	// the Krylov subspace before the method is restarted. It must be
+	// non-negative. If it is zero, a default value of min(dim, 30) is used.
+	Restart int
+
+	m int // Restart parameter in use.
+
+	// x0 is the initial approximation at the start of a cycle.
+	x0 mat.VecDense
+	// v holds the orthonormal basis of the Krylov subspace in its
+	// first k+1 columns.
+	v mat.Dense
+	// z holds the preconditioned basis vectors M^{-1} v_j in its first k
+	// columns.
+	z mat.Dense
+	// h is the upper Hessenberg matrix of the Arnoldi process reduced to
+	// upper triangular form by Givens rotations.
+	h mat.Dense
+	// cs and sn hold the Givens rotations.
+	cs, sn []float64
+	// g is the right-hand side of the least-squares problem.
+	g []float64
+	// y is the solution of the least-squares problem.
+	y mat.VecDense
+
+	k int // Current dimension of the Krylov subspace.
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface
+// for more details.
+func (g *GMRES) Init(x, residual mat.Vector) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("gmres: vector length mismatch")
+	}
+	if g.Restart < 0 {
+		panic("gmres: negative restart")
+	}
+
+	g.m = g.Restart
+	if g.m == 0 {
+		g.m = 30
+	}
+	if g.m > dim {
+		g.m = dim
+	}
+
+	g.x0.CloneVec(x)
+	g.v = *mat.NewDense(dim, g.m+1, nil)
+	g.z = *mat.NewDense(dim, g.m, nil)
+	g.h = *mat.NewDense(g.m+1, g.m, nil)
+	g.cs = make([]float64, g.m)
+	g.sn = make([]float64, g.m)
+	g.g = make([]float64, g.m+1)
+	g.y = *mat.NewVecDense(g.m, nil)
+
+	g.startCycle(residual)
+	g.resume = 2
+}
+
+// startCycle initializes a new cycle of the method from the residual of
+// the current approximate solution.
+func (g *GMRES) startCycle(residual mat.Vector) {
+	beta := mat.Norm(residual, 2)
+	v0 := g.v.ColView(0).(*mat.VecDense)
+	v0.ScaleVec(1/beta, residual)
+	for i := range g.g {
+		g.g[i] = 0
+	}
+	g.g[0] = beta
+	g.k = 0
+}
+
+// Iterate performs an iteration of the linear solve. See the Method
+// interface for more details.
+//
+// GMRES will command the following operations:
+//  MulVec
+//  PreconSolve
+//  ComputeResidual
+//  CheckResidualNorm
+//  MajorIteration
+func (g *GMRES) Iterate(ctx *Context) (Operation, error) {
+	switch g.resume {
+	case 1:
+		// Start of a new cycle with the residual in ctx.Dst.
+		g.x0.CopyVec(ctx.X)
+		if mat.Norm(ctx.Dst, 2) == 0 {
+			ctx.ResidualNorm = 0
+			g.resume = 5
+			return CheckResidualNorm, nil
+		}
+		g.startCycle(ctx.Dst)
+		fallthrough
+	case 2:
+		// Solve M z_k = v_k.
+		ctx.Src.CopyVec(g.v.ColView(g.k))
+		g.resume = 3
+		return PreconSolve, nil
+	case 3:
+		zk := g.z.ColView(g.k).(*mat.VecDense)
+		zk.CopyVec(ctx.Dst)
+		ctx.Src.CopyVec(zk)
+		g.resume = 4
+		// Compute A z_k.
+		return MulVec, nil
+	case 4:
+		k := g.k
+		w := ctx.Dst
+		// Orthogonalize A z_k against the basis using modified
+		// Gram-Schmidt.
+		for i := 0; i <= k; i++ {
+			vi := g.v.ColView(i)
+			hik := mat.Dot(w, vi)
+			g.h.Set(i, k, hik)
+			w.AddScaledVec(w, -hik, vi)
+		}
+		hk1 := mat.Norm(w, 2)
+		if hk1 != 0 {
+			g.v.ColView(k+1).(*mat.VecDense).ScaleVec(1/hk1, w)
+		}
+
+		// Apply the previous Givens rotations to the new column of H.
+		for i := 0; i < k; i++ {
+			hi, hi1 := g.h.At(i, k), g.h.At(i+1, k)
+			g.h.Set(i, k, g.cs[i]*hi+g.sn[i]*hi1)
+			g.h.Set(i+1, k, -g.sn[i]*hi+g.cs[i]*hi1)
+		}
+		// Compute and apply a new rotation to eliminate h_{k+1,k}.
+		hkk := g.h.At(k, k)
+		r := math.Hypot(hkk, hk1)
+		if r == 0 {
+			g.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		g.cs[k] = hkk / r
+		g.sn[k] = hk1 / r
+		g.h.Set(k, k, r)
+		g.g[k+1] = -g.sn[k] * g.g[k]
+		g.g[k] *= g.cs[k]
+
+		g.k++
+		g.updateSolution(ctx.X)
+		ctx.ResidualNorm = math.Abs(g.g[g.k])
+		if hk1 == 0 {
+			// The Krylov subspace is invariant under A so
+			// the solution is exact.
+			ctx.ResidualNorm = 0
+		}
+		g.resume = 5
+		return CheckResidualNorm, nil
+	case 5:
+		if ctx.Converged || g.k < g.m {
+			g.resume = 2
+		} else {
+			g.resume = 6
+		}
+		return MajorIteration, nil
+	case 6:
+		// Restart with the true residual of the current solution.
+		g.resume = 1
+		return ComputeResidual, nil
+
+	default:
+		panic("gmres: Init not called")
+	}
+}
+
+// updateSolution computes x = x0 + Z*y where y solves the k×k upper
+// triangular system R*y = g.
+func (g *GMRES) updateSolution(x *mat.VecDense) {
+	k := g.k
+	h := g.h.RawMatrix()
+	y := g.y.RawVector()
+	copy(y.Data[:k], g.g[:k])
+	blas64.Trsv(blas.NoTrans,
+		blas64.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: k, Data: h.Data, Stride: h.Stride},
+		blas64.Vector{N: k, Data: y.Data, Inc: y.Inc})
+	x.MulVec(g.z.Slice(0, x.Len(), 0, k), g.y.SliceVec(0, k))
+	x.AddVec(x, &g.x0)
+}
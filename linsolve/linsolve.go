@@ -0,0 +1,379 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrIterationLimit is returned when the maximum number of iterations
+	// was reached before convergence.
+	ErrIterationLimit = errors.New("linsolve: iteration limit reached")
+
+	// ErrBreakdown is returned when a method encounters a division by zero
+	// or a similar condition that prevents it from continuing.
+	ErrBreakdown = errors.New("linsolve: breakdown")
+)
+
+// MulVecToer represents a square matrix A by means of a matrix-vector
+// multiplication.
+type MulVecToer interface {
+	// MulVecTo computes A*x or A^T*x and stores the result into dst.
+	MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector)
+}
+
+// Operation specifies the type of operation commanded by Method at each
+// iteration.
+type Operation uint64
+
+// Supported Operations.
+const (
+	// NoOperation specifies that no action is required from the caller.
+	NoOperation Operation = 0
+
+	// MulVec specifies that the caller must compute A*ctx.Src and store the
+	// result in ctx.Dst. If combined with Trans, A^T*ctx.Src must be
+	// computed instead.
+	MulVec Operation = 1 << (iota - 1)
+
+	// PreconSolve specifies that the caller must solve M*z = ctx.Src for z,
+	// where M is the preconditioner, and store the result in ctx.Dst. If
+	// combined with Trans, M^T*z = ctx.Src must be solved instead.
+	PreconSolve
+
+	// Trans is combined with MulVec or PreconSolve to specify that the
+	// operation involves the transposed matrix.
+	Trans
+
+	// ComputeResidual specifies that the caller must compute the residual
+	// b - A*ctx.X and store the result in ctx.Dst.
+	ComputeResidual
+
+	// CheckResidualNorm specifies that the caller must check whether the
+	// norm of the residual given by ctx.ResidualNorm satisfies the
+	// convergence criterion and set ctx.Converged accordingly.
+	CheckResidualNorm
+
+	// MajorIteration specifies that the method has completed an iteration
+	// and that ctx.X holds the current approximate solution. The caller
+	// terminates the iteration if ctx.Converged is true.
+	MajorIteration
+)
+
+func (op Operation) String() string {
+	var trans string
+	if op&Trans != 0 {
+		trans = "|Trans"
+	}
+	switch op &^ Trans {
+	case NoOperation:
+		if op == NoOperation {
+			return "NoOperation"
+		}
+		return "Trans"
+	case MulVec:
+		return "MulVec" + trans
+	case PreconSolve:
+		return "PreconSolve" + trans
+	case ComputeResidual:
+		return "ComputeResidual" + trans
+	case CheckResidualNorm:
+		return "CheckResidualNorm" + trans
+	case MajorIteration:
+		return "MajorIteration" + trans
+	}
+	return fmt.Sprintf("Operation(%d)", op)
+}
+
+// Context mediates the communication between the Method and the caller.
+// The caller must not modify the fields of Context apart from those it is
+// commanded to by the Method.
+type Context struct {
+	// X holds the current approximate solution. It is updated by the
+	// Method before it returns MajorIteration.
+	X *mat.VecDense
+
+	// ResidualNorm is an estimate of the norm of the current residual.
+	ResidualNorm float64
+
+	// Converged indicates whether the convergence criterion has been
+	// satisfied. It is set by the caller in response to CheckResidualNorm.
+	Converged bool
+
+	// Src and Dst are the source and destination vectors for the MulVec,
+	// PreconSolve and ComputeResidual operations.
+	Src, Dst *mat.VecDense
+}
+
+// Method is an iterative method that produces a sequence of vectors that
+// converge to the solution of the system of linear equations A * x = b.
+//
+// Method uses a reverse-communication interface between the iterative
+// algorithm and the caller. Method acts as a client that commands the caller
+// to perform needed operations via Operation returned from the Iterate
+// method. This provides independence of Method on the representation of the
+// matrix A and enables automation of common operations like checking for
+// convergence and maintaining statistics.
+type Method interface {
+	// Init initializes the method for solving a linear system with the
+	// initial estimate x and the corresponding residual vector.
+	//
+	// Method will not retain x or residual.
+	Init(x, residual mat.Vector)
+
+	// Iterate performs a step toward the solution of the linear system
+	// and returns the operation that must be performed by the caller
+	// before Iterate is called again. The vectors in ctx are allocated
+	// by the caller and must have the length of the system.
+	Iterate(ctx *Context) (Operation, error)
+}
+
+// Settings holds settings for solving a linear system.
+type Settings struct {
+	// InitX holds the initial guess. If it is nil or zero-valued, the zero
+	// vector will be used, otherwise its length must be equal to the
+	// dimension of the system.
+	InitX *mat.VecDense
+
+	// Dst, if not nil, will be used for storing the approximate solution.
+	// If it is zero-valued, it will be allocated, otherwise its length must
+	// be equal to the dimension of the system.
+	Dst *mat.VecDense
+
+	// Tolerance specifies the relative tolerance for the residual norm.
+	// The iteration is considered converged when
+	//  |r_i| < Tolerance * |b|,
+	// where r_i is the residual at the i-th iteration.
+	//
+	// If Tolerance is zero, a default value of 1e-8 will be used, otherwise
+	// it must be positive and less than 1.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of major iterations allowed.
+	// If it is zero, a default value of 4*n will be used where n is the
+	// dimension of the system.
+	MaxIterations int
+
+	// PreconSolve describes the preconditioner M. It must solve M*dst = rhs
+	// or M^T*dst = rhs if trans is true and store the result in dst.
+	// If PreconSolve is nil, the identity preconditioner will be used.
+	PreconSolve func(dst *mat.VecDense, trans bool, rhs mat.Vector) error
+}
+
+// defaultSettings fills zero fields of s with default values.
+func defaultSettings(s *Settings, dim int) {
+	if s.InitX == nil {
+		s.InitX = &mat.VecDense{}
+	}
+	if s.Dst == nil {
+		s.Dst = &mat.VecDense{}
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = 1e-8
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 4 * dim
+	}
+	if s.PreconSolve == nil {
+		s.PreconSolve = NoPreconditioner
+	}
+}
+
+// checkSettings panics if s is not valid for a system of dimension dim.
+func checkSettings(s *Settings, dim int) {
+	if !s.InitX.IsZero() && s.InitX.Len() != dim {
+		panic("linsolve: mismatched length of initial guess")
+	}
+	if !s.Dst.IsZero() && s.Dst.Len() != dim {
+		panic("linsolve: mismatched destination length")
+	}
+	if s.Tolerance <= 0 || 1 <= s.Tolerance {
+		panic("linsolve: invalid tolerance")
+	}
+	if s.MaxIterations <= 0 {
+		panic("linsolve: negative iteration limit")
+	}
+}
+
+// Result holds the result of an iterative solve.
+type Result struct {
+	// X is the approximate solution.
+	X *mat.VecDense
+
+	// ResidualNorm is an approximation to the norm of the final residual.
+	ResidualNorm float64
+
+	// History holds the residual norm reported at each major iteration,
+	// preceded by the norm of the initial residual.
+	History []float64
+
+	Stats
+}
+
+// Stats holds statistics about an iterative solve.
+type Stats struct {
+	// Iterations is the number of major iterations performed.
+	Iterations int
+
+	// MulVec is the number of MulVec operations performed.
+	MulVec int
+
+	// PreconSolve is the number of PreconSolve operations performed.
+	PreconSolve int
+
+	// Runtime is the total runtime of the solve.
+	Runtime time.Duration
+}
+
+// Iterative finds an approximate solution of the system of n linear
+// equations
+//  A*x = b,
+// where A is a non-singular square matrix of order n and b is the
+// right-hand side vector, using an iterative method m. If m is nil, the
+// default method CG is used.
+//
+// settings provide means for adjusting the iterative process. Zero values
+// of the fields mean default values. If settings is nil, default settings
+// are used.
+//
+// Note that the default choices of Method and Settings were chosen to
+// provide accuracy and robustness, rather than speed of execution.
+//
+// Iterative returns the result and an error. The result is non-nil even
+// when an error is returned, in which case it holds the last approximate
+// solution found.
+func Iterative(a MulVecToer, b *mat.VecDense, m Method, settings *Settings) (*Result, error) {
+	start := time.Now()
+
+	n := b.Len()
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	defaultSettings(&s, n)
+	checkSettings(&s, n)
+	if m == nil {
+		m = &CG{}
+	}
+
+	x := s.Dst
+	if x.IsZero() {
+		*x = *mat.NewVecDense(n, nil)
+	}
+	if s.InitX.IsZero() {
+		x.Zero()
+	} else if s.InitX != x {
+		x.CopyVec(s.InitX)
+	}
+
+	var stats Stats
+	ctx := iterContext{
+		Context: Context{
+			X:   x,
+			Src: mat.NewVecDense(n, nil),
+			Dst: mat.NewVecDense(n, nil),
+		},
+	}
+	result := func(err error) (*Result, error) {
+		stats.Runtime = time.Since(start)
+		return &Result{
+			X:            x,
+			ResidualNorm: ctx.ResidualNorm,
+			History:      ctx.history,
+			Stats:        stats,
+		}, err
+	}
+
+	computeResidual(ctx.Dst, a, b, x, &stats)
+	bnorm := mat.Norm(b, 2)
+	if bnorm == 0 {
+		// The solution of a system with zero right-hand side is zero.
+		x.Zero()
+		ctx.Dst.CopyVec(b)
+	}
+	ctx.ResidualNorm = mat.Norm(ctx.Dst, 2)
+	ctx.history = append(ctx.history, ctx.ResidualNorm)
+	if ctx.ResidualNorm < s.Tolerance*bnorm || bnorm == 0 {
+		return result(nil)
+	}
+
+	m.Init(x, ctx.Dst)
+	for {
+		op, err := m.Iterate(&ctx.Context)
+		if err != nil {
+			return result(err)
+		}
+		trans := op&Trans != 0
+		switch op &^ Trans {
+		case NoOperation:
+		case MulVec:
+			stats.MulVec++
+			a.MulVecTo(ctx.Dst, trans, ctx.Src)
+		case PreconSolve:
+			stats.PreconSolve++
+			err = s.PreconSolve(ctx.Dst, trans, ctx.Src)
+			if err != nil {
+				return result(err)
+			}
+		case ComputeResidual:
+			computeResidual(ctx.Dst, a, b, ctx.X, &stats)
+		case CheckResidualNorm:
+			ctx.Converged = ctx.ResidualNorm < s.Tolerance*bnorm
+		case MajorIteration:
+			stats.Iterations++
+			ctx.history = append(ctx.history, ctx.ResidualNorm)
+			if ctx.Converged {
+				return result(nil)
+			}
+			if math.IsNaN(ctx.ResidualNorm) || math.IsInf(ctx.ResidualNorm, 0) {
+				return result(ErrBreakdown)
+			}
+			if stats.Iterations >= s.MaxIterations {
+				return result(ErrIterationLimit)
+			}
+		default:
+			panic("linsolve: invalid operation")
+		}
+	}
+}
+
+// iterContext is the context used by Iterative. It holds the residual
+// history in addition to the fields communicated to the Method.
+type iterContext struct {
+	Context
+	history []float64
+}
+
+// computeResidual computes dst = b - A*x.
+func computeResidual(dst *mat.VecDense, a MulVecToer, b, x *mat.VecDense, stats *Stats) {
+	stats.MulVec++
+	a.MulVecTo(dst, false, x)
+	dst.SubVec(b, dst)
+}
+
+// NoPreconditioner implements the identity preconditioner.
+func NoPreconditioner(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	if dst.Len() != rhs.Len() {
+		panic("linsolve: mismatched vector length")
+	}
+	dst.CopyVec(rhs)
+	return nil
+}
+
+// resetVec sets v to the zero vector of length n, reusing the storage of v
+// if possible.
+func resetVec(v *mat.VecDense, n int) {
+	if v.IsZero() || v.Len() != n {
+		*v = *mat.NewVecDense(n, nil)
+		return
+	}
+	v.Zero()
+}
@@ -0,0 +1,55 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/linsolve"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/sparse"
+)
+
+func ExampleIterative() {
+	// Solve the 1D Poisson equation -u'' = 1 on (0, 1) with zero boundary
+	// values using a finite difference discretization.
+	const n = 9
+	const h = 1.0 / (n + 1)
+	a := sparse.NewCOO(n, n, nil, nil, nil)
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		a.Append(i, i, 2/(h*h))
+		if i > 0 {
+			a.Append(i, i-1, -1/(h*h))
+		}
+		if i < n-1 {
+			a.Append(i, i+1, -1/(h*h))
+		}
+		b.SetVec(i, 1)
+	}
+	csr := a.ToCSR()
+
+	prec, err := linsolve.NewIC0(csr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	settings := &linsolve.Settings{
+		Tolerance:   1e-12,
+		PreconSolve: prec.PreconSolve,
+	}
+	result, err := linsolve.Iterative(csr, b, &linsolve.CG{}, settings)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The exact solution is u(x) = x(1-x)/2.
+	fmt.Printf("iterations = %d\n", result.Iterations)
+	fmt.Printf("u = %.4f\n", mat.Formatted(result.X.T()))
+
+	// Output:
+	// iterations = 1
+	// u = [0.0450  0.0800  0.1050  0.1200  0.1250  0.1200  0.1050  0.0800  0.0450]
+}
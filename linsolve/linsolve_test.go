@@ -0,0 +1,260 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/sparse"
+)
+
+// laplace2D returns the n²×n² matrix of the five-point finite difference
+// discretization of the negative Laplacian on an n×n grid, shifted by
+// -shift*I. If conv is not zero, a first-order upwind discretization of
+// a convection term is added making the matrix non-symmetric.
+func laplace2D(n int, shift, conv float64) *sparse.CSR {
+	dim := n * n
+	a := sparse.NewCOO(dim, dim, nil, nil, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			row := i*n + j
+			a.Append(row, row, 4-shift+conv)
+			if i > 0 {
+				a.Append(row, row-n, -1-conv)
+			}
+			if i < n-1 {
+				a.Append(row, row+n, -1)
+			}
+			if j > 0 {
+				a.Append(row, row-1, -1)
+			}
+			if j < n-1 {
+				a.Append(row, row+1, -1)
+			}
+		}
+	}
+	return a.ToCSR()
+}
+
+type testProblem struct {
+	name      string
+	a         *sparse.CSR
+	symmetric bool
+	posdef    bool
+}
+
+func testProblems() []testProblem {
+	return []testProblem{
+		{name: "laplace", a: laplace2D(10, 0, 0), symmetric: true, posdef: true},
+		{name: "indefinite", a: laplace2D(10, 1.3, 0), symmetric: true},
+		{name: "convection", a: laplace2D(10, 0, 2)},
+	}
+}
+
+func preconditioners(a *sparse.CSR, p testProblem) map[string]func(*mat.VecDense, bool, mat.Vector) error {
+	precs := map[string]func(*mat.VecDense, bool, mat.Vector) error{
+		"none": nil,
+	}
+	if !p.symmetric || p.posdef {
+		jac, err := NewJacobi(a)
+		if err != nil {
+			panic(err)
+		}
+		precs["jacobi"] = jac.PreconSolve
+	}
+	if p.posdef {
+		ssor, err := NewSSOR(a, 1.2)
+		if err != nil {
+			panic(err)
+		}
+		precs["ssor"] = ssor.PreconSolve
+		ic, err := NewIC0(a)
+		if err != nil {
+			panic(err)
+		}
+		precs["ic0"] = ic.PreconSolve
+	}
+	if !p.symmetric {
+		ilu, err := NewILU0(a)
+		if err != nil {
+			panic(err)
+		}
+		precs["ilu0"] = ilu.PreconSolve
+	}
+	return precs
+}
+
+func TestIterative(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, p := range testProblems() {
+		n, _ := p.a.Dims()
+		want := mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			want.SetVec(i, rnd.NormFloat64())
+		}
+		var b mat.VecDense
+		p.a.MulVecTo(&b, false, want)
+
+		methods := []struct {
+			name   string
+			method func() Method
+		}{
+			{name: "GMRES", method: func() Method { return &GMRES{} }},
+			{name: "GMRES(5)", method: func() Method { return &GMRES{Restart: 5} }},
+			{name: "BiCGStab", method: func() Method { return &BiCGStab{} }},
+		}
+		if p.symmetric {
+			methods = append(methods, struct {
+				name   string
+				method func() Method
+			}{name: "MINRES", method: func() Method { return &MINRES{} }})
+		}
+		if p.posdef {
+			methods = append(methods, struct {
+				name   string
+				method func() Method
+			}{name: "CG", method: func() Method { return &CG{} }})
+		}
+
+		for _, m := range methods {
+			for pname, precon := range preconditioners(p.a, p) {
+				if m.name == "GMRES(5)" && !p.posdef && p.symmetric {
+					// Restarted GMRES may stagnate for indefinite
+					// matrices.
+					continue
+				}
+				name := fmt.Sprintf("%s %s %s", p.name, m.name, pname)
+				const tol = 1e-10
+				settings := &Settings{
+					Tolerance:     tol,
+					MaxIterations: 10 * n,
+					PreconSolve:   precon,
+				}
+				res, err := Iterative(p.a, &b, m.method(), settings)
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if len(res.History) != res.Iterations+1 {
+					t.Errorf("%s: unexpected history length: got:%d want:%d", name, len(res.History), res.Iterations+1)
+				}
+				if res.ResidualNorm != res.History[len(res.History)-1] {
+					t.Errorf("%s: final residual norm does not match history", name)
+				}
+				var r mat.VecDense
+				p.a.MulVecTo(&r, false, res.X)
+				r.SubVec(&b, &r)
+				bnorm := mat.Norm(&b, 2)
+				rnorm := mat.Norm(&r, 2)
+				// Allow for the difference between the recursive and true
+				// residuals and for MINRES measuring the residual in a
+				// preconditioner-dependent norm.
+				if rnorm > 100*tol*bnorm {
+					t.Errorf("%s: residual too large: |r|/|b| = %v", name, rnorm/bnorm)
+				}
+				if !floats.EqualApprox(res.X.RawVector().Data, want.RawVector().Data, 1e-6) {
+					t.Errorf("%s: unexpected solution", name)
+				}
+			}
+		}
+	}
+}
+
+func TestIterativeSettings(t *testing.T) {
+	a := laplace2D(8, 0, 0)
+	n, _ := a.Dims()
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		b.SetVec(i, float64(i%7)-3)
+	}
+
+	// Zero right-hand side.
+	res, err := Iterative(a, mat.NewVecDense(n, nil), &CG{}, &Settings{InitX: mat.VecDenseCopyOf(b)})
+	if err != nil {
+		t.Fatalf("unexpected error for zero right-hand side: %v", err)
+	}
+	if mat.Norm(res.X, 2) != 0 || res.Iterations != 0 {
+		t.Errorf("unexpected result for zero right-hand side")
+	}
+
+	// Iteration limit.
+	res, err = Iterative(a, b, &CG{}, &Settings{MaxIterations: 3})
+	if err != ErrIterationLimit {
+		t.Errorf("unexpected error for iteration limit: got:%v want:%v", err, ErrIterationLimit)
+	}
+	if res.Iterations != 3 || len(res.History) != 4 {
+		t.Errorf("unexpected number of iterations: got:%d", res.Iterations)
+	}
+
+	// Exact initial guess and supplied destination.
+	sol, err := Iterative(a, b, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dst := mat.NewVecDense(n, nil)
+	res, err = Iterative(a, b, &GMRES{}, &Settings{InitX: sol.X, Dst: dst, Tolerance: 1e-6})
+	if err != nil {
+		t.Fatalf("unexpected error for exact initial guess: %v", err)
+	}
+	if res.X != dst {
+		t.Errorf("result not stored in destination")
+	}
+	if res.Iterations != 0 {
+		t.Errorf("unexpected iterations for exact initial guess: got:%d want:0", res.Iterations)
+	}
+
+	// Restarted GMRES must report every iteration.
+	res, err = Iterative(a, b, &GMRES{Restart: 3}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error for restarted GMRES: %v", err)
+	}
+	if res.MulVec < res.Iterations {
+		t.Errorf("unexpected number of MulVec operations: got:%d want>=%d", res.MulVec, res.Iterations)
+	}
+	for i := 3; i < len(res.History); i++ {
+		if res.History[i] > res.History[i-1]*(1+1e-12) {
+			t.Errorf("GMRES residual increased at iteration %d: %v > %v", i, res.History[i], res.History[i-1])
+		}
+	}
+
+	for _, s := range []*Settings{
+		{Tolerance: -1},
+		{Tolerance: 1},
+		{MaxIterations: -1},
+		{InitX: mat.NewVecDense(n+1, nil)},
+		{Dst: mat.NewVecDense(n-1, nil)},
+	} {
+		if !panics(func() { Iterative(a, b, nil, s) }) {
+			t.Errorf("expected panic for invalid settings %+v", s)
+		}
+	}
+}
+
+func TestCGNotPositiveDefinite(t *testing.T) {
+	a := laplace2D(6, 3, 0)
+	n, _ := a.Dims()
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		b.SetVec(i, 1)
+	}
+	_, err := Iterative(a, b, &CG{}, nil)
+	if err != ErrBreakdown && err != ErrIterationLimit {
+		t.Errorf("unexpected error for indefinite matrix: %v", err)
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
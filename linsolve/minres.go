@@ -0,0 +1,173 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// dlamchE is the machine epsilon.
+const dlamchE = 1.0 / (1 << 53)
+
+// errNotPositiveDefinite is returned when the preconditioner is found to be
+// indefinite.
+var errNotPositiveDefinite = errors.New("minres: preconditioner not positive definite")
+
+// MINRES implements the Minimum Residual method with preconditioning for
+// solving systems of linear equations
+//  A * x = b,
+// where A is a symmetric, possibly indefinite, matrix. The preconditioner
+// must be symmetric positive definite.
+//
+// MINRES minimizes the norm of the residual over the Krylov subspace using
+// short recurrences. When a preconditioner M is used, the residual norm
+// reported by MINRES and used for checking convergence is the norm of the
+// residual r with respect to M^{-1}, that is sqrt(r^T M^{-1} r).
+//
+// References:
+//  - Paige, C. and Saunders, M. (1975). Solution of sparse indefinite systems
+//    of linear equations. SIAM J. Numer. Anal., 12(4), 617.
+//    https://doi.org/10.1137/0712047
+//  - Choi, S., Paige, C. and Saunders, M. (2011). MINRES-QLP: A Krylov
+//    subspace method for indefinite or singular symmetric systems. SIAM J.
+//    Sci. Comput., 33(4), 1810. https://doi.org/10.1137/100787921
+type MINRES struct {
+	x, r1, r2, y, v mat.VecDense
+	w, w1, w2       mat.VecDense
+
+	beta, oldb, alpha float64
+	dbar, epsln       float64
+	phibar            float64
+	cs, sn            float64
+	iter              int
+
+	resume int
+}
+
+// Init initializes the data for a linear solve. See the Method interface
+// for more details.
+func (m *MINRES) Init(x, residual mat.Vector) {
+	dim := x.Len()
+	if residual.Len() != dim {
+		panic("minres: vector length mismatch")
+	}
+
+	m.x.CloneVec(x)
+	m.r1.CloneVec(residual)
+	m.r2.CloneVec(residual)
+	resetVec(&m.y, dim)
+	resetVec(&m.v, dim)
+	resetVec(&m.w, dim)
+	resetVec(&m.w1, dim)
+	resetVec(&m.w2, dim)
+
+	m.resume = 1
+}
+
+// Iterate performs an iteration of the linear solve. See the Method
+// interface for more details.
+//
+// MINRES will command the following operations:
+//  MulVec
+//  PreconSolve
+//  CheckResidualNorm
+//  MajorIteration
+func (m *MINRES) Iterate(ctx *Context) (Operation, error) {
+	switch m.resume {
+	case 1:
+		ctx.Src.CopyVec(&m.r1)
+		m.resume = 2
+		// Solve M y = r_0.
+		return PreconSolve, nil
+	case 2:
+		m.y.CopyVec(ctx.Dst)
+		beta1 := mat.Dot(&m.r1, &m.y)
+		if beta1 <= 0 {
+			m.resume = 0
+			return NoOperation, errNotPositiveDefinite
+		}
+		beta1 = math.Sqrt(beta1)
+		m.beta = beta1
+		m.oldb = 0
+		m.dbar = 0
+		m.epsln = 0
+		m.phibar = beta1
+		m.cs = -1
+		m.sn = 0
+		m.iter = 0
+		fallthrough
+	case 3:
+		// Compute the next Lanczos vector.
+		m.iter++
+		m.v.ScaleVec(1/m.beta, &m.y)
+		ctx.Src.CopyVec(&m.v)
+		m.resume = 4
+		return MulVec, nil
+	case 4:
+		m.y.CopyVec(ctx.Dst)
+		if m.iter >= 2 {
+			m.y.AddScaledVec(&m.y, -m.beta/m.oldb, &m.r1)
+		}
+		m.alpha = mat.Dot(&m.v, &m.y)
+		m.y.AddScaledVec(&m.y, -m.alpha/m.beta, &m.r2)
+		m.r1.CopyVec(&m.r2)
+		m.r2.CopyVec(&m.y)
+		ctx.Src.CopyVec(&m.r2)
+		m.resume = 5
+		// Solve M y = r_2.
+		return PreconSolve, nil
+	case 5:
+		m.y.CopyVec(ctx.Dst)
+		m.oldb = m.beta
+		beta := mat.Dot(&m.r2, &m.y)
+		if beta < 0 {
+			m.resume = 0
+			return NoOperation, errNotPositiveDefinite
+		}
+		m.beta = math.Sqrt(beta)
+
+		// Apply the previous rotation.
+		oldeps := m.epsln
+		delta := m.cs*m.dbar + m.sn*m.alpha
+		gbar := m.sn*m.dbar - m.cs*m.alpha
+		m.epsln = m.sn * m.beta
+		m.dbar = -m.cs * m.beta
+
+		// Compute the next rotation.
+		gamma := math.Max(math.Hypot(gbar, m.beta), dlamchE)
+		m.cs = gbar / gamma
+		m.sn = m.beta / gamma
+		phi := m.cs * m.phibar
+		m.phibar *= m.sn
+
+		// Update the solution.
+		m.w1.CopyVec(&m.w2)
+		m.w2.CopyVec(&m.w)
+		m.w.AddScaledVec(&m.v, -oldeps, &m.w1)
+		m.w.AddScaledVec(&m.w, -delta, &m.w2)
+		m.w.ScaleVec(1/gamma, &m.w)
+		m.x.AddScaledVec(&m.x, phi, &m.w)
+
+		ctx.ResidualNorm = math.Abs(m.phibar)
+		m.resume = 6
+		return CheckResidualNorm, nil
+	case 6:
+		ctx.X.CopyVec(&m.x)
+		m.resume = 3
+		if m.beta == 0 && !ctx.Converged {
+			// The Krylov subspace is invariant under A but the
+			// system has not been solved, so A is singular.
+			m.resume = 0
+			return NoOperation, ErrBreakdown
+		}
+		return MajorIteration, nil
+
+	default:
+		panic("minres: Init not called")
+	}
+}
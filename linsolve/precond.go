@@ -0,0 +1,346 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/sparse"
+)
+
+var (
+	// ErrZeroDiagonal is returned when a preconditioner cannot be
+	// constructed because the matrix has a zero on its diagonal.
+	ErrZeroDiagonal = errors.New("linsolve: zero diagonal element")
+
+	// ErrNotPositiveDefinite is returned when an incomplete Cholesky
+	// factorization encounters a non-positive pivot.
+	ErrNotPositiveDefinite = errors.New("linsolve: non-positive pivot in incomplete Cholesky factorization")
+)
+
+// Jacobi is the Jacobi, or diagonal, preconditioner M = diag(A).
+type Jacobi struct {
+	inv []float64
+}
+
+// NewJacobi returns the Jacobi preconditioner for the square matrix a.
+// NewJacobi returns ErrZeroDiagonal if a has a zero diagonal element.
+func NewJacobi(a mat.Matrix) (*Jacobi, error) {
+	n := checkSquare(a)
+	inv := make([]float64, n)
+	for i := range inv {
+		d := a.At(i, i)
+		if d == 0 {
+			return nil, ErrZeroDiagonal
+		}
+		inv[i] = 1 / d
+	}
+	return &Jacobi{inv: inv}, nil
+}
+
+// PreconSolve solves M*dst = rhs and stores the result in dst. Since M is
+// diagonal, the trans parameter has no effect.
+func (p *Jacobi) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	if dst.Len() != len(p.inv) || rhs.Len() != len(p.inv) {
+		panic("linsolve: mismatched vector length")
+	}
+	for i, v := range p.inv {
+		dst.SetVec(i, v*rhs.AtVec(i))
+	}
+	return nil
+}
+
+// SSOR is the symmetric successive over-relaxation preconditioner
+//  M = ω/(2-ω) * (D/ω + L) * (D/ω)^{-1} * (D/ω + U),
+// where D, L and U are the diagonal, strictly lower and strictly upper
+// triangular parts of A and ω is the relaxation parameter. With ω = 1 it
+// is the symmetric Gauss-Seidel preconditioner.
+type SSOR struct {
+	a     *sparse.CSR
+	diag  []float64
+	omega float64
+}
+
+// NewSSOR returns the SSOR preconditioner for the square matrix a with the
+// relaxation parameter omega, which must be in the interval (0, 2).
+// NewSSOR returns ErrZeroDiagonal if a has a zero diagonal element.
+func NewSSOR(a *sparse.CSR, omega float64) (*SSOR, error) {
+	n := checkSquare(a)
+	if omega <= 0 || 2 <= omega {
+		panic("linsolve: relaxation parameter out of range")
+	}
+	diag := make([]float64, n)
+	for i := range diag {
+		d := a.At(i, i)
+		if d == 0 {
+			return nil, ErrZeroDiagonal
+		}
+		diag[i] = d / omega
+	}
+	return &SSOR{a: a, diag: diag, omega: omega}, nil
+}
+
+// PreconSolve solves M*dst = rhs, or M^T*dst = rhs if trans is true, and
+// stores the result in dst.
+func (p *SSOR) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	n := len(p.diag)
+	if dst.Len() != n || rhs.Len() != n {
+		panic("linsolve: mismatched vector length")
+	}
+	indptr, ind, data := p.a.RawCSR()
+	x := vecCopy(rhs)
+	lower, upper := true, false
+	if trans {
+		// M^T = ω/(2-ω) * (D/ω + U)^T * (D/ω)^{-1} * (D/ω + L)^T.
+		lower, upper = upper, lower
+	}
+	triSolve(x, indptr, ind, data, p.diag, lower, trans)
+	for i, d := range p.diag {
+		x[i] *= d
+	}
+	triSolve(x, indptr, ind, data, p.diag, upper, trans)
+	f := (2 - p.omega) / p.omega
+	for i, v := range x {
+		dst.SetVec(i, f*v)
+	}
+	return nil
+}
+
+// IC0 is the zero fill-in incomplete Cholesky preconditioner M = L*L^T,
+// where L is lower triangular with the sparsity pattern of the lower
+// triangle of A.
+type IC0 struct {
+	l    *sparse.CSR
+	diag []float64
+}
+
+// NewIC0 returns the zero fill-in incomplete Cholesky preconditioner for
+// the symmetric positive definite matrix a. Only the lower triangle of a is
+// used. NewIC0 returns ErrNotPositiveDefinite if a non-positive pivot is
+// encountered, which may happen even if a is positive definite.
+func NewIC0(a *sparse.CSR) (*IC0, error) {
+	n := checkSquare(a)
+	aptr, aind, adata := a.RawCSR()
+
+	// Extract the lower triangle of a.
+	indptr := make([]int, n+1)
+	var ind []int
+	var data []float64
+	for i := 0; i < n; i++ {
+		for k := aptr[i]; k < aptr[i+1] && aind[k] <= i; k++ {
+			ind = append(ind, aind[k])
+			data = append(data, adata[k])
+		}
+		indptr[i+1] = len(ind)
+	}
+
+	diag := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			j := ind[k]
+			// Compute the sum of l_ip*l_jp for p < j over the
+			// common sparsity pattern of rows i and j.
+			var sum float64
+			ki, kj := indptr[i], indptr[j]
+			for ki < k && kj < indptr[j+1] && ind[kj] < j {
+				switch {
+				case ind[ki] < ind[kj]:
+					ki++
+				case ind[ki] > ind[kj]:
+					kj++
+				default:
+					sum += data[ki] * data[kj]
+					ki++
+					kj++
+				}
+			}
+			if j < i {
+				if diag[j] == 0 {
+					return nil, ErrZeroDiagonal
+				}
+				data[k] = (data[k] - sum) / diag[j]
+				continue
+			}
+			d := data[k] - sum
+			if d <= 0 {
+				return nil, ErrNotPositiveDefinite
+			}
+			diag[i] = math.Sqrt(d)
+			data[k] = diag[i]
+		}
+		if diag[i] == 0 {
+			return nil, ErrZeroDiagonal
+		}
+	}
+	return &IC0{l: sparse.NewCSR(n, n, indptr, ind, data), diag: diag}, nil
+}
+
+// PreconSolve solves M*dst = rhs and stores the result in dst. Since M is
+// symmetric, the trans parameter has no effect.
+func (p *IC0) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	n := len(p.diag)
+	if dst.Len() != n || rhs.Len() != n {
+		panic("linsolve: mismatched vector length")
+	}
+	indptr, ind, data := p.l.RawCSR()
+	x := vecCopy(rhs)
+	triSolve(x, indptr, ind, data, p.diag, true, false)
+	triSolve(x, indptr, ind, data, p.diag, true, true)
+	for i, v := range x {
+		dst.SetVec(i, v)
+	}
+	return nil
+}
+
+// ILU0 is the zero fill-in incomplete LU preconditioner M = L*U, where L is
+// unit lower triangular and U is upper triangular and the factors have the
+// sparsity pattern of the corresponding parts of A.
+type ILU0 struct {
+	lu   *sparse.CSR
+	diag []float64
+}
+
+// NewILU0 returns the zero fill-in incomplete LU preconditioner for the
+// square matrix a. All diagonal elements of a must be present in its
+// sparsity pattern. NewILU0 returns ErrZeroDiagonal if a zero pivot is
+// encountered.
+func NewILU0(a *sparse.CSR) (*ILU0, error) {
+	n := checkSquare(a)
+	aptr, aind, adata := a.RawCSR()
+	indptr := append([]int(nil), aptr...)
+	ind := append([]int(nil), aind...)
+	data := append([]float64(nil), adata...)
+
+	// diagPos[i] is the position of the diagonal element of row i.
+	diagPos := make([]int, n)
+	for i := 0; i < n; i++ {
+		diagPos[i] = -1
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			if ind[k] == i {
+				diagPos[i] = k
+				break
+			}
+		}
+		if diagPos[i] < 0 {
+			return nil, ErrZeroDiagonal
+		}
+	}
+
+	// pos[j] is the position of column j in the current row, or -1.
+	pos := make([]int, n)
+	for j := range pos {
+		pos[j] = -1
+	}
+	diag := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			pos[ind[k]] = k
+		}
+		for k := indptr[i]; k < diagPos[i]; k++ {
+			j := ind[k]
+			data[k] /= data[diagPos[j]]
+			for kk := diagPos[j] + 1; kk < indptr[j+1]; kk++ {
+				if p := pos[ind[kk]]; p >= 0 {
+					data[p] -= data[k] * data[kk]
+				}
+			}
+		}
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			pos[ind[k]] = -1
+		}
+		diag[i] = data[diagPos[i]]
+		if diag[i] == 0 {
+			return nil, ErrZeroDiagonal
+		}
+	}
+	return &ILU0{lu: sparse.NewCSR(n, n, indptr, ind, data), diag: diag}, nil
+}
+
+// PreconSolve solves M*dst = rhs, or M^T*dst = rhs if trans is true, and
+// stores the result in dst.
+func (p *ILU0) PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error {
+	n := len(p.diag)
+	if dst.Len() != n || rhs.Len() != n {
+		panic("linsolve: mismatched vector length")
+	}
+	indptr, ind, data := p.lu.RawCSR()
+	x := vecCopy(rhs)
+	if trans {
+		// M^T = U^T * L^T.
+		triSolve(x, indptr, ind, data, p.diag, false, true)
+		triSolve(x, indptr, ind, data, nil, true, true)
+	} else {
+		triSolve(x, indptr, ind, data, nil, true, false)
+		triSolve(x, indptr, ind, data, p.diag, false, false)
+	}
+	for i, v := range x {
+		dst.SetVec(i, v)
+	}
+	return nil
+}
+
+// triSolve solves T*x = b, or T^T*x = b if trans is true, in place, where T
+// is the lower triangle of the CSR matrix given by (indptr, ind, data) if
+// lower is true and the upper triangle otherwise. The diagonal of T is
+// given by diag, or is the unit diagonal if diag is nil. Elements of the
+// matrix outside the triangle and on its diagonal are ignored.
+func triSolve(x []float64, indptr, ind []int, data, diag []float64, lower, trans bool) {
+	n := len(x)
+	inTri := func(i, j int) bool {
+		if lower {
+			return j < i
+		}
+		return j > i
+	}
+	forward := lower != trans
+	for step := 0; step < n; step++ {
+		i := step
+		if !forward {
+			i = n - 1 - step
+		}
+		if !trans {
+			sum := x[i]
+			for k := indptr[i]; k < indptr[i+1]; k++ {
+				if inTri(i, ind[k]) {
+					sum -= data[k] * x[ind[k]]
+				}
+			}
+			if diag != nil {
+				sum /= diag[i]
+			}
+			x[i] = sum
+			continue
+		}
+		if diag != nil {
+			x[i] /= diag[i]
+		}
+		xi := x[i]
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			if inTri(i, ind[k]) {
+				x[ind[k]] -= data[k] * xi
+			}
+		}
+	}
+}
+
+// checkSquare panics if a is not square and returns its order.
+func checkSquare(a mat.Matrix) int {
+	r, c := a.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	return r
+}
+
+// vecCopy returns a copy of the elements of v.
+func vecCopy(v mat.Vector) []float64 {
+	x := make([]float64, v.Len())
+	for i := range x {
+		x[i] = v.AtVec(i)
+	}
+	return x
+}
@@ -0,0 +1,203 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/sparse"
+)
+
+// tridiag returns an n×n tridiagonal matrix with random off-diagonal
+// elements and a dominant diagonal. If sym is true the matrix is symmetric.
+func tridiag(n int, sym bool, rnd *rand.Rand) *sparse.CSR {
+	a := sparse.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < n; i++ {
+		a.Append(i, i, 4+rnd.Float64())
+		if i > 0 {
+			v := rnd.NormFloat64()
+			a.Append(i, i-1, v)
+			if sym {
+				a.Append(i-1, i, v)
+			} else {
+				a.Append(i-1, i, rnd.NormFloat64())
+			}
+		}
+	}
+	return a.ToCSR()
+}
+
+type preconSolver interface {
+	PreconSolve(dst *mat.VecDense, trans bool, rhs mat.Vector) error
+}
+
+// checkPreconSolve checks that p solves systems with the matrix m and
+// its transpose.
+func checkPreconSolve(t *testing.T, name string, p preconSolver, m mat.Matrix, rnd *rand.Rand) {
+	n, _ := m.Dims()
+	rhs := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		rhs.SetVec(i, rnd.NormFloat64())
+	}
+	for _, trans := range []bool{false, true} {
+		dst := mat.NewVecDense(n, nil)
+		err := p.PreconSolve(dst, trans, rhs)
+		if err != nil {
+			t.Errorf("%s trans=%t: unexpected error: %v", name, trans, err)
+			continue
+		}
+		var got mat.VecDense
+		if trans {
+			got.MulVec(m.T(), dst)
+		} else {
+			got.MulVec(m, dst)
+		}
+		if !floats.EqualApprox(got.RawVector().Data, rhs.RawVector().Data, 1e-12) {
+			t.Errorf("%s trans=%t: M*dst != rhs:\ngot: %v\nwant:%v", name, trans, got.RawVector().Data, rhs.RawVector().Data)
+		}
+	}
+}
+
+func TestJacobi(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a := tridiag(10, false, rnd)
+	p, err := NewJacobi(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	d := mat.NewDiagDense(10, nil)
+	for i := 0; i < 10; i++ {
+		d.SetDiag(i, a.At(i, i))
+	}
+	checkPreconSolve(t, "Jacobi", p, d, rnd)
+
+	_, err = NewJacobi(sparse.NewCSR(2, 2, []int{0, 1, 1}, []int{0}, []float64{1}))
+	if err != ErrZeroDiagonal {
+		t.Errorf("unexpected error for zero diagonal: got:%v want:%v", err, ErrZeroDiagonal)
+	}
+}
+
+func TestSSOR(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, omega := range []float64{0.5, 1, 1.5} {
+		for _, sym := range []bool{true, false} {
+			n := 8
+			a := tridiag(n, sym, rnd)
+			p, err := NewSSOR(a, omega)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Construct M = ω/(2-ω) * (D/ω + L) * (D/ω)^{-1} * (D/ω + U).
+			ad := a.ToDense()
+			lower := mat.NewDense(n, n, nil)
+			upper := mat.NewDense(n, n, nil)
+			dinv := mat.NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					switch {
+					case i > j:
+						lower.Set(i, j, ad.At(i, j))
+					case i < j:
+						upper.Set(i, j, ad.At(i, j))
+					default:
+						lower.Set(i, i, ad.At(i, i)/omega)
+						upper.Set(i, i, ad.At(i, i)/omega)
+						dinv.Set(i, i, omega/ad.At(i, i))
+					}
+				}
+			}
+			var m mat.Dense
+			m.Product(lower, dinv, upper)
+			m.Scale(omega/(2-omega), &m)
+			checkPreconSolve(t, "SSOR", p, &m, rnd)
+		}
+	}
+}
+
+func TestIC0(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	// There is no fill-in for a tridiagonal matrix so the incomplete
+	// factorization is exact.
+	a := tridiag(12, true, rnd)
+	p, err := NewIC0(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkPreconSolve(t, "IC0", p, a, rnd)
+
+	// For a general sparse matrix, M = L*L^T must match A on the sparsity
+	// pattern of A.
+	b := laplace2D(5, 0, 0)
+	p, err = NewIC0(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var m mat.Dense
+	l := p.l.ToDense()
+	m.Mul(l, l.T())
+	b.DoNonZero(func(i, j int, v float64) {
+		if !floats.EqualWithinAbsOrRel(m.At(i, j), v, 1e-12, 1e-12) {
+			t.Errorf("IC0: mismatch at (%d,%d): got:%v want:%v", i, j, m.At(i, j), v)
+		}
+	})
+
+	_, err = NewIC0(laplace2D(4, 5, 0))
+	if err != ErrNotPositiveDefinite {
+		t.Errorf("unexpected error for indefinite matrix: got:%v want:%v", err, ErrNotPositiveDefinite)
+	}
+}
+
+func TestILU0(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a := tridiag(12, false, rnd)
+	p, err := NewILU0(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkPreconSolve(t, "ILU0", p, a, rnd)
+
+	// For a general sparse matrix, M = L*U must match A on the sparsity
+	// pattern of A.
+	b := laplace2D(5, 0, 1)
+	p, err = NewILU0(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	n, _ := b.Dims()
+	lu := p.lu.ToDense()
+	l := mat.NewDense(n, n, nil)
+	u := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			switch {
+			case i > j:
+				l.Set(i, j, lu.At(i, j))
+			case i == j:
+				l.Set(i, i, 1)
+				u.Set(i, i, lu.At(i, i))
+			default:
+				u.Set(i, j, lu.At(i, j))
+			}
+		}
+	}
+	var m mat.Dense
+	m.Mul(l, u)
+	b.DoNonZero(func(i, j int, v float64) {
+		if !floats.EqualWithinAbsOrRel(m.At(i, j), v, 1e-12, 1e-12) {
+			t.Errorf("ILU0: mismatch at (%d,%d): got:%v want:%v", i, j, m.At(i, j), v)
+		}
+	})
+	checkPreconSolve(t, "ILU0", p, &m, rnd)
+
+	_, err = NewILU0(sparse.NewCSR(2, 2, []int{0, 1, 2}, []int{1, 0}, []float64{1, 1}))
+	if err != ErrZeroDiagonal {
+		t.Errorf("unexpected error for missing diagonal: got:%v want:%v", err, ErrZeroDiagonal)
+	}
+}
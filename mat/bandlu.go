@@ -0,0 +1,240 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// BandLU is a type for creating and using the LU factorization of a band
+// matrix.
+//
+// The factorization of an n×n matrix with kl sub-diagonals and ku
+// super-diagonals takes O(n*kl*(kl+ku)) time and the subsequent solves take
+// O(n*(2*kl+ku)) time per right-hand side, so for matrices with a small
+// bandwidth, such as tridiagonal or pentadiagonal matrices, BandLU is
+// considerably faster than LU.
+type BandLU struct {
+	// lu holds the factors in the storage format of Dgbtrf. Its KL and KU
+	// fields are the bandwidths of the original matrix and U has KL+KU
+	// super-diagonals to accommodate the fill-in from the row interchanges.
+	lu    blas64.Band
+	pivot []int
+	cond  float64
+}
+
+// updateCond updates the stored condition number of the matrix. anorm is the
+// norm of the original matrix.
+func (lu *BandLU) updateCond(anorm float64, norm lapack.MatrixNorm) {
+	n := lu.lu.Cols
+	work := getFloats(3*n, false)
+	defer putFloats(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	v := lapack64.Gbcon(norm, lu.lu, lu.pivot, anorm, work, iwork)
+	lu.cond = 1 / v
+}
+
+// Factorize computes the LU factorization of the square band matrix a and
+// stores the result. The LU decomposition will complete regardless of the
+// singularity of a.
+//
+// The LU factorization is computed with partial pivoting, and so really the
+// decomposition is a PLU decomposition where P is a permutation matrix. The
+// row interchanges widen the band of U to kl+ku super-diagonals, where kl and
+// ku are the bandwidths of a.
+func (lu *BandLU) Factorize(a Banded) {
+	lu.factorize(a, CondNorm)
+}
+
+func (lu *BandLU) factorize(a Banded, norm lapack.MatrixNorm) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	kl, ku := a.Bandwidth()
+	ldab := 2*kl + ku + 1
+	lu.lu = blas64.Band{
+		Rows:   r,
+		Cols:   r,
+		KL:     kl,
+		KU:     ku,
+		Stride: ldab,
+		Data:   useZeroed(lu.lu.Data, r*ldab),
+	}
+	if cap(lu.pivot) < r {
+		lu.pivot = make([]int, r)
+	}
+	lu.pivot = lu.pivot[:r]
+
+	// Copy the band of a into the rows of the factorization storage and
+	// compute the norm of a at the same time.
+	colSum := getFloats(r, true)
+	defer putFloats(colSum)
+	var anorm float64
+	for i := 0; i < r; i++ {
+		var rowSum float64
+		for j := max(0, i-kl); j < min(r, i+ku+1); j++ {
+			v := a.At(i, j)
+			lu.lu.Data[i*ldab+kl+j-i] = v
+			rowSum += math.Abs(v)
+			colSum[j] += math.Abs(v)
+		}
+		anorm = math.Max(anorm, rowSum)
+	}
+	if norm == lapack.MaxColumnSum {
+		anorm = floats.Max(colSum)
+	}
+
+	lapack64.Gbtrf(lu.lu, lu.pivot)
+	lu.updateCond(anorm, norm)
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a successful factorization.
+func (lu *BandLU) Cond() float64 {
+	if lu.isZero() {
+		panic("bandlu: no decomposition computed")
+	}
+	return lu.cond
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (lu *BandLU) Reset() {
+	lu.lu.Rows = 0
+	lu.lu.Cols = 0
+	lu.pivot = lu.pivot[:0]
+}
+
+func (lu *BandLU) isZero() bool {
+	return len(lu.pivot) == 0
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+func (lu *BandLU) Det() float64 {
+	det, sign := lu.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+func (lu *BandLU) LogDet() (det float64, sign float64) {
+	n := lu.lu.Cols
+	logDiag := getFloats(n, false)
+	defer putFloats(logDiag)
+	sign = 1.0
+	for i := 0; i < n; i++ {
+		v := lu.lu.Data[i*lu.lu.Stride+lu.lu.KL]
+		if v < 0 {
+			sign *= -1
+		}
+		if lu.pivot[i] != i {
+			sign *= -1
+		}
+		logDiag[i] = math.Log(math.Abs(v))
+	}
+	return floats.Sum(logDiag), sign
+}
+
+// Solve solves a system of linear equations using the LU decomposition of a
+// band matrix. It computes
+//  A * X = B if trans == false
+//  A^T * X = B if trans == true
+// In both cases, A is represented in LU factorized form, and the matrix X is
+// stored into x.
+//
+// If A is singular or near-singular a Condition error is returned. See
+// the documentation for Condition for more information.
+func (lu *BandLU) Solve(x *Dense, trans bool, b Matrix) error {
+	n := lu.lu.Cols
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	if lu.Det() == 0 {
+		return Condition(math.Inf(1))
+	}
+
+	x.reuseAs(n, bc)
+	bU, _ := untranspose(b)
+	var restore func()
+	if x == bU {
+		x, restore = x.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawMatrixer); ok {
+		x.checkOverlap(rm.RawMatrix())
+	}
+
+	x.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack64.Gbtrs(t, lu.lu, lu.pivot, x.mat)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// SolveVec solves a system of linear equations using the LU decomposition of a
+// band matrix. It computes
+//  A * x = b if trans == false
+//  A^T * x = b if trans == true
+// In both cases, A is represented in LU factorized form, and the vector x is
+// stored into x.
+//
+// If A is singular or near-singular a Condition error is returned. See
+// the documentation for Condition for more information.
+func (lu *BandLU) SolveVec(x *VecDense, trans bool, b Vector) error {
+	n := lu.lu.Cols
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	switch rv := b.(type) {
+	default:
+		x.reuseAs(n)
+		return lu.Solve(x.asDense(), trans, b)
+	case RawVectorer:
+		if x != b {
+			x.checkOverlap(rv.RawVector())
+		}
+		if lu.Det() == 0 {
+			return Condition(math.Inf(1))
+		}
+
+		x.reuseAs(n)
+		var restore func()
+		if x == b {
+			x, restore = x.isolatedWorkspace(b)
+			defer restore()
+		}
+		x.CopyVec(b)
+		vMat := blas64.General{
+			Rows:   n,
+			Cols:   1,
+			Stride: x.mat.Inc,
+			Data:   x.mat.Data,
+		}
+		t := blas.NoTrans
+		if trans {
+			t = blas.Trans
+		}
+		lapack64.Gbtrs(t, lu.lu, lu.pivot, vMat)
+		if lu.cond > ConditionTolerance {
+			return Condition(lu.cond)
+		}
+		return nil
+	}
+}
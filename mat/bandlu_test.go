@@ -0,0 +1,126 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestBandLU(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, kl, ku int
+	}{
+		{1, 0, 0},
+		{5, 0, 0},
+		{5, 1, 1},
+		{10, 1, 1},
+		{10, 2, 2},
+		{10, 0, 3},
+		{10, 3, 0},
+		{20, 2, 5},
+		{20, 5, 2},
+		{20, 19, 19},
+	} {
+		n, kl, ku := test.n, test.kl, test.ku
+		a := NewBandDense(n, n, kl, ku, nil)
+		for i := 0; i < n; i++ {
+			for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+				a.SetBand(i, j, rnd.NormFloat64())
+			}
+		}
+		var dense Dense
+		dense.Clone(a)
+
+		var blu BandLU
+		blu.Factorize(a)
+		var lu LU
+		lu.Factorize(&dense)
+
+		if math.Abs(blu.Det()-lu.Det()) > tol*math.Max(1, math.Abs(lu.Det())) {
+			t.Errorf("n=%d,kl=%d,ku=%d: determinant mismatch: got %v, want %v", n, kl, ku, blu.Det(), lu.Det())
+		}
+		if math.Abs(blu.Cond()-lu.Cond()) > 1e-8*lu.Cond() {
+			t.Errorf("n=%d,kl=%d,ku=%d: condition number mismatch: got %v, want %v", n, kl, ku, blu.Cond(), lu.Cond())
+		}
+
+		for _, trans := range []bool{false, true} {
+			for _, bc := range []int{1, 4} {
+				b := NewDense(n, bc, nil)
+				for i := range b.mat.Data {
+					b.mat.Data[i] = rnd.NormFloat64()
+				}
+				var x Dense
+				if err := blu.Solve(&x, trans, b); err != nil {
+					t.Errorf("n=%d,kl=%d,ku=%d: unexpected error: %v", n, kl, ku, err)
+					continue
+				}
+				var ax Dense
+				if trans {
+					ax.Mul(a.T(), &x)
+				} else {
+					ax.Mul(a, &x)
+				}
+				if !EqualApprox(&ax, b, tol*blu.Cond()) {
+					t.Errorf("n=%d,kl=%d,ku=%d,trans=%t: incorrect solution of A*X=B", n, kl, ku, trans)
+				}
+
+				// Check that the result is the same when the solution
+				// overwrites the right-hand side.
+				var xb Dense
+				xb.Clone(b)
+				if err := blu.Solve(&xb, trans, &xb); err != nil {
+					t.Errorf("n=%d,kl=%d,ku=%d: unexpected error: %v", n, kl, ku, err)
+				}
+				if !Equal(&xb, &x) {
+					t.Errorf("n=%d,kl=%d,ku=%d,trans=%t: solution mismatch for aliased receiver", n, kl, ku, trans)
+				}
+			}
+
+			b := NewVecDense(n, nil)
+			for i := 0; i < n; i++ {
+				b.SetVec(i, rnd.NormFloat64())
+			}
+			var x VecDense
+			if err := blu.SolveVec(&x, trans, b); err != nil {
+				t.Errorf("n=%d,kl=%d,ku=%d: unexpected error: %v", n, kl, ku, err)
+				continue
+			}
+			var ax VecDense
+			if trans {
+				ax.MulVec(a.T(), &x)
+			} else {
+				ax.MulVec(a, &x)
+			}
+			if !EqualApprox(&ax, b, tol*blu.Cond()) {
+				t.Errorf("n=%d,kl=%d,ku=%d,trans=%t: incorrect solution of A*x=b", n, kl, ku, trans)
+			}
+		}
+	}
+}
+
+func TestBandLUSingular(t *testing.T) {
+	// Tridiagonal matrix with a zero row.
+	a := NewBandDense(4, 4, 1, 1, []float64{
+		0, 2, 1,
+		1, 2, 1,
+		0, 0, 0,
+		1, 2, 0,
+	})
+	var lu BandLU
+	lu.Factorize(a)
+	if lu.Det() != 0 {
+		t.Errorf("unexpected determinant of singular matrix: got %v", lu.Det())
+	}
+	var x Dense
+	err := lu.Solve(&x, false, NewDense(4, 1, nil))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for singular matrix: got %v", err)
+	}
+}
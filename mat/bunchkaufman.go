@@ -0,0 +1,212 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badBunchKaufman = "mat: invalid Bunch-Kaufman factorization"
+
+// BunchKaufman is a type for creating and using the Bunch-Kaufman
+// factorization of a symmetric matrix. The factorization has the form
+//  A = U * D * U^T,
+// where U is a product of permutation and unit upper triangular matrices and
+// D is symmetric and block diagonal with 1×1 and 2×2 diagonal blocks.
+//
+// Unlike the Cholesky factorization, the Bunch-Kaufman factorization does not
+// require A to be positive definite, so it can be used for symmetric
+// indefinite systems such as the KKT systems arising in constrained
+// optimization.
+//
+// BunchKaufman methods may only be called on a value that has been
+// successfully initialized by a call to Factorize that has returned true.
+// Calls to methods of an unsuccessful factorization will panic.
+type BunchKaufman struct {
+	// sym holds the factors U and D in its upper triangle as returned by
+	// Dsytrf.
+	sym  *SymDense
+	ipiv []int
+	cond float64
+}
+
+// updateCond updates the condition number of the factorization. anorm is the
+// norm of the original matrix A.
+func (bk *BunchKaufman) updateCond(anorm float64) {
+	n := bk.sym.mat.N
+	work := getFloats(2*n, false)
+	defer putFloats(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	v := lapack64.Sycon(bk.sym.mat, bk.ipiv, anorm, work, iwork)
+	bk.cond = 1 / v
+}
+
+// Factorize calculates the Bunch-Kaufman factorization of the symmetric matrix
+// A and returns whether the matrix is nonsingular. If Factorize returns false,
+// the factorization must not be used.
+func (bk *BunchKaufman) Factorize(a Symmetric) (ok bool) {
+	n := a.Symmetric()
+	if bk.sym == nil {
+		bk.sym = NewSymDense(n, nil)
+	} else {
+		bk.sym = NewSymDense(n, use(bk.sym.mat.Data, n*n))
+	}
+	bk.sym.CopySym(a)
+	if cap(bk.ipiv) < n {
+		bk.ipiv = make([]int, n)
+	}
+	bk.ipiv = bk.ipiv[:n]
+
+	work := getFloats(n, false)
+	anorm := lapack64.Lansy(CondNorm, bk.sym.mat, work)
+	putFloats(work)
+	ok = lapack64.Sytrf(bk.sym.mat, bk.ipiv)
+	if ok {
+		bk.updateCond(anorm)
+	} else {
+		bk.Reset()
+	}
+	return ok
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (bk *BunchKaufman) Reset() {
+	if bk.sym != nil {
+		bk.sym.Reset()
+	}
+	bk.ipiv = bk.ipiv[:0]
+	bk.cond = math.Inf(1)
+}
+
+func (bk *BunchKaufman) valid() bool {
+	return bk.sym != nil && !bk.sym.IsZero()
+}
+
+// Cond returns the condition number of the factorized matrix.
+func (bk *BunchKaufman) Cond() float64 {
+	if !bk.valid() {
+		panic(badBunchKaufman)
+	}
+	return bk.cond
+}
+
+// Size returns the dimension of the factorized matrix.
+func (bk *BunchKaufman) Size() int {
+	if !bk.valid() {
+		panic(badBunchKaufman)
+	}
+	return bk.sym.mat.N
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+func (bk *BunchKaufman) Det() float64 {
+	det, sign := bk.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the absolute value of the determinant and the sign
+// of the determinant for the matrix that has been factorized. Numerical
+// stability in product and division expressions is generally improved by
+// working in log space.
+func (bk *BunchKaufman) LogDet() (det float64, sign float64) {
+	if !bk.valid() {
+		panic(badBunchKaufman)
+	}
+	// The permutations are applied symmetrically, so the determinant of A
+	// is equal to the determinant of the block diagonal matrix D.
+	n := bk.sym.mat.N
+	lda := bk.sym.mat.Stride
+	d := bk.sym.mat.Data
+	sign = 1
+	for k := 0; k < n; k++ {
+		if bk.ipiv[k] >= 0 {
+			v := d[k*lda+k]
+			if v < 0 {
+				sign = -sign
+			}
+			det += math.Log(math.Abs(v))
+			continue
+		}
+		// The determinant of the 2×2 block
+		//  [a b]
+		//  [b c]
+		// is computed as b^2 * ((a/b)*(c/b) - 1) to avoid overflow.
+		a := d[k*lda+k]
+		b := d[k*lda+k+1]
+		c := d[(k+1)*lda+k+1]
+		v := (a/b)*(c/b) - 1
+		if v < 0 {
+			sign = -sign
+		}
+		det += math.Log(math.Abs(v)) + 2*math.Log(math.Abs(b))
+		k++
+	}
+	return det, sign
+}
+
+// Solve finds the matrix x that solves A * X = B where A is represented
+// by the Bunch-Kaufman factorization, placing the result in x.
+//
+// If A is near-singular a Condition error is returned. See the documentation
+// for Condition for more information.
+func (bk *BunchKaufman) Solve(x *Dense, b Matrix) error {
+	if !bk.valid() {
+		panic(badBunchKaufman)
+	}
+	n := bk.sym.mat.N
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+
+	x.reuseAs(bm, bn)
+	if b != x {
+		x.Copy(b)
+	}
+	lapack64.Sytrs(bk.sym.mat, bk.ipiv, x.mat)
+	if bk.cond > ConditionTolerance {
+		return Condition(bk.cond)
+	}
+	return nil
+}
+
+// SolveVec finds the vector x that solves A * x = b where A is represented
+// by the Bunch-Kaufman factorization, placing the result in x.
+//
+// If A is near-singular a Condition error is returned. See the documentation
+// for Condition for more information.
+func (bk *BunchKaufman) SolveVec(x *VecDense, b Vector) error {
+	if !bk.valid() {
+		panic(badBunchKaufman)
+	}
+	n := bk.sym.mat.N
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	switch rv := b.(type) {
+	default:
+		x.reuseAs(n)
+		return bk.Solve(x.asDense(), b)
+	case RawVectorer:
+		bmat := rv.RawVector()
+		if x != b {
+			x.checkOverlap(bmat)
+		}
+		x.reuseAs(n)
+		if x != b {
+			x.CopyVec(b)
+		}
+		lapack64.Sytrs(bk.sym.mat, bk.ipiv, x.asGeneral())
+		if bk.cond > ConditionTolerance {
+			return Condition(bk.cond)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestBunchKaufman(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 31, 50} {
+		for _, zeroDiag := range []bool{false, true} {
+			if zeroDiag && n == 1 {
+				continue
+			}
+			a := NewSymDense(n, nil)
+			for i := 0; i < n; i++ {
+				for j := i; j < n; j++ {
+					if i == j && zeroDiag {
+						continue
+					}
+					a.SetSym(i, j, rnd.NormFloat64())
+				}
+			}
+
+			var bk BunchKaufman
+			if !bk.Factorize(a) {
+				t.Errorf("n=%d: unexpected factorization failure", n)
+				continue
+			}
+			if bk.Size() != n {
+				t.Errorf("n=%d: unexpected size: got %d", n, bk.Size())
+			}
+
+			var lu LU
+			lu.Factorize(a)
+			if math.Abs(bk.Det()-lu.Det()) > tol*math.Max(1, math.Abs(lu.Det())) {
+				t.Errorf("n=%d: determinant mismatch: got %v, want %v", n, bk.Det(), lu.Det())
+			}
+			logDet, sign := bk.LogDet()
+			wantLogDet, wantSign := lu.LogDet()
+			if sign != wantSign || math.Abs(logDet-wantLogDet) > tol*math.Max(1, math.Abs(wantLogDet)) {
+				t.Errorf("n=%d: log determinant mismatch: got %v,%v, want %v,%v", n, logDet, sign, wantLogDet, wantSign)
+			}
+
+			// The condition number estimate is a lower bound of the
+			// exact condition number.
+			var ainv Dense
+			if err := bk.Solve(&ainv, eye(n)); err != nil {
+				t.Fatalf("n=%d: unexpected error from Solve: %v", n, err)
+			}
+			want := Norm(a, 1) * Norm(&ainv, 1)
+			if bk.Cond() > want*(1+1e-10) || bk.Cond() < want/10 {
+				t.Errorf("n=%d: unexpected condition number: got %v, want %v", n, bk.Cond(), want)
+			}
+
+			for _, bc := range []int{1, 3} {
+				b := NewDense(n, bc, nil)
+				for i := range b.mat.Data {
+					b.mat.Data[i] = rnd.NormFloat64()
+				}
+				var x Dense
+				if err := bk.Solve(&x, b); err != nil {
+					t.Errorf("n=%d: unexpected error from Solve: %v", n, err)
+					continue
+				}
+				var ax Dense
+				ax.Mul(a, &x)
+				if !EqualApprox(&ax, b, tol*bk.Cond()) {
+					t.Errorf("n=%d: incorrect solution of A*X=B", n)
+				}
+
+				// Check that the solution is the same when the
+				// receiver aliases the right-hand side.
+				var xb Dense
+				xb.Clone(b)
+				if err := bk.Solve(&xb, &xb); err != nil {
+					t.Errorf("n=%d: unexpected error from Solve: %v", n, err)
+				}
+				if !Equal(&xb, &x) {
+					t.Errorf("n=%d: solution mismatch for aliased receiver", n)
+				}
+			}
+
+			b := NewVecDense(n, nil)
+			for i := 0; i < n; i++ {
+				b.SetVec(i, rnd.NormFloat64())
+			}
+			var x VecDense
+			if err := bk.SolveVec(&x, b); err != nil {
+				t.Errorf("n=%d: unexpected error from SolveVec: %v", n, err)
+				continue
+			}
+			var ax VecDense
+			ax.MulVec(a, &x)
+			if !EqualApprox(&ax, b, tol*bk.Cond()) {
+				t.Errorf("n=%d: incorrect solution of A*x=b", n)
+			}
+		}
+	}
+}
+
+func TestBunchKaufmanSingular(t *testing.T) {
+	a := NewSymDense(3, []float64{
+		1, 2, 3,
+		0, 4, 6,
+		0, 0, 0,
+	})
+	var bk BunchKaufman
+	if bk.Factorize(a) {
+		t.Error("unexpected success factorizing singular matrix")
+	}
+	if panicked, _ := panics(func() { bk.Cond() }); !panicked {
+		t.Error("Cond did not panic after failed factorization")
+	}
+}
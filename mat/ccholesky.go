@@ -0,0 +1,229 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+const badCCholesky = "mat: invalid complex Cholesky factorization"
+
+// CCholesky is a type for creating and using the Cholesky factorization of a
+// Hermitian positive definite matrix,
+//  A = U^H * U,
+// where U is upper triangular with a real positive diagonal.
+//
+// CCholesky methods may only be called on a value that has been successfully
+// initialized by a call to Factorize that has returned true. Calls to methods
+// of an unsuccessful CCholesky factorization will panic.
+type CCholesky struct {
+	// The upper triangle of chol holds U and the strictly lower
+	// triangle is zero.
+	chol *CDense
+	cond float64
+}
+
+// updateCond updates the condition number of the Cholesky decomposition
+// using anorm as the norm of the original matrix A.
+func (c *CCholesky) updateCond(anorm float64) {
+	n := c.chol.mat.Rows
+	work := make([]complex128, 2*n)
+	v := lapack128.Pocon(c.hermitian(), anorm, work)
+	c.cond = 1 / v
+}
+
+// Cond returns the condition number of the factorized matrix.
+func (c *CCholesky) Cond() float64 {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	return c.cond
+}
+
+// Factorize calculates the Cholesky decomposition of the Hermitian matrix A
+// and returns whether the matrix is positive definite. Only the upper
+// triangle of a is used and the imaginary parts of its diagonal elements are
+// ignored. If Factorize returns false, the factorization must not be used.
+//
+// Factorize will panic if a is not square.
+func (c *CCholesky) Factorize(a CMatrix) (ok bool) {
+	r, n := a.Dims()
+	if r != n {
+		panic(ErrSquare)
+	}
+	if c.chol == nil {
+		c.chol = NewCDense(n, n, nil)
+	} else {
+		c.chol.Reset()
+		c.chol.reuseAsZeroed(n, n)
+	}
+	u := c.chol.mat
+	for i := 0; i < n; i++ {
+		u.Data[i*u.Stride+i] = complex(real(a.At(i, i)), 0)
+		for j := i + 1; j < n; j++ {
+			u.Data[i*u.Stride+j] = a.At(i, j)
+		}
+	}
+	work := getFloats(n, false)
+	anorm := lapack128.Lanhe(CondNorm, c.hermitian(), work)
+	putFloats(work)
+	_, ok = lapack128.Potrf(c.hermitian())
+	if ok {
+		c.updateCond(anorm)
+	} else {
+		c.Reset()
+	}
+	return ok
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (c *CCholesky) Reset() {
+	if c.chol != nil {
+		c.chol.Reset()
+	}
+	c.cond = math.Inf(1)
+}
+
+// Size returns the dimension of the factorized matrix.
+func (c *CCholesky) Size() int {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	return c.chol.mat.Rows
+}
+
+// Det returns the determinant of the matrix that has been factorized. The
+// determinant of a Hermitian matrix is real.
+func (c *CCholesky) Det() float64 {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	return math.Exp(c.LogDet())
+}
+
+// LogDet returns the log of the determinant of the matrix that has been factorized.
+func (c *CCholesky) LogDet() float64 {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	var det float64
+	for i := 0; i < c.chol.mat.Rows; i++ {
+		det += 2 * math.Log(real(c.chol.mat.Data[i*c.chol.mat.Stride+i]))
+	}
+	return det
+}
+
+// Solve finds the matrix x that solves A * X = B where A is represented
+// by the Cholesky decomposition, placing the result in x.
+func (c *CCholesky) Solve(x *CDense, b CMatrix) error {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n := c.chol.mat.Rows
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+
+	x.reuseAs(bm, bn)
+	if b != x {
+		x.Copy(b)
+	}
+	lapack128.Potrs(c.triangular(), x.mat)
+	if c.cond > ConditionTolerance {
+		return Condition(c.cond)
+	}
+	return nil
+}
+
+// UTo extracts the n×n upper triangular matrix U from a Cholesky
+// decomposition
+//  A = U^H * U.
+// If dst is nil, a new matrix is allocated. The resulting dst matrix is returned.
+func (c *CCholesky) UTo(dst *CDense) *CDense {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n := c.chol.mat.Rows
+	if dst == nil {
+		dst = NewCDense(n, n, nil)
+	} else {
+		dst.reuseAs(n, n)
+	}
+	dst.Copy(c.chol)
+	return dst
+}
+
+// LTo extracts the n×n lower triangular matrix L from a Cholesky
+// decomposition
+//  A = L * L^H.
+// If dst is nil, a new matrix is allocated. The resulting dst matrix is returned.
+func (c *CCholesky) LTo(dst *CDense) *CDense {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n := c.chol.mat.Rows
+	if dst == nil {
+		dst = NewCDense(n, n, nil)
+	} else {
+		dst.reuseAs(n, n)
+	}
+	dst.Copy(c.chol.H())
+	return dst
+}
+
+// ToCDense reconstructs the original Hermitian matrix given its Cholesky
+// decomposition, storing the result into dst. If dst is nil, a new matrix
+// is allocated. The resulting matrix is returned.
+func (c *CCholesky) ToCDense(dst *CDense) *CDense {
+	if !c.valid() {
+		panic(badCCholesky)
+	}
+	n := c.chol.mat.Rows
+	if dst == nil {
+		dst = NewCDense(n, n, nil)
+	} else {
+		dst.reuseAs(n, n)
+	}
+	dst.Mul(c.chol.H(), c.chol)
+	// Enforce an exactly real diagonal.
+	for i := 0; i < n; i++ {
+		dst.mat.Data[i*dst.mat.Stride+i] = complex(real(dst.mat.Data[i*dst.mat.Stride+i]), 0)
+	}
+	return dst
+}
+
+// hermitian returns the upper triangle of the receiver's storage as a
+// Hermitian matrix.
+func (c *CCholesky) hermitian() cblas128.Hermitian {
+	u := c.chol.mat
+	return cblas128.Hermitian{
+		N:      u.Rows,
+		Stride: u.Stride,
+		Data:   u.Data,
+		Uplo:   blas.Upper,
+	}
+}
+
+// triangular returns the Cholesky factor U as a triangular matrix.
+func (c *CCholesky) triangular() cblas128.Triangular {
+	u := c.chol.mat
+	return cblas128.Triangular{
+		N:      u.Rows,
+		Stride: u.Stride,
+		Data:   u.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+}
+
+func (c *CCholesky) valid() bool {
+	return c.chol != nil && !c.chol.IsZero()
+}
@@ -0,0 +1,106 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// randHPD returns a random n×n Hermitian positive definite matrix.
+func randHPD(n int, rnd *rand.Rand) *CDense {
+	b := randCDense(n, n, rnd)
+	var a CDense
+	a.Mul(b.H(), b)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, complex(real(a.At(i, i))+float64(n), 0))
+	}
+	return &a
+}
+
+func TestCCholesky(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 10, 65, 130} {
+		a := randHPD(n, rnd)
+		// Corrupt the strictly lower triangle and the imaginary part of
+		// the diagonal, which must be ignored.
+		upper := CDenseCopyOf(a)
+		for i := 0; i < n; i++ {
+			upper.Set(i, i, a.At(i, i)+1i)
+			for j := 0; j < i; j++ {
+				upper.Set(i, j, cmplx.NaN())
+			}
+		}
+
+		var chol CCholesky
+		ok := chol.Factorize(upper)
+		if !ok {
+			t.Fatalf("n=%d: unexpected factorization failure", n)
+		}
+		if chol.Size() != n {
+			t.Errorf("n=%d: unexpected size: %d", n, chol.Size())
+		}
+
+		u := chol.UTo(nil)
+		l := chol.LTo(nil)
+		for i := 0; i < n; i++ {
+			if imag(u.At(i, i)) != 0 || real(u.At(i, i)) <= 0 {
+				t.Errorf("n=%d: diagonal of U not real positive", n)
+			}
+			for j := 0; j < i; j++ {
+				if u.At(i, j) != 0 || l.At(j, i) != 0 {
+					t.Errorf("n=%d: U or L not triangular", n)
+				}
+			}
+		}
+		var got CDense
+		got.Mul(l, u)
+		if !CEqualApprox(&got, a, 1e-12) {
+			t.Errorf("n=%d: U^H * U does not equal original matrix", n)
+		}
+		if !CEqualApprox(chol.ToCDense(nil), a, 1e-12) {
+			t.Errorf("n=%d: unexpected result from ToCDense", n)
+		}
+
+		var lu CLU
+		lu.Factorize(a)
+		logdet, _ := lu.LogDet()
+		if math.Abs(chol.LogDet()-logdet) > 1e-8*math.Abs(logdet) {
+			t.Errorf("n=%d: log determinant mismatch: Cholesky %v, LU %v", n, chol.LogDet(), logdet)
+		}
+
+		b := randCDense(n, 3, rnd)
+		var x CDense
+		err := chol.Solve(&x, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error from Solve: %v", n, err)
+		}
+		var ax CDense
+		ax.Mul(a, &x)
+		if !CEqualApprox(&ax, b, 1e-10) {
+			t.Errorf("n=%d: unexpected solution", n)
+		}
+
+		if chol.Cond() < 1 || chol.Cond() > lu.Cond()*10 || chol.Cond() < lu.Cond()/10 {
+			t.Errorf("n=%d: condition number mismatch: Cholesky %v, LU %v", n, chol.Cond(), lu.Cond())
+		}
+	}
+
+	// Hermitian indefinite matrix.
+	a := NewCDense(2, 2, []complex128{
+		1, 2 + 1i,
+		2 - 1i, 1,
+	})
+	var chol CCholesky
+	if chol.Factorize(a) {
+		t.Errorf("unexpected success factorizing an indefinite matrix")
+	}
+	if ok, _ := panics(func() { chol.Det() }); !ok {
+		t.Errorf("expected panic using a failed factorization")
+	}
+}
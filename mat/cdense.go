@@ -4,9 +4,20 @@
 
 package mat
 
-import "gonum.org/v1/gonum/blas/cblas128"
+import (
+	"math/cmplx"
 
-// Dense is a dense matrix representation with complex data.
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+var (
+	cDense *CDense
+
+	_ CMatrix      = cDense
+	_ RawCMatrixer = cDense
+)
+
+// CDense is a dense matrix representation with complex data.
 type CDense struct {
 	mat cblas128.General
 
@@ -18,6 +29,9 @@ func (m *CDense) Dims() (r, c int) {
 	return m.mat.Rows, m.mat.Cols
 }
 
+// Caps returns the number of rows and columns in the backing matrix.
+func (m *CDense) Caps() (r, c int) { return m.capRows, m.capCols }
+
 // H performs an implicit conjugate transpose by returning the receiver inside a
 // Conjugate.
 func (m *CDense) H() CMatrix {
@@ -58,6 +72,49 @@ func NewCDense(r, c int, data []complex128) *CDense {
 	}
 }
 
+// CDenseCopyOf returns a newly allocated copy of the elements of a.
+func CDenseCopyOf(a CMatrix) *CDense {
+	d := &CDense{}
+	d.Clone(a)
+	return d
+}
+
+// SetRawCMatrix sets the underlying cblas128.General used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in b.
+func (m *CDense) SetRawCMatrix(b cblas128.General) {
+	m.capRows, m.capCols = b.Rows, b.Cols
+	m.mat = b
+}
+
+// RawCMatrix returns the underlying cblas128.General used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in returned cblas128.General.
+func (m *CDense) RawCMatrix() cblas128.General { return m.mat }
+
+// Slice returns a new CMatrix that shares backing data with the receiver.
+// The returned matrix starts at {i,j} of the receiver and extends k-i rows
+// and l-j columns. The final row in the resulting matrix is k-1 and the
+// final column is l-1.
+// Slice panics with ErrIndexOutOfRange if the slice is outside the capacity
+// of the receiver.
+func (m *CDense) Slice(i, k, j, l int) CMatrix {
+	mr, mc := m.Caps()
+	if i < 0 || mr <= i || j < 0 || mc <= j || k < i || mr < k || l < j || mc < l {
+		if i == k || j == l {
+			panic(ErrZeroLength)
+		}
+		panic(ErrIndexOutOfRange)
+	}
+	t := *m
+	t.mat.Data = t.mat.Data[i*t.mat.Stride+j : (k-1)*t.mat.Stride+l]
+	t.mat.Rows = k - i
+	t.mat.Cols = l - j
+	t.capRows -= i
+	t.capCols -= j
+	return &t
+}
+
 // reuseAs resizes an empty matrix to a r×c matrix,
 // or checks that a non-empty matrix is r×c.
 //
@@ -112,6 +169,21 @@ func (m *CDense) reuseAsZeroed(r, c int) {
 	m.Zero()
 }
 
+// isolatedWorkspace returns a new complex dense matrix w with the size of a
+// and returns a callback to defer which performs cleanup at the return of
+// the call. This should be used when a method receiver is the same pointer
+// as an input argument.
+func (m *CDense) isolatedWorkspace(a CMatrix) (w *CDense, restore func()) {
+	r, c := a.Dims()
+	if r == 0 || c == 0 {
+		panic(ErrZeroLength)
+	}
+	w = NewCDense(r, c, nil)
+	return w, func() {
+		m.Copy(w)
+	}
+}
+
 // Reset zeros the dimensions of the matrix so that it can be reused as the
 // receiver of a dimensionally restricted operation.
 //
@@ -140,11 +212,49 @@ func (m *CDense) Zero() {
 	}
 }
 
+// Clone makes a copy of a into the receiver, overwriting the previous value of
+// the receiver. The clone operation does not make any restriction on shape and
+// will not cause shadowing.
+func (m *CDense) Clone(a CMatrix) {
+	r, c := a.Dims()
+	mat := cblas128.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: c,
+		Data:   make([]complex128, r*c),
+	}
+	m.capRows, m.capCols = r, c
+
+	aU, conj := unconjugate(a)
+	switch aU := aU.(type) {
+	case RawCMatrixer:
+		amat := aU.RawCMatrix()
+		if conj {
+			for i := 0; i < r; i++ {
+				row := mat.Data[i*c : (i+1)*c]
+				for j := range row {
+					row[j] = cmplx.Conj(amat.Data[j*amat.Stride+i])
+				}
+			}
+		} else {
+			for i := 0; i < r; i++ {
+				copy(mat.Data[i*c:(i+1)*c], amat.Data[i*amat.Stride:i*amat.Stride+c])
+			}
+		}
+	default:
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				mat.Data[i*c+j] = a.At(i, j)
+			}
+		}
+	}
+	m.mat = mat
+}
+
 // Copy makes a copy of elements of a into the receiver. It is similar to the
 // built-in copy; it copies as much as the overlap between the two matrices and
 // returns the number of rows and columns it copied. If a aliases the receiver
-// and is a transposed Dense or VecDense, with a non-unitary increment, Copy will
-// panic.
+// and is a conjugate transposed CDense, Copy will panic.
 //
 // See the Copier interface for more information.
 func (m *CDense) Copy(a CMatrix) (r, c int) {
@@ -157,11 +267,39 @@ func (m *CDense) Copy(a CMatrix) (r, c int) {
 	if r == 0 || c == 0 {
 		return 0, 0
 	}
-	// TODO(btracey): Check for overlap when complex version exists.
-	// TODO(btracey): Add fast-paths.
-	for i := 0; i < r; i++ {
-		for j := 0; j < c; j++ {
-			m.set(i, j, a.At(i, j))
+
+	aU, conj := unconjugate(a)
+	switch aU := aU.(type) {
+	case RawCMatrixer:
+		amat := aU.RawCMatrix()
+		if conj {
+			m.checkOverlap(amat)
+			for i := 0; i < r; i++ {
+				row := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c]
+				for j := range row {
+					row[j] = cmplx.Conj(amat.Data[j*amat.Stride+i])
+				}
+			}
+		} else {
+			switch o := offsetComplex(m.mat.Data, amat.Data); {
+			case o < 0:
+				for i := r - 1; i >= 0; i-- {
+					copy(m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+c], amat.Data[i*amat.Stride:i*amat.Stride+c])
+				}
+			case o > 0:
+				for i := 0; i < r; i++ {
+					copy(m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+c], amat.Data[i*amat.Stride:i*amat.Stride+c])
+				}
+			default:
+				// Nothing to do.
+			}
+		}
+	default:
+		m.checkOverlapMatrix(aU)
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				m.set(i, j, a.At(i, j))
+			}
 		}
 	}
 	return r, c
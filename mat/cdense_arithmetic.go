@@ -0,0 +1,204 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Add adds a and b element-wise, placing the result in the receiver. Add
+// will panic if the two matrices do not have the same shape.
+func (m *CDense) Add(a, b CMatrix) {
+	m.elementwise(a, b, func(x, y complex128) complex128 { return x + y })
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. Sub
+// will panic if the two matrices do not have the same shape.
+func (m *CDense) Sub(a, b CMatrix) {
+	m.elementwise(a, b, func(x, y complex128) complex128 { return x - y })
+}
+
+// MulElem performs element-wise multiplication of a and b, placing the result
+// in the receiver. MulElem will panic if the two matrices do not have the same
+// shape.
+func (m *CDense) MulElem(a, b CMatrix) {
+	m.elementwise(a, b, func(x, y complex128) complex128 { return x * y })
+}
+
+// DivElem performs element-wise division of a by b, placing the result
+// in the receiver. DivElem will panic if the two matrices do not have the same
+// shape.
+func (m *CDense) DivElem(a, b CMatrix) {
+	m.elementwise(a, b, func(x, y complex128) complex128 { return x / y })
+}
+
+// elementwise places fn(a_ij, b_ij) into the receiver for each element of a
+// and b. It panics if a and b do not have the same shape.
+func (m *CDense) elementwise(a, b CMatrix, fn func(x, y complex128) complex128) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+
+	aU, _ := unconjugate(a)
+	bU, _ := unconjugate(b)
+	m.reuseAs(ar, ac)
+
+	if arm, ok := a.(RawCMatrixer); ok {
+		if brm, ok := b.(RawCMatrixer); ok {
+			amat, bmat := arm.RawCMatrix(), brm.RawCMatrix()
+			if m != aU {
+				m.checkOverlap(amat)
+			}
+			if m != bU {
+				m.checkOverlap(bmat)
+			}
+			for ja, jb, jm := 0, 0, 0; ja < ar*amat.Stride; ja, jb, jm = ja+amat.Stride, jb+bmat.Stride, jm+m.mat.Stride {
+				for i, v := range amat.Data[ja : ja+ac] {
+					m.mat.Data[i+jm] = fn(v, bmat.Data[i+jb])
+				}
+			}
+			return
+		}
+	}
+
+	m.checkOverlapMatrix(aU)
+	m.checkOverlapMatrix(bU)
+	var restore func()
+	if m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, fn(a.At(r, c), b.At(r, c)))
+		}
+	}
+}
+
+// Inverse computes the inverse of the matrix a, storing the result into the
+// receiver. If a is ill-conditioned, a Condition error will be returned.
+// Note that matrix inversion is numerically unstable, and should generally
+// be avoided where possible, for example by using the Solve routines.
+func (m *CDense) Inverse(a CMatrix) error {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	var lu CLU
+	lu.Factorize(a)
+	m.reuseAsZeroed(r, r)
+	for i := 0; i < r; i++ {
+		m.mat.Data[i*m.mat.Stride+i] = 1
+	}
+	return lu.Solve(m, false, m)
+}
+
+// Mul takes the matrix product of a and b, placing the result in the receiver.
+// If the number of columns in a does not equal the number of rows in b, Mul will panic.
+func (m *CDense) Mul(a, b CMatrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+
+	if ac != br {
+		panic(ErrShape)
+	}
+
+	aU, aConj := unconjugate(a)
+	bU, bConj := unconjugate(b)
+	m.reuseAs(ar, bc)
+	var restore func()
+	if m == aU {
+		m, restore = m.isolatedWorkspace(aU)
+		defer restore()
+	} else if m == bU {
+		m, restore = m.isolatedWorkspace(bU)
+		defer restore()
+	}
+
+	aT, amat := rawOperand(aU, aConj)
+	if restore == nil {
+		m.checkOverlap(amat)
+	}
+	bT, bmat := rawOperand(bU, bConj)
+	if restore == nil {
+		m.checkOverlap(bmat)
+	}
+	cblas128.Gemm(aT, bT, 1, amat, bmat, 0, m.mat)
+}
+
+// rawOperand returns the cblas128.General representation of the unconjugated
+// matrix a and the corresponding transpose flag for use in a BLAS call. If a
+// is not a RawCMatrixer, a copy of its elements is made.
+func rawOperand(a CMatrix, conj bool) (blas.Transpose, cblas128.General) {
+	t := blas.NoTrans
+	if conj {
+		t = blas.ConjTrans
+	}
+	if rm, ok := a.(RawCMatrixer); ok {
+		return t, rm.RawCMatrix()
+	}
+	return t, CDenseCopyOf(a).mat
+}
+
+// Scale multiplies the elements of a by f, placing the result in the receiver.
+func (m *CDense) Scale(f complex128, a CMatrix) {
+	m.Apply(func(_, _ int, v complex128) complex128 { return f * v }, a)
+}
+
+// Conj calculates the element-wise conjugate of a and stores the result in
+// the receiver. Conj will panic if m and a do not have the same dimension
+// unless m is empty.
+func (m *CDense) Conj(a CMatrix) {
+	m.Apply(func(_, _ int, v complex128) complex128 { return cmplx.Conj(v) }, a)
+}
+
+// Apply applies the function fn to each of the elements of a, placing the
+// resulting matrix in the receiver. The function fn takes a row/column
+// index and element value and returns some function of that tuple.
+func (m *CDense) Apply(fn func(i, j int, v complex128) complex128, a CMatrix) {
+	ar, ac := a.Dims()
+
+	m.reuseAs(ar, ac)
+
+	aU, aConj := unconjugate(a)
+	if rm, ok := aU.(RawCMatrixer); ok {
+		amat := rm.RawCMatrix()
+		if (aConj && m == aU) || m.checkOverlapMatrix(aU) {
+			var restore func()
+			m, restore = m.isolatedWorkspace(a)
+			defer restore()
+		}
+		if !aConj {
+			for j, ja, jm := 0, 0, 0; ja < ar*amat.Stride; j, ja, jm = j+1, ja+amat.Stride, jm+m.mat.Stride {
+				for i, v := range amat.Data[ja : ja+ac] {
+					m.mat.Data[i+jm] = fn(j, i, v)
+				}
+			}
+		} else {
+			for j, ja, jm := 0, 0, 0; ja < ac*amat.Stride; j, ja, jm = j+1, ja+amat.Stride, jm+1 {
+				for i, v := range amat.Data[ja : ja+ar] {
+					m.mat.Data[i*m.mat.Stride+jm] = fn(i, j, cmplx.Conj(v))
+				}
+			}
+		}
+		return
+	}
+
+	m.checkOverlapMatrix(a)
+	for r := 0; r < ar; r++ {
+		for c := 0; c < ac; c++ {
+			m.set(r, c, fn(r, c, a.At(r, c)))
+		}
+	}
+}
@@ -4,7 +4,12 @@
 
 package mat
 
-import "testing"
+import (
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
 
 func TestCDenseNewAtSet(t *testing.T) {
 	for cas, test := range []struct {
@@ -46,3 +51,210 @@ func TestCDenseNewAtSet(t *testing.T) {
 		}
 	}
 }
+
+// randCDense returns an r×c complex matrix with elements whose real and
+// imaginary parts are drawn from the standard normal distribution.
+func randCDense(r, c int, rnd *rand.Rand) *CDense {
+	m := NewCDense(r, c, nil)
+	for i := range m.mat.Data {
+		m.mat.Data[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return m
+}
+
+// basicCMatrix is a CMatrix that does not implement RawCMatrixer.
+type basicCMatrix CDense
+
+func (m *basicCMatrix) At(i, j int) complex128 { return (*CDense)(m).At(i, j) }
+func (m *basicCMatrix) Dims() (r, c int)       { return (*CDense)(m).Dims() }
+func (m *basicCMatrix) H() CMatrix             { return Conjugate{m} }
+
+func TestCDenseCloneCopySlice(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a := randCDense(4, 5, rnd)
+	for _, src := range []CMatrix{a, a.H(), (*basicCMatrix)(a)} {
+		var got CDense
+		got.Clone(src)
+		if !CEqual(&got, src) {
+			t.Errorf("unexpected result from Clone of %T", src)
+		}
+		r, c := src.Dims()
+		dst := NewCDense(r, c, nil)
+		dst.Copy(src)
+		if !CEqual(dst, src) {
+			t.Errorf("unexpected result from Copy of %T", src)
+		}
+	}
+
+	s := a.Slice(1, 3, 2, 5)
+	if r, c := s.Dims(); r != 2 || c != 3 {
+		t.Errorf("unexpected slice dimensions: got %d×%d want 2×3", r, c)
+	}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			if s.At(i, j) != a.At(i+1, j+2) {
+				t.Errorf("unexpected slice element at %d,%d", i, j)
+			}
+		}
+	}
+	s.(*CDense).Set(0, 0, 42)
+	if a.At(1, 2) != 42 {
+		t.Errorf("slice does not share storage with the original matrix")
+	}
+
+	// Copy between overlapping slices with the same stride.
+	want := CDenseCopyOf(a.Slice(0, 3, 0, 3))
+	a.Slice(1, 4, 1, 4).(*CDense).Copy(a.Slice(0, 3, 0, 3))
+	if !CEqual(a.Slice(1, 4, 1, 4), want) {
+		t.Errorf("unexpected result from overlapping Copy")
+	}
+
+	if ok, _ := panics(func() { a.Slice(0, 2, 0, 2).(*CDense).Copy(a.Slice(0, 2, 1, 3).H()) }); !ok {
+		t.Errorf("expected panic for overlapping conjugate transpose Copy")
+	}
+}
+
+func TestCDenseElementwise(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		fn   func(m *CDense, a, b CMatrix)
+		op   func(x, y complex128) complex128
+	}{
+		{name: "Add", fn: (*CDense).Add, op: func(x, y complex128) complex128 { return x + y }},
+		{name: "Sub", fn: (*CDense).Sub, op: func(x, y complex128) complex128 { return x - y }},
+		{name: "MulElem", fn: (*CDense).MulElem, op: func(x, y complex128) complex128 { return x * y }},
+		{name: "DivElem", fn: (*CDense).DivElem, op: func(x, y complex128) complex128 { return x / y }},
+	} {
+		for _, r := range []int{1, 3, 4} {
+			c := r + 1
+			a := randCDense(r, c, rnd)
+			b := randCDense(r, c, rnd)
+			bh := randCDense(c, r, rnd)
+			want := NewCDense(r, c, nil)
+			for _, operands := range []struct {
+				a, b CMatrix
+			}{
+				{a, b},
+				{a, bh.H()},
+				{(*basicCMatrix)(a), b},
+			} {
+				for i := 0; i < r; i++ {
+					for j := 0; j < c; j++ {
+						want.Set(i, j, test.op(operands.a.At(i, j), operands.b.At(i, j)))
+					}
+				}
+				var got CDense
+				test.fn(&got, operands.a, operands.b)
+				if !CEqualApprox(&got, want, 1e-14) {
+					t.Errorf("%s: unexpected result for %T and %T operands", test.name, operands.a, operands.b)
+				}
+			}
+
+			// Aliased receiver.
+			for i := 0; i < r; i++ {
+				for j := 0; j < c; j++ {
+					want.Set(i, j, test.op(a.At(i, j), bh.H().At(i, j)))
+				}
+			}
+			test.fn(a, a, bh.H())
+			if !CEqualApprox(a, want, 1e-14) {
+				t.Errorf("%s: unexpected result for aliased receiver", test.name)
+			}
+		}
+
+		if ok, _ := panics(func() { test.fn(&CDense{}, NewCDense(2, 3, nil), NewCDense(3, 2, nil)) }); !ok {
+			t.Errorf("%s: expected panic for mismatched shapes", test.name)
+		}
+	}
+}
+
+func TestCDenseMul(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		r, k, c int
+	}{
+		{1, 1, 1},
+		{3, 4, 5},
+		{5, 3, 2},
+		{10, 10, 10},
+	} {
+		a := randCDense(test.r, test.k, rnd)
+		b := randCDense(test.k, test.c, rnd)
+		want := NewCDense(test.r, test.c, nil)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				var v complex128
+				for l := 0; l < test.k; l++ {
+					v += a.At(i, l) * b.At(l, j)
+				}
+				want.Set(i, j, v)
+			}
+		}
+		ah := CDenseCopyOf(a.H())
+		bh := CDenseCopyOf(b.H())
+		for _, operands := range []struct {
+			a, b CMatrix
+		}{
+			{a, b},
+			{ah.H(), b},
+			{a, bh.H()},
+			{ah.H(), bh.H()},
+			{(*basicCMatrix)(a), b},
+			{a, (*basicCMatrix)(bh).H()},
+		} {
+			var got CDense
+			got.Mul(operands.a, operands.b)
+			if !CEqualApprox(&got, want, 1e-12) {
+				t.Errorf("unexpected result for %d×%d * %d×%d with %T and %T operands",
+					test.r, test.k, test.k, test.c, operands.a, operands.b)
+			}
+		}
+		if test.r == test.k && test.k == test.c {
+			// Aliased receiver.
+			got := CDenseCopyOf(a)
+			got.Mul(got, b)
+			if !CEqualApprox(got, want, 1e-12) {
+				t.Errorf("unexpected result for aliased receiver")
+			}
+		}
+	}
+	if ok, _ := panics(func() { (&CDense{}).Mul(NewCDense(2, 3, nil), NewCDense(2, 3, nil)) }); !ok {
+		t.Errorf("expected panic for mismatched shapes")
+	}
+}
+
+func TestCDenseScaleConjApply(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a := randCDense(3, 4, rnd)
+	f := complex(2, -1)
+	for _, src := range []CMatrix{a, a.H(), (*basicCMatrix)(a)} {
+		r, c := src.Dims()
+		var scaled, conj, applied CDense
+		scaled.Scale(f, src)
+		conj.Conj(src)
+		applied.Apply(func(i, j int, v complex128) complex128 { return v + complex(float64(i), float64(j)) }, src)
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				v := src.At(i, j)
+				if scaled.At(i, j) != f*v {
+					t.Errorf("unexpected Scale result for %T at %d,%d", src, i, j)
+				}
+				if conj.At(i, j) != cmplx.Conj(v) {
+					t.Errorf("unexpected Conj result for %T at %d,%d", src, i, j)
+				}
+				if applied.At(i, j) != v+complex(float64(i), float64(j)) {
+					t.Errorf("unexpected Apply result for %T at %d,%d", src, i, j)
+				}
+			}
+		}
+	}
+
+	// Aliased receiver.
+	want := CDenseCopyOf(a)
+	want.Scale(f, want)
+	a.Scale(f, a)
+	if !CEqual(a, want) {
+		t.Errorf("unexpected result for aliased Scale")
+	}
+}
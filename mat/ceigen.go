@@ -0,0 +1,279 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// CEigenHerm is a type for creating and manipulating the Eigen decomposition of
+// complex Hermitian matrices.
+type CEigenHerm struct {
+	vectorsComputed bool
+
+	values  []float64
+	vectors *CDense
+}
+
+// Factorize computes the eigenvalue decomposition of the Hermitian matrix a.
+// The Eigen decomposition is defined as
+//  A = P * D * P^H
+// where D is a real diagonal matrix containing the eigenvalues of the matrix,
+// and P is a unitary matrix of the eigenvectors of A. Only the upper triangle
+// of a is used and the imaginary parts of its diagonal elements are ignored.
+// Factorize computes the eigenvalues in ascending order. If the vectors input
+// argument is false, the eigenvectors are not computed.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+// Factorize will panic if a is not square.
+func (e *CEigenHerm) Factorize(a CMatrix, vectors bool) (ok bool) {
+	r, n := a.Dims()
+	if r != n {
+		panic(ErrSquare)
+	}
+	h := cblas128.Hermitian{
+		N:      n,
+		Stride: n,
+		Data:   make([]complex128, n*n),
+		Uplo:   blas.Upper,
+	}
+	for i := 0; i < n; i++ {
+		h.Data[i*n+i] = complex(real(a.At(i, i)), 0)
+		for j := i + 1; j < n; j++ {
+			h.Data[i*n+j] = a.At(i, j)
+		}
+	}
+
+	jobz := lapack.EVNone
+	lrwork := max(1, n-1)
+	if vectors {
+		jobz = lapack.EVCompute
+		lrwork = n + 3*n*n
+	}
+	w := make([]float64, n)
+	rwork := getFloats(lrwork, false)
+	work := []complex128{0}
+	lapack128.Heev(jobz, h, w, work, -1, rwork)
+	work = make([]complex128, int(real(work[0])))
+	ok = lapack128.Heev(jobz, h, w, work, len(work), rwork)
+	putFloats(rwork)
+	if !ok {
+		e.vectorsComputed = false
+		e.values = nil
+		e.vectors = nil
+		return false
+	}
+	e.vectorsComputed = vectors
+	e.values = w
+	e.vectors = NewCDense(n, n, h.Data)
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *CEigenHerm) succFact() bool {
+	return len(e.values) != 0
+}
+
+// Values extracts the eigenvalues of the factorized matrix. If dst is
+// non-nil, the values are stored in-place into dst. In this case
+// dst must have length n, otherwise Values will panic. If dst is
+// nil, then a new slice will be allocated of the proper length and filled
+// with the eigenvalues.
+//
+// Values panics if the Eigen decomposition was not successful.
+func (e *CEigenHerm) Values(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo returns the eigenvectors of the decomposition. Each eigenvector is
+// a column corresponding to the respective eigenvalue returned by e.Values.
+// If dst is nil, a new matrix is allocated and returned.
+//
+// VectorsTo panics if the factorization was not successful or if the
+// decomposition did not compute the eigenvectors.
+func (e *CEigenHerm) VectorsTo(dst *CDense) *CDense {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.vectorsComputed {
+		panic(badNoVect)
+	}
+	n := len(e.values)
+	if dst == nil {
+		dst = NewCDense(n, n, nil)
+	} else {
+		dst.reuseAs(n, n)
+	}
+	dst.Copy(e.vectors)
+	return dst
+}
+
+// CEigen is a type for creating and using the eigenvalue decomposition of a
+// dense complex matrix.
+type CEigen struct {
+	n int // The size of the factorized matrix.
+
+	right bool // have the right eigenvectors been computed
+	left  bool // have the left eigenvectors been computed
+
+	values   []complex128
+	rVectors *CDense
+	lVectors *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *CEigen) succFact() bool {
+	return len(e.values) != 0
+}
+
+// Factorize computes the eigenvalues of the square complex matrix a, and
+// optionally the eigenvectors.
+//
+// A right eigenvalue/eigenvector combination is defined by
+//  A * x_r = λ * x_r
+// where x_r is the column vector called an eigenvector, and λ is the corresponding
+// eigenvalue.
+//
+// Similarly, a left eigenvalue/eigenvector combination is defined by
+//  x_l^H * A = λ * x_l^H
+// The eigenvalues, but not the eigenvectors, are the same for both decompositions.
+//
+// In all cases, Factorize computes the eigenvalues of the matrix. If right and left
+// are true, then the right and left eigenvectors will be computed, respectively.
+// Factorize panics if the input matrix is not square.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+func (e *CEigen) Factorize(a CMatrix, left, right bool) (ok bool) {
+	// Copy a because it is modified during the Lapack call.
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	var sd CDense
+	sd.Clone(a)
+
+	var vl, vr CDense
+	jobvl := lapack.LeftEVNone
+	jobvr := lapack.RightEVNone
+	if left {
+		vl = *NewCDense(r, r, nil)
+		jobvl = lapack.LeftEVCompute
+	} else {
+		vl.mat.Stride = 1
+	}
+	if right {
+		vr = *NewCDense(c, c, nil)
+		jobvr = lapack.RightEVCompute
+	} else {
+		vr.mat.Stride = 1
+	}
+
+	w := make([]complex128, c)
+	rwork := getFloats(2*c, false)
+	defer putFloats(rwork)
+
+	work := []complex128{0}
+	lapack128.Geev(jobvl, jobvr, sd.mat, w, vl.mat, vr.mat, work, -1, rwork)
+	work = make([]complex128, int(real(work[0])))
+	first := lapack128.Geev(jobvl, jobvr, sd.mat, w, vl.mat, vr.mat, work, len(work), rwork)
+
+	if first != 0 {
+		e.values = nil
+		return false
+	}
+	e.n = r
+	e.right = right
+	e.left = left
+	e.values = w
+	e.lVectors = nil
+	if left {
+		e.lVectors = &vl
+	}
+	e.rVectors = nil
+	if right {
+		e.rVectors = &vr
+	}
+	return true
+}
+
+// Values extracts the eigenvalues of the factorized matrix. If dst is
+// non-nil, the values are stored in-place into dst. In this case
+// dst must have length n, otherwise Values will panic. If dst is
+// nil, then a new slice will be allocated of the proper length and
+// filled with the eigenvalues.
+//
+// Values panics if the Eigen decomposition was not successful.
+func (e *CEigen) Values(dst []complex128) []complex128 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo returns the right eigenvectors of the decomposition. VectorsTo
+// will panic if the right eigenvectors were not computed during the factorization,
+// or if the factorization was not successful.
+//
+// The computed eigenvectors are normalized to have Euclidean norm equal to 1
+// and largest component real.
+func (e *CEigen) VectorsTo(dst *CDense) *CDense {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.right {
+		panic(badNoVect)
+	}
+	if dst == nil {
+		dst = NewCDense(e.n, e.n, nil)
+	} else {
+		dst.reuseAs(e.n, e.n)
+	}
+	dst.Copy(e.rVectors)
+	return dst
+}
+
+// LeftVectorsTo returns the left eigenvectors of the decomposition. LeftVectorsTo
+// will panic if the left eigenvectors were not computed during the factorization,
+// or if the factorization was not successful.
+//
+// The computed eigenvectors are normalized to have Euclidean norm equal to 1
+// and largest component real.
+func (e *CEigen) LeftVectorsTo(dst *CDense) *CDense {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.left {
+		panic(badNoVect)
+	}
+	if dst == nil {
+		dst = NewCDense(e.n, e.n, nil)
+	} else {
+		dst.reuseAs(e.n, e.n)
+	}
+	dst.Copy(e.lVectors)
+	return dst
+}
@@ -0,0 +1,122 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCEigenHerm(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 3, 10, 40} {
+		b := randCDense(n, n, rnd)
+		var a CDense
+		a.Add(b, b.H())
+
+		var es CEigenHerm
+		ok := es.Factorize(&a, true)
+		if !ok {
+			t.Fatalf("n=%d: unexpected factorization failure", n)
+		}
+		w := es.Values(nil)
+		for i := 1; i < n; i++ {
+			if w[i] < w[i-1] {
+				t.Errorf("n=%d: eigenvalues not in ascending order", n)
+				break
+			}
+		}
+		p := es.VectorsTo(nil)
+		var php CDense
+		php.Mul(p.H(), p)
+		if !cIsIdentity(&php, 1e-12) {
+			t.Errorf("n=%d: eigenvectors are not orthonormal", n)
+		}
+		// Check that A * P = P * D.
+		var ap, pd CDense
+		ap.Mul(&a, p)
+		pd.Clone(p)
+		for j, v := range w {
+			for i := 0; i < n; i++ {
+				pd.Set(i, j, pd.At(i, j)*complex(v, 0))
+			}
+		}
+		if !CEqualApprox(&ap, &pd, 1e-11) {
+			t.Errorf("n=%d: A*P does not equal P*D", n)
+		}
+
+		// The eigenvalues must not depend on whether the eigenvectors
+		// are computed.
+		var ev CEigenHerm
+		ok = ev.Factorize(&a, false)
+		if !ok {
+			t.Fatalf("n=%d: unexpected factorization failure without vectors", n)
+		}
+		for i, v := range ev.Values(nil) {
+			if math.Abs(v-w[i]) > 1e-12*math.Max(1, math.Abs(w[i])) {
+				t.Errorf("n=%d: eigenvalue mismatch at %d: got %v want %v", n, i, v, w[i])
+			}
+		}
+		panicked, _ := panics(func() { ev.VectorsTo(nil) })
+		if !panicked {
+			t.Errorf("n=%d: expected panic for eigenvectors not computed", n)
+		}
+	}
+}
+
+func TestCEigen(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 3, 10, 40} {
+		a := randCDense(n, n, rnd)
+
+		var e CEigen
+		ok := e.Factorize(a, true, true)
+		if !ok {
+			t.Fatalf("n=%d: unexpected factorization failure", n)
+		}
+		w := e.Values(nil)
+		vr := e.VectorsTo(nil)
+		vl := e.LeftVectorsTo(nil)
+		for j, lambda := range w {
+			// Check that A * x_r = λ * x_r and x_l^H * A = λ * x_l^H.
+			xr := vr.Slice(0, n, j, j+1)
+			xl := vl.Slice(0, n, j, j+1)
+			var axr, xlha CDense
+			axr.Mul(a, xr)
+			xlha.Mul(xl.H(), a)
+			var lxr, lxl CDense
+			lxr.Scale(lambda, xr)
+			lxl.Scale(cmplx.Conj(lambda), xl)
+			if !CEqualApprox(&axr, &lxr, 1e-11) {
+				t.Errorf("n=%d: right eigenvector %d mismatch", n, j)
+			}
+			if !CEqualApprox(xlha.H(), &lxl, 1e-11) {
+				t.Errorf("n=%d: left eigenvector %d mismatch", n, j)
+			}
+		}
+
+		var ev CEigen
+		ok = ev.Factorize(a, false, false)
+		if !ok {
+			t.Fatalf("n=%d: unexpected factorization failure without vectors", n)
+		}
+		for i, v := range ev.Values(nil) {
+			if cmplx.Abs(v-w[i]) > 1e-12*math.Max(1, cmplx.Abs(w[i])) {
+				t.Errorf("n=%d: eigenvalue mismatch at %d: got %v want %v", n, i, v, w[i])
+			}
+		}
+		panicked, _ := panics(func() { ev.VectorsTo(nil) })
+		if !panicked {
+			t.Errorf("n=%d: expected panic for right eigenvectors not computed", n)
+		}
+		panicked, _ = panics(func() { ev.LeftVectorsTo(nil) })
+		if !panicked {
+			t.Errorf("n=%d: expected panic for left eigenvectors not computed", n)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// CLU is a type for creating and using the LU factorization of a complex
+// matrix.
+type CLU struct {
+	lu    *CDense
+	pivot []int
+	cond  float64
+}
+
+// updateCond updates the stored condition number of the matrix. anorm is the
+// norm of the original matrix.
+func (lu *CLU) updateCond(anorm float64, norm lapack.MatrixNorm) {
+	a := lu.lu.mat
+	n := a.Cols
+	for i := 0; i < n; i++ {
+		if a.Data[i*a.Stride+i] == 0 {
+			lu.cond = math.Inf(1)
+			return
+		}
+	}
+	work := make([]complex128, 2*n)
+	v := lapack128.Gecon(norm, a, anorm, work)
+	lu.cond = 1 / v
+}
+
+// Factorize computes the LU factorization of the square matrix a and stores the
+// result. The LU decomposition will complete regardless of the singularity of a.
+//
+// The LU factorization is computed with pivoting, and so really the decomposition
+// is a PLU decomposition where P is a permutation matrix. The individual matrix
+// factors can be extracted from the factorization using the Pivot, LTo and UTo
+// methods.
+func (lu *CLU) Factorize(a CMatrix) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrSquare)
+	}
+	if lu.lu == nil {
+		lu.lu = NewCDense(r, r, nil)
+	} else {
+		lu.lu.Reset()
+		lu.lu.reuseAs(r, r)
+	}
+	lu.lu.Copy(a)
+	if cap(lu.pivot) < r {
+		lu.pivot = make([]int, r)
+	}
+	lu.pivot = lu.pivot[:r]
+	m := lu.lu.mat
+	work := getFloats(r, false)
+	anorm := lapack128.Lange(CondNorm, m, work)
+	putFloats(work)
+	lapack128.Getrf(m, lu.pivot)
+	lu.updateCond(anorm, CondNorm)
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a successful factorization.
+func (lu *CLU) Cond() float64 {
+	if lu.lu == nil || lu.lu.IsZero() {
+		panic("clu: no decomposition computed")
+	}
+	return lu.cond
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (lu *CLU) Reset() {
+	if lu.lu != nil {
+		lu.lu.Reset()
+	}
+	lu.pivot = lu.pivot[:0]
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+func (lu *CLU) Det() complex128 {
+	det, phase := lu.LogDet()
+	return phase * complex(math.Exp(det), 0)
+}
+
+// LogDet returns the log of the absolute value of the determinant and the
+// phase of the determinant for the matrix that has been factorized. The
+// phase is a complex number with unit modulus such that
+//  det(A) = phase * exp(det).
+// Numerical stability in product and division expressions is generally
+// improved by working in log space.
+func (lu *CLU) LogDet() (det float64, phase complex128) {
+	_, n := lu.lu.Dims()
+	phase = 1
+	for i := 0; i < n; i++ {
+		v := lu.lu.at(i, i)
+		abs := cmplx.Abs(v)
+		if abs != 0 {
+			phase *= v / complex(abs, 0)
+		}
+		if lu.pivot[i] != i {
+			phase = -phase
+		}
+		det += math.Log(abs)
+	}
+	return det, phase
+}
+
+// Pivot returns pivot indices that enable the construction of the permutation
+// matrix P (see Dense.Permutation). If swaps == nil, then new memory will be
+// allocated, otherwise the length of the input must be equal to the size of the
+// factorized matrix.
+func (lu *CLU) Pivot(swaps []int) []int {
+	_, n := lu.lu.Dims()
+	if swaps == nil {
+		swaps = make([]int, n)
+	}
+	if len(swaps) != n {
+		panic(badSliceLength)
+	}
+	// Perform the inverse of the row swaps in order to find the final
+	// row swap position.
+	for i := range swaps {
+		swaps[i] = i
+	}
+	for i := n - 1; i >= 0; i-- {
+		v := lu.pivot[i]
+		swaps[i], swaps[v] = swaps[v], swaps[i]
+	}
+	return swaps
+}
+
+// LTo extracts the unit lower triangular matrix from an LU factorization.
+// If dst is nil, a new matrix is allocated. The resulting L matrix is returned.
+func (lu *CLU) LTo(dst *CDense) *CDense {
+	_, n := lu.lu.Dims()
+	if dst == nil {
+		dst = NewCDense(n, n, nil)
+	} else {
+		dst.reuseAsZeroed(n, n)
+	}
+	// Extract the lower triangular elements.
+	for i := 0; i < n; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride:i*dst.mat.Stride+i], lu.lu.mat.Data[i*lu.lu.mat.Stride:])
+		// Set ones on the diagonal.
+		dst.mat.Data[i*dst.mat.Stride+i] = 1
+	}
+	return dst
+}
+
+// UTo extracts the upper triangular matrix from an LU factorization.
+// If dst is nil, a new matrix is allocated. The resulting U matrix is returned.
+func (lu *CLU) UTo(dst *CDense) *CDense {
+	_, n := lu.lu.Dims()
+	if dst == nil {
+		dst = NewCDense(n, n, nil)
+	} else {
+		dst.reuseAsZeroed(n, n)
+	}
+	// Extract the upper triangular elements.
+	for i := 0; i < n; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride+i:i*dst.mat.Stride+n], lu.lu.mat.Data[i*lu.lu.mat.Stride+i:])
+	}
+	return dst
+}
+
+// Solve solves a system of linear equations using the LU decomposition of a matrix.
+// It computes
+//  A * X = B if trans == false
+//  A^H * X = B if trans == true
+// In both cases, A is represented in LU factorized form, and the matrix X is
+// stored into x.
+//
+// If A is singular or near-singular a Condition error is returned. See
+// the documentation for Condition for more information.
+func (lu *CLU) Solve(x *CDense, trans bool, b CMatrix) error {
+	_, n := lu.lu.Dims()
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	if math.IsInf(lu.cond, 1) {
+		return Condition(math.Inf(1))
+	}
+
+	x.reuseAs(n, bc)
+	bU, _ := unconjugate(b)
+	var restore func()
+	if x == bU {
+		x, restore = x.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawCMatrixer); ok {
+		x.checkOverlap(rm.RawCMatrix())
+	}
+
+	x.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.ConjTrans
+	}
+	lapack128.Getrs(t, lu.lu.mat, x.mat, lu.pivot)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+func TestCLU(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 5, 10, 11, 50, 100} {
+		a := randCDense(n, n, rnd)
+		want := CDenseCopyOf(a)
+
+		var lu CLU
+		lu.Factorize(a)
+
+		l := lu.LTo(nil)
+		u := lu.UTo(nil)
+		for i := 0; i < n; i++ {
+			if l.At(i, i) != 1 {
+				t.Errorf("n=%d: L does not have a unit diagonal", n)
+			}
+			for j := i + 1; j < n; j++ {
+				if l.At(i, j) != 0 || u.At(j, i) != 0 {
+					t.Errorf("n=%d: L or U not triangular", n)
+				}
+			}
+		}
+
+		// Construct P from the pivot indices.
+		p := NewCDense(n, n, nil)
+		for i, v := range lu.Pivot(nil) {
+			p.Set(i, v, 1)
+		}
+		var got CDense
+		got.Mul(l, u)
+		got.Mul(p, &got)
+		if !CEqualApprox(&got, want, 1e-12) {
+			t.Errorf("n=%d: PLU does not equal original matrix", n)
+		}
+
+		// Compare the determinant with the product of the diagonal of U and
+		// the sign of the permutation.
+		det := complex(1, 0)
+		for i := 0; i < n; i++ {
+			det *= u.At(i, i)
+			if lu.pivot[i] != i {
+				det = -det
+			}
+		}
+		if !cEqualWithinAbsOrRel(lu.Det(), det, 1e-12, 1e-12) {
+			t.Errorf("n=%d: unexpected determinant: got %v want %v", n, lu.Det(), det)
+		}
+		logdet, phase := lu.LogDet()
+		if math.Abs(cmplx.Abs(phase)-1) > 1e-14 {
+			t.Errorf("n=%d: phase does not have unit modulus: %v", n, phase)
+		}
+		if math.Abs(logdet-math.Log(cmplx.Abs(det))) > 1e-10 {
+			t.Errorf("n=%d: unexpected log determinant: got %v want %v", n, logdet, math.Log(cmplx.Abs(det)))
+		}
+
+		// Compare the condition number estimate with the condition number
+		// computed from the explicit inverse.
+		var inv CDense
+		err := inv.Inverse(want)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error from Inverse: %v", n, err)
+		}
+		var eye CDense
+		eye.Mul(want, &inv)
+		if !cIsIdentity(&eye, 1e-10) {
+			t.Errorf("n=%d: A * A^-1 is not the identity", n)
+		}
+		work := make([]float64, n)
+		cond := lapack128.Lange(CondNorm, want.mat, work) * lapack128.Lange(CondNorm, inv.mat, work)
+		if lu.Cond() > cond*(1+1e-10) || lu.Cond() < cond/10 {
+			t.Errorf("n=%d: unexpected condition number estimate: got %v want ~%v", n, lu.Cond(), cond)
+		}
+	}
+}
+
+func TestCLUSolve(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, bc int
+	}{
+		{5, 5},
+		{5, 1},
+		{20, 3},
+		{70, 10},
+	} {
+		n, bc := test.n, test.bc
+		a := randCDense(n, n, rnd)
+		b := randCDense(n, bc, rnd)
+
+		var lu CLU
+		lu.Factorize(a)
+		for _, trans := range []bool{false, true} {
+			var x CDense
+			err := lu.Solve(&x, trans, b)
+			if err != nil {
+				t.Errorf("unexpected error from Solve: %v", err)
+			}
+			var got CDense
+			if trans {
+				got.Mul(a.H(), &x)
+			} else {
+				got.Mul(a, &x)
+			}
+			if !CEqualApprox(&got, b, 1e-10) {
+				t.Errorf("n=%d, bc=%d, trans=%t: unexpected solution", n, bc, trans)
+			}
+
+			// Solve in place.
+			if bc == n {
+				xc := CDenseCopyOf(b)
+				lu.Solve(xc, trans, xc)
+				if !CEqualApprox(xc, &x, 1e-14) {
+					t.Errorf("n=%d, trans=%t: unexpected in place solution", n, trans)
+				}
+			}
+		}
+	}
+
+	// Singular matrix.
+	a := NewCDense(3, 3, []complex128{
+		1, 2i, 3,
+		2, 4i, 6,
+		1i, 5, 1,
+	})
+	var lu CLU
+	lu.Factorize(a)
+	var x CDense
+	err := lu.Solve(&x, false, NewCDense(3, 1, nil))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular matrix, got %v", err)
+	}
+	if !math.IsInf(lu.Cond(), 1) {
+		t.Errorf("unexpected condition number for singular matrix: %v", lu.Cond())
+	}
+}
+
+// cIsIdentity returns whether the square matrix a is equal to the identity
+// matrix within tol.
+func cIsIdentity(a CMatrix, tol float64) bool {
+	r, c := a.Dims()
+	if r != c {
+		return false
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			var want complex128
+			if i == j {
+				want = 1
+			}
+			if cmplx.Abs(a.At(i, j)-want) > tol {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"math/cmplx"
 
+	"gonum.org/v1/gonum/blas/cblas128"
 	"gonum.org/v1/gonum/floats"
 )
 
@@ -27,6 +28,13 @@ type CMatrix interface {
 	H() CMatrix
 }
 
+// A RawCMatrixer can return a cblas128.General representation of the receiver.
+// Changes to the cblas128.General.Data slice will be reflected in the original
+// matrix, changes to the Rows, Cols and Stride fields will not.
+type RawCMatrixer interface {
+	RawCMatrix() cblas128.General
+}
+
 var (
 	_ CMatrix      = Conjugate{}
 	_ Unconjugator = Conjugate{}
@@ -0,0 +1,169 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// CQR is a type for creating and using the QR factorization of a complex
+// matrix.
+type CQR struct {
+	qr   *CDense
+	tau  []complex128
+	cond float64
+}
+
+func (qr *CQR) updateCond(norm lapack.MatrixNorm) {
+	// Since A = Q*R, and Q is unitary, the condition number of A is
+	// approximated by the condition number of R. See QR.updateCond for
+	// the details.
+	a := qr.qr.mat
+	n := a.Cols
+	work := make([]complex128, 2*n)
+	rwork := getFloats(n, false)
+	r := cblas128.Triangular{
+		N:      n,
+		Stride: a.Stride,
+		Data:   a.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+	v := lapack128.Trcon(norm, r, work, rwork)
+	putFloats(rwork)
+	qr.cond = 1 / v
+}
+
+// Factorize computes the QR factorization of an m×n matrix a where m >= n. The QR
+// factorization always exists even if A is singular.
+//
+// The QR decomposition is a factorization of the matrix A such that A = Q * R.
+// The matrix Q is a unitary m×m matrix, and R is an m×n upper triangular matrix.
+// Q and R can be extracted using the QTo and RTo methods.
+func (qr *CQR) Factorize(a CMatrix) {
+	m, n := a.Dims()
+	if m < n {
+		panic(ErrShape)
+	}
+	if qr.qr == nil {
+		qr.qr = &CDense{}
+	}
+	qr.qr.Clone(a)
+	qr.tau = make([]complex128, n)
+	work := []complex128{0}
+	lapack128.Geqrf(qr.qr.mat, qr.tau, work, -1)
+	work = make([]complex128, int(real(work[0])))
+	lapack128.Geqrf(qr.qr.mat, qr.tau, work, len(work))
+	qr.updateCond(CondNorm)
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a successful factorization.
+func (qr *CQR) Cond() float64 {
+	if qr.qr == nil || qr.qr.IsZero() {
+		panic("cqr: no decomposition computed")
+	}
+	return qr.cond
+}
+
+// RTo extracts the m×n upper trapezoidal matrix from a QR decomposition.
+// If dst is nil, a new matrix is allocated. The resulting dst matrix is returned.
+func (qr *CQR) RTo(dst *CDense) *CDense {
+	r, c := qr.qr.Dims()
+	if dst == nil {
+		dst = NewCDense(r, c, nil)
+	} else {
+		dst.reuseAsZeroed(r, c)
+	}
+	for i := 0; i < c; i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride+i:i*dst.mat.Stride+c], qr.qr.mat.Data[i*qr.qr.mat.Stride+i:])
+	}
+	return dst
+}
+
+// QTo extracts the m×m unitary matrix Q from a QR decomposition.
+// If dst is nil, a new matrix is allocated. The resulting Q matrix is returned.
+func (qr *CQR) QTo(dst *CDense) *CDense {
+	r, _ := qr.qr.Dims()
+	if dst == nil {
+		dst = NewCDense(r, r, nil)
+	} else {
+		dst.reuseAsZeroed(r, r)
+	}
+
+	// Set Q = I.
+	for i := 0; i < r*r; i += r + 1 {
+		dst.mat.Data[i] = 1
+	}
+
+	// Construct Q from the elementary reflectors.
+	work := []complex128{0}
+	lapack128.Unmqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, dst.mat, work, -1)
+	work = make([]complex128, int(real(work[0])))
+	lapack128.Unmqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, dst.mat, work, len(work))
+	return dst
+}
+
+// Solve finds a minimum-norm solution to a system of linear equations defined
+// by the matrices A and b, where A is an m×n matrix represented in its QR factorized
+// form. If A is singular or near-singular a Condition error is returned.
+// See the documentation for Condition for more information.
+//
+// The minimization problem solved depends on the input parameters.
+//  If trans == false, find X such that ||A*X - B||_2 is minimized.
+//  If trans == true, find the minimum norm solution of A^H * X = B.
+// The solution matrix, X, is stored in place into x.
+func (qr *CQR) Solve(x *CDense, trans bool, b CMatrix) error {
+	r, c := qr.qr.Dims()
+	br, bc := b.Dims()
+
+	// The QR solve algorithm stores the result in-place into the right hand side.
+	// The storage for the answer must be large enough to hold both b and x.
+	// However, this method's receiver must be the size of x. Copy b, and then
+	// copy the result into x at the end.
+	if trans {
+		if c != br {
+			panic(ErrShape)
+		}
+		x.reuseAs(r, bc)
+	} else {
+		if r != br {
+			panic(ErrShape)
+		}
+		x.reuseAs(c, bc)
+	}
+	if math.IsInf(qr.cond, 1) {
+		return Condition(math.Inf(1))
+	}
+	// Do not need to worry about overlap between x and b because w has its
+	// own independent storage.
+	w := NewCDense(max(r, c), bc, nil)
+	w.Copy(b)
+	a := qr.qr.mat
+	bi := cblas128.Implementation()
+	work := []complex128{0}
+	lapack128.Unmqr(blas.Left, blas.ConjTrans, a, qr.tau, w.mat, work, -1)
+	work = make([]complex128, int(real(work[0])))
+	if trans {
+		bi.Ztrsm(blas.Left, blas.Upper, blas.ConjTrans, blas.NonUnit,
+			c, bc, 1, a.Data, a.Stride, w.mat.Data, w.mat.Stride)
+		lapack128.Unmqr(blas.Left, blas.NoTrans, a, qr.tau, w.mat, work, len(work))
+	} else {
+		lapack128.Unmqr(blas.Left, blas.ConjTrans, a, qr.tau, w.mat, work, len(work))
+		bi.Ztrsm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit,
+			c, bc, 1, a.Data, a.Stride, w.mat.Data, w.mat.Stride)
+	}
+	// X was set above to be the correct size for the result.
+	x.Copy(w)
+	if qr.cond > ConditionTolerance {
+		return Condition(qr.cond)
+	}
+	return nil
+}
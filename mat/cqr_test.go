@@ -0,0 +1,114 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCQR(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{10, 5},
+		{40, 17},
+	} {
+		m, n := test.m, test.n
+		a := randCDense(m, n, rnd)
+		want := CDenseCopyOf(a)
+
+		var qr CQR
+		qr.Factorize(a)
+		q := qr.QTo(nil)
+		var qhq CDense
+		qhq.Mul(q.H(), q)
+		if !cIsIdentity(&qhq, 1e-12) {
+			t.Errorf("Q is not unitary: m = %v, n = %v", m, n)
+		}
+
+		r := qr.RTo(nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < min(i, n); j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("R is not upper trapezoidal: m = %v, n = %v", m, n)
+				}
+			}
+		}
+
+		var got CDense
+		got.Mul(q, r)
+		if !CEqualApprox(&got, want, 1e-12) {
+			t.Errorf("QR does not equal original matrix: m = %v, n = %v", m, n)
+		}
+	}
+}
+
+func TestCQRSolve(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, bc int
+	}{
+		{5, 5, 1},
+		{5, 5, 3},
+		{10, 5, 1},
+		{10, 5, 4},
+		{30, 12, 2},
+	} {
+		m, n, bc := test.m, test.n, test.bc
+		a := randCDense(m, n, rnd)
+		var qr CQR
+		qr.Factorize(a)
+
+		// Least squares solution of A * X = B. The residual must be
+		// orthogonal to the range of A.
+		b := randCDense(m, bc, rnd)
+		var x CDense
+		err := qr.Solve(&x, false, b)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if r, c := x.Dims(); r != n || c != bc {
+			t.Errorf("unexpected solution dimensions: got %d×%d want %d×%d", r, c, n, bc)
+		}
+		var resid CDense
+		resid.Mul(a, &x)
+		resid.Sub(b, &resid)
+		var ahr CDense
+		ahr.Mul(a.H(), &resid)
+		if !CEqualApprox(&ahr, NewCDense(n, bc, nil), 1e-10) {
+			t.Errorf("m=%d, n=%d: residual not orthogonal to range of A", m, n)
+		}
+
+		// Minimum norm solution of A^H * X = B. The solution must satisfy
+		// the equations and lie in the range of A.
+		b = randCDense(n, bc, rnd)
+		x.Reset()
+		err = qr.Solve(&x, true, b)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if r, c := x.Dims(); r != m || c != bc {
+			t.Errorf("unexpected solution dimensions: got %d×%d want %d×%d", r, c, m, bc)
+		}
+		var got CDense
+		got.Mul(a.H(), &x)
+		if !CEqualApprox(&got, b, 1e-10) {
+			t.Errorf("m=%d, n=%d: solution does not satisfy A^H * X = B", m, n)
+		}
+		// Project x onto the range of A using the first n columns of Q.
+		q := qr.QTo(nil).Slice(0, m, 0, n)
+		var coef, proj CDense
+		coef.Mul(q.H(), &x)
+		proj.Mul(q, &coef)
+		if !CEqualApprox(&proj, &x, 1e-10) {
+			t.Errorf("m=%d, n=%d: solution not in the range of A", m, n)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack128"
+)
+
+// CSVD is a type for creating and using the Singular Value Decomposition (SVD)
+// of a complex matrix.
+type CSVD struct {
+	kind SVDKind
+
+	s  []float64
+	u  cblas128.General
+	vt cblas128.General
+}
+
+// Factorize computes the singular value decomposition (SVD) of the input
+// complex matrix A. The singular values of A are computed in all cases, while
+// the singular vectors are optionally computed depending on the input kind.
+//
+// The full singular value decomposition (kind == SVDFull) is a factorization
+// of an m×n matrix A of the form
+//  A = U * Σ * V^H
+// where Σ is an m×n real diagonal matrix, U is an m×m unitary matrix, and V is
+// an n×n unitary matrix. The diagonal elements of Σ are the singular values of
+// A. The first min(m,n) columns of U and V are, respectively, the left and
+// right singular vectors of A.
+//
+// As for SVD, only the singular values can be computed (kind == SVDNone), or
+// a "thin" representation of the unitary matrices U and V (kind == SVDThin).
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, routines that require a successful factorization will panic.
+func (svd *CSVD) Factorize(a CMatrix, kind SVDKind) (ok bool) {
+	m, n := a.Dims()
+	var jobU, jobVT lapack.SVDJob
+	switch kind {
+	default:
+		panic("csvd: bad input kind")
+	case SVDNone:
+		svd.u.Stride = 1
+		svd.vt.Stride = 1
+		jobU = lapack.SVDNone
+		jobVT = lapack.SVDNone
+	case SVDFull:
+		svd.u = cblas128.General{
+			Rows:   m,
+			Cols:   m,
+			Stride: m,
+			Data:   useC(svd.u.Data, m*m),
+		}
+		svd.vt = cblas128.General{
+			Rows:   n,
+			Cols:   n,
+			Stride: n,
+			Data:   useC(svd.vt.Data, n*n),
+		}
+		jobU = lapack.SVDAll
+		jobVT = lapack.SVDAll
+	case SVDThin:
+		svd.u = cblas128.General{
+			Rows:   m,
+			Cols:   min(m, n),
+			Stride: min(m, n),
+			Data:   useC(svd.u.Data, m*min(m, n)),
+		}
+		svd.vt = cblas128.General{
+			Rows:   min(m, n),
+			Cols:   n,
+			Stride: n,
+			Data:   useC(svd.vt.Data, min(m, n)*n),
+		}
+		jobU = lapack.SVDStore
+		jobVT = lapack.SVDStore
+	}
+
+	// A is destroyed on call, so copy the matrix.
+	aCopy := CDenseCopyOf(a)
+	svd.kind = kind
+	svd.s = use(svd.s, min(m, n))
+
+	mn := min(m, n)
+	lrwork := 5 * mn
+	if kind != SVDNone {
+		lrwork = mn * (2*mn + 2*max(m, n) + 1)
+	}
+	rwork := getFloats(lrwork, false)
+	work := []complex128{0}
+	lapack128.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1, rwork)
+	work = make([]complex128, int(real(work[0])))
+	ok = lapack128.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work), rwork)
+	putFloats(rwork)
+	if !ok {
+		svd.kind = 0
+	}
+	return ok
+}
+
+// Kind returns the matrix.SVDKind of the decomposition. If no decomposition has
+// been computed, Kind returns 0.
+func (svd *CSVD) Kind() SVDKind {
+	return svd.kind
+}
+
+// Cond returns the 2-norm condition number for the factorized matrix. Cond will
+// panic if the receiver does not contain a successful factorization.
+func (svd *CSVD) Cond() float64 {
+	if svd.kind == 0 {
+		panic("csvd: no decomposition computed")
+	}
+	return svd.s[0] / svd.s[len(svd.s)-1]
+}
+
+// Values returns the singular values of the factorized matrix in descending
+// order.
+//
+// If the input slice is non-nil, the values will be stored in-place into
+// the slice. In this case, the slice must have length min(m,n), and Values will
+// panic with ErrSliceLengthMismatch otherwise. If the input slice is nil, a new
+// slice of the appropriate length will be allocated and returned.
+//
+// Values will panic if the receiver does not contain a successful factorization.
+func (svd *CSVD) Values(s []float64) []float64 {
+	if svd.kind == 0 {
+		panic("csvd: no decomposition computed")
+	}
+	if s == nil {
+		s = make([]float64, len(svd.s))
+	}
+	if len(s) != len(svd.s) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(s, svd.s)
+	return s
+}
+
+// UTo extracts the matrix U from the singular value decomposition. The first
+// min(m,n) columns are the left singular vectors and correspond to the singular
+// values as returned from CSVD.Values.
+//
+// If dst is not nil, U is stored in-place into dst, and dst must have size
+// m×m if svd.Kind() == SVDFull, size m×min(m,n) if svd.Kind() == SVDThin, and
+// UTo panics otherwise. If dst is nil, a new matrix of the appropriate size is
+// allocated and returned.
+func (svd *CSVD) UTo(dst *CDense) *CDense {
+	kind := svd.kind
+	if kind != SVDFull && kind != SVDThin {
+		panic("mat: improper SVD kind")
+	}
+	r := svd.u.Rows
+	c := svd.u.Cols
+	if dst == nil {
+		dst = NewCDense(r, c, nil)
+	} else {
+		dst.reuseAs(r, c)
+	}
+
+	tmp := &CDense{
+		mat:     svd.u,
+		capRows: r,
+		capCols: c,
+	}
+	dst.Copy(tmp)
+
+	return dst
+}
+
+// VTo extracts the matrix V from the singular value decomposition. The first
+// min(m,n) columns are the right singular vectors and correspond to the singular
+// values as returned from CSVD.Values.
+//
+// If dst is not nil, V is stored in-place into dst, and dst must have size
+// n×n if svd.Kind() == SVDFull, size n×min(m,n) if svd.Kind() == SVDThin, and
+// VTo panics otherwise. If dst is nil, a new matrix of the appropriate size is
+// allocated and returned.
+func (svd *CSVD) VTo(dst *CDense) *CDense {
+	kind := svd.kind
+	if kind != SVDFull && kind != SVDThin {
+		panic("mat: improper SVD kind")
+	}
+	r := svd.vt.Rows
+	c := svd.vt.Cols
+	if dst == nil {
+		dst = NewCDense(c, r, nil)
+	} else {
+		dst.reuseAs(c, r)
+	}
+
+	// V is the conjugate transpose of the stored V^H.
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			dst.set(j, i, cmplx.Conj(svd.vt.Data[i*svd.vt.Stride+j]))
+		}
+	}
+
+	return dst
+}
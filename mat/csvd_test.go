@@ -0,0 +1,133 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestCSVD(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{10, 4},
+		{4, 10},
+		{30, 30},
+		{50, 20},
+	} {
+		m, n := test.m, test.n
+		a := randCDense(m, n, rnd)
+
+		var full CSVD
+		ok := full.Factorize(a, SVDFull)
+		if !ok {
+			t.Fatalf("m=%d,n=%d: unexpected full factorization failure", m, n)
+		}
+		if full.Kind() != SVDFull {
+			t.Errorf("m=%d,n=%d: unexpected kind: %v", m, n, full.Kind())
+		}
+		s := full.Values(nil)
+		for i := 1; i < len(s); i++ {
+			if s[i] > s[i-1] {
+				t.Errorf("m=%d,n=%d: singular values not in descending order", m, n)
+				break
+			}
+		}
+
+		u := full.UTo(nil)
+		v := full.VTo(nil)
+		var uhu, vhv CDense
+		uhu.Mul(u.H(), u)
+		vhv.Mul(v.H(), v)
+		if !cIsIdentity(&uhu, 1e-12) {
+			t.Errorf("m=%d,n=%d: U is not unitary", m, n)
+		}
+		if !cIsIdentity(&vhv, 1e-12) {
+			t.Errorf("m=%d,n=%d: V is not unitary", m, n)
+		}
+
+		sigma := NewCDense(m, n, nil)
+		for i, v := range s {
+			sigma.Set(i, i, complex(v, 0))
+		}
+		var us, got CDense
+		us.Mul(u, sigma)
+		got.Mul(&us, v.H())
+		if !CEqualApprox(&got, a, 1e-12) {
+			t.Errorf("m=%d,n=%d: U*Σ*V^H does not equal original matrix", m, n)
+		}
+
+		var thin CSVD
+		ok = thin.Factorize(a, SVDThin)
+		if !ok {
+			t.Fatalf("m=%d,n=%d: unexpected thin factorization failure", m, n)
+		}
+		u = thin.UTo(nil)
+		v = thin.VTo(nil)
+		if r, c := u.Dims(); r != m || c != min(m, n) {
+			t.Errorf("m=%d,n=%d: unexpected size of thin U: %d×%d", m, n, r, c)
+		}
+		if r, c := v.Dims(); r != n || c != min(m, n) {
+			t.Errorf("m=%d,n=%d: unexpected size of thin V: %d×%d", m, n, r, c)
+		}
+		sigma = NewCDense(min(m, n), min(m, n), nil)
+		for i, v := range thin.Values(nil) {
+			sigma.Set(i, i, complex(v, 0))
+		}
+		us.Reset()
+		us.Mul(u, sigma)
+		got.Mul(&us, v.H())
+		if !CEqualApprox(&got, a, 1e-12) {
+			t.Errorf("m=%d,n=%d: thin U*Σ*V^H does not equal original matrix", m, n)
+		}
+
+		var none CSVD
+		ok = none.Factorize(a, SVDNone)
+		if !ok {
+			t.Fatalf("m=%d,n=%d: unexpected factorization failure without vectors", m, n)
+		}
+		if !floats.EqualApprox(none.Values(nil), s, 1e-12) {
+			t.Errorf("m=%d,n=%d: singular values depend on the kind of factorization", m, n)
+		}
+		if c := none.Cond(); math.Abs(c-s[0]/s[len(s)-1]) > 1e-12*c {
+			t.Errorf("m=%d,n=%d: unexpected condition number: got %v want %v", m, n, c, s[0]/s[len(s)-1])
+		}
+	}
+}
+
+func TestCSVDReal(t *testing.T) {
+	// The singular values of a real matrix must agree with those computed
+	// by SVD.
+	rnd := rand.New(rand.NewSource(1))
+	const m, n = 12, 7
+	a := NewDense(m, n, nil)
+	ca := NewCDense(m, n, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			v := rnd.NormFloat64()
+			a.Set(i, j, v)
+			ca.Set(i, j, complex(v, 0))
+		}
+	}
+	var svd SVD
+	if !svd.Factorize(a, SVDNone) {
+		t.Fatal("unexpected SVD failure")
+	}
+	var csvd CSVD
+	if !csvd.Factorize(ca, SVDNone) {
+		t.Fatal("unexpected CSVD failure")
+	}
+	if !floats.EqualApprox(csvd.Values(nil), svd.Values(nil), 1e-13) {
+		t.Errorf("singular values mismatch: got %v want %v", csvd.Values(nil), svd.Values(nil))
+	}
+}
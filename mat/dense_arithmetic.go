@@ -627,6 +627,50 @@ func (m *Dense) Exp(a Matrix) {
 	}
 }
 
+// ExpFrechet calculates the Fréchet derivative of the matrix exponential at a
+// in the direction e,
+//  L(a, e) = d/dt e^(a + t*e) at t = 0,
+// placing the result in the receiver. The Fréchet derivative gives the first
+// order sensitivity of e^a to a perturbation e of a. ExpFrechet will panic
+// with ErrShape if a is not square or if e is not the same size as a.
+func (m *Dense) ExpFrechet(a, e Matrix) {
+	// The implementation used here is based on the identity
+	//  exp([A E; 0 A]) = [e^A L(A,E); 0 e^A]
+	// from Functions of Matrices: Theory and Computation, Chapter 3,
+	// Theorem 3.6. https://doi.org/10.1137/1.9780898717778.ch3
+
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	er, ec := e.Dims()
+	if er != r || ec != c {
+		panic(ErrShape)
+	}
+
+	// L is linear in E, so scale E to the norm of A to avoid that the
+	// scaling and squaring in Exp is dominated by E.
+	scale := 1.0
+	if anorm, enorm := Norm(a, 1), Norm(e, 1); anorm != 0 && enorm != 0 {
+		scale = anorm / enorm
+	}
+
+	n := 2 * r
+	b := getWorkspace(n, n, true)
+	defer putWorkspace(b)
+	b.Slice(0, r, 0, r).(*Dense).Copy(a)
+	b.Slice(r, n, r, n).(*Dense).Copy(a)
+	be := b.Slice(0, r, r, n).(*Dense)
+	be.Scale(scale, e)
+
+	eb := getWorkspace(n, n, false)
+	defer putWorkspace(eb)
+	eb.Exp(b)
+
+	m.reuseAs(r, r)
+	m.Scale(1/scale, eb.Slice(0, r, r, n))
+}
+
 // Pow calculates the integral power of the matrix a to n, placing the result
 // in the receiver. Pow will panic if n is negative or if a is not square.
 func (m *Dense) Pow(a Matrix, n int) {
@@ -999,6 +999,46 @@ func TestDenseExp(t *testing.T) {
 	}
 }
 
+func TestDenseExpFrechet(t *testing.T) {
+	const tol = 1e-7
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10} {
+		a := NewDense(n, n, nil)
+		e := NewDense(n, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+			e.mat.Data[i] = 1e-3 * rnd.NormFloat64()
+		}
+
+		// Compare with a central difference approximation.
+		const h = 1e-4
+		var got, ap, am, want Dense
+		got.ExpFrechet(a, e)
+		ap.Scale(h, e)
+		ap.Add(a, &ap)
+		ap.Exp(&ap)
+		am.Scale(-h, e)
+		am.Add(a, &am)
+		am.Exp(&am)
+		want.Sub(&ap, &am)
+		want.Scale(1/(2*h), &want)
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("n=%d: unexpected Fréchet derivative\ngot:\n%v\nwant:\n%v", n, Formatted(&got), Formatted(&want))
+		}
+
+		// The derivative in the direction of a commuting matrix E = A is
+		// A * e^A.
+		var ea Dense
+		ea.Exp(a)
+		got.ExpFrechet(a, a)
+		want.Mul(a, &ea)
+		if !EqualApprox(&got, &want, 1e-12*Norm(&want, 1)) {
+			t.Errorf("n=%d: unexpected Fréchet derivative in commuting direction", n)
+		}
+	}
+}
+
 func TestDensePow(t *testing.T) {
 	for i, test := range []struct {
 		a    [][]float64
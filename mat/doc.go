@@ -94,6 +94,11 @@
 // without needing to update the original matrix and refactorize,
 // as in *LU.RankOne.
 //
+// The complex factorization types CLU, CQR, CCholesky, CSVD, CEigen and
+// CEigenHerm mirror their real counterparts, with the conjugate transpose
+// taking the place of the transpose, so that for example *CLU.Solve with
+// trans set to true solves A^H * X = B.
+//
 // BLAS and LAPACK
 //
 // BLAS and LAPACK are the standard APIs for linear algebra routines. Many
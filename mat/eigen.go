@@ -5,18 +5,26 @@
 package mat
 
 import (
+	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
 
 const (
-	badFact   = "mat: use without successful factorization"
-	badNoVect = "mat: eigenvectors not computed"
+	badFact     = "mat: use without successful factorization"
+	badNoVect   = "mat: eigenvectors not computed"
+	badInterval = "mat: empty eigenvalue interval"
 )
 
 // EigenSym is a type for creating and manipulating the Eigen decomposition of
 // symmetric matrices.
 type EigenSym struct {
+	// DivideConquer specifies whether Factorize uses a divide and
+	// conquer algorithm to compute the eigenvectors. The divide and
+	// conquer algorithm is usually substantially faster for large
+	// matrices, at the cost of additional workspace.
+	DivideConquer bool
+
 	vectorsComputed bool
 
 	values  []float64
@@ -31,6 +39,10 @@ type EigenSym struct {
 // in ascending order. If the vectors input argument is false, the eigenvectors
 // are not computed.
 //
+// If the DivideConquer field of the receiver is true, the eigenvectors are
+// computed using a divide and conquer algorithm, otherwise they are computed
+// using implicit QL/QR iteration.
+//
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, methods that require a successful factorization will panic.
 func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
@@ -44,10 +56,20 @@ func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
 	}
 	w := make([]float64, n)
 	work := []float64{0}
-	lapack64.Syev(jobz, sd.mat, w, work, -1)
+	if e.DivideConquer {
+		iwork := []int{0}
+		lapack64.Syevd(jobz, sd.mat, w, work, -1, iwork, -1)
 
-	work = getFloats(int(work[0]), false)
-	ok = lapack64.Syev(jobz, sd.mat, w, work, len(work))
+		work = getFloats(int(work[0]), false)
+		iwork = getInts(iwork[0], false)
+		ok = lapack64.Syevd(jobz, sd.mat, w, work, len(work), iwork, len(iwork))
+		putInts(iwork)
+	} else {
+		lapack64.Syev(jobz, sd.mat, w, work, -1)
+
+		work = getFloats(int(work[0]), false)
+		ok = lapack64.Syev(jobz, sd.mat, w, work, len(work))
+	}
 	putFloats(work)
 	if !ok {
 		e.vectorsComputed = false
@@ -61,16 +83,108 @@ func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
 	return true
 }
 
+// FactorizeIndex computes the eigenvalues of the symmetric n×n matrix a with
+// indices lo through hi inclusive, where the eigenvalues are indexed in
+// ascending order starting at zero, so that FactorizeIndex(a, 0, k-1, true)
+// computes the k smallest eigenpairs and FactorizeIndex(a, n-k, n-1, true) the
+// k largest. It must hold that 0 <= lo <= hi < n, otherwise FactorizeIndex will
+// panic. If the vectors input argument is false, the eigenvectors are not
+// computed, otherwise only the hi-lo+1 eigenvectors corresponding to the
+// selected eigenvalues are computed.
+//
+// The selected eigenvalues are computed by bisection and the eigenvectors by
+// inverse iteration, which is typically much faster than Factorize when only a
+// few eigenpairs of a large matrix are required.
+//
+// FactorizeIndex returns whether the decomposition succeeded. If the
+// decomposition failed, methods that require a successful factorization will
+// panic.
+func (e *EigenSym) FactorizeIndex(a Symmetric, lo, hi int, vectors bool) (ok bool) {
+	n := a.Symmetric()
+	if lo < 0 || hi < lo || n <= hi {
+		panic(ErrIndexOutOfRange)
+	}
+	return e.factorizeRange(a, lapack.EVRangeIndex, 0, 0, lo, hi, vectors)
+}
+
+// FactorizeInterval computes the eigenvalues of the symmetric matrix a that lie
+// in the half-open interval (lo, hi]. It must hold that lo < hi, otherwise
+// FactorizeInterval will panic. If the vectors input argument is false, the
+// eigenvectors are not computed, otherwise only the eigenvectors corresponding
+// to the selected eigenvalues are computed.
+//
+// The selected eigenvalues are computed by bisection and the eigenvectors by
+// inverse iteration, which is typically much faster than Factorize when only a
+// few eigenpairs of a large matrix are required.
+//
+// FactorizeInterval returns whether the decomposition succeeded. The
+// decomposition may succeed without finding any eigenvalues in the interval,
+// in which case Values returns an empty slice. If the decomposition failed,
+// methods that require a successful factorization will panic.
+func (e *EigenSym) FactorizeInterval(a Symmetric, lo, hi float64, vectors bool) (ok bool) {
+	if !(lo < hi) {
+		panic(badInterval)
+	}
+	return e.factorizeRange(a, lapack.EVRangeValue, lo, hi, 0, 0, vectors)
+}
+
+// factorizeRange computes the eigenvalues of a selected by rng and, if vectors
+// is true, the corresponding eigenvectors.
+func (e *EigenSym) factorizeRange(a Symmetric, rng lapack.EVRange, vl, vu float64, il, iu int, vectors bool) (ok bool) {
+	n := a.Symmetric()
+	sd := NewSymDense(n, nil)
+	sd.CopySym(a)
+
+	jobz := lapack.EVNone
+	ncol := n
+	if rng == lapack.EVRangeIndex {
+		ncol = iu - il + 1
+	}
+	var z blas64.General
+	if vectors {
+		jobz = lapack.EVCompute
+		z = blas64.General{
+			Rows:   n,
+			Cols:   ncol,
+			Stride: ncol,
+			Data:   make([]float64, n*ncol),
+		}
+	}
+	w := make([]float64, n)
+	work := []float64{0}
+	iwork := []int{0}
+	lapack64.Syevr(jobz, rng, sd.mat, vl, vu, il, iu, 0, w, z, work, -1, iwork, -1)
+
+	work = getFloats(int(work[0]), false)
+	iwork = getInts(iwork[0], false)
+	m, ok := lapack64.Syevr(jobz, rng, sd.mat, vl, vu, il, iu, 0, w, z, work, len(work), iwork, len(iwork))
+	putFloats(work)
+	putInts(iwork)
+	if !ok {
+		e.vectorsComputed = false
+		e.values = nil
+		e.vectors = nil
+		return false
+	}
+	e.vectorsComputed = vectors
+	e.values = w[:m:m]
+	e.vectors = nil
+	if vectors && m > 0 {
+		e.vectors = NewDense(n, ncol, z.Data).Slice(0, n, 0, m).(*Dense)
+	}
+	return true
+}
+
 // succFact returns whether the receiver contains a successful factorization.
 func (e *EigenSym) succFact() bool {
-	return len(e.values) != 0
+	return e.values != nil
 }
 
 // Values extracts the eigenvalues of the factorized matrix. If dst is
 // non-nil, the values are stored in-place into dst. In this case
-// dst must have length n, otherwise Values will panic. If dst is
-// nil, then a new slice will be allocated of the proper length and filled
-// with the eigenvalues.
+// dst must have length equal to the number of computed eigenvalues, otherwise
+// Values will panic. If dst is nil, then a new slice will be allocated of the
+// proper length and filled with the eigenvalues.
 //
 // Values panics if the Eigen decomposition was not successful.
 func (e *EigenSym) Values(dst []float64) []float64 {
@@ -89,10 +203,13 @@ func (e *EigenSym) Values(dst []float64) []float64 {
 
 // EigenvectorsSym extracts the eigenvectors of the factorized matrix and stores
 // them in the receiver. Each eigenvector is a column corresponding to the
-// respective eigenvalue returned by e.Values.
+// respective eigenvalue returned by e.Values. If only a subset of the
+// eigenvalues was computed by FactorizeIndex or FactorizeInterval, the receiver
+// is n×k where k is the number of computed eigenvalues.
 //
-// EigenvectorsSym panics if the factorization was not successful or if the
-// decomposition did not compute the eigenvectors.
+// EigenvectorsSym panics if the factorization was not successful, if the
+// decomposition did not compute the eigenvectors or if no eigenvalues were
+// found.
 func (m *Dense) EigenvectorsSym(e *EigenSym) {
 	if !e.succFact() {
 		panic(badFact)
@@ -100,7 +217,10 @@ func (m *Dense) EigenvectorsSym(e *EigenSym) {
 	if !e.vectorsComputed {
 		panic(badNoVect)
 	}
-	m.reuseAs(len(e.values), len(e.values))
+	if len(e.values) == 0 {
+		panic(ErrZeroLength)
+	}
+	m.reuseAs(e.vectors.Dims())
 	m.Copy(e.vectors)
 }
 
@@ -197,14 +317,14 @@ func (e *Eigen) Factorize(a Matrix, left, right bool) (ok bool) {
 	var cvl, cvr CDense
 	if left {
 		cvl = *NewCDense(r, r, nil)
-		e.complexEigenTo(&cvl, &vl)
+		complexEigenTo(&cvl, &vl, e.values)
 		e.lVectors = &cvl
 	} else {
 		e.lVectors = nil
 	}
 	if right {
 		cvr = *NewCDense(c, c, nil)
-		e.complexEigenTo(&cvr, &vr)
+		complexEigenTo(&cvr, &vr, e.values)
 		e.rVectors = &cvr
 	} else {
 		e.rVectors = nil
@@ -234,7 +354,8 @@ func (e *Eigen) Values(dst []complex128) []complex128 {
 }
 
 // complexEigenTo extracts the complex eigenvectors from the real matrix d
-// and stores them into the complex matrix dst.
+// and stores them into the complex matrix dst. values holds the eigenvalues
+// (or, for generalized problems, their numerators) of the decomposition.
 //
 // The columns of the returned n×n dense matrix contain the eigenvectors of the
 // decomposition in the same order as the eigenvalues.
@@ -245,7 +366,7 @@ func (e *Eigen) Values(dst []complex128) []complex128 {
 //  dst[:,j]   = d[:,j] + i*d[:,j+1],
 //  dst[:,j+1] = d[:,j] - i*d[:,j+1],
 // where i is the imaginary unit.
-func (e *Eigen) complexEigenTo(dst *CDense, d *Dense) {
+func complexEigenTo(dst *CDense, d *Dense, values []complex128) {
 	r, c := d.Dims()
 	cr, cc := dst.Dims()
 	if r != cr {
@@ -255,7 +376,7 @@ func (e *Eigen) complexEigenTo(dst *CDense, d *Dense) {
 		panic("size mismatch")
 	}
 	for j := 0; j < c; j++ {
-		if imag(e.values[j]) == 0 {
+		if imag(values[j]) == 0 {
 			for i := 0; i < r; i++ {
 				dst.set(i, j, complex(d.at(i, j), 0))
 			}
@@ -5,6 +5,8 @@
 package mat
 
 import (
+	"fmt"
+	"math"
 	"sort"
 	"testing"
 
@@ -162,13 +164,15 @@ func TestSymEigen(t *testing.T) {
 	// Randomized tests
 	rnd := rand.New(rand.NewSource(1))
 	for _, n := range []int{3, 5, 10, 70} {
-		for cas := 0; cas < 10; cas++ {
+		for cas := 0; cas < 20; cas++ {
 			a := make([]float64, n*n)
 			for i := range a {
 				a[i] = rnd.NormFloat64()
 			}
 			s := NewSymDense(n, a)
-			var es EigenSym
+			// Alternate between the QR iteration and divide and
+			// conquer algorithms.
+			es := EigenSym{DivideConquer: cas%2 == 1}
 			ok := es.Factorize(s, true)
 			if !ok {
 				t.Errorf("Bad test")
@@ -200,3 +204,152 @@ func TestSymEigen(t *testing.T) {
 		}
 	}
 }
+
+func TestSymEigenRange(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 3, 5, 10, 70} {
+		for cas := 0; cas < 5; cas++ {
+			a := make([]float64, n*n)
+			for i := range a {
+				a[i] = rnd.NormFloat64()
+			}
+			s := NewSymDense(n, a)
+
+			var full EigenSym
+			ok := full.Factorize(s, false)
+			if !ok {
+				t.Fatalf("Bad test")
+			}
+			all := full.Values(nil)
+
+			lo := rnd.Intn(n)
+			hi := lo + rnd.Intn(n-lo)
+			for _, vectors := range []bool{true, false} {
+				var es EigenSym
+				ok = es.FactorizeIndex(s, lo, hi, vectors)
+				if !ok {
+					t.Errorf("n=%d,lo=%d,hi=%d: unexpected FactorizeIndex failure", n, lo, hi)
+					continue
+				}
+				checkEigenSymRange(t, s, &es, all[lo:hi+1], vectors, fmt.Sprintf("index n=%d,lo=%d,hi=%d", n, lo, hi))
+			}
+
+			// Place the interval ends between eigenvalues.
+			vl := all[lo] - 1
+			if lo > 0 {
+				vl = (all[lo-1] + all[lo]) / 2
+			}
+			vu := all[hi] + 1
+			if hi < n-1 {
+				vu = (all[hi] + all[hi+1]) / 2
+			}
+			for _, vectors := range []bool{true, false} {
+				var es EigenSym
+				ok = es.FactorizeInterval(s, vl, vu, vectors)
+				if !ok {
+					t.Errorf("n=%d,vl=%v,vu=%v: unexpected FactorizeInterval failure", n, vl, vu)
+					continue
+				}
+				checkEigenSymRange(t, s, &es, all[lo:hi+1], vectors, fmt.Sprintf("interval n=%d,vl=%v,vu=%v", n, vl, vu))
+			}
+
+			// Check an interval containing no eigenvalues.
+			var es EigenSym
+			ok = es.FactorizeInterval(s, all[n-1]+1, all[n-1]+2, true)
+			if !ok {
+				t.Errorf("n=%d: unexpected FactorizeInterval failure for empty interval", n)
+			}
+			if len(es.Values(nil)) != 0 {
+				t.Errorf("n=%d: unexpected eigenvalues in empty interval", n)
+			}
+		}
+	}
+}
+
+func checkEigenSymRange(t *testing.T, s *SymDense, es *EigenSym, want []float64, vectors bool, name string) {
+	got := es.Values(nil)
+	if !floats.EqualApprox(got, want, 1e-12) {
+		t.Errorf("%s: eigenvalue mismatch: got %v, want %v", name, got, want)
+		return
+	}
+	if !vectors {
+		return
+	}
+	var vecs Dense
+	vecs.EigenvectorsSym(es)
+	n, k := vecs.Dims()
+	if n != s.Symmetric() || k != len(want) {
+		t.Errorf("%s: unexpected eigenvector dimensions: got %d×%d, want %d×%d", name, n, k, s.Symmetric(), len(want))
+		return
+	}
+
+	// Check that the eigenvectors are orthonormal.
+	var vtv Dense
+	vtv.Mul(vecs.T(), &vecs)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if math.Abs(vtv.At(i, j)-want) > 1e-10 {
+				t.Errorf("%s: eigenvectors not orthonormal", name)
+				return
+			}
+		}
+	}
+
+	// Check that A*V = V*D.
+	var av, vd Dense
+	av.Mul(s, &vecs)
+	vd.Mul(&vecs, NewDiagDense(k, got))
+	if !EqualApprox(&av, &vd, 1e-10) {
+		t.Errorf("%s: eigenvectors do not match eigenvalues", name)
+	}
+}
+
+func BenchmarkEigenSymFactorize(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dc := range []bool{false, true} {
+		alg := "Dsyev"
+		if dc {
+			alg = "Dsyevd"
+		}
+		for _, size := range []int{10, 100, 500} {
+			for _, vectors := range []bool{false, true} {
+				a := NewSymDense(size, nil)
+				for i := 0; i < size; i++ {
+					for j := i; j < size; j++ {
+						a.SetSym(i, j, rnd.NormFloat64())
+					}
+				}
+				b.Run(fmt.Sprintf("%s/%d/vectors=%t", alg, size, vectors), func(b *testing.B) {
+					es := EigenSym{DivideConquer: dc}
+					for i := 0; i < b.N; i++ {
+						es.Factorize(a, vectors)
+					}
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkEigenSymFactorizeIndex(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, size := range []int{100, 500} {
+		for _, k := range []int{1, 10} {
+			a := NewSymDense(size, nil)
+			for i := 0; i < size; i++ {
+				for j := i; j < size; j++ {
+					a.SetSym(i, j, rnd.NormFloat64())
+				}
+			}
+			b.Run(fmt.Sprintf("%d/largest=%d", size, k), func(b *testing.B) {
+				var es EigenSym
+				for i := 0; i < b.N; i++ {
+					es.FactorizeIndex(a, size-k, size-1, true)
+				}
+			})
+		}
+	}
+}
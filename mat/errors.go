@@ -134,6 +134,9 @@ var (
 	ErrSliceLengthMismatch = Error{"matrix: input slice length mismatch"}
 	ErrNotPSD              = Error{"matrix: input not positive symmetric definite"}
 	ErrFailedEigen         = Error{"matrix: eigendecomposition not successful"}
+	ErrNoStabilizing       = Error{"matrix: no stabilizing solution"}
+	ErrNegativeEigen       = Error{"matrix: negative real eigenvalue"}
+	ErrImaginaryEigen      = Error{"matrix: eigenvalue on the imaginary axis"}
 )
 
 // ErrorStack represents matrix handling errors that have been recovered by Maybe wrappers.
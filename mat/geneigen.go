@@ -0,0 +1,338 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// GenEigenSym is a type for creating and using the eigenvalue decomposition of
+// a symmetric-definite generalized eigenvalue problem
+//  A * x = λ * B * x,
+// where A and B are symmetric and B is positive definite.
+type GenEigenSym struct {
+	vectorsComputed bool
+
+	values  []float64
+	vectors *Dense
+}
+
+// Factorize computes the eigenvalues and, optionally, the eigenvectors of the
+// symmetric-definite generalized eigenvalue problem
+//  A * x = λ * B * x.
+// The problem is reduced to a standard symmetric eigenvalue problem using the
+// Cholesky factorization of B. Factorize computes the eigenvalues in ascending
+// order. If the vectors input argument is false, the eigenvectors are not
+// computed. Factorize will panic if a and b are not of the same size.
+//
+// Factorize returns whether the decomposition succeeded. The decomposition
+// fails if B is not positive definite or if the eigenvalue computation does not
+// converge. If the decomposition failed, methods that require a successful
+// factorization will panic.
+func (e *GenEigenSym) Factorize(a, b Symmetric, vectors bool) (ok bool) {
+	n := a.Symmetric()
+	if b.Symmetric() != n {
+		panic(ErrShape)
+	}
+	sa := NewSymDense(n, nil)
+	sa.CopySym(a)
+	sb := NewSymDense(n, nil)
+	sb.CopySym(b)
+
+	jobz := lapack.EVNone
+	if vectors {
+		jobz = lapack.EVCompute
+	}
+	w := make([]float64, n)
+	work := []float64{0}
+	lapack64.Sygv(lapack.GenEVAxBx, jobz, sa.mat, sb.mat, w, work, -1)
+
+	work = getFloats(int(work[0]), false)
+	ok = lapack64.Sygv(lapack.GenEVAxBx, jobz, sa.mat, sb.mat, w, work, len(work))
+	putFloats(work)
+	if !ok {
+		e.vectorsComputed = false
+		e.values = nil
+		e.vectors = nil
+		return false
+	}
+	e.vectorsComputed = vectors
+	e.values = w
+	e.vectors = NewDense(n, n, sa.mat.Data)
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *GenEigenSym) succFact() bool {
+	return e.values != nil
+}
+
+// Values extracts the eigenvalues of the factorized problem. If dst is
+// non-nil, the values are stored in-place into dst. In this case dst must have
+// length n, otherwise Values will panic. If dst is nil, then a new slice will
+// be allocated of the proper length and filled with the eigenvalues.
+//
+// Values panics if the decomposition was not successful.
+func (e *GenEigenSym) Values(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo returns the eigenvectors of the decomposition. Each eigenvector is
+// a column corresponding to the respective eigenvalue returned by e.Values.
+// If dst is nil, a new matrix is allocated. The resulting matrix is returned.
+//
+// The eigenvectors are normalized so that
+//  X^T * B * X = I,
+// where X is the matrix of eigenvectors.
+//
+// VectorsTo panics if the factorization was not successful or if the
+// decomposition did not compute the eigenvectors.
+func (e *GenEigenSym) VectorsTo(dst *Dense) *Dense {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.vectorsComputed {
+		panic(badNoVect)
+	}
+	r, c := e.vectors.Dims()
+	if dst == nil {
+		dst = NewDense(r, c, nil)
+	} else {
+		dst.reuseAs(r, c)
+	}
+	dst.Copy(e.vectors)
+	return dst
+}
+
+// GenEigen is a type for creating and using the eigenvalue decomposition of a
+// general generalized eigenvalue problem
+//  A * x = λ * B * x,
+// where A and B are square dense matrices.
+type GenEigen struct {
+	n int // The size of the factorized matrices.
+
+	right bool // have the right eigenvectors been computed
+	left  bool // have the left eigenvectors been computed
+
+	alpha    []complex128
+	beta     []float64
+	rVectors *CDense
+	lVectors *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *GenEigen) succFact() bool {
+	return e.alpha != nil
+}
+
+// Factorize computes the generalized eigenvalues of the pair of square
+// matrices (a, b), and optionally the eigenvectors, using the QZ algorithm.
+//
+// A right generalized eigenvalue/eigenvector combination is defined by
+//  A * x_r = λ * B * x_r
+// and a left eigenvalue/eigenvector combination by
+//  x_l^H * A = λ * x_l^H * B,
+// where x_l^H is the conjugate transpose of x_l.
+//
+// The generalized eigenvalues are computed as pairs (α, β) with λ = α/β, so
+// that eigenvalues which are infinite because B is singular are represented by
+// β == 0.
+//
+// In all cases, Factorize computes the eigenvalues of the matrix pair. If
+// right and left are true, then the right and left eigenvectors will be
+// computed, respectively. Factorize panics if the input matrices are not
+// square or not of the same size.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+func (e *GenEigen) Factorize(a, b Matrix, left, right bool) (ok bool) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	if br, bc := b.Dims(); br != r || bc != c {
+		panic(ErrShape)
+	}
+	// Copy a and b because they are modified during the Lapack call.
+	var sa, sb Dense
+	sa.Clone(a)
+	sb.Clone(b)
+
+	var vl, vr Dense
+	jobvl := lapack.LeftEVNone
+	jobvr := lapack.RightEVNone
+	if left {
+		vl = *NewDense(r, r, nil)
+		jobvl = lapack.LeftEVCompute
+	} else {
+		vl.mat.Stride = 1
+	}
+	if right {
+		vr = *NewDense(c, c, nil)
+		jobvr = lapack.RightEVCompute
+	} else {
+		vr.mat.Stride = 1
+	}
+
+	alphar := getFloats(c, false)
+	defer putFloats(alphar)
+	alphai := getFloats(c, false)
+	defer putFloats(alphai)
+	beta := make([]float64, c)
+
+	work := []float64{0}
+	lapack64.Ggev(jobvl, jobvr, sa.mat, sb.mat, alphar, alphai, beta, vl.mat, vr.mat, work, -1)
+	work = getFloats(int(work[0]), false)
+	first, ok := lapack64.Ggev(jobvl, jobvr, sa.mat, sb.mat, alphar, alphai, beta, vl.mat, vr.mat, work, len(work))
+	putFloats(work)
+
+	if first != 0 || !ok {
+		e.alpha = nil
+		e.beta = nil
+		return false
+	}
+	e.n = r
+	e.right = right
+	e.left = left
+
+	alpha := make([]complex128, r)
+	for i, v := range alphar {
+		alpha[i] = complex(v, alphai[i])
+	}
+	e.alpha = alpha
+	e.beta = beta
+
+	// Construct complex eigenvectors from float64 data.
+	if left {
+		e.lVectors = NewCDense(r, r, nil)
+		complexEigenTo(e.lVectors, &vl, alpha)
+	} else {
+		e.lVectors = nil
+	}
+	if right {
+		e.rVectors = NewCDense(c, c, nil)
+		complexEigenTo(e.rVectors, &vr, alpha)
+	} else {
+		e.rVectors = nil
+	}
+	return true
+}
+
+// Values extracts the generalized eigenvalues λ = α/β of the factorized
+// matrix pair. If dst is non-nil, the values are stored in-place into dst. In
+// this case dst must have length n, otherwise Values will panic. If dst is
+// nil, then a new slice will be allocated of the proper length and filled with
+// the eigenvalues.
+//
+// Eigenvalues with β == 0 are returned as complex infinity, or as complex NaN if
+// also α == 0, in which case the matrix pair is singular. Use AlphaBeta to
+// obtain the eigenvalues without the division.
+//
+// Values panics if the decomposition was not successful.
+func (e *GenEigen) Values(dst []complex128) []complex128 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	for i, alpha := range e.alpha {
+		beta := e.beta[i]
+		switch {
+		case beta != 0:
+			dst[i] = alpha / complex(beta, 0)
+		case alpha == 0:
+			dst[i] = cmplx.NaN()
+		default:
+			dst[i] = cmplx.Inf()
+		}
+	}
+	return dst
+}
+
+// AlphaBeta extracts the generalized eigenvalues of the factorized matrix pair
+// as the pairs (α, β) with λ = α/β, where β is real and non-negative. If alpha
+// and beta are non-nil, the values are stored in-place into them. In this case
+// they must have length n, otherwise AlphaBeta will panic. If alpha or beta is
+// nil, then a new slice will be allocated of the proper length.
+//
+// AlphaBeta panics if the decomposition was not successful.
+func (e *GenEigen) AlphaBeta(alpha []complex128, beta []float64) ([]complex128, []float64) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if alpha == nil {
+		alpha = make([]complex128, e.n)
+	}
+	if beta == nil {
+		beta = make([]float64, e.n)
+	}
+	if len(alpha) != e.n || len(beta) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(alpha, e.alpha)
+	copy(beta, e.beta)
+	return alpha, beta
+}
+
+// VectorsTo returns the right eigenvectors of the decomposition. VectorsTo
+// will panic if the right eigenvectors were not computed during the factorization,
+// or if the factorization was not successful.
+//
+// The computed eigenvectors are normalized so that their largest component,
+// measured by |real part| + |imag part|, has that measure equal to 1.
+func (e *GenEigen) VectorsTo(dst *CDense) *CDense {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.right {
+		panic(badNoVect)
+	}
+	if dst == nil {
+		dst = NewCDense(e.n, e.n, nil)
+	} else {
+		dst.reuseAs(e.n, e.n)
+	}
+	dst.Copy(e.rVectors)
+	return dst
+}
+
+// LeftVectorsTo returns the left eigenvectors of the decomposition. LeftVectorsTo
+// will panic if the left eigenvectors were not computed during the factorization,
+// or if the factorization was not successful.
+//
+// The computed eigenvectors are normalized so that their largest component,
+// measured by |real part| + |imag part|, has that measure equal to 1.
+func (e *GenEigen) LeftVectorsTo(dst *CDense) *CDense {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.left {
+		panic(badNoVect)
+	}
+	if dst == nil {
+		dst = NewCDense(e.n, e.n, nil)
+	} else {
+		dst.reuseAs(e.n, e.n)
+	}
+	dst.Copy(e.lVectors)
+	return dst
+}
@@ -0,0 +1,200 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestGenEigenSym(t *testing.T) {
+	const tol = 1e-10
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30, 70} {
+		a := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				a.SetSym(i, j, rnd.NormFloat64())
+			}
+		}
+		m := NewDense(n, n, nil)
+		for i := range m.mat.Data {
+			m.mat.Data[i] = rnd.NormFloat64()
+		}
+		b := NewSymDense(n, nil)
+		b.SymOuterK(1, m)
+		for i := 0; i < n; i++ {
+			b.SetSym(i, i, b.At(i, i)+float64(n))
+		}
+
+		var ges GenEigenSym
+		ok := ges.Factorize(a, b, true)
+		if !ok {
+			t.Errorf("n=%d: unexpected factorization failure", n)
+			continue
+		}
+		values := ges.Values(nil)
+		if !sort.Float64sAreSorted(values) {
+			t.Errorf("n=%d: eigenvalues not sorted", n)
+		}
+		x := ges.VectorsTo(nil)
+
+		// Check that A*X = B*X*Λ.
+		var ax, bx Dense
+		ax.Mul(a, x)
+		bx.Mul(b, x)
+		bx.Mul(&bx, NewDiagDense(n, values))
+		if !EqualApprox(&ax, &bx, tol) {
+			t.Errorf("n=%d: A*X != B*X*Λ", n)
+		}
+
+		// Check that X^T*B*X = I.
+		var xtbx Dense
+		xtbx.Product(x.T(), b, x)
+		if !EqualApprox(&xtbx, eye(n), tol) {
+			t.Errorf("n=%d: X^T*B*X != I", n)
+		}
+
+		// Check that the eigenvalues are the same when the eigenvectors
+		// are not computed.
+		var gesNoVec GenEigenSym
+		ok = gesNoVec.Factorize(a, b, false)
+		if !ok {
+			t.Errorf("n=%d: unexpected factorization failure without eigenvectors", n)
+			continue
+		}
+		got := gesNoVec.Values(nil)
+		for i := range got {
+			if math.Abs(got[i]-values[i]) > tol*math.Max(1, math.Abs(values[i])) {
+				t.Errorf("n=%d: eigenvalue mismatch without eigenvectors", n)
+				break
+			}
+		}
+		if panicked, _ := panics(func() { gesNoVec.VectorsTo(nil) }); !panicked {
+			t.Errorf("n=%d: VectorsTo did not panic without eigenvectors", n)
+		}
+
+		// Check that a B that is not positive definite is detected.
+		notPD := NewSymDense(n, nil)
+		notPD.CopySym(b)
+		notPD.SetSym(n-1, n-1, -1)
+		if ges.Factorize(a, notPD, true) {
+			t.Errorf("n=%d: B not positive definite but factorization succeeded", n)
+		}
+		if panicked, _ := panics(func() { ges.Values(nil) }); !panicked {
+			t.Errorf("n=%d: Values did not panic after failed factorization", n)
+		}
+	}
+}
+
+func TestGenEigen(t *testing.T) {
+	const tol = 1e-10
+
+	// Known values with an infinite eigenvalue.
+	a := NewDense(3, 3, []float64{
+		1, 2, 0,
+		0, 3, 0,
+		0, 0, 5,
+	})
+	b := NewDense(3, 3, []float64{
+		1, 0, 0,
+		0, 1, 0,
+		0, 0, 0,
+	})
+	var ge GenEigen
+	if !ge.Factorize(a, b, true, true) {
+		t.Fatal("unexpected factorization failure")
+	}
+	var finite []float64
+	var infinite int
+	for _, v := range ge.Values(nil) {
+		if cmplx.IsInf(v) {
+			infinite++
+			continue
+		}
+		finite = append(finite, real(v))
+	}
+	sort.Float64s(finite)
+	if infinite != 1 || len(finite) != 2 || math.Abs(finite[0]-1) > tol || math.Abs(finite[1]-3) > tol {
+		t.Errorf("unexpected eigenvalues: got %v", ge.Values(nil))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30, 50} {
+		a := NewDense(n, n, nil)
+		b := NewDense(n, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+			b.mat.Data[i] = rnd.NormFloat64()
+		}
+		for _, vecs := range []struct{ left, right bool }{
+			{false, false},
+			{true, false},
+			{false, true},
+			{true, true},
+		} {
+			var ge GenEigen
+			if !ge.Factorize(a, b, vecs.left, vecs.right) {
+				t.Errorf("n=%d: unexpected factorization failure", n)
+				continue
+			}
+			alpha, beta := ge.AlphaBeta(nil, nil)
+			for i, v := range ge.Values(nil) {
+				if beta[i] < 0 {
+					t.Errorf("n=%d: negative beta", n)
+				}
+				if beta[i] != 0 && cmplx.Abs(v-alpha[i]/complex(beta[i], 0)) > tol*cmplx.Abs(v) {
+					t.Errorf("n=%d: Values and AlphaBeta mismatch", n)
+				}
+			}
+
+			if vecs.right {
+				x := ge.VectorsTo(nil)
+				for j := 0; j < n; j++ {
+					// β*A*x_j = α*B*x_j.
+					for i := 0; i < n; i++ {
+						var ax, bx complex128
+						for k := 0; k < n; k++ {
+							ax += complex(a.At(i, k), 0) * x.At(k, j)
+							bx += complex(b.At(i, k), 0) * x.At(k, j)
+						}
+						if cmplx.Abs(complex(beta[j], 0)*ax-alpha[j]*bx) > tol*float64(n)*(beta[j]+cmplx.Abs(alpha[j])) {
+							t.Errorf("n=%d: right eigenvector %d does not satisfy the eigenproblem", n, j)
+							break
+						}
+					}
+				}
+			} else if panicked, _ := panics(func() { ge.VectorsTo(nil) }); !panicked {
+				t.Errorf("n=%d: VectorsTo did not panic without right eigenvectors", n)
+			}
+
+			if vecs.left {
+				y := ge.LeftVectorsTo(nil)
+				for j := 0; j < n; j++ {
+					// β*y_j^H*A = α*y_j^H*B.
+					for i := 0; i < n; i++ {
+						var ya, yb complex128
+						for k := 0; k < n; k++ {
+							yk := cmplx.Conj(y.At(k, j))
+							ya += yk * complex(a.At(k, i), 0)
+							yb += yk * complex(b.At(k, i), 0)
+						}
+						if cmplx.Abs(complex(beta[j], 0)*ya-alpha[j]*yb) > tol*float64(n)*(beta[j]+cmplx.Abs(alpha[j])) {
+							t.Errorf("n=%d: left eigenvector %d does not satisfy the eigenproblem", n, j)
+							break
+						}
+					}
+				}
+			} else if panicked, _ := panics(func() { ge.LeftVectorsTo(nil) }); !panicked {
+				t.Errorf("n=%d: LeftVectorsTo did not panic without left eigenvectors", n)
+			}
+		}
+	}
+}
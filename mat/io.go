@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"io"
 	"math"
+
+	"gonum.org/v1/gonum/blas"
 )
 
 // version is the current on-disk codec version.
@@ -19,6 +21,32 @@ const version uint32 = 0x1
 // maxLen is the biggest slice/array len one can create on a 32/64b platform.
 const maxLen = int64(int(^uint(0) >> 1))
 
+// Factorization tags identify the type of a serialised factorization in the
+// factorization header. See io_factor.go for the factorization encoding scheme.
+const (
+	tagBandLU       = "BLU "
+	tagBunchKaufman = "BK  "
+	tagCCholesky    = "CCHL"
+	tagCEigen       = "CEIG"
+	tagCEigenHerm   = "CEGH"
+	tagCholesky     = "CHOL"
+	tagCLU          = "CLU "
+	tagCQR          = "CQR "
+	tagCSVD         = "CSVD"
+	tagEigen        = "EIG "
+	tagEigenSym     = "EIGS"
+	tagGenEigen     = "GEIG"
+	tagGenEigenSym  = "GEGS"
+	tagGSVD         = "GSVD"
+	tagHOGSVD       = "HOSV"
+	tagLQ           = "LQ  "
+	tagLU           = "LU  "
+	tagPivotedQR    = "PQR "
+	tagQR           = "QR  "
+	tagSchur        = "SCHR"
+	tagSVD          = "SVD "
+)
+
 var (
 	headerSize  = binary.Size(storage{})
 	sizeInt64   = binary.Size(int64(0))
@@ -44,8 +72,9 @@ var (
 // Triangular 		'T' 	'F' 		ul 		Diag==Unit 	n 	n 	0 	0
 // TriangularBand 	'T' 	'B' 		ul 		Diag==Unit 	n 	n 	k 	k
 // TriangularPacked 	'T' 	'P' 		ul	 	Diag==Unit 	n 	n 	0 	0
+// ComplexGeneral 	'C' 	'F' 		'A' 		false 		r 	c 	0 	0
 //
-// G - general, S - symmetric, T - triangular
+// G - general, S - symmetric, T - triangular, C - complex general
 // F - full, B - band, P - packed
 // A - all, U - upper, L - lower
 
@@ -425,6 +454,689 @@ func (v *VecDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
 	return n, nil
 }
 
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// SymDense is little-endian encoded as follows:
+//   0 -  3  Version = 1          (uint32)
+//   4       'S'                  (byte)
+//   5       'P'                  (byte)
+//   6       'U'                  (byte)
+//   7       0                    (byte)
+//   8 - 15  n                    (int64)
+//  16 - 23  n                    (int64)
+//  24 - 31  0                    (int64)
+//  32 - 39  0                    (int64)
+//  40 - ..  upper triangular matrix elements (float64)
+//           [0,0] [0,1] ... [0,n-1]
+//                 [1,1] ... [1,n-1]
+//           ...
+//                           [n-1,n-1]
+func (s SymDense) MarshalBinary() ([]byte, error) {
+	n := int64(s.mat.N)
+	return marshalBinary(int64(headerSize)+n*(n+1)/2*int64(sizeFloat64), s.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (s SymDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n := s.mat.N
+	e := encoder{w: w}
+	e.storage(storage{
+		Form: 'S', Packing: 'P', Uplo: 'U',
+		Rows: int64(n), Cols: int64(n),
+		Version: version,
+	})
+	for i := 0; i < n; i++ {
+		e.floats(s.mat.Data[i*s.mat.Stride+i : i*s.mat.Stride+n])
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-zero SymDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed:
+//  - matrix.ErrShape is returned if the number of rows or columns is negative,
+//  - an error is returned if the resulting SymDense matrix is too
+//  big for the current architecture (e.g. a 16GB matrix written by a
+//  64b application and read back from a 32b application.)
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (s *SymDense) UnmarshalBinary(data []byte) error {
+	if !s.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+	return unmarshalBinary(data, headerSize, s.UnmarshalBinaryFrom, s.Reset)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-zero SymDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (s *SymDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !s.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+
+	d := decoder{r: r}
+	header := d.storage()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	n := header.Rows
+	if !header.is('S', 'P', 'U', false, n, n, 0, 0) {
+		return d.n, errWrongType
+	}
+	if err := checkSize(n, n); err != nil {
+		return d.n, err
+	}
+	data := d.floats(int(n * (n + 1) / 2))
+	if d.err != nil {
+		return d.n, d.err
+	}
+
+	s.reuseAs(int(n))
+	for i := 0; i < int(n); i++ {
+		data = data[copy(s.mat.Data[i*s.mat.Stride+i:i*s.mat.Stride+int(n)], data):]
+	}
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// TriDense is little-endian encoded as follows:
+//   0 -  3  Version = 1          (uint32)
+//   4       'T'                  (byte)
+//   5       'P'                  (byte)
+//   6       'U' or 'L'           (byte)
+//   7       unit diagonal        (bool)
+//   8 - 15  n                    (int64)
+//  16 - 23  n                    (int64)
+//  24 - 31  0                    (int64)
+//  32 - 39  0                    (int64)
+//  40 - ..  triangular matrix elements in row-major order (float64)
+//           [0,0] [0,1] ... [0,n-1]       or  [0,0]
+//                 [1,1] ... [1,n-1]           [1,0] [1,1]
+//           ...                               ...
+//                           [n-1,n-1]         [n-1,0] ... [n-1,n-1]
+func (t TriDense) MarshalBinary() ([]byte, error) {
+	n := int64(t.mat.N)
+	return marshalBinary(int64(headerSize)+n*(n+1)/2*int64(sizeFloat64), t.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (t TriDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n := t.mat.N
+	e := encoder{w: w}
+	e.storage(storage{
+		Form: 'T', Packing: 'P', Uplo: byte(t.mat.Uplo), Unit: t.mat.Diag == blas.Unit,
+		Rows: int64(n), Cols: int64(n),
+		Version: version,
+	})
+	for i := 0; i < n; i++ {
+		if t.mat.Uplo == blas.Upper {
+			e.floats(t.mat.Data[i*t.mat.Stride+i : i*t.mat.Stride+n])
+		} else {
+			e.floats(t.mat.Data[i*t.mat.Stride : i*t.mat.Stride+i+1])
+		}
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-zero TriDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed:
+//  - matrix.ErrShape is returned if the number of rows or columns is negative,
+//  - an error is returned if the resulting TriDense matrix is too
+//  big for the current architecture (e.g. a 16GB matrix written by a
+//  64b application and read back from a 32b application.)
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (t *TriDense) UnmarshalBinary(data []byte) error {
+	if !t.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+	return unmarshalBinary(data, headerSize, t.UnmarshalBinaryFrom, t.Reset)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-zero TriDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (t *TriDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !t.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+
+	d := decoder{r: r}
+	header := d.storage()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	n := header.Rows
+	uplo := header.Uplo
+	if uplo != 'U' && uplo != 'L' || !header.is('T', 'P', uplo, header.Unit, n, n, 0, 0) {
+		return d.n, errWrongType
+	}
+	if err := checkSize(n, n); err != nil {
+		return d.n, err
+	}
+	data := d.floats(int(n * (n + 1) / 2))
+	if d.err != nil {
+		return d.n, d.err
+	}
+
+	kind := TriKind(uplo == 'U')
+	t.reuseAs(int(n), kind)
+	if header.Unit {
+		t.mat.Diag = blas.Unit
+	}
+	for i := 0; i < int(n); i++ {
+		row := t.mat.Data[i*t.mat.Stride : i*t.mat.Stride+i+1]
+		if kind == Upper {
+			row = t.mat.Data[i*t.mat.Stride+i : i*t.mat.Stride+int(n)]
+		}
+		data = data[copy(row, data):]
+	}
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// BandDense is little-endian encoded as follows:
+//   0 -  3  Version = 1          (uint32)
+//   4       'G'                  (byte)
+//   5       'B'                  (byte)
+//   6       'A'                  (byte)
+//   7       0                    (byte)
+//   8 - 15  number of rows r     (int64)
+//  16 - 23  number of columns c  (int64)
+//  24 - 31  kU                   (int64)
+//  32 - 39  kL                   (int64)
+//  40 - ..  band matrix elements (float64)
+// The elements are stored in the band storage format described by
+// NewBandDense, as min(r, c+kL) rows of kL+kU+1 elements. Entries outside
+// the matrix are stored as zero.
+func (b BandDense) MarshalBinary() ([]byte, error) {
+	rows := int64(min(b.mat.Rows, b.mat.Cols+b.mat.KL))
+	return marshalBinary(int64(headerSize)+rows*int64(b.mat.Stride)*int64(sizeFloat64), b.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (b BandDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	r, c, kl, ku := b.mat.Rows, b.mat.Cols, b.mat.KL, b.mat.KU
+	e := encoder{w: w}
+	e.storage(storage{
+		Form: 'G', Packing: 'B', Uplo: 'A',
+		Rows: int64(r), Cols: int64(c), KU: int64(ku), KL: int64(kl),
+		Version: version,
+	})
+	row := make([]float64, kl+ku+1)
+	for i := 0; i < min(r, c+kl); i++ {
+		for k := range row {
+			row[k] = 0
+			if j := i - kl + k; 0 <= j && j < c {
+				row[k] = b.mat.Data[i*b.mat.Stride+k]
+			}
+		}
+		e.floats(row)
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-zero BandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed:
+//  - matrix.ErrShape is returned if the number of rows or columns is negative,
+//  - an error is returned if the resulting BandDense matrix is too
+//  big for the current architecture (e.g. a 16GB matrix written by a
+//  64b application and read back from a 32b application.)
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (b *BandDense) UnmarshalBinary(data []byte) error {
+	if b.mat.Stride != 0 {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+	return unmarshalBinary(data, headerSize, b.UnmarshalBinaryFrom, func() { *b = BandDense{} })
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-zero BandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (b *BandDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if b.mat.Stride != 0 {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+
+	d := decoder{r: r}
+	header := d.storage()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	rows, cols, ku, kl := header.Rows, header.Cols, header.KU, header.KL
+	if !header.is('G', 'B', 'A', false, rows, cols, ku, kl) {
+		return d.n, errWrongType
+	}
+	if err := checkSize(rows, cols); err != nil {
+		return d.n, err
+	}
+	if kl < 0 || ku < 0 || kl >= rows || ku >= cols {
+		return d.n, errBadSize
+	}
+	data := d.floats(int(min64(rows, cols+kl) * (kl + ku + 1)))
+	if d.err != nil {
+		return d.n, d.err
+	}
+
+	*b = *NewBandDense(int(rows), int(cols), int(kl), int(ku), data)
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// SymBandDense is little-endian encoded as follows:
+//   0 -  3  Version = 1          (uint32)
+//   4       'S'                  (byte)
+//   5       'B'                  (byte)
+//   6       'U'                  (byte)
+//   7       0                    (byte)
+//   8 - 15  n                    (int64)
+//  16 - 23  n                    (int64)
+//  24 - 31  k                    (int64)
+//  32 - 39  k                    (int64)
+//  40 - ..  band matrix elements (float64)
+// The elements of the upper triangle are stored in the band storage format
+// described by NewSymBandDense, as n rows of k+1 elements. Entries outside
+// the matrix are stored as zero.
+func (s SymBandDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(int64(headerSize)+int64(s.mat.N)*int64(s.mat.K+1)*int64(sizeFloat64), s.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (s SymBandDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n, k := s.mat.N, s.mat.K
+	e := encoder{w: w}
+	e.storage(storage{
+		Form: 'S', Packing: 'B', Uplo: 'U',
+		Rows: int64(n), Cols: int64(n), KU: int64(k), KL: int64(k),
+		Version: version,
+	})
+	row := make([]float64, k+1)
+	for i := 0; i < n; i++ {
+		for kk := range row {
+			row[kk] = 0
+			if i+kk < n {
+				row[kk] = s.mat.Data[i*s.mat.Stride+kk]
+			}
+		}
+		e.floats(row)
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-zero SymBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed:
+//  - matrix.ErrShape is returned if the number of rows or columns is negative,
+//  - an error is returned if the resulting SymBandDense matrix is too
+//  big for the current architecture (e.g. a 16GB matrix written by a
+//  64b application and read back from a 32b application.)
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (s *SymBandDense) UnmarshalBinary(data []byte) error {
+	if s.mat.Stride != 0 {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+	return unmarshalBinary(data, headerSize, s.UnmarshalBinaryFrom, func() { *s = SymBandDense{} })
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-zero SymBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (s *SymBandDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if s.mat.Stride != 0 {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+
+	d := decoder{r: r}
+	header := d.storage()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	n, k := header.Rows, header.KU
+	if !header.is('S', 'B', 'U', false, n, n, k, k) {
+		return d.n, errWrongType
+	}
+	if err := checkSize(n, n); err != nil {
+		return d.n, err
+	}
+	if k < 0 || k >= n {
+		return d.n, errBadSize
+	}
+	data := d.floats(int(n * (k + 1)))
+	if d.err != nil {
+		return d.n, d.err
+	}
+
+	*s = *NewSymBandDense(int(n), int(k), data)
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// TriBandDense is little-endian encoded as follows:
+//   0 -  3  Version = 1          (uint32)
+//   4       'T'                  (byte)
+//   5       'B'                  (byte)
+//   6       'U' or 'L'           (byte)
+//   7       unit diagonal        (bool)
+//   8 - 15  n                    (int64)
+//  16 - 23  n                    (int64)
+//  24 - 31  k                    (int64)
+//  32 - 39  k                    (int64)
+//  40 - ..  band matrix elements (float64)
+// The elements are stored in the band storage format described by
+// NewTriBandDense, as n rows of k+1 elements. Entries outside the matrix are
+// stored as zero.
+func (t TriBandDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(int64(headerSize)+int64(t.mat.N)*int64(t.mat.K+1)*int64(sizeFloat64), t.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (t TriBandDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n, k := t.mat.N, t.mat.K
+	e := encoder{w: w}
+	e.storage(storage{
+		Form: 'T', Packing: 'B', Uplo: byte(t.mat.Uplo), Unit: t.mat.Diag == blas.Unit,
+		Rows: int64(n), Cols: int64(n), KU: int64(k), KL: int64(k),
+		Version: version,
+	})
+	row := make([]float64, k+1)
+	for i := 0; i < n; i++ {
+		for kk := range row {
+			j := i + kk
+			if t.mat.Uplo == blas.Lower {
+				j = i - k + kk
+			}
+			row[kk] = 0
+			if 0 <= j && j < n {
+				row[kk] = t.mat.Data[i*t.mat.Stride+kk]
+			}
+		}
+		e.floats(row)
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-zero TriBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed:
+//  - matrix.ErrShape is returned if the number of rows or columns is negative,
+//  - an error is returned if the resulting TriBandDense matrix is too
+//  big for the current architecture (e.g. a 16GB matrix written by a
+//  64b application and read back from a 32b application.)
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (t *TriBandDense) UnmarshalBinary(data []byte) error {
+	if !t.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+	return unmarshalBinary(data, headerSize, t.UnmarshalBinaryFrom, t.Reset)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-zero TriBandDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (t *TriBandDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !t.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+
+	d := decoder{r: r}
+	header := d.storage()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	n, k, uplo := header.Rows, header.KU, header.Uplo
+	if uplo != 'U' && uplo != 'L' || !header.is('T', 'B', uplo, header.Unit, n, n, k, k) {
+		return d.n, errWrongType
+	}
+	if err := checkSize(n, n); err != nil {
+		return d.n, err
+	}
+	if k < 0 || k >= n {
+		return d.n, errBadSize
+	}
+	data := d.floats(int(n * (k + 1)))
+	if d.err != nil {
+		return d.n, d.err
+	}
+
+	*t = *NewTriBandDense(int(n), int(k), TriKind(uplo == 'U'), data)
+	if header.Unit {
+		t.mat.Diag = blas.Unit
+	}
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// DiagDense is encoded as a symmetric band matrix with zero bandwidth, so it
+// can also be decoded by SymBandDense. It is little-endian encoded as follows:
+//   0 -  3  Version = 1          (uint32)
+//   4       'S'                  (byte)
+//   5       'B'                  (byte)
+//   6       'U'                  (byte)
+//   7       0                    (byte)
+//   8 - 15  n                    (int64)
+//  16 - 23  n                    (int64)
+//  24 - 31  0                    (int64)
+//  32 - 39  0                    (int64)
+//  40 - ..  diagonal elements    (float64)
+func (d DiagDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(int64(headerSize)+int64(d.mat.N)*int64(sizeFloat64), d.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (d DiagDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	n := d.mat.N
+	e := encoder{w: w}
+	e.storage(storage{
+		Form: 'S', Packing: 'B', Uplo: 'U',
+		Rows: int64(n), Cols: int64(n),
+		Version: version,
+	})
+	for i := 0; i < n; i++ {
+		e.floats(d.mat.Data[i*d.mat.Inc : i*d.mat.Inc+1])
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-zero DiagDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed:
+//  - matrix.ErrShape is returned if the number of rows or columns is negative,
+//  - an error is returned if the resulting DiagDense matrix is too
+//  big for the current architecture (e.g. a 16GB matrix written by a
+//  64b application and read back from a 32b application.)
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (d *DiagDense) UnmarshalBinary(data []byte) error {
+	if !d.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+	return unmarshalBinary(data, headerSize, d.UnmarshalBinaryFrom, d.Reset)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-zero DiagDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (d *DiagDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !d.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+
+	dec := decoder{r: r}
+	header := dec.storage()
+	if dec.err != nil {
+		return dec.n, dec.err
+	}
+	n := header.Rows
+	if !header.is('S', 'B', 'U', false, n, n, 0, 0) {
+		return dec.n, errWrongType
+	}
+	if err := checkSize(n, 1); err != nil {
+		return dec.n, err
+	}
+	data := dec.floats(int(n))
+	if dec.err != nil {
+		return dec.n, dec.err
+	}
+
+	*d = *NewDiagDense(int(n), data)
+	return dec.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// CDense is little-endian encoded as follows:
+//   0 -  3  Version = 1          (uint32)
+//   4       'C'                  (byte)
+//   5       'F'                  (byte)
+//   6       'A'                  (byte)
+//   7       0                    (byte)
+//   8 - 15  number of rows       (int64)
+//  16 - 23  number of columns    (int64)
+//  24 - 31  0                    (int64)
+//  32 - 39  0                    (int64)
+//  40 - ..  matrix data elements (complex128 as real and imaginary float64)
+//           [0,0] [0,1] ... [0,ncols-1]
+//           [1,0] [1,1] ... [1,ncols-1]
+//           ...
+//           [nrows-1,0] ... [nrows-1,ncols-1]
+func (m CDense) MarshalBinary() ([]byte, error) {
+	return marshalBinary(int64(headerSize)+int64(m.mat.Rows)*int64(m.mat.Cols)*2*int64(sizeFloat64), m.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (m CDense) MarshalBinaryTo(w io.Writer) (int, error) {
+	r, c := m.mat.Rows, m.mat.Cols
+	e := encoder{w: w}
+	e.storage(storage{
+		Form: 'C', Packing: 'F', Uplo: 'A',
+		Rows: int64(r), Cols: int64(c),
+		Version: version,
+	})
+	for i := 0; i < r; i++ {
+		e.complexes(m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c])
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver.
+// It panics if the receiver is a non-zero CDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+//
+// Limited checks on the validity of the binary input are performed:
+//  - matrix.ErrShape is returned if the number of rows or columns is negative,
+//  - an error is returned if the resulting CDense matrix is too
+//  big for the current architecture (e.g. a 16GB matrix written by a
+//  64b application and read back from a 32b application.)
+// UnmarshalBinary does not limit the size of the unmarshaled matrix, and so
+// it should not be used on untrusted data.
+func (m *CDense) UnmarshalBinary(data []byte) error {
+	if !m.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+	return unmarshalBinary(data, headerSize, m.UnmarshalBinaryFrom, m.Reset)
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver and returns
+// the number of bytes read and an error if any.
+// It panics if the receiver is a non-zero CDense matrix.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the list of sanity checks performed on the input.
+func (m *CDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	if !m.IsZero() {
+		panic("mat: unmarshal into non-zero matrix")
+	}
+
+	d := decoder{r: r}
+	header := d.storage()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	rows, cols := header.Rows, header.Cols
+	if !header.is('C', 'F', 'A', false, rows, cols, 0, 0) {
+		return d.n, errWrongType
+	}
+	if err := checkSize(rows, 2*cols); err != nil {
+		return d.n, err
+	}
+	data := d.complexes(int(rows * cols))
+	if d.err != nil {
+		return d.n, d.err
+	}
+
+	*m = *NewCDense(int(rows), int(cols), data)
+	return d.n, nil
+}
+
 // storage is the internal representation of the storage format of a
 // serialised matrix.
 type storage struct {
@@ -490,3 +1202,183 @@ func readFull(r io.Reader, buf []byte) (int, error) {
 	}
 	return n, err
 }
+
+// is returns whether the storage header describes a matrix with the given
+// form, packing, uplo, unit diagonal, dimensions and bandwidths.
+func (s storage) is(form, packing, uplo byte, unit bool, rows, cols, ku, kl int64) bool {
+	return s == storage{
+		Version: s.Version,
+		Form:    form, Packing: packing, Uplo: uplo, Unit: unit,
+		Rows: rows, Cols: cols, KU: ku, KL: kl,
+	}
+}
+
+// checkSize returns an error if a matrix with the given dimensions cannot be
+// allocated.
+func checkSize(rows, cols int64) error {
+	if rows < 0 || cols < 0 {
+		return errBadSize
+	}
+	if rows == 0 || cols == 0 {
+		return ErrZeroLength
+	}
+	if rows > maxLen/cols {
+		return errTooBig
+	}
+	return nil
+}
+
+func min64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// marshalBinary returns the binary form of a value written by marshalTo,
+// which writes size bytes.
+func marshalBinary(size int64, marshalTo func(io.Writer) (int, error)) ([]byte, error) {
+	if size <= 0 || size > maxLen {
+		// size is too big and has wrapped around.
+		return nil, errTooBig
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, size))
+	_, err := marshalTo(buf)
+	return buf.Bytes(), err
+}
+
+// unmarshalBinary decodes data using unmarshalFrom, which must consume all of
+// data. If data holds trailing bytes, reset is called to zero the receiver.
+func unmarshalBinary(data []byte, minSize int, unmarshalFrom func(io.Reader) (int, error), reset func()) error {
+	if len(data) < minSize {
+		return errTooSmall
+	}
+	n, err := unmarshalFrom(bytes.NewReader(data))
+	if err == io.ErrUnexpectedEOF {
+		return errBadBuffer
+	}
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		reset()
+		return errBadBuffer
+	}
+	return nil
+}
+
+// encoder writes little-endian encoded values to w, keeping track of the
+// number of bytes written and the first error.
+type encoder struct {
+	w   io.Writer
+	n   int
+	err error
+	buf []byte
+}
+
+func (e *encoder) write(b []byte) {
+	if e.err != nil {
+		return
+	}
+	n, err := e.w.Write(b)
+	e.n += n
+	e.err = err
+}
+
+func (e *encoder) storage(s storage) {
+	if e.err != nil {
+		return
+	}
+	n, err := s.marshalBinaryTo(e.w)
+	e.n += n
+	e.err = err
+}
+
+func (e *encoder) floats(v []float64) {
+	e.buf = e.buf[:0]
+	for _, f := range v {
+		e.buf = appendUint64(e.buf, math.Float64bits(f))
+	}
+	e.write(e.buf)
+}
+
+func (e *encoder) complexes(v []complex128) {
+	e.buf = e.buf[:0]
+	for _, c := range v {
+		e.buf = appendUint64(e.buf, math.Float64bits(real(c)))
+		e.buf = appendUint64(e.buf, math.Float64bits(imag(c)))
+	}
+	e.write(e.buf)
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+// decoder reads little-endian encoded values from r, keeping track of the
+// number of bytes read and the first error.
+type decoder struct {
+	r   io.Reader
+	n   int
+	err error
+}
+
+// read reads len(b) bytes into b. An EOF is reported as io.ErrUnexpectedEOF.
+func (d *decoder) read(b []byte) bool {
+	if d.err != nil {
+		return false
+	}
+	n, err := readFull(d.r, b)
+	d.n += n
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	d.err = err
+	return err == nil
+}
+
+func (d *decoder) storage() storage {
+	var s storage
+	if d.err != nil {
+		return s
+	}
+	n, err := s.unmarshalBinaryFrom(d.r)
+	d.n += n
+	d.err = err
+	return s
+}
+
+// floats reads n float64 values. The values are read in chunks so that a
+// corrupt length does not cause a large allocation before the end of the
+// input is reached.
+func (d *decoder) floats(n int) []float64 {
+	const chunk = 1024
+	var buf [chunk * 8]byte
+	v := make([]float64, 0, min(n, chunk))
+	for len(v) < n {
+		k := min(n-len(v), chunk)
+		if !d.read(buf[:8*k]) {
+			return nil
+		}
+		for i := 0; i < k; i++ {
+			v = append(v, math.Float64frombits(binary.LittleEndian.Uint64(buf[8*i:])))
+		}
+	}
+	return v
+}
+
+// complexes reads n complex128 values encoded as their real and imaginary
+// parts.
+func (d *decoder) complexes(n int) []complex128 {
+	f := d.floats(2 * n)
+	if d.err != nil {
+		return nil
+	}
+	v := make([]complex128, n)
+	for i := range v {
+		v[i] = complex(f[2*i], f[2*i+1])
+	}
+	return v
+}
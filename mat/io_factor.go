@@ -0,0 +1,1899 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Factorization encoding scheme:
+//
+// A serialised factorization starts with an 8 byte header holding the codec
+// version as a little-endian uint32 followed by a four byte tag identifying
+// the type of the factorization. The tags are defined in io.go:
+//
+// Type 		Tag
+// BandLU 		"BLU "
+// BunchKaufman 	"BK  "
+// CCholesky 		"CCHL"
+// CEigen 		"CEIG"
+// CEigenHerm 		"CEGH"
+// Cholesky 		"CHOL"
+// CLU 			"CLU "
+// CQR 			"CQR "
+// CSVD 		"CSVD"
+// Eigen 		"EIG "
+// EigenSym 		"EIGS"
+// GenEigen 		"GEIG"
+// GenEigenSym 		"GEGS"
+// GSVD 		"GSVD"
+// HOGSVD 		"HOSV"
+// LQ 			"LQ  "
+// LU 			"LU  "
+// PivotedQR 		"PQR "
+// QR 			"QR  "
+// Schur 		"SCHR"
+// SVD 			"SVD "
+//
+// The header is followed by the fields of the factorization in the order
+// given in the documentation of each MarshalBinary method. Fields are
+// little-endian encoded as follows:
+//  int      int64
+//  bool     one byte holding 0 or 1
+//  float64  float64
+//  []T      int64 length followed by the elements
+//  matrix   the binary form of the matrix type
+// A field marked as optional is preceded by a bool that is true if the field
+// is present.
+
+// factorHeaderSize is the size of the header of a serialised factorization.
+const factorHeaderSize = 8
+
+var (
+	errNoFactor  = errors.New("mat: marshal of empty factorization")
+	errBadFactor = errors.New("mat: inconsistent factorization data")
+)
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// Cholesky is encoded as the fields
+//  cond  float64   condition number
+//  chol  TriDense  upper triangular factor U
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (c *Cholesky) MarshalBinary() ([]byte, error) {
+	return marshalFactor(c.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (c *Cholesky) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !c.valid() {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagCholesky)
+	e.float(c.cond)
+	e.matrix(c.chol)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (c *Cholesky) UnmarshalBinary(data []byte) error {
+	var tmp Cholesky
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*c = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (c *Cholesky) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagCholesky)
+	cond := d.float()
+	var chol TriDense
+	d.matrix(&chol)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if _, kind := chol.Triangle(); kind != Upper {
+		return d.n, errBadFactor
+	}
+	c.chol = &chol
+	c.cond = cond
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// LU is encoded as the fields
+//  cond   float64  condition number
+//  pivot  []int    row pivots
+//  lu     Dense    L and U factors
+// It returns an error if the receiver does not hold a factorization.
+func (lu *LU) MarshalBinary() ([]byte, error) {
+	return marshalFactor(lu.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (lu *LU) MarshalBinaryTo(w io.Writer) (int, error) {
+	if lu.isZero() {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagLU)
+	e.float(lu.cond)
+	e.intSlice(lu.pivot)
+	e.matrix(lu.lu)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (lu *LU) UnmarshalBinary(data []byte) error {
+	var tmp LU
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*lu = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (lu *LU) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagLU)
+	cond := d.float()
+	pivot := d.intSlice()
+	var f Dense
+	d.matrix(&f)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if n, c := f.Dims(); n != c || len(pivot) != n || !validPivots(pivot, n) {
+		return d.n, errBadFactor
+	}
+	lu.lu = &f
+	lu.pivot = pivot
+	lu.cond = cond
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// QR is encoded as the fields
+//  cond  float64    condition number
+//  tau   []float64  scalar factors of the elementary reflectors
+//  qr    Dense      R and the elementary reflectors
+// It returns an error if the receiver does not hold a factorization.
+func (qr *QR) MarshalBinary() ([]byte, error) {
+	return marshalFactor(qr.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (qr *QR) MarshalBinaryTo(w io.Writer) (int, error) {
+	if qr.qr == nil || qr.qr.IsZero() {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagQR)
+	e.float(qr.cond)
+	e.floatSlice(qr.tau)
+	e.matrix(qr.qr)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (qr *QR) UnmarshalBinary(data []byte) error {
+	var tmp QR
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*qr = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (qr *QR) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagQR)
+	cond := d.float()
+	tau := d.floatSlice()
+	var f Dense
+	d.matrix(&f)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if m, n := f.Dims(); len(tau) != min(m, n) {
+		return d.n, errBadFactor
+	}
+	qr.qr = &f
+	qr.tau = tau
+	qr.cond = cond
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// LQ is encoded as the fields
+//  cond  float64    condition number
+//  tau   []float64  scalar factors of the elementary reflectors
+//  lq    Dense      L and the elementary reflectors
+// It returns an error if the receiver does not hold a factorization.
+func (lq *LQ) MarshalBinary() ([]byte, error) {
+	return marshalFactor(lq.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (lq *LQ) MarshalBinaryTo(w io.Writer) (int, error) {
+	if lq.lq == nil || lq.lq.IsZero() {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagLQ)
+	e.float(lq.cond)
+	e.floatSlice(lq.tau)
+	e.matrix(lq.lq)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (lq *LQ) UnmarshalBinary(data []byte) error {
+	var tmp LQ
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*lq = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (lq *LQ) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagLQ)
+	cond := d.float()
+	tau := d.floatSlice()
+	var f Dense
+	d.matrix(&f)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if m, n := f.Dims(); len(tau) != min(m, n) {
+		return d.n, errBadFactor
+	}
+	lq.lq = &f
+	lq.tau = tau
+	lq.cond = cond
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// PivotedQR is encoded as the fields
+//  jpvt  []int      column permutation
+//  tau   []float64  scalar factors of the elementary reflectors
+//  qr    Dense      R and the elementary reflectors
+// It returns an error if the receiver does not hold a factorization.
+func (qr *PivotedQR) MarshalBinary() ([]byte, error) {
+	return marshalFactor(qr.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (qr *PivotedQR) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !qr.valid() {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagPivotedQR)
+	e.intSlice(qr.jpvt)
+	e.floatSlice(qr.tau)
+	e.matrix(qr.qr)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (qr *PivotedQR) UnmarshalBinary(data []byte) error {
+	var tmp PivotedQR
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*qr = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (qr *PivotedQR) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagPivotedQR)
+	jpvt := d.intSlice()
+	tau := d.floatSlice()
+	var f Dense
+	d.matrix(&f)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if m, n := f.Dims(); len(tau) != min(m, n) || len(jpvt) != n || !validPivots(jpvt, n) {
+		return d.n, errBadFactor
+	}
+	qr.qr = &f
+	qr.tau = tau
+	qr.jpvt = jpvt
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// SVD is encoded as the fields
+//  kind  int        SVDKind of the factorization
+//  s     []float64  singular values
+//  u     Dense      left singular vectors, present if kind is SVDThin or SVDFull
+//  vt    Dense      transposed right singular vectors, present if kind is
+//                   SVDThin or SVDFull
+// The DivideConquer field is not encoded. MarshalBinary returns an error if
+// the receiver does not hold a successful factorization.
+func (svd *SVD) MarshalBinary() ([]byte, error) {
+	return marshalFactor(svd.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (svd *SVD) MarshalBinaryTo(w io.Writer) (int, error) {
+	if svd.kind == 0 {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagSVD)
+	e.integer(int(svd.kind))
+	e.floatSlice(svd.s)
+	if svd.kind == SVDThin || svd.kind == SVDFull {
+		e.general(svd.u)
+		e.general(svd.vt)
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds. The DivideConquer field is not modified.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (svd *SVD) UnmarshalBinary(data []byte) error {
+	tmp := SVD{DivideConquer: svd.DivideConquer}
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*svd = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any. The DivideConquer field is not modified.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (svd *SVD) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagSVD)
+	kind := SVDKind(d.integer())
+	s := d.floatSlice()
+	u := blas64.General{Stride: 1}
+	vt := blas64.General{Stride: 1}
+	if kind == SVDThin || kind == SVDFull {
+		u = d.general()
+		vt = d.general()
+	}
+	if d.err != nil {
+		return d.n, d.err
+	}
+	var bad bool
+	switch kind {
+	case SVDNone:
+		bad = len(s) == 0
+	case SVDThin:
+		bad = len(s) == 0 || u.Cols != len(s) || vt.Rows != len(s)
+	case SVDFull:
+		bad = u.Rows != u.Cols || vt.Rows != vt.Cols || len(s) != min(u.Rows, vt.Cols)
+	default:
+		bad = true
+	}
+	if bad {
+		return d.n, errBadFactor
+	}
+	svd.kind = kind
+	svd.s = s
+	svd.u = u
+	svd.vt = vt
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// EigenSym is encoded as the fields
+//  values   []float64  eigenvalues
+//  vectors  Dense      eigenvectors, optional
+// The DivideConquer field is not encoded. MarshalBinary returns an error if
+// the receiver does not hold a successful factorization.
+func (e *EigenSym) MarshalBinary() ([]byte, error) {
+	return marshalFactor(e.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (e *EigenSym) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !e.succFact() {
+		return 0, errNoFactor
+	}
+	return marshalEigenSym(w, tagEigenSym, e.values, e.vectorsComputed, e.vectors)
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds. The DivideConquer field is not modified.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (e *EigenSym) UnmarshalBinary(data []byte) error {
+	tmp := EigenSym{DivideConquer: e.DivideConquer}
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*e = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any. The DivideConquer field is not modified.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (e *EigenSym) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	values, vectors, n, err := unmarshalEigenSym(r, tagEigenSym)
+	if err != nil {
+		return n, err
+	}
+	e.values = values
+	e.vectorsComputed = vectors != nil
+	e.vectors = vectors
+	return n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// GenEigenSym is encoded as the fields
+//  values   []float64  eigenvalues
+//  vectors  Dense      eigenvectors, optional
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (e *GenEigenSym) MarshalBinary() ([]byte, error) {
+	return marshalFactor(e.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (e *GenEigenSym) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !e.succFact() {
+		return 0, errNoFactor
+	}
+	return marshalEigenSym(w, tagGenEigenSym, e.values, e.vectorsComputed, e.vectors)
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (e *GenEigenSym) UnmarshalBinary(data []byte) error {
+	var tmp GenEigenSym
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*e = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (e *GenEigenSym) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	values, vectors, n, err := unmarshalEigenSym(r, tagGenEigenSym)
+	if err != nil {
+		return n, err
+	}
+	e.values = values
+	e.vectorsComputed = vectors != nil
+	e.vectors = vectors
+	return n, nil
+}
+
+// marshalEigenSym writes the eigenvalues and optional eigenvectors of a
+// symmetric eigendecomposition with the given tag to w.
+func marshalEigenSym(w io.Writer, tag string, values []float64, computed bool, vectors *Dense) (int, error) {
+	e := encoder{w: w}
+	e.factor(tag)
+	e.floatSlice(values)
+	e.boolean(computed)
+	if computed {
+		e.matrix(vectors)
+	}
+	return e.n, e.err
+}
+
+// unmarshalEigenSym reads the eigenvalues and optional eigenvectors of a
+// symmetric eigendecomposition with the given tag from r. The returned
+// vectors are nil if they were not computed.
+func unmarshalEigenSym(r io.Reader, tag string) (values []float64, vectors *Dense, n int, err error) {
+	d := decoder{r: r}
+	d.factor(tag)
+	values = d.floatSlice()
+	if d.boolean() {
+		vectors = &Dense{}
+		d.matrix(vectors)
+	}
+	if d.err != nil {
+		return nil, nil, d.n, d.err
+	}
+	if values == nil {
+		return nil, nil, d.n, errBadFactor
+	}
+	if vectors != nil {
+		if _, c := vectors.Dims(); c != len(values) {
+			return nil, nil, d.n, errBadFactor
+		}
+	}
+	return values, vectors, d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// Eigen is encoded as the fields
+//  n         int           size of the factorized matrix
+//  values    []complex128  eigenvalues
+//  rVectors  CDense        right eigenvectors, optional
+//  lVectors  CDense        left eigenvectors, optional
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (e *Eigen) MarshalBinary() ([]byte, error) {
+	return marshalFactor(e.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (e *Eigen) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !e.succFact() {
+		return 0, errNoFactor
+	}
+	enc := encoder{w: w}
+	enc.factor(tagEigen)
+	enc.integer(e.n)
+	enc.complexSlice(e.values)
+	enc.boolean(e.right)
+	if e.right {
+		enc.matrix(e.rVectors)
+	}
+	enc.boolean(e.left)
+	if e.left {
+		enc.matrix(e.lVectors)
+	}
+	return enc.n, enc.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (e *Eigen) UnmarshalBinary(data []byte) error {
+	var tmp Eigen
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*e = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (e *Eigen) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagEigen)
+	n := d.integer()
+	values := d.complexSlice()
+	rVectors := d.optionalCDense()
+	lVectors := d.optionalCDense()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if n == 0 || len(values) != n || !squareOrNil(rVectors, n) || !squareOrNil(lVectors, n) {
+		return d.n, errBadFactor
+	}
+	e.n = n
+	e.values = values
+	e.right = rVectors != nil
+	e.rVectors = rVectors
+	e.left = lVectors != nil
+	e.lVectors = lVectors
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// GenEigen is encoded as the fields
+//  n         int           size of the factorized matrices
+//  alpha     []complex128  numerators of the eigenvalues
+//  beta      []float64     denominators of the eigenvalues
+//  rVectors  CDense        right eigenvectors, optional
+//  lVectors  CDense        left eigenvectors, optional
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (e *GenEigen) MarshalBinary() ([]byte, error) {
+	return marshalFactor(e.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (e *GenEigen) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !e.succFact() {
+		return 0, errNoFactor
+	}
+	enc := encoder{w: w}
+	enc.factor(tagGenEigen)
+	enc.integer(e.n)
+	enc.complexSlice(e.alpha)
+	enc.floatSlice(e.beta)
+	enc.boolean(e.right)
+	if e.right {
+		enc.matrix(e.rVectors)
+	}
+	enc.boolean(e.left)
+	if e.left {
+		enc.matrix(e.lVectors)
+	}
+	return enc.n, enc.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (e *GenEigen) UnmarshalBinary(data []byte) error {
+	var tmp GenEigen
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*e = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (e *GenEigen) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagGenEigen)
+	n := d.integer()
+	alpha := d.complexSlice()
+	beta := d.floatSlice()
+	rVectors := d.optionalCDense()
+	lVectors := d.optionalCDense()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if n == 0 || len(alpha) != n || len(beta) != n || !squareOrNil(rVectors, n) || !squareOrNil(lVectors, n) {
+		return d.n, errBadFactor
+	}
+	e.n = n
+	e.alpha = alpha
+	e.beta = beta
+	e.right = rVectors != nil
+	e.rVectors = rVectors
+	e.left = lVectors != nil
+	e.lVectors = lVectors
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// Schur is encoded as the fields
+//  values  []complex128  eigenvalues
+//  t       Dense         quasi-upper triangular Schur form T
+//  z       Dense         Schur vectors, optional
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (s *Schur) MarshalBinary() ([]byte, error) {
+	return marshalFactor(s.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (s *Schur) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !s.succFact() {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagSchur)
+	e.complexSlice(s.values)
+	e.matrix(s.t)
+	e.boolean(s.z != nil)
+	if s.z != nil {
+		e.matrix(s.z)
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (s *Schur) UnmarshalBinary(data []byte) error {
+	var tmp Schur
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*s = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (s *Schur) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagSchur)
+	values := d.complexSlice()
+	var t Dense
+	d.matrix(&t)
+	var z *Dense
+	if d.boolean() {
+		z = &Dense{}
+		d.matrix(z)
+	}
+	if d.err != nil {
+		return d.n, d.err
+	}
+	n, c := t.Dims()
+	if n != c || len(values) != n {
+		return d.n, errBadFactor
+	}
+	if z != nil {
+		if r, c := z.Dims(); r != n || c != n {
+			return d.n, errBadFactor
+		}
+	}
+	s.n = n
+	s.values = values
+	s.t = &t
+	s.z = z
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// BandLU is encoded as the fields
+//  cond   float64    condition number
+//  n      int        size of the factorized matrix
+//  kl     int        number of sub-diagonals of the factorized matrix
+//  ku     int        number of super-diagonals of the factorized matrix
+//  pivot  []int      row pivots
+//  lu     []float64  L and U factors in the band storage format of Dgbtrf,
+//                    as n rows of 2*kl+ku+1 elements
+// It returns an error if the receiver does not hold a factorization.
+func (lu *BandLU) MarshalBinary() ([]byte, error) {
+	return marshalFactor(lu.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (lu *BandLU) MarshalBinaryTo(w io.Writer) (int, error) {
+	if lu.isZero() {
+		return 0, errNoFactor
+	}
+	n, kl, ku := lu.lu.Rows, lu.lu.KL, lu.lu.KU
+	e := encoder{w: w}
+	e.factor(tagBandLU)
+	e.float(lu.cond)
+	e.integer(n)
+	e.integer(kl)
+	e.integer(ku)
+	e.intSlice(lu.pivot)
+	e.integer(n * (2*kl + ku + 1))
+	for i := 0; i < n; i++ {
+		e.floats(lu.lu.Data[i*lu.lu.Stride : i*lu.lu.Stride+2*kl+ku+1])
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (lu *BandLU) UnmarshalBinary(data []byte) error {
+	var tmp BandLU
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*lu = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (lu *BandLU) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagBandLU)
+	cond := d.float()
+	n := d.integer()
+	kl := d.integer()
+	ku := d.integer()
+	pivot := d.intSlice()
+	data := d.floatSlice()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if n <= 0 || kl < 0 || ku < 0 || kl >= n || ku >= n || len(pivot) != n || !validPivots(pivot, n) || len(data) != n*(2*kl+ku+1) {
+		return d.n, errBadFactor
+	}
+	lu.lu = blas64.Band{
+		Rows:   n,
+		Cols:   n,
+		KL:     kl,
+		KU:     ku,
+		Stride: 2*kl + ku + 1,
+		Data:   data,
+	}
+	lu.pivot = pivot
+	lu.cond = cond
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// BunchKaufman is encoded as the fields
+//  cond  float64   condition number
+//  ipiv  []int     pivots in the format of Dsytrf
+//  sym   SymDense  factors U and D
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (bk *BunchKaufman) MarshalBinary() ([]byte, error) {
+	return marshalFactor(bk.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (bk *BunchKaufman) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !bk.valid() {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagBunchKaufman)
+	e.float(bk.cond)
+	e.intSlice(bk.ipiv)
+	e.matrix(bk.sym)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (bk *BunchKaufman) UnmarshalBinary(data []byte) error {
+	var tmp BunchKaufman
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*bk = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (bk *BunchKaufman) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagBunchKaufman)
+	cond := d.float()
+	ipiv := d.intSlice()
+	var sym SymDense
+	d.matrix(&sym)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	n := sym.Symmetric()
+	if len(ipiv) != n {
+		return d.n, errBadFactor
+	}
+	for _, p := range ipiv {
+		// Dsytrf stores 1×1 pivots as non-negative indices and 2×2 pivots
+		// as bitwise complemented indices.
+		if p < 0 {
+			p = ^p
+		}
+		if p >= n {
+			return d.n, errBadFactor
+		}
+	}
+	bk.sym = &sym
+	bk.ipiv = ipiv
+	bk.cond = cond
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// CCholesky is encoded as the fields
+//  cond  float64  condition number
+//  chol  CDense   upper triangular factor U
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (c *CCholesky) MarshalBinary() ([]byte, error) {
+	return marshalFactor(c.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (c *CCholesky) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !c.valid() {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagCCholesky)
+	e.float(c.cond)
+	e.matrix(c.chol)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (c *CCholesky) UnmarshalBinary(data []byte) error {
+	var tmp CCholesky
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*c = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (c *CCholesky) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagCCholesky)
+	cond := d.float()
+	var chol CDense
+	d.matrix(&chol)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if n, m := chol.Dims(); n != m {
+		return d.n, errBadFactor
+	}
+	c.chol = &chol
+	c.cond = cond
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// CLU is encoded as the fields
+//  cond   float64  condition number
+//  pivot  []int    row pivots
+//  lu     CDense   L and U factors
+// It returns an error if the receiver does not hold a factorization.
+func (lu *CLU) MarshalBinary() ([]byte, error) {
+	return marshalFactor(lu.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (lu *CLU) MarshalBinaryTo(w io.Writer) (int, error) {
+	if len(lu.pivot) == 0 {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagCLU)
+	e.float(lu.cond)
+	e.intSlice(lu.pivot)
+	e.matrix(lu.lu)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (lu *CLU) UnmarshalBinary(data []byte) error {
+	var tmp CLU
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*lu = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (lu *CLU) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagCLU)
+	cond := d.float()
+	pivot := d.intSlice()
+	var f CDense
+	d.matrix(&f)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if n, c := f.Dims(); n != c || len(pivot) != n || !validPivots(pivot, n) {
+		return d.n, errBadFactor
+	}
+	lu.lu = &f
+	lu.pivot = pivot
+	lu.cond = cond
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// CQR is encoded as the fields
+//  cond  float64       condition number
+//  tau   []complex128  scalar factors of the elementary reflectors
+//  qr    CDense        R and the elementary reflectors
+// It returns an error if the receiver does not hold a factorization.
+func (qr *CQR) MarshalBinary() ([]byte, error) {
+	return marshalFactor(qr.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (qr *CQR) MarshalBinaryTo(w io.Writer) (int, error) {
+	if qr.qr == nil || qr.qr.IsZero() {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagCQR)
+	e.float(qr.cond)
+	e.complexSlice(qr.tau)
+	e.matrix(qr.qr)
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (qr *CQR) UnmarshalBinary(data []byte) error {
+	var tmp CQR
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*qr = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (qr *CQR) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagCQR)
+	cond := d.float()
+	tau := d.complexSlice()
+	var f CDense
+	d.matrix(&f)
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if m, n := f.Dims(); len(tau) != min(m, n) {
+		return d.n, errBadFactor
+	}
+	qr.qr = &f
+	qr.tau = tau
+	qr.cond = cond
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// CSVD is encoded as the fields
+//  kind  int        SVDKind of the factorization
+//  s     []float64  singular values
+//  u     CDense     left singular vectors, present if kind is SVDThin or SVDFull
+//  vt    CDense     conjugate transposed right singular vectors, present if
+//                   kind is SVDThin or SVDFull
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (svd *CSVD) MarshalBinary() ([]byte, error) {
+	return marshalFactor(svd.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (svd *CSVD) MarshalBinaryTo(w io.Writer) (int, error) {
+	if svd.kind == 0 {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagCSVD)
+	e.integer(int(svd.kind))
+	e.floatSlice(svd.s)
+	if svd.kind == SVDThin || svd.kind == SVDFull {
+		e.cgeneral(svd.u)
+		e.cgeneral(svd.vt)
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (svd *CSVD) UnmarshalBinary(data []byte) error {
+	var tmp CSVD
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*svd = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (svd *CSVD) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagCSVD)
+	kind := SVDKind(d.integer())
+	s := d.floatSlice()
+	u := cblas128.General{Stride: 1}
+	vt := cblas128.General{Stride: 1}
+	if kind == SVDThin || kind == SVDFull {
+		u = d.cgeneral()
+		vt = d.cgeneral()
+	}
+	if d.err != nil {
+		return d.n, d.err
+	}
+	var bad bool
+	switch kind {
+	case SVDNone:
+		bad = len(s) == 0
+	case SVDThin:
+		bad = len(s) == 0 || u.Cols != len(s) || vt.Rows != len(s)
+	case SVDFull:
+		bad = u.Rows != u.Cols || vt.Rows != vt.Cols || len(s) != min(u.Rows, vt.Cols)
+	default:
+		bad = true
+	}
+	if bad {
+		return d.n, errBadFactor
+	}
+	svd.kind = kind
+	svd.s = s
+	svd.u = u
+	svd.vt = vt
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// CEigenHerm is encoded as the fields
+//  values   []float64  eigenvalues
+//  vectors  CDense     eigenvectors, optional
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (e *CEigenHerm) MarshalBinary() ([]byte, error) {
+	return marshalFactor(e.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (e *CEigenHerm) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !e.succFact() {
+		return 0, errNoFactor
+	}
+	enc := encoder{w: w}
+	enc.factor(tagCEigenHerm)
+	enc.floatSlice(e.values)
+	enc.boolean(e.vectorsComputed)
+	if e.vectorsComputed {
+		enc.matrix(e.vectors)
+	}
+	return enc.n, enc.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (e *CEigenHerm) UnmarshalBinary(data []byte) error {
+	var tmp CEigenHerm
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*e = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (e *CEigenHerm) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagCEigenHerm)
+	values := d.floatSlice()
+	vectors := d.optionalCDense()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if len(values) == 0 || !squareOrNil(vectors, len(values)) {
+		return d.n, errBadFactor
+	}
+	e.values = values
+	e.vectorsComputed = vectors != nil
+	e.vectors = vectors
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// CEigen is encoded as the fields
+//  n         int           size of the factorized matrix
+//  values    []complex128  eigenvalues
+//  rVectors  CDense        right eigenvectors, optional
+//  lVectors  CDense        left eigenvectors, optional
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (e *CEigen) MarshalBinary() ([]byte, error) {
+	return marshalFactor(e.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (e *CEigen) MarshalBinaryTo(w io.Writer) (int, error) {
+	if !e.succFact() {
+		return 0, errNoFactor
+	}
+	enc := encoder{w: w}
+	enc.factor(tagCEigen)
+	enc.integer(e.n)
+	enc.complexSlice(e.values)
+	enc.boolean(e.right)
+	if e.right {
+		enc.matrix(e.rVectors)
+	}
+	enc.boolean(e.left)
+	if e.left {
+		enc.matrix(e.lVectors)
+	}
+	return enc.n, enc.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (e *CEigen) UnmarshalBinary(data []byte) error {
+	var tmp CEigen
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*e = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (e *CEigen) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagCEigen)
+	n := d.integer()
+	values := d.complexSlice()
+	rVectors := d.optionalCDense()
+	lVectors := d.optionalCDense()
+	if d.err != nil {
+		return d.n, d.err
+	}
+	if n == 0 || len(values) != n || !squareOrNil(rVectors, n) || !squareOrNil(lVectors, n) {
+		return d.n, errBadFactor
+	}
+	e.n = n
+	e.values = values
+	e.right = rVectors != nil
+	e.rVectors = rVectors
+	e.left = lVectors != nil
+	e.lVectors = lVectors
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// GSVD is encoded as the fields
+//  kind        int        GSVDKind of the factorization
+//  r, p, c     int        dimensions of the factorized matrices
+//  k, l        int        rank terms of the factorization
+//  s1, s2      []float64  generalized singular value pairs
+//  a, b        Dense      the triangular factors computed by Dggsvd3
+//  u           Dense      present if kind includes GSVDU
+//  v           Dense      present if kind includes GSVDV
+//  q           Dense      present if kind includes GSVDQ
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (gsvd *GSVD) MarshalBinary() ([]byte, error) {
+	return marshalFactor(gsvd.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (gsvd *GSVD) MarshalBinaryTo(w io.Writer) (int, error) {
+	if gsvd.a.Rows == 0 {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagGSVD)
+	for _, v := range []int{int(gsvd.kind), gsvd.r, gsvd.p, gsvd.c, gsvd.k, gsvd.l} {
+		e.integer(v)
+	}
+	e.floatSlice(gsvd.s1)
+	e.floatSlice(gsvd.s2)
+	e.general(gsvd.a)
+	e.general(gsvd.b)
+	if gsvd.kind&GSVDU != 0 {
+		e.general(gsvd.u)
+	}
+	if gsvd.kind&GSVDV != 0 {
+		e.general(gsvd.v)
+	}
+	if gsvd.kind&GSVDQ != 0 {
+		e.general(gsvd.q)
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (gsvd *GSVD) UnmarshalBinary(data []byte) error {
+	var tmp GSVD
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*gsvd = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (gsvd *GSVD) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagGSVD)
+	kind := GSVDKind(d.integer())
+	rows, p, c, k, l := d.integer(), d.integer(), d.integer(), d.integer(), d.integer()
+	s1 := d.floatSlice()
+	s2 := d.floatSlice()
+	a := d.general()
+	b := d.general()
+	var u, v, q blas64.General
+	if kind&GSVDU != 0 {
+		u = d.general()
+	}
+	if kind&GSVDV != 0 {
+		v = d.general()
+	}
+	if kind&GSVDQ != 0 {
+		q = d.general()
+	}
+	if d.err != nil {
+		return d.n, d.err
+	}
+	switch {
+	case kind&^(GSVDU|GSVDV|GSVDQ) != 0,
+		a.Rows != rows || a.Cols != c || b.Rows != p || b.Cols != c,
+		len(s1) != c || len(s2) != c,
+		k < 0 || l < 0 || k+l > c,
+		kind&GSVDU != 0 && (u.Rows != rows || u.Cols != rows),
+		kind&GSVDV != 0 && (v.Rows != p || v.Cols != p),
+		kind&GSVDQ != 0 && (q.Rows != c || q.Cols != c):
+		return d.n, errBadFactor
+	}
+	gsvd.kind = kind
+	gsvd.r, gsvd.p, gsvd.c, gsvd.k, gsvd.l = rows, p, c, k, l
+	gsvd.s1, gsvd.s2 = s1, s2
+	gsvd.a, gsvd.b = a, b
+	gsvd.u, gsvd.v, gsvd.q = u, v, q
+	return d.n, nil
+}
+
+// MarshalBinary encodes the receiver into a binary form and returns the result.
+//
+// HOGSVD is encoded as the fields
+//  n  int    number of factorized matrices
+//  v  Dense  right singular vectors
+//  b  Dense  n matrices B_i, one after the other
+// It returns an error if the receiver does not hold a successful
+// factorization.
+func (gsvd *HOGSVD) MarshalBinary() ([]byte, error) {
+	return marshalFactor(gsvd.MarshalBinaryTo)
+}
+
+// MarshalBinaryTo encodes the receiver into a binary form and writes it into w.
+// MarshalBinaryTo returns the number of bytes written into w and an error, if any.
+//
+// See MarshalBinary for the on-disk layout.
+func (gsvd *HOGSVD) MarshalBinaryTo(w io.Writer) (int, error) {
+	if gsvd.n == 0 {
+		return 0, errNoFactor
+	}
+	e := encoder{w: w}
+	e.factor(tagHOGSVD)
+	e.integer(gsvd.n)
+	e.matrix(gsvd.v)
+	for i := range gsvd.b {
+		e.matrix(&gsvd.b[i])
+	}
+	return e.n, e.err
+}
+
+// UnmarshalBinary decodes the binary form into the receiver, replacing any
+// factorization it holds.
+//
+// See MarshalBinary for the on-disk layout.
+// UnmarshalBinary does not limit the size of the unmarshaled factorization,
+// and so it should not be used on untrusted data.
+func (gsvd *HOGSVD) UnmarshalBinary(data []byte) error {
+	var tmp HOGSVD
+	err := unmarshalBinary(data, factorHeaderSize, tmp.UnmarshalBinaryFrom, func() {})
+	if err == nil {
+		*gsvd = tmp
+	}
+	return err
+}
+
+// UnmarshalBinaryFrom decodes the binary form into the receiver, replacing any
+// factorization it holds, and returns the number of bytes read and an error
+// if any.
+//
+// See MarshalBinary for the on-disk layout.
+// See UnmarshalBinary for the limitations on the input.
+func (gsvd *HOGSVD) UnmarshalBinaryFrom(r io.Reader) (int, error) {
+	d := decoder{r: r}
+	d.factor(tagHOGSVD)
+	n := d.integer()
+	if d.err == nil && n < 2 {
+		d.err = errBadFactor
+	}
+	var v Dense
+	d.matrix(&v)
+	var b []Dense
+	for i := 0; i < n && d.err == nil; i++ {
+		b = append(b, Dense{})
+		d.matrix(&b[i])
+	}
+	if d.err != nil {
+		return d.n, d.err
+	}
+	_, c := v.Dims()
+	for i := range b {
+		if _, bc := b[i].Dims(); bc != c {
+			return d.n, errBadFactor
+		}
+	}
+	gsvd.n = n
+	gsvd.v = &v
+	gsvd.b = b
+	gsvd.err = nil
+	return d.n, nil
+}
+
+// marshalFactor returns the binary form of a factorization written by
+// marshalTo.
+func marshalFactor(marshalTo func(io.Writer) (int, error)) ([]byte, error) {
+	var buf bytes.Buffer
+	_, err := marshalTo(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// validPivots returns whether all the pivot indices are in [0, n).
+func validPivots(pivot []int, n int) bool {
+	for _, p := range pivot {
+		if p < 0 || n <= p {
+			return false
+		}
+	}
+	return true
+}
+
+// squareOrNil returns whether m is nil or an n×n matrix.
+func squareOrNil(m *CDense, n int) bool {
+	if m == nil {
+		return true
+	}
+	r, c := m.Dims()
+	return r == n && c == n
+}
+
+// binaryMarshalerTo is a matrix that can write its binary form to an
+// io.Writer.
+type binaryMarshalerTo interface {
+	MarshalBinaryTo(io.Writer) (int, error)
+}
+
+// binaryUnmarshalerFrom is a matrix that can read its binary form from an
+// io.Reader.
+type binaryUnmarshalerFrom interface {
+	UnmarshalBinaryFrom(io.Reader) (int, error)
+}
+
+func (e *encoder) factor(tag string) {
+	var buf [factorHeaderSize]byte
+	binary.LittleEndian.PutUint32(buf[:4], version)
+	copy(buf[4:], tag)
+	e.write(buf[:])
+}
+
+func (e *encoder) integer(v int) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(int64(v)))
+	e.write(buf[:])
+}
+
+func (e *encoder) boolean(v bool) {
+	var b byte
+	if v {
+		b = 1
+	}
+	e.write([]byte{b})
+}
+
+func (e *encoder) float(v float64) {
+	e.floats([]float64{v})
+}
+
+func (e *encoder) floatSlice(v []float64) {
+	e.integer(len(v))
+	e.floats(v)
+}
+
+func (e *encoder) complexSlice(v []complex128) {
+	e.integer(len(v))
+	e.complexes(v)
+}
+
+func (e *encoder) intSlice(v []int) {
+	e.integer(len(v))
+	for _, i := range v {
+		e.integer(i)
+	}
+}
+
+func (e *encoder) matrix(m binaryMarshalerTo) {
+	if e.err != nil {
+		return
+	}
+	n, err := m.MarshalBinaryTo(e.w)
+	e.n += n
+	e.err = err
+}
+
+// general writes g as a Dense.
+func (e *encoder) general(g blas64.General) {
+	e.matrix(Dense{mat: g, capRows: g.Rows, capCols: g.Cols})
+}
+
+// cgeneral writes g as a CDense.
+func (e *encoder) cgeneral(g cblas128.General) {
+	e.matrix(CDense{mat: g, capRows: g.Rows, capCols: g.Cols})
+}
+
+// factor reads a factorization header and checks that it has the given tag.
+func (d *decoder) factor(tag string) {
+	var buf [factorHeaderSize]byte
+	if d.err != nil {
+		return
+	}
+	n, err := readFull(d.r, buf[:])
+	d.n += n
+	if err != nil {
+		d.err = err
+		return
+	}
+	if v := binary.LittleEndian.Uint32(buf[:4]); v != version {
+		d.err = fmt.Errorf("mat: incorrect version: %d", v)
+		return
+	}
+	if string(buf[4:]) != tag {
+		d.err = errWrongType
+	}
+}
+
+func (d *decoder) integer() int {
+	var buf [8]byte
+	if !d.read(buf[:]) {
+		return 0
+	}
+	v := int64(binary.LittleEndian.Uint64(buf[:]))
+	if v > maxLen || v < -maxLen {
+		d.err = errTooBig
+		return 0
+	}
+	return int(v)
+}
+
+func (d *decoder) boolean() bool {
+	var buf [1]byte
+	if !d.read(buf[:]) {
+		return false
+	}
+	switch buf[0] {
+	case 0:
+		return false
+	case 1:
+		return true
+	}
+	d.err = errBadFactor
+	return false
+}
+
+func (d *decoder) float() float64 {
+	var buf [8]byte
+	if !d.read(buf[:]) {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(buf[:]))
+}
+
+// length reads the length of a slice.
+func (d *decoder) length() int {
+	n := d.integer()
+	if d.err == nil && n < 0 {
+		d.err = errBadSize
+	}
+	return n
+}
+
+func (d *decoder) floatSlice() []float64 {
+	n := d.length()
+	if d.err != nil || n == 0 {
+		return nil
+	}
+	return d.floats(n)
+}
+
+func (d *decoder) complexSlice() []complex128 {
+	n := d.length()
+	if d.err != nil || n == 0 {
+		return nil
+	}
+	return d.complexes(n)
+}
+
+func (d *decoder) intSlice() []int {
+	n := d.length()
+	if d.err != nil || n == 0 {
+		return nil
+	}
+	v := make([]int, 0, min(n, 1024))
+	for len(v) < n && d.err == nil {
+		v = append(v, d.integer())
+	}
+	if d.err != nil {
+		return nil
+	}
+	return v
+}
+
+func (d *decoder) matrix(m binaryUnmarshalerFrom) {
+	if d.err != nil {
+		return
+	}
+	n, err := m.UnmarshalBinaryFrom(d.r)
+	d.n += n
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	d.err = err
+}
+
+// general reads a Dense and returns its storage.
+func (d *decoder) general() blas64.General {
+	var m Dense
+	d.matrix(&m)
+	return m.mat
+}
+
+// cgeneral reads a CDense and returns its storage.
+func (d *decoder) cgeneral() cblas128.General {
+	var m CDense
+	d.matrix(&m)
+	return m.mat
+}
+
+// optionalCDense reads an optional CDense, returning nil if it is absent.
+func (d *decoder) optionalCDense() *CDense {
+	if !d.boolean() {
+		return nil
+	}
+	m := &CDense{}
+	d.matrix(m)
+	return m
+}
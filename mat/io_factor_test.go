@@ -0,0 +1,631 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"encoding"
+	"io"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// factorCodec is a factorization type that implements the binary codec.
+type factorCodec interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	MarshalBinaryTo(io.Writer) (int, error)
+	UnmarshalBinaryFrom(io.Reader) (int, error)
+}
+
+var (
+	_ factorCodec = (*BandLU)(nil)
+	_ factorCodec = (*BunchKaufman)(nil)
+	_ factorCodec = (*CCholesky)(nil)
+	_ factorCodec = (*CEigen)(nil)
+	_ factorCodec = (*CEigenHerm)(nil)
+	_ factorCodec = (*Cholesky)(nil)
+	_ factorCodec = (*CLU)(nil)
+	_ factorCodec = (*CQR)(nil)
+	_ factorCodec = (*CSVD)(nil)
+	_ factorCodec = (*Eigen)(nil)
+	_ factorCodec = (*EigenSym)(nil)
+	_ factorCodec = (*GenEigen)(nil)
+	_ factorCodec = (*GenEigenSym)(nil)
+	_ factorCodec = (*GSVD)(nil)
+	_ factorCodec = (*HOGSVD)(nil)
+	_ factorCodec = (*LQ)(nil)
+	_ factorCodec = (*LU)(nil)
+	_ factorCodec = (*PivotedQR)(nil)
+	_ factorCodec = (*QR)(nil)
+	_ factorCodec = (*Schur)(nil)
+	_ factorCodec = (*SVD)(nil)
+)
+
+// factorTags holds the tags of all factorization types.
+var factorTags = []string{
+	tagBandLU,
+	tagBunchKaufman,
+	tagCCholesky,
+	tagCEigen,
+	tagCEigenHerm,
+	tagCholesky,
+	tagCLU,
+	tagCQR,
+	tagCSVD,
+	tagEigen,
+	tagEigenSym,
+	tagGenEigen,
+	tagGenEigenSym,
+	tagGSVD,
+	tagHOGSVD,
+	tagLQ,
+	tagLU,
+	tagPivotedQR,
+	tagQR,
+	tagSchur,
+	tagSVD,
+}
+
+func TestFactorIORoundTrip(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	randDense := func(r, c int) *Dense {
+		m := NewDense(r, c, nil)
+		for i := range m.mat.Data {
+			m.mat.Data[i] = rnd.NormFloat64()
+		}
+		return m
+	}
+	randSPD := func(n int) *SymDense {
+		a := randDense(n, n)
+		var s SymDense
+		s.SymOuterK(1, a)
+		for i := 0; i < n; i++ {
+			s.SetSym(i, i, s.At(i, i)+1)
+		}
+		return &s
+	}
+	randCDense := func(r, c int) *CDense {
+		m := NewCDense(r, c, nil)
+		for i := range m.mat.Data {
+			m.mat.Data[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+		return m
+	}
+	const n = 5
+	a := randDense(n, n)
+	b := randDense(n, 2)
+	cb := randCDense(n, 2)
+	spd := randSPD(n)
+
+	// solveEqual returns a check that the solutions of the system with
+	// the right-hand side b computed by two factorizations are equal.
+	solveEqual := func(solve func(f factorCodec, x *Dense) error) func(want, got factorCodec) bool {
+		return func(want, got factorCodec) bool {
+			var x, y Dense
+			if err := solve(want, &x); err != nil {
+				return false
+			}
+			if err := solve(got, &y); err != nil {
+				return false
+			}
+			return EqualApprox(&x, &y, tol)
+		}
+	}
+	csolveEqual := func(solve func(f factorCodec, x *CDense) error) func(want, got factorCodec) bool {
+		return func(want, got factorCodec) bool {
+			var x, y CDense
+			if err := solve(want, &x); err != nil {
+				return false
+			}
+			if err := solve(got, &y); err != nil {
+				return false
+			}
+			return CEqualApprox(&x, &y, tol)
+		}
+	}
+
+	for _, test := range []struct {
+		name  string
+		tag   string
+		fact  func() factorCodec
+		new   func() factorCodec
+		check func(want, got factorCodec) bool
+	}{
+		{
+			name: "Cholesky",
+			tag:  tagCholesky,
+			fact: func() factorCodec {
+				var c Cholesky
+				if !c.Factorize(spd) {
+					t.Fatal("unexpected Cholesky failure")
+				}
+				return &c
+			},
+			new: func() factorCodec { return &Cholesky{} },
+			check: solveEqual(func(f factorCodec, x *Dense) error {
+				return f.(*Cholesky).Solve(x, b)
+			}),
+		},
+		{
+			name: "LU",
+			tag:  tagLU,
+			fact: func() factorCodec {
+				var lu LU
+				lu.Factorize(a)
+				return &lu
+			},
+			new: func() factorCodec { return &LU{} },
+			check: solveEqual(func(f factorCodec, x *Dense) error {
+				return f.(*LU).Solve(x, true, b)
+			}),
+		},
+		{
+			name: "QR",
+			tag:  tagQR,
+			fact: func() factorCodec {
+				var qr QR
+				qr.Factorize(randDense(7, n))
+				return &qr
+			},
+			new: func() factorCodec { return &QR{} },
+			check: solveEqual(func(f factorCodec, x *Dense) error {
+				return f.(*QR).Solve(x, false, randDenseFrom(rand.NewSource(2), 7, 2))
+			}),
+		},
+		{
+			name: "LQ",
+			tag:  tagLQ,
+			fact: func() factorCodec {
+				var lq LQ
+				lq.Factorize(randDense(3, n))
+				return &lq
+			},
+			new: func() factorCodec { return &LQ{} },
+			check: solveEqual(func(f factorCodec, x *Dense) error {
+				return f.(*LQ).Solve(x, false, randDenseFrom(rand.NewSource(2), 3, 2))
+			}),
+		},
+		{
+			name: "PivotedQR",
+			tag:  tagPivotedQR,
+			fact: func() factorCodec {
+				var qr PivotedQR
+				qr.Factorize(randDense(7, n))
+				return &qr
+			},
+			new: func() factorCodec { return &PivotedQR{} },
+			check: solveEqual(func(f factorCodec, x *Dense) error {
+				f.(*PivotedQR).Solve(x, 0, randDenseFrom(rand.NewSource(2), 7, 2))
+				return nil
+			}),
+		},
+		{
+			name: "SVDNone",
+			tag:  tagSVD,
+			fact: func() factorCodec {
+				var svd SVD
+				if !svd.Factorize(randDense(4, n), SVDNone) {
+					t.Fatal("unexpected SVD failure")
+				}
+				return &svd
+			},
+			new: func() factorCodec { return &SVD{} },
+		},
+		{
+			name: "SVDThin",
+			tag:  tagSVD,
+			fact: func() factorCodec {
+				var svd SVD
+				if !svd.Factorize(randDense(4, n), SVDThin) {
+					t.Fatal("unexpected SVD failure")
+				}
+				return &svd
+			},
+			new: func() factorCodec { return &SVD{} },
+			check: func(want, got factorCodec) bool {
+				var u1, u2 Dense
+				want.(*SVD).UTo(&u1)
+				got.(*SVD).UTo(&u2)
+				return Equal(&u1, &u2)
+			},
+		},
+		{
+			name: "SVDFull",
+			tag:  tagSVD,
+			fact: func() factorCodec {
+				var svd SVD
+				if !svd.Factorize(randDense(n, 3), SVDFull) {
+					t.Fatal("unexpected SVD failure")
+				}
+				return &svd
+			},
+			new: func() factorCodec { return &SVD{DivideConquer: true} },
+			check: func(want, got factorCodec) bool {
+				return got.(*SVD).DivideConquer && got.(*SVD).Cond() == want.(*SVD).Cond()
+			},
+		},
+		{
+			name: "EigenSym",
+			tag:  tagEigenSym,
+			fact: func() factorCodec {
+				var e EigenSym
+				if !e.Factorize(spd, true) {
+					t.Fatal("unexpected EigenSym failure")
+				}
+				return &e
+			},
+			new: func() factorCodec { return &EigenSym{} },
+			check: func(want, got factorCodec) bool {
+				var v1, v2 Dense
+				v1.EigenvectorsSym(want.(*EigenSym))
+				v2.EigenvectorsSym(got.(*EigenSym))
+				return Equal(&v1, &v2)
+			},
+		},
+		{
+			name: "EigenSymValues",
+			tag:  tagEigenSym,
+			fact: func() factorCodec {
+				var e EigenSym
+				if !e.Factorize(spd, false) {
+					t.Fatal("unexpected EigenSym failure")
+				}
+				return &e
+			},
+			new: func() factorCodec { return &EigenSym{} },
+		},
+		{
+			name: "GenEigenSym",
+			tag:  tagGenEigenSym,
+			fact: func() factorCodec {
+				var e GenEigenSym
+				if !e.Factorize(spd, randSPD(n), true) {
+					t.Fatal("unexpected GenEigenSym failure")
+				}
+				return &e
+			},
+			new: func() factorCodec { return &GenEigenSym{} },
+		},
+		{
+			name: "Eigen",
+			tag:  tagEigen,
+			fact: func() factorCodec {
+				var e Eigen
+				if !e.Factorize(a, true, true) {
+					t.Fatal("unexpected Eigen failure")
+				}
+				return &e
+			},
+			new: func() factorCodec { return &Eigen{} },
+			check: func(want, got factorCodec) bool {
+				var v1, v2 CDense
+				want.(*Eigen).VectorsTo(&v1)
+				got.(*Eigen).VectorsTo(&v2)
+				return CEqual(&v1, &v2)
+			},
+		},
+		{
+			name: "GenEigen",
+			tag:  tagGenEigen,
+			fact: func() factorCodec {
+				var e GenEigen
+				if !e.Factorize(a, randDense(n, n), false, true) {
+					t.Fatal("unexpected GenEigen failure")
+				}
+				return &e
+			},
+			new: func() factorCodec { return &GenEigen{} },
+		},
+		{
+			name: "Schur",
+			tag:  tagSchur,
+			fact: func() factorCodec {
+				var s Schur
+				if !s.Factorize(a, true) {
+					t.Fatal("unexpected Schur failure")
+				}
+				return &s
+			},
+			new: func() factorCodec { return &Schur{} },
+		},
+		{
+			name: "BandLU",
+			tag:  tagBandLU,
+			fact: func() factorCodec {
+				var lu BandLU
+				band := NewBandDense(n, n, 1, 2, nil)
+				for i := range band.mat.Data {
+					band.mat.Data[i] = rnd.NormFloat64()
+				}
+				lu.Factorize(band)
+				return &lu
+			},
+			new: func() factorCodec { return &BandLU{} },
+			check: solveEqual(func(f factorCodec, x *Dense) error {
+				return f.(*BandLU).Solve(x, false, b)
+			}),
+		},
+		{
+			name: "BunchKaufman",
+			tag:  tagBunchKaufman,
+			fact: func() factorCodec {
+				var bk BunchKaufman
+				sym := NewSymDense(n, nil)
+				for i := 0; i < n; i++ {
+					for j := i; j < n; j++ {
+						sym.SetSym(i, j, rnd.NormFloat64())
+					}
+				}
+				if !bk.Factorize(sym) {
+					t.Fatal("unexpected BunchKaufman failure")
+				}
+				return &bk
+			},
+			new: func() factorCodec { return &BunchKaufman{} },
+			check: solveEqual(func(f factorCodec, x *Dense) error {
+				return f.(*BunchKaufman).Solve(x, b)
+			}),
+		},
+		{
+			name: "CCholesky",
+			tag:  tagCCholesky,
+			fact: func() factorCodec {
+				c := randCDense(n, n)
+				var h CDense
+				h.Mul(c.H(), c)
+				for i := 0; i < n; i++ {
+					h.Set(i, i, h.At(i, i)+1)
+				}
+				var chol CCholesky
+				if !chol.Factorize(&h) {
+					t.Fatal("unexpected CCholesky failure")
+				}
+				return &chol
+			},
+			new: func() factorCodec { return &CCholesky{} },
+			check: csolveEqual(func(f factorCodec, x *CDense) error {
+				return f.(*CCholesky).Solve(x, cb)
+			}),
+		},
+		{
+			name: "CLU",
+			tag:  tagCLU,
+			fact: func() factorCodec {
+				var lu CLU
+				lu.Factorize(randCDense(n, n))
+				return &lu
+			},
+			new: func() factorCodec { return &CLU{} },
+			check: csolveEqual(func(f factorCodec, x *CDense) error {
+				return f.(*CLU).Solve(x, false, cb)
+			}),
+		},
+		{
+			name: "CQR",
+			tag:  tagCQR,
+			fact: func() factorCodec {
+				var qr CQR
+				qr.Factorize(randCDense(n, n))
+				return &qr
+			},
+			new: func() factorCodec { return &CQR{} },
+			check: csolveEqual(func(f factorCodec, x *CDense) error {
+				return f.(*CQR).Solve(x, false, cb)
+			}),
+		},
+		{
+			name: "CSVD",
+			tag:  tagCSVD,
+			fact: func() factorCodec {
+				var svd CSVD
+				if !svd.Factorize(randCDense(4, 3), SVDThin) {
+					t.Fatal("unexpected CSVD failure")
+				}
+				return &svd
+			},
+			new: func() factorCodec { return &CSVD{} },
+			check: func(want, got factorCodec) bool {
+				return CEqual(want.(*CSVD).UTo(nil), got.(*CSVD).UTo(nil)) &&
+					CEqual(want.(*CSVD).VTo(nil), got.(*CSVD).VTo(nil))
+			},
+		},
+		{
+			name: "CEigenHerm",
+			tag:  tagCEigenHerm,
+			fact: func() factorCodec {
+				c := randCDense(n, n)
+				var h CDense
+				h.Add(c, c.H())
+				var e CEigenHerm
+				if !e.Factorize(&h, true) {
+					t.Fatal("unexpected CEigenHerm failure")
+				}
+				return &e
+			},
+			new: func() factorCodec { return &CEigenHerm{} },
+			check: func(want, got factorCodec) bool {
+				return CEqual(want.(*CEigenHerm).VectorsTo(nil), got.(*CEigenHerm).VectorsTo(nil))
+			},
+		},
+		{
+			name: "CEigen",
+			tag:  tagCEigen,
+			fact: func() factorCodec {
+				var e CEigen
+				if !e.Factorize(randCDense(n, n), true, true) {
+					t.Fatal("unexpected CEigen failure")
+				}
+				return &e
+			},
+			new: func() factorCodec { return &CEigen{} },
+			check: func(want, got factorCodec) bool {
+				return CEqual(want.(*CEigen).VectorsTo(nil), got.(*CEigen).VectorsTo(nil)) &&
+					CEqual(want.(*CEigen).LeftVectorsTo(nil), got.(*CEigen).LeftVectorsTo(nil))
+			},
+		},
+		{
+			name: "GSVD",
+			tag:  tagGSVD,
+			fact: func() factorCodec {
+				var gsvd GSVD
+				if !gsvd.Factorize(randDense(4, 3), randDense(5, 3), GSVDU|GSVDV|GSVDQ) {
+					t.Fatal("unexpected GSVD failure")
+				}
+				return &gsvd
+			},
+			new: func() factorCodec { return &GSVD{} },
+			check: func(want, got factorCodec) bool {
+				var u1, u2 Dense
+				want.(*GSVD).UTo(&u1)
+				got.(*GSVD).UTo(&u2)
+				return Equal(&u1, &u2)
+			},
+		},
+		{
+			name: "HOGSVD",
+			tag:  tagHOGSVD,
+			fact: func() factorCodec {
+				var gsvd HOGSVD
+				if !gsvd.Factorize(randDense(6, 3), randDense(5, 3), randDense(4, 3)) {
+					t.Fatalf("unexpected HOGSVD failure: %v", gsvd.Err())
+				}
+				return &gsvd
+			},
+			new: func() factorCodec { return &HOGSVD{} },
+			check: func(want, got factorCodec) bool {
+				var u1, u2 Dense
+				want.(*HOGSVD).UTo(&u1, 2)
+				got.(*HOGSVD).UTo(&u2, 2)
+				return Equal(&u1, &u2)
+			},
+		},
+	} {
+		f := test.fact()
+		buf, err := f.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: unexpected error marshaling: %v", test.name, err)
+		}
+		if tag := string(buf[4:factorHeaderSize]); tag != test.tag {
+			t.Errorf("%s: unexpected tag: got %q, want %q", test.name, tag, test.tag)
+		}
+
+		got := test.new()
+		err = got.UnmarshalBinary(buf)
+		if err != nil {
+			t.Fatalf("%s: unexpected error unmarshaling: %v", test.name, err)
+		}
+		again, err := got.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: unexpected error marshaling unmarshaled factorization: %v", test.name, err)
+		}
+		if !bytes.Equal(again, buf) {
+			t.Errorf("%s: binary form changed after round trip", test.name)
+		}
+		if test.check != nil && !test.check(f, got) {
+			t.Errorf("%s: unmarshaled factorization differs from original", test.name)
+		}
+
+		var w bytes.Buffer
+		nw, err := f.MarshalBinaryTo(&w)
+		if err != nil {
+			t.Fatalf("%s: unexpected error marshaling to writer: %v", test.name, err)
+		}
+		if nw != len(buf) || !bytes.Equal(w.Bytes(), buf) {
+			t.Errorf("%s: MarshalBinaryTo and MarshalBinary differ", test.name)
+		}
+		got = test.new()
+		nr, err := got.UnmarshalBinaryFrom(&w)
+		if err != nil {
+			t.Fatalf("%s: unexpected error unmarshaling from reader: %v", test.name, err)
+		}
+		if nr != len(buf) {
+			t.Errorf("%s: unexpected number of bytes read: got %d, want %d", test.name, nr, len(buf))
+		}
+		if test.check != nil && !test.check(f, got) {
+			t.Errorf("%s: factorization unmarshaled from reader differs from original", test.name)
+		}
+
+		// Check that truncated and padded input is rejected and leaves
+		// the receiver unchanged.
+		for _, bad := range [][]byte{buf[:len(buf)-1], append(buf[:len(buf):len(buf)], 0)} {
+			err = got.UnmarshalBinary(bad)
+			if err != errBadBuffer {
+				t.Errorf("%s: unexpected error for buffer of length %d: got %v, want %v", test.name, len(bad), err, errBadBuffer)
+			}
+		}
+		again, err = got.MarshalBinary()
+		if err != nil || !bytes.Equal(again, buf) {
+			t.Errorf("%s: receiver modified by failed unmarshal", test.name)
+		}
+
+		// Check that data with the tag of any other factorization is
+		// rejected.
+		for _, tag := range factorTags {
+			if tag == test.tag {
+				continue
+			}
+			bad := append([]byte(nil), buf...)
+			copy(bad[4:factorHeaderSize], tag)
+			err = test.new().UnmarshalBinary(bad)
+			if err != errWrongType {
+				t.Errorf("%s: unexpected error for tag %q: got %v, want %v", test.name, tag, err, errWrongType)
+			}
+			_, err = test.new().UnmarshalBinaryFrom(bytes.NewReader(bad))
+			if err != errWrongType {
+				t.Errorf("%s: unexpected error reading tag %q: got %v, want %v", test.name, tag, err, errWrongType)
+			}
+		}
+
+		// Check that an empty factorization cannot be marshaled.
+		_, err = test.new().MarshalBinary()
+		if err != errNoFactor {
+			t.Errorf("%s: unexpected error marshaling empty factorization: got %v, want %v", test.name, err, errNoFactor)
+		}
+	}
+}
+
+func TestFactorUnmarshalError(t *testing.T) {
+	var lu LU
+	lu.Factorize(NewDense(2, 2, []float64{1, 2, 3, 4}))
+	buf, err := lu.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var qr QR
+	if err := qr.UnmarshalBinary(buf); err != errWrongType {
+		t.Errorf("unexpected error for wrong factorization type: got %v, want %v", err, errWrongType)
+	}
+	if err := lu.UnmarshalBinary(buf[:factorHeaderSize-1]); err != errTooSmall {
+		t.Errorf("unexpected error for short data: got %v, want %v", err, errTooSmall)
+	}
+
+	bad := append([]byte(nil), buf...)
+	bad[0] = 2
+	if err := lu.UnmarshalBinary(bad); err == nil {
+		t.Errorf("expected error for incorrect version")
+	}
+
+	// Corrupt the first pivot index.
+	bad = append([]byte(nil), buf...)
+	bad[factorHeaderSize+16] = 5
+	if err := lu.UnmarshalBinary(bad); err != errBadFactor {
+		t.Errorf("unexpected error for invalid pivot: got %v, want %v", err, errBadFactor)
+	}
+}
+
+// randDenseFrom returns an r×c matrix with elements drawn from the standard
+// normal distribution using src.
+func randDenseFrom(src rand.Source, r, c int) *Dense {
+	rnd := rand.New(src)
+	m := NewDense(r, c, nil)
+	for i := range m.mat.Data {
+		m.mat.Data[i] = rnd.NormFloat64()
+	}
+	return m
+}
@@ -12,6 +12,7 @@ import (
 	"math"
 	"testing"
 
+	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
 )
 
@@ -20,6 +21,20 @@ var (
 	_ encoding.BinaryUnmarshaler = (*Dense)(nil)
 	_ encoding.BinaryMarshaler   = (*VecDense)(nil)
 	_ encoding.BinaryUnmarshaler = (*VecDense)(nil)
+	_ encoding.BinaryMarshaler   = (*SymDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*SymDense)(nil)
+	_ encoding.BinaryMarshaler   = (*TriDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*TriDense)(nil)
+	_ encoding.BinaryMarshaler   = (*BandDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*BandDense)(nil)
+	_ encoding.BinaryMarshaler   = (*SymBandDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*SymBandDense)(nil)
+	_ encoding.BinaryMarshaler   = (*TriBandDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*TriBandDense)(nil)
+	_ encoding.BinaryMarshaler   = (*DiagDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*DiagDense)(nil)
+	_ encoding.BinaryMarshaler   = (*CDense)(nil)
+	_ encoding.BinaryUnmarshaler = (*CDense)(nil)
 )
 
 var denseData = []struct {
@@ -567,6 +582,205 @@ func TestVecDenseIORoundTrip(t *testing.T) {
 	}
 }
 
+// storageMatrix is a matrix type that implements the binary codec.
+type storageMatrix interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	MarshalBinaryTo(io.Writer) (int, error)
+	UnmarshalBinaryFrom(io.Reader) (int, error)
+}
+
+func TestSymDenseMarshal(t *testing.T) {
+	m := NewSymDense(2, []float64{1, 2, 2, 3})
+	got, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []byte("\x01\x00\x00\x00SPU\x00\x02\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xf0?\x00\x00\x00\x00\x00\x00\x00@\x00\x00\x00\x00\x00\x00\b@")
+	if !bytes.Equal(got, want) {
+		t.Errorf("unexpected encoding:\ngot: %q\nwant:%q", got, want)
+	}
+}
+
+func TestStorageIORoundTrip(t *testing.T) {
+	sub := NewSymDense(4, []float64{
+		1, 2, 3, 4,
+		2, 5, 6, 7,
+		3, 6, 8, 9,
+		4, 7, 9, 10,
+	}).SliceSquare(1, 3).(*SymDense)
+	tri := NewTriDense(3, Lower, []float64{1, 0, 0, 2, 3, 0, 4, 5, 6})
+	unit := NewTriDense(2, Upper, []float64{1, 2, 0, 1})
+	unit.mat.Diag = blas.Unit
+	for _, test := range []struct {
+		name string
+		m    storageMatrix
+		new  func() storageMatrix
+		eq   func(a, b storageMatrix) bool
+	}{
+		{
+			name: "SymDense",
+			m:    NewSymDense(3, []float64{1, 2, 3, 2, 4, 5, 3, 5, 6}),
+			new:  func() storageMatrix { return &SymDense{} },
+		},
+		{
+			name: "SymDenseSlice",
+			m:    sub,
+			new:  func() storageMatrix { return &SymDense{} },
+		},
+		{
+			name: "TriDenseUpper",
+			m:    NewTriDense(3, Upper, []float64{1, 2, 3, 0, 4, 5, 0, 0, 6}),
+			new:  func() storageMatrix { return &TriDense{} },
+		},
+		{
+			name: "TriDenseLower",
+			m:    tri,
+			new:  func() storageMatrix { return &TriDense{} },
+		},
+		{
+			name: "TriDenseUnit",
+			m:    unit,
+			new:  func() storageMatrix { return &TriDense{} },
+		},
+		{
+			name: "BandDense",
+			m: NewBandDense(5, 4, 1, 2, []float64{
+				-1, 1, 2, 3,
+				4, 5, 6, 7,
+				8, 9, 10, 11,
+				12, 13, 14, -1,
+				15, 16, -1, -1,
+			}),
+			new: func() storageMatrix { return &BandDense{} },
+		},
+		{
+			name: "SymBandDense",
+			m:    NewSymBandDense(3, 1, []float64{1, 2, 3, 4, 5, -1}),
+			new:  func() storageMatrix { return &SymBandDense{} },
+		},
+		{
+			name: "TriBandDenseUpper",
+			m:    NewTriBandDense(3, 1, Upper, []float64{1, 2, 3, 4, 5, -1}),
+			new:  func() storageMatrix { return &TriBandDense{} },
+		},
+		{
+			name: "TriBandDenseLower",
+			m:    NewTriBandDense(3, 2, Lower, []float64{-1, -1, 1, -1, 2, 3, 4, 5, 6}),
+			new:  func() storageMatrix { return &TriBandDense{} },
+		},
+		{
+			name: "DiagDense",
+			m:    NewDiagDense(3, []float64{1, 2, 3}),
+			new:  func() storageMatrix { return &DiagDense{} },
+		},
+		{
+			name: "CDense",
+			m:    NewCDense(2, 3, []complex128{1, 2i, 3 + 4i, -1, complex(math.Inf(1), 0), 0}),
+			new:  func() storageMatrix { return &CDense{} },
+			eq: func(a, b storageMatrix) bool {
+				return CEqual(a.(CMatrix), b.(CMatrix))
+			},
+		},
+	} {
+		eq := test.eq
+		if eq == nil {
+			eq = func(a, b storageMatrix) bool {
+				return Equal(a.(Matrix), b.(Matrix))
+			}
+		}
+
+		buf, err := test.m.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: unexpected error marshaling: %v", test.name, err)
+		}
+		got := test.new()
+		err = got.UnmarshalBinary(buf)
+		if err != nil {
+			t.Fatalf("%s: unexpected error unmarshaling: %v", test.name, err)
+		}
+		if !eq(got, test.m) {
+			t.Errorf("%s: unexpected result after UnmarshalBinary", test.name)
+		}
+		if tri, ok := test.m.(*TriDense); ok && tri.mat.Diag != got.(*TriDense).mat.Diag {
+			t.Errorf("%s: unit diagonal not preserved", test.name)
+		}
+
+		var w bytes.Buffer
+		n, err := test.m.MarshalBinaryTo(&w)
+		if err != nil {
+			t.Fatalf("%s: unexpected error marshaling to writer: %v", test.name, err)
+		}
+		if n != len(buf) || !bytes.Equal(w.Bytes(), buf) {
+			t.Errorf("%s: MarshalBinaryTo and MarshalBinary differ", test.name)
+		}
+		got = test.new()
+		n, err = got.UnmarshalBinaryFrom(&w)
+		if err != nil {
+			t.Fatalf("%s: unexpected error unmarshaling from reader: %v", test.name, err)
+		}
+		if n != len(buf) {
+			t.Errorf("%s: unexpected number of bytes read: got %d, want %d", test.name, n, len(buf))
+		}
+		if !eq(got, test.m) {
+			t.Errorf("%s: unexpected result after UnmarshalBinaryFrom", test.name)
+		}
+
+		// Check that truncated and padded input is rejected.
+		for _, bad := range [][]byte{buf[:len(buf)-1], append(buf[:len(buf):len(buf)], 0)} {
+			err = test.new().UnmarshalBinary(bad)
+			if err != errBadBuffer {
+				t.Errorf("%s: unexpected error for buffer of length %d: got %v, want %v", test.name, len(bad), err, errBadBuffer)
+			}
+		}
+		_, err = test.new().UnmarshalBinaryFrom(bytes.NewReader(buf[:len(buf)-1]))
+		if err != io.ErrUnexpectedEOF {
+			t.Errorf("%s: unexpected error for truncated reader: got %v, want %v", test.name, err, io.ErrUnexpectedEOF)
+		}
+	}
+}
+
+func TestStorageUnmarshalError(t *testing.T) {
+	dense, err := NewDense(2, 2, []float64{1, 2, 3, 4}).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, m := range []storageMatrix{
+		&SymDense{}, &TriDense{}, &BandDense{}, &SymBandDense{},
+		&TriBandDense{}, &DiagDense{}, &CDense{},
+	} {
+		if err := m.UnmarshalBinary(dense); err != errWrongType {
+			t.Errorf("%T: unexpected error for Dense data: got %v, want %v", m, err, errWrongType)
+		}
+		if err := m.UnmarshalBinary(dense[:headerSize-1]); err != errTooSmall {
+			t.Errorf("%T: unexpected error for short data: got %v, want %v", m, err, errTooSmall)
+		}
+	}
+
+	// A DiagDense can be read as a SymBandDense.
+	diag, err := NewDiagDense(2, []float64{1, 2}).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sb SymBandDense
+	if err := sb.UnmarshalBinary(diag); err != nil {
+		t.Errorf("unexpected error unmarshaling DiagDense as SymBandDense: %v", err)
+	}
+	if !Equal(&sb, NewDiagDense(2, []float64{1, 2})) {
+		t.Errorf("unexpected result unmarshaling DiagDense as SymBandDense")
+	}
+
+	// A band with a bandwidth larger than the matrix is rejected.
+	band, err := NewBandDense(2, 2, 1, 1, nil).MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	band[24] = 2 // kU
+	if err := new(BandDense).UnmarshalBinary(band); err != errBadSize {
+		t.Errorf("unexpected error for bad bandwidth: got %v, want %v", err, errBadSize)
+	}
+}
+
 func BenchmarkMarshalDense10(b *testing.B)    { marshalBinaryBenchDense(b, 10) }
 func BenchmarkMarshalDense100(b *testing.B)   { marshalBinaryBenchDense(b, 100) }
 func BenchmarkMarshalDense1000(b *testing.B)  { marshalBinaryBenchDense(b, 1000) }
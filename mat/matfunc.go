@@ -0,0 +1,416 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// Sqrt calculates the principal square root of the matrix a, that is, the
+// unique square root whose eigenvalues have positive real parts, placing the
+// result in the receiver. Sqrt will panic with ErrSquare if a is not square.
+//
+// The real principal square root exists if a has no eigenvalues on the
+// negative real axis, otherwise ErrNegativeEigen is returned. If a has a
+// repeated zero eigenvalue, the square root may not exist and a Condition error
+// is returned. If the Schur decomposition of a fails, ErrFailedEigen is
+// returned.
+func (m *Dense) Sqrt(a Matrix) error {
+	// The implementation used here is the real Schur method from Functions of
+	// Matrices: Theory and Computation, Chapter 6, Algorithm 6.7.
+	// https://doi.org/10.1137/1.9780898717778.ch6
+
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+
+	var s Schur
+	if !s.Factorize(a, true) {
+		return ErrFailedEigen
+	}
+	for _, v := range s.values {
+		if imag(v) == 0 && real(v) < 0 {
+			return ErrNegativeEigen
+		}
+	}
+
+	r := getWorkspace(n, n, true)
+	defer putWorkspace(r)
+	ok := sqrtQuasiTri(r, s.t)
+
+	m.reuseAs(n, n)
+	m.Product(s.z, r, s.z.T())
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// Log calculates the principal logarithm of the matrix a, that is, the unique
+// logarithm whose eigenvalues have imaginary parts in (-π, π), placing the
+// result in the receiver. Log will panic with ErrSquare if a is not square.
+//
+// The real principal logarithm exists if a has no eigenvalues on the closed
+// negative real axis. If a has a negative real eigenvalue, ErrNegativeEigen is
+// returned, and if a is singular, ErrSingular is returned. If the Schur
+// decomposition of a fails, ErrFailedEigen is returned.
+func (m *Dense) Log(a Matrix) error {
+	// The implementation used here is the inverse scaling and squaring
+	// method from Functions of Matrices: Theory and Computation, Chapter 11,
+	// Algorithm 11.10, applied to the real Schur form of a.
+	// https://doi.org/10.1137/1.9780898717778.ch11
+
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+
+	var s Schur
+	if !s.Factorize(a, true) {
+		return ErrFailedEigen
+	}
+	for _, v := range s.values {
+		if v == 0 {
+			return ErrSingular
+		}
+		if imag(v) == 0 && real(v) < 0 {
+			return ErrNegativeEigen
+		}
+	}
+
+	// Take square roots of T until it is close enough to the identity for
+	// the Padé approximant of log(I+X) to be accurate.
+	const theta = 0.25
+	x, k, ok := sqrtToIdentity(s.t, theta)
+	defer putWorkspace(x)
+
+	// Evaluate the [8/8] Padé approximant of log(I+X) in its partial fraction
+	// form, which is the 8-point Gauss-Legendre quadrature of
+	//  log(I+X) = \int_0^1 X (I + t*X)^-1 dt.
+	nodes := [...]float64{
+		0.0198550717512319, 0.1016667612931866, 0.2372337950418355, 0.4082826787521751,
+		0.5917173212478249, 0.7627662049581645, 0.8983332387068134, 0.9801449282487681,
+	}
+	weights := [...]float64{
+		0.0506142681451881, 0.1111905172266872, 0.1568533229389436, 0.1813418916891810,
+		0.1813418916891810, 0.1568533229389436, 0.1111905172266872, 0.0506142681451881,
+	}
+	l := getWorkspace(n, n, true)
+	defer putWorkspace(l)
+	w := getWorkspace(n, n, false)
+	defer putWorkspace(w)
+	y := getWorkspace(n, n, false)
+	defer putWorkspace(y)
+	for i, node := range nodes {
+		w.Scale(node, x)
+		for j := 0; j < n; j++ {
+			w.set(j, j, w.at(j, j)+1)
+		}
+		y.Solve(w, x)
+		y.Scale(weights[i], y)
+		l.Add(l, y)
+	}
+	l.Scale(math.Ldexp(1, k), l)
+
+	m.reuseAs(n, n)
+	m.Product(s.z, l, s.z.T())
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// PowReal calculates the real power of the matrix a to p, placing the result
+// in the receiver. For non-integer p, the result is the principal power
+//  a^p = exp(p * log(a)).
+// PowReal will panic with ErrSquare if a is not square.
+//
+// If p is integer-valued, the result is computed by repeated multiplication
+// and, if p is negative, inversion of a, in which case a Condition error is
+// returned if a is near-singular. Otherwise, if a has a negative real
+// eigenvalue, ErrNegativeEigen is returned, and if a is singular, ErrSingular
+// is returned. If the Schur decomposition of a fails, ErrFailedEigen is
+// returned.
+func (m *Dense) PowReal(a Matrix, p float64) error {
+	// The implementation used here is the Schur-Padé algorithm from
+	// N. J. Higham and L. Lin, A Schur-Padé algorithm for fractional powers
+	// of a matrix, SIAM J. Matrix Anal. Appl. 32(3), 2011.
+	// https://doi.org/10.1137/10081232X
+
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+
+	ip, fp := math.Modf(p)
+	if fp == 0 {
+		if p >= 0 {
+			m.Pow(a, int(p))
+			return nil
+		}
+		var inv Dense
+		err := inv.Inverse(a)
+		m.Pow(&inv, int(-p))
+		return err
+	}
+
+	var s Schur
+	if !s.Factorize(a, true) {
+		return ErrFailedEigen
+	}
+	for _, v := range s.values {
+		if v == 0 {
+			return ErrSingular
+		}
+		if imag(v) == 0 && real(v) < 0 {
+			return ErrNegativeEigen
+		}
+	}
+
+	// Keep T for the integer part of the power.
+	t := getWorkspace(n, n, false)
+	defer putWorkspace(t)
+	t.Copy(s.t)
+
+	// Compute T^fp = (T^(1/2^k))^(fp*2^k) using the [7/7] Padé approximant
+	// of (1-X)^fp where X = I - T^(1/2^k) is small enough.
+	const theta = 0.279
+	x, k, ok := sqrtToIdentity(s.t, theta)
+	defer putWorkspace(x)
+	x.Scale(-1, x)
+	r := getWorkspace(n, n, false)
+	defer putWorkspace(r)
+	padeFracPow(r, x, fp, 7)
+	for ; k > 0; k-- {
+		r.Mul(r, r)
+	}
+
+	// Multiply by the integer power of T.
+	if ip != 0 {
+		tk := getWorkspace(n, n, false)
+		defer putWorkspace(tk)
+		tk.Pow(t, int(math.Abs(ip)))
+		if ip > 0 {
+			r.Mul(tk, r)
+		} else {
+			tmp := getWorkspace(n, n, false)
+			defer putWorkspace(tmp)
+			err := tmp.Solve(tk, r)
+			if err != nil {
+				ok = false
+			}
+			r.Copy(tmp)
+		}
+	}
+
+	m.reuseAs(n, n)
+	m.Product(s.z, r, s.z.T())
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// Sign calculates the matrix sign function of the matrix a, placing the result
+// in the receiver. The sign of a is the square root of the identity that has
+// the same invariant subspaces as a, with eigenvalue -1 for eigenvalues of a
+// with negative real part and 1 for eigenvalues with positive real part. Sign
+// will panic with ErrSquare if a is not square.
+//
+// The sign function is defined only if a has no eigenvalues on the imaginary
+// axis, otherwise ErrImaginaryEigen is returned. If the Schur decomposition of
+// a fails, ErrFailedEigen is returned.
+func (m *Dense) Sign(a Matrix) error {
+	// The implementation used here is the Schur method from Functions of
+	// Matrices: Theory and Computation, Chapter 5, Algorithm 5.5, with the
+	// Schur form reordered so that the diagonal blocks of the sign function
+	// are -I and I.
+	// https://doi.org/10.1137/1.9780898717778.ch5
+
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+
+	var s Schur
+	if !s.Factorize(a, true) {
+		return ErrFailedEigen
+	}
+	const eps = 1.0 / (1 << 53)
+	tol := float64(n) * eps * Norm(s.t, 1)
+	selected := make([]bool, n)
+	for i, v := range s.values {
+		if math.Abs(real(v)) <= tol {
+			return ErrImaginaryEigen
+		}
+		selected[i] = real(v) < 0
+	}
+	k, ok := s.Reorder(selected)
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+
+	// With T = [T11 T12; 0 T22], where T11 is k×k and holds the eigenvalues
+	// with negative real part, the sign of T is [-I Y; 0 I] where
+	//  T11 * Y - Y * T22 = -2 * T12.
+	sign := getWorkspace(n, n, true)
+	defer putWorkspace(sign)
+	for i := 0; i < n; i++ {
+		if i < k {
+			sign.set(i, i, -1)
+		} else {
+			sign.set(i, i, 1)
+		}
+	}
+	if 0 < k && k < n {
+		y := sign.Slice(0, k, k, n).(*Dense)
+		y.Scale(-2, s.t.Slice(0, k, k, n))
+		t11 := s.t.Slice(0, k, 0, k).(*Dense)
+		t22 := s.t.Slice(k, n, k, n).(*Dense)
+		scale, okSyl := lapack64.Trsyl(blas.NoTrans, blas.NoTrans, -1, t11.mat, t22.mat, y.mat)
+		if scale != 1 {
+			y.Scale(1/scale, y)
+		}
+		ok = okSyl
+	}
+
+	m.reuseAs(n, n)
+	m.Product(s.z, sign, s.z.T())
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// sqrtQuasiTri computes the principal square root r of the upper
+// quasi-triangular matrix t in Schur canonical form. t must not have negative
+// real eigenvalues and r must be a zeroed matrix of the same size as t. The
+// square root is upper quasi-triangular in Schur canonical form with the same
+// block structure as t. sqrtQuasiTri returns false if the square root could
+// only be computed using perturbed values.
+func sqrtQuasiTri(r, t *Dense) (ok bool) {
+	n := t.mat.Rows
+	blocks := schurBlockStarts(t.mat)
+	ok = true
+	for k, j0 := range blocks {
+		j1 := n
+		if k < len(blocks)-1 {
+			j1 = blocks[k+1]
+		}
+
+		// Compute the square root of the diagonal block.
+		if j1-j0 == 1 {
+			r.set(j0, j0, math.Sqrt(t.at(j0, j0)))
+		} else {
+			// The block [a b; c a] with b*c < 0 has the eigenvalues
+			// a ± i*mu and its square root is alpha*I + (T_jj - a*I)/(2*alpha)
+			// where alpha + i*beta is the principal square root of a + i*mu.
+			a := t.at(j0, j0)
+			b := t.at(j0, j0+1)
+			c := t.at(j0+1, j0)
+			mu := math.Sqrt(math.Abs(b)) * math.Sqrt(math.Abs(c))
+			var alpha float64
+			if a >= 0 {
+				alpha = math.Sqrt((a + math.Hypot(a, mu)) / 2)
+			} else {
+				beta := math.Sqrt((math.Hypot(a, mu) - a) / 2)
+				alpha = mu / (2 * beta)
+			}
+			r.set(j0, j0, alpha)
+			r.set(j0+1, j0+1, alpha)
+			r.set(j0, j0+1, b/(2*alpha))
+			r.set(j0+1, j0, c/(2*alpha))
+		}
+		if j0 == 0 {
+			continue
+		}
+
+		// Solve R[:j0,:j0] * R[:j0,j0:j1] + R[:j0,j0:j1] * R[j0:j1,j0:j1] = T[:j0,j0:j1]
+		// for the block column above the diagonal block.
+		rc := r.Slice(0, j0, j0, j1).(*Dense)
+		rc.Copy(t.Slice(0, j0, j0, j1))
+		r11 := r.Slice(0, j0, 0, j0).(*Dense)
+		r22 := r.Slice(j0, j1, j0, j1).(*Dense)
+		scale, okSyl := lapack64.Trsyl(blas.NoTrans, blas.NoTrans, 1, r11.mat, r22.mat, rc.mat)
+		if scale != 1 {
+			rc.Scale(1/scale, rc)
+		}
+		if !okSyl {
+			ok = false
+		}
+	}
+	return ok
+}
+
+// sqrtToIdentity repeatedly replaces the upper quasi-triangular matrix t in
+// Schur canonical form by its principal square root until the 1-norm of
+// t - I is at most theta. It returns t - I in a workspace matrix, the number
+// of square roots taken and whether all square roots were computed without
+// perturbation. The values in t are destroyed.
+func sqrtToIdentity(t *Dense, theta float64) (x *Dense, k int, ok bool) {
+	n := t.mat.Rows
+	x = getWorkspace(n, n, false)
+	r := getWorkspace(n, n, false)
+	defer putWorkspace(r)
+	ok = true
+	for {
+		x.Copy(t)
+		for i := 0; i < n; i++ {
+			x.set(i, i, x.at(i, i)-1)
+		}
+		if Norm(x, 1) <= theta {
+			return x, k, ok
+		}
+		r.Zero()
+		if !sqrtQuasiTri(r, t) {
+			ok = false
+		}
+		t.Copy(r)
+		k++
+	}
+}
+
+// padeFracPow stores in dst the [deg/deg] Padé approximant of (I-X)^p
+// evaluated bottom-up from its continued fraction representation
+//  1 + c_1*x/(1 + c_2*x/(1 + ... c_{2*deg}*x)),
+// where c_1 = -p and
+//  c_{2j} = (p - j)/(2*(2j-1)),
+//  c_{2j+1} = (-p - j)/(2*(2j+1)).
+func padeFracPow(dst, x *Dense, p float64, deg int) {
+	n := x.mat.Rows
+	coef := func(i int) float64 {
+		if i == 1 {
+			return -p
+		}
+		j := float64(i / 2)
+		if i%2 == 0 {
+			return (p - j) / (2 * (2*j - 1))
+		}
+		return (-p - j) / (2 * (2*j + 1))
+	}
+
+	w := getWorkspace(n, n, false)
+	defer putWorkspace(w)
+	cx := getWorkspace(n, n, false)
+	defer putWorkspace(cx)
+
+	dst.reuseAs(n, n)
+	dst.Scale(coef(2*deg), x)
+	for i := 2*deg - 1; i >= 1; i-- {
+		w.Copy(dst)
+		for j := 0; j < n; j++ {
+			w.set(j, j, w.at(j, j)+1)
+		}
+		cx.Scale(coef(i), x)
+		dst.Solve(w, cx)
+	}
+	for j := 0; j < n; j++ {
+		dst.set(j, j, dst.at(j, j)+1)
+	}
+}
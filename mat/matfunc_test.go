@@ -0,0 +1,253 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// matFuncTestMatrices returns random n×n test matrices whose eigenvalues are
+// not on the closed negative real axis. The first is shifted to have all its
+// eigenvalues in the right half-plane, the second has complex eigenvalues in
+// the left half-plane and the third is a non-normal upper triangular matrix.
+func matFuncTestMatrices(n int, rnd *rand.Rand) []*Dense {
+	a := randNormDense(n, rnd)
+	shift := Norm(a, math.Inf(1)) + 1
+	for i := 0; i < n; i++ {
+		a.set(i, i, a.at(i, i)+shift)
+	}
+
+	// Block diagonal matrix of rotations by 2π/3 scaled by 2 and conjugated
+	// by a random well-conditioned matrix.
+	rot := NewDense(n, n, nil)
+	s, c := math.Sincos(2 * math.Pi / 3)
+	for i := 0; i < n-1; i += 2 {
+		rot.set(i, i, 2*c)
+		rot.set(i, i+1, -2*s)
+		rot.set(i+1, i, 2*s)
+		rot.set(i+1, i+1, 2*c)
+	}
+	if n%2 == 1 {
+		rot.set(n-1, n-1, 0.5)
+	}
+	v := randNormDense(n, rnd)
+	v.Scale(0.1/math.Sqrt(float64(n)), v)
+	for i := 0; i < n; i++ {
+		v.set(i, i, v.at(i, i)+1)
+	}
+	var vinv, b Dense
+	vinv.Inverse(v)
+	b.Product(v, rot, &vinv)
+
+	tri := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		tri.set(i, i, 0.5+rnd.Float64())
+		for j := i + 1; j < n; j++ {
+			tri.set(i, j, rnd.NormFloat64())
+		}
+	}
+	return []*Dense{a, &b, tri}
+}
+
+func TestDenseSqrt(t *testing.T) {
+	const tol = 1e-10
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for cas, a := range matFuncTestMatrices(n, rnd) {
+			name := fmt.Sprintf("n=%d,case=%d", n, cas)
+
+			var x Dense
+			err := x.Sqrt(a)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			var x2 Dense
+			x2.Mul(&x, &x)
+			if !EqualApprox(&x2, a, tol*Norm(a, 1)) {
+				t.Errorf("%s: X*X != A", name)
+			}
+			var eig Eigen
+			if !eig.Factorize(&x, false, false) {
+				t.Errorf("%s: unexpected eigendecomposition failure", name)
+				continue
+			}
+			for _, v := range eig.Values(nil) {
+				if real(v) <= 0 {
+					t.Errorf("%s: square root not principal: eigenvalue %v", name, v)
+					break
+				}
+			}
+		}
+	}
+
+	var x Dense
+	if err := x.Sqrt(NewDense(2, 2, []float64{-1, 1, 0, 2})); err != ErrNegativeEigen {
+		t.Errorf("unexpected error for negative eigenvalue: got %v, want %v", err, ErrNegativeEigen)
+	}
+}
+
+func TestDenseLog(t *testing.T) {
+	const tol = 1e-10
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for cas, a := range matFuncTestMatrices(n, rnd) {
+			name := fmt.Sprintf("n=%d,case=%d", n, cas)
+
+			var l Dense
+			err := l.Log(a)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			var el Dense
+			el.Exp(&l)
+			if !EqualApprox(&el, a, tol*Norm(a, 1)) {
+				t.Errorf("%s: exp(log(A)) != A", name)
+			}
+		}
+
+		// The logarithm of the exponential of a matrix with eigenvalues
+		// whose imaginary parts are in (-π, π) is the matrix itself.
+		b := randNormDense(n, rnd)
+		b.Scale(1/Norm(b, 2), b)
+		var eb, l Dense
+		eb.Exp(b)
+		err := l.Log(&eb)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		if !EqualApprox(&l, b, tol) {
+			t.Errorf("n=%d: log(exp(B)) != B", n)
+		}
+	}
+
+	var l Dense
+	if err := l.Log(NewDense(2, 2, []float64{-1, 1, 0, 2})); err != ErrNegativeEigen {
+		t.Errorf("unexpected error for negative eigenvalue: got %v, want %v", err, ErrNegativeEigen)
+	}
+	if err := l.Log(NewDense(2, 2, []float64{0, 1, 0, 2})); err != ErrSingular {
+		t.Errorf("unexpected error for singular matrix: got %v, want %v", err, ErrSingular)
+	}
+}
+
+func TestDensePowReal(t *testing.T) {
+	const tol = 1e-10
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		for cas, a := range matFuncTestMatrices(n, rnd) {
+			name := fmt.Sprintf("n=%d,case=%d", n, cas)
+
+			// Check that A^(1/2) is the principal square root.
+			var half, sqrt Dense
+			if err := half.PowReal(a, 0.5); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			sqrt.Sqrt(a)
+			if !EqualApprox(&half, &sqrt, tol*Norm(&sqrt, 1)) {
+				t.Errorf("%s: A^(1/2) != sqrt(A)", name)
+			}
+
+			// Check that (A^(1/3))^3 = A.
+			var third, cube Dense
+			if err := third.PowReal(a, 1.0/3); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			cube.Pow(&third, 3)
+			if !EqualApprox(&cube, a, tol*Norm(a, 1)) {
+				t.Errorf("%s: (A^(1/3))^3 != A", name)
+			}
+
+			// Check that A^-2.7 * A^2.7 = I.
+			var pos, neg, prod Dense
+			if err := pos.PowReal(a, 2.7); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if err := neg.PowReal(a, -2.7); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			prod.Mul(&neg, &pos)
+			if !EqualApprox(&prod, eye(n), tol*Norm(&pos, 1)*Norm(&neg, 1)) {
+				t.Errorf("%s: A^-2.7 * A^2.7 != I", name)
+			}
+
+			// Check integer powers.
+			var got, want Dense
+			got.PowReal(a, 3)
+			want.Pow(a, 3)
+			if !Equal(&got, &want) {
+				t.Errorf("%s: A^3 differs from Pow", name)
+			}
+			got.PowReal(a, -1)
+			want.Inverse(a)
+			if !Equal(&got, &want) {
+				t.Errorf("%s: A^-1 differs from Inverse", name)
+			}
+		}
+	}
+
+	var x Dense
+	if err := x.PowReal(NewDense(2, 2, []float64{-1, 1, 0, 2}), 0.5); err != ErrNegativeEigen {
+		t.Errorf("unexpected error for negative eigenvalue: got %v, want %v", err, ErrNegativeEigen)
+	}
+}
+
+func TestDenseSign(t *testing.T) {
+	const tol = 1e-10
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		a := randNormDense(n, rnd)
+		var s Dense
+		err := s.Sign(a)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+
+		// Check that S is a square root of the identity that commutes
+		// with A and that its trace is the number of eigenvalues of A in
+		// the right half-plane minus those in the left half-plane.
+		var s2, sa, as Dense
+		s2.Mul(&s, &s)
+		if !EqualApprox(&s2, eye(n), tol*Norm(&s, 1)*Norm(&s, 1)) {
+			t.Errorf("n=%d: S*S != I", n)
+		}
+		sa.Mul(&s, a)
+		as.Mul(a, &s)
+		if !EqualApprox(&sa, &as, tol*Norm(&s, 1)*Norm(a, 1)) {
+			t.Errorf("n=%d: S*A != A*S", n)
+		}
+		var eig Eigen
+		if !eig.Factorize(a, false, false) {
+			t.Errorf("n=%d: unexpected eigendecomposition failure", n)
+			continue
+		}
+		var want float64
+		for _, v := range eig.Values(nil) {
+			want += math.Copysign(1, real(v))
+		}
+		if got := Trace(&s); math.Abs(got-want) > tol*float64(n) {
+			t.Errorf("n=%d: unexpected trace of S: got %v, want %v", n, got, want)
+		}
+	}
+
+	var s Dense
+	if err := s.Sign(NewDense(2, 2, []float64{0, 1, -1, 0})); err != ErrImaginaryEigen {
+		t.Errorf("unexpected error for imaginary eigenvalues: got %v, want %v", err, ErrImaginaryEigen)
+	}
+}
@@ -0,0 +1,403 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// MatrixMarketFormat specifies the storage format of a Matrix Market file.
+type MatrixMarketFormat int
+
+const (
+	// MatrixMarketArray stores all the elements of a matrix in
+	// column-major order.
+	MatrixMarketArray MatrixMarketFormat = iota
+	// MatrixMarketCoordinate stores the row index, column index and
+	// value of the non-zero elements of a matrix.
+	MatrixMarketCoordinate
+)
+
+var (
+	errMMHeader   = errors.New("mat: invalid Matrix Market header")
+	errMMComplex  = errors.New("mat: complex Matrix Market data")
+	errMMFormat   = errors.New("mat: invalid Matrix Market format")
+	errMMTooFew   = errors.New("mat: too few Matrix Market entries")
+	errMMTooMany  = errors.New("mat: too many Matrix Market entries")
+	errMMBadIndex = errors.New("mat: Matrix Market index out of range")
+)
+
+// mmHeader holds the banner and size information of a Matrix Market file.
+type mmHeader struct {
+	format   MatrixMarketFormat
+	field    string // One of "real", "integer", "complex" or "pattern".
+	symmetry string // One of "general", "symmetric", "skew-symmetric" or "hermitian".
+
+	rows, cols, nnz int
+}
+
+// ReadMatrixMarket reads a real matrix in the Matrix Market exchange format
+// from r. Both the array and the coordinate formats are accepted, with real,
+// integer or pattern data. Entries of a pattern matrix have the value 1.
+//
+// A matrix with symmetric storage is returned as a *SymDense and a general or
+// skew-symmetric matrix is returned as a *Dense. ReadMatrixMarket returns an
+// error if the file holds complex data, which can be read with
+// ReadCMatrixMarket.
+func ReadMatrixMarket(r io.Reader) (Matrix, error) {
+	var (
+		m   Matrix
+		set func(i, j int, v complex128)
+	)
+	err := readMatrixMarket(r, func(h *mmHeader) error {
+		if h.field == "complex" {
+			return errMMComplex
+		}
+		switch h.symmetry {
+		case "symmetric":
+			s := NewSymDense(h.rows, nil)
+			m = s
+			set = func(i, j int, v complex128) { s.SetSym(i, j, real(v)) }
+		case "skew-symmetric":
+			d := NewDense(h.rows, h.cols, nil)
+			m = d
+			set = func(i, j int, v complex128) {
+				d.set(i, j, real(v))
+				d.set(j, i, -real(v))
+			}
+		default:
+			d := NewDense(h.rows, h.cols, nil)
+			m = d
+			set = func(i, j int, v complex128) { d.set(i, j, real(v)) }
+		}
+		return nil
+	}, func(i, j int, v complex128) { set(i, j, v) })
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReadCMatrixMarket reads a matrix in the Matrix Market exchange format from
+// r and returns it as a *CDense. Both the array and the coordinate formats
+// are accepted, with any data field and symmetry. Entries of a pattern matrix
+// have the value 1.
+func ReadCMatrixMarket(r io.Reader) (*CDense, error) {
+	var m *CDense
+	var symmetry string
+	err := readMatrixMarket(r, func(h *mmHeader) error {
+		m = NewCDense(h.rows, h.cols, nil)
+		symmetry = h.symmetry
+		return nil
+	}, func(i, j int, v complex128) {
+		m.set(i, j, v)
+		switch symmetry {
+		case "symmetric":
+			m.set(j, i, v)
+		case "skew-symmetric":
+			m.set(j, i, -v)
+		case "hermitian":
+			m.set(j, i, complex(real(v), -imag(v)))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// readMatrixMarket reads a Matrix Market file from r. After reading the
+// header, alloc is called to allocate the destination matrix and set is then
+// called for each stored entry with zero-based indices.
+func readMatrixMarket(r io.Reader, alloc func(*mmHeader) error, set func(i, j int, v complex128)) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+
+	if !sc.Scan() {
+		if err := sc.Err(); err != nil {
+			return err
+		}
+		return errMMHeader
+	}
+	banner := strings.Fields(strings.ToLower(sc.Text()))
+	if len(banner) != 5 || banner[0] != "%%matrixmarket" || banner[1] != "matrix" {
+		return errMMHeader
+	}
+	var h mmHeader
+	switch banner[2] {
+	case "array":
+		h.format = MatrixMarketArray
+	case "coordinate":
+		h.format = MatrixMarketCoordinate
+	default:
+		return errMMHeader
+	}
+	h.field = banner[3]
+	switch h.field {
+	case "real", "integer", "complex":
+	case "pattern":
+		if h.format == MatrixMarketArray {
+			return errMMHeader
+		}
+	default:
+		return errMMHeader
+	}
+	h.symmetry = banner[4]
+	switch h.symmetry {
+	case "general", "symmetric", "skew-symmetric":
+	case "hermitian":
+		if h.field != "complex" {
+			return errMMHeader
+		}
+	default:
+		return errMMHeader
+	}
+
+	// nextLine returns the fields of the next line that is neither empty
+	// nor a comment.
+	nextLine := func() ([]string, error) {
+		for sc.Scan() {
+			line := strings.TrimSpace(sc.Text())
+			if line == "" || line[0] == '%' {
+				continue
+			}
+			return strings.Fields(line), nil
+		}
+		return nil, sc.Err()
+	}
+
+	size, err := nextLine()
+	if err != nil {
+		return err
+	}
+	wantSize := 3
+	if h.format == MatrixMarketArray {
+		wantSize = 2
+	}
+	if len(size) != wantSize {
+		return errMMFormat
+	}
+	dims, err := parseInts(size)
+	if err != nil {
+		return err
+	}
+	h.rows, h.cols = dims[0], dims[1]
+	if h.rows <= 0 || h.cols <= 0 {
+		return errBadSize
+	}
+	if int64(h.rows)*int64(h.cols) > maxLen {
+		return errTooBig
+	}
+	if h.symmetry != "general" && h.rows != h.cols {
+		return errMMFormat
+	}
+	if h.format == MatrixMarketCoordinate {
+		h.nnz = dims[2]
+		if h.nnz < 0 {
+			return errMMFormat
+		}
+	} else {
+		switch h.symmetry {
+		case "general":
+			h.nnz = h.rows * h.cols
+		case "skew-symmetric":
+			h.nnz = h.rows * (h.rows - 1) / 2
+		default:
+			h.nnz = h.rows * (h.rows + 1) / 2
+		}
+	}
+
+	if err := alloc(&h); err != nil {
+		return err
+	}
+
+	nvals := 1
+	switch h.field {
+	case "complex":
+		nvals = 2
+	case "pattern":
+		nvals = 0
+	}
+
+	// i and j hold the position of the next entry of an array file, which
+	// lists the columns of the stored triangle in column-major order.
+	var i, j int
+	if h.symmetry == "skew-symmetric" {
+		i = 1
+	}
+	for k := 0; k < h.nnz; k++ {
+		fields, err := nextLine()
+		if err != nil {
+			return err
+		}
+		if fields == nil {
+			return errMMTooFew
+		}
+		if h.format == MatrixMarketCoordinate {
+			if len(fields) != 2+nvals {
+				return errMMFormat
+			}
+			idx, err := parseInts(fields[:2])
+			if err != nil {
+				return err
+			}
+			i, j = idx[0]-1, idx[1]-1
+			if i < 0 || h.rows <= i || j < 0 || h.cols <= j {
+				return errMMBadIndex
+			}
+			if h.symmetry == "skew-symmetric" && i == j {
+				return errMMBadIndex
+			}
+			fields = fields[2:]
+		} else if len(fields) != nvals {
+			return errMMFormat
+		}
+
+		v := complex(1, 0)
+		if nvals > 0 {
+			re, err := strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				return err
+			}
+			var im float64
+			if nvals == 2 {
+				im, err = strconv.ParseFloat(fields[1], 64)
+				if err != nil {
+					return err
+				}
+			}
+			v = complex(re, im)
+		}
+		set(i, j, v)
+
+		if h.format == MatrixMarketArray {
+			i++
+			if i == h.rows {
+				j++
+				switch h.symmetry {
+				case "general":
+					i = 0
+				case "skew-symmetric":
+					i = j + 1
+				default:
+					i = j
+				}
+			}
+		}
+	}
+	fields, err := nextLine()
+	if err != nil {
+		return err
+	}
+	if fields != nil {
+		return errMMTooMany
+	}
+	return nil
+}
+
+// parseInts parses each element of s as a decimal integer.
+func parseInts(s []string) ([]int, error) {
+	v := make([]int, len(s))
+	for i, f := range s {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, err
+		}
+		v[i] = n
+	}
+	return v, nil
+}
+
+// WriteMatrixMarket writes m to w in the Matrix Market exchange format using
+// the given storage format. The elements are written as real values.
+//
+// If m is a Symmetric matrix, only its lower triangle is written and the file
+// is marked as symmetric. Otherwise all elements of m are written, with zero
+// elements omitted in the coordinate format.
+func WriteMatrixMarket(w io.Writer, m Matrix, format MatrixMarketFormat) error {
+	r, c := m.Dims()
+	_, sym := m.(Symmetric)
+	symmetry := "general"
+	if sym {
+		symmetry = "symmetric"
+	}
+	return writeMatrixMarket(w, format, "real", symmetry, r, c, func(i, j int) complex128 {
+		return complex(m.At(i, j), 0)
+	})
+}
+
+// WriteCMatrixMarket writes m to w in the Matrix Market exchange format using
+// the given storage format. All elements of m are written as complex values,
+// with zero elements omitted in the coordinate format.
+func WriteCMatrixMarket(w io.Writer, m CMatrix, format MatrixMarketFormat) error {
+	r, c := m.Dims()
+	return writeMatrixMarket(w, format, "complex", "general", r, c, m.At)
+}
+
+// writeMatrixMarket writes the r×c matrix with elements given by at to w.
+// For a symmetric matrix only the lower triangle is written.
+func writeMatrixMarket(w io.Writer, format MatrixMarketFormat, field, symmetry string, r, c int, at func(i, j int) complex128) error {
+	var name string
+	switch format {
+	case MatrixMarketArray:
+		name = "array"
+	case MatrixMarketCoordinate:
+		name = "coordinate"
+	default:
+		return errMMFormat
+	}
+	first := func(j int) int {
+		if symmetry == "symmetric" {
+			return j
+		}
+		return 0
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%%%%MatrixMarket matrix %s %s %s\n", name, field, symmetry)
+	if format == MatrixMarketArray {
+		fmt.Fprintf(bw, "%d %d\n", r, c)
+	} else {
+		var nnz int
+		for j := 0; j < c; j++ {
+			for i := first(j); i < r; i++ {
+				if at(i, j) != 0 {
+					nnz++
+				}
+			}
+		}
+		fmt.Fprintf(bw, "%d %d %d\n", r, c, nnz)
+	}
+
+	buf := make([]byte, 0, 64)
+	for j := 0; j < c; j++ {
+		for i := first(j); i < r; i++ {
+			v := at(i, j)
+			buf = buf[:0]
+			if format == MatrixMarketCoordinate {
+				if v == 0 {
+					continue
+				}
+				buf = strconv.AppendInt(buf, int64(i+1), 10)
+				buf = append(buf, ' ')
+				buf = strconv.AppendInt(buf, int64(j+1), 10)
+				buf = append(buf, ' ')
+			}
+			buf = strconv.AppendFloat(buf, real(v), 'g', -1, 64)
+			if field == "complex" {
+				buf = append(buf, ' ')
+				buf = strconv.AppendFloat(buf, imag(v), 'g', -1, 64)
+			}
+			buf = append(buf, '\n')
+			bw.Write(buf)
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,235 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMatrixMarketRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		m    Matrix
+		want Matrix
+	}{
+		{
+			name: "Dense",
+			m:    NewDense(2, 3, []float64{1, 0, -2.5, 0, 4e-300, 6}),
+		},
+		{
+			name: "SymDense",
+			m:    NewSymDense(3, []float64{1, 2, 3, 2, 0, 5, 3, 5, 6}),
+		},
+		{
+			name: "TriDense",
+			m:    NewTriDense(3, Upper, []float64{1, 2, 3, 0, 4, 5, 0, 0, 6}),
+			want: NewDense(3, 3, []float64{1, 2, 3, 0, 4, 5, 0, 0, 6}),
+		},
+		{
+			name: "VecDense",
+			m:    NewVecDense(4, []float64{1, 0, 0.1, -7}),
+			want: NewDense(4, 1, []float64{1, 0, 0.1, -7}),
+		},
+	} {
+		want := test.want
+		if want == nil {
+			want = test.m
+		}
+		for _, format := range []MatrixMarketFormat{MatrixMarketArray, MatrixMarketCoordinate} {
+			var buf bytes.Buffer
+			err := WriteMatrixMarket(&buf, test.m, format)
+			if err != nil {
+				t.Fatalf("%s format %d: unexpected error writing: %v", test.name, format, err)
+			}
+			got, err := ReadMatrixMarket(&buf)
+			if err != nil {
+				t.Fatalf("%s format %d: unexpected error reading: %v", test.name, format, err)
+			}
+			_, gotSym := got.(*SymDense)
+			_, wantSym := want.(Symmetric)
+			if gotSym != wantSym {
+				t.Errorf("%s format %d: unexpected type %T", test.name, format, got)
+			}
+			if !Equal(got, want) {
+				t.Errorf("%s format %d: unexpected result:\ngot: %v\nwant:%v", test.name, format, Formatted(got), Formatted(want))
+			}
+		}
+	}
+}
+
+func TestCMatrixMarketRoundTrip(t *testing.T) {
+	m := NewCDense(2, 3, []complex128{1 + 2i, 0, -3i, 4, 0.5 - 0.25i, 0})
+	for _, format := range []MatrixMarketFormat{MatrixMarketArray, MatrixMarketCoordinate} {
+		var buf bytes.Buffer
+		err := WriteCMatrixMarket(&buf, m, format)
+		if err != nil {
+			t.Fatalf("format %d: unexpected error writing: %v", format, err)
+		}
+		got, err := ReadCMatrixMarket(&buf)
+		if err != nil {
+			t.Fatalf("format %d: unexpected error reading: %v", format, err)
+		}
+		if !CEqual(got, m) {
+			t.Errorf("format %d: unexpected result: got %v, want %v", format, got.mat.Data, m.mat.Data)
+		}
+	}
+}
+
+func TestReadMatrixMarket(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		file string
+		want Matrix
+	}{
+		{
+			name: "array real general",
+			file: `%%MatrixMarket matrix array real general
+% A comment.
+2 2
+1
+3
+2
+4
+`,
+			want: NewDense(2, 2, []float64{1, 2, 3, 4}),
+		},
+		{
+			name: "array integer symmetric",
+			file: `%%MatrixMarket matrix array integer symmetric
+3 3
+1
+2
+3
+4
+5
+6
+`,
+			want: NewSymDense(3, []float64{1, 2, 3, 2, 4, 5, 3, 5, 6}),
+		},
+		{
+			name: "array real skew-symmetric",
+			file: `%%MatrixMarket matrix array real skew-symmetric
+3 3
+1
+2
+3
+`,
+			want: NewDense(3, 3, []float64{0, -1, -2, 1, 0, -3, 2, 3, 0}),
+		},
+		{
+			name: "coordinate real general",
+			file: `%%MATRIXMARKET Matrix Coordinate Real General
+%
+3 2 3
+
+  1 1 1.5e+00
+3 2 -2
+2 1 0.25
+`,
+			want: NewDense(3, 2, []float64{1.5, 0, 0.25, 0, 0, -2}),
+		},
+		{
+			name: "coordinate pattern symmetric",
+			file: `%%MatrixMarket matrix coordinate pattern symmetric
+3 3 3
+1 1
+3 1
+3 2
+`,
+			want: NewSymDense(3, []float64{1, 0, 1, 0, 0, 1, 1, 1, 0}),
+		},
+		{
+			name: "coordinate integer skew-symmetric",
+			file: `%%MatrixMarket matrix coordinate integer skew-symmetric
+2 2 1
+2 1 7
+`,
+			want: NewDense(2, 2, []float64{0, -7, 7, 0}),
+		},
+	} {
+		got, err := ReadMatrixMarket(strings.NewReader(test.file))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !Equal(got, test.want) {
+			t.Errorf("%s: unexpected result:\ngot: %v\nwant:%v", test.name, Formatted(got), Formatted(test.want))
+		}
+	}
+}
+
+func TestReadCMatrixMarket(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		file string
+		want *CDense
+	}{
+		{
+			name: "coordinate complex hermitian",
+			file: `%%MatrixMarket matrix coordinate complex hermitian
+2 2 2
+1 1 1 0
+2 1 2 3
+`,
+			want: NewCDense(2, 2, []complex128{1, 2 - 3i, 2 + 3i, 0}),
+		},
+		{
+			name: "array complex symmetric",
+			file: `%%MatrixMarket matrix array complex symmetric
+2 2
+1 1
+2 2
+3 3
+`,
+			want: NewCDense(2, 2, []complex128{1 + 1i, 2 + 2i, 2 + 2i, 3 + 3i}),
+		},
+		{
+			name: "array real general",
+			file: `%%MatrixMarket matrix array real general
+1 2
+1
+-2
+`,
+			want: NewCDense(1, 2, []complex128{1, -2}),
+		},
+	} {
+		got, err := ReadCMatrixMarket(strings.NewReader(test.file))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !CEqual(got, test.want) {
+			t.Errorf("%s: unexpected result: got %v, want %v", test.name, got.mat.Data, test.want.mat.Data)
+		}
+	}
+}
+
+func TestReadMatrixMarketError(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		file string
+	}{
+		{name: "empty", file: ""},
+		{name: "bad banner", file: "%%MatrixMarket tensor array real general\n1 1\n1\n"},
+		{name: "array pattern", file: "%%MatrixMarket matrix array pattern general\n1 1\n"},
+		{name: "real hermitian", file: "%%MatrixMarket matrix array real hermitian\n1 1\n1\n"},
+		{name: "complex", file: "%%MatrixMarket matrix array complex general\n1 1\n1 0\n"},
+		{name: "bad size", file: "%%MatrixMarket matrix array real general\n1\n1\n"},
+		{name: "zero size", file: "%%MatrixMarket matrix coordinate real general\n0 1 0\n"},
+		{name: "non-square symmetric", file: "%%MatrixMarket matrix array real symmetric\n2 1\n1\n2\n"},
+		{name: "too few", file: "%%MatrixMarket matrix array real general\n2 1\n1\n"},
+		{name: "too many", file: "%%MatrixMarket matrix array real general\n1 1\n1\n2\n"},
+		{name: "bad index", file: "%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n"},
+		{name: "skew diagonal", file: "%%MatrixMarket matrix coordinate real skew-symmetric\n2 2 1\n1 1 1\n"},
+		{name: "bad value", file: "%%MatrixMarket matrix array real general\n1 1\nx\n"},
+	} {
+		_, err := ReadMatrixMarket(strings.NewReader(test.file))
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
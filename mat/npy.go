@@ -0,0 +1,364 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// npyMagic is the magic string at the start of a NumPy .npy file.
+const npyMagic = "\x93NUMPY"
+
+var (
+	errNPYHeader = errors.New("mat: invalid NumPy header")
+	errNPYDtype  = errors.New("mat: unsupported NumPy data type")
+	errNPYShape  = errors.New("mat: unsupported NumPy array shape")
+
+	npyDescr   = regexp.MustCompile(`['"]descr['"]\s*:\s*['"]([^'"]*)['"]`)
+	npyFortran = regexp.MustCompile(`['"]fortran_order['"]\s*:\s*(True|False)`)
+	npyShape   = regexp.MustCompile(`['"]shape['"]\s*:\s*\(([^)]*)\)`)
+)
+
+// npyHeader holds the description of the array stored in a .npy file.
+type npyHeader struct {
+	order   binary.ByteOrder
+	kind    byte // 'f' for floating point or 'c' for complex.
+	size    int  // Size of an element in bytes.
+	fortran bool
+	shape   []int
+}
+
+// ReadNPY reads an array in the NumPy .npy format from r. A one-dimensional
+// array is returned as a *VecDense and a two-dimensional array as a *Dense.
+// Little and big-endian float64 and float32 data in C or Fortran order are
+// accepted.
+func ReadNPY(r io.Reader) (Matrix, error) {
+	h, err := readNPYHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if h.kind != 'f' {
+		return nil, errWrongType
+	}
+	return h.readMatrix(r)
+}
+
+// readMatrix reads the real array described by h from r.
+func (h *npyHeader) readMatrix(r io.Reader) (Matrix, error) {
+	rows, cols := h.dims()
+	data := make([]float64, rows*cols)
+	if err := h.readData(r, func(k int, v complex128) { data[k] = real(v) }); err != nil {
+		return nil, err
+	}
+	if len(h.shape) == 1 {
+		return NewVecDense(rows, data), nil
+	}
+	return NewDense(rows, cols, data), nil
+}
+
+// ReadCNPY reads an array in the NumPy .npy format from r and returns it as a
+// *CDense. A one-dimensional array is returned as a column vector. Little and
+// big-endian complex128, complex64, float64 and float32 data in C or Fortran
+// order are accepted.
+func ReadCNPY(r io.Reader) (*CDense, error) {
+	h, err := readNPYHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	return h.readCDense(r)
+}
+
+// readCDense reads the array described by h from r.
+func (h *npyHeader) readCDense(r io.Reader) (*CDense, error) {
+	rows, cols := h.dims()
+	data := make([]complex128, rows*cols)
+	if err := h.readData(r, func(k int, v complex128) { data[k] = v }); err != nil {
+		return nil, err
+	}
+	return NewCDense(rows, cols, data), nil
+}
+
+// readNPYHeader reads and parses the header of a .npy file.
+func readNPYHeader(r io.Reader) (*npyHeader, error) {
+	var pre [len(npyMagic) + 2]byte
+	if _, err := io.ReadFull(r, pre[:]); err != nil {
+		return nil, err
+	}
+	if string(pre[:len(npyMagic)]) != npyMagic {
+		return nil, errNPYHeader
+	}
+	var n int
+	switch major := pre[len(npyMagic)]; major {
+	case 1:
+		var l uint16
+		if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+			return nil, err
+		}
+		n = int(l)
+	case 2, 3:
+		var l uint32
+		if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+			return nil, err
+		}
+		n = int(l)
+	default:
+		return nil, fmt.Errorf("mat: unsupported NumPy format version %d", major)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	dict := string(buf)
+
+	var h npyHeader
+	descr := npyDescr.FindStringSubmatch(dict)
+	fortran := npyFortran.FindStringSubmatch(dict)
+	shape := npyShape.FindStringSubmatch(dict)
+	if descr == nil || fortran == nil || shape == nil {
+		return nil, errNPYHeader
+	}
+	h.fortran = fortran[1] == "True"
+
+	d := descr[1]
+	if len(d) < 3 {
+		return nil, errNPYDtype
+	}
+	switch d[0] {
+	case '<', '|', '=':
+		h.order = binary.LittleEndian
+	case '>':
+		h.order = binary.BigEndian
+	default:
+		return nil, errNPYDtype
+	}
+	h.kind = d[1]
+	size, err := strconv.Atoi(d[2:])
+	if err != nil {
+		return nil, errNPYDtype
+	}
+	h.size = size
+	switch {
+	case h.kind == 'f' && (size == 4 || size == 8):
+	case h.kind == 'c' && (size == 8 || size == 16):
+	default:
+		return nil, errNPYDtype
+	}
+
+	for _, f := range strings.Split(shape[1], ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		// Python 2 may write dimensions as long integers.
+		n, err := strconv.Atoi(strings.TrimSuffix(f, "L"))
+		if err != nil {
+			return nil, errNPYHeader
+		}
+		if n <= 0 {
+			return nil, errBadSize
+		}
+		h.shape = append(h.shape, n)
+	}
+	if len(h.shape) != 1 && len(h.shape) != 2 {
+		return nil, errNPYShape
+	}
+	rows, cols := h.dims()
+	if int64(rows)*int64(cols) > maxLen/int64(h.size) {
+		return nil, errTooBig
+	}
+	return &h, nil
+}
+
+// dims returns the dimensions of the matrix holding the array. A
+// one-dimensional array is held by a column vector.
+func (h *npyHeader) dims() (r, c int) {
+	if len(h.shape) == 1 {
+		return h.shape[0], 1
+	}
+	return h.shape[0], h.shape[1]
+}
+
+// readData reads the elements of the array from r and calls set for each
+// element with its index in row-major order.
+func (h *npyHeader) readData(r io.Reader, set func(k int, v complex128)) error {
+	rows, cols := h.dims()
+	buf := make([]byte, rows*cols*h.size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	float := func(b []byte) float64 {
+		if len(b) == 4 {
+			return float64(math.Float32frombits(h.order.Uint32(b)))
+		}
+		return math.Float64frombits(h.order.Uint64(b))
+	}
+	for k := 0; k < rows*cols; k++ {
+		b := buf[k*h.size : (k+1)*h.size]
+		var v complex128
+		if h.kind == 'c' {
+			v = complex(float(b[:h.size/2]), float(b[h.size/2:]))
+		} else {
+			v = complex(float(b), 0)
+		}
+		if h.fortran {
+			// Element k is at row k%rows and column k/rows.
+			set((k%rows)*cols+k/rows, v)
+		} else {
+			set(k, v)
+		}
+	}
+	return nil
+}
+
+// WriteNPY writes m to w in the NumPy .npy format as a little-endian float64
+// array in C order. If m is a Vector, it is written as a one-dimensional
+// array, otherwise it is written as a two-dimensional array.
+func WriteNPY(w io.Writer, m Matrix) error {
+	r, c := m.Dims()
+	shape := fmt.Sprintf("(%d, %d)", r, c)
+	if v, ok := m.(Vector); ok {
+		shape = fmt.Sprintf("(%d,)", v.Len())
+	}
+	buf := make([]byte, 8*r*c)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			binary.LittleEndian.PutUint64(buf[8*(i*c+j):], math.Float64bits(m.At(i, j)))
+		}
+	}
+	return writeNPY(w, "<f8", shape, buf)
+}
+
+// WriteCNPY writes m to w in the NumPy .npy format as a two-dimensional
+// little-endian complex128 array in C order.
+func WriteCNPY(w io.Writer, m CMatrix) error {
+	r, c := m.Dims()
+	buf := make([]byte, 16*r*c)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := m.At(i, j)
+			b := buf[16*(i*c+j):]
+			binary.LittleEndian.PutUint64(b, math.Float64bits(real(v)))
+			binary.LittleEndian.PutUint64(b[8:], math.Float64bits(imag(v)))
+		}
+	}
+	return writeNPY(w, "<c16", fmt.Sprintf("(%d, %d)", r, c), buf)
+}
+
+// writeNPY writes a version 1.0 .npy file holding data with the given dtype
+// descriptor and shape to w.
+func writeNPY(w io.Writer, descr, shape string, data []byte) error {
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': %s, }", descr, shape)
+
+	// The header is padded with spaces and terminated by a newline so that
+	// the data is aligned to 64 bytes.
+	const align = 64
+	pre := len(npyMagic) + 4
+	pad := align - (pre+len(dict)+1)%align
+	if pad == align {
+		pad = 0
+	}
+	n := len(dict) + pad + 1
+	if n > math.MaxUint16 {
+		return errTooBig
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(npyMagic)
+	buf.Write([]byte{1, 0})
+	binary.Write(&buf, binary.LittleEndian, uint16(n))
+	buf.WriteString(dict)
+	buf.WriteString(strings.Repeat(" ", pad))
+	buf.WriteByte('\n')
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// ReadNPZ reads the arrays held in a NumPy .npz archive of the given size
+// from r. The arrays are returned keyed by their names, without the ".npy"
+// extension. Real arrays are returned as described by ReadNPY and complex
+// arrays are returned as a *CDense.
+func ReadNPZ(r io.ReaderAt, size int64) (map[string]interface{}, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	arrays := make(map[string]interface{}, len(zr.File))
+	for _, f := range zr.File {
+		name := strings.TrimSuffix(f.Name, ".npy")
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		m, err := readNPYAny(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("mat: reading %q: %v", name, err)
+		}
+		arrays[name] = m
+	}
+	return arrays, nil
+}
+
+// readNPYAny reads a .npy file from r, returning a *CDense for complex data
+// and a Matrix as described by ReadNPY otherwise.
+func readNPYAny(r io.Reader) (interface{}, error) {
+	h, err := readNPYHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if h.kind == 'c' {
+		return h.readCDense(r)
+	}
+	return h.readMatrix(r)
+}
+
+// WriteNPZ writes the given arrays to w as an uncompressed NumPy .npz
+// archive. Each array is stored in a file named after its key with the
+// ".npy" extension. The values of arrays must be a Matrix, which is written
+// as described by WriteNPY, or a CMatrix, which is written as described by
+// WriteCNPY.
+func WriteNPZ(w io.Writer, arrays map[string]interface{}) error {
+	names := make([]string, 0, len(arrays))
+	for name := range arrays {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	zw := zip.NewWriter(w)
+	for _, name := range names {
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: name + ".npy", Method: zip.Store})
+		if err != nil {
+			return err
+		}
+		switch m := arrays[name].(type) {
+		case Matrix:
+			err = WriteNPY(f, m)
+		case CMatrix:
+			err = WriteCNPY(f, m)
+		default:
+			err = errWrongType
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
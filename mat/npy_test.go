@@ -0,0 +1,258 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// npyFile returns a version 1.0 .npy file with the given header dictionary
+// and data, which is encoded with the given byte order.
+func npyFile(dict string, order binary.ByteOrder, data interface{}) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(npyMagic)
+	buf.Write([]byte{1, 0})
+	binary.Write(&buf, binary.LittleEndian, uint16(len(dict)+1))
+	buf.WriteString(dict)
+	buf.WriteByte('\n')
+	binary.Write(&buf, order, data)
+	return buf.Bytes()
+}
+
+func TestNPYRoundTrip(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		m    Matrix
+		want Matrix
+	}{
+		{
+			name: "Dense",
+			m:    NewDense(2, 3, []float64{1, 0, -2.5, math.Inf(1), 4e-300, 6}),
+		},
+		{
+			name: "SymDense",
+			m:    NewSymDense(2, []float64{1, 2, 2, 3}),
+			want: NewDense(2, 2, []float64{1, 2, 2, 3}),
+		},
+		{
+			name: "TriDense",
+			m:    NewTriDense(2, Lower, []float64{1, 0, 2, 3}),
+			want: NewDense(2, 2, []float64{1, 0, 2, 3}),
+		},
+		{
+			name: "VecDense",
+			m:    NewVecDense(3, []float64{1, 2, 3}),
+		},
+	} {
+		want := test.want
+		if want == nil {
+			want = test.m
+		}
+		var buf bytes.Buffer
+		err := WriteNPY(&buf, test.m)
+		if err != nil {
+			t.Fatalf("%s: unexpected error writing: %v", test.name, err)
+		}
+		r, c := want.Dims()
+		if (buf.Len()-8*r*c)%64 != 0 {
+			t.Errorf("%s: data not aligned to 64 bytes", test.name)
+		}
+		got, err := ReadNPY(&buf)
+		if err != nil {
+			t.Fatalf("%s: unexpected error reading: %v", test.name, err)
+		}
+		_, gotVec := got.(*VecDense)
+		_, wantVec := want.(*VecDense)
+		if gotVec != wantVec {
+			t.Errorf("%s: unexpected type %T", test.name, got)
+		}
+		if !Equal(got, want) {
+			t.Errorf("%s: unexpected result:\ngot: %v\nwant:%v", test.name, Formatted(got), Formatted(want))
+		}
+	}
+
+	c := NewCDense(2, 2, []complex128{1 + 2i, -3i, 4, 0.5})
+	var buf bytes.Buffer
+	err := WriteCNPY(&buf, c)
+	if err != nil {
+		t.Fatalf("CDense: unexpected error writing: %v", err)
+	}
+	got, err := ReadCNPY(&buf)
+	if err != nil {
+		t.Fatalf("CDense: unexpected error reading: %v", err)
+	}
+	if !CEqual(got, c) {
+		t.Errorf("CDense: unexpected result: got %v, want %v", got.mat.Data, c.mat.Data)
+	}
+}
+
+func TestReadNPY(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		file []byte
+		want Matrix
+	}{
+		{
+			name: "little-endian float64",
+			file: npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }",
+				binary.LittleEndian, []float64{1, 2, 3, 4, 5, 6}),
+			want: NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+		},
+		{
+			name: "big-endian float64 fortran order",
+			file: npyFile("{'descr': '>f8', 'fortran_order': True, 'shape': (2, 3), }",
+				binary.BigEndian, []float64{1, 4, 2, 5, 3, 6}),
+			want: NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+		},
+		{
+			name: "little-endian float32",
+			file: npyFile("{'descr': '<f4', 'fortran_order': False, 'shape': (3,), }",
+				binary.LittleEndian, []float32{0.5, -1, 2}),
+			want: NewVecDense(3, []float64{0.5, -1, 2}),
+		},
+		{
+			name: "big-endian float32 fortran order",
+			file: npyFile("{'fortran_order': True, 'shape': (3L, 2L), 'descr': '>f4'}",
+				binary.BigEndian, []float32{1, 3, 5, 2, 4, 6}),
+			want: NewDense(3, 2, []float64{1, 2, 3, 4, 5, 6}),
+		},
+	} {
+		got, err := ReadNPY(bytes.NewReader(test.file))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !Equal(got, test.want) {
+			t.Errorf("%s: unexpected result:\ngot: %v\nwant:%v", test.name, Formatted(got), Formatted(test.want))
+		}
+	}
+}
+
+func TestReadCNPY(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		file []byte
+		want *CDense
+	}{
+		{
+			name: "complex128 fortran order",
+			file: npyFile("{'descr': '<c16', 'fortran_order': True, 'shape': (2, 2), }",
+				binary.LittleEndian, []float64{1, 1, 3, 3, 2, 2, 4, 4}),
+			want: NewCDense(2, 2, []complex128{1 + 1i, 2 + 2i, 3 + 3i, 4 + 4i}),
+		},
+		{
+			name: "big-endian complex64",
+			file: npyFile("{'descr': '>c8', 'fortran_order': False, 'shape': (2,), }",
+				binary.BigEndian, []float32{1, -1, 0.5, 2}),
+			want: NewCDense(2, 1, []complex128{1 - 1i, 0.5 + 2i}),
+		},
+		{
+			name: "float64",
+			file: npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (1, 2), }",
+				binary.LittleEndian, []float64{1, 2}),
+			want: NewCDense(1, 2, []complex128{1, 2}),
+		},
+	} {
+		got, err := ReadCNPY(bytes.NewReader(test.file))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !CEqual(got, test.want) {
+			t.Errorf("%s: unexpected result: got %v, want %v", test.name, got.mat.Data, test.want.mat.Data)
+		}
+	}
+}
+
+func TestReadNPYError(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		file []byte
+	}{
+		{name: "empty", file: nil},
+		{name: "bad magic", file: []byte("\x93NUMPZ\x01\x00\x00\x00")},
+		{
+			name: "complex",
+			file: npyFile("{'descr': '<c16', 'fortran_order': False, 'shape': (1,), }", binary.LittleEndian, []float64{1, 0}),
+		},
+		{
+			name: "integer",
+			file: npyFile("{'descr': '<i8', 'fortran_order': False, 'shape': (1,), }", binary.LittleEndian, []int64{1}),
+		},
+		{
+			name: "scalar",
+			file: npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (), }", binary.LittleEndian, []float64{1}),
+		},
+		{
+			name: "three dimensional",
+			file: npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (1, 1, 1), }", binary.LittleEndian, []float64{1}),
+		},
+		{
+			name: "zero length",
+			file: npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (0,), }", binary.LittleEndian, []float64{}),
+		},
+		{
+			name: "missing key",
+			file: npyFile("{'descr': '<f8', 'shape': (1,), }", binary.LittleEndian, []float64{1}),
+		},
+		{
+			name: "short data",
+			file: npyFile("{'descr': '<f8', 'fortran_order': False, 'shape': (2,), }", binary.LittleEndian, []float64{1}),
+		},
+	} {
+		_, err := ReadNPY(bytes.NewReader(test.file))
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
+
+func TestNPZRoundTrip(t *testing.T) {
+	arrays := map[string]interface{}{
+		"a": NewDense(2, 2, []float64{1, 2, 3, 4}),
+		"v": NewVecDense(3, []float64{5, 6, 7}),
+		"c": NewCDense(1, 2, []complex128{1i, 2}),
+	}
+	var buf bytes.Buffer
+	err := WriteNPZ(&buf, arrays)
+	if err != nil {
+		t.Fatalf("unexpected error writing: %v", err)
+	}
+	got, err := ReadNPZ(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error reading: %v", err)
+	}
+	if len(got) != len(arrays) {
+		t.Fatalf("unexpected number of arrays: got %d, want %d", len(got), len(arrays))
+	}
+	for _, name := range []string{"a", "v"} {
+		m, ok := got[name].(Matrix)
+		if !ok {
+			t.Errorf("%s: unexpected type %T", name, got[name])
+			continue
+		}
+		if !Equal(m, arrays[name].(Matrix)) {
+			t.Errorf("%s: unexpected result", name)
+		}
+	}
+	if _, ok := got["v"].(*VecDense); !ok {
+		t.Errorf("v: unexpected type %T", got["v"])
+	}
+	c, ok := got["c"].(*CDense)
+	if !ok {
+		t.Fatalf("c: unexpected type %T", got["c"])
+	}
+	if !CEqual(c, arrays["c"].(*CDense)) {
+		t.Errorf("c: unexpected result")
+	}
+
+	err = WriteNPZ(&buf, map[string]interface{}{"x": 1.0})
+	if err == nil {
+		t.Errorf("expected error for unsupported type")
+	}
+}
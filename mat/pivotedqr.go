@@ -0,0 +1,259 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badPivotedQR = "mat: invalid pivoted QR factorization"
+
+// PivotedQR is a type for creating and using the QR factorization with column
+// pivoting of a matrix. The factorization has the form
+//  A * P = Q * R,
+// where P is a permutation matrix, Q is an orthonormal m×m matrix and R is an
+// m×n upper trapezoidal matrix whose diagonal elements are non-increasing in
+// absolute value. The decay of the diagonal of R reveals the numerical rank of
+// A, and the factorization can be used to compute minimum-norm solutions to
+// rank-deficient least-squares problems.
+type PivotedQR struct {
+	qr   *Dense
+	tau  []float64
+	jpvt []int
+}
+
+// Factorize computes the QR factorization with column pivoting of the m×n
+// matrix a. Unlike QR, there is no restriction on the shape of a. The
+// factorization always exists even if A is rank-deficient.
+func (qr *PivotedQR) Factorize(a Matrix) {
+	m, n := a.Dims()
+	if qr.qr == nil {
+		qr.qr = &Dense{}
+	}
+	qr.qr.Clone(a)
+	qr.tau = make([]float64, min(m, n))
+	qr.jpvt = make([]int, n)
+	for i := range qr.jpvt {
+		qr.jpvt[i] = -1
+	}
+	work := []float64{0}
+	lapack64.Geqp3(qr.qr.mat, qr.jpvt, qr.tau, work, -1)
+	work = getFloats(int(work[0]), false)
+	lapack64.Geqp3(qr.qr.mat, qr.jpvt, qr.tau, work, len(work))
+	putFloats(work)
+}
+
+func (qr *PivotedQR) valid() bool {
+	return qr.qr != nil && !qr.qr.IsZero()
+}
+
+// Pivot returns the column permutation of the factorization. The jth column
+// of A*P is the piv[j] column of A. If piv is nil, a new slice is allocated
+// and returned. If piv is not nil, it must have length equal to the number
+// of columns of A.
+func (qr *PivotedQR) Pivot(piv []int) []int {
+	if !qr.valid() {
+		panic(badPivotedQR)
+	}
+	n := len(qr.jpvt)
+	if piv == nil {
+		piv = make([]int, n)
+	}
+	if len(piv) != n {
+		panic(badSliceLength)
+	}
+	copy(piv, qr.jpvt)
+	return piv
+}
+
+// Rank returns the numerical rank of the factorized matrix, the number of
+// diagonal elements of R that are larger in absolute value than tol times
+// the absolute value of the first diagonal element. Rank will panic if tol
+// is negative.
+func (qr *PivotedQR) Rank(tol float64) int {
+	if !qr.valid() {
+		panic(badPivotedQR)
+	}
+	if tol < 0 {
+		panic("mat: negative tolerance")
+	}
+	k := len(qr.tau)
+	if k == 0 {
+		return 0
+	}
+	lda := qr.qr.mat.Stride
+	rmax := math.Abs(qr.qr.mat.Data[0])
+	if rmax == 0 {
+		return 0
+	}
+	var rank int
+	for rank < k && math.Abs(qr.qr.mat.Data[rank*lda+rank]) > tol*rmax {
+		rank++
+	}
+	return rank
+}
+
+// RTo extracts the m×n upper trapezoidal matrix from a pivoted QR
+// decomposition. If dst is nil, a new matrix is allocated. The resulting dst
+// matrix is returned.
+func (qr *PivotedQR) RTo(dst *Dense) *Dense {
+	if !qr.valid() {
+		panic(badPivotedQR)
+	}
+	m, n := qr.qr.Dims()
+	if dst == nil {
+		dst = NewDense(m, n, nil)
+	} else {
+		dst.reuseAsZeroed(m, n)
+	}
+	for i := 0; i < min(m, n); i++ {
+		copy(dst.mat.Data[i*dst.mat.Stride+i:i*dst.mat.Stride+n], qr.qr.mat.Data[i*qr.qr.mat.Stride+i:i*qr.qr.mat.Stride+n])
+	}
+	return dst
+}
+
+// QTo extracts the m×m orthonormal matrix Q from a pivoted QR decomposition.
+// If dst is nil, a new matrix is allocated. The resulting Q matrix is returned.
+func (qr *PivotedQR) QTo(dst *Dense) *Dense {
+	if !qr.valid() {
+		panic(badPivotedQR)
+	}
+	m, _ := qr.qr.Dims()
+	if dst == nil {
+		dst = NewDense(m, m, nil)
+	} else {
+		dst.reuseAsZeroed(m, m)
+	}
+
+	// Set Q = I.
+	for i := 0; i < m*m; i += m + 1 {
+		dst.mat.Data[i] = 1
+	}
+
+	// Construct Q from the elementary reflectors.
+	qr.applyQ(blas.NoTrans, dst.mat)
+	return dst
+}
+
+// applyQ computes Q*C or Q^T*C in place.
+func (qr *PivotedQR) applyQ(trans blas.Transpose, c blas64.General) {
+	// Only the first min(m,n) columns of the factorization hold
+	// elementary reflectors.
+	a := qr.qr.mat
+	a.Cols = len(qr.tau)
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, trans, a, qr.tau, c, work, -1)
+	work = getFloats(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, trans, a, qr.tau, c, work, len(work))
+	putFloats(work)
+}
+
+// Solve finds the minimum-norm solution X of the least-squares problem
+//  minimize ||A * X - B||_2,
+// where A is an m×n matrix represented in its pivoted QR factorized form
+// and A is treated as having the numerical rank r returned by Rank(tol).
+// The solution is placed into x and the rank r is returned.
+//
+// The leading r rows of the upper trapezoidal matrix R are reduced to
+// triangular form by orthogonal transformations from the right, giving the
+// complete orthogonal decomposition
+//  A * P = Q * [T 0] * Z,
+//              [0 0]
+// where T is an r×r upper triangular matrix and Z is an n×n orthogonal matrix,
+// from which the minimum-norm solution is computed.
+func (qr *PivotedQR) Solve(x *Dense, tol float64, b Matrix) (rank int) {
+	if !qr.valid() {
+		panic(badPivotedQR)
+	}
+	m, n := qr.qr.Dims()
+	br, bc := b.Dims()
+	if br != m {
+		panic(ErrShape)
+	}
+	rank = qr.Rank(tol)
+
+	// The intermediate results are stored in-place in w, which must be
+	// large enough to hold both b and x.
+	w := getWorkspace(max(m, n), bc, true)
+	defer putWorkspace(w)
+	wb := w.Slice(0, m, 0, bc).(*Dense)
+	wb.Copy(b)
+	x.reuseAs(n, bc)
+	if rank == 0 {
+		x.Zero()
+		return rank
+	}
+
+	// Compute Q^T * B.
+	qr.applyQ(blas.Trans, wb.mat)
+
+	// Reduce the leading rank×n block of R to [T 0] * Z.
+	t := getWorkspace(rank, n, false)
+	defer putWorkspace(t)
+	t.Copy(qr.qr.Slice(0, rank, 0, n).(*Dense))
+	tau := getFloats(rank, false)
+	defer putFloats(tau)
+	if rank < n {
+		work := []float64{0}
+		lapack64.Tzrzf(t.mat, tau, work, -1)
+		work = getFloats(int(work[0]), false)
+		lapack64.Tzrzf(t.mat, tau, work, len(work))
+		putFloats(work)
+	}
+
+	// Solve T * Y_1 = (Q^T * B)_1.
+	tri := t.asTriDense(rank, blas.NonUnit, blas.Upper).mat
+	if !lapack64.Trtrs(blas.NoTrans, tri, w.Slice(0, rank, 0, bc).(*Dense).mat) {
+		// A zero diagonal element of T would have been excluded by Rank.
+		panic("mat: unexpected singular triangular factor")
+	}
+	for i := rank; i < n; i++ {
+		zero(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
+	}
+
+	// Compute Y = Z^T * [Y_1; 0].
+	if rank < n {
+		wn := w.Slice(0, n, 0, bc).(*Dense)
+		work := []float64{0}
+		lapack64.Ormrz(blas.Left, blas.Trans, t.mat, n-rank, tau, wn.mat, work, -1)
+		work = getFloats(int(work[0]), false)
+		lapack64.Ormrz(blas.Left, blas.Trans, t.mat, n-rank, tau, wn.mat, work, len(work))
+		putFloats(work)
+	}
+
+	// Undo the column permutation, X = P * Y.
+	for i, p := range qr.jpvt {
+		copy(x.mat.Data[p*x.mat.Stride:p*x.mat.Stride+bc], w.mat.Data[i*w.mat.Stride:i*w.mat.Stride+bc])
+	}
+	return rank
+}
+
+// SolveVec finds the minimum-norm solution x of the least-squares problem
+//  minimize ||A * x - b||_2.
+// See PivotedQR.Solve for the full documentation.
+func (qr *PivotedQR) SolveVec(x *VecDense, tol float64, b Vector) (rank int) {
+	if !qr.valid() {
+		panic(badPivotedQR)
+	}
+	_, n := qr.qr.Dims()
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+	bm := Matrix(b)
+	if rv, ok := b.(RawVectorer); ok {
+		bmat := rv.RawVector()
+		if x != b {
+			x.checkOverlap(bmat)
+		}
+		b := VecDense{mat: bmat}
+		bm = b.asDense()
+	}
+	x.reuseAs(n)
+	return qr.Solve(x.asDense(), tol, bm)
+}
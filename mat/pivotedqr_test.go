@@ -0,0 +1,150 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestPivotedQR(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{10, 5},
+		{5, 10},
+		{40, 30},
+		{30, 40},
+	} {
+		m, n := test.m, test.n
+		a := NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+
+		var qr PivotedQR
+		qr.Factorize(a)
+		q := qr.QTo(nil)
+		if !isOrthonormal(q, 1e-10) {
+			t.Errorf("m=%d,n=%d: Q is not orthonormal", m, n)
+		}
+		r := qr.RTo(nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < min(i, n); j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("m=%d,n=%d: R is not upper trapezoidal", m, n)
+				}
+			}
+		}
+		for i := 1; i < min(m, n); i++ {
+			if math.Abs(r.At(i, i)) > math.Abs(r.At(i-1, i-1))*(1+tol) {
+				t.Errorf("m=%d,n=%d: diagonal of R is not non-increasing", m, n)
+				break
+			}
+		}
+		if rank := qr.Rank(tol); rank != min(m, n) {
+			t.Errorf("m=%d,n=%d: unexpected rank: got %d, want %d", m, n, rank, min(m, n))
+		}
+
+		// Check that A * P = Q * R.
+		piv := qr.Pivot(nil)
+		ap := NewDense(m, n, nil)
+		for j, p := range piv {
+			ap.SetCol(j, Col(nil, p, a))
+		}
+		var qrp Dense
+		qrp.Mul(q, r)
+		if !EqualApprox(&qrp, ap, tol) {
+			t.Errorf("m=%d,n=%d: Q*R != A*P", m, n)
+		}
+	}
+}
+
+func TestPivotedQRSolve(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank, bc int
+	}{
+		{5, 5, 5, 1},
+		{10, 5, 5, 2},
+		{5, 10, 5, 2},
+		{5, 5, 3, 1},
+		{10, 6, 2, 3},
+		{6, 10, 4, 3},
+		{40, 30, 17, 2},
+		{30, 40, 17, 2},
+		{60, 80, 50, 1},
+	} {
+		m, n, rank, bc := test.m, test.n, test.rank, test.bc
+
+		// Construct a random m×n matrix A of the given rank.
+		u := NewDense(m, rank, nil)
+		for i := range u.mat.Data {
+			u.mat.Data[i] = rnd.NormFloat64()
+		}
+		v := NewDense(rank, n, nil)
+		for i := range v.mat.Data {
+			v.mat.Data[i] = rnd.NormFloat64()
+		}
+		var a Dense
+		a.Mul(u, v)
+		b := NewDense(m, bc, nil)
+		for i := range b.mat.Data {
+			b.mat.Data[i] = rnd.NormFloat64()
+		}
+
+		var qr PivotedQR
+		qr.Factorize(&a)
+		if got := qr.Rank(1e-10); got != rank {
+			t.Errorf("m=%d,n=%d: unexpected rank: got %d, want %d", m, n, got, rank)
+			continue
+		}
+		var x Dense
+		if got := qr.Solve(&x, 1e-10, b); got != rank {
+			t.Errorf("m=%d,n=%d: unexpected rank from Solve: got %d, want %d", m, n, got, rank)
+		}
+
+		// Compute the minimum-norm solution using the pseudo-inverse of A
+		// obtained from its singular value decomposition.
+		var svd SVD
+		if !svd.Factorize(&a, SVDFull) {
+			t.Fatalf("m=%d,n=%d: SVD factorization failed", m, n)
+		}
+		s := svd.Values(nil)
+		uf := svd.UTo(nil)
+		vf := svd.VTo(nil)
+		var utb Dense
+		utb.Mul(uf.T(), b)
+		y := NewDense(n, bc, nil)
+		for i := 0; i < rank; i++ {
+			for j := 0; j < bc; j++ {
+				y.Set(i, j, utb.At(i, j)/s[i])
+			}
+		}
+		var want Dense
+		want.Mul(vf, y)
+		if !EqualApprox(&x, &want, tol) {
+			t.Errorf("m=%d,n=%d,rank=%d: solution mismatch", m, n, rank)
+		}
+
+		// Check the vector variant.
+		var xv VecDense
+		if got := qr.SolveVec(&xv, 1e-10, b.ColView(0)); got != rank {
+			t.Errorf("m=%d,n=%d: unexpected rank from SolveVec: got %d, want %d", m, n, got, rank)
+		}
+		if !EqualApprox(&xv, want.ColView(0), tol) {
+			t.Errorf("m=%d,n=%d,rank=%d: vector solution mismatch", m, n, rank)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// FactorizeRandomized computes an approximate truncated singular value
+// decomposition of the m×n matrix A,
+//  A ≈ U * Σ * V^T,
+// where U is m×rank, Σ is a rank×rank diagonal matrix and V is n×rank, using
+// the randomized algorithm of Halko, Martinsson and Tropp.
+//
+// The range of A is sampled by multiplying A with an n×(rank+oversample)
+// Gaussian random matrix, and the sample is refined with power iterations of
+// A*A^T. The resulting orthonormal basis Q is used to compute the SVD of the
+// small matrix Q^T*A, from which the decomposition of A is recovered. Each
+// power iteration improves the accuracy for matrices whose singular values
+// decay slowly at the cost of two additional products with A. Typical values
+// are oversample = 10 and power = 2.
+//
+// The result has kind SVDThin, and the values returned by Values, UTo and VTo
+// have rank instead of min(m,n) elements and columns respectively.
+//
+// The random numbers are generated from src. If src is nil, the global source
+// of golang.org/x/exp/rand is used.
+//
+// FactorizeRandomized will panic if rank is not in [1, min(m,n)], or if
+// oversample or power is negative. It returns whether the decomposition
+// succeeded. If the decomposition failed, routines that require a successful
+// factorization will panic.
+//
+// References:
+//  Halko, N., Martinsson, P. G., and Tropp, J. A. (2011). Finding structure
+//  with randomness: Probabilistic algorithms for constructing approximate
+//  matrix decompositions. SIAM Review, 53(2), 217-288.
+func (svd *SVD) FactorizeRandomized(a Matrix, rank, oversample, power int, src rand.Source) (ok bool) {
+	m, n := a.Dims()
+	if rank < 1 || rank > min(m, n) {
+		panic("mat: rank out of range")
+	}
+	if oversample < 0 {
+		panic("mat: negative oversampling")
+	}
+	if power < 0 {
+		panic("mat: negative number of power iterations")
+	}
+	l := min(rank+oversample, min(m, n))
+
+	normFloat64 := rand.NormFloat64
+	if src != nil {
+		normFloat64 = rand.New(src).NormFloat64
+	}
+	omega := NewDense(n, l, nil)
+	for i := range omega.mat.Data {
+		omega.mat.Data[i] = normFloat64()
+	}
+
+	// Compute an orthonormal basis for the range of A*(A^T*A)^power*Ω,
+	// orthonormalizing after each product for numerical stability.
+	var y, z Dense
+	y.Mul(a, omega)
+	q := orthonormalBasis(&y)
+	for i := 0; i < power; i++ {
+		z.Mul(a.T(), q)
+		q = orthonormalBasis(&z)
+		y.Mul(a, q)
+		q = orthonormalBasis(&y)
+	}
+
+	// Compute the SVD of the l×n matrix B = Q^T * A.
+	var b Dense
+	b.Mul(q.T(), a)
+	var small SVD
+	small.DivideConquer = svd.DivideConquer
+	if !small.Factorize(&b, SVDThin) {
+		svd.kind = 0
+		return false
+	}
+
+	// Truncate to the requested rank and recover U = Q * U_B.
+	svd.s = use(svd.s, rank)
+	copy(svd.s, small.s[:rank])
+
+	ub := &Dense{
+		mat:     small.u,
+		capRows: small.u.Rows,
+		capCols: small.u.Cols,
+	}
+	var u Dense
+	u.Mul(q, ub.Slice(0, l, 0, rank))
+	svd.u = u.mat
+
+	svd.vt.Rows = rank
+	svd.vt.Cols = n
+	svd.vt.Stride = n
+	svd.vt.Data = use(svd.vt.Data, rank*n)
+	for i := 0; i < rank; i++ {
+		copy(svd.vt.Data[i*n:i*n+n], small.vt.Data[i*small.vt.Stride:i*small.vt.Stride+n])
+	}
+	svd.kind = SVDThin
+	return true
+}
+
+// orthonormalBasis returns an m×n matrix whose columns are an orthonormal basis
+// for the range of the m×n matrix a, where m >= n. a is overwritten.
+func orthonormalBasis(a *Dense) *Dense {
+	m, n := a.Dims()
+	tau := getFloats(n, false)
+	defer putFloats(tau)
+	work := []float64{0}
+	lapack64.Geqrf(a.mat, tau, work, -1)
+	work = getFloats(int(work[0]), false)
+	lapack64.Geqrf(a.mat, tau, work, len(work))
+	putFloats(work)
+
+	q := NewDense(m, n, nil)
+	for i := 0; i < n; i++ {
+		q.mat.Data[i*q.mat.Stride+i] = 1
+	}
+	work = []float64{0}
+	lapack64.Ormqr(blas.Left, blas.NoTrans, a.mat, tau, q.mat, work, -1)
+	work = getFloats(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, blas.NoTrans, a.mat, tau, q.mat, work, len(work))
+	putFloats(work)
+	return q
+}
@@ -0,0 +1,95 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSVDFactorizeRandomized(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank, k, oversample, power int
+	}{
+		// Exactly low-rank matrices are recovered to working precision.
+		{50, 30, 5, 5, 5, 0},
+		{30, 50, 5, 5, 5, 0},
+		{100, 40, 10, 10, 0, 1},
+		{100, 40, 10, 4, 10, 2},
+		{40, 40, 40, 40, 0, 0},
+	} {
+		m, n, rank := test.m, test.n, test.rank
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d,k=%d,oversample=%d,power=%d", m, n, rank, test.k, test.oversample, test.power)
+
+		// Construct a random matrix of the given rank with well separated
+		// singular values.
+		var a Dense
+		{
+			x := NewDense(m, rank, nil)
+			for i := range x.mat.Data {
+				x.mat.Data[i] = rnd.NormFloat64()
+			}
+			y := NewDense(rank, n, nil)
+			for i := range y.mat.Data {
+				y.mat.Data[i] = rnd.NormFloat64()
+			}
+			a.Mul(x, y)
+		}
+
+		var want SVD
+		if !want.Factorize(&a, SVDThin) {
+			t.Fatalf("%s: SVD failed", name)
+		}
+		sWant := want.Values(nil)
+
+		var svd SVD
+		if !svd.FactorizeRandomized(&a, test.k, test.oversample, test.power, rand.NewSource(1)) {
+			t.Fatalf("%s: FactorizeRandomized failed", name)
+		}
+		if svd.Kind() != SVDThin {
+			t.Errorf("%s: unexpected kind: %v", name, svd.Kind())
+		}
+		s := svd.Values(nil)
+		if len(s) != test.k {
+			t.Fatalf("%s: unexpected number of singular values: got %d, want %d", name, len(s), test.k)
+		}
+		for i := range s {
+			if math.Abs(s[i]-sWant[i]) > tol*sWant[0] {
+				t.Errorf("%s: singular value mismatch at %d: got %v, want %v", name, i, s[i], sWant[i])
+			}
+		}
+
+		u := svd.UTo(nil)
+		v := svd.VTo(nil)
+		if r, c := u.Dims(); r != m || c != test.k {
+			t.Errorf("%s: unexpected size of U: %d×%d", name, r, c)
+		}
+		if r, c := v.Dims(); r != n || c != test.k {
+			t.Errorf("%s: unexpected size of V: %d×%d", name, r, c)
+		}
+		var utu, vtv Dense
+		utu.Mul(u.T(), u)
+		vtv.Mul(v.T(), v)
+		if !EqualApprox(&utu, eye(test.k), tol) || !EqualApprox(&vtv, eye(test.k), tol) {
+			t.Errorf("%s: singular vectors are not orthonormal", name)
+		}
+
+		// If the requested rank is at least the rank of A, the product
+		// of the factors must reconstruct A.
+		if test.k >= rank {
+			var us, usv Dense
+			us.Mul(u, NewDiagDense(test.k, s))
+			usv.Mul(&us, v.T())
+			if !EqualApprox(&usv, &a, tol*sWant[0]) {
+				t.Errorf("%s: U*Σ*V^T != A", name)
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+// SolveCARE solves the continuous-time algebraic Riccati equation
+//  A^T * X + X * A - X * B * R^-1 * B^T * X + Q = 0
+// for the stabilizing solution X, that is, the unique symmetric solution for
+// which all eigenvalues of the closed-loop matrix A - B * R^-1 * B^T * X have
+// negative real parts, and stores the result into the receiver. A must be n×n,
+// B must be n×m, Q must be n×n and R must be m×m, otherwise SolveCARE will
+// panic. R must be positive definite, otherwise ErrNotPSD is returned.
+//
+// The solution is computed by the Schur method from the stable invariant
+// subspace of the 2n×2n Hamiltonian matrix
+//  H = [  A  -B*R^-1*B^T ]
+//      [ -Q      -A^T    ]
+// The stabilizing solution exists if (A, B) is stabilizable and H has no
+// eigenvalues on the imaginary axis. If H has eigenvalues on or too close to
+// the imaginary axis, ErrNoStabilizing is returned. If the Schur decomposition
+// of H fails, ErrFailedEigen is returned. If the computed basis of the stable
+// subspace is ill-conditioned, a Condition error is returned.
+func (s *SymDense) SolveCARE(a, b Matrix, q, r Symmetric) error {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	br, m := b.Dims()
+	if br != n || q.Symmetric() != n || r.Symmetric() != m {
+		panic(ErrShape)
+	}
+
+	var chol Cholesky
+	if ok := chol.Factorize(r); !ok {
+		return ErrNotPSD
+	}
+
+	// Construct the Hamiltonian matrix H.
+	var rbt Dense
+	err := chol.Solve(&rbt, b.T())
+	if err != nil {
+		return err
+	}
+	h := NewDense(2*n, 2*n, nil)
+	h.Slice(0, n, 0, n).(*Dense).Copy(a)
+	g := h.Slice(0, n, n, 2*n).(*Dense)
+	g.Mul(b, &rbt)
+	g.Scale(-1, g)
+	hq := h.Slice(n, 2*n, 0, n).(*Dense)
+	hq.Copy(q)
+	hq.Scale(-1, hq)
+	at := h.Slice(n, 2*n, n, 2*n).(*Dense)
+	at.Copy(a.T())
+	at.Scale(-1, at)
+
+	// Move the eigenvalues of H with negative real part to the top-left
+	// corner of its Schur form.
+	var schur Schur
+	if !schur.Factorize(h, true) {
+		return ErrFailedEigen
+	}
+	selected := make([]bool, 2*n)
+	for i, v := range schur.values {
+		selected[i] = real(v) < 0
+	}
+	k, ok := schur.Reorder(selected)
+	if !ok || k != n {
+		return ErrNoStabilizing
+	}
+
+	// The leading n Schur vectors [U11; U21] span the stable invariant
+	// subspace of H and X = U21 * U11^-1, that is, U11^T * X = U21^T since X
+	// is symmetric.
+	u11 := schur.z.Slice(0, n, 0, n)
+	u21 := schur.z.Slice(n, 2*n, 0, n)
+	var x Dense
+	err = x.Solve(u11.T(), u21.T())
+
+	s.reuseAs(n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.set(i, j, (x.at(i, j)+x.at(j, i))/2)
+		}
+	}
+	return err
+}
@@ -0,0 +1,104 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSolveCARE(t *testing.T) {
+	const tol = 1e-10
+
+	// Scalar equation 2*x - x^2 + 1 = 0 with the stabilizing solution
+	// x = 1 + sqrt(2).
+	one := NewDense(1, 1, []float64{1})
+	var x SymDense
+	err := x.SolveCARE(one, one, NewSymDense(1, []float64{1}), NewSymDense(1, []float64{1}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := x.At(0, 0), 1+math.Sqrt2; math.Abs(got-want) > tol {
+		t.Errorf("unexpected scalar solution: got %v, want %v", got, want)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 25} {
+		for _, m := range []int{1, 2, 5} {
+			name := fmt.Sprintf("n=%d,m=%d", n, m)
+
+			a := randNormDense(n, rnd)
+			b := NewDense(n, m, nil)
+			for i := range b.mat.Data {
+				b.mat.Data[i] = rnd.NormFloat64()
+			}
+			var q SymDense
+			q.SymOuterK(1, randNormDense(n, rnd))
+			var r SymDense
+			r.SymOuterK(1, randNormDense(m, rnd))
+			for i := 0; i < m; i++ {
+				r.SetSym(i, i, r.At(i, i)+1)
+			}
+
+			var x SymDense
+			err := x.SolveCARE(a, b, &q, &r)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+
+			// Check the residual A^T*X + X*A - X*B*R^-1*B^T*X + Q.
+			var rinv SymDense
+			var chol Cholesky
+			chol.Factorize(&r)
+			chol.InverseTo(&rinv)
+			var res, tmp, k Dense
+			res.Mul(a.T(), &x)
+			tmp.Mul(&x, a)
+			res.Add(&res, &tmp)
+			k.Product(&rinv, b.T(), &x)
+			tmp.Product(&x, b, &k)
+			res.Sub(&res, &tmp)
+			res.Add(&res, &q)
+			xnorm := math.Max(1, Norm(&x, 1))
+			if resid := Norm(&res, 1) / (xnorm * xnorm); resid > tol {
+				t.Errorf("%s: unexpected residual: %v", name, resid)
+			}
+
+			// Check that the closed-loop matrix A - B*R^-1*B^T*X is stable.
+			var acl Dense
+			acl.Mul(b, &k)
+			acl.Sub(a, &acl)
+			var eig Eigen
+			if ok := eig.Factorize(&acl, false, false); !ok {
+				t.Errorf("%s: unexpected eigendecomposition failure", name)
+				continue
+			}
+			for _, v := range eig.Values(nil) {
+				if real(v) >= 0 {
+					t.Errorf("%s: closed-loop matrix not stable: eigenvalue %v", name, v)
+					break
+				}
+			}
+		}
+	}
+
+	// The Hamiltonian matrix of a zero system has all eigenvalues on the
+	// imaginary axis.
+	zero := NewDense(1, 1, nil)
+	x.Reset()
+	err = x.SolveCARE(zero, zero, NewSymDense(1, nil), NewSymDense(1, []float64{1}))
+	if err != ErrNoStabilizing {
+		t.Errorf("unexpected error for system without stabilizing solution: got %v, want %v", err, ErrNoStabilizing)
+	}
+
+	err = x.SolveCARE(one, one, NewSymDense(1, []float64{1}), NewSymDense(1, []float64{-1}))
+	if err != ErrNotPSD {
+		t.Errorf("unexpected error for indefinite R: got %v, want %v", err, ErrNotPSD)
+	}
+}
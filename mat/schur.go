@@ -0,0 +1,258 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badNoSchurVect = "mat: Schur vectors not computed"
+
+// Schur is a type for creating and using the real Schur decomposition of a
+// square matrix
+//  A = Z * T * Z^T,
+// where Z is an orthogonal matrix of Schur vectors and T is an upper
+// quasi-triangular matrix in Schur canonical form, that is, block upper
+// triangular with 1×1 and 2×2 diagonal blocks. Each 1×1 diagonal block holds a
+// real eigenvalue of A and each 2×2 diagonal block, which has its diagonal
+// elements equal and its off-diagonal elements of opposite sign, holds a
+// complex conjugate pair of eigenvalues.
+//
+// The leading k columns of Z span an invariant subspace of A whenever T[k,k-1]
+// is zero. Reorder can be used to move a selected set of eigenvalues to the
+// top-left corner of T so that the corresponding invariant subspace is spanned
+// by the leading columns of Z.
+type Schur struct {
+	n int // The size of the factorized matrix.
+
+	t *Dense
+	z *Dense // z is nil if the Schur vectors were not computed.
+
+	values []complex128
+}
+
+// Factorize computes the real Schur decomposition of the square matrix a and,
+// if vectors is true, the matrix of Schur vectors. Factorize panics if a is
+// not square.
+//
+// Factorize returns whether the decomposition succeeded. The decomposition
+// fails if the QR algorithm does not converge. If the decomposition failed,
+// methods that require a successful factorization will panic.
+func (s *Schur) Factorize(a Matrix, vectors bool) (ok bool) {
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	t := DenseCopyOf(a)
+
+	var z Dense
+	jobvs := lapack.SchurNone
+	if vectors {
+		z = *NewDense(r, r, nil)
+		jobvs = lapack.SchurOrig
+	} else {
+		z.mat.Stride = 1
+	}
+
+	wr := getFloats(r, false)
+	defer putFloats(wr)
+	wi := getFloats(r, false)
+	defer putFloats(wi)
+
+	work := []float64{0}
+	lapack64.Gees(jobvs, t.mat, wr, wi, z.mat, work, -1)
+	work = getFloats(int(work[0]), false)
+	first := lapack64.Gees(jobvs, t.mat, wr, wi, z.mat, work, len(work))
+	putFloats(work)
+
+	if first != 0 {
+		s.n = 0
+		s.t = nil
+		s.z = nil
+		s.values = nil
+		return false
+	}
+	s.n = r
+	s.t = t
+	if vectors {
+		s.z = &z
+	} else {
+		s.z = nil
+	}
+	s.values = make([]complex128, r)
+	s.setValues(wr, wi)
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (s *Schur) succFact() bool {
+	return s.t != nil
+}
+
+// setValues stores the eigenvalues with real and imaginary parts given by wr
+// and wi in the receiver.
+func (s *Schur) setValues(wr, wi []float64) {
+	for i, v := range wr {
+		s.values[i] = complex(v, wi[i])
+	}
+}
+
+// TTo extracts the quasi-triangular matrix T of the Schur decomposition. If dst
+// is nil, a new matrix is allocated. The resulting matrix is returned.
+//
+// TTo panics if the decomposition was not successful.
+func (s *Schur) TTo(dst *Dense) *Dense {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = NewDense(s.n, s.n, nil)
+	} else {
+		dst.reuseAs(s.n, s.n)
+	}
+	dst.Copy(s.t)
+	return dst
+}
+
+// ZTo extracts the orthogonal matrix Z of Schur vectors. If dst is nil, a new
+// matrix is allocated. The resulting matrix is returned.
+//
+// ZTo panics if the decomposition was not successful or if the Schur vectors
+// were not computed.
+func (s *Schur) ZTo(dst *Dense) *Dense {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	if s.z == nil {
+		panic(badNoSchurVect)
+	}
+	if dst == nil {
+		dst = NewDense(s.n, s.n, nil)
+	} else {
+		dst.reuseAs(s.n, s.n)
+	}
+	dst.Copy(s.z)
+	return dst
+}
+
+// Values extracts the eigenvalues of the factorized matrix in the order in
+// which they appear on the diagonal of T. Complex conjugate pairs of
+// eigenvalues appear consecutively with the eigenvalue having the positive
+// imaginary part first. If dst is non-nil, the values are stored in-place into
+// dst. In this case dst must have length n, otherwise Values will panic. If
+// dst is nil, then a new slice will be allocated of the proper length and
+// filled with the eigenvalues.
+//
+// Values panics if the decomposition was not successful.
+func (s *Schur) Values(dst []complex128) []complex128 {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, s.n)
+	}
+	if len(dst) != s.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, s.values)
+	return dst
+}
+
+// BlockSizes returns the sizes of the diagonal blocks of T in the order in
+// which they appear on the diagonal. A block of size 1 holds a real eigenvalue
+// and a block of size 2 holds a complex conjugate pair of eigenvalues.
+//
+// BlockSizes panics if the decomposition was not successful.
+func (s *Schur) BlockSizes() []int {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	var sizes []int
+	for i := 0; i < s.n; {
+		if i < s.n-1 && s.t.at(i+1, i) != 0 {
+			sizes = append(sizes, 2)
+			i += 2
+			continue
+		}
+		sizes = append(sizes, 1)
+		i++
+	}
+	return sizes
+}
+
+// Reorder reorders the Schur decomposition so that the eigenvalues specified
+// by selected appear in the leading diagonal blocks of T. The leading m columns
+// of Z then form an orthonormal basis of the invariant subspace of A
+// corresponding to the selected eigenvalues.
+//
+// selected must have length n, otherwise Reorder will panic. The i-th
+// eigenvalue, as returned by Values, is selected if selected[i] is true. A
+// complex conjugate pair of eigenvalues is selected if either of its elements
+// is selected. The relative order of the selected eigenvalues and of the
+// remaining eigenvalues is preserved.
+//
+// Reorder returns the dimension m of the selected invariant subspace, that is,
+// the number of selected eigenvalues counting each complex conjugate pair as
+// two, and whether the reordering succeeded. The reordering fails if some
+// eigenvalues are too close to be separated, in which case T and Z may have
+// been partially reordered but still form a valid Schur decomposition of A.
+//
+// Reorder panics if the decomposition was not successful.
+func (s *Schur) Reorder(selected []bool) (m int, ok bool) {
+	m, _, _, ok = s.reorder(lapack.SchurCondNone, selected)
+	return m, ok
+}
+
+// ReorderCond reorders the Schur decomposition in the same way as Reorder and
+// additionally estimates the reciprocal condition numbers of the selected
+// cluster of eigenvalues and of the corresponding invariant subspace.
+//
+// cond is a lower bound on the reciprocal condition number of the average of
+// the selected eigenvalues and sep is an estimate of the separation between
+// the leading m×m block of T and the trailing block, which is the reciprocal
+// condition number of the invariant subspace. Small values of cond and sep
+// indicate an ill-conditioned cluster and subspace, respectively. If m is 0 or
+// n, cond is 1 and sep is the 1-norm of T. If the reordering failed, cond and
+// sep are zero.
+//
+// ReorderCond panics if the decomposition was not successful.
+func (s *Schur) ReorderCond(selected []bool) (m int, cond, sep float64, ok bool) {
+	return s.reorder(lapack.SchurCondBoth, selected)
+}
+
+func (s *Schur) reorder(job lapack.SchurCond, selected []bool) (m int, cond, sep float64, ok bool) {
+	if !s.succFact() {
+		panic(badFact)
+	}
+	if len(selected) != s.n {
+		panic(ErrSliceLengthMismatch)
+	}
+
+	compq := lapack.UpdateSchurNone
+	q := blas64.General{Stride: 1}
+	if s.z != nil {
+		compq = lapack.UpdateSchur
+		q = s.z.mat
+	}
+
+	wr := getFloats(s.n, false)
+	defer putFloats(wr)
+	wi := getFloats(s.n, false)
+	defer putFloats(wi)
+
+	work := []float64{0}
+	iwork := []int{0}
+	lapack64.Trsen(job, compq, selected, s.t.mat, q, wr, wi, work, -1, iwork, -1)
+	work = getFloats(int(work[0]), false)
+	iwork = getInts(iwork[0], false)
+	m, cond, sep, ok = lapack64.Trsen(job, compq, selected, s.t.mat, q, wr, wi, work, len(work), iwork, len(iwork))
+	putFloats(work)
+	putInts(iwork)
+
+	s.setValues(wr, wi)
+	return m, cond, sep, ok
+}
@@ -0,0 +1,180 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSchur(t *testing.T) {
+	const tol = 1e-12
+
+	// Known values with a complex conjugate pair of eigenvalues.
+	a := NewDense(3, 3, []float64{
+		0, -1, 0,
+		1, 0, 0,
+		0, 0, 2,
+	})
+	var schur Schur
+	if !schur.Factorize(a, true) {
+		t.Fatal("unexpected factorization failure")
+	}
+	var nReal, nComplex int
+	for _, v := range schur.Values(nil) {
+		switch {
+		case imag(v) == 0 && math.Abs(real(v)-2) < tol:
+			nReal++
+		case math.Abs(real(v)) < tol && math.Abs(math.Abs(imag(v))-1) < tol:
+			nComplex++
+		}
+	}
+	if nReal != 1 || nComplex != 2 {
+		t.Errorf("unexpected eigenvalues: got %v", schur.Values(nil))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30, 50} {
+		a := NewDense(n, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+		}
+
+		var schur Schur
+		if !schur.Factorize(a, true) {
+			t.Errorf("n=%d: unexpected factorization failure", n)
+			continue
+		}
+		testSchurDecomposition(t, n, a, &schur, tol)
+
+		// Check that the block sizes are consistent with the values.
+		values := schur.Values(nil)
+		var i int
+		for _, size := range schur.BlockSizes() {
+			if size == 1 && imag(values[i]) != 0 {
+				t.Errorf("n=%d: complex eigenvalue in 1×1 block", n)
+			}
+			if size == 2 && (imag(values[i]) <= 0 || values[i+1] != cmplx.Conj(values[i])) {
+				t.Errorf("n=%d: 2×2 block does not hold a complex conjugate pair", n)
+			}
+			i += size
+		}
+		if i != n {
+			t.Errorf("n=%d: block sizes do not sum to n", n)
+		}
+
+		// Check that the eigenvalues are the same when the Schur
+		// vectors are not computed.
+		var schurNoVec Schur
+		if !schurNoVec.Factorize(a, false) {
+			t.Errorf("n=%d: unexpected factorization failure without Schur vectors", n)
+			continue
+		}
+		got := schurNoVec.Values(nil)
+		for i := range got {
+			if cmplx.Abs(got[i]-values[i]) > tol*math.Max(1, cmplx.Abs(values[i])) {
+				t.Errorf("n=%d: eigenvalue mismatch without Schur vectors", n)
+				break
+			}
+		}
+		if panicked, _ := panics(func() { schurNoVec.ZTo(nil) }); !panicked {
+			t.Errorf("n=%d: ZTo did not panic without Schur vectors", n)
+		}
+
+		// Move the eigenvalues in the left half-plane to the top.
+		selected := make([]bool, n)
+		var mWant int
+		for i, v := range values {
+			if real(v) < 0 {
+				selected[i] = true
+				mWant++
+			}
+		}
+		m, cond, sep, ok := schur.ReorderCond(selected)
+		if !ok {
+			t.Errorf("n=%d: unexpected reordering failure", n)
+			continue
+		}
+		if m != mWant {
+			t.Errorf("n=%d: unexpected dimension of invariant subspace: got %d, want %d", n, m, mWant)
+		}
+		if cond <= 0 || cond > 1 || sep <= 0 {
+			t.Errorf("n=%d: unexpected condition numbers: cond=%v, sep=%v", n, cond, sep)
+		}
+		testSchurDecomposition(t, n, a, &schur, tol)
+		for i, v := range schur.Values(nil) {
+			if (i < m) != (real(v) < 0) {
+				t.Errorf("n=%d: eigenvalue %v at position %d after reordering", n, v, i)
+			}
+		}
+
+		// Check that the leading m Schur vectors span an invariant
+		// subspace, that is, A*Z1 = Z1*T11.
+		if m == 0 {
+			continue
+		}
+		z := schur.ZTo(nil)
+		tmat := schur.TTo(nil)
+		z1 := z.Slice(0, n, 0, m)
+		var az1, z1t11 Dense
+		az1.Mul(a, z1)
+		z1t11.Mul(z1, tmat.Slice(0, m, 0, m))
+		if !EqualApprox(&az1, &z1t11, tol*float64(n)) {
+			t.Errorf("n=%d: leading Schur vectors do not span an invariant subspace", n)
+		}
+
+		// Check that reordering an already reordered decomposition
+		// leaves it unchanged.
+		for i := range selected {
+			selected[i] = i < m
+		}
+		m2, ok := schur.Reorder(selected)
+		if !ok || m2 != m {
+			t.Errorf("n=%d: unexpected result of repeated reordering", n)
+		}
+		if !Equal(schur.TTo(nil), tmat) || !Equal(schur.ZTo(nil), z) {
+			t.Errorf("n=%d: repeated reordering modified the decomposition", n)
+		}
+	}
+}
+
+// testSchurDecomposition checks that the Schur decomposition in schur is
+// consistent with a.
+func testSchurDecomposition(t *testing.T, n int, a Matrix, schur *Schur, tol float64) {
+	tmat := schur.TTo(nil)
+	z := schur.ZTo(nil)
+
+	// Check that T is upper quasi-triangular and its diagonal blocks hold
+	// the eigenvalues.
+	values := schur.Values(nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i-1; j++ {
+			if tmat.At(i, j) != 0 {
+				t.Errorf("n=%d: T not upper quasi-triangular", n)
+				return
+			}
+		}
+		if real(values[i]) != tmat.At(i, i) {
+			t.Errorf("n=%d: eigenvalue %d does not match the diagonal of T", n, i)
+		}
+	}
+
+	// Check that Z is orthogonal.
+	var ztz Dense
+	ztz.Mul(z.T(), z)
+	if !EqualApprox(&ztz, eye(n), tol) {
+		t.Errorf("n=%d: Z not orthogonal", n)
+	}
+
+	// Check that A = Z*T*Z^T.
+	var ztzt Dense
+	ztzt.Product(z, tmat, z.T())
+	if !EqualApprox(&ztzt, a, tol*float64(n)) {
+		t.Errorf("n=%d: A != Z*T*Z^T", n)
+	}
+}
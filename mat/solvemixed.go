@@ -0,0 +1,134 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// SolveMixed solves the linear system
+//  A * X = B
+// for X, where A is an n×n matrix and B is an n×k matrix, using mixed
+// precision iterative refinement, and places the result in the receiver.
+//
+// The LU factorization of A is computed in single precision, which for large
+// matrices takes about half the time of the double precision factorization
+// used by Solve and LU, and the resulting solution is iteratively refined to
+// double precision accuracy using residuals computed in double precision.
+// If A or B cannot be represented in single precision, A is too
+// ill-conditioned for the refinement to converge, or the refinement does not
+// converge, the solution is computed using an LU factorization in double
+// precision instead.
+//
+// SolveMixed returns the number of refinement iterations used. A negative
+// value indicates that the solution was computed in double precision, with
+// the reason given by the value as documented by lapack64.Dsgesv. If A is
+// singular or near-singular, a Condition error is returned. See the
+// documentation for Condition for more information.
+func (m *Dense) SolveMixed(a, b Matrix) (iter int, err error) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	// A and B are copied before the receiver is modified, so the receiver
+	// may share data with them.
+	lu := getWorkspace(n, n, false)
+	defer putWorkspace(lu)
+	lu.Copy(a)
+	bw := getWorkspace(n, bc, false)
+	defer putWorkspace(bw)
+	bw.Copy(b)
+	m.reuseAs(n, bc)
+
+	work := getFloats(max(4*n, n*bc), false)
+	defer putFloats(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	anorm := lapack64.Lange(CondNorm, lu.mat, work)
+
+	ipiv := getInts(n, false)
+	defer putInts(ipiv)
+	swork := make([]float32, n*(n+bc+4))
+	iter, ok := lapack64.Dsgesv(lu.mat, ipiv, bw.mat, m.mat, work, swork, iwork)
+	if !ok {
+		return iter, Condition(math.Inf(1))
+	}
+	if iter < 0 {
+		// The refinement was not attempted or did not converge, so the
+		// double precision factorization may be ill-conditioned.
+		cond := 1 / lapack64.Gecon(CondNorm, lu.mat, anorm, work, iwork)
+		if cond > ConditionTolerance {
+			return iter, Condition(cond)
+		}
+	}
+	return iter, nil
+}
+
+// SolveSymMixed solves the linear system
+//  A * X = B
+// for X, where A is an n×n symmetric positive definite matrix and B is an n×k
+// matrix, using mixed precision iterative refinement, and places the result
+// in the receiver.
+//
+// The Cholesky factorization of A is computed in single precision, which for
+// large matrices takes about half the time of the double precision
+// factorization used by Cholesky, and the resulting solution is iteratively
+// refined to double precision accuracy using residuals computed in double
+// precision. If A or B cannot be represented in single precision, A is not
+// positive definite in single precision, A is too ill-conditioned for the
+// refinement to converge, or the refinement does not converge, the solution
+// is computed using a Cholesky factorization in double precision instead.
+//
+// SolveSymMixed returns the number of refinement iterations used. A negative
+// value indicates that the solution was computed in double precision, with
+// the reason given by the value as documented by lapack64.Dsposv. If A is not
+// positive definite, ErrNotPSD is returned. If A is near-singular, a
+// Condition error is returned. See the documentation for Condition for more
+// information.
+func (m *Dense) SolveSymMixed(a Symmetric, b Matrix) (iter int, err error) {
+	n := a.Symmetric()
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	// A and B are copied before the receiver is modified, so the receiver
+	// may share data with them.
+	sym := getWorkspaceSym(n, false)
+	defer putWorkspaceSym(sym)
+	sym.CopySym(a)
+	bw := getWorkspace(n, bc, false)
+	defer putWorkspace(bw)
+	bw.Copy(b)
+	m.reuseAs(n, bc)
+
+	work := getFloats(max(3*n, n*bc), false)
+	defer putFloats(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	anorm := lapack64.Lansy(CondNorm, sym.mat, work)
+
+	swork := make([]float32, n*(n+bc+3))
+	iter, ok := lapack64.Dsposv(sym.mat, bw.mat, m.mat, work, swork, iwork)
+	if !ok {
+		return iter, ErrNotPSD
+	}
+	if iter < 0 {
+		// The refinement was not attempted or did not converge, so the
+		// double precision factorization may be ill-conditioned.
+		cond := 1 / lapack64.Pocon(sym.mat, anorm, work, iwork)
+		if cond > ConditionTolerance {
+			return iter, Condition(cond)
+		}
+	}
+	return iter, nil
+}
@@ -0,0 +1,151 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSolveMixed(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, bc int
+	}{
+		{1, 1},
+		{3, 2},
+		{10, 1},
+		{50, 3},
+		{100, 5},
+	} {
+		n, bc := test.n, test.bc
+		a := NewDense(n, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+		}
+		// Make A diagonally dominant so that it is well conditioned.
+		for i := 0; i < n; i++ {
+			a.Set(i, i, a.At(i, i)+float64(2*n))
+		}
+		b := NewDense(n, bc, nil)
+		for i := range b.mat.Data {
+			b.mat.Data[i] = rnd.NormFloat64()
+		}
+
+		var want Dense
+		if err := want.Solve(a, b); err != nil {
+			t.Fatalf("n=%d: unexpected error from Solve: %v", n, err)
+		}
+
+		var x Dense
+		iter, err := x.SolveMixed(a, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+		}
+		if iter < 0 {
+			t.Errorf("n=%d: unexpected fall back to double precision: iter=%d", n, iter)
+		}
+		if !EqualApprox(&x, &want, tol) {
+			t.Errorf("n=%d: solution mismatch", n)
+		}
+
+		// Check that the receiver may be the right-hand side.
+		bCopy := DenseCopyOf(b)
+		if _, err := bCopy.SolveMixed(a, bCopy); err != nil {
+			t.Errorf("n=%d: unexpected error with aliased receiver: %v", n, err)
+		}
+		if !EqualApprox(bCopy, &want, tol) {
+			t.Errorf("n=%d: solution mismatch with aliased receiver", n)
+		}
+	}
+
+	// An ill-conditioned matrix is solved in double precision.
+	const n = 12
+	h := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			h.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+	var x Dense
+	iter, err := x.SolveMixed(h, eye(n))
+	if iter >= 0 {
+		t.Errorf("unexpected mixed precision solution of Hilbert matrix: iter=%d", iter)
+	}
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for Hilbert matrix: got %v, want Condition", err)
+	}
+
+	// A singular matrix returns an infinite condition number.
+	var y Dense
+	iter, err = y.SolveMixed(NewDense(2, 2, nil), eye(2))
+	if iter >= 0 {
+		t.Errorf("unexpected mixed precision solution of singular matrix: iter=%d", iter)
+	}
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for singular matrix: got %v, want Condition", err)
+	}
+}
+
+func TestSolveSymMixed(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, bc int
+	}{
+		{1, 1},
+		{3, 2},
+		{10, 1},
+		{50, 3},
+		{100, 5},
+	} {
+		n, bc := test.n, test.bc
+		a := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				a.SetSym(i, j, rnd.NormFloat64())
+			}
+			a.SetSym(i, i, a.At(i, i)+float64(2*n))
+		}
+		b := NewDense(n, bc, nil)
+		for i := range b.mat.Data {
+			b.mat.Data[i] = rnd.NormFloat64()
+		}
+
+		var chol Cholesky
+		if !chol.Factorize(a) {
+			t.Fatalf("n=%d: unexpected Cholesky failure", n)
+		}
+		var want Dense
+		if err := chol.Solve(&want, b); err != nil {
+			t.Fatalf("n=%d: unexpected error from Cholesky.Solve: %v", n, err)
+		}
+
+		var x Dense
+		iter, err := x.SolveSymMixed(a, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+		}
+		if iter < 0 {
+			t.Errorf("n=%d: unexpected fall back to double precision: iter=%d", n, iter)
+		}
+		if !EqualApprox(&x, &want, tol) {
+			t.Errorf("n=%d: solution mismatch", n)
+		}
+	}
+
+	// An indefinite matrix returns ErrNotPSD.
+	a := NewSymDense(2, []float64{1, 2, 2, 1})
+	var x Dense
+	iter, err := x.SolveSymMixed(a, eye(2))
+	if iter >= 0 {
+		t.Errorf("unexpected mixed precision solution of indefinite matrix: iter=%d", iter)
+	}
+	if err != ErrNotPSD {
+		t.Errorf("unexpected error for indefinite matrix: got %v, want %v", err, ErrNotPSD)
+	}
+}
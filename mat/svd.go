@@ -13,6 +13,12 @@ import (
 // SVD is a type for creating and using the Singular Value Decomposition (SVD)
 // of a matrix.
 type SVD struct {
+	// DivideConquer specifies whether Factorize uses a divide and
+	// conquer algorithm. The divide and conquer algorithm is usually
+	// substantially faster for large matrices when singular vectors are
+	// computed, at the cost of additional workspace.
+	DivideConquer bool
+
 	kind SVDKind
 
 	s  []float64
@@ -38,6 +44,10 @@ type SVD struct {
 // orthogonal matrices U and V (kind = SVDThin). The thin representation can
 // save a significant amount of memory if m >> n or m << n.
 //
+// If the DivideConquer field of the receiver is true, the decomposition is
+// computed using a divide and conquer algorithm, otherwise it is computed
+// using implicit QR iteration.
+//
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, routines that require a successful factorization will panic.
 func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
@@ -95,9 +105,19 @@ func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 	svd.s = use(svd.s, min(m, n))
 
 	work := []float64{0}
-	lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1)
-	work = getFloats(int(work[0]), false)
-	ok = lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work))
+	if svd.DivideConquer {
+		// Dgesdd computes the left and right singular vectors together
+		// so jobU and jobVT are always equal.
+		iwork := getInts(8*min(m, n), false)
+		lapack64.Gesdd(jobU, aCopy.mat, svd.u, svd.vt, svd.s, work, -1, iwork)
+		work = getFloats(int(work[0]), false)
+		ok = lapack64.Gesdd(jobU, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work), iwork)
+		putInts(iwork)
+	} else {
+		lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1)
+		work = getFloats(int(work[0]), false)
+		ok = lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work))
+	}
 	putFloats(work)
 	if !ok {
 		svd.kind = 0
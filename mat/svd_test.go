@@ -5,6 +5,7 @@
 package mat
 
 import (
+	"fmt"
 	"testing"
 
 	"golang.org/x/exp/rand"
@@ -102,15 +103,16 @@ func TestSVD(t *testing.T) {
 	} {
 		m := test.m
 		n := test.n
-		for trial := 0; trial < 10; trial++ {
+		for trial := 0; trial < 20; trial++ {
 			a := NewDense(m, n, nil)
 			for i := range a.mat.Data {
 				a.mat.Data[i] = rand.NormFloat64()
 			}
 			aCopy := DenseCopyOf(a)
 
-			// Test Full decomposition.
-			var svd SVD
+			// Test Full decomposition, alternating between the QR
+			// iteration and divide and conquer algorithms.
+			svd := SVD{DivideConquer: trial%2 == 1}
 			ok := svd.Factorize(a, SVDFull)
 			if !ok {
 				t.Errorf("SVD factorization failed")
@@ -171,3 +173,35 @@ func TestSVD(t *testing.T) {
 func extractSVD(svd *SVD) (s []float64, u, v *Dense) {
 	return svd.Values(nil), svd.UTo(nil), svd.VTo(nil)
 }
+
+func BenchmarkSVDFactorize(b *testing.B) {
+	for _, dc := range []bool{false, true} {
+		alg := "Dgesvd"
+		if dc {
+			alg = "Dgesdd"
+		}
+		for _, size := range []int{10, 100, 500} {
+			for _, kind := range []SVDKind{SVDNone, SVDThin, SVDFull} {
+				a, _ := randDense(size, 1, rand.NormFloat64)
+				b.Run(fmt.Sprintf("%s/%d/%s", alg, size, svdKindString(kind)), func(b *testing.B) {
+					svd := SVD{DivideConquer: dc}
+					for i := 0; i < b.N; i++ {
+						svd.Factorize(a, kind)
+					}
+				})
+			}
+		}
+	}
+}
+
+func svdKindString(kind SVDKind) string {
+	switch kind {
+	case SVDNone:
+		return "None"
+	case SVDThin:
+		return "Thin"
+	case SVDFull:
+		return "Full"
+	}
+	return "unknown"
+}
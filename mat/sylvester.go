@@ -0,0 +1,343 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// SolveSylvester solves the Sylvester matrix equation
+//  A * X + X * B = C
+// for X using the Bartels-Stewart algorithm and stores the result into the
+// receiver. A must be m×m, B must be n×n and C must be m×n, otherwise
+// SolveSylvester will panic.
+//
+// The equation has a unique solution if and only if A and -B have no common
+// eigenvalues. If they have common or very close eigenvalues, the solution is
+// computed using perturbed values and a Condition error is returned. If the
+// Schur decomposition of A or B fails, ErrFailedEigen is returned.
+func (m *Dense) SolveSylvester(a, b, c Matrix) error {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != ac || br != bc {
+		panic(ErrSquare)
+	}
+	cr, cc := c.Dims()
+	if cr != ar || cc != br {
+		panic(ErrShape)
+	}
+
+	var sa, sb Schur
+	if !sa.Factorize(a, true) || !sb.Factorize(b, true) {
+		return ErrFailedEigen
+	}
+
+	// Transform the equation into T_A * Y + Y * T_B = Z_A^T * C * Z_B with
+	// X = Z_A * Y * Z_B^T.
+	var y Dense
+	y.Product(sa.z.T(), c, sb.z)
+	scale, ok := lapack64.Trsyl(blas.NoTrans, blas.NoTrans, 1, sa.t.mat, sb.t.mat, y.mat)
+
+	m.reuseAs(ar, br)
+	m.Product(sa.z, &y, sb.z.T())
+	if scale != 1 {
+		m.Scale(1/scale, m)
+	}
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// SolveLyapunov solves the continuous-time Lyapunov equation
+//  A * X + X * A^T + Q = 0
+// for X and stores the result into the receiver. A and Q must be n×n,
+// otherwise SolveLyapunov will panic. If Q is symmetric, so is the solution.
+// For a stable A and a positive semi-definite Q = B * B^T, X is the
+// controllability Gramian of the system defined by A and B.
+//
+// The equation has a unique solution if and only if no two eigenvalues of A
+// sum to zero. If some eigenvalues are close to violating this condition, the
+// solution is computed using perturbed values and a Condition error is
+// returned. If the Schur decomposition of A fails, ErrFailedEigen is returned.
+func (m *Dense) SolveLyapunov(a, q Matrix) error {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	qr, qc := q.Dims()
+	if qr != n || qc != n {
+		panic(ErrShape)
+	}
+
+	var s Schur
+	if !s.Factorize(a, true) {
+		return ErrFailedEigen
+	}
+
+	// Transform the equation into T * Y + Y * T^T = -Z^T * Q * Z with
+	// X = Z * Y * Z^T.
+	var y Dense
+	y.Product(s.z.T(), q, s.z)
+	y.Scale(-1, &y)
+	scale, ok := lapack64.Trsyl(blas.NoTrans, blas.Trans, 1, s.t.mat, s.t.mat, y.mat)
+
+	m.reuseAs(n, n)
+	m.Product(s.z, &y, s.z.T())
+	if scale != 1 {
+		m.Scale(1/scale, m)
+	}
+	if _, isSym := q.(Symmetric); isSym {
+		m.symmetrize()
+	}
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// SolveDiscreteLyapunov solves the discrete-time Lyapunov equation, also
+// known as the Stein equation,
+//  A * X * A^T - X + Q = 0
+// for X and stores the result into the receiver. A and Q must be n×n,
+// otherwise SolveDiscreteLyapunov will panic. If Q is symmetric, so is the
+// solution.
+//
+// The equation has a unique solution if and only if no product of two
+// eigenvalues of A is equal to one. If some eigenvalues are close to violating
+// this condition, the solution is computed using perturbed values and a
+// Condition error is returned. If the Schur decomposition of A fails,
+// ErrFailedEigen is returned.
+func (m *Dense) SolveDiscreteLyapunov(a, q Matrix) error {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	qr, qc := q.Dims()
+	if qr != n || qc != n {
+		panic(ErrShape)
+	}
+
+	var s Schur
+	if !s.Factorize(a, true) {
+		return ErrFailedEigen
+	}
+
+	// Transform the equation into T * Y * T^T - Y = -Z^T * Q * Z with
+	// X = Z * Y * Z^T.
+	var y Dense
+	y.Product(s.z.T(), q, s.z)
+	y.Scale(-1, &y)
+	ok := solveQuasiTriStein(s.t.mat, y.mat)
+
+	m.reuseAs(n, n)
+	m.Product(s.z, &y, s.z.T())
+	if _, isSym := q.(Symmetric); isSym {
+		m.symmetrize()
+	}
+	if !ok {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// symmetrize replaces the square matrix m by (m + m^T)/2.
+func (m *Dense) symmetrize() {
+	n := m.mat.Rows
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			v := (m.at(i, j) + m.at(j, i)) / 2
+			m.set(i, j, v)
+			m.set(j, i, v)
+		}
+	}
+}
+
+// solveQuasiTriStein solves the Stein equation
+//  T * Y * T^T - Y = C
+// where T is an n×n upper quasi-triangular matrix in Schur canonical form. On
+// return, C is overwritten by the solution Y. solveQuasiTriStein returns
+// false if T has eigenvalues whose product is close to one, in which case
+// perturbed values were used to solve the equation.
+//
+// The solution is computed one diagonal block column of Y at a time, starting
+// from the last one, by a block back substitution with the diagonal blocks of
+// T. The resulting algorithm needs O(n^3) operations.
+func solveQuasiTriStein(t, c blas64.General) (ok bool) {
+	n := t.Rows
+	bi := blas64.Implementation()
+
+	blocks := schurBlockStarts(t)
+	blockSize := func(k int) int {
+		if k == len(blocks)-1 {
+			return n - blocks[k]
+		}
+		return blocks[k+1] - blocks[k]
+	}
+
+	// Pivots of the small systems below smin are perturbed to smin, where
+	// eps is the relative machine precision and minNormal is the smallest
+	// normalized positive number.
+	const (
+		eps       = 1.0 / (1 << 52)
+		minNormal = 2.2250738585072014e-308
+	)
+	tnorm := 0.0
+	for i := 0; i < n; i++ {
+		for _, v := range t.Data[i*t.Stride+max(0, i-1) : i*t.Stride+n] {
+			tnorm = math.Max(tnorm, math.Abs(v))
+		}
+	}
+	smin := math.Max(eps*tnorm*tnorm, minNormal)
+
+	ok = true
+	w := make([]float64, 2*n)
+	var kron [16]float64
+	var rhs [4]float64
+	for l := len(blocks) - 1; l >= 0; l-- {
+		l0 := blocks[l]
+		lb := blockSize(l)
+		l1 := l0 + lb
+
+		// Compute R = C[:,l0:l1] - T * Y[:,l1:] * T[l0:l1,l1:]^T, where
+		// the columns of Y to the right of block l are already known.
+		if l1 < n {
+			// W = Y[:,l1:] * T[l0:l1,l1:]^T is stored in w as n×lb.
+			bi.Dgemm(blas.NoTrans, blas.Trans, n, lb, n-l1, 1, c.Data[l1:], c.Stride, t.Data[l0*t.Stride+l1:], t.Stride, 0, w, lb)
+			bi.Dgemm(blas.NoTrans, blas.NoTrans, n, lb, n, -1, t.Data, t.Stride, w, lb, 1, c.Data[l0:], c.Stride)
+		}
+
+		// Solve T * Y_l * S^T - Y_l = R for the block column Y_l, where S is
+		// the l-th diagonal block of T, by back substitution over the
+		// diagonal blocks of T.
+		s := t.Data[l0*t.Stride+l0:]
+		for k := len(blocks) - 1; k >= 0; k-- {
+			k0 := blocks[k]
+			kb := blockSize(k)
+			k1 := k0 + kb
+
+			// V = T[k0:k1,k1:] * Y_l[k1:,:] is stored in w as kb×lb.
+			for i := 0; i < kb*lb; i++ {
+				w[i] = 0
+			}
+			if k1 < n {
+				bi.Dgemm(blas.NoTrans, blas.NoTrans, kb, lb, n-k1, 1, t.Data[k0*t.Stride+k1:], t.Stride, c.Data[k1*c.Stride+l0:], c.Stride, 0, w, lb)
+			}
+			// rhs = R[k0:k1,:] - V * S^T.
+			for i := 0; i < kb; i++ {
+				for j := 0; j < lb; j++ {
+					v := c.Data[(k0+i)*c.Stride+l0+j]
+					for p := 0; p < lb; p++ {
+						v -= w[i*lb+p] * s[j*t.Stride+p]
+					}
+					rhs[i*lb+j] = v
+				}
+			}
+
+			// Solve T_kk * y * S^T - y = rhs using the row-major
+			// vectorization (T_kk ⊗ S - I) vec(y) = vec(rhs).
+			tk := t.Data[k0*t.Stride+k0:]
+			sz := kb * lb
+			for i1 := 0; i1 < kb; i1++ {
+				for j1 := 0; j1 < lb; j1++ {
+					for i2 := 0; i2 < kb; i2++ {
+						for j2 := 0; j2 < lb; j2++ {
+							kron[(i1*lb+j1)*sz+i2*lb+j2] = tk[i1*t.Stride+i2] * s[j1*t.Stride+j2]
+						}
+					}
+				}
+			}
+			for i := 0; i < sz; i++ {
+				kron[i*sz+i]--
+			}
+			if !solveSmall(sz, kron[:sz*sz], rhs[:sz], smin) {
+				ok = false
+			}
+			for i := 0; i < kb; i++ {
+				copy(c.Data[(k0+i)*c.Stride+l0:(k0+i)*c.Stride+l1], rhs[i*lb:(i+1)*lb])
+			}
+		}
+	}
+	return ok
+}
+
+// solveSmall solves the n×n system a * x = b stored in row-major order using
+// Gaussian elimination with complete pivoting. On return, b is overwritten by
+// the solution. Pivots smaller than smin in absolute value are replaced by
+// smin, in which case solveSmall returns false.
+func solveSmall(n int, a, b []float64, smin float64) (ok bool) {
+	ok = true
+	var colPerm [4]int
+	for i := 0; i < n; i++ {
+		colPerm[i] = i
+	}
+	for k := 0; k < n; k++ {
+		// Find the pivot in the trailing submatrix.
+		pr, pc := k, k
+		pmax := 0.0
+		for i := k; i < n; i++ {
+			for j := k; j < n; j++ {
+				if v := math.Abs(a[i*n+j]); v > pmax {
+					pr, pc, pmax = i, j, v
+				}
+			}
+		}
+		if pr != k {
+			for j := 0; j < n; j++ {
+				a[k*n+j], a[pr*n+j] = a[pr*n+j], a[k*n+j]
+			}
+			b[k], b[pr] = b[pr], b[k]
+		}
+		if pc != k {
+			for i := 0; i < n; i++ {
+				a[i*n+k], a[i*n+pc] = a[i*n+pc], a[i*n+k]
+			}
+			colPerm[k], colPerm[pc] = colPerm[pc], colPerm[k]
+		}
+		if pmax < smin {
+			a[k*n+k] = smin
+			ok = false
+		}
+		for i := k + 1; i < n; i++ {
+			f := a[i*n+k] / a[k*n+k]
+			for j := k + 1; j < n; j++ {
+				a[i*n+j] -= f * a[k*n+j]
+			}
+			b[i] -= f * b[k]
+		}
+	}
+	var x [4]float64
+	for i := n - 1; i >= 0; i-- {
+		v := b[i]
+		for j := i + 1; j < n; j++ {
+			v -= a[i*n+j] * x[j]
+		}
+		x[i] = v / a[i*n+i]
+	}
+	for i := 0; i < n; i++ {
+		b[colPerm[i]] = x[i]
+	}
+	return ok
+}
+
+// schurBlockStarts returns the indices of the first rows of the diagonal
+// blocks of the upper quasi-triangular matrix t in Schur canonical form.
+func schurBlockStarts(t blas64.General) []int {
+	n := t.Rows
+	var blocks []int
+	for i := 0; i < n; {
+		blocks = append(blocks, i)
+		if i < n-1 && t.Data[(i+1)*t.Stride+i] != 0 {
+			i += 2
+		} else {
+			i++
+		}
+	}
+	return blocks
+}
@@ -0,0 +1,205 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSolveSylvester(t *testing.T) {
+	const tol = 1e-11
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{1, 2, 3, 5, 10, 25} {
+		for _, n := range []int{1, 2, 3, 5, 10, 25} {
+			// Shift the spectra of A and -B apart so that the equation
+			// is well conditioned.
+			a := randNormDense(m, rnd)
+			b := randNormDense(n, rnd)
+			for i := 0; i < m; i++ {
+				a.set(i, i, a.at(i, i)+10)
+			}
+			for i := 0; i < n; i++ {
+				b.set(i, i, b.at(i, i)+10)
+			}
+			want := NewDense(m, n, nil)
+			for i := range want.mat.Data {
+				want.mat.Data[i] = rnd.NormFloat64()
+			}
+			var c, tmp Dense
+			c.Mul(a, want)
+			tmp.Mul(want, b)
+			c.Add(&c, &tmp)
+
+			var x Dense
+			err := x.SolveSylvester(a, b, &c)
+			if err != nil {
+				t.Errorf("m=%d,n=%d: unexpected error: %v", m, n, err)
+				continue
+			}
+			if !EqualApprox(&x, want, tol) {
+				t.Errorf("m=%d,n=%d: unexpected solution", m, n)
+			}
+
+			// Check that the receiver can alias C.
+			err = c.SolveSylvester(a, b, &c)
+			if err != nil || !Equal(&c, &x) {
+				t.Errorf("m=%d,n=%d: unexpected result when the receiver is C", m, n)
+			}
+		}
+	}
+
+	// A and -B share the eigenvalue 1.
+	a := NewDense(2, 2, []float64{1, 2, 0, 3})
+	b := NewDense(1, 1, []float64{-1})
+	var x Dense
+	if _, ok := x.SolveSylvester(a, b, NewDense(2, 1, []float64{1, 1})).(Condition); !ok {
+		t.Errorf("no Condition error for singular equation")
+	}
+
+	if panicked, _ := panics(func() { x.Reset(); x.SolveSylvester(a, b, NewDense(1, 2, nil)) }); !panicked {
+		t.Errorf("no panic for mismatched C")
+	}
+}
+
+func TestSolveLyapunov(t *testing.T) {
+	const tol = 1e-11
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 25, 50} {
+		for _, sym := range []bool{false, true} {
+			name := fmt.Sprintf("n=%d,sym=%t", n, sym)
+
+			a := stableDense(n, rnd)
+			var q Matrix
+			if sym {
+				b := NewDense(n, 2, nil)
+				for i := range b.mat.Data {
+					b.mat.Data[i] = rnd.NormFloat64()
+				}
+				var bbt SymDense
+				bbt.SymOuterK(1, b)
+				q = &bbt
+			} else {
+				q = randNormDense(n, rnd)
+			}
+
+			var x Dense
+			err := x.SolveLyapunov(a, q)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+
+			// Check the residual A*X + X*A^T + Q.
+			var r, tmp Dense
+			r.Mul(a, &x)
+			tmp.Mul(&x, a.T())
+			r.Add(&r, &tmp)
+			r.Add(&r, q)
+			xnorm := math.Max(1, Norm(&x, 1))
+			if resid := Norm(&r, 1) / (Norm(a, 1) * xnorm); resid > tol {
+				t.Errorf("%s: unexpected residual: %v", name, resid)
+			}
+
+			if !sym {
+				continue
+			}
+			if !Equal(&x, x.T()) {
+				t.Errorf("%s: solution not symmetric", name)
+			}
+			// For a stable A and positive semi-definite Q, X is positive
+			// semi-definite.
+			var eig EigenSym
+			if ok := eig.Factorize(NewSymDense(n, x.RawMatrix().Data), false); !ok {
+				t.Errorf("%s: unexpected eigendecomposition failure", name)
+				continue
+			}
+			for _, v := range eig.Values(nil) {
+				if v < -tol*xnorm {
+					t.Errorf("%s: Gramian not positive semi-definite", name)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestSolveDiscreteLyapunov(t *testing.T) {
+	const tol = 1e-11
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 25, 50} {
+		for _, sym := range []bool{false, true} {
+			name := fmt.Sprintf("n=%d,sym=%t", n, sym)
+
+			// Scale A so that its spectral radius is less than one and
+			// the equation is well conditioned.
+			a := randNormDense(n, rnd)
+			a.Scale(0.5/math.Sqrt(float64(n)), a)
+			var q Matrix
+			if sym {
+				b := randNormDense(n, rnd)
+				var bbt SymDense
+				bbt.SymOuterK(1, b)
+				q = &bbt
+			} else {
+				q = randNormDense(n, rnd)
+			}
+
+			var x Dense
+			err := x.SolveDiscreteLyapunov(a, q)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+
+			// Check the residual A*X*A^T - X + Q.
+			var r Dense
+			r.Product(a, &x, a.T())
+			r.Sub(&r, &x)
+			r.Add(&r, q)
+			if resid := Norm(&r, 1) / math.Max(1, Norm(&x, 1)); resid > tol {
+				t.Errorf("%s: unexpected residual: %v", name, resid)
+			}
+			if sym && !Equal(&x, x.T()) {
+				t.Errorf("%s: solution not symmetric", name)
+			}
+		}
+	}
+
+	// The eigenvalues 2 and 0.5 of A have product one.
+	a := NewDense(2, 2, []float64{2, 1, 0, 0.5})
+	var x Dense
+	if _, ok := x.SolveDiscreteLyapunov(a, eye(2)).(Condition); !ok {
+		t.Errorf("no Condition error for singular equation")
+	}
+}
+
+// randNormDense returns an n×n matrix with normally distributed elements.
+func randNormDense(n int, rnd *rand.Rand) *Dense {
+	a := NewDense(n, n, nil)
+	for i := range a.mat.Data {
+		a.mat.Data[i] = rnd.NormFloat64()
+	}
+	return a
+}
+
+// stableDense returns a random n×n matrix with all eigenvalues in the open
+// left half-plane.
+func stableDense(n int, rnd *rand.Rand) *Dense {
+	a := randNormDense(n, rnd)
+	// Shift the spectrum by more than the spectral radius of A which is
+	// bounded by its infinity norm.
+	shift := Norm(a, math.Inf(1)) + 1
+	for i := 0; i < n; i++ {
+		a.set(i, i, a.at(i, i)-shift)
+	}
+	return a
+}
@@ -0,0 +1,219 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// TSQR is a type for computing the QR factorization of a tall and skinny
+// matrix, that is an m×n matrix A with m much larger than n. The rows of A are
+// supplied in consecutive blocks by calls to AddRows, so A does not need to be
+// held in memory at once and may be streamed from an external source.
+//
+// Each call to AddRows updates the R factor of the factorization
+//  A = Q * R,
+// where R is a min(m,n)×n upper trapezoidal matrix, by computing the QR
+// factorization of R stacked on top of the new block of rows. The singular
+// values and the right singular vectors of A are equal to those of R, so they
+// can be obtained by factorizing R without keeping Q.
+//
+// If KeepQ is true, the orthogonal factors of each step are retained so that
+// the thin m×min(m,n) matrix Q and the left singular vectors of A can also be
+// computed. This requires storage comparable to A itself. KeepQ must not be
+// changed after the first call to AddRows.
+type TSQR struct {
+	// KeepQ specifies whether the orthogonal factors are retained.
+	KeepQ bool
+
+	m, n int
+	r    *Dense
+
+	blocks []tsqrBlock
+}
+
+// tsqrBlock holds the QR factorization of an R factor stacked on top of a
+// block of rows of A.
+type tsqrBlock struct {
+	// qr holds the elementary reflectors computed by Geqrf.
+	qr  blas64.General
+	tau []float64
+	// k is the number of rows of the R factor in the top of qr.
+	k int
+}
+
+// Reset resets the factorization so that it can be reused for a new matrix.
+func (t *TSQR) Reset() {
+	t.m = 0
+	t.n = 0
+	t.r = nil
+	t.blocks = nil
+}
+
+// AddRows updates the factorization with the next block of rows of A. All
+// blocks must have the same number of columns.
+func (t *TSQR) AddRows(a Matrix) {
+	r, c := a.Dims()
+	if t.m == 0 {
+		t.n = c
+	} else if c != t.n {
+		panic(ErrShape)
+	}
+	n := t.n
+
+	// Stack the current R factor on top of the new block.
+	var k int
+	if t.r != nil {
+		k, _ = t.r.Dims()
+	}
+	w := NewDense(k+r, n, nil)
+	if k > 0 {
+		w.Slice(0, k, 0, n).(*Dense).Copy(t.r)
+	}
+	w.Slice(k, k+r, 0, n).(*Dense).Copy(a)
+
+	kr := min(k+r, n)
+	tau := make([]float64, kr)
+	work := []float64{0}
+	lapack64.Geqrf(w.mat, tau, work, -1)
+	work = getFloats(int(work[0]), false)
+	lapack64.Geqrf(w.mat, tau, work, len(work))
+	putFloats(work)
+
+	// Extract the updated R factor.
+	rf := NewDense(kr, n, nil)
+	for i := 0; i < kr; i++ {
+		copy(rf.mat.Data[i*rf.mat.Stride+i:i*rf.mat.Stride+n], w.mat.Data[i*w.mat.Stride+i:i*w.mat.Stride+n])
+	}
+	t.r = rf
+	t.m += r
+
+	if t.KeepQ {
+		t.blocks = append(t.blocks, tsqrBlock{qr: w.mat, tau: tau, k: k})
+	}
+}
+
+// Dims returns the number of rows and columns of the matrix A added so far.
+func (t *TSQR) Dims() (m, n int) {
+	return t.m, t.n
+}
+
+// RTo extracts the min(m,n)×n upper trapezoidal matrix R from the
+// factorization. If dst is nil, a new matrix is allocated. The resulting dst
+// matrix is returned. RTo will panic if no rows have been added.
+func (t *TSQR) RTo(dst *Dense) *Dense {
+	if t.r == nil {
+		panic("mat: no rows added to TSQR")
+	}
+	r, c := t.r.Dims()
+	if dst == nil {
+		dst = NewDense(r, c, nil)
+	} else {
+		dst.reuseAs(r, c)
+	}
+	dst.Copy(t.r)
+	return dst
+}
+
+// QTo extracts the thin m×min(m,n) orthonormal matrix Q from the
+// factorization. If dst is nil, a new matrix is allocated. The resulting dst
+// matrix is returned. QTo will panic if KeepQ was false when the rows were
+// added.
+func (t *TSQR) QTo(dst *Dense) *Dense {
+	if t.r == nil {
+		panic("mat: no rows added to TSQR")
+	}
+	k, _ := t.r.Dims()
+	x := NewDense(k, k, nil)
+	for i := 0; i < k; i++ {
+		x.mat.Data[i*x.mat.Stride+i] = 1
+	}
+	return t.mulQ(dst, x)
+}
+
+// mulQ computes Q*X where Q is the thin orthonormal factor and X is a
+// min(m,n)×p matrix, placing the m×p result into dst. If dst is nil, a new
+// matrix is allocated.
+func (t *TSQR) mulQ(dst *Dense, x *Dense) *Dense {
+	if !t.KeepQ || len(t.blocks) == 0 {
+		panic("mat: TSQR orthogonal factors not kept")
+	}
+	_, p := x.Dims()
+	if dst == nil {
+		dst = NewDense(t.m, p, nil)
+	} else {
+		dst.reuseAs(t.m, p)
+	}
+
+	// Apply the orthogonal factors from the last block to the first. At
+	// each step, the top rows of the result are the coefficients with
+	// respect to the R factor of the previous step and the bottom rows are
+	// the rows of Q*X corresponding to the block.
+	y := DenseCopyOf(x)
+	row := t.m
+	for i := len(t.blocks) - 1; i >= 0; i-- {
+		blk := t.blocks[i]
+		rows := blk.qr.Rows
+		yr, _ := y.Dims()
+		z := NewDense(rows, p, nil)
+		z.Slice(0, yr, 0, p).(*Dense).Copy(y)
+
+		a := blk.qr
+		a.Cols = len(blk.tau)
+		work := []float64{0}
+		lapack64.Ormqr(blas.Left, blas.NoTrans, a, blk.tau, z.mat, work, -1)
+		work = getFloats(int(work[0]), false)
+		lapack64.Ormqr(blas.Left, blas.NoTrans, a, blk.tau, z.mat, work, len(work))
+		putFloats(work)
+
+		b := rows - blk.k
+		row -= b
+		dst.Slice(row, row+b, 0, p).(*Dense).Copy(z.Slice(blk.k, rows, 0, p))
+		if blk.k > 0 {
+			y = DenseCopyOf(z.Slice(0, blk.k, 0, p))
+		}
+	}
+	return dst
+}
+
+// FactorizeTSQR computes the singular value decomposition of the matrix A
+// represented by the tall and skinny QR factorization t. The singular values
+// and right singular vectors of A are computed from the R factor of t, so A
+// is not accessed.
+//
+// kind must be SVDNone or SVDThin, and FactorizeTSQR will panic otherwise. If
+// kind is SVDThin, the left singular vectors are computed from the orthogonal
+// factors of t, and t.KeepQ must have been true when the rows were added.
+//
+// FactorizeTSQR returns whether the decomposition succeeded. If the
+// decomposition failed, routines that require a successful factorization
+// will panic.
+func (svd *SVD) FactorizeTSQR(t *TSQR, kind SVDKind) (ok bool) {
+	if kind != SVDNone && kind != SVDThin {
+		panic("svd: bad input kind")
+	}
+	if kind == SVDThin && !t.KeepQ {
+		panic("mat: TSQR orthogonal factors not kept")
+	}
+	r := t.RTo(nil)
+	if !svd.Factorize(r, kind) {
+		return false
+	}
+	if kind == SVDNone {
+		return true
+	}
+
+	// The left singular vectors of A are Q times those of R.
+	ur := &Dense{
+		mat:     svd.u,
+		capRows: svd.u.Rows,
+		capCols: svd.u.Cols,
+	}
+	u := t.mulQ(nil, ur)
+	svd.u = u.mat
+	return true
+}
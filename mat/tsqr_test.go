@@ -0,0 +1,125 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestTSQR(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n   int
+		blocks []int
+	}{
+		{1, 1, []int{1}},
+		{10, 3, []int{10}},
+		{10, 3, []int{1, 1, 8}},
+		{10, 3, []int{3, 3, 4}},
+		{5, 8, []int{2, 3}},
+		{200, 20, []int{50, 50, 50, 50}},
+		{200, 20, []int{7, 93, 100}},
+	} {
+		m, n := test.m, test.n
+		name := fmt.Sprintf("m=%d,n=%d,blocks=%v", m, n, test.blocks)
+		a := NewDense(m, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+		}
+
+		tsqr := TSQR{KeepQ: true}
+		var row int
+		for _, b := range test.blocks {
+			tsqr.AddRows(a.Slice(row, row+b, 0, n))
+			row += b
+		}
+		if r, c := tsqr.Dims(); r != m || c != n {
+			t.Errorf("%s: unexpected dimensions: got %d×%d", name, r, c)
+		}
+
+		k := min(m, n)
+		q := tsqr.QTo(nil)
+		if r, c := q.Dims(); r != m || c != k {
+			t.Fatalf("%s: unexpected size of Q: got %d×%d", name, r, c)
+		}
+		var qtq Dense
+		qtq.Mul(q.T(), q)
+		if !EqualApprox(&qtq, eye(k), tol) {
+			t.Errorf("%s: Q does not have orthonormal columns", name)
+		}
+		r := tsqr.RTo(nil)
+		for i := 0; i < k; i++ {
+			for j := 0; j < i; j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("%s: R is not upper trapezoidal", name)
+				}
+			}
+		}
+		var qr Dense
+		qr.Mul(q, r)
+		if !EqualApprox(&qr, a, tol*float64(n)) {
+			t.Errorf("%s: Q*R != A", name)
+		}
+
+		// Compare the singular value decomposition with the one computed
+		// directly.
+		var want, got SVD
+		if !want.Factorize(a, SVDThin) {
+			t.Fatalf("%s: SVD failed", name)
+		}
+		if !got.FactorizeTSQR(&tsqr, SVDThin) {
+			t.Fatalf("%s: FactorizeTSQR failed", name)
+		}
+		sWant := want.Values(nil)
+		sGot := got.Values(nil)
+		for i := range sWant {
+			if math.Abs(sGot[i]-sWant[i]) > tol*sWant[0] {
+				t.Errorf("%s: singular value mismatch at %d: got %v, want %v", name, i, sGot[i], sWant[i])
+			}
+		}
+		u := got.UTo(nil)
+		v := got.VTo(nil)
+		var us, usv Dense
+		us.Mul(u, NewDiagDense(k, sGot))
+		usv.Mul(&us, v.T())
+		if !EqualApprox(&usv, a, tol*float64(n)) {
+			t.Errorf("%s: U*Σ*V^T != A", name)
+		}
+	}
+}
+
+func TestTSQRNoQ(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a := NewDense(100, 10, nil)
+	for i := range a.mat.Data {
+		a.mat.Data[i] = rnd.NormFloat64()
+	}
+	var tsqr TSQR
+	for i := 0; i < 100; i += 25 {
+		tsqr.AddRows(a.Slice(i, i+25, 0, 10))
+	}
+	var svd SVD
+	if !svd.FactorizeTSQR(&tsqr, SVDNone) {
+		t.Fatal("FactorizeTSQR failed")
+	}
+	var want SVD
+	want.Factorize(a, SVDNone)
+	if !floats.EqualApprox(svd.Values(nil), want.Values(nil), 1e-12) {
+		t.Error("singular value mismatch")
+	}
+	if panicked, _ := panics(func() { tsqr.QTo(nil) }); !panicked {
+		t.Error("QTo did not panic without kept orthogonal factors")
+	}
+	if panicked, _ := panics(func() { svd.FactorizeTSQR(&tsqr, SVDThin) }); !panicked {
+		t.Error("FactorizeTSQR did not panic without kept orthogonal factors")
+	}
+}
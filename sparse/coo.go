@@ -0,0 +1,169 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	coo *COO
+
+	_ mat.Matrix      = coo
+	_ mat.NonZeroDoer = coo
+	_ MulVecToer      = coo
+)
+
+// COO is a sparse matrix in coordinate (triplet) format. It stores a list
+// of (row, column, value) triplets and is intended for assembling a sparse
+// matrix. Duplicate triplets are allowed and their values are summed.
+//
+// COO matrices are inefficient for element access and arithmetic and
+// should be converted to CSR or CSC format for those operations.
+type COO struct {
+	r, c int
+	rows []int
+	cols []int
+	data []float64
+}
+
+// NewCOO returns a new r×c COO matrix holding the triplets in rows, cols and
+// data. The slices must have equal length and are used as the backing storage
+// of the matrix. NewCOO will panic if any index is out of range, if r or c are
+// not positive, or if the slices have different lengths.
+func NewCOO(r, c int, rows, cols []int, data []float64) *COO {
+	checkDims(r, c)
+	if len(rows) != len(data) || len(cols) != len(data) {
+		panic(mat.ErrShape)
+	}
+	for k := range data {
+		if rows[k] < 0 || r <= rows[k] || cols[k] < 0 || c <= cols[k] {
+			panic(mat.ErrIndexOutOfRange)
+		}
+	}
+	return &COO{r: r, c: c, rows: rows, cols: cols, data: data}
+}
+
+// COOCopyOf returns a newly allocated COO matrix holding the non-zero
+// elements of a.
+func COOCopyOf(a mat.Matrix) *COO {
+	r, c := a.Dims()
+	m := &COO{r: r, c: c}
+	doNonZero(a, func(i, j int, v float64) {
+		m.Append(i, j, v)
+	})
+	return m
+}
+
+// Dims returns the dimensions of the matrix.
+func (m *COO) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element of the matrix at row i, column j. At sums all of
+// the triplets stored for the element and so takes time proportional to
+// the number of stored triplets.
+func (m *COO) At(i, j int) float64 {
+	if i < 0 || m.r <= i {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || m.c <= j {
+		panic(mat.ErrColAccess)
+	}
+	var v float64
+	for k, row := range m.rows {
+		if row == i && m.cols[k] == j {
+			v += m.data[k]
+		}
+	}
+	return v
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// mat.Transpose.
+func (m *COO) T() mat.Matrix {
+	return mat.Transpose{Matrix: m}
+}
+
+// NNZ returns the number of stored triplets.
+func (m *COO) NNZ() int {
+	return len(m.data)
+}
+
+// Append adds the triplet (i, j, v) to the matrix, so that the element at
+// row i, column j is incremented by v.
+func (m *COO) Append(i, j int, v float64) {
+	if i < 0 || m.r <= i {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || m.c <= j {
+		panic(mat.ErrColAccess)
+	}
+	m.rows = append(m.rows, i)
+	m.cols = append(m.cols, j)
+	m.data = append(m.data, v)
+}
+
+// Reset empties the list of stored triplets while retaining the
+// dimensions and the allocated storage of the matrix.
+func (m *COO) Reset() {
+	m.rows = m.rows[:0]
+	m.cols = m.cols[:0]
+	m.data = m.data[:0]
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of m.
+// The function fn takes a row/column index and the element value of m at
+// (i, j). Duplicate triplets are summed before fn is called, so fn is
+// called at most once for each element.
+func (m *COO) DoNonZero(fn func(i, j int, v float64)) {
+	m.ToCSR().DoNonZero(fn)
+}
+
+// ToCSR returns a CSR matrix equal to the receiver. Duplicate triplets
+// are summed.
+func (m *COO) ToCSR() *CSR {
+	indptr, ind, data := compress(m.r, m.c, m.rows, m.cols, m.data)
+	return &CSR{r: m.r, c: m.c, indptr: indptr, ind: ind, data: data}
+}
+
+// ToCSC returns a CSC matrix equal to the receiver. Duplicate triplets
+// are summed.
+func (m *COO) ToCSC() *CSC {
+	indptr, ind, data := compress(m.c, m.r, m.cols, m.rows, m.data)
+	return &CSC{r: m.r, c: m.c, indptr: indptr, ind: ind, data: data}
+}
+
+// ToDense returns a newly allocated dense matrix equal to the receiver.
+func (m *COO) ToDense() *mat.Dense {
+	d := mat.NewDense(m.r, m.c, nil)
+	raw := d.RawMatrix()
+	for k, v := range m.data {
+		raw.Data[m.rows[k]*raw.Stride+m.cols[k]] += v
+	}
+	return d
+}
+
+// MulVecTo computes A*x if trans is false or A^T*x if trans is true and
+// stores the result in dst. If dst is a zero-valued vector it is allocated
+// with the correct length, otherwise its length must match the result.
+func (m *COO) MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	r, c := m.r, m.c
+	rows, cols := m.rows, m.cols
+	if trans {
+		r, c = c, r
+		rows, cols = cols, rows
+	}
+	if x.Len() != c {
+		panic(mat.ErrShape)
+	}
+	reuseAsVec(dst, r)
+	xd := vecData(x)
+	y, done := vecDst(dst, x)
+	for k, v := range m.data {
+		y[rows[k]] += v * xd[cols[k]]
+	}
+	done()
+}
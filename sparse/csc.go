@@ -0,0 +1,210 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import (
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	csc *CSC
+
+	_ mat.Matrix         = csc
+	_ mat.NonZeroDoer    = csc
+	_ mat.RowNonZeroDoer = csc
+	_ mat.ColNonZeroDoer = csc
+	_ MulVecToer         = csc
+)
+
+// CSC is a sparse matrix in compressed sparse column format.
+//
+// The row indices of the stored elements of column j are held in
+// ind[indptr[j]:indptr[j+1]] in strictly increasing order, with the
+// corresponding values in the same positions of data.
+type CSC struct {
+	r, c   int
+	indptr []int
+	ind    []int
+	data   []float64
+}
+
+// NewCSC returns a new r×c CSC matrix using the provided compressed
+// storage. The slice indptr must have length c+1 with indptr[0] == 0 and
+// indptr[c] == len(ind) == len(data). The row indices of each column must
+// be strictly increasing. The slices are used as the backing storage of
+// the matrix. NewCSC will panic if the storage is not valid.
+func NewCSC(r, c int, indptr, ind []int, data []float64) *CSC {
+	checkDims(r, c)
+	checkCompressed(c, r, indptr, ind, data)
+	return &CSC{r: r, c: c, indptr: indptr, ind: ind, data: data}
+}
+
+// CSCCopyOf returns a newly allocated CSC matrix holding the non-zero
+// elements of a.
+func CSCCopyOf(a mat.Matrix) *CSC {
+	switch a := a.(type) {
+	case *CSC:
+		return a.clone()
+	case *CSR:
+		return a.ToCSC()
+	case *COO:
+		return a.ToCSC()
+	}
+	return CSRCopyOf(a.T()).T().(*CSC)
+}
+
+func (m *CSC) clone() *CSC {
+	return &CSC{
+		r:      m.r,
+		c:      m.c,
+		indptr: append([]int(nil), m.indptr...),
+		ind:    append([]int(nil), m.ind...),
+		data:   append([]float64(nil), m.data...),
+	}
+}
+
+// Dims returns the dimensions of the matrix.
+func (m *CSC) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element of the matrix at row i, column j.
+func (m *CSC) At(i, j int) float64 {
+	if i < 0 || m.r <= i {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || m.c <= j {
+		panic(mat.ErrColAccess)
+	}
+	return compressedAt(m.indptr, m.ind, m.data, j, i)
+}
+
+// T returns the transpose of the matrix as a *CSR sharing the storage of
+// the receiver.
+func (m *CSC) T() mat.Matrix {
+	return &CSR{r: m.c, c: m.r, indptr: m.indptr, ind: m.ind, data: m.data}
+}
+
+// NNZ returns the number of stored elements.
+func (m *CSC) NNZ() int {
+	return len(m.data)
+}
+
+// RawCSC returns the compressed storage of the matrix. Changes to the
+// values in the returned data slice will be reflected in the matrix.
+func (m *CSC) RawCSC() (indptr, ind []int, data []float64) {
+	return m.indptr, m.ind, m.data
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of m.
+// The function fn takes a row/column index and the element value of m at
+// (i, j).
+func (m *CSC) DoNonZero(fn func(i, j int, v float64)) {
+	for j := 0; j < m.c; j++ {
+		for k := m.indptr[j]; k < m.indptr[j+1]; k++ {
+			if m.data[k] != 0 {
+				fn(m.ind[k], j, m.data[k])
+			}
+		}
+	}
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of
+// row i of m. The function fn takes a row/column index and the element
+// value of m at (i, j). DoRowNonZero requires a search of every column and
+// so is less efficient than DoColNonZero.
+func (m *CSC) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if i < 0 || m.r <= i {
+		panic(mat.ErrRowAccess)
+	}
+	for j := 0; j < m.c; j++ {
+		v := compressedAt(m.indptr, m.ind, m.data, j, i)
+		if v != 0 {
+			fn(i, j, v)
+		}
+	}
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of m. The function fn takes a row/column index and the element
+// value of m at (i, j).
+func (m *CSC) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if j < 0 || m.c <= j {
+		panic(mat.ErrColAccess)
+	}
+	for k := m.indptr[j]; k < m.indptr[j+1]; k++ {
+		if m.data[k] != 0 {
+			fn(m.ind[k], j, m.data[k])
+		}
+	}
+}
+
+// ToCSR returns a CSR matrix equal to the receiver.
+func (m *CSC) ToCSR() *CSR {
+	indptr, ind, data := transposeCompressed(m.c, m.r, m.indptr, m.ind, m.data)
+	return &CSR{r: m.r, c: m.c, indptr: indptr, ind: ind, data: data}
+}
+
+// ToCOO returns a COO matrix equal to the receiver.
+func (m *CSC) ToCOO() *COO {
+	t := m.T().(*CSR).ToCOO()
+	t.r, t.c = t.c, t.r
+	t.rows, t.cols = t.cols, t.rows
+	return t
+}
+
+// ToDense returns a newly allocated dense matrix equal to the receiver.
+func (m *CSC) ToDense() *mat.Dense {
+	d := mat.NewDense(m.r, m.c, nil)
+	raw := d.RawMatrix()
+	for j := 0; j < m.c; j++ {
+		for k := m.indptr[j]; k < m.indptr[j+1]; k++ {
+			raw.Data[m.ind[k]*raw.Stride+j] = m.data[k]
+		}
+	}
+	return d
+}
+
+// MulVecTo computes A*x if trans is false or A^T*x if trans is true and
+// stores the result in dst. If dst is a zero-valued vector it is allocated
+// with the correct length, otherwise its length must match the result.
+func (m *CSC) MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	m.T().(*CSR).MulVecTo(dst, !trans, x)
+}
+
+// MulMatTo computes A*b if trans is false or A^T*b if trans is true and
+// stores the result in dst. If dst is a zero-valued matrix it is allocated
+// with the correct size, otherwise its dimensions must match the result.
+func (m *CSC) MulMatTo(dst *mat.Dense, trans bool, b mat.Matrix) {
+	mulMatTo(dst, !trans, m.c, m.r, m.indptr, m.ind, m.data, b)
+}
+
+// Mul takes the matrix product of a and b, placing the result in the
+// receiver. The receiver is resized to fit the result and may be one of
+// the operands. Operands that are not *CSC are converted using CSCCopyOf.
+//
+// If the number of columns in a does not equal the number of rows in b,
+// Mul will panic.
+func (m *CSC) Mul(a, b mat.Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		panic(mat.ErrShape)
+	}
+	// The product C = A*B is computed as C^T = B^T*A^T in compressed
+	// row form, which is C in compressed column form.
+	ca := asCSC(a)
+	cb := asCSC(b)
+	indptr, ind, data := mulCompressed(bc, ar, cb.indptr, cb.ind, cb.data, ca.indptr, ca.ind, ca.data)
+	*m = CSC{r: ar, c: bc, indptr: indptr, ind: ind, data: data}
+}
+
+// asCSC returns a as a *CSC, converting it if necessary.
+func asCSC(a mat.Matrix) *CSC {
+	if a, ok := a.(*CSC); ok {
+		return a
+	}
+	return CSCCopyOf(a)
+}
@@ -0,0 +1,352 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/internal/asm/f64"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	csr *CSR
+
+	_ mat.Matrix         = csr
+	_ mat.NonZeroDoer    = csr
+	_ mat.RowNonZeroDoer = csr
+	_ mat.ColNonZeroDoer = csr
+	_ MulVecToer         = csr
+)
+
+// CSR is a sparse matrix in compressed sparse row format.
+//
+// The column indices of the stored elements of row i are held in
+// ind[indptr[i]:indptr[i+1]] in strictly increasing order, with the
+// corresponding values in the same positions of data.
+type CSR struct {
+	r, c   int
+	indptr []int
+	ind    []int
+	data   []float64
+}
+
+// NewCSR returns a new r×c CSR matrix using the provided compressed
+// storage. The slice indptr must have length r+1 with indptr[0] == 0 and
+// indptr[r] == len(ind) == len(data). The column indices of each row must
+// be strictly increasing. The slices are used as the backing storage of
+// the matrix. NewCSR will panic if the storage is not valid.
+func NewCSR(r, c int, indptr, ind []int, data []float64) *CSR {
+	checkDims(r, c)
+	checkCompressed(r, c, indptr, ind, data)
+	return &CSR{r: r, c: c, indptr: indptr, ind: ind, data: data}
+}
+
+// CSRCopyOf returns a newly allocated CSR matrix holding the non-zero
+// elements of a.
+func CSRCopyOf(a mat.Matrix) *CSR {
+	switch a := a.(type) {
+	case *CSR:
+		return a.clone()
+	case *CSC:
+		return a.ToCSR()
+	case *COO:
+		return a.ToCSR()
+	}
+	r, c := a.Dims()
+	m := &CSR{r: r, c: c, indptr: make([]int, r+1)}
+	if nz, ok := a.(mat.RowNonZeroDoer); ok {
+		var row []int
+		var vals []float64
+		for i := 0; i < r; i++ {
+			row = row[:0]
+			vals = vals[:0]
+			nz.DoRowNonZero(i, func(_, j int, v float64) {
+				row = append(row, j)
+				vals = append(vals, v)
+			})
+			sortRow(row, vals)
+			m.ind = append(m.ind, row...)
+			m.data = append(m.data, vals...)
+			m.indptr[i+1] = len(m.ind)
+		}
+		return m
+	}
+	return COOCopyOf(a).ToCSR()
+}
+
+func (m *CSR) clone() *CSR {
+	return &CSR{
+		r:      m.r,
+		c:      m.c,
+		indptr: append([]int(nil), m.indptr...),
+		ind:    append([]int(nil), m.ind...),
+		data:   append([]float64(nil), m.data...),
+	}
+}
+
+// Dims returns the dimensions of the matrix.
+func (m *CSR) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element of the matrix at row i, column j.
+func (m *CSR) At(i, j int) float64 {
+	if i < 0 || m.r <= i {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || m.c <= j {
+		panic(mat.ErrColAccess)
+	}
+	return compressedAt(m.indptr, m.ind, m.data, i, j)
+}
+
+// T returns the transpose of the matrix as a *CSC sharing the storage of
+// the receiver.
+func (m *CSR) T() mat.Matrix {
+	return &CSC{r: m.c, c: m.r, indptr: m.indptr, ind: m.ind, data: m.data}
+}
+
+// NNZ returns the number of stored elements.
+func (m *CSR) NNZ() int {
+	return len(m.data)
+}
+
+// RawCSR returns the compressed storage of the matrix. Changes to the
+// values in the returned data slice will be reflected in the matrix.
+func (m *CSR) RawCSR() (indptr, ind []int, data []float64) {
+	return m.indptr, m.ind, m.data
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of m.
+// The function fn takes a row/column index and the element value of m at
+// (i, j).
+func (m *CSR) DoNonZero(fn func(i, j int, v float64)) {
+	for i := 0; i < m.r; i++ {
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			if m.data[k] != 0 {
+				fn(i, m.ind[k], m.data[k])
+			}
+		}
+	}
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of
+// row i of m. The function fn takes a row/column index and the element
+// value of m at (i, j).
+func (m *CSR) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if i < 0 || m.r <= i {
+		panic(mat.ErrRowAccess)
+	}
+	for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+		if m.data[k] != 0 {
+			fn(i, m.ind[k], m.data[k])
+		}
+	}
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of m. The function fn takes a row/column index and the element
+// value of m at (i, j). DoColNonZero requires a search of every row and
+// so is less efficient than DoRowNonZero.
+func (m *CSR) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if j < 0 || m.c <= j {
+		panic(mat.ErrColAccess)
+	}
+	for i := 0; i < m.r; i++ {
+		v := compressedAt(m.indptr, m.ind, m.data, i, j)
+		if v != 0 {
+			fn(i, j, v)
+		}
+	}
+}
+
+// ToCSC returns a CSC matrix equal to the receiver.
+func (m *CSR) ToCSC() *CSC {
+	indptr, ind, data := transposeCompressed(m.r, m.c, m.indptr, m.ind, m.data)
+	return &CSC{r: m.r, c: m.c, indptr: indptr, ind: ind, data: data}
+}
+
+// ToCOO returns a COO matrix equal to the receiver.
+func (m *CSR) ToCOO() *COO {
+	nnz := len(m.data)
+	rows := make([]int, nnz)
+	for i := 0; i < m.r; i++ {
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			rows[k] = i
+		}
+	}
+	return &COO{
+		r:    m.r,
+		c:    m.c,
+		rows: rows,
+		cols: append([]int(nil), m.ind...),
+		data: append([]float64(nil), m.data...),
+	}
+}
+
+// ToDense returns a newly allocated dense matrix equal to the receiver.
+func (m *CSR) ToDense() *mat.Dense {
+	d := mat.NewDense(m.r, m.c, nil)
+	raw := d.RawMatrix()
+	for i := 0; i < m.r; i++ {
+		row := raw.Data[i*raw.Stride : i*raw.Stride+m.c]
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			row[m.ind[k]] = m.data[k]
+		}
+	}
+	return d
+}
+
+// MulVecTo computes A*x if trans is false or A^T*x if trans is true and
+// stores the result in dst. If dst is a zero-valued vector it is allocated
+// with the correct length, otherwise its length must match the result.
+func (m *CSR) MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	r, c := m.r, m.c
+	if trans {
+		r, c = c, r
+	}
+	if x.Len() != c {
+		panic(mat.ErrShape)
+	}
+	reuseAsVec(dst, r)
+	xd := vecData(x)
+	y, done := vecDst(dst, x)
+	if trans {
+		scatterMulVec(y, m.r, m.indptr, m.ind, m.data, xd)
+	} else {
+		gatherMulVec(y, m.r, m.indptr, m.ind, m.data, xd)
+	}
+	done()
+}
+
+// MulMatTo computes A*b if trans is false or A^T*b if trans is true and
+// stores the result in dst. If dst is a zero-valued matrix it is allocated
+// with the correct size, otherwise its dimensions must match the result.
+func (m *CSR) MulMatTo(dst *mat.Dense, trans bool, b mat.Matrix) {
+	mulMatTo(dst, trans, m.r, m.c, m.indptr, m.ind, m.data, b)
+}
+
+// Mul takes the matrix product of a and b, placing the result in the
+// receiver. The receiver is resized to fit the result and may be one of
+// the operands. Operands that are not *CSR are converted using CSRCopyOf.
+//
+// If the number of columns in a does not equal the number of rows in b,
+// Mul will panic.
+func (m *CSR) Mul(a, b mat.Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		panic(mat.ErrShape)
+	}
+	ca := asCSR(a)
+	cb := asCSR(b)
+	indptr, ind, data := mulCompressed(ar, bc, ca.indptr, ca.ind, ca.data, cb.indptr, cb.ind, cb.data)
+	*m = CSR{r: ar, c: bc, indptr: indptr, ind: ind, data: data}
+}
+
+// asCSR returns a as a *CSR, converting it if necessary.
+func asCSR(a mat.Matrix) *CSR {
+	if a, ok := a.(*CSR); ok {
+		return a
+	}
+	return CSRCopyOf(a)
+}
+
+// gatherMulVec computes y = A*x for the n×m compressed row matrix A.
+func gatherMulVec(y []float64, n int, indptr, ind []int, data, x []float64) {
+	for i := 0; i < n; i++ {
+		var sum float64
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			sum += data[k] * x[ind[k]]
+		}
+		y[i] = sum
+	}
+}
+
+// scatterMulVec computes y += A^T*x for the n×m compressed row matrix A.
+func scatterMulVec(y []float64, n int, indptr, ind []int, data, x []float64) {
+	for i := 0; i < n; i++ {
+		xi := x[i]
+		if xi == 0 {
+			continue
+		}
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			y[ind[k]] += data[k] * xi
+		}
+	}
+}
+
+// mulMatTo computes dst = op(A)*b for the r×c compressed row matrix A.
+func mulMatTo(dst *mat.Dense, trans bool, r, c int, indptr, ind []int, data []float64, b mat.Matrix) {
+	ar, ac := r, c
+	if trans {
+		ar, ac = c, r
+	}
+	br, bc := b.Dims()
+	if ac != br {
+		panic(mat.ErrShape)
+	}
+	if sharesStorage(dst.RawMatrix(), b) {
+		var tmp mat.Dense
+		mulMatTo(&tmp, trans, r, c, indptr, ind, data, b)
+		dst.Copy(&tmp)
+		return
+	}
+	reuseAsDense(dst, ar, bc)
+	raw := dst.RawMatrix()
+	row := rowAccessor(b)
+	for i := 0; i < r; i++ {
+		if !trans {
+			drow := raw.Data[i*raw.Stride : i*raw.Stride+bc]
+			for k := indptr[i]; k < indptr[i+1]; k++ {
+				f64.AxpyUnitary(data[k], row(ind[k]), drow)
+			}
+			continue
+		}
+		brow := row(i)
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			j := ind[k]
+			f64.AxpyUnitary(data[k], brow, raw.Data[j*raw.Stride:j*raw.Stride+bc])
+		}
+	}
+}
+
+// rowAccessor returns a function that returns the elements of row i of a.
+// The returned slice must not be modified and is only valid until the
+// next call.
+func rowAccessor(a mat.Matrix) func(i int) []float64 {
+	_, c := a.Dims()
+	if rm, ok := a.(mat.RawMatrixer); ok {
+		raw := rm.RawMatrix()
+		return func(i int) []float64 {
+			return raw.Data[i*raw.Stride : i*raw.Stride+c]
+		}
+	}
+	buf := make([]float64, c)
+	return func(i int) []float64 {
+		return mat.Row(buf, i, a)
+	}
+}
+
+// sortRow sorts the indices in ind in increasing order, permuting vals
+// correspondingly.
+func sortRow(ind []int, vals []float64) {
+	if !sort.IntsAreSorted(ind) {
+		sort.Sort(rowSorter{ind: ind, vals: vals})
+	}
+}
+
+type rowSorter struct {
+	ind  []int
+	vals []float64
+}
+
+func (r rowSorter) Len() int           { return len(r.ind) }
+func (r rowSorter) Less(i, j int) bool { return r.ind[i] < r.ind[j] }
+func (r rowSorter) Swap(i, j int) {
+	r.ind[i], r.ind[j] = r.ind[j], r.ind[i]
+	r.vals[i], r.vals[j] = r.vals[j], r.vals[i]
+}
@@ -0,0 +1,24 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sparse provides sparse matrix types that implement the mat.Matrix
+// interface.
+//
+// Three storage formats are provided. COO stores a list of (row, column, value)
+// triplets and is intended for assembling a matrix element by element. CSR and
+// CSC store the matrix in compressed sparse row and compressed sparse column
+// format respectively and are intended for arithmetic. A COO matrix is usually
+// built and then converted to one of the compressed formats using its ToCSR or
+// ToCSC methods.
+//
+// The transpose of a CSR matrix is a CSC matrix sharing the same storage and
+// vice versa, so implicit transposes do not require copying.
+//
+// All of the types implement the mat.NonZeroDoer interface and the compressed
+// formats also implement mat.RowNonZeroDoer and mat.ColNonZeroDoer, allowing
+// them to be used efficiently with code that accepts a mat.Matrix. Conversion
+// to a dense representation can be performed with the ToDense methods and a
+// sparse matrix can be constructed from any mat.Matrix with COOCopyOf,
+// CSRCopyOf and CSCCopyOf.
+package sparse // import "gonum.org/v1/gonum/sparse"
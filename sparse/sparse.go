@@ -0,0 +1,395 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	_ Sparse = (*COO)(nil)
+	_ Sparse = (*CSR)(nil)
+	_ Sparse = (*CSC)(nil)
+)
+
+// Sparse is a sparse matrix.
+type Sparse interface {
+	mat.Matrix
+	mat.NonZeroDoer
+
+	// NNZ returns the number of stored elements of the matrix.
+	// Stored elements may be zero valued.
+	NNZ() int
+}
+
+// MulVecToer is a linear operator that can compute the product of itself,
+// or its transpose, and a vector.
+type MulVecToer interface {
+	// MulVecTo computes A*x or A^T*x and stores the result into dst.
+	MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector)
+}
+
+// checkDims panics if r or c are not valid matrix dimensions.
+func checkDims(r, c int) {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(mat.ErrZeroLength)
+		}
+		panic("sparse: negative dimension")
+	}
+}
+
+// reuseAsVec prepares dst to receive a vector of length n. If dst is
+// a zero-valued vector it is allocated, otherwise it must have length n.
+func reuseAsVec(dst *mat.VecDense, n int) {
+	if dst.IsZero() {
+		*dst = *mat.NewVecDense(n, nil)
+		return
+	}
+	if dst.Len() != n {
+		panic(mat.ErrShape)
+	}
+}
+
+// reuseAsDense prepares dst to receive an r×c matrix. If dst is a
+// zero-valued matrix it is allocated, otherwise it must be r×c and is
+// zeroed.
+func reuseAsDense(dst *mat.Dense, r, c int) {
+	if dst.IsZero() {
+		*dst = *mat.NewDense(r, c, nil)
+		return
+	}
+	dr, dc := dst.Dims()
+	if dr != r || dc != c {
+		panic(mat.ErrShape)
+	}
+	dst.Zero()
+}
+
+// vecData returns the elements of x as a slice with unit increment. If x
+// is a *mat.VecDense with unit increment, its backing data is returned,
+// otherwise the elements are copied.
+func vecData(x mat.Vector) []float64 {
+	if v, ok := x.(*mat.VecDense); ok {
+		raw := v.RawVector()
+		if raw.Inc == 1 {
+			return raw.Data[:raw.N]
+		}
+	}
+	n := x.Len()
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = x.AtVec(i)
+	}
+	return d
+}
+
+// vecDst returns a unit-increment slice to hold the result of a product
+// that will be stored in dst, and a function that must be called to
+// write the result to dst once it has been computed. The returned slice
+// is zeroed and does not share storage with x.
+func vecDst(dst *mat.VecDense, x mat.Vector) (y []float64, done func()) {
+	raw := dst.RawVector()
+	if raw.Inc == 1 && !sharesStorage(generalFromVector(raw), x) {
+		y = raw.Data[:raw.N]
+		for i := range y {
+			y[i] = 0
+		}
+		return y, func() {}
+	}
+	y = make([]float64, raw.N)
+	return y, func() {
+		for i, v := range y {
+			dst.SetVec(i, v)
+		}
+	}
+}
+
+// sharesStorage returns whether the elements of a may be held in the
+// storage referenced by dst. Matrices that do not expose their backing
+// data are assumed not to share storage with dst.
+func sharesStorage(dst blas64.General, a mat.Matrix) bool {
+	if t, ok := a.(mat.Untransposer); ok {
+		a = t.Untranspose()
+	}
+	switch a := a.(type) {
+	case mat.RawMatrixer:
+		return overlaps(dst, a.RawMatrix())
+	case mat.RawVectorer:
+		return overlaps(dst, generalFromVector(a.RawVector()))
+	case mat.RawSymmetricer:
+		raw := a.RawSymmetric()
+		return overlaps(dst, blas64.General{Rows: raw.N, Cols: raw.N, Stride: raw.Stride, Data: raw.Data})
+	case mat.RawTriangular:
+		raw := a.RawTriangular()
+		return overlaps(dst, blas64.General{Rows: raw.N, Cols: raw.N, Stride: raw.Stride, Data: raw.Data})
+	}
+	return false
+}
+
+// generalFromVector returns a blas64.General with the backing data of v
+// held as a single column.
+func generalFromVector(v blas64.Vector) blas64.General {
+	return blas64.General{
+		Rows:   v.N,
+		Cols:   1,
+		Stride: v.Inc,
+		Data:   v.Data,
+	}
+}
+
+// overlaps returns whether a and b reference any common data elements.
+// It makes the same test as the region overlap checks of the mat package,
+// but reports the overlap instead of panicking. Overlapping data with
+// different strides is conservatively reported as overlapping.
+func overlaps(a, b blas64.General) bool {
+	if cap(a.Data) == 0 || cap(b.Data) == 0 {
+		return false
+	}
+
+	off := offset(a.Data[:1], b.Data[:1])
+	if off == 0 {
+		return true
+	}
+	if off > 0 && len(a.Data) <= off {
+		// a is completely before b.
+		return false
+	}
+	if off < 0 && len(b.Data) <= -off {
+		// a is completely after b.
+		return false
+	}
+	if a.Stride != b.Stride {
+		return true
+	}
+
+	if off < 0 {
+		off = -off
+		a.Cols, b.Cols = b.Cols, a.Cols
+	}
+	return rectanglesOverlap(off, a.Cols, b.Cols, a.Stride)
+}
+
+// rectanglesOverlap returns whether the strided rectangles a and b overlap
+// when b is offset by off elements after a but has at least one element before
+// the end of a. off must be positive. a and b have aCols and bCols respectively.
+// See the mat package for a description of the method.
+func rectanglesOverlap(off, aCols, bCols, stride int) bool {
+	if stride == 1 {
+		// Unit stride means overlapping data
+		// slices must overlap as matrices.
+		return true
+	}
+
+	// Flatten the shifted matrix column positions
+	// so a starts at 0, modulo the common stride.
+	aTo := aCols
+	bFrom := off % stride
+	bTo := (bFrom + bCols) % stride
+
+	if bTo == 0 || bFrom < bTo {
+		// b matrix is not wrapped: compare for
+		// simple overlap.
+		return bFrom < aTo
+	}
+
+	// b strictly wraps and so must overlap with a.
+	return true
+}
+
+// doNonZero calls fn for each non-zero element of a, using the most
+// efficient method available.
+func doNonZero(a mat.Matrix, fn func(i, j int, v float64)) {
+	if nz, ok := a.(mat.NonZeroDoer); ok {
+		nz.DoNonZero(fn)
+		return
+	}
+	if t, ok := a.(mat.Untransposer); ok {
+		if nz, ok := t.Untranspose().(mat.NonZeroDoer); ok {
+			nz.DoNonZero(func(i, j int, v float64) {
+				fn(j, i, v)
+			})
+			return
+		}
+	}
+	r, c := a.Dims()
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := a.At(i, j)
+			if v != 0 {
+				fn(i, j, v)
+			}
+		}
+	}
+}
+
+// compress converts the n×m triplets given by (major, minor, data) into
+// compressed form with major index as the compressed dimension. The
+// minor indices within each major index are sorted in increasing order
+// and duplicate entries are summed.
+func compress(n, m int, major, minor []int, data []float64) (indptr, ind []int, vals []float64) {
+	nnz := len(data)
+
+	// Counting sort by minor index, then a stable counting sort by major
+	// index, yields entries ordered by major and then minor index.
+	count := make([]int, m+1)
+	for _, j := range minor {
+		count[j+1]++
+	}
+	for j := 0; j < m; j++ {
+		count[j+1] += count[j]
+	}
+	perm := make([]int, nnz)
+	for k, j := range minor {
+		perm[count[j]] = k
+		count[j]++
+	}
+
+	indptr = make([]int, n+1)
+	for _, i := range major {
+		indptr[i+1]++
+	}
+	for i := 0; i < n; i++ {
+		indptr[i+1] += indptr[i]
+	}
+	next := make([]int, n)
+	copy(next, indptr[:n])
+	ind = make([]int, nnz)
+	vals = make([]float64, nnz)
+	for _, k := range perm {
+		i := major[k]
+		ind[next[i]] = minor[k]
+		vals[next[i]] = data[k]
+		next[i]++
+	}
+
+	// Sum duplicates, compacting in place.
+	var dst int
+	for i := 0; i < n; i++ {
+		start := dst
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			if dst > start && ind[dst-1] == ind[k] {
+				vals[dst-1] += vals[k]
+				continue
+			}
+			ind[dst] = ind[k]
+			vals[dst] = vals[k]
+			dst++
+		}
+		indptr[i] = start
+	}
+	indptr[n] = dst
+	return indptr, ind[:dst:dst], vals[:dst:dst]
+}
+
+// transposeCompressed returns the compressed form of the transpose of the
+// n×m compressed matrix held in (indptr, ind, data).
+func transposeCompressed(n, m int, indptr, ind []int, data []float64) (tptr, tind []int, tdata []float64) {
+	nnz := indptr[n]
+	tptr = make([]int, m+1)
+	for _, j := range ind[:nnz] {
+		tptr[j+1]++
+	}
+	for j := 0; j < m; j++ {
+		tptr[j+1] += tptr[j]
+	}
+	next := make([]int, m)
+	copy(next, tptr[:m])
+	tind = make([]int, nnz)
+	tdata = make([]float64, nnz)
+	for i := 0; i < n; i++ {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			j := ind[k]
+			tind[next[j]] = i
+			tdata[next[j]] = data[k]
+			next[j]++
+		}
+	}
+	return tptr, tind, tdata
+}
+
+// checkCompressed panics if (indptr, ind, data) is not a valid compressed
+// representation of a matrix with n major and m minor indices.
+func checkCompressed(n, m int, indptr, ind []int, data []float64) {
+	if len(indptr) != n+1 {
+		panic("sparse: bad index pointer length")
+	}
+	if indptr[0] != 0 {
+		panic("sparse: bad index pointer start")
+	}
+	if len(ind) != len(data) || indptr[n] != len(ind) {
+		panic("sparse: index and data length mismatch")
+	}
+	for i := 0; i < n; i++ {
+		if indptr[i] > indptr[i+1] {
+			panic("sparse: index pointer not increasing")
+		}
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			if ind[k] < 0 || m <= ind[k] {
+				panic(mat.ErrIndexOutOfRange)
+			}
+			if k > indptr[i] && ind[k] <= ind[k-1] {
+				panic("sparse: indices not strictly increasing")
+			}
+		}
+	}
+}
+
+// compressedAt returns the element at minor index j of major index i of
+// a compressed matrix.
+func compressedAt(indptr, ind []int, data []float64, i, j int) float64 {
+	lo, hi := indptr[i], indptr[i+1]
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		switch {
+		case ind[mid] < j:
+			lo = mid + 1
+		case ind[mid] > j:
+			hi = mid
+		default:
+			return data[mid]
+		}
+	}
+	return 0
+}
+
+// mulCompressed computes the product of two matrices held in compressed
+// form using Gustavson's algorithm. The first matrix is n×k with k minor
+// indices and the second is k×m.
+func mulCompressed(n, m int, aptr, aind []int, adata []float64, bptr, bind []int, bdata []float64) (indptr, ind []int, data []float64) {
+	indptr = make([]int, n+1)
+	w := make([]float64, m)
+	mark := make([]int, m)
+	for j := range mark {
+		mark[j] = -1
+	}
+	var cols []int
+	for i := 0; i < n; i++ {
+		cols = cols[:0]
+		for ka := aptr[i]; ka < aptr[i+1]; ka++ {
+			l := aind[ka]
+			av := adata[ka]
+			for kb := bptr[l]; kb < bptr[l+1]; kb++ {
+				j := bind[kb]
+				if mark[j] != i {
+					mark[j] = i
+					w[j] = 0
+					cols = append(cols, j)
+				}
+				w[j] += av * bdata[kb]
+			}
+		}
+		sort.Ints(cols)
+		for _, j := range cols {
+			ind = append(ind, j)
+			data = append(data, w[j])
+		}
+		indptr[i+1] = len(ind)
+	}
+	return indptr, ind, data
+}
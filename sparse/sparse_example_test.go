@@ -0,0 +1,45 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/sparse"
+)
+
+func ExampleCOO() {
+	// Assemble the 1D Laplacian using a COO matrix.
+	const n = 5
+	a := sparse.NewCOO(n, n, nil, nil, nil)
+	for i := 0; i < n; i++ {
+		a.Append(i, i, 2)
+		if i > 0 {
+			a.Append(i, i-1, -1)
+		}
+		if i < n-1 {
+			a.Append(i, i+1, -1)
+		}
+	}
+
+	// Convert to CSR format for arithmetic.
+	csr := a.ToCSR()
+	x := mat.NewVecDense(n, []float64{1, 2, 3, 4, 5})
+	var y mat.VecDense
+	csr.MulVecTo(&y, false, x)
+
+	fmt.Printf("A = %v\n\n", mat.Formatted(csr, mat.Prefix("    ")))
+	fmt.Printf("A*x = %v\n", mat.Formatted(y.T()))
+
+	// Output:
+	// A = ⎡ 2  -1   0   0   0⎤
+	//     ⎢-1   2  -1   0   0⎥
+	//     ⎢ 0  -1   2  -1   0⎥
+	//     ⎢ 0   0  -1   2  -1⎥
+	//     ⎣ 0   0   0  -1   2⎦
+	//
+	// A*x = [0  0  0  0  6]
+}
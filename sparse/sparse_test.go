@@ -0,0 +1,390 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sparse
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// randCOO returns a random r×c COO matrix with approximately density*r*c
+// triplets, including duplicate entries, and its dense equivalent.
+func randCOO(r, c int, density float64, rnd *rand.Rand) (*COO, *mat.Dense) {
+	m := &COO{r: r, c: c}
+	d := mat.NewDense(r, c, nil)
+	n := int(density * float64(r*c))
+	for k := 0; k < n; k++ {
+		i := rnd.Intn(r)
+		j := rnd.Intn(c)
+		v := rnd.NormFloat64()
+		m.Append(i, j, v)
+		d.Set(i, j, d.At(i, j)+v)
+	}
+	return m, d
+}
+
+func equalApprox(a, b mat.Matrix, tol float64) bool {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		return false
+	}
+	for i := 0; i < ar; i++ {
+		for j := 0; j < ac; j++ {
+			if !floats.EqualWithinAbsOrRel(a.At(i, j), b.At(i, j), tol, tol) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+var sizes = []struct{ r, c int }{
+	{1, 1}, {1, 5}, {5, 1}, {3, 3}, {7, 4}, {4, 7}, {20, 20}, {31, 17},
+}
+
+func TestConversions(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range sizes {
+		for _, density := range []float64{0, 0.1, 0.5, 2} {
+			coo, want := randCOO(test.r, test.c, density, rnd)
+			name := fmt.Sprintf("%d×%d density=%v", test.r, test.c, density)
+
+			mats := []struct {
+				name string
+				m    Sparse
+			}{
+				{name: "COO", m: coo},
+				{name: "CSR", m: coo.ToCSR()},
+				{name: "CSC", m: coo.ToCSC()},
+				{name: "CSR.ToCSC", m: coo.ToCSR().ToCSC()},
+				{name: "CSC.ToCSR", m: coo.ToCSC().ToCSR()},
+				{name: "CSR.ToCOO", m: coo.ToCSR().ToCOO()},
+				{name: "CSC.ToCOO", m: coo.ToCSC().ToCOO()},
+				{name: "CSRCopyOf", m: CSRCopyOf(want)},
+				{name: "CSCCopyOf", m: CSCCopyOf(want)},
+				{name: "COOCopyOf", m: COOCopyOf(want)},
+				{name: "CSRCopyOf(CSC)", m: CSRCopyOf(coo.ToCSC())},
+				{name: "CSCCopyOf(CSR)", m: CSCCopyOf(coo.ToCSR())},
+			}
+			for _, m := range mats {
+				if !mat.Equal(m.m, want) {
+					t.Errorf("%s %s: unexpected value:\ngot:\n%v\nwant:\n%v",
+						name, m.name, mat.Formatted(m.m), mat.Formatted(want))
+				}
+				if !mat.Equal(m.m.T(), want.T()) {
+					t.Errorf("%s %s: unexpected transpose", name, m.name)
+				}
+				d := mat.NewDense(test.r, test.c, nil)
+				m.m.DoNonZero(func(i, j int, v float64) {
+					if v == 0 {
+						t.Errorf("%s %s: zero value passed to DoNonZero", name, m.name)
+					}
+					if d.At(i, j) != 0 {
+						t.Errorf("%s %s: element (%d,%d) visited twice", name, m.name, i, j)
+					}
+					d.Set(i, j, v)
+				})
+				if !mat.Equal(d, want) {
+					t.Errorf("%s %s: unexpected result from DoNonZero", name, m.name)
+				}
+			}
+
+			for _, m := range []interface {
+				mat.RowNonZeroDoer
+				mat.ColNonZeroDoer
+			}{coo.ToCSR(), coo.ToCSC()} {
+				rows := mat.NewDense(test.r, test.c, nil)
+				for i := 0; i < test.r; i++ {
+					m.DoRowNonZero(i, func(i, j int, v float64) { rows.Set(i, j, v) })
+				}
+				if !mat.Equal(rows, want) {
+					t.Errorf("%s %T: unexpected result from DoRowNonZero", name, m)
+				}
+				cols := mat.NewDense(test.r, test.c, nil)
+				for j := 0; j < test.c; j++ {
+					m.DoColNonZero(j, func(i, j int, v float64) { cols.Set(i, j, v) })
+				}
+				if !mat.Equal(cols, want) {
+					t.Errorf("%s %T: unexpected result from DoColNonZero", name, m)
+				}
+			}
+
+			for _, d := range []*mat.Dense{coo.ToDense(), coo.ToCSR().ToDense(), coo.ToCSC().ToDense()} {
+				if !mat.Equal(d, want) {
+					t.Errorf("%s: unexpected result from ToDense", name)
+				}
+			}
+		}
+	}
+}
+
+func TestCopyOfBanded(t *testing.T) {
+	b := mat.NewBandDense(5, 6, 1, 2, []float64{
+		-1, 1, 2, 3,
+		4, 5, 6, 7,
+		8, 9, 10, 11,
+		12, 13, 14, 15,
+		16, 17, 18, 19,
+	})
+	for _, m := range []Sparse{CSRCopyOf(b), CSCCopyOf(b), COOCopyOf(b)} {
+		if !mat.Equal(m, b) {
+			t.Errorf("unexpected %T copy of banded matrix:\ngot:\n%v\nwant:\n%v",
+				m, mat.Formatted(m), mat.Formatted(b))
+		}
+	}
+}
+
+func TestNewCompressed(t *testing.T) {
+	csr := NewCSR(3, 4, []int{0, 2, 2, 4}, []int{0, 3, 1, 2}, []float64{1, 2, 3, 4})
+	want := mat.NewDense(3, 4, []float64{
+		1, 0, 0, 2,
+		0, 0, 0, 0,
+		0, 3, 4, 0,
+	})
+	if !mat.Equal(csr, want) {
+		t.Errorf("unexpected CSR matrix:\ngot:\n%v\nwant:\n%v", mat.Formatted(csr), mat.Formatted(want))
+	}
+	csc := NewCSC(4, 3, []int{0, 2, 2, 4}, []int{0, 3, 1, 2}, []float64{1, 2, 3, 4})
+	if !mat.Equal(csc, want.T()) {
+		t.Errorf("unexpected CSC matrix:\ngot:\n%v\nwant:\n%v", mat.Formatted(csc), mat.Formatted(want.T()))
+	}
+
+	for _, test := range []struct {
+		name   string
+		indptr []int
+		ind    []int
+		data   []float64
+	}{
+		{name: "short indptr", indptr: []int{0, 2, 4}, ind: []int{0, 1, 0, 1}, data: []float64{1, 2, 3, 4}},
+		{name: "bad start", indptr: []int{1, 2, 2, 4}, ind: []int{0, 1, 0, 1}, data: []float64{1, 2, 3, 4}},
+		{name: "data length", indptr: []int{0, 2, 2, 4}, ind: []int{0, 1, 0, 1}, data: []float64{1, 2, 3}},
+		{name: "decreasing indptr", indptr: []int{0, 3, 2, 4}, ind: []int{0, 1, 0, 1}, data: []float64{1, 2, 3, 4}},
+		{name: "index out of range", indptr: []int{0, 2, 2, 4}, ind: []int{0, 4, 0, 1}, data: []float64{1, 2, 3, 4}},
+		{name: "unsorted", indptr: []int{0, 2, 2, 4}, ind: []int{1, 0, 0, 1}, data: []float64{1, 2, 3, 4}},
+		{name: "duplicate", indptr: []int{0, 2, 2, 4}, ind: []int{1, 1, 0, 1}, data: []float64{1, 2, 3, 4}},
+	} {
+		if !panics(func() { NewCSR(3, 4, test.indptr, test.ind, test.data) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func TestMulVecTo(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range sizes {
+		coo, d := randCOO(test.r, test.c, 0.3, rnd)
+		for _, trans := range []bool{false, true} {
+			r, c := test.r, test.c
+			var a mat.Matrix = d
+			if trans {
+				r, c = c, r
+				a = d.T()
+			}
+			x := mat.NewVecDense(c, nil)
+			for i := 0; i < c; i++ {
+				x.SetVec(i, rnd.NormFloat64())
+			}
+			var want mat.VecDense
+			want.MulVec(a, x)
+
+			for _, m := range []MulVecToer{coo, coo.ToCSR(), coo.ToCSC()} {
+				var got mat.VecDense
+				m.MulVecTo(&got, trans, x)
+				if !equalApprox(&got, &want, 1e-14) {
+					t.Errorf("%d×%d %T trans=%t: unexpected result:\ngot:  %v\nwant: %v",
+						test.r, test.c, m, trans, mat.Formatted(got.T()), mat.Formatted(want.T()))
+				}
+
+				// Check that a non-zero receiver with non-unit
+				// increment is handled correctly.
+				buf := mat.NewDense(r, 2, nil)
+				strided := buf.ColView(1).(*mat.VecDense)
+				strided.SetVec(0, 1)
+				m.MulVecTo(strided, trans, x)
+				if !equalApprox(strided, &want, 1e-14) {
+					t.Errorf("%d×%d %T trans=%t: unexpected result for strided receiver", test.r, test.c, m, trans)
+				}
+
+				// Check that an aliased square product is handled correctly.
+				if r == c {
+					y := mat.VecDenseCopyOf(x)
+					m.MulVecTo(y, trans, y)
+					if !equalApprox(y, &want, 1e-14) {
+						t.Errorf("%d×%d %T trans=%t: unexpected result for aliased receiver", test.r, test.c, m, trans)
+					}
+				}
+
+				// Check that distinct views of shared data are handled
+				// correctly, both when they coincide and when they
+				// partially overlap.
+				for _, off := range []int{0, 1} {
+					if off == 0 && r != c {
+						continue
+					}
+					buf := mat.NewVecDense(r+c+1, nil)
+					xv := buf.SliceVec(0, c).(*mat.VecDense)
+					xv.CopyVec(x)
+					dst := buf.SliceVec(off, off+r).(*mat.VecDense)
+					m.MulVecTo(dst, trans, xv)
+					if !equalApprox(dst, &want, 1e-14) {
+						t.Errorf("%d×%d %T trans=%t off=%d: unexpected result for receiver sharing data with x", test.r, test.c, m, trans, off)
+					}
+				}
+
+				if !panics(func() { m.MulVecTo(mat.NewVecDense(r+1, nil), trans, x) }) {
+					t.Errorf("%d×%d %T trans=%t: expected panic for bad receiver length", test.r, test.c, m, trans)
+				}
+			}
+		}
+	}
+}
+
+func TestMulMatTo(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range sizes {
+		coo, d := randCOO(test.r, test.c, 0.3, rnd)
+		for _, trans := range []bool{false, true} {
+			r, c := test.r, test.c
+			var a mat.Matrix = d
+			if trans {
+				r, c = c, r
+				a = d.T()
+			}
+			for _, n := range []int{1, 3} {
+				bData := make([]float64, c*n)
+				for i := range bData {
+					bData[i] = rnd.NormFloat64()
+				}
+				b := mat.NewDense(c, n, bData)
+				var want mat.Dense
+				want.Mul(a, b)
+
+				for _, m := range []interface {
+					MulMatTo(*mat.Dense, bool, mat.Matrix)
+				}{coo.ToCSR(), coo.ToCSC()} {
+					var got mat.Dense
+					m.MulMatTo(&got, trans, b)
+					if !equalApprox(&got, &want, 1e-14) {
+						t.Errorf("%d×%d %T trans=%t n=%d: unexpected result:\ngot:\n%v\nwant:\n%v",
+							test.r, test.c, m, trans, n, mat.Formatted(&got), mat.Formatted(&want))
+					}
+					got.Reset()
+					m.MulMatTo(&got, trans, b.T().T())
+					if !equalApprox(&got, &want, 1e-14) {
+						t.Errorf("%d×%d %T trans=%t n=%d: unexpected result for non-raw operand", test.r, test.c, m, trans, n)
+					}
+					if r == c && n == c {
+						alias := mat.DenseCopyOf(b)
+						m.MulMatTo(alias, trans, alias)
+						if !equalApprox(alias, &want, 1e-14) {
+							t.Errorf("%d×%d %T trans=%t n=%d: unexpected result for aliased receiver", test.r, test.c, m, trans, n)
+						}
+					}
+
+					// Check that distinct views of shared data are handled
+					// correctly, both when they coincide and when they
+					// partially overlap.
+					for _, off := range []int{0, 1} {
+						if off == 0 && r != c {
+							continue
+						}
+						buf := mat.NewDense(r+c+1, n, nil)
+						bv := buf.Slice(0, c, 0, n).(*mat.Dense)
+						bv.Copy(b)
+						dst := buf.Slice(off, off+r, 0, n).(*mat.Dense)
+						m.MulMatTo(dst, trans, bv)
+						if !equalApprox(dst, &want, 1e-14) {
+							t.Errorf("%d×%d %T trans=%t n=%d off=%d: unexpected result for receiver sharing data with b", test.r, test.c, m, trans, n, off)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestMul(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, k, n int }{
+		{1, 1, 1}, {3, 4, 5}, {5, 4, 3}, {10, 10, 10}, {20, 7, 13},
+	} {
+		for _, density := range []float64{0.1, 0.4, 1} {
+			a, ad := randCOO(test.m, test.k, density, rnd)
+			b, bd := randCOO(test.k, test.n, density, rnd)
+			var want mat.Dense
+			want.Mul(ad, bd)
+
+			for _, operands := range []struct {
+				name string
+				a, b mat.Matrix
+			}{
+				{name: "COO", a: a, b: b},
+				{name: "CSR", a: a.ToCSR(), b: b.ToCSR()},
+				{name: "CSC", a: a.ToCSC(), b: b.ToCSC()},
+				{name: "mixed", a: a.ToCSC(), b: b.ToCSR()},
+				{name: "dense", a: ad, b: bd},
+			} {
+				var csr CSR
+				csr.Mul(operands.a, operands.b)
+				if !equalApprox(&csr, &want, 1e-13) {
+					t.Errorf("%d×%d×%d density=%v %s: unexpected CSR product", test.m, test.k, test.n, density, operands.name)
+				}
+				checkCompressed(test.m, test.n, csr.indptr, csr.ind, csr.data)
+
+				var csc CSC
+				csc.Mul(operands.a, operands.b)
+				if !equalApprox(&csc, &want, 1e-13) {
+					t.Errorf("%d×%d×%d density=%v %s: unexpected CSC product", test.m, test.k, test.n, density, operands.name)
+				}
+				checkCompressed(test.n, test.m, csc.indptr, csc.ind, csc.data)
+			}
+		}
+	}
+
+	if !panics(func() {
+		var m CSR
+		m.Mul(NewCOO(2, 3, nil, nil, nil), NewCOO(2, 3, nil, nil, nil))
+	}) {
+		t.Errorf("expected panic for mismatched dimensions")
+	}
+}
+
+func TestCOOAt(t *testing.T) {
+	m := NewCOO(2, 3, []int{0, 1, 0}, []int{1, 2, 1}, []float64{1, 2, 3})
+	if got := m.At(0, 1); got != 4 {
+		t.Errorf("unexpected value for duplicate element: got:%v want:4", got)
+	}
+	if got := m.NNZ(); got != 3 {
+		t.Errorf("unexpected number of stored elements: got:%d want:3", got)
+	}
+	if got := m.ToCSR().NNZ(); got != 2 {
+		t.Errorf("unexpected number of stored elements after compression: got:%d want:2", got)
+	}
+	m.Reset()
+	if got := m.At(0, 1); got != 0 {
+		t.Errorf("unexpected value after reset: got:%v want:0", got)
+	}
+	if !panics(func() { m.Append(2, 0, 1) }) {
+		t.Errorf("expected panic for out of range row")
+	}
+	if !panics(func() { NewCOO(2, 3, []int{0}, []int{3}, []float64{1}) }) {
+		t.Errorf("expected panic for out of range column")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
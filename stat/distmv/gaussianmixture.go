@@ -0,0 +1,615 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"errors"
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// CovarianceType specifies the structure of the component covariance matrices
+// of a GaussianMixture fitted by FitGaussianMixture.
+type CovarianceType int
+
+const (
+	// FullCovariance gives each component its own unconstrained covariance
+	// matrix.
+	FullCovariance CovarianceType = iota
+	// DiagonalCovariance gives each component its own diagonal covariance
+	// matrix.
+	DiagonalCovariance
+	// SphericalCovariance gives each component its own covariance matrix
+	// that is a multiple of the identity.
+	SphericalCovariance
+	// TiedCovariance gives all components the same unconstrained covariance
+	// matrix.
+	TiedCovariance
+)
+
+var errNotPosDef = errors.New("distmv: covariance matrix not positive definite")
+
+// GaussianMixture is a finite mixture of multivariate normal distributions.
+// Its pdf is given by
+//  p(x) = \sum_k w_k N(x; μ_k, Σ_k)
+// where the weights w_k are non-negative and sum to one, and N(x; μ_k, Σ_k) is
+// the pdf of the k^th normal component. Use NewGaussianMixture or
+// FitGaussianMixture to construct.
+type GaussianMixture struct {
+	weights    []float64
+	logWeights []float64
+	components []*Normal
+	dim        int
+
+	// If src is altered, rnd must be updated.
+	src rand.Source
+	rnd *rand.Rand
+}
+
+// NewGaussianMixture returns a GaussianMixture with the given component weights
+// and normal components. The weights are normalized to sum to one.
+// NewGaussianMixture panics if there are no components, if len(weights) is not
+// equal to len(components), if any weight is negative or all weights are zero,
+// or if the components do not all have the same dimension.
+//
+// The components are retained by the returned GaussianMixture and must not be
+// modified while it is in use.
+func NewGaussianMixture(weights []float64, components []*Normal, src rand.Source) *GaussianMixture {
+	if len(components) == 0 {
+		panic("distmv: no mixture components")
+	}
+	if len(weights) != len(components) {
+		panic(badInputLength)
+	}
+	dim := components[0].Dim()
+	var sum float64
+	for i, w := range weights {
+		if w < 0 {
+			panic("distmv: negative mixture weight")
+		}
+		if components[i].Dim() != dim {
+			panic(badSizeMismatch)
+		}
+		sum += w
+	}
+	if sum == 0 {
+		panic("distmv: zero mixture weights")
+	}
+	g := &GaussianMixture{
+		weights:    make([]float64, len(weights)),
+		logWeights: make([]float64, len(weights)),
+		components: make([]*Normal, len(components)),
+		dim:        dim,
+		src:        src,
+		rnd:        rand.New(src),
+	}
+	for i, w := range weights {
+		g.weights[i] = w / sum
+		g.logWeights[i] = math.Log(g.weights[i])
+	}
+	copy(g.components, components)
+	return g
+}
+
+// Component returns the i^th normal component of the mixture.
+func (g *GaussianMixture) Component(i int) *Normal {
+	return g.components[i]
+}
+
+// CovarianceMatrix returns the covariance matrix of the distribution. Upon
+// return, the value at element {i, j} of the covariance matrix is equal to
+// the covariance of the i^th and j^th variables.
+//  covariance(i, j) = E[(x_i - E[x_i])(x_j - E[x_j])]
+// If the input matrix is nil a new matrix is allocated, otherwise the result
+// is stored in-place into the input.
+func (g *GaussianMixture) CovarianceMatrix(s *mat.SymDense) *mat.SymDense {
+	if s == nil {
+		s = mat.NewSymDense(g.dim, nil)
+	} else if s.Symmetric() == 0 {
+		*s = *(s.GrowSquare(g.dim).(*mat.SymDense))
+	} else if s.Symmetric() != g.dim {
+		panic("distmv: input matrix size mismatch")
+	}
+	// The covariance of the mixture is
+	//  \sum_k w_k (Σ_k + μ_k μ_k^T) - μ μ^T
+	// where μ is the mean of the mixture.
+	s.Zero()
+	for k, c := range g.components {
+		s.AddSym(s, scaledSym(g.weights[k], &c.sigma))
+		s.SymRankOne(s, g.weights[k], mat.NewVecDense(g.dim, c.mu))
+	}
+	mu := g.Mean(nil)
+	s.SymRankOne(s, -1, mat.NewVecDense(g.dim, mu))
+	return s
+}
+
+// scaledSym returns f*a.
+func scaledSym(f float64, a mat.Symmetric) *mat.SymDense {
+	var s mat.SymDense
+	s.ScaleSym(f, a)
+	return &s
+}
+
+// Dim returns the dimension of the distribution.
+func (g *GaussianMixture) Dim() int {
+	return g.dim
+}
+
+// LogProb computes the log of the pdf of the point x.
+func (g *GaussianMixture) LogProb(x []float64) float64 {
+	if len(x) != g.dim {
+		panic(badSizeMismatch)
+	}
+	lp := make([]float64, len(g.components))
+	g.logJoint(lp, x)
+	return floats.LogSumExp(lp)
+}
+
+// logJoint stores the log of the joint probability of x and each component
+// into dst.
+func (g *GaussianMixture) logJoint(dst, x []float64) {
+	for k, c := range g.components {
+		dst[k] = g.logWeights[k] + normalLogProb(x, c.mu, &c.chol, c.logSqrtDet)
+	}
+}
+
+// Mean returns the mean of the probability distribution at x. If the
+// input argument is nil, a new slice will be allocated, otherwise the result
+// will be put in-place into the receiver.
+func (g *GaussianMixture) Mean(x []float64) []float64 {
+	x = reuseAs(x, g.dim)
+	for i := range x {
+		x[i] = 0
+	}
+	for k, c := range g.components {
+		floats.AddScaled(x, g.weights[k], c.mu)
+	}
+	return x
+}
+
+// NumComponents returns the number of components in the mixture.
+func (g *GaussianMixture) NumComponents() int {
+	return len(g.components)
+}
+
+// Prob computes the value of the probability density function at x.
+func (g *GaussianMixture) Prob(x []float64) float64 {
+	return math.Exp(g.LogProb(x))
+}
+
+// Rand generates a random number according to the distributon.
+// If the input slice is nil, new memory is allocated, otherwise the result is stored
+// in place.
+func (g *GaussianMixture) Rand(x []float64) []float64 {
+	x = reuseAs(x, g.dim)
+	var u float64
+	if g.src == nil {
+		u = rand.Float64()
+	} else {
+		u = g.rnd.Float64()
+	}
+	// Find the component containing u in the cumulative weights. Rounding
+	// may leave u beyond the last cumulative weight, so the last component
+	// with non-zero weight is used by default.
+	var idx int
+	var cum float64
+	for k, w := range g.weights {
+		if w == 0 {
+			continue
+		}
+		idx = k
+		cum += w
+		if u < cum {
+			break
+		}
+	}
+	for i := range x {
+		if g.src == nil {
+			x[i] = rand.NormFloat64()
+		} else {
+			x[i] = g.rnd.NormFloat64()
+		}
+	}
+	c := g.components[idx]
+	transformNormal(x, x, c.mu, &c.chol)
+	return x
+}
+
+// Responsibilities returns the posterior probabilities of each component
+// having generated the point x,
+//  p(k | x) = w_k N(x; μ_k, Σ_k) / p(x)
+// If dst is nil, a new slice will be allocated and returned, otherwise the
+// result is stored in-place into dst. Responsibilities panics if len(x) is not
+// equal to the dimension of the distribution, or if dst is non-nil and its
+// length is not equal to the number of components.
+func (g *GaussianMixture) Responsibilities(dst, x []float64) []float64 {
+	if len(x) != g.dim {
+		panic(badSizeMismatch)
+	}
+	dst = reuseAs(dst, len(g.components))
+	g.logJoint(dst, x)
+	lse := floats.LogSumExp(dst)
+	for k, v := range dst {
+		dst[k] = math.Exp(v - lse)
+	}
+	return dst
+}
+
+// Weights returns the component weights of the mixture. If dst is nil, a new
+// slice will be allocated and returned, otherwise the result is stored
+// in-place into dst.
+func (g *GaussianMixture) Weights(dst []float64) []float64 {
+	dst = reuseAs(dst, len(g.weights))
+	copy(dst, g.weights)
+	return dst
+}
+
+// GaussianMixtureSettings specifies the behavior of FitGaussianMixture.
+type GaussianMixtureSettings struct {
+	// Covariance specifies the structure of the component covariance
+	// matrices.
+	Covariance CovarianceType
+
+	// MaxIterations is the maximum number of EM iterations. If MaxIterations
+	// is not positive, a default value of 100 is used.
+	MaxIterations int
+
+	// Tolerance is the convergence threshold on the change between
+	// iterations of the average log-likelihood of the observations. If
+	// Tolerance is not positive, a default value of 1e-6 is used.
+	Tolerance float64
+
+	// Regularization is added to the diagonal of the covariance matrices
+	// to keep them positive definite. If Regularization is not positive,
+	// a default value of 1e-6 is used.
+	Regularization float64
+}
+
+// GaussianMixtureResult holds information about the fit of a GaussianMixture
+// returned by FitGaussianMixture.
+type GaussianMixtureResult struct {
+	// LogLikelihood is the weighted log-likelihood of the observations
+	// under the fitted mixture.
+	LogLikelihood float64
+
+	// Iterations is the number of EM iterations performed.
+	Iterations int
+
+	// Converged is true if the change in the average log-likelihood fell
+	// below the tolerance within the maximum number of iterations.
+	Converged bool
+
+	// Parameters is the number of free parameters of the fitted model.
+	Parameters int
+
+	// BIC is the Bayesian information criterion of the fit,
+	//  BIC = -2 log L + p log n
+	// where L is the likelihood, p is the number of free parameters and n
+	// is the sum of the observation weights.
+	BIC float64
+
+	// AIC is the Akaike information criterion of the fit,
+	//  AIC = -2 log L + 2 p
+	AIC float64
+}
+
+// FitGaussianMixture fits a GaussianMixture with k components to the rows of x
+// using the expectation-maximization algorithm. If weights is nil, all of the
+// observations are weighted equally, otherwise len(weights) must equal the
+// number of rows of x. If settings is nil, the default settings are used.
+//
+// The EM iterations are started from a hard assignment of the observations to
+// initial centers chosen with the k-means++ algorithm. The random choices of
+// k-means++ are drawn from src, which is also used by the returned mixture.
+// If src is nil, the global source is used.
+//
+// FitGaussianMixture panics if k is not positive, if x has fewer than k rows,
+// or if len(weights) is not equal to the number of rows of x. If a covariance
+// matrix is not positive definite during the fitting, FitGaussianMixture
+// returns a nil mixture and a non-nil error.
+func FitGaussianMixture(x mat.Matrix, weights []float64, k int, settings *GaussianMixtureSettings, src rand.Source) (*GaussianMixture, *GaussianMixtureResult, error) {
+	n, d := x.Dims()
+	if k <= 0 {
+		panic("distmv: non-positive number of mixture components")
+	}
+	if n < k {
+		panic("distmv: fewer observations than mixture components")
+	}
+	if weights != nil && len(weights) != n {
+		panic(badInputLength)
+	}
+	if settings == nil {
+		settings = &GaussianMixtureSettings{}
+	}
+	maxIter := settings.MaxIterations
+	if maxIter <= 0 {
+		maxIter = 100
+	}
+	tol := settings.Tolerance
+	if tol <= 0 {
+		tol = 1e-6
+	}
+	reg := settings.Regularization
+	if reg <= 0 {
+		reg = 1e-6
+	}
+	switch settings.Covariance {
+	default:
+		panic("distmv: unknown covariance type")
+	case FullCovariance, DiagonalCovariance, SphericalCovariance, TiedCovariance:
+	}
+
+	if weights == nil {
+		weights = make([]float64, n)
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+	sumWeights := floats.Sum(weights)
+
+	em := &gaussianMixtureEM{
+		x:       mat.DenseCopyOf(x),
+		weights: weights,
+		k:       k,
+		cov:     settings.Covariance,
+		reg:     reg,
+		src:     src,
+		resp:    mat.NewDense(n, k, nil),
+	}
+
+	var uniform func() float64
+	if src == nil {
+		uniform = rand.Float64
+	} else {
+		uniform = rand.New(src).Float64
+	}
+	em.initKMeansPlusPlus(uniform)
+
+	var (
+		ll        float64
+		prev      = math.Inf(-1)
+		iter      int
+		converged bool
+	)
+	for {
+		g, ok := em.maximize()
+		if !ok {
+			return nil, nil, errNotPosDef
+		}
+		iter++
+		ll = em.expect(g)
+		if math.Abs(ll-prev) < tol*sumWeights {
+			converged = true
+		}
+		if converged || iter == maxIter {
+			params := k - 1 + k*d
+			switch settings.Covariance {
+			case FullCovariance:
+				params += k * d * (d + 1) / 2
+			case DiagonalCovariance:
+				params += k * d
+			case SphericalCovariance:
+				params += k
+			case TiedCovariance:
+				params += d * (d + 1) / 2
+			}
+			p := float64(params)
+			return g, &GaussianMixtureResult{
+				LogLikelihood: ll,
+				Iterations:    iter,
+				Converged:     converged,
+				Parameters:    params,
+				BIC:           -2*ll + p*math.Log(sumWeights),
+				AIC:           -2*ll + 2*p,
+			}, nil
+		}
+		prev = ll
+	}
+}
+
+// gaussianMixtureEM holds the state of the EM iterations of
+// FitGaussianMixture.
+type gaussianMixtureEM struct {
+	x       *mat.Dense
+	weights []float64
+	k       int
+	cov     CovarianceType
+	reg     float64
+	src     rand.Source
+
+	// resp holds the responsibility of each component, in the columns,
+	// for each observation, in the rows.
+	resp *mat.Dense
+}
+
+// initKMeansPlusPlus chooses k centers from the observations using the
+// k-means++ algorithm and sets the responsibilities to the hard assignment of
+// each observation to its nearest center.
+func (em *gaussianMixtureEM) initKMeansPlusPlus(uniform func() float64) {
+	n, _ := em.x.Dims()
+	centers := make([][]float64, 0, em.k)
+	dist := make([]float64, n)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	prob := make([]float64, n)
+	for len(centers) < em.k {
+		// The first center is chosen with probability proportional to the
+		// observation weights and the remaining centers with probability
+		// proportional to the weighted squared distance to the nearest
+		// center already chosen.
+		if len(centers) == 0 {
+			copy(prob, em.weights)
+		} else {
+			floats.MulTo(prob, em.weights, dist)
+		}
+		idx := sampleIndex(prob, uniform())
+		if idx < 0 {
+			// All of the remaining observations coincide with a
+			// center, so fall back to the observation weights.
+			idx = sampleIndex(em.weights, uniform())
+		}
+		c := em.x.RawRowView(idx)
+		centers = append(centers, c)
+		for i := range dist {
+			d := floats.Distance(em.x.RawRowView(i), c, 2)
+			dist[i] = math.Min(dist[i], d*d)
+		}
+	}
+
+	em.resp.Zero()
+	for i := 0; i < n; i++ {
+		row := em.x.RawRowView(i)
+		best := 0
+		bestDist := math.Inf(1)
+		for j, c := range centers {
+			d := floats.Distance(row, c, 2)
+			if d < bestDist {
+				best = j
+				bestDist = d
+			}
+		}
+		em.resp.Set(i, best, 1)
+	}
+}
+
+// sampleIndex returns the index i sampled with probability proportional to
+// p[i] using the uniform variate u. sampleIndex returns -1 if the sum of p is
+// zero.
+func sampleIndex(p []float64, u float64) int {
+	sum := floats.Sum(p)
+	if sum == 0 {
+		return -1
+	}
+	target := u * sum
+	idx := -1
+	var cum float64
+	for i, v := range p {
+		if v == 0 {
+			continue
+		}
+		idx = i
+		cum += v
+		if target < cum {
+			break
+		}
+	}
+	return idx
+}
+
+// expect updates the responsibilities using the mixture g and returns the
+// weighted log-likelihood of the observations.
+func (em *gaussianMixtureEM) expect(g *GaussianMixture) float64 {
+	n, _ := em.x.Dims()
+	var ll float64
+	for i := 0; i < n; i++ {
+		r := em.resp.RawRowView(i)
+		g.logJoint(r, em.x.RawRowView(i))
+		lse := floats.LogSumExp(r)
+		for k, v := range r {
+			r[k] = math.Exp(v - lse)
+		}
+		ll += em.weights[i] * lse
+	}
+	return ll
+}
+
+// maximize returns the mixture that maximizes the expected log-likelihood
+// under the current responsibilities. The returned boolean is false if a
+// covariance matrix is not positive definite.
+func (em *gaussianMixtureEM) maximize() (*GaussianMixture, bool) {
+	n, d := em.x.Dims()
+
+	// A small amount is added to the total responsibility of each component
+	// so that components with no observations remain well defined.
+	const minResp = 10 * 2.220446049250313e-16
+
+	nk := make([]float64, em.k)
+	means := make([][]float64, em.k)
+	for k := range means {
+		means[k] = make([]float64, d)
+	}
+	for i := 0; i < n; i++ {
+		row := em.x.RawRowView(i)
+		for k := range nk {
+			r := em.weights[i] * em.resp.At(i, k)
+			nk[k] += r
+			floats.AddScaled(means[k], r, row)
+		}
+	}
+	for k := range nk {
+		nk[k] += minResp
+		floats.Scale(1/nk[k], means[k])
+	}
+
+	covs := make([]*mat.SymDense, em.k)
+	centered := mat.NewDense(n, d, nil)
+	var tied *mat.SymDense
+	if em.cov == TiedCovariance {
+		tied = mat.NewSymDense(d, nil)
+	}
+	for k := range covs {
+		// Form the rows sqrt(w_i r_ik) (x_i - μ_k).
+		for i := 0; i < n; i++ {
+			dst := centered.RawRowView(i)
+			floats.SubTo(dst, em.x.RawRowView(i), means[k])
+			floats.Scale(math.Sqrt(em.weights[i]*em.resp.At(i, k)), dst)
+		}
+		switch em.cov {
+		case FullCovariance:
+			covs[k] = mat.NewSymDense(d, nil)
+			covs[k].SymOuterK(1/nk[k], centered.T())
+		case TiedCovariance:
+			tied.SymOuterK(1, centered.T())
+			if k == 0 {
+				covs[k] = mat.NewSymDense(d, nil)
+			}
+			covs[0].AddSym(covs[0], tied)
+		case DiagonalCovariance, SphericalCovariance:
+			diag := make([]float64, d)
+			for i := 0; i < n; i++ {
+				for j, v := range centered.RawRowView(i) {
+					diag[j] += v * v
+				}
+			}
+			floats.Scale(1/nk[k], diag)
+			if em.cov == SphericalCovariance {
+				v := floats.Sum(diag) / float64(d)
+				for j := range diag {
+					diag[j] = v
+				}
+			}
+			covs[k] = mat.NewSymDense(d, nil)
+			for j, v := range diag {
+				covs[k].SetSym(j, j, v)
+			}
+		}
+	}
+	if em.cov == TiedCovariance {
+		covs[0].ScaleSym(1/floats.Sum(nk), covs[0])
+		for k := 1; k < em.k; k++ {
+			covs[k] = covs[0]
+		}
+	}
+
+	components := make([]*Normal, em.k)
+	for k := range components {
+		if em.cov != TiedCovariance || k == 0 {
+			for j := 0; j < d; j++ {
+				covs[k].SetSym(j, j, covs[k].At(j, j)+em.reg)
+			}
+		}
+		var ok bool
+		components[k], ok = NewNormal(means[k], covs[k], em.src)
+		if !ok {
+			return nil, false
+		}
+	}
+	return NewGaussianMixture(nk, components, em.src), true
+}
@@ -0,0 +1,235 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func newTestMixture(t *testing.T, src rand.Source) *GaussianMixture {
+	weights := []float64{0.5, 0.3, 0.2}
+	mus := [][]float64{{0, 0}, {6, 1}, {-2, 7}}
+	sigmas := [][]float64{
+		{1, 0.3, 0.3, 0.5},
+		{0.8, -0.2, -0.2, 1.5},
+		{2, 0, 0, 0.4},
+	}
+	components := make([]*Normal, len(weights))
+	for i := range components {
+		var ok bool
+		components[i], ok = NewNormal(mus[i], mat.NewSymDense(2, sigmas[i]), src)
+		if !ok {
+			t.Fatalf("bad test: covariance %d not positive definite", i)
+		}
+	}
+	return NewGaussianMixture(weights, components, src)
+}
+
+func TestGaussianMixtureProbs(t *testing.T) {
+	g := newTestMixture(t, nil)
+	var cases []probCase
+	for _, loc := range [][]float64{{0, 0}, {6, 1}, {3, 3}, {-10, 4}} {
+		var p float64
+		for k := 0; k < g.NumComponents(); k++ {
+			p += g.weights[k] * g.Component(k).Prob(loc)
+		}
+		cases = append(cases, probCase{dist: g, loc: loc, logProb: math.Log(p)})
+	}
+	testProbability(t, cases)
+}
+
+func TestGaussianMixtureRand(t *testing.T) {
+	g := newTestMixture(t, rand.NewSource(1))
+	x := mat.NewDense(200000, g.Dim(), nil)
+	generateSamples(x, g)
+	checkMean(t, 0, x, g, 2e-2)
+	checkCov(t, 0, x, g, 5e-2)
+}
+
+func TestGaussianMixtureResponsibilities(t *testing.T) {
+	g := newTestMixture(t, nil)
+	for _, loc := range [][]float64{{0, 0}, {6, 1}, {3, 3}, {-10, 4}} {
+		resp := g.Responsibilities(nil, loc)
+		if math.Abs(floats.Sum(resp)-1) > 1e-14 {
+			t.Errorf("responsibilities at %v do not sum to one: %v", loc, resp)
+		}
+		p := g.Prob(loc)
+		for k, r := range resp {
+			want := g.weights[k] * g.Component(k).Prob(loc) / p
+			if math.Abs(r-want) > 1e-12 {
+				t.Errorf("responsibility mismatch at %v for component %d: got %v, want %v", loc, k, r, want)
+			}
+		}
+	}
+}
+
+func TestFitGaussianMixture(t *testing.T) {
+	truth := newTestMixture(t, rand.NewSource(1))
+	const n = 5000
+	x := mat.NewDense(n, truth.Dim(), nil)
+	generateSamples(x, truth)
+
+	for _, test := range []struct {
+		cov    CovarianceType
+		params int
+	}{
+		{cov: FullCovariance, params: 2 + 6 + 9},
+		{cov: DiagonalCovariance, params: 2 + 6 + 6},
+		{cov: SphericalCovariance, params: 2 + 6 + 3},
+		{cov: TiedCovariance, params: 2 + 6 + 3},
+	} {
+		g, res, err := FitGaussianMixture(x, nil, 3, &GaussianMixtureSettings{Covariance: test.cov}, rand.NewSource(2))
+		if err != nil {
+			t.Errorf("unexpected error for covariance type %d: %v", test.cov, err)
+			continue
+		}
+		if !res.Converged {
+			t.Errorf("fit did not converge for covariance type %d", test.cov)
+		}
+		if res.Parameters != test.params {
+			t.Errorf("unexpected number of parameters for covariance type %d: got %d, want %d", test.cov, res.Parameters, test.params)
+		}
+
+		var ll float64
+		for i := 0; i < n; i++ {
+			ll += g.LogProb(x.RawRowView(i))
+		}
+		if math.Abs(ll-res.LogLikelihood) > 1e-8*math.Abs(ll) {
+			t.Errorf("log-likelihood mismatch for covariance type %d: got %v, want %v", test.cov, res.LogLikelihood, ll)
+		}
+		p := float64(test.params)
+		if math.Abs(res.BIC-(-2*ll+p*math.Log(n))) > 1e-8*math.Abs(ll) {
+			t.Errorf("unexpected BIC for covariance type %d: %v", test.cov, res.BIC)
+		}
+		if math.Abs(res.AIC-(-2*ll+2*p)) > 1e-8*math.Abs(ll) {
+			t.Errorf("unexpected AIC for covariance type %d: %v", test.cov, res.AIC)
+		}
+
+		// The components are well separated, so each true component
+		// should be recovered by the nearest fitted component.
+		weights := g.Weights(nil)
+		for k := 0; k < truth.NumComponents(); k++ {
+			want := truth.Component(k)
+			var best int
+			bestDist := math.Inf(1)
+			for j := 0; j < g.NumComponents(); j++ {
+				d := floats.Distance(want.mu, g.Component(j).mu, 2)
+				if d < bestDist {
+					best = j
+					bestDist = d
+				}
+			}
+			if bestDist > 0.2 {
+				t.Errorf("component %d mean not recovered for covariance type %d: got %v, want %v", k, test.cov, g.Component(best).mu, want.mu)
+			}
+			if math.Abs(weights[best]-truth.weights[k]) > 0.03 {
+				t.Errorf("component %d weight not recovered for covariance type %d: got %v, want %v", k, test.cov, weights[best], truth.weights[k])
+			}
+			if test.cov == FullCovariance {
+				got := g.Component(best).CovarianceMatrix(nil)
+				if !mat.EqualApprox(got, &want.sigma, 0.2) {
+					t.Errorf("component %d covariance not recovered:\ngot  %v\nwant %v", k, mat.Formatted(got), mat.Formatted(&want.sigma))
+				}
+			}
+		}
+	}
+}
+
+func TestFitGaussianMixtureMonotone(t *testing.T) {
+	truth := newTestMixture(t, rand.NewSource(1))
+	x := mat.NewDense(500, truth.Dim(), nil)
+	generateSamples(x, truth)
+
+	for _, cov := range []CovarianceType{FullCovariance, DiagonalCovariance, SphericalCovariance, TiedCovariance} {
+		prev := math.Inf(-1)
+		for iter := 1; iter <= 15; iter++ {
+			settings := &GaussianMixtureSettings{
+				Covariance:    cov,
+				MaxIterations: iter,
+				Tolerance:     1e-300,
+			}
+			_, res, err := FitGaussianMixture(x, nil, 4, settings, rand.NewSource(3))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.Iterations != iter {
+				t.Errorf("unexpected number of iterations: got %d, want %d", res.Iterations, iter)
+			}
+			if res.LogLikelihood < prev-1e-8 {
+				t.Errorf("log-likelihood decreased for covariance type %d at iteration %d: %v < %v", cov, iter, res.LogLikelihood, prev)
+			}
+			prev = res.LogLikelihood
+		}
+	}
+}
+
+func TestFitGaussianMixtureBIC(t *testing.T) {
+	truth := newTestMixture(t, rand.NewSource(1))
+	x := mat.NewDense(2000, truth.Dim(), nil)
+	generateSamples(x, truth)
+
+	best := -1
+	bestBIC := math.Inf(1)
+	for k := 1; k <= 5; k++ {
+		_, res, err := FitGaussianMixture(x, nil, k, nil, rand.NewSource(4))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.BIC < bestBIC {
+			best = k
+			bestBIC = res.BIC
+		}
+	}
+	if best != truth.NumComponents() {
+		t.Errorf("BIC selected %d components, want %d", best, truth.NumComponents())
+	}
+}
+
+func TestFitGaussianMixtureWeights(t *testing.T) {
+	// Fitting with integer observation weights should match fitting with
+	// the observations repeated, given the same initial responsibilities.
+	truth := newTestMixture(t, rand.NewSource(1))
+	const n = 300
+	x := mat.NewDense(n, truth.Dim(), nil)
+	generateSamples(x, truth)
+	weights := make([]float64, n)
+	rep := mat.NewDense(2*n, truth.Dim(), nil)
+	for i := range weights {
+		weights[i] = 2
+		rep.SetRow(2*i, x.RawRowView(i))
+		rep.SetRow(2*i+1, x.RawRowView(i))
+	}
+
+	emW := &gaussianMixtureEM{x: x, weights: weights, k: 3, reg: 1e-6, resp: mat.NewDense(n, 3, nil)}
+	emR := &gaussianMixtureEM{x: rep, weights: make([]float64, 2*n), k: 3, reg: 1e-6, resp: mat.NewDense(2*n, 3, nil)}
+	for i := 0; i < n; i++ {
+		emR.weights[2*i] = 1
+		emR.weights[2*i+1] = 1
+		emW.resp.Set(i, i%3, 1)
+		emR.resp.Set(2*i, i%3, 1)
+		emR.resp.Set(2*i+1, i%3, 1)
+	}
+	for iter := 0; iter < 5; iter++ {
+		gW, ok := emW.maximize()
+		if !ok {
+			t.Fatal("unexpected failure in weighted maximization")
+		}
+		gR, ok := emR.maximize()
+		if !ok {
+			t.Fatal("unexpected failure in repeated maximization")
+		}
+		llW := emW.expect(gW)
+		llR := emR.expect(gR)
+		if math.Abs(llW-llR) > 1e-8*math.Abs(llR) {
+			t.Errorf("log-likelihood mismatch at iteration %d: weighted %v, repeated %v", iter, llW, llR)
+		}
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// AlphaStable implements the α-stable distribution, a four-parameter family of
+// continuous distributions that is closed under linear combinations of
+// independent variables. The normal, Cauchy and Lévy distributions are special
+// cases of the α-stable distribution.
+//
+// The distribution is parameterized by its characteristic function
+//  exp(iμt - |σt|^α (1 - iβ sign(t) tan(πα/2)))    for α != 1
+//  exp(iμt - |σt| (1 + iβ sign(t) 2/π log|t|))       for α == 1
+// which is the parameterization S1 of Nolan.
+//
+// The density and distribution functions have no closed form in general and
+// are computed by numerical integration of the integral representations given
+// in
+//  Nolan, J. P. "Numerical calculation of stable densities and distribution
+//  functions." Communications in Statistics. Stochastic Models 13.4 (1997):
+//  759-774.
+//
+// AlphaStable does not implement Fitter. Each evaluation of the likelihood
+// requires a numerical integration for every sample, which makes maximum
+// likelihood estimation impractical, and the likelihood is not regular at
+// Alpha = 2 or |Beta| = 1, where the standard errors are not defined.
+//
+// For more information, see https://en.wikipedia.org/wiki/Stable_distribution.
+type AlphaStable struct {
+	// Alpha is the stability parameter. Alpha must be in (0, 2].
+	Alpha float64
+	// Beta is the skewness parameter. Beta must be in [-1, 1].
+	Beta float64
+	// Sigma is the scale parameter. Sigma must be greater than 0.
+	Sigma float64
+	// Mu is the location parameter.
+	Mu float64
+
+	Src rand.Source
+}
+
+// standard returns the value of x transformed to the distribution with unit
+// scale and zero location.
+func (a AlphaStable) standard(x float64) float64 {
+	if a.Alpha == 1 {
+		return (x - a.Mu - 2/math.Pi*a.Beta*a.Sigma*math.Log(a.Sigma)) / a.Sigma
+	}
+	return (x - a.Mu) / a.Sigma
+}
+
+// normal returns the normal distribution equivalent to a when α == 2.
+func (a AlphaStable) normal() Normal {
+	return Normal{Mu: a.Mu, Sigma: math.Sqrt2 * a.Sigma}
+}
+
+// stableKernel returns the logarithm of the function g(θ) in the integral
+// representation of the standard α-stable distribution at y, and the length
+// of the interval [lo, hi] over which g is integrated. Either y > 0 and
+// α != 1, or β > 0 and α == 1.
+//
+// The returned function takes the distances u = θ-lo and w = hi-θ from the
+// ends of the interval, and evaluates g using whichever is smaller so that the
+// trigonometric terms keep full relative precision near both ends.
+func stableKernel(alpha, beta, y float64) (logg func(u, w float64) float64, length float64) {
+	if alpha == 1 {
+		// lo = -π/2 and hi = π/2.
+		c := -math.Pi*y/(2*beta) + math.Log(2/math.Pi)
+		logg = func(u, w float64) float64 {
+			// v = π/2 + βθ, and the cosine and tangent of θ.
+			var v, cos, tan float64
+			if u <= w {
+				v = math.Pi/2*(1-beta) + beta*u
+				cos = math.Sin(u)
+				tan = -math.Cos(u) / cos
+			} else {
+				v = math.Pi/2*(1+beta) - beta*w
+				cos = math.Sin(w)
+				tan = math.Cos(w) / cos
+			}
+			return c + math.Log(v/cos) + v*tan/beta
+		}
+		return logg, math.Pi
+	}
+	// lo = -θ0 and hi = π/2.
+	theta0 := math.Atan(beta*math.Tan(math.Pi*alpha/2)) / alpha
+	length = math.Pi/2 + theta0
+	c1 := math.Pi/2 - theta0
+	c2 := math.Pi - alpha*length
+	e := alpha / (alpha - 1)
+	c := e*math.Log(y) + math.Log(math.Cos(alpha*theta0))/(alpha-1)
+	logg = func(u, w float64) float64 {
+		// g(θ) = (cos αθ0)^(1/(α-1)) (cos θ / sin α(θ0+θ))^(α/(α-1))
+		//         * cos(αθ0+(α-1)θ) / cos θ
+		var cos, sin, cosz float64
+		if u <= w {
+			cos = math.Sin(c1 + u)
+			sin = math.Sin(alpha * u)
+			cosz = math.Sin(c1 + (1-alpha)*u)
+		} else {
+			cos = math.Sin(w)
+			sin = math.Sin(c2 + alpha*w)
+			cosz = math.Sin(c2 + (alpha-1)*w)
+		}
+		return c + e*math.Log(cos/sin) + math.Log(cosz/cos)
+	}
+	return logg, length
+}
+
+// stableIntegral returns the integral of f(g(θ)) over the interval of length
+// length where log(g(θ)) = logg(u, w) as returned by stableKernel. The
+// integrands are concentrated near the point where g(θ) = 1, so the
+// interval is divided into panels that shrink geometrically towards it.
+func stableIntegral(f func(g float64) float64, logg func(u, w float64) float64, length float64) float64 {
+	if length <= 0 {
+		return 0
+	}
+	half := length / 2
+	lower := func(u float64) float64 {
+		return f(math.Exp(logg(u, length-u)))
+	}
+	upper := func(w float64) float64 {
+		return f(math.Exp(logg(length-w, w)))
+	}
+
+	// g is monotonic over the interval, so locate the point where g = 1 by
+	// bisection within the half of the interval that contains it. The
+	// peaks of both halves are placed at the midpoint of the interval
+	// when g does not cross 1 within them.
+	peakLower, peakUpper := half, half
+	eps := 1e-10 * length
+	lm := logg(half, half)
+	if l := logg(eps, length-eps); l*lm < 0 {
+		peakLower = stableRoot(func(u float64) float64 { return logg(u, length-u) }, eps, half, l)
+	} else if l := logg(length-eps, eps); l*lm < 0 {
+		peakUpper = stableRoot(func(w float64) float64 { return logg(length-w, w) }, eps, half, l)
+	}
+	return gradedIntegral(lower, half, peakLower) + gradedIntegral(upper, half, peakUpper)
+}
+
+// stableRoot returns the root of the monotonic function f in [a, b], where
+// fa = f(a).
+func stableRoot(f func(float64) float64, a, b, fa float64) float64 {
+	for i := 0; i < 100 && b-a > 1e-15*b; i++ {
+		m := a + (b-a)/2
+		if (f(m) < 0) == (fa < 0) {
+			a = m
+		} else {
+			b = m
+		}
+	}
+	return a + (b-a)/2
+}
+
+// gradedIntegral returns the integral of f over [0, b], integrating over
+// panels whose widths shrink geometrically towards the peak at p in [0, b].
+func gradedIntegral(f func(float64) float64, b, p float64) float64 {
+	var sum float64
+	for _, end := range []float64{0, b} {
+		x0 := end
+		for d := p - end; math.Abs(d) > 1e-13*math.Max(1, p); {
+			d /= 8
+			x1 := p - d
+			sum += integrate(f, math.Min(x0, x1), math.Max(x0, x1), 1e-17)
+			x0 = x1
+		}
+		sum += integrate(f, math.Min(x0, p), math.Max(x0, p), 1e-17)
+	}
+	return sum
+}
+
+// stableDensity is the integrand of the density function.
+func stableDensity(g float64) float64 {
+	if math.IsInf(g, 1) {
+		return 0
+	}
+	return g * math.Exp(-g)
+}
+
+// stableLower is the integrand of the lower tail of the distribution function.
+func stableLower(g float64) float64 {
+	return math.Exp(-g)
+}
+
+// stableUpper is the integrand of the upper tail of the distribution function.
+func stableUpper(g float64) float64 {
+	return -math.Expm1(-g)
+}
+
+// standardProb returns the density function of the standard α-stable
+// distribution with skewness beta at y.
+func (a AlphaStable) standardProb(y, beta float64) float64 {
+	alpha := a.Alpha
+	if alpha == 1 {
+		if beta == 0 {
+			return 1 / (math.Pi * (1 + y*y))
+		}
+		if beta < 0 {
+			y, beta = -y, -beta
+		}
+		logg, length := stableKernel(alpha, beta, y)
+		return stableIntegral(stableDensity, logg, length) / (2 * beta)
+	}
+	if y == 0 {
+		zeta := -beta * math.Tan(math.Pi*alpha/2)
+		theta0 := math.Atan(-zeta) / alpha
+		return math.Gamma(1+1/alpha) * math.Cos(theta0) / (math.Pi * math.Pow(1+zeta*zeta, 1/(2*alpha)))
+	}
+	if y < 0 {
+		y, beta = -y, -beta
+	}
+	logg, length := stableKernel(alpha, beta, y)
+	return alpha / (math.Pi * math.Abs(alpha-1) * y) * stableIntegral(stableDensity, logg, length)
+}
+
+// standardCDF returns the distribution function and survival function of
+// the standard α-stable distribution with skewness beta at y.
+func (a AlphaStable) standardCDF(y, beta float64) (cdf, survival float64) {
+	alpha := a.Alpha
+	if alpha == 1 {
+		if beta == 0 {
+			return math.Atan2(1, -y) / math.Pi, math.Atan2(1, y) / math.Pi
+		}
+		if beta < 0 {
+			survival, cdf = a.standardCDF(-y, -beta)
+			return cdf, survival
+		}
+		logg, length := stableKernel(alpha, beta, y)
+		cdf = stableIntegral(stableLower, logg, length) / math.Pi
+		survival = stableIntegral(stableUpper, logg, length) / math.Pi
+		return cdf, survival
+	}
+	theta0 := math.Atan(beta*math.Tan(math.Pi*alpha/2)) / alpha
+	if y == 0 {
+		return (math.Pi/2 - theta0) / math.Pi, (math.Pi/2 + theta0) / math.Pi
+	}
+	if y < 0 {
+		survival, cdf = a.standardCDF(-y, -beta)
+		return cdf, survival
+	}
+	logg, length := stableKernel(alpha, beta, y)
+	if alpha > 1 {
+		survival = stableIntegral(stableLower, logg, length) / math.Pi
+		return 1 - survival, survival
+	}
+	cdf = (math.Pi/2-theta0)/math.Pi + stableIntegral(stableLower, logg, length)/math.Pi
+	survival = stableIntegral(stableUpper, logg, length) / math.Pi
+	return cdf, survival
+}
+
+// support returns the bounds of the support of the distribution.
+func (a AlphaStable) support() (lo, hi float64) {
+	if a.Alpha < 1 {
+		switch a.Beta {
+		case 1:
+			return a.Mu, math.Inf(1)
+		case -1:
+			return math.Inf(-1), a.Mu
+		}
+	}
+	return math.Inf(-1), math.Inf(1)
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (a AlphaStable) CDF(x float64) float64 {
+	if a.Alpha == 2 {
+		return a.normal().CDF(x)
+	}
+	cdf, _ := a.standardCDF(a.standard(x), a.Beta)
+	return cdf
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+//
+// The excess kurtosis is undefined for α < 2, and this returns math.NaN().
+func (a AlphaStable) ExKurtosis() float64 {
+	if a.Alpha == 2 {
+		return 0
+	}
+	return math.NaN()
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (a AlphaStable) LogProb(x float64) float64 {
+	if a.Alpha == 2 {
+		return a.normal().LogProb(x)
+	}
+	return math.Log(a.standardProb(a.standard(x), a.Beta)) - math.Log(a.Sigma)
+}
+
+// Mean returns the mean of the probability distribution.
+//
+// The mean is undefined for α <= 1, and this returns math.NaN().
+func (a AlphaStable) Mean() float64 {
+	if a.Alpha <= 1 {
+		return math.NaN()
+	}
+	return a.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (AlphaStable) NumParameters() int {
+	return 4
+}
+
+// Prob computes the value of the probability density function at x.
+func (a AlphaStable) Prob(x float64) float64 {
+	return math.Exp(a.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (a AlphaStable) Quantile(p float64) float64 {
+	if a.Alpha == 2 {
+		if p < 0 || 1 < p {
+			panic(badPercentile)
+		}
+		return a.Mu + math.Sqrt2*a.Sigma*mathext.NormalQuantile(p)
+	}
+	lo, hi := a.support()
+	x0 := a.Mu
+	if a.Alpha == 1 {
+		x0 += 2 / math.Pi * a.Beta * a.Sigma * math.Log(a.Sigma)
+	}
+	return continuousQuantile(p, a.CDF, a.Prob, lo, hi, x0)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (a AlphaStable) Rand() float64 {
+	// Use the method of Chambers, Mallows and Stuck.
+	//
+	// Chambers, J. M., Mallows, C. L. and Stuck, B. W. "A method for
+	// simulating stable random variables." Journal of the American
+	// Statistical Association 71.354 (1976): 340-344.
+	var v, w float64
+	if a.Src == nil {
+		v = rand.Float64()
+		w = rand.ExpFloat64()
+	} else {
+		rnd := rand.New(a.Src)
+		v = rnd.Float64()
+		w = rnd.ExpFloat64()
+	}
+	v = math.Pi * (v - 0.5)
+	alpha, beta := a.Alpha, a.Beta
+	if alpha == 1 {
+		c := math.Pi/2 + beta*v
+		x := 2 / math.Pi * (c*math.Tan(v) - beta*math.Log(math.Pi/2*w*math.Cos(v)/c))
+		return a.Sigma*x + 2/math.Pi*beta*a.Sigma*math.Log(a.Sigma) + a.Mu
+	}
+	t := beta * math.Tan(math.Pi*alpha/2)
+	b := math.Atan(t) / alpha
+	s := math.Pow(1+t*t, 1/(2*alpha))
+	x := s * math.Sin(alpha*(v+b)) / math.Pow(math.Cos(v), 1/alpha) *
+		math.Pow(math.Cos(v-alpha*(v+b))/w, (1-alpha)/alpha)
+	return a.Sigma*x + a.Mu
+}
+
+// Skewness returns the skewness of the distribution.
+//
+// The skewness is undefined for α < 2, and this returns math.NaN().
+func (a AlphaStable) Skewness() float64 {
+	if a.Alpha == 2 {
+		return 0
+	}
+	return math.NaN()
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (a AlphaStable) StdDev() float64 {
+	return math.Sqrt(a.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (a AlphaStable) Survival(x float64) float64 {
+	if a.Alpha == 2 {
+		return a.normal().Survival(x)
+	}
+	_, survival := a.standardCDF(a.standard(x), a.Beta)
+	return survival
+}
+
+// Variance returns the variance of the probability distribution.
+//
+// The variance is infinite for α < 2.
+func (a AlphaStable) Variance() float64 {
+	if a.Alpha == 2 {
+		return 2 * a.Sigma * a.Sigma
+	}
+	return math.Inf(1)
+}
@@ -0,0 +1,89 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestAlphaStableProbCDF(t *testing.T) {
+	const tol = 1e-10
+	for i, tt := range []struct {
+		x, alpha, beta, sigma, mu float64
+		wantProb, wantCDF         float64
+	}{
+		// Values calculated from the closed forms of the Lévy, Cauchy and
+		// normal distributions.
+		{1.5, 0.5, 1, 2, 1, 0.21596386605275225, 0.045500263896358396},
+		{3, 0.5, 1, 2, 1, 0.1209853622595717, 0.31731050786291404},
+		{20, 0.5, 1, 2, 1, 0.006463043186425063, 0.7456027889274615},
+		{0.5, 0.5, 1, 2, 1, 0, 0},
+		{0.5, 0.5, -1, 2, 1, 0.21596386605275225, 0.9544997361036416},
+		{-1, 0.5, -1, 2, 1, 0.1209853622595717, 0.682689492137086},
+		{-18, 0.5, -1, 2, 1, 0.006463043186425063, 0.25439721107253854},
+		{-3, 1, 0, 2, 1, 0.03183098861837907, 0.14758361765043326},
+		{1, 1, 0, 2, 1, 0.15915494309189535, 0.5},
+		{4, 1, 0, 2, 1, 0.048970751720583176, 0.8128329581890013},
+		{-4, 2, 0.3, 1.5, -1, 0.06918458290343246, 0.0786496035251426},
+		{-1, 2, 0.3, 1.5, -1, 0.18806319451591877, 0.5},
+		{0.5, 2, 0.3, 1.5, -1, 0.14646376315590748, 0.7602499389065233},
+	} {
+		a := AlphaStable{Alpha: tt.alpha, Beta: tt.beta, Sigma: tt.sigma, Mu: tt.mu}
+		prob := a.Prob(tt.x)
+		if !floats.EqualWithinAbsOrRel(prob, tt.wantProb, tol, tol) {
+			t.Errorf("Prob mismatch case %d: got %v, want %v", i, prob, tt.wantProb)
+		}
+		cdf := a.CDF(tt.x)
+		if !floats.EqualWithinAbsOrRel(cdf, tt.wantCDF, tol, tol) {
+			t.Errorf("CDF mismatch case %d: got %v, want %v", i, cdf, tt.wantCDF)
+		}
+		survival := a.Survival(tt.x)
+		if !floats.EqualWithinAbsOrRel(survival, 1-tt.wantCDF, tol, tol) {
+			t.Errorf("Survival mismatch case %d: got %v, want %v", i, survival, 1-tt.wantCDF)
+		}
+	}
+}
+
+func TestAlphaStable(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, a := range []AlphaStable{
+		{1.5, 0.5, 1, 0, src},
+		{0.8, -0.3, 2, 1, src},
+		{1, 0.5, 3, -2, src},
+		{1.1, 1, 0.5, 0, src},
+		{0.5, 1, 1, 0, src},
+		{2, 0, 1, 0, src},
+	} {
+		testAlphaStable(t, a, i)
+	}
+}
+
+func testAlphaStable(t *testing.T, a AlphaStable, i int) {
+	const (
+		tol = 1e-2
+		n   = 1e5
+	)
+	x := make([]float64, n)
+	generateSamples(x, a)
+	sort.Float64s(x)
+
+	checkQuantileCDFSurvival(t, i, x, a, tol)
+
+	// The density and distribution functions are computed by separate
+	// integrals, so check that they are consistent.
+	for _, p := range []float64{0.05, 0.3, 0.5, 0.7, 0.95} {
+		q := a.Quantile(p)
+		h := 1e-4 * a.Sigma
+		deriv := (a.CDF(q+h) - a.CDF(q-h)) / (2 * h)
+		if !floats.EqualWithinAbsOrRel(a.Prob(q), deriv, 1e-6, 1e-6) {
+			t.Errorf("Prob mismatch case %d at %v: got %v, want %v", i, q, a.Prob(q), deriv)
+		}
+	}
+}
@@ -47,6 +47,29 @@ func (b Bernoulli) ExKurtosis() float64 {
 	return (1 - 6*pq) / pq
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimate of P is the weighted fraction of the samples that equal 1.
+func (b *Bernoulli) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	b.P = weightedMean(identity, samples, weights)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [P] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (b Bernoulli) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 1)
+	dst[0] = math.Sqrt(b.P * (1 - b.P) / sumWeights(samples, weights))
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (b Bernoulli) LogProb(x float64) float64 {
 	if x == 0 {
@@ -9,6 +9,7 @@ import (
 
 	"golang.org/x/exp/rand"
 
+	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/gonum/mathext"
 )
 
@@ -57,6 +58,71 @@ func (b Beta) ExKurtosis() float64 {
 	return num / den
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates have no closed form and are found by Newton's method
+// starting from the method of moments estimates.
+// If the iteration does not converge, the parameters are set to the best
+// estimate found.
+func (b *Beta) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mean := weightedMean(identity, samples, weights)
+	variance := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+	meanLog := weightedMean(math.Log, samples, weights)
+	meanLog1m := weightedMean(func(x float64) float64 { return math.Log1p(-x) }, samples, weights)
+
+	p := []float64{1, 1}
+	if c := mean*(1-mean)/variance - 1; c > 0 {
+		p[0] = mean * c
+		p[1] = (1 - mean) * c
+	}
+	// The log-likelihood per unit weight depends on the samples only
+	// through meanLog and meanLog1m.
+	maximizeLikelihood(p, mlProblem{
+		positive: []bool{true, true},
+		weight:   1,
+		logLik: func(p []float64) float64 {
+			return (p[0]-1)*meanLog + (p[1]-1)*meanLog1m - mathext.Lbeta(p[0], p[1])
+		},
+		score: func(dst, p []float64) {
+			dab := mathext.Digamma(p[0] + p[1])
+			dst[0] = meanLog - mathext.Digamma(p[0]) + dab
+			dst[1] = meanLog1m - mathext.Digamma(p[1]) + dab
+		},
+		hess: func(dst *mat.SymDense, p []float64) {
+			tab := trigamma(p[0] + p[1])
+			dst.SetSym(0, 0, tab-trigamma(p[0]))
+			dst.SetSym(0, 1, tab)
+			dst.SetSym(1, 1, tab-trigamma(p[1]))
+		},
+	})
+	b.Alpha = p[0]
+	b.Beta = p[1]
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Alpha, Beta] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (b Beta) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	// The Fisher information for a single sample is
+	//  [ψ'(α)-ψ'(α+β)  -ψ'(α+β)      ]
+	//  [-ψ'(α+β)       ψ'(β)-ψ'(α+β) ]
+	tab := trigamma(b.Alpha + b.Beta)
+	ia := trigamma(b.Alpha) - tab
+	ib := trigamma(b.Beta) - tab
+	d := (ia*ib - tab*tab) * sumWeights(samples, weights)
+	dst[0] = math.Sqrt(ib / d)
+	dst[1] = math.Sqrt(ia / d)
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (b Beta) LogProb(x float64) float64 {
@@ -46,6 +46,30 @@ func (b Binomial) ExKurtosis() float64 {
 	return (1 - 6*v) / (b.N * v)
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The number of trials N is not estimated and must be set before calling Fit.
+// The estimate of P is the weighted mean of the samples divided by N.
+func (b *Binomial) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	b.P = weightedMean(identity, samples, weights) / b.N
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [P] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (b Binomial) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 1)
+	dst[0] = math.Sqrt(b.P * (1 - b.P) / (b.N * sumWeights(samples, weights)))
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (b Binomial) LogProb(x float64) float64 {
@@ -74,6 +74,42 @@ func (c Categorical) Entropy() float64 {
 	return -ent
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The samples must be category indices in [0, c.Len()). The estimated
+// probability of each category is its weighted frequency in the samples.
+func (c Categorical) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	w := make([]float64, c.Len())
+	for i, x := range samples {
+		xi := int(x)
+		if float64(xi) != x || xi < 0 || xi >= len(w) {
+			panic("categorical: sample out of range")
+		}
+		w[xi] += weightAt(weights, i)
+	}
+	c.ReweightAll(w)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of the probabilities of each category for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (c Categorical) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, c.Len())
+	n := sumWeights(samples, weights)
+	for i := range dst {
+		p := c.Prob(float64(i))
+		dst[i] = math.Sqrt(p * (1 - p) / n)
+	}
+	return dst
+}
+
 // Len returns the number of values x could possibly take (the length of the
 // initial supplied weight vector).
 func (c Categorical) Len() int {
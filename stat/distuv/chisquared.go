@@ -38,6 +38,31 @@ func (c ChiSquared) ExKurtosis() float64 {
 	return 12 / c.K
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimate of K satisfies ψ(K/2) = mean(log(x)) - log(2), where ψ is the
+// digamma function, and is found by Newton's method.
+func (c *ChiSquared) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	meanLog := weightedMean(math.Log, samples, weights)
+	c.K = 2 * digammaInv(meanLog-math.Ln2)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [K] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (c ChiSquared) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 1)
+	dst[0] = 2 / math.Sqrt(trigamma(c.K/2)*sumWeights(samples, weights))
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (c ChiSquared) LogProb(x float64) float64 {
@@ -210,6 +210,20 @@ func checkProbQuantContinuous(t *testing.T, i int, xs []float64, c cumulantProbe
 	}
 }
 
+// checkQuantileDiscrete checks that Quantile, CDF and Survival are consistent
+// for discrete distributions over the integers.
+func checkQuantileDiscrete(t *testing.T, i int, c cumulanter) {
+	for _, p := range []float64{0.1, 0.25, 0.5, 0.75, 0.9} {
+		k := c.Quantile(p)
+		if c.CDF(k) < p || c.CDF(k-1) >= p {
+			t.Errorf("Quantile mismatch case %v: CDF(%v) = %v and CDF(%v) = %v for p = %v", i, k-1, c.CDF(k-1), k, c.CDF(k), p)
+		}
+		if math.Abs(1-c.CDF(k)-c.Survival(k)) > 1e-14 {
+			t.Errorf("Survival/CDF mismatch case %v: want: %v, got: %v", i, 1-c.CDF(k), c.Survival(k))
+		}
+	}
+}
+
 // checkProbDiscrete confirms that PDF and Rand are consistent for discrete distributions.
 func checkProbDiscrete(t *testing.T, i int, xs []float64, p probLogprober, tol float64) {
 	// Make a map of all of the unique samples.
@@ -0,0 +1,139 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// Erlang implements the Erlang distribution, the distribution of the sum of
+// K independent exponential variables with rate Lambda. It is the special
+// case of the gamma distribution with integer shape parameter.
+//
+// The Erlang distribution has density function
+//  λ^k x^(k-1) e^(-λx) / (k-1)!
+//
+// For more information, see https://en.wikipedia.org/wiki/Erlang_distribution.
+type Erlang struct {
+	// K is the shape parameter of the distribution. K must be greater than 0.
+	K int
+	// Lambda is the rate parameter of the distribution. Lambda must be
+	// greater than 0.
+	Lambda float64
+
+	Src rand.Source
+}
+
+// gamma returns the gamma distribution equivalent to e.
+func (e Erlang) gamma() Gamma {
+	return Gamma{Alpha: float64(e.K), Beta: e.Lambda, Src: e.Src}
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (e Erlang) CDF(x float64) float64 {
+	return e.gamma().CDF(x)
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (e Erlang) Entropy() float64 {
+	k := float64(e.K)
+	lg, _ := math.Lgamma(k)
+	return (1-k)*mathext.Digamma(k) + lg - math.Log(e.Lambda) + k
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (e Erlang) ExKurtosis() float64 {
+	return 6 / float64(e.K)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The shape K is not estimated and must be set before calling Fit.
+// The estimate of Lambda is K divided by the weighted mean of the samples.
+func (e *Erlang) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	e.Lambda = float64(e.K) / weightedMean(identity, samples, weights)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Lambda] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (e Erlang) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 1)
+	// The Fisher information for a single sample is K/λ².
+	dst[0] = e.Lambda / math.Sqrt(float64(e.K)*sumWeights(samples, weights))
+	return dst
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (e Erlang) LogProb(x float64) float64 {
+	return e.gamma().LogProb(x)
+}
+
+// Mean returns the mean of the probability distribution.
+func (e Erlang) Mean() float64 {
+	return float64(e.K) / e.Lambda
+}
+
+// Mode returns the mode of the probability distribution.
+func (e Erlang) Mode() float64 {
+	return float64(e.K-1) / e.Lambda
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Erlang) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (e Erlang) Prob(x float64) float64 {
+	return math.Exp(e.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (e Erlang) Quantile(p float64) float64 {
+	return e.gamma().Quantile(p)
+}
+
+// Rand returns a random sample drawn from the distribution.
+//
+// Rand panics if K is not positive.
+func (e Erlang) Rand() float64 {
+	if e.K <= 0 {
+		panic("erlang: k <= 0")
+	}
+	return e.gamma().Rand()
+}
+
+// Skewness returns the skewness of the distribution.
+func (e Erlang) Skewness() float64 {
+	return 2 / math.Sqrt(float64(e.K))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (e Erlang) StdDev() float64 {
+	return math.Sqrt(e.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (e Erlang) Survival(x float64) float64 {
+	return e.gamma().Survival(x)
+}
+
+// Variance returns the variance of the probability distribution.
+func (e Erlang) Variance() float64 {
+	return float64(e.K) / (e.Lambda * e.Lambda)
+}
@@ -0,0 +1,75 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestErlangProb(t *testing.T) {
+	// Values calculated from the closed form of the distribution.
+	pts := []univariateProbPoint{
+		{
+			loc:     0.1,
+			prob:    0.032749230123119276,
+			cumProb: 0.0011484812448621096,
+			logProb: -3.4188758248682007,
+		},
+		{
+			loc:     0.5,
+			prob:    0.36787944117144233,
+			cumProb: 0.08030139707139416,
+			logProb: -1.0,
+		},
+		{
+			loc:     1.5,
+			prob:    0.4480836153107755,
+			cumProb: 0.5768099188731565,
+			logProb: -0.8027754226637805,
+		},
+		{
+			loc:     4,
+			prob:    0.02146960818576076,
+			cumProb: 0.986246032255997,
+			logProb: -3.841116916640328,
+		},
+	}
+	testDistributionProbs(t, Erlang{K: 3, Lambda: 2}, "Erlang", pts)
+}
+
+func TestErlang(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, e := range []Erlang{
+		{1, 1, src},
+		{3, 2, src},
+		{10, 0.5, src},
+	} {
+		testErlang(t, e, i)
+	}
+}
+
+func testErlang(t *testing.T, e Erlang, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, e)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, 0, x, e, tol, bins)
+	checkProbContinuous(t, i, x, e, 1e-10)
+	checkMean(t, i, x, e, tol)
+	checkVarAndStd(t, i, x, e, tol)
+	checkEntropy(t, i, x, e, tol)
+	checkExKurtosis(t, i, x, e, 1e-1)
+	checkSkewness(t, i, x, e, 2e-2)
+	checkQuantileCDFSurvival(t, i, x, e, tol)
+	checkProbQuantContinuous(t, i, x, e, tol)
+}
@@ -77,6 +77,18 @@ func (e *Exponential) Fit(samples, weights []float64) {
 	e.ConjugateUpdate(suffStat, nSamples, make([]float64, e.NumSuffStat()))
 }
 
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Rate] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (e Exponential) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 1)
+	dst[0] = e.Rate / math.Sqrt(sumWeights(samples, weights))
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (e Exponential) LogProb(x float64) float64 {
 	if x < 0 {
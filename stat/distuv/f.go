@@ -9,6 +9,7 @@ import (
 
 	"golang.org/x/exp/rand"
 
+	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/gonum/mathext"
 )
 
@@ -41,6 +42,85 @@ func (f F) ExKurtosis() float64 {
 	return (12 / (f.D2 - 6)) * ((5*f.D2-22)/(f.D2-8) + ((f.D2-4)/f.D1)*((f.D2-2)/(f.D2-8))*((f.D2-2)/(f.D1+f.D2-2)))
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates have no closed form and are found by Newton's method
+// starting from the method of moments estimates where they exist.
+// If the iteration does not converge, the parameters are set to the best
+// estimate found.
+func (f *F) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mean := weightedMean(identity, samples, weights)
+	variance := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+
+	p := []float64{2, 8}
+	if mean > 1 {
+		d2 := 2 * mean / (mean - 1)
+		if d2 > 4 {
+			p[1] = d2
+			if den := variance*(d2-2)*(d2-2)*(d2-4) - 2*d2*d2; den > 0 {
+				p[0] = 2 * d2 * d2 * (d2 - 2) / den
+			}
+		}
+	}
+	maximizeLikelihood(p, mlProblem{
+		positive: []bool{true, true},
+		weight:   sumWeights(samples, weights),
+		logLik: func(p []float64) float64 {
+			return logLikelihood(F{D1: p[0], D2: p[1]}.LogProb, samples, weights)
+		},
+		score: func(dst, p []float64) {
+			weightedScore(dst, F{D1: p[0], D2: p[1]}.logProbGrad, samples, weights)
+		},
+		hess: func(dst *mat.SymDense, p []float64) {
+			weightedHessian(dst, F{D1: p[0], D2: p[1]}.logProbHess, samples, weights)
+		},
+	})
+	f.D1 = p[0]
+	f.D2 = p[1]
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [D1, D2] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// The observed Fisher information is used since the expected information has
+// no simple closed form.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (f F) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	return observedStdErr(dst, []float64{f.D1, f.D2}, func(dst *mat.SymDense, p []float64) {
+		weightedHessian(dst, F{D1: p[0], D2: p[1]}.logProbHess, samples, weights)
+	})
+}
+
+// logProbGrad stores into deriv the gradient of LogProb at x with respect to
+// [D1, D2].
+func (f F) logProbGrad(deriv []float64, x float64) []float64 {
+	a, b := f.D1, f.D2
+	s := b + a*x
+	dab := mathext.Digamma((a + b) / 2)
+	deriv[0] = 0.5*(math.Log(a*x)+1-math.Log(s)-(a+b)*x/s) - 0.5*(mathext.Digamma(a/2)-dab)
+	deriv[1] = 0.5*(math.Log(b)+1-math.Log(s)-(a+b)/s) - 0.5*(mathext.Digamma(b/2)-dab)
+	return deriv
+}
+
+// logProbHess stores into hess the Hessian of LogProb at x with respect to
+// [D1, D2].
+func (f F) logProbHess(hess *mat.SymDense, x float64) {
+	a, b := f.D1, f.D2
+	s := b + a*x
+	s2 := s * s
+	tab := trigamma((a + b) / 2)
+	hess.SetSym(0, 0, 0.5*(1/a-2*x/s+(a+b)*x*x/s2)-0.25*(trigamma(a/2)-tab))
+	hess.SetSym(0, 1, 0.5*(-(1+x)/s+(a+b)*x/s2)+0.25*tab)
+	hess.SetSym(1, 1, 0.5*(1/b-2/s+(a+b)/s2)-0.25*(trigamma(b/2)-tab))
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (f F) LogProb(x float64) float64 {
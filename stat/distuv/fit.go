@@ -0,0 +1,483 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+	"gonum.org/v1/gonum/stat"
+)
+
+// maxFitIterations is the maximum number of iterations used by the
+// iterative maximum likelihood estimators.
+const maxFitIterations = 200
+
+// fitGradientTol is the gradient norm of the log-likelihood per unit weight
+// below which the iterative maximum likelihood estimators have converged.
+// Closer to the maximum the change in the log-likelihood is of the order of
+// its rounding error, so the line search can no longer make progress.
+const fitGradientTol = 1e-7
+
+const (
+	// maxLinesearchSteps is the maximum number of step halvings in the
+	// line search of the iterative maximum likelihood estimators.
+	maxLinesearchSteps = 50
+
+	// armijoDecrease is the fraction of the decrease predicted by the
+	// gradient that a line search step must achieve.
+	armijoDecrease = 1e-4
+)
+
+// checkFitInput panics if weights is not nil and its length does not match
+// the length of samples, or if there are no samples.
+func checkFitInput(samples, weights []float64) {
+	if weights != nil && len(samples) != len(weights) {
+		panic(badLength)
+	}
+	if len(samples) == 0 {
+		panic(badNoSamples)
+	}
+}
+
+// sumWeights returns the sum of the weights, or the number of samples if
+// weights is nil.
+func sumWeights(samples, weights []float64) float64 {
+	if weights == nil {
+		return float64(len(samples))
+	}
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+	return sum
+}
+
+// weightAt returns the weight of the i^th sample.
+func weightAt(weights []float64, i int) float64 {
+	if weights == nil {
+		return 1
+	}
+	return weights[i]
+}
+
+// identity returns x.
+func identity(x float64) float64 {
+	return x
+}
+
+// weightedMean returns the weighted mean of f applied to the samples.
+func weightedMean(f func(float64) float64, samples, weights []float64) float64 {
+	var sum, sumW float64
+	for i, x := range samples {
+		w := weightAt(weights, i)
+		if w == 0 {
+			continue
+		}
+		sum += w * f(x)
+		sumW += w
+	}
+	return sum / sumW
+}
+
+// weightedQuantile returns the p quantile of the samples with relative
+// weights. The samples and weights are not modified.
+func weightedQuantile(p float64, samples, weights []float64) float64 {
+	x := make([]float64, len(samples))
+	copy(x, samples)
+	var w []float64
+	if weights != nil {
+		w = make([]float64, len(weights))
+		copy(w, weights)
+	}
+	stat.SortWeighted(x, w)
+	return stat.Quantile(p, stat.Empirical, x, w)
+}
+
+// logLikelihood returns the weighted log-likelihood of the samples for the
+// distribution with the given log density.
+func logLikelihood(logProb func(float64) float64, samples, weights []float64) float64 {
+	var ll float64
+	for i, x := range samples {
+		w := weightAt(weights, i)
+		if w == 0 {
+			continue
+		}
+		ll += w * logProb(x)
+	}
+	return ll
+}
+
+// useFitDst returns dst if it is not nil, and a new slice of length n
+// otherwise. useFitDst panics if dst is not nil and len(dst) != n.
+func useFitDst(dst []float64, n int) []float64 {
+	if dst == nil {
+		return make([]float64, n)
+	}
+	if len(dst) != n {
+		panic(badLength)
+	}
+	return dst
+}
+
+// toUnconstrained stores into u the parameters p, transformed so that the
+// parameters constrained to be positive are replaced by their logarithm.
+func toUnconstrained(u, p []float64, positive []bool) {
+	for i, v := range p {
+		if positive[i] {
+			v = math.Log(v)
+		}
+		u[i] = v
+	}
+}
+
+// fromUnconstrained is the inverse of toUnconstrained.
+func fromUnconstrained(p, u []float64, positive []bool) {
+	for i, v := range u {
+		if positive[i] {
+			v = math.Exp(v)
+		}
+		p[i] = v
+	}
+}
+
+// mlProblem is the maximization of a log-likelihood over the parameters of
+// a distribution.
+type mlProblem struct {
+	// positive marks the parameters that are constrained to be positive.
+	positive []bool
+
+	// weight is the total weight of the samples. The log-likelihood is
+	// divided by weight so that the convergence tolerances do not depend
+	// on the number of samples.
+	weight float64
+
+	// logLik returns the log-likelihood of the parameters p.
+	logLik func(p []float64) float64
+
+	// score stores into dst the gradient of the log-likelihood with respect
+	// to the parameters p.
+	score func(dst, p []float64)
+
+	// hess stores into dst the Hessian of the log-likelihood with respect
+	// to the parameters p. If hess is nil, the BFGS method is used instead
+	// of Newton's method.
+	hess func(dst *mat.SymDense, p []float64)
+}
+
+// maximizeLikelihood finds the parameters that maximize the log-likelihood of
+// prob. On entry p holds the initial parameters, on return it holds the
+// estimate. The parameters marked in prob.positive are constrained to be
+// positive by optimizing over their logarithm.
+//
+// The maximization uses Newton's method if prob.hess is not nil and the BFGS
+// method otherwise, each with a backtracking line search. The iteration stops
+// when the gradient norm of the log-likelihood per unit weight is below
+// fitGradientTol, when the line search can make no further progress or
+// after maxFitIterations iterations. If the iteration does not converge,
+// p holds the best parameters found.
+func maximizeLikelihood(p []float64, prob mlProblem) {
+	n := len(p)
+	params := make([]float64, n)
+	score := make([]float64, n)
+
+	// The objective is the negative log-likelihood per unit weight as a
+	// function of the unconstrained parameters u.
+	f := func(u []float64) float64 {
+		fromUnconstrained(params, u, prob.positive)
+		v := -prob.logLik(params) / prob.weight
+		if math.IsNaN(v) {
+			return math.Inf(1)
+		}
+		return v
+	}
+	grad := func(dst, u []float64) {
+		fromUnconstrained(params, u, prob.positive)
+		prob.score(dst, params)
+		for i, v := range dst {
+			if prob.positive[i] {
+				v *= params[i]
+			}
+			dst[i] = -v / prob.weight
+		}
+	}
+	hess := func(dst *mat.SymDense, u []float64) {
+		fromUnconstrained(params, u, prob.positive)
+		prob.hess(dst, params)
+		prob.score(score, params)
+		// Apply the chain rule for the parameters p_i = exp(u_i).
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				v := dst.At(i, j)
+				if prob.positive[i] {
+					v *= params[i]
+				}
+				if prob.positive[j] {
+					v *= params[j]
+				}
+				if i == j && prob.positive[i] {
+					v += score[i] * params[i]
+				}
+				dst.SetSym(i, j, -v/prob.weight)
+			}
+		}
+	}
+
+	u := make([]float64, n)
+	toUnconstrained(u, p, prob.positive)
+	fu := f(u)
+	g := make([]float64, n)
+	grad(g, u)
+
+	uNext := make([]float64, n)
+	gNext := make([]float64, n)
+	gv := mat.NewVecDense(n, g)
+	dir := mat.NewVecDense(n, nil)
+	h := mat.NewSymDense(n, nil)
+	var chol mat.Cholesky
+
+	// invHess is the BFGS approximation to the inverse Hessian, scaled
+	// after the first step as in Nocedal and Wright, Numerical Optimization
+	// (2nd ed), eq. 6.20.
+	var invHess *mat.SymDense
+	var s, y, tmp *mat.VecDense
+	first := true
+	if prob.hess == nil {
+		invHess = mat.NewSymDense(n, nil)
+		s = mat.NewVecDense(n, nil)
+		y = mat.NewVecDense(n, nil)
+		tmp = mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			invHess.SetSym(i, i, 1)
+		}
+	}
+
+	for iter := 0; iter < maxFitIterations; iter++ {
+		if math.IsInf(fu, 1) || floats.Norm(g, 2) < fitGradientTol {
+			break
+		}
+
+		// Find a descent direction, falling back to steepest descent if
+		// the Hessian or its approximation is not positive definite.
+		descent := false
+		if prob.hess != nil {
+			hess(h, u)
+			if chol.Factorize(h) && chol.SolveVec(dir, gv) == nil {
+				dir.ScaleVec(-1, dir)
+				descent = mat.Dot(dir, gv) < 0
+			}
+		} else {
+			dir.MulVec(invHess, gv)
+			dir.ScaleVec(-1, dir)
+			descent = mat.Dot(dir, gv) < 0
+		}
+		if !descent {
+			dir.ScaleVec(-1, gv)
+		}
+		slope := mat.Dot(dir, gv)
+
+		// Backtrack from the full step until the Armijo condition holds.
+		dirData := dir.RawVector().Data
+		step := 1.0
+		fNext := math.Inf(1)
+		for i := 0; i < maxLinesearchSteps; i++ {
+			floats.AddScaledTo(uNext, u, step, dirData)
+			fNext = f(uNext)
+			if fNext <= fu+armijoDecrease*step*slope {
+				break
+			}
+			step /= 2
+		}
+		if !(fNext <= fu+armijoDecrease*step*slope) {
+			// The log-likelihood cannot be increased further at the
+			// precision it is computed to.
+			break
+		}
+		grad(gNext, uNext)
+
+		if prob.hess == nil {
+			// Update the inverse Hessian approximation using
+			//  B_{k+1}^-1 = B_k^-1
+			//             + (s_k^T y_k + y_k^T B_k^-1 y_k) / (s_k^T y_k)^2 * (s_k s_k^T)
+			//             - (B_k^-1 y_k s_k^T + s_k y_k^T B_k^-1) / (s_k^T y_k).
+			floats.SubTo(s.RawVector().Data, uNext, u)
+			floats.SubTo(y.RawVector().Data, gNext, g)
+			sDotY := mat.Dot(s, y)
+			if sDotY > 0 {
+				if first {
+					scale := sDotY / mat.Dot(y, y)
+					for i := 0; i < n; i++ {
+						invHess.SetSym(i, i, scale)
+					}
+					first = false
+				}
+				yBy := mat.Inner(y, invHess, y)
+				tmp.MulVec(invHess, y)
+				invHess.SymRankOne(invHess, (1+yBy/sDotY)/sDotY, s)
+				invHess.RankTwo(invHess, -1/sDotY, tmp, s)
+			}
+		}
+
+		copy(u, uNext)
+		copy(g, gNext)
+		fu = fNext
+	}
+	fromUnconstrained(p, u, prob.positive)
+}
+
+// observedStdErr returns the standard errors of the maximum likelihood
+// estimates p computed from the observed Fisher information, the negative
+// of the Hessian of the log-likelihood computed by hess at p. If the
+// observed information is not positive definite, the standard errors are NaN.
+func observedStdErr(dst, p []float64, hess func(dst *mat.SymDense, p []float64)) []float64 {
+	h := mat.NewSymDense(len(p), nil)
+	hess(h, p)
+	h.ScaleSym(-1, h)
+
+	var chol mat.Cholesky
+	var cov mat.SymDense
+	if !chol.Factorize(h) || chol.InverseTo(&cov) != nil {
+		for i := range dst {
+			dst[i] = math.NaN()
+		}
+		return dst
+	}
+	for i := range dst {
+		dst[i] = math.Sqrt(cov.At(i, i))
+	}
+	return dst
+}
+
+// weightedScore stores into dst the gradient of the weighted log-likelihood
+// of the samples, given the gradient score of the log density at a sample.
+func weightedScore(dst []float64, score func(deriv []float64, x float64) []float64, samples, weights []float64) {
+	for i := range dst {
+		dst[i] = 0
+	}
+	deriv := make([]float64, len(dst))
+	for i, x := range samples {
+		w := weightAt(weights, i)
+		if w == 0 {
+			continue
+		}
+		score(deriv, x)
+		floats.AddScaled(dst, w, deriv)
+	}
+}
+
+// weightedHessian stores into dst the Hessian of the weighted log-likelihood
+// of the samples, given the Hessian hess of the log density at a sample.
+func weightedHessian(dst *mat.SymDense, hess func(dst *mat.SymDense, x float64), samples, weights []float64) {
+	n := dst.Symmetric()
+	h := mat.NewSymDense(n, nil)
+	dst.Zero()
+	for i, x := range samples {
+		w := weightAt(weights, i)
+		if w == 0 {
+			continue
+		}
+		hess(h, x)
+		for j := 0; j < n; j++ {
+			for k := j; k < n; k++ {
+				dst.SetSym(j, k, dst.At(j, k)+w*h.At(j, k))
+			}
+		}
+	}
+}
+
+// trigamma returns the trigamma function, the derivative of the digamma
+// function, at x.
+func trigamma(x float64) float64 {
+	return mathext.Zeta(2, x)
+}
+
+// digammaInv returns the x > 0 such that Digamma(x) = y.
+func digammaInv(y float64) float64 {
+	// Initial guess from Minka, "Estimating a Dirichlet distribution", 2000.
+	var x float64
+	if y >= -2.22 {
+		x = math.Exp(y) + 0.5
+	} else {
+		x = -1 / (y + eulerMascheroni)
+	}
+	for i := 0; i < maxFitIterations; i++ {
+		delta := (mathext.Digamma(x) - y) / trigamma(x)
+		for x-delta <= 0 {
+			delta /= 2
+		}
+		x -= delta
+		if math.Abs(delta) <= 1e-14*x {
+			break
+		}
+	}
+	return x
+}
+
+// fitGamma returns the maximum likelihood estimates of the shape and rate
+// parameters of a gamma distribution given the mean of the samples and the
+// mean of their logarithms.
+func fitGamma(mean, meanLog float64) (alpha, beta float64) {
+	// The shape parameter satisfies
+	//  log(α) - ψ(α) = log(mean) - meanLog
+	// which is solved by Newton's method starting from the approximation
+	// in Minka, "Estimating a Gamma distribution", 2002.
+	s := math.Log(mean) - meanLog
+	alpha = (3 - s + math.Sqrt((s-3)*(s-3)+24*s)) / (12 * s)
+	for i := 0; i < maxFitIterations; i++ {
+		f := math.Log(alpha) - mathext.Digamma(alpha) - s
+		df := 1/alpha - trigamma(alpha)
+		delta := f / df
+		for alpha-delta <= 0 {
+			delta /= 2
+		}
+		alpha -= delta
+		if math.Abs(delta) <= 1e-14*alpha {
+			break
+		}
+	}
+	return alpha, alpha / mean
+}
+
+// gammaStdErr stores into dst the standard errors of the maximum likelihood
+// estimates of the shape and rate parameters of a gamma distribution given
+// the sum of the sample weights.
+func gammaStdErr(dst []float64, alpha, beta, sumW float64) {
+	// The Fisher information for a single sample is
+	//  [ψ'(α)  -1/β]
+	//  [-1/β   α/β²]
+	tg := trigamma(alpha)
+	d := (alpha*tg - 1) * sumW
+	dst[0] = math.Sqrt(alpha / d)
+	dst[1] = beta * math.Sqrt(tg/d)
+}
+
+// fitVonMisesKappa returns the κ such that I_1(κ)/I_0(κ) = r, the maximum
+// likelihood estimate of the concentration of a von Mises distribution given
+// the length r of the weighted mean resultant vector of the samples.
+func fitVonMisesKappa(r float64) float64 {
+	if r <= 0 {
+		return 0
+	}
+	if r >= 1 {
+		return math.Inf(1)
+	}
+	// Start from the approximation in Banerjee et al., "Clustering on the
+	// unit hypersphere using von Mises-Fisher distributions", 2005.
+	kappa := r * (2 - r*r) / (1 - r*r)
+	for i := 0; i < maxFitIterations; i++ {
+		a := besselRatio(kappa)
+		delta := (a - r) / besselRatioDeriv(kappa, a)
+		for kappa-delta <= 0 {
+			delta /= 2
+		}
+		kappa -= delta
+		if math.Abs(delta) <= 1e-14*kappa {
+			break
+		}
+	}
+	return kappa
+}
@@ -0,0 +1,559 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+type fitTest struct {
+	name string
+	dist Rander
+	// newFitter returns a distribution to be fitted with any parameters
+	// that are not estimated set.
+	newFitter func() Fitter
+	// params returns the estimated parameters of a fitted distribution.
+	params func(Fitter) []float64
+	want   []float64
+	// logProb returns the log density for the given estimated parameters.
+	// It is nil for distributions where the likelihood is not smooth in
+	// the parameters.
+	logProb func(p []float64) func(float64) float64
+}
+
+func fitTests() []fitTest {
+	src := rand.NewSource(1)
+	return []fitTest{
+		{
+			name:      "Bernoulli",
+			dist:      Bernoulli{P: 0.3, Src: src},
+			newFitter: func() Fitter { return &Bernoulli{} },
+			params:    func(f Fitter) []float64 { return []float64{f.(*Bernoulli).P} },
+			want:      []float64{0.3},
+			logProb:   func(p []float64) func(float64) float64 { return Bernoulli{P: p[0]}.LogProb },
+		},
+		{
+			name:      "Beta",
+			dist:      Beta{Alpha: 2, Beta: 5, Src: src},
+			newFitter: func() Fitter { return &Beta{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Beta)
+				return []float64{d.Alpha, d.Beta}
+			},
+			want:    []float64{2, 5},
+			logProb: func(p []float64) func(float64) float64 { return Beta{Alpha: p[0], Beta: p[1]}.LogProb },
+		},
+		{
+			name:      "Binomial",
+			dist:      Binomial{N: 20, P: 0.4, Src: src},
+			newFitter: func() Fitter { return &Binomial{N: 20} },
+			params:    func(f Fitter) []float64 { return []float64{f.(*Binomial).P} },
+			want:      []float64{0.4},
+			logProb:   func(p []float64) func(float64) float64 { return Binomial{N: 20, P: p[0]}.LogProb },
+		},
+		{
+			name:      "Categorical",
+			dist:      NewCategorical([]float64{1, 2, 3, 4}, src),
+			newFitter: func() Fitter { return NewCategorical([]float64{1, 1, 1, 1}, nil) },
+			params: func(f Fitter) []float64 {
+				c := f.(Categorical)
+				p := make([]float64, c.Len())
+				for i := range p {
+					p[i] = c.Prob(float64(i))
+				}
+				return p
+			},
+			want: []float64{0.1, 0.2, 0.3, 0.4},
+		},
+		{
+			name:      "ChiSquared",
+			dist:      ChiSquared{K: 3.5, Src: src},
+			newFitter: func() Fitter { return &ChiSquared{} },
+			params:    func(f Fitter) []float64 { return []float64{f.(*ChiSquared).K} },
+			want:      []float64{3.5},
+			logProb:   func(p []float64) func(float64) float64 { return ChiSquared{K: p[0]}.LogProb },
+		},
+		{
+			name:      "Erlang",
+			dist:      Erlang{K: 3, Lambda: 2, Src: src},
+			newFitter: func() Fitter { return &Erlang{K: 3} },
+			params:    func(f Fitter) []float64 { return []float64{f.(*Erlang).Lambda} },
+			want:      []float64{2},
+			logProb:   func(p []float64) func(float64) float64 { return Erlang{K: 3, Lambda: p[0]}.LogProb },
+		},
+		{
+			name:      "Exponential",
+			dist:      Exponential{Rate: 2, Src: src},
+			newFitter: func() Fitter { return &Exponential{} },
+			params:    func(f Fitter) []float64 { return []float64{f.(*Exponential).Rate} },
+			want:      []float64{2},
+			logProb:   func(p []float64) func(float64) float64 { return Exponential{Rate: p[0]}.LogProb },
+		},
+		{
+			name:      "F",
+			dist:      F{D1: 5, D2: 12, Src: src},
+			newFitter: func() Fitter { return &F{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*F)
+				return []float64{d.D1, d.D2}
+			},
+			want: []float64{5, 12},
+		},
+		{
+			name:      "Gamma",
+			dist:      Gamma{Alpha: 2.5, Beta: 0.7, Src: src},
+			newFitter: func() Fitter { return &Gamma{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Gamma)
+				return []float64{d.Alpha, d.Beta}
+			},
+			want:    []float64{2.5, 0.7},
+			logProb: func(p []float64) func(float64) float64 { return Gamma{Alpha: p[0], Beta: p[1]}.LogProb },
+		},
+		{
+			name:      "Geometric",
+			dist:      Geometric{P: 0.3, Src: src},
+			newFitter: func() Fitter { return &Geometric{} },
+			params:    func(f Fitter) []float64 { return []float64{f.(*Geometric).P} },
+			want:      []float64{0.3},
+			logProb:   func(p []float64) func(float64) float64 { return Geometric{P: p[0]}.LogProb },
+		},
+		{
+			name:      "GumbelRight",
+			dist:      GumbelRight{Mu: 1, Beta: 2, Src: src},
+			newFitter: func() Fitter { return &GumbelRight{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*GumbelRight)
+				return []float64{d.Mu, d.Beta}
+			},
+			want:    []float64{1, 2},
+			logProb: func(p []float64) func(float64) float64 { return GumbelRight{Mu: p[0], Beta: p[1]}.LogProb },
+		},
+		{
+			name:      "InverseGamma",
+			dist:      InverseGamma{Alpha: 3, Beta: 2, Src: src},
+			newFitter: func() Fitter { return &InverseGamma{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*InverseGamma)
+				return []float64{d.Alpha, d.Beta}
+			},
+			want:    []float64{3, 2},
+			logProb: func(p []float64) func(float64) float64 { return InverseGamma{Alpha: p[0], Beta: p[1]}.LogProb },
+		},
+		{
+			name:      "Laplace",
+			dist:      Laplace{Mu: 1, Scale: 0.5, Src: src},
+			newFitter: func() Fitter { return &Laplace{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Laplace)
+				return []float64{d.Mu, d.Scale}
+			},
+			want: []float64{1, 0.5},
+		},
+		{
+			name:      "Logistic",
+			dist:      Logistic{Mu: 1, S: 0.5, Src: src},
+			newFitter: func() Fitter { return &Logistic{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Logistic)
+				return []float64{d.Mu, d.S}
+			},
+			want:    []float64{1, 0.5},
+			logProb: func(p []float64) func(float64) float64 { return Logistic{Mu: p[0], S: p[1]}.LogProb },
+		},
+		{
+			name:      "LogNormal",
+			dist:      LogNormal{Mu: 0.5, Sigma: 0.8, Src: src},
+			newFitter: func() Fitter { return &LogNormal{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*LogNormal)
+				return []float64{d.Mu, d.Sigma}
+			},
+			want:    []float64{0.5, 0.8},
+			logProb: func(p []float64) func(float64) float64 { return LogNormal{Mu: p[0], Sigma: p[1]}.LogProb },
+		},
+		{
+			name:      "Nakagami",
+			dist:      Nakagami{Mu: 1.5, Omega: 2, Src: src},
+			newFitter: func() Fitter { return &Nakagami{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Nakagami)
+				return []float64{d.Mu, d.Omega}
+			},
+			want:    []float64{1.5, 2},
+			logProb: func(p []float64) func(float64) float64 { return Nakagami{Mu: p[0], Omega: p[1]}.LogProb },
+		},
+		{
+			name:      "NegativeBinomial",
+			dist:      NegativeBinomial{R: 3, P: 0.4, Src: src},
+			newFitter: func() Fitter { return &NegativeBinomial{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*NegativeBinomial)
+				return []float64{d.R, d.P}
+			},
+			want:    []float64{3, 0.4},
+			logProb: func(p []float64) func(float64) float64 { return NegativeBinomial{R: p[0], P: p[1]}.LogProb },
+		},
+		{
+			name:      "Normal",
+			dist:      Normal{Mu: 1, Sigma: 2, Src: src},
+			newFitter: func() Fitter { return &Normal{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Normal)
+				return []float64{d.Mu, d.Sigma}
+			},
+			want:    []float64{1, 2},
+			logProb: func(p []float64) func(float64) float64 { return Normal{Mu: p[0], Sigma: p[1]}.LogProb },
+		},
+		{
+			name:      "Pareto",
+			dist:      Pareto{Xm: 1.5, Alpha: 3, Src: src},
+			newFitter: func() Fitter { return &Pareto{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Pareto)
+				return []float64{d.Xm, d.Alpha}
+			},
+			want: []float64{1.5, 3},
+		},
+		{
+			name:      "Poisson",
+			dist:      Poisson{Lambda: 4, Src: src},
+			newFitter: func() Fitter { return &Poisson{} },
+			params:    func(f Fitter) []float64 { return []float64{f.(*Poisson).Lambda} },
+			want:      []float64{4},
+			logProb:   func(p []float64) func(float64) float64 { return Poisson{Lambda: p[0]}.LogProb },
+		},
+		{
+			name:      "Rayleigh",
+			dist:      Rayleigh{Sigma: 1.5, Src: src},
+			newFitter: func() Fitter { return &Rayleigh{} },
+			params:    func(f Fitter) []float64 { return []float64{f.(*Rayleigh).Sigma} },
+			want:      []float64{1.5},
+			logProb:   func(p []float64) func(float64) float64 { return Rayleigh{Sigma: p[0]}.LogProb },
+		},
+		{
+			name:      "Rice",
+			dist:      Rice{Nu: 2, Sigma: 1, Src: src},
+			newFitter: func() Fitter { return &Rice{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Rice)
+				return []float64{d.Nu, d.Sigma}
+			},
+			want:    []float64{2, 1},
+			logProb: func(p []float64) func(float64) float64 { return Rice{Nu: p[0], Sigma: p[1]}.LogProb },
+		},
+		{
+			name:      "SkewNormal",
+			dist:      SkewNormal{Mu: 1, Sigma: 2, Alpha: 4, Src: src},
+			newFitter: func() Fitter { return &SkewNormal{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*SkewNormal)
+				return []float64{d.Mu, d.Sigma, d.Alpha}
+			},
+			want:    []float64{1, 2, 4},
+			logProb: func(p []float64) func(float64) float64 { return SkewNormal{Mu: p[0], Sigma: p[1], Alpha: p[2]}.LogProb },
+		},
+		{
+			name:      "StudentsT",
+			dist:      StudentsT{Mu: 1, Sigma: 2, Nu: 5, Src: src},
+			newFitter: func() Fitter { return &StudentsT{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*StudentsT)
+				return []float64{d.Mu, d.Sigma, d.Nu}
+			},
+			want: []float64{1, 2, 5},
+		},
+		{
+			name: "Triangle",
+			dist: func() Triangle {
+				t := NewTriangle(0, 4, 1)
+				t.Src = src
+				return t
+			}(),
+			newFitter: func() Fitter {
+				t := NewTriangle(0, 4, 2)
+				return &t
+			},
+			params: func(f Fitter) []float64 { return []float64{f.(*Triangle).c} },
+			want:   []float64{1},
+		},
+		{
+			name:      "Uniform",
+			dist:      Uniform{Min: -1, Max: 3, Src: src},
+			newFitter: func() Fitter { return &Uniform{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Uniform)
+				return []float64{d.Min, d.Max}
+			},
+			want: []float64{-1, 3},
+		},
+		{
+			// The log density is not evaluated since samples close to
+			// Mu±π may lie outside the support for the estimated Mu.
+			name:      "VonMises",
+			dist:      VonMises{Mu: 0.5, Kappa: 2, Src: src},
+			newFitter: func() Fitter { return &VonMises{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*VonMises)
+				return []float64{d.Mu, d.Kappa}
+			},
+			want: []float64{0.5, 2},
+		},
+		{
+			name:      "Weibull",
+			dist:      Weibull{K: 1.5, Lambda: 2, Src: src},
+			newFitter: func() Fitter { return &Weibull{} },
+			params: func(f Fitter) []float64 {
+				d := f.(*Weibull)
+				return []float64{d.K, d.Lambda}
+			},
+			want:    []float64{1.5, 2},
+			logProb: func(p []float64) func(float64) float64 { return Weibull{K: p[0], Lambda: p[1]}.LogProb },
+		},
+	}
+}
+
+func TestFit(t *testing.T) {
+	const n = 10000
+	for _, test := range fitTests() {
+		samples := randn(test.dist, n)
+		f := test.newFitter()
+		f.Fit(samples, nil)
+		got := test.params(f)
+		se := f.FitStdErr(nil, samples, nil)
+		if len(se) != len(got) {
+			t.Errorf("%s: unexpected number of standard errors: got %d, want %d", test.name, len(se), len(got))
+			continue
+		}
+		for i, v := range got {
+			if math.IsNaN(se[i]) {
+				// Parameters without Fisher information are the bounds of
+				// the support, which converge faster than 1/sqrt(n).
+				if math.Abs(v-test.want[i]) > 1e-2*math.Max(1, math.Abs(test.want[i])) {
+					t.Errorf("%s: parameter %d mismatch: got %v, want %v", test.name, i, v, test.want[i])
+				}
+				continue
+			}
+			if !(se[i] > 0) {
+				t.Errorf("%s: invalid standard error for parameter %d: %v", test.name, i, se[i])
+				continue
+			}
+			if math.Abs(v-test.want[i]) > 5*se[i] {
+				t.Errorf("%s: parameter %d mismatch: got %v, want %v±%v", test.name, i, v, test.want[i], se[i])
+			}
+		}
+
+		if test.logProb == nil {
+			continue
+		}
+		// The estimate must be a maximum of the likelihood.
+		ll := func(p []float64) float64 {
+			return logLikelihood(test.logProb(p), samples, nil)
+		}
+		max := ll(got)
+		for i := range got {
+			for _, d := range []float64{-1e-3, 1e-3} {
+				p := make([]float64, len(got))
+				copy(p, got)
+				p[i] += d * se[i]
+				if ll(p) > max {
+					t.Errorf("%s: estimate is not a maximum of the likelihood in parameter %d", test.name, i)
+				}
+			}
+		}
+		// The expected and observed Fisher information agree for large
+		// numbers of samples.
+		observed := observedStdErr(make([]float64, len(got)), got, func(dst *mat.SymDense, p []float64) {
+			fd.Hessian(dst, ll, p, &fd.Settings{Formula: fd.Central})
+		})
+		for i := range se {
+			if math.Abs(se[i]-observed[i]) > 0.1*observed[i] {
+				t.Errorf("%s: standard error mismatch for parameter %d: got %v, observed %v", test.name, i, se[i], observed[i])
+			}
+		}
+	}
+}
+
+func TestFitWeights(t *testing.T) {
+	const n = 500
+	for _, test := range fitTests() {
+		samples := randn(test.dist, n)
+
+		f := test.newFitter()
+		f.Fit(samples, nil)
+		want := test.params(f)
+		wantSE := f.FitStdErr(nil, samples, nil)
+
+		// Unit weights are equivalent to no weights.
+		f = test.newFitter()
+		f.Fit(samples, ones(n))
+		if got := test.params(f); !fitParamsEqual(got, want, 1e-12) {
+			t.Errorf("%s: unit weights mismatch: got %v, want %v", test.name, got, want)
+		}
+
+		// Integer weights are equivalent to repeated samples, and zero
+		// weights are equivalent to omitted samples.
+		rep := make([]float64, 0, 2*n)
+		weights := make([]float64, 2*n)
+		for i, x := range samples {
+			rep = append(rep, x, x)
+			weights[i] = 2
+		}
+		wSamples := append(append([]float64(nil), samples...), samples...)
+		f = test.newFitter()
+		f.Fit(wSamples, weights)
+		got := test.params(f)
+		f = test.newFitter()
+		f.Fit(rep, nil)
+		wantRep := test.params(f)
+		if !fitParamsEqual(got, wantRep, 1e-6) {
+			t.Errorf("%s: weighted fit mismatch: got %v, want %v", test.name, got, wantRep)
+		}
+		if !fitParamsEqual(wantRep, want, 1e-6) {
+			t.Errorf("%s: repeated fit mismatch: got %v, want %v", test.name, wantRep, want)
+		}
+		f = test.newFitter()
+		f.Fit(wSamples, weights)
+		gotSE := f.FitStdErr(nil, wSamples, weights)
+		for i := range gotSE {
+			if math.IsNaN(wantSE[i]) {
+				continue
+			}
+			// Doubling the effective number of samples reduces the
+			// standard errors by a factor of sqrt(2).
+			if math.Abs(gotSE[i]*math.Sqrt2-wantSE[i]) > 1e-4*wantSE[i] {
+				t.Errorf("%s: weighted standard error mismatch for parameter %d: got %v, want %v", test.name, i, gotSE[i]*math.Sqrt2, wantSE[i])
+			}
+		}
+	}
+}
+
+func TestLogProbDerivatives(t *testing.T) {
+	// The parameters avoid integer arguments to Digamma in the gradients,
+	// where its finite difference derivative is inaccurate.
+	for _, test := range []struct {
+		name    string
+		p       []float64
+		x       []float64
+		logProb func(p []float64) func(float64) float64
+		grad    func(p []float64) func([]float64, float64) []float64
+		hess    func(p []float64) func(*mat.SymDense, float64)
+	}{
+		{
+			name:    "F",
+			p:       []float64{3.3, 7.1},
+			x:       []float64{0.1, 0.9, 2.5},
+			logProb: func(p []float64) func(float64) float64 { return F{D1: p[0], D2: p[1]}.LogProb },
+			grad:    func(p []float64) func([]float64, float64) []float64 { return F{D1: p[0], D2: p[1]}.logProbGrad },
+			hess:    func(p []float64) func(*mat.SymDense, float64) { return F{D1: p[0], D2: p[1]}.logProbHess },
+		},
+		{
+			name:    "GumbelRight",
+			p:       []float64{1, 2},
+			x:       []float64{-3, 0.5, 6},
+			logProb: func(p []float64) func(float64) float64 { return GumbelRight{Mu: p[0], Beta: p[1]}.LogProb },
+			grad: func(p []float64) func([]float64, float64) []float64 {
+				return GumbelRight{Mu: p[0], Beta: p[1]}.logProbGrad
+			},
+			hess: func(p []float64) func(*mat.SymDense, float64) {
+				return GumbelRight{Mu: p[0], Beta: p[1]}.logProbHess
+			},
+		},
+		{
+			name:    "Logistic",
+			p:       []float64{1, 2},
+			x:       []float64{-3, 0.5, 6},
+			logProb: func(p []float64) func(float64) float64 { return Logistic{Mu: p[0], S: p[1]}.LogProb },
+			grad:    func(p []float64) func([]float64, float64) []float64 { return Logistic{Mu: p[0], S: p[1]}.logProbGrad },
+			hess:    func(p []float64) func(*mat.SymDense, float64) { return Logistic{Mu: p[0], S: p[1]}.logProbHess },
+		},
+		{
+			name:    "Rice",
+			p:       []float64{2, 1.3},
+			x:       []float64{0.1, 1.5, 4},
+			logProb: func(p []float64) func(float64) float64 { return Rice{Nu: p[0], Sigma: p[1]}.LogProb },
+			grad:    func(p []float64) func([]float64, float64) []float64 { return Rice{Nu: p[0], Sigma: p[1]}.logProbGrad },
+			hess:    func(p []float64) func(*mat.SymDense, float64) { return Rice{Nu: p[0], Sigma: p[1]}.logProbHess },
+		},
+		{
+			// At x = -3 the argument of Φ is -6, where the continued
+			// fraction is used.
+			name:    "SkewNormal",
+			p:       []float64{1, 2, 3},
+			x:       []float64{-3, 0.5, 6},
+			logProb: func(p []float64) func(float64) float64 { return SkewNormal{Mu: p[0], Sigma: p[1], Alpha: p[2]}.LogProb },
+			grad: func(p []float64) func([]float64, float64) []float64 {
+				return SkewNormal{Mu: p[0], Sigma: p[1], Alpha: p[2]}.logProbGrad
+			},
+			hess: func(p []float64) func(*mat.SymDense, float64) {
+				return SkewNormal{Mu: p[0], Sigma: p[1], Alpha: p[2]}.logProbHess
+			},
+		},
+		{
+			name:    "StudentsT",
+			p:       []float64{1, 2, 4.6},
+			x:       []float64{-3, 0.5, 6},
+			logProb: func(p []float64) func(float64) float64 { return StudentsT{Mu: p[0], Sigma: p[1], Nu: p[2]}.LogProb },
+			grad: func(p []float64) func([]float64, float64) []float64 {
+				return StudentsT{Mu: p[0], Sigma: p[1], Nu: p[2]}.logProbGrad
+			},
+			hess: func(p []float64) func(*mat.SymDense, float64) {
+				return StudentsT{Mu: p[0], Sigma: p[1], Nu: p[2]}.logProbHess
+			},
+		},
+	} {
+		n := len(test.p)
+		for _, x := range test.x {
+			f := func(p []float64) float64 { return test.logProb(p)(x) }
+			want := fd.Gradient(nil, f, test.p, &fd.Settings{Formula: fd.Central})
+			got := test.grad(test.p)(make([]float64, n), x)
+			if !floats.EqualApprox(got, want, 1e-6) {
+				t.Errorf("%s: gradient mismatch at x=%v: got %v, want %v", test.name, x, got, want)
+			}
+
+			g := func(y, p []float64) { test.grad(p)(y, x) }
+			jac := mat.NewDense(n, n, nil)
+			fd.Jacobian(jac, g, test.p, &fd.JacobianSettings{Formula: fd.Central})
+			hess := mat.NewSymDense(n, nil)
+			test.hess(test.p)(hess, x)
+			if !mat.EqualApprox(hess, jac, 1e-6) {
+				t.Errorf("%s: Hessian mismatch at x=%v: got %v, want %v", test.name, x, mat.Formatted(hess), mat.Formatted(jac))
+			}
+		}
+	}
+}
+
+func fitParamsEqual(a, b []float64, tol float64) bool {
+	for i, v := range a {
+		if math.Abs(v-b[i]) > tol*math.Max(1, math.Abs(b[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFitPanics(t *testing.T) {
+	for _, test := range fitTests() {
+		f := test.newFitter()
+		if !panics(func() { f.Fit([]float64{1, 1}, []float64{1}) }) {
+			t.Errorf("%s: expected panic for weight length mismatch", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -43,6 +43,32 @@ func (g Gamma) ExKurtosis() float64 {
 	return 6 / g.Alpha
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimate of Alpha is found by Newton's method and the estimate of
+// Beta is Alpha divided by the weighted mean of the samples.
+func (g *Gamma) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mean := weightedMean(identity, samples, weights)
+	meanLog := weightedMean(math.Log, samples, weights)
+	g.Alpha, g.Beta = fitGamma(mean, meanLog)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Alpha, Beta] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (g Gamma) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	gammaStdErr(dst, g.Alpha, g.Beta, sumWeights(samples, weights))
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (g Gamma) LogProb(x float64) float64 {
@@ -0,0 +1,69 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestGeometricProbCDF(t *testing.T) {
+	const tol = 1e-14
+	for i, tt := range []struct {
+		k        float64
+		wantProb float64
+		wantCDF  float64
+	}{
+		// Values calculated from the closed form with P = 0.3.
+		{0, 0.3, 0.3},
+		{1, 0.21, 0.51},
+		{5, 0.050421, 0.882351},
+		{12, 0.0041523861603, 0.9903110989593},
+		{-1, 0, 0},
+		{2.5, 0, 0.657},
+	} {
+		g := Geometric{P: 0.3}
+		prob := g.Prob(tt.k)
+		if !floats.EqualWithinAbsOrRel(prob, tt.wantProb, tol, tol) {
+			t.Errorf("Prob mismatch case %d: got %v, want %v", i, prob, tt.wantProb)
+		}
+		cdf := g.CDF(tt.k)
+		if !floats.EqualWithinAbsOrRel(cdf, tt.wantCDF, tol, tol) {
+			t.Errorf("CDF mismatch case %d: got %v, want %v", i, cdf, tt.wantCDF)
+		}
+	}
+}
+
+func TestGeometric(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, g := range []Geometric{
+		{0.1, src},
+		{0.5, src},
+		{0.9, src},
+	} {
+		testGeometric(t, g, i)
+	}
+}
+
+func testGeometric(t *testing.T, g Geometric, i int) {
+	const (
+		tol = 1e-2
+		n   = 1e6
+	)
+	x := make([]float64, n)
+	generateSamples(x, g)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, g, 2e-3)
+	checkMean(t, i, x, g, tol)
+	checkVarAndStd(t, i, x, g, tol)
+	checkExKurtosis(t, i, x, g, 1e-1)
+	checkSkewness(t, i, x, g, 2e-2)
+	checkQuantileDiscrete(t, i, g)
+}
@@ -8,6 +8,8 @@ import (
 	"math"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
 )
 
 // GumbelRight implements the right-skewed Gumbel distribution, a two-parameter
@@ -46,6 +48,83 @@ func (g GumbelRight) ExKurtosis() float64 {
 	return 12.0 / 5
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates have no closed form and are found by Newton's method
+// starting from the method of moments estimates.
+// If the iteration does not converge, the parameters are set to the best
+// estimate found.
+func (g *GumbelRight) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mean := weightedMean(identity, samples, weights)
+	variance := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+
+	beta := math.Sqrt(6*variance) / math.Pi
+	if beta == 0 {
+		beta = 1
+	}
+	p := []float64{mean - eulerMascheroni*beta, beta}
+	maximizeLikelihood(p, mlProblem{
+		positive: []bool{false, true},
+		weight:   sumWeights(samples, weights),
+		logLik: func(p []float64) float64 {
+			return logLikelihood(GumbelRight{Mu: p[0], Beta: p[1]}.LogProb, samples, weights)
+		},
+		score: func(dst, p []float64) {
+			weightedScore(dst, GumbelRight{Mu: p[0], Beta: p[1]}.logProbGrad, samples, weights)
+		},
+		hess: func(dst *mat.SymDense, p []float64) {
+			weightedHessian(dst, GumbelRight{Mu: p[0], Beta: p[1]}.logProbHess, samples, weights)
+		},
+	})
+	g.Mu = p[0]
+	g.Beta = p[1]
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Mu, Beta] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (g GumbelRight) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	// The Fisher information for a single sample is
+	//  1/β² [1      γ-1         ]
+	//       [γ-1    π²/6+(1-γ)² ]
+	// where γ is the Euler-Mascheroni constant.
+	// The determinant of the bracketed matrix is π²/6.
+	c := eulerMascheroni - 1
+	det := math.Pi * math.Pi / 6 * sumWeights(samples, weights)
+	dst[0] = g.Beta * math.Sqrt((c*c+math.Pi*math.Pi/6)/det)
+	dst[1] = g.Beta * math.Sqrt(1/det)
+	return dst
+}
+
+// logProbGrad stores into deriv the gradient of LogProb at x with respect to
+// [Mu, Beta].
+func (g GumbelRight) logProbGrad(deriv []float64, x float64) []float64 {
+	z := g.z(x)
+	e := math.Exp(-z)
+	deriv[0] = (1 - e) / g.Beta
+	deriv[1] = (z*(1-e) - 1) / g.Beta
+	return deriv
+}
+
+// logProbHess stores into hess the Hessian of LogProb at x with respect to
+// [Mu, Beta].
+func (g GumbelRight) logProbHess(hess *mat.SymDense, x float64) {
+	z := g.z(x)
+	e := math.Exp(-z)
+	b2 := g.Beta * g.Beta
+	hess.SetSym(0, 0, -e/b2)
+	hess.SetSym(0, 1, -(e*z+1-e)/b2)
+	hess.SetSym(1, 1, (1-2*z*(1-e)-e*z*z)/b2)
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (g GumbelRight) LogProb(x float64) float64 {
 	z := g.z(x)
@@ -0,0 +1,181 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Hypergeometric implements the hypergeometric distribution, a discrete
+// probability distribution that expresses the probability of the number of
+// successes in Draws draws without replacement from a population of size N
+// that contains K successes.
+//
+// The hypergeometric distribution has density function
+//  f(k) = C(K, k) C(N-K, n-k) / C(N, n)
+// for max(0, n+K-N) <= k <= min(n, K), where n is the number of draws and
+// C(a, b) is the binomial coefficient.
+//
+// Hypergeometric does not implement Fitter since its parameters are integers,
+// for which the likelihood has no derivatives and the asymptotic standard
+// errors are not defined.
+//
+// For more information, see https://en.wikipedia.org/wiki/Hypergeometric_distribution.
+type Hypergeometric struct {
+	// N is the size of the population. N must be a non-negative integer.
+	N float64
+	// K is the number of successes in the population. K must be an integer
+	// in [0, N].
+	K float64
+	// Draws is the number of draws. Draws must be an integer in [0, N].
+	Draws float64
+
+	Src rand.Source
+}
+
+// bounds returns the smallest and largest values in the support of the
+// distribution.
+func (h Hypergeometric) bounds() (lo, hi float64) {
+	return math.Max(0, h.Draws+h.K-h.N), math.Min(h.Draws, h.K)
+}
+
+// sum returns the sum of the probabilities of the integers in [a, b].
+func (h Hypergeometric) sum(a, b float64) float64 {
+	var s float64
+	for k := a; k <= b; k++ {
+		s += h.Prob(k)
+	}
+	return s
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (h Hypergeometric) CDF(x float64) float64 {
+	lo, hi := h.bounds()
+	x = math.Floor(x)
+	if x < lo {
+		return 0
+	}
+	if x >= hi {
+		return 1
+	}
+	// Sum over the shorter tail to limit the accumulation of rounding error.
+	if x < h.Mode() {
+		return h.sum(lo, x)
+	}
+	return 1 - h.sum(x+1, hi)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (h Hypergeometric) ExKurtosis() float64 {
+	n, N, K := h.Draws, h.N, h.K
+	num := (N-1)*N*N*(N*(N+1)-6*K*(N-K)-6*n*(N-n)) + 6*n*K*(N-K)*(N-n)*(5*N-6)
+	return num / (n * K * (N - K) * (N - n) * (N - 2) * (N - 3))
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (h Hypergeometric) LogProb(x float64) float64 {
+	lo, hi := h.bounds()
+	if x < lo || hi < x || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	return logChoose(h.K, x) + logChoose(h.N-h.K, h.Draws-x) - logChoose(h.N, h.Draws)
+}
+
+// logChoose returns the natural logarithm of the binomial coefficient
+// C(n, k) for 0 <= k <= n.
+func logChoose(n, k float64) float64 {
+	a, _ := math.Lgamma(n + 1)
+	b, _ := math.Lgamma(k + 1)
+	c, _ := math.Lgamma(n - k + 1)
+	return a - b - c
+}
+
+// Mean returns the mean of the probability distribution.
+func (h Hypergeometric) Mean() float64 {
+	return h.Draws * h.K / h.N
+}
+
+// Mode returns the mode of the probability distribution.
+func (h Hypergeometric) Mode() float64 {
+	return math.Floor((h.Draws + 1) * (h.K + 1) / (h.N + 2))
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Hypergeometric) NumParameters() int {
+	return 3
+}
+
+// Prob computes the value of the probability density function at x.
+func (h Hypergeometric) Prob(x float64) float64 {
+	return math.Exp(h.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+//
+// Since the distribution is discrete, Quantile returns the smallest k such
+// that CDF(k) >= p.
+func (h Hypergeometric) Quantile(p float64) float64 {
+	lo, hi := h.bounds()
+	return discreteQuantile(p, h.CDF, lo, hi, h.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (h Hypergeometric) Rand() float64 {
+	var u float64
+	if h.Src == nil {
+		u = rand.Float64()
+	} else {
+		u = rand.New(h.Src).Float64()
+	}
+	// Invert the CDF by walking up from the bottom of the support using
+	// the ratio of successive probabilities
+	//  f(k+1)/f(k) = (K-k)(n-k) / ((k+1)(N-K-n+k+1))
+	lo, hi := h.bounds()
+	p := h.Prob(lo)
+	cdf := p
+	k := lo
+	for cdf < u && k < hi {
+		p *= (h.K - k) * (h.Draws - k) / ((k + 1) * (h.N - h.K - h.Draws + k + 1))
+		k++
+		cdf += p
+	}
+	return k
+}
+
+// Skewness returns the skewness of the distribution.
+func (h Hypergeometric) Skewness() float64 {
+	n, N, K := h.Draws, h.N, h.K
+	return (N - 2*K) * math.Sqrt(N-1) * (N - 2*n) / (math.Sqrt(n*K*(N-K)*(N-n)) * (N - 2))
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (h Hypergeometric) StdDev() float64 {
+	return math.Sqrt(h.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (h Hypergeometric) Survival(x float64) float64 {
+	lo, hi := h.bounds()
+	x = math.Floor(x)
+	if x < lo {
+		return 1
+	}
+	if x >= hi {
+		return 0
+	}
+	if x < h.Mode() {
+		return 1 - h.sum(lo, x)
+	}
+	return h.sum(x+1, hi)
+}
+
+// Variance returns the variance of the probability distribution.
+func (h Hypergeometric) Variance() float64 {
+	n, N, K := h.Draws, h.N, h.K
+	return n * K / N * (N - K) / N * (N - n) / (N - 1)
+}
@@ -0,0 +1,71 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestHypergeometricProbCDF(t *testing.T) {
+	const tol = 1e-12
+	for i, tt := range []struct {
+		k, n, succ, draws float64
+		wantProb          float64
+		wantCDF           float64
+	}{
+		// Values calculated exactly using rational arithmetic.
+		{0, 50, 20, 10, 0.0029248638425452608, 0.0029248638425452608},
+		{4, 50, 20, 10, 0.28005860310537134, 0.6450268898822081},
+		{10, 50, 20, 10, 1.7985883651357545e-05, 1},
+		{7, 20, 15, 12, 0.05108359133126935, 0.05108359133126935},
+		{9, 20, 15, 12, 0.3973168214654283, 0.7038183694530443},
+		{12, 20, 15, 12, 0.003611971104231166, 1},
+		{6, 20, 15, 12, 0, 0},
+		{13, 20, 15, 12, 0, 1},
+	} {
+		h := Hypergeometric{N: tt.n, K: tt.succ, Draws: tt.draws}
+		prob := h.Prob(tt.k)
+		if !floats.EqualWithinAbsOrRel(prob, tt.wantProb, tol, tol) {
+			t.Errorf("Prob mismatch case %d: got %v, want %v", i, prob, tt.wantProb)
+		}
+		cdf := h.CDF(tt.k)
+		if !floats.EqualWithinAbsOrRel(cdf, tt.wantCDF, tol, tol) {
+			t.Errorf("CDF mismatch case %d: got %v, want %v", i, cdf, tt.wantCDF)
+		}
+	}
+}
+
+func TestHypergeometric(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, h := range []Hypergeometric{
+		{50, 20, 10, src},
+		{20, 15, 12, src},
+		{1000, 30, 200, src},
+	} {
+		testHypergeometric(t, h, i)
+	}
+}
+
+func testHypergeometric(t *testing.T, h Hypergeometric, i int) {
+	const (
+		tol = 1e-2
+		n   = 1e6
+	)
+	x := make([]float64, n)
+	generateSamples(x, h)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, h, 2e-3)
+	checkMean(t, i, x, h, tol)
+	checkVarAndStd(t, i, x, h, tol)
+	checkExKurtosis(t, i, x, h, 5e-2)
+	checkSkewness(t, i, x, h, 2e-2)
+	checkQuantileDiscrete(t, i, h)
+}
@@ -20,3 +20,22 @@ type RandLogProber interface {
 type Quantiler interface {
 	Quantile(p float64) float64
 }
+
+// Fitter is a distribution whose parameters can be estimated from weighted
+// samples by maximum likelihood. All the distributions in this package
+// implement Fitter except AlphaStable, Hypergeometric, NoncentralChiSquared
+// and NoncentralT, for the reasons given in their documentation.
+type Fitter interface {
+	// Fit sets the parameters of the distribution to the maximum
+	// likelihood estimates for the samples with relative weights. If
+	// weights is nil, all the weights are 1, otherwise len(weights) must
+	// equal len(samples).
+	Fit(samples, weights []float64)
+
+	// FitStdErr returns the asymptotic standard errors of the parameters
+	// estimated by Fit, computed from the Fisher information at the current
+	// parameters of the distribution for the samples with relative weights.
+	// If dst is not nil, the result is stored in-place into dst and
+	// returned, otherwise a new slice is allocated.
+	FitStdErr(dst, samples, weights []float64) []float64
+}
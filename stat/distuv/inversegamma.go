@@ -49,6 +49,32 @@ func (g InverseGamma) ExKurtosis() float64 {
 	return (30*g.Alpha - 66) / (g.Alpha - 3) / (g.Alpha - 4)
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The reciprocals of the samples follow a gamma distribution with shape Alpha
+// and rate Beta, and the estimates are found as for Gamma.
+func (g *InverseGamma) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	meanInv := weightedMean(func(x float64) float64 { return 1 / x }, samples, weights)
+	meanLog := weightedMean(math.Log, samples, weights)
+	g.Alpha, g.Beta = fitGamma(meanInv, -meanLog)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Alpha, Beta] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (g InverseGamma) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	gammaStdErr(dst, g.Alpha, g.Beta, sumWeights(samples, weights))
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (g InverseGamma) LogProb(x float64) float64 {
@@ -6,10 +6,8 @@ package distuv
 
 import (
 	"math"
-	"sort"
 
 	"golang.org/x/exp/rand"
-	"gonum.org/v1/gonum/stat"
 )
 
 // Laplace represents the Laplace distribution (https://en.wikipedia.org/wiki/Laplace_distribution).
@@ -58,27 +56,10 @@ func (l *Laplace) Fit(samples, weights []float64) {
 		return
 	}
 
-	var (
-		sortedSamples []float64
-		sortedWeights []float64
-	)
-	if sort.Float64sAreSorted(samples) {
-		sortedSamples = samples
-		sortedWeights = weights
-	} else {
-		// Need to copy variables so the input variables aren't effected by the sorting
-		sortedSamples = make([]float64, len(samples))
-		copy(sortedSamples, samples)
-		sortedWeights := make([]float64, len(samples))
-		copy(sortedWeights, weights)
-
-		stat.SortWeighted(sortedSamples, sortedWeights)
-	}
-
 	// The (weighted) median of the samples is the maximum likelihood estimate
 	// of the mean parameter
 	// TODO: Rethink quantile type when stat has more options
-	l.Mu = stat.Quantile(0.5, stat.Empirical, sortedSamples, sortedWeights)
+	l.Mu = weightedQuantile(0.5, samples, weights)
 
 	// The scale parameter is the average absolute distance
 	// between the sample and the mean
@@ -98,6 +79,20 @@ func (l *Laplace) Fit(samples, weights []float64) {
 	}
 }
 
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Mu, Scale] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (l Laplace) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	se := l.Scale / math.Sqrt(sumWeights(samples, weights))
+	dst[0] = se
+	dst[1] = se
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability density
 // function at x.
 func (l Laplace) LogProb(x float64) float64 {
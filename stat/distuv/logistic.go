@@ -0,0 +1,192 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Logistic implements the logistic distribution, a two-parameter continuous
+// distribution with support over the real numbers.
+//
+// The logistic distribution has density function
+//  e^(-(x-μ)/s) / (s (1 + e^(-(x-μ)/s))^2)
+//
+// For more information, see https://en.wikipedia.org/wiki/Logistic_distribution.
+type Logistic struct {
+	// Mu is the location parameter of the distribution.
+	Mu float64
+	// S is the scale parameter of the distribution. S must be greater than 0.
+	S float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (l Logistic) CDF(x float64) float64 {
+	return 1 / (1 + math.Exp(-(x-l.Mu)/l.S))
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (l Logistic) Entropy() float64 {
+	return math.Log(l.S) + 2
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (Logistic) ExKurtosis() float64 {
+	return 6.0 / 5
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates have no closed form and are found by Newton's method
+// starting from the weighted median and the method of moments estimate of S.
+// If the iteration does not converge, the parameters are set to the best
+// estimate found.
+func (l *Logistic) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mean := weightedMean(identity, samples, weights)
+	variance := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+
+	s := math.Sqrt(3*variance) / math.Pi
+	if s == 0 {
+		s = 1
+	}
+	p := []float64{weightedQuantile(0.5, samples, weights), s}
+	maximizeLikelihood(p, mlProblem{
+		positive: []bool{false, true},
+		weight:   sumWeights(samples, weights),
+		logLik: func(p []float64) float64 {
+			return logLikelihood(Logistic{Mu: p[0], S: p[1]}.LogProb, samples, weights)
+		},
+		score: func(dst, p []float64) {
+			weightedScore(dst, Logistic{Mu: p[0], S: p[1]}.logProbGrad, samples, weights)
+		},
+		hess: func(dst *mat.SymDense, p []float64) {
+			weightedHessian(dst, Logistic{Mu: p[0], S: p[1]}.logProbHess, samples, weights)
+		},
+	})
+	l.Mu = p[0]
+	l.S = p[1]
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Mu, S] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (l Logistic) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	// The Fisher information for a single sample is
+	//  1/s² [1/3  0         ]
+	//       [0    (π²+3)/9  ]
+	sumW := sumWeights(samples, weights)
+	dst[0] = l.S * math.Sqrt(3/sumW)
+	dst[1] = l.S * math.Sqrt(9/((math.Pi*math.Pi+3)*sumW))
+	return dst
+}
+
+// logProbGrad stores into deriv the gradient of LogProb at x with respect to
+// [Mu, S].
+func (l Logistic) logProbGrad(deriv []float64, x float64) []float64 {
+	z := (x - l.Mu) / l.S
+	t := math.Tanh(z / 2)
+	deriv[0] = t / l.S
+	deriv[1] = (z*t - 1) / l.S
+	return deriv
+}
+
+// logProbHess stores into hess the Hessian of LogProb at x with respect to
+// [Mu, S].
+func (l Logistic) logProbHess(hess *mat.SymDense, x float64) {
+	z := (x - l.Mu) / l.S
+	t := math.Tanh(z / 2)
+	dt := (1 - t*t) / 2
+	s2 := l.S * l.S
+	hess.SetSym(0, 0, -dt/s2)
+	hess.SetSym(0, 1, -(z*dt+t)/s2)
+	hess.SetSym(1, 1, (1-2*z*t-z*z*dt)/s2)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (l Logistic) LogProb(x float64) float64 {
+	// The density is symmetric about Mu, so use the absolute value of the
+	// standardized variable to avoid overflow.
+	z := math.Abs(x-l.Mu) / l.S
+	return -z - math.Log(l.S) - 2*math.Log1p(math.Exp(-z))
+}
+
+// Mean returns the mean of the probability distribution.
+func (l Logistic) Mean() float64 {
+	return l.Mu
+}
+
+// Median returns the median of the probability distribution.
+func (l Logistic) Median() float64 {
+	return l.Mu
+}
+
+// Mode returns the mode of the probability distribution.
+func (l Logistic) Mode() float64 {
+	return l.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Logistic) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (l Logistic) Prob(x float64) float64 {
+	return math.Exp(l.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (l Logistic) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	return l.Mu + l.S*math.Log(p/(1-p))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (l Logistic) Rand() float64 {
+	var rnd float64
+	if l.Src == nil {
+		rnd = rand.Float64()
+	} else {
+		rnd = rand.New(l.Src).Float64()
+	}
+	return l.Quantile(rnd)
+}
+
+// Skewness returns the skewness of the distribution.
+func (Logistic) Skewness() float64 {
+	return 0
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (l Logistic) StdDev() float64 {
+	return l.S * math.Pi / math.Sqrt(3)
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (l Logistic) Survival(x float64) float64 {
+	return 1 / (1 + math.Exp((x-l.Mu)/l.S))
+}
+
+// Variance returns the variance of the probability distribution.
+func (l Logistic) Variance() float64 {
+	return l.S * l.S * math.Pi * math.Pi / 3
+}
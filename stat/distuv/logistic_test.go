@@ -0,0 +1,83 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestLogisticProb(t *testing.T) {
+	// Values calculated from the closed form of the distribution.
+	pts := []univariateProbPoint{
+		{
+			loc:     -3,
+			prob:    0.05249679270175326,
+			cumProb: 0.11920292202211755,
+			logProb: -2.9470032026458903,
+		},
+		{
+			loc:     0,
+			prob:    0.11750185610079725,
+			cumProb: 0.3775406687981454,
+			logProb: -2.1413011489201588,
+		},
+		{
+			loc:     1,
+			prob:    0.125,
+			cumProb: 0.5,
+			logProb: -2.0794415416798357,
+		},
+		{
+			loc:     2.5,
+			prob:    0.10894749688090699,
+			cumProb: 0.679178699175393,
+			logProb: -2.2168891927897456,
+		},
+		{
+			loc:     10,
+			prob:    0.005433114861112617,
+			cumProb: 0.9890130573694068,
+			logProb: -5.215242670257133,
+		},
+	}
+	testDistributionProbs(t, Logistic{Mu: 1, S: 2}, "Logistic", pts)
+}
+
+func TestLogistic(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, l := range []Logistic{
+		{0, 1, src},
+		{-5, 0.5, src},
+		{3, 4, src},
+	} {
+		testLogistic(t, l, i)
+	}
+}
+
+func testLogistic(t *testing.T, l Logistic, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, l)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, math.Inf(-1), x, l, tol, bins)
+	checkProbContinuous(t, i, x, l, 1e-10)
+	checkMean(t, i, x, l, tol)
+	checkMedian(t, i, x, l, tol)
+	checkVarAndStd(t, i, x, l, tol)
+	checkEntropy(t, i, x, l, tol)
+	checkExKurtosis(t, i, x, l, 1e-1)
+	checkSkewness(t, i, x, l, 5e-2)
+	checkQuantileCDFSurvival(t, i, x, l, tol)
+	checkProbQuantContinuous(t, i, x, l, tol)
+}
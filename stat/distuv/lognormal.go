@@ -35,6 +35,38 @@ func (l LogNormal) ExKurtosis() float64 {
 	return math.Exp(4*s2) + 2*math.Exp(3*s2) + 3*math.Exp(2*s2) - 6
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates are the weighted mean and the uncorrected weighted standard
+// deviation of the logarithm of the samples.
+func (l *LogNormal) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mu := weightedMean(math.Log, samples, weights)
+	variance := weightedMean(func(x float64) float64 {
+		d := math.Log(x) - mu
+		return d * d
+	}, samples, weights)
+	l.Mu = mu
+	l.Sigma = math.Sqrt(variance)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Mu, Sigma] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (l LogNormal) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	n := sumWeights(samples, weights)
+	dst[0] = l.Sigma / math.Sqrt(n)
+	dst[1] = l.Sigma / math.Sqrt(2*n)
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (l LogNormal) LogProb(x float64) float64 {
 	if x < 0 {
@@ -0,0 +1,163 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// Nakagami implements the Nakagami distribution, a two-parameter continuous
+// distribution with support over the non-negative real numbers. The square
+// of a Nakagami random variable follows a gamma distribution with shape μ
+// and rate μ/Ω.
+//
+// The Nakagami distribution has density function
+//  2μ^μ / (Γ(μ)Ω^μ) x^(2μ-1) e^(-μx^2/Ω)
+//
+// For more information, see https://en.wikipedia.org/wiki/Nakagami_distribution.
+type Nakagami struct {
+	// Mu is the shape parameter of the distribution. Mu must be greater than
+	// or equal to 0.5.
+	Mu float64
+	// Omega is the spread parameter of the distribution, the mean of the
+	// square of the random variable. Omega must be greater than 0.
+	Omega float64
+
+	Src rand.Source
+}
+
+// moment returns the k^th raw moment of the distribution
+//  E[X^k] = Γ(μ+k/2)/Γ(μ) (Ω/μ)^(k/2)
+func (n Nakagami) moment(k float64) float64 {
+	lg1, _ := math.Lgamma(n.Mu + k/2)
+	lg2, _ := math.Lgamma(n.Mu)
+	return math.Exp(lg1 - lg2 + k/2*math.Log(n.Omega/n.Mu))
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (n Nakagami) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return mathext.GammaIncReg(n.Mu, n.Mu*x*x/n.Omega)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (n Nakagami) ExKurtosis() float64 {
+	m1 := n.Mean()
+	m3 := n.moment(3)
+	m4 := n.Omega * n.Omega * (n.Mu + 1) / n.Mu
+	v := n.Variance()
+	return (m4-4*m1*m3+6*m1*m1*n.Omega-3*m1*m1*m1*m1)/(v*v) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The squares of the samples follow a gamma distribution with shape Mu and
+// rate Mu/Omega, so the estimate of Omega is the weighted mean of the squared
+// samples and the estimate of Mu is found by Newton's method as for Gamma.
+// Since the likelihood is concave in Mu, an estimate less than 0.5 is
+// replaced by 0.5, the most likely allowed value.
+func (n *Nakagami) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	meanSq := weightedMean(func(x float64) float64 { return x * x }, samples, weights)
+	meanLog := weightedMean(math.Log, samples, weights)
+	mu, _ := fitGamma(meanSq, 2*meanLog)
+	n.Mu = math.Max(mu, 0.5)
+	n.Omega = meanSq
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Mu, Omega] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (n Nakagami) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	// The Fisher information for a single sample is
+	//  [ψ'(μ)-1/μ  0   ]
+	//  [0          μ/Ω²]
+	sumW := sumWeights(samples, weights)
+	dst[0] = 1 / math.Sqrt((trigamma(n.Mu)-1/n.Mu)*sumW)
+	dst[1] = n.Omega / math.Sqrt(n.Mu*sumW)
+	return dst
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (n Nakagami) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	lg, _ := math.Lgamma(n.Mu)
+	return math.Ln2 + n.Mu*math.Log(n.Mu/n.Omega) - lg + (2*n.Mu-1)*math.Log(x) - n.Mu*x*x/n.Omega
+}
+
+// Mean returns the mean of the probability distribution.
+func (n Nakagami) Mean() float64 {
+	return n.moment(1)
+}
+
+// Mode returns the mode of the probability distribution.
+func (n Nakagami) Mode() float64 {
+	return math.Sqrt(n.Omega * (2*n.Mu - 1) / (2 * n.Mu))
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Nakagami) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (n Nakagami) Prob(x float64) float64 {
+	return math.Exp(n.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (n Nakagami) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	return math.Sqrt(mathext.GammaIncRegInv(n.Mu, p) * n.Omega / n.Mu)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (n Nakagami) Rand() float64 {
+	return math.Sqrt(Gamma{Alpha: n.Mu, Beta: n.Mu / n.Omega, Src: n.Src}.Rand())
+}
+
+// Skewness returns the skewness of the distribution.
+func (n Nakagami) Skewness() float64 {
+	m1 := n.Mean()
+	s := n.StdDev()
+	return (n.moment(3) - 3*m1*s*s - m1*m1*m1) / (s * s * s)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (n Nakagami) StdDev() float64 {
+	return math.Sqrt(n.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (n Nakagami) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return mathext.GammaIncRegComp(n.Mu, n.Mu*x*x/n.Omega)
+}
+
+// Variance returns the variance of the probability distribution.
+func (n Nakagami) Variance() float64 {
+	m1 := n.Mean()
+	return n.Omega - m1*m1
+}
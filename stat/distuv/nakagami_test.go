@@ -0,0 +1,74 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestNakagamiProb(t *testing.T) {
+	// Values calculated from the closed form of the distribution.
+	pts := []univariateProbPoint{
+		{
+			loc:     0.2,
+			prob:    0.006923987550955699,
+			cumProb: 0.0003492973307711589,
+			logProb: -4.972763439625351,
+		},
+		{
+			loc:     1,
+			prob:    0.4563707724734151,
+			cumProb: 0.1443048016123467,
+			logProb: -0.7844497023230501,
+		},
+		{
+			loc:     1.7,
+			prob:    0.6359947635190399,
+			cumProb: 0.5737812418305639,
+			logProb: -0.45256494913653905,
+		},
+		{
+			loc:     3.5,
+			prob:    0.010822144830979668,
+			cumProb: 0.9973970059808868,
+			logProb: -4.526160796836946,
+		},
+	}
+	testDistributionProbs(t, Nakagami{Mu: 2, Omega: 3}, "Nakagami", pts)
+}
+
+func TestNakagami(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, d := range []Nakagami{
+		{0.5, 1, src},
+		{2, 3, src},
+		{7.5, 0.2, src},
+	} {
+		testNakagami(t, d, i)
+	}
+}
+
+func testNakagami(t *testing.T, d Nakagami, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, d)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, 0, x, d, tol, bins)
+	checkProbContinuous(t, i, x, d, 1e-10)
+	checkMean(t, i, x, d, tol)
+	checkVarAndStd(t, i, x, d, tol)
+	checkExKurtosis(t, i, x, d, 5e-2)
+	checkSkewness(t, i, x, d, 2e-2)
+	checkQuantileCDFSurvival(t, i, x, d, tol)
+	checkProbQuantContinuous(t, i, x, d, tol)
+}
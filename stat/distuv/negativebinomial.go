@@ -0,0 +1,220 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+)
+
+// NegativeBinomial implements the negative binomial distribution, a discrete
+// probability distribution that expresses the probability of the number of
+// failures before the R^th success in a sequence of independent Bernoulli
+// trials. R may be non-integer, in which case the distribution is a gamma
+// mixture of Poisson distributions.
+//
+// The negative binomial distribution has density function
+//  f(k) = Γ(k+r) / (k! Γ(r)) p^r (1-p)^k
+// for k = 0, 1, 2, ...
+//
+// For more information, see https://en.wikipedia.org/wiki/Negative_binomial_distribution.
+type NegativeBinomial struct {
+	// R is the number of successes. R must be greater than 0.
+	R float64
+	// P is the probability of success in any given trial. P must be in (0, 1].
+	P float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (n NegativeBinomial) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return mathext.RegIncBeta(n.R, math.Floor(x)+1, n.P)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (n NegativeBinomial) ExKurtosis() float64 {
+	return 6/n.R + n.P*n.P/((1-n.P)*n.R)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// For a given R the likelihood is maximized by P = R/(R+m), where m is the
+// weighted mean of the samples. The estimate of R has no closed form and is
+// found by Newton's method starting from the method of moments estimate.
+// If the weighted variance of the samples does not exceed m, the likelihood
+// increases with R without bound and R is set to the largest estimate found.
+// If the iteration does not converge, the parameters are set to the best
+// estimate found.
+func (n *NegativeBinomial) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mean := weightedMean(identity, samples, weights)
+	variance := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+	sumW := sumWeights(samples, weights)
+
+	p := []float64{1}
+	if variance > mean {
+		p[0] = mean * mean / (variance - mean)
+	}
+	// The partial derivative of the log-likelihood with respect to P is
+	// zero at P = R/(R+m), so only the derivatives with respect to R and
+	// the dependence of P on R contribute to the derivatives of the
+	// profile log-likelihood.
+	maximizeLikelihood(p, mlProblem{
+		positive: []bool{true},
+		weight:   sumW,
+		logLik: func(p []float64) float64 {
+			return logLikelihood(NegativeBinomial{R: p[0], P: p[0] / (p[0] + mean)}.LogProb, samples, weights)
+		},
+		score: func(dst, p []float64) {
+			r := p[0]
+			dst[0] = sumW * math.Log(r/(r+mean))
+			dr := mathext.Digamma(r)
+			for i, x := range samples {
+				if w := weightAt(weights, i); w != 0 {
+					dst[0] += w * (mathext.Digamma(x+r) - dr)
+				}
+			}
+		},
+		hess: func(dst *mat.SymDense, p []float64) {
+			r := p[0]
+			h := sumW * mean / (r * (r + mean))
+			tr := trigamma(r)
+			for i, x := range samples {
+				if w := weightAt(weights, i); w != 0 {
+					h += w * (trigamma(x+r) - tr)
+				}
+			}
+			dst.SetSym(0, 0, h)
+		},
+	})
+	n.R = p[0]
+	n.P = p[0] / (p[0] + mean)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [R, P] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// The observed Fisher information is used since the expected information has
+// no simple closed form.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (n NegativeBinomial) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	return observedStdErr(dst, []float64{n.R, n.P}, func(dst *mat.SymDense, p []float64) {
+		weightedHessian(dst, NegativeBinomial{R: p[0], P: p[1]}.logProbHess, samples, weights)
+	})
+}
+
+// logProbHess stores into hess the Hessian of LogProb at x with respect to
+// [R, P].
+func (n NegativeBinomial) logProbHess(hess *mat.SymDense, x float64) {
+	hpp := -n.R / (n.P * n.P)
+	if x != 0 {
+		q := 1 - n.P
+		hpp -= x / (q * q)
+	}
+	hess.SetSym(0, 0, trigamma(x+n.R)-trigamma(n.R))
+	hess.SetSym(0, 1, 1/n.P)
+	hess.SetSym(1, 1, hpp)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (n NegativeBinomial) LogProb(x float64) float64 {
+	if x < 0 || math.Floor(x) != x {
+		return math.Inf(-1)
+	}
+	lg1, _ := math.Lgamma(x + n.R)
+	lg2, _ := math.Lgamma(x + 1)
+	lg3, _ := math.Lgamma(n.R)
+	lp := lg1 - lg2 - lg3 + n.R*math.Log(n.P)
+	if x != 0 {
+		lp += x * math.Log1p(-n.P)
+	}
+	return lp
+}
+
+// Mean returns the mean of the probability distribution.
+func (n NegativeBinomial) Mean() float64 {
+	return n.R * (1 - n.P) / n.P
+}
+
+// Mode returns the mode of the probability distribution.
+func (n NegativeBinomial) Mode() float64 {
+	if n.R <= 1 {
+		return 0
+	}
+	return math.Floor((n.R - 1) * (1 - n.P) / n.P)
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (NegativeBinomial) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (n NegativeBinomial) Prob(x float64) float64 {
+	return math.Exp(n.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+//
+// Since the distribution is discrete, Quantile returns the smallest k such
+// that CDF(k) >= p.
+func (n NegativeBinomial) Quantile(p float64) float64 {
+	if n.P == 1 {
+		return 0
+	}
+	return discreteQuantile(p, n.CDF, 0, math.Inf(1), n.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (n NegativeBinomial) Rand() float64 {
+	if n.P == 1 {
+		return 0
+	}
+	// The negative binomial distribution is a Poisson distribution whose
+	// rate follows a gamma distribution with shape R and scale (1-P)/P.
+	lambda := Gamma{Alpha: n.R, Beta: n.P / (1 - n.P), Src: n.Src}.Rand()
+	if lambda == 0 {
+		return 0
+	}
+	return Poisson{Lambda: lambda, Src: n.Src}.Rand()
+}
+
+// Skewness returns the skewness of the distribution.
+func (n NegativeBinomial) Skewness() float64 {
+	return (2 - n.P) / math.Sqrt((1-n.P)*n.R)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (n NegativeBinomial) StdDev() float64 {
+	return math.Sqrt(n.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (n NegativeBinomial) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return mathext.RegIncBeta(math.Floor(x)+1, n.R, 1-n.P)
+}
+
+// Variance returns the variance of the probability distribution.
+func (n NegativeBinomial) Variance() float64 {
+	return n.R * (1 - n.P) / (n.P * n.P)
+}
@@ -0,0 +1,73 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestNegativeBinomialProbCDF(t *testing.T) {
+	const tol = 1e-12
+	for i, tt := range []struct {
+		k, r, p  float64
+		wantProb float64
+		wantCDF  float64
+	}{
+		// Values calculated by direct summation of the density function.
+		{0, 3.5, 0.4, 0.04047715405015527, 0.04047715405015527},
+		{4, 3.5, 0.4, 0.12307230478277412, 0.48953221897585464},
+		{10, 3.5, 0.4, 0.03471498578002947, 0.9124883832397588},
+		{0, 1, 0.2, 0.2, 0.2},
+		{3, 1, 0.2, 0.1024, 0.5904},
+		{7, 1, 0.2, 0.04194304, 0.83222784},
+		{2, 5, 0.5, 0.1171875, 0.2265625},
+		{5, 5, 0.5, 0.123046875, 0.623046875},
+		{12, 5, 0.5, 0.013885498046875, 0.9754791259765625},
+	} {
+		b := NegativeBinomial{R: tt.r, P: tt.p}
+		prob := b.Prob(tt.k)
+		if !floats.EqualWithinAbsOrRel(prob, tt.wantProb, tol, tol) {
+			t.Errorf("Prob mismatch case %d: got %v, want %v", i, prob, tt.wantProb)
+		}
+		cdf := b.CDF(tt.k)
+		if !floats.EqualWithinAbsOrRel(cdf, tt.wantCDF, tol, tol) {
+			t.Errorf("CDF mismatch case %d: got %v, want %v", i, cdf, tt.wantCDF)
+		}
+	}
+}
+
+func TestNegativeBinomial(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, b := range []NegativeBinomial{
+		{3.5, 0.4, src},
+		{1, 0.2, src},
+		{20, 0.7, src},
+		{0.5, 0.1, src},
+	} {
+		testNegativeBinomial(t, b, i)
+	}
+}
+
+func testNegativeBinomial(t *testing.T, b NegativeBinomial, i int) {
+	const (
+		tol = 1e-2
+		n   = 1e6
+	)
+	x := make([]float64, n)
+	generateSamples(x, b)
+	sort.Float64s(x)
+
+	checkProbDiscrete(t, i, x, b, 2e-3)
+	checkMean(t, i, x, b, tol)
+	checkVarAndStd(t, i, x, b, 2e-2)
+	checkExKurtosis(t, i, x, b, 2e-1)
+	checkSkewness(t, i, x, b, 5e-2)
+	checkQuantileDiscrete(t, i, b)
+}
@@ -0,0 +1,118 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// NoncentralChiSquared implements the noncentral χ² distribution, the
+// distribution of the sum of the squares of K independent normal variables
+// with unit variance whose means have squares summing to Lambda.
+//
+// The noncentral χ² distribution has density function
+//  \sum_{j=0}^∞ e^(-λ/2) (λ/2)^j / j! f_{k+2j}(x)
+// where f_n is the density function of the χ² distribution with n degrees
+// of freedom.
+//
+// NoncentralChiSquared does not implement Fitter since the derivatives of its
+// log-likelihood are ratios of infinite series with no closed form.
+//
+// For more information, see https://en.wikipedia.org/wiki/Noncentral_chi-squared_distribution.
+type NoncentralChiSquared struct {
+	// K is the number of degrees of freedom. K must be greater than 0.
+	K float64
+	// Lambda is the noncentrality parameter. Lambda must be greater than or
+	// equal to 0.
+	Lambda float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (n NoncentralChiSquared) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return poissonMixture(n.Lambda/2, func(j float64) float64 {
+		return ChiSquared{K: n.K + 2*j}.CDF(x)
+	})
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (n NoncentralChiSquared) ExKurtosis() float64 {
+	v := n.K + 2*n.Lambda
+	return 12 * (n.K + 4*n.Lambda) / (v * v)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (n NoncentralChiSquared) LogProb(x float64) float64 {
+	return math.Log(n.Prob(x))
+}
+
+// Mean returns the mean of the probability distribution.
+func (n NoncentralChiSquared) Mean() float64 {
+	return n.K + n.Lambda
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (NoncentralChiSquared) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (n NoncentralChiSquared) Prob(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return poissonMixture(n.Lambda/2, func(j float64) float64 {
+		return ChiSquared{K: n.K + 2*j}.Prob(x)
+	})
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (n NoncentralChiSquared) Quantile(p float64) float64 {
+	return continuousQuantile(p, n.CDF, n.Prob, 0, math.Inf(1), n.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (n NoncentralChiSquared) Rand() float64 {
+	// The noncentral χ² distribution is a Poisson mixture of central χ²
+	// distributions.
+	var j float64
+	if n.Lambda > 0 {
+		j = Poisson{Lambda: n.Lambda / 2, Src: n.Src}.Rand()
+	}
+	return Gamma{Alpha: n.K/2 + j, Beta: 0.5, Src: n.Src}.Rand()
+}
+
+// Skewness returns the skewness of the distribution.
+func (n NoncentralChiSquared) Skewness() float64 {
+	v := n.K + 2*n.Lambda
+	return math.Pow(2, 1.5) * (n.K + 3*n.Lambda) / math.Pow(v, 1.5)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (n NoncentralChiSquared) StdDev() float64 {
+	return math.Sqrt(n.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (n NoncentralChiSquared) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return poissonMixture(n.Lambda/2, func(j float64) float64 {
+		return ChiSquared{K: n.K + 2*j}.Survival(x)
+	})
+}
+
+// Variance returns the variance of the probability distribution.
+func (n NoncentralChiSquared) Variance() float64 {
+	return 2 * (n.K + 2*n.Lambda)
+}
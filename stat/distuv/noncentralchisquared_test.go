@@ -0,0 +1,88 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestNoncentralChiSquaredProbCDF(t *testing.T) {
+	const tol = 1e-10
+	for i, tt := range []struct {
+		x, k, lambda      float64
+		wantProb, wantCDF float64
+	}{
+		// Values calculated by numerical integration of the density function
+		// and by summation of the Poisson mixture of χ² distribution functions.
+		{0.5, 3, 2, 0.09498153621397694, 0.03283956190317832},
+		{4, 3, 2, 0.11839464506363484, 0.4838813583880766},
+		{12, 3, 2, 0.017253584069950954, 0.9453046187167079},
+		{0.2, 1.5, 0.5, 0.5284743816824325, 0.14646110051507155},
+		{1, 1.5, 0.5, 0.26879022536420333, 0.4381219098779331},
+		{6, 1.5, 0.5, 0.026864699782224555, 0.9375963892516006},
+		{20, 10, 25, 0.016395894304992154, 0.06871019090405048},
+		{35, 10, 25, 0.03605387605713542, 0.5345276844220143},
+		{60, 10, 25, 0.0034635717103814307, 0.9786917165680639},
+		{-1, 3, 2, 0, 0},
+	} {
+		c := NoncentralChiSquared{K: tt.k, Lambda: tt.lambda}
+		prob := c.Prob(tt.x)
+		if !floats.EqualWithinAbsOrRel(prob, tt.wantProb, tol, tol) {
+			t.Errorf("Prob mismatch case %d: got %v, want %v", i, prob, tt.wantProb)
+		}
+		cdf := c.CDF(tt.x)
+		if !floats.EqualWithinAbsOrRel(cdf, tt.wantCDF, tol, tol) {
+			t.Errorf("CDF mismatch case %d: got %v, want %v", i, cdf, tt.wantCDF)
+		}
+	}
+
+	// With λ = 0 the distribution is the central χ² distribution.
+	c := NoncentralChiSquared{K: 4, Lambda: 0}
+	chi := ChiSquared{K: 4}
+	for _, x := range []float64{0.5, 3, 10} {
+		if !floats.EqualWithinAbsOrRel(c.Prob(x), chi.Prob(x), 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch for λ = 0 at %v: got %v, want %v", x, c.Prob(x), chi.Prob(x))
+		}
+		if !floats.EqualWithinAbsOrRel(c.CDF(x), chi.CDF(x), 1e-14, 1e-14) {
+			t.Errorf("CDF mismatch for λ = 0 at %v: got %v, want %v", x, c.CDF(x), chi.CDF(x))
+		}
+	}
+}
+
+func TestNoncentralChiSquared(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, c := range []NoncentralChiSquared{
+		{3, 2, src},
+		{1.5, 0.5, src},
+		{10, 25, src},
+		{4, 0, src},
+	} {
+		testNoncentralChiSquared(t, c, i)
+	}
+}
+
+func testNoncentralChiSquared(t *testing.T, c NoncentralChiSquared, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, c)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, 0, x, c, tol, bins)
+	checkMean(t, i, x, c, tol)
+	checkVarAndStd(t, i, x, c, 5e-2)
+	checkExKurtosis(t, i, x, c, 1e-1)
+	checkSkewness(t, i, x, c, 5e-2)
+	checkQuantileCDFSurvival(t, i, x, c, tol)
+	checkProbQuantContinuous(t, i, x, c, tol)
+}
@@ -0,0 +1,93 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestNoncentralTProbCDF(t *testing.T) {
+	const tol = 1e-10
+	for i, tt := range []struct {
+		x, nu, mu         float64
+		wantProb, wantCDF float64
+	}{
+		// Values calculated by numerical integration over the χ² variable.
+		{-2, 5, 1.5, 0.0025155525469862158, 0.001516585452439412},
+		{0, 5, 1.5, 0.12324024847660736, 0.06680720126885777},
+		{0.5, 5, 1.5, 0.2358454444341366, 0.15568997078793742},
+		{2, 5, 1.5, 0.28634378071050887, 0.6314492472556663},
+		{10, 5, 1.5, 0.0007316571011907989, 0.9984229601245825},
+		{-5, 1, -0.7, 0.025311202584934744, 0.13179686514782768},
+		{-0.5, 1, -0.7, 0.3016256309169632, 0.6148973703447401},
+		{1, 1, -0.7, 0.07036042734473803, 0.9037083411398648},
+		{1, 12, 3, 0.05838439342251936, 0.023862022524560156},
+		{3, 12, 3, 0.33385279802511286, 0.4769437914722437},
+		{6, 12, 3, 0.03326121756899407, 0.9695141590826774},
+	} {
+		d := NoncentralT{Nu: tt.nu, Mu: tt.mu}
+		prob := d.Prob(tt.x)
+		if !floats.EqualWithinAbsOrRel(prob, tt.wantProb, tol, tol) {
+			t.Errorf("Prob mismatch case %d: got %v, want %v", i, prob, tt.wantProb)
+		}
+		cdf := d.CDF(tt.x)
+		if !floats.EqualWithinAbsOrRel(cdf, tt.wantCDF, tol, tol) {
+			t.Errorf("CDF mismatch case %d: got %v, want %v", i, cdf, tt.wantCDF)
+		}
+	}
+
+	// With μ = 0 the distribution is Student's t distribution.
+	d := NoncentralT{Nu: 3.5, Mu: 0}
+	st := StudentsT{Mu: 0, Sigma: 1, Nu: 3.5}
+	for _, x := range []float64{-4, -0.5, 0, 1, 6} {
+		if !floats.EqualWithinAbsOrRel(d.Prob(x), st.Prob(x), 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch for μ = 0 at %v: got %v, want %v", x, d.Prob(x), st.Prob(x))
+		}
+		if !floats.EqualWithinAbsOrRel(d.CDF(x), st.CDF(x), 1e-14, 1e-14) {
+			t.Errorf("CDF mismatch for μ = 0 at %v: got %v, want %v", x, d.CDF(x), st.CDF(x))
+		}
+	}
+}
+
+func TestNoncentralT(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, d := range []NoncentralT{
+		{5, 1.5, src},
+		{1, -0.7, src},
+		{12, 3, src},
+		{30, -1, src},
+	} {
+		testNoncentralT(t, d, i)
+	}
+}
+
+func testNoncentralT(t *testing.T, d NoncentralT, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, d)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, math.Inf(-1), x, d, tol, bins)
+	checkQuantileCDFSurvival(t, i, x, d, tol)
+	checkProbQuantContinuous(t, i, x, d, tol)
+	if d.Nu > 4 {
+		checkMean(t, i, x, d, tol)
+		checkVarAndStd(t, i, x, d, 5e-2)
+	}
+	if d.Nu > 8 {
+		checkSkewness(t, i, x, d, 5e-2)
+		checkExKurtosis(t, i, x, d, 2e-1)
+	}
+}
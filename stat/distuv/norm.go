@@ -85,6 +85,20 @@ func (n *Normal) Fit(samples, weights []float64) {
 	n.ConjugateUpdate(suffStat, nSamples, make([]float64, n.NumSuffStat()))
 }
 
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Mu, Sigma] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (n Normal) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	nSamples := sumWeights(samples, weights)
+	dst[0] = n.Sigma / math.Sqrt(nSamples)
+	dst[1] = n.Sigma / math.Sqrt(2*nSamples)
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (n Normal) LogProb(x float64) float64 {
 	return negLogRoot2Pi - math.Log(n.Sigma) - (x-n.Mu)*(x-n.Mu)/(2*n.Sigma*n.Sigma)
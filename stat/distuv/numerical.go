@@ -0,0 +1,306 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import "math"
+
+// legendreX and legendreW are the nodes and weights of the Gauss-Legendre
+// quadrature rule on [-1, 1] used by integrate.
+var legendreX, legendreW = legendreRule(16)
+
+// legendreRule returns the nodes and weights of the n-point Gauss-Legendre
+// quadrature rule on [-1, 1].
+func legendreRule(n int) (x, w []float64) {
+	x = make([]float64, n)
+	w = make([]float64, n)
+	for i := 0; i < (n+1)/2; i++ {
+		// Find the i^th root of the Legendre polynomial P_n by Newton's method.
+		z := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(n) + 0.5))
+		var dp float64
+		for iter := 0; iter < 100; iter++ {
+			p1, p2 := 1.0, 0.0
+			for j := 1; j <= n; j++ {
+				p1, p2 = (float64(2*j-1)*z*p1-float64(j-1)*p2)/float64(j), p1
+			}
+			dp = float64(n) * (z*p1 - p2) / (z*z - 1)
+			dz := p1 / dp
+			z -= dz
+			if math.Abs(dz) < 1e-15 {
+				break
+			}
+		}
+		x[i] = -z
+		x[n-1-i] = z
+		w[i] = 2 / ((1 - z*z) * dp * dp)
+		w[n-1-i] = w[i]
+	}
+	return x, w
+}
+
+// gaussLegendre returns the Gauss-Legendre estimate of the integral of f
+// over [a, b].
+func gaussLegendre(f func(float64) float64, a, b float64) float64 {
+	half := (b - a) / 2
+	mid := (a + b) / 2
+	var sum float64
+	for i, x := range legendreX {
+		sum += legendreW[i] * f(mid+half*x)
+	}
+	return half * sum
+}
+
+// integrate returns the integral of f over the finite interval [a, b],
+// computed by adaptive Gauss-Legendre quadrature to the absolute
+// tolerance tol.
+func integrate(f func(float64) float64, a, b, tol float64) float64 {
+	return integrateAdaptive(f, a, b, gaussLegendre(f, a, b), tol, 0)
+}
+
+func integrateAdaptive(f func(float64) float64, a, b, whole, tol float64, depth int) float64 {
+	const maxDepth = 20
+	mid := (a + b) / 2
+	left := gaussLegendre(f, a, mid)
+	right := gaussLegendre(f, mid, b)
+	sum := left + right
+	if depth >= maxDepth || math.Abs(sum-whole) <= math.Max(tol, 1e-15*math.Abs(sum)) {
+		return sum
+	}
+	return integrateAdaptive(f, a, mid, left, tol, depth+1) + integrateAdaptive(f, mid, b, right, tol, depth+1)
+}
+
+// besselIe returns the exponentially scaled modified Bessel function of the
+// first kind exp(-|x|) I_ν(x) for ν = 0 or ν = 1.
+func besselIe(nu int, x float64) float64 {
+	if nu != 0 && nu != 1 {
+		panic("distuv: bad Bessel function order")
+	}
+	if x < 0 {
+		if nu == 0 {
+			return besselIe(0, -x)
+		}
+		return -besselIe(1, -x)
+	}
+	if x <= 20 {
+		// Use the power series
+		//  I_ν(x) = \sum_k (x/2)^(2k+ν) / (k! (k+ν)!)
+		y := x / 2
+		term := 1.0
+		if nu == 1 {
+			term = y
+		}
+		sum := term
+		for k := 1; term > 1e-17*sum; k++ {
+			term *= y * y / float64(k*(k+nu))
+			sum += term
+		}
+		return sum * math.Exp(-x)
+	}
+	// Use the asymptotic expansion
+	//  I_ν(x) ≈ e^x / sqrt(2πx) \sum_k (-1)^k a_k(ν) / x^k
+	// where a_k(ν) = (4ν²-1²)(4ν²-3²)...(4ν²-(2k-1)²) / (k! 8^k).
+	mu := float64(4 * nu * nu)
+	term := 1.0
+	sum := 1.0
+	for k := 1; k < 100; k++ {
+		next := -term * (mu - float64((2*k-1)*(2*k-1))) / (float64(8*k) * x)
+		if math.Abs(next) >= math.Abs(term) {
+			break
+		}
+		term = next
+		sum += term
+		if math.Abs(term) < 1e-17*math.Abs(sum) {
+			break
+		}
+	}
+	return sum / math.Sqrt(2*math.Pi*x)
+}
+
+// besselRatio returns I_1(x)/I_0(x), the ratio of the modified Bessel
+// functions of the first kind of orders one and zero.
+func besselRatio(x float64) float64 {
+	return besselIe(1, x) / besselIe(0, x)
+}
+
+// besselRatioDeriv returns the derivative at x of I_1(x)/I_0(x), given the
+// value rho of the ratio at x.
+func besselRatioDeriv(x, rho float64) float64 {
+	if x == 0 {
+		return 0.5
+	}
+	return 1 - rho/x - rho*rho
+}
+
+// besselRatios returns the ratios I_j(κ)/I_0(κ) for j = 1, 2, ... of the
+// modified Bessel functions of the first kind, truncated once the ratios are
+// negligible.
+func besselRatios(kappa float64) []float64 {
+	if kappa == 0 {
+		return nil
+	}
+	// The ratios r_j = I_j(κ)/I_{j-1}(κ) satisfy the backward recurrence
+	//  r_j = 1 / (2j/κ + r_{j+1})
+	// which is started beyond the point where the ratios fall below 1/2.
+	n := 50 + int(2*kappa+math.Sqrt(80*kappa))
+	r := make([]float64, n+1)
+	for j := n - 1; j >= 1; j-- {
+		r[j] = 1 / (2*float64(j)/kappa + r[j+1])
+	}
+	rho := make([]float64, 0, n)
+	p := 1.0
+	for j := 1; j < n; j++ {
+		p *= r[j]
+		if p < 1e-17 {
+			break
+		}
+		rho = append(rho, p)
+	}
+	return rho
+}
+
+// continuousQuantile returns the x such that cdf(x) = p for a continuous
+// distribution with density prob and support [lo, hi], either of which may
+// be infinite. The search starts from x0, which must be in the support.
+func continuousQuantile(p float64, cdf, prob func(float64) float64, lo, hi, x0 float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	if p == 0 {
+		return lo
+	}
+	if p == 1 {
+		return hi
+	}
+
+	// Find a finite bracket [a, b] containing the quantile.
+	a, b := lo, hi
+	if cdf(x0) < p {
+		a = x0
+		for step := 1.0; math.IsInf(b, 1); step *= 2 {
+			x := a + step
+			if cdf(x) >= p {
+				b = x
+				break
+			}
+			a = x
+		}
+	} else {
+		b = x0
+		for step := 1.0; math.IsInf(a, -1); step *= 2 {
+			x := b - step
+			if cdf(x) < p {
+				a = x
+				break
+			}
+			b = x
+		}
+	}
+
+	// Refine by Newton's method, falling back to bisection when the Newton
+	// step leaves the bracket.
+	x := x0
+	if !(a < x && x < b) {
+		x = a + (b-a)/2
+	}
+	for i := 0; i < 200; i++ {
+		f := cdf(x) - p
+		if f == 0 {
+			return x
+		}
+		if f < 0 {
+			a = x
+		} else {
+			b = x
+		}
+		next := x - f/prob(x)
+		if !(a < next && next < b) {
+			next = a + (b-a)/2
+		}
+		if math.Abs(next-x) <= 1e-15*math.Max(1, math.Abs(x)) || b-a <= 1e-15*math.Max(1, math.Abs(x)) {
+			return next
+		}
+		x = next
+	}
+	return x
+}
+
+// discreteQuantile returns the smallest integer k in [lo, hi] such that
+// cdf(k) >= p for a distribution over the integers in [lo, hi], either of
+// which may be infinite. The search starts from x0.
+func discreteQuantile(p float64, cdf func(float64) float64, lo, hi, x0 float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	if p == 1 {
+		return hi
+	}
+	k := math.Min(math.Max(math.Floor(x0), lo), hi)
+	// Find integers a < b with cdf(a) < p <= cdf(b).
+	var a, b float64
+	if cdf(k) >= p {
+		b = k
+		for step := 1.0; ; step *= 2 {
+			a = math.Max(b-step, lo)
+			if a == b {
+				return b
+			}
+			if cdf(a) < p {
+				break
+			}
+			b = a
+		}
+	} else {
+		a = k
+		for step := 1.0; ; step *= 2 {
+			b = math.Min(a+step, hi)
+			if cdf(b) >= p || b == hi {
+				break
+			}
+			a = b
+		}
+	}
+	for b-a > 1 {
+		m := math.Floor((a + b) / 2)
+		if cdf(m) >= p {
+			b = m
+		} else {
+			a = m
+		}
+	}
+	return b
+}
+
+// poissonMixture returns the Poisson mixture
+//  \sum_{j=0}^∞ e^{-m} m^j / j! term(j)
+// for m >= 0, summing outwards from the mode of the Poisson weights until
+// the remaining contributions are negligible.
+func poissonMixture(m float64, term func(j float64) float64) float64 {
+	if m == 0 {
+		return term(0)
+	}
+	const tol = 1e-17
+	j0 := math.Floor(m)
+	lg, _ := math.Lgamma(j0 + 1)
+	w0 := math.Exp(-m + j0*math.Log(m) - lg)
+	sum := w0 * term(j0)
+	w := w0
+	for j := j0 + 1; ; j++ {
+		w *= m / j
+		t := w * term(j)
+		sum += t
+		if w < tol && math.Abs(t) <= tol*math.Abs(sum) {
+			break
+		}
+	}
+	w = w0
+	for j := j0 - 1; j >= 0; j-- {
+		w *= (j + 1) / m
+		t := w * term(j)
+		sum += t
+		if w < tol && math.Abs(t) <= tol*math.Abs(sum) {
+			break
+		}
+	}
+	return sum
+}
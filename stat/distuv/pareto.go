@@ -51,6 +51,40 @@ func (p Pareto) ExKurtosis() float64 {
 
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimate of Xm is the smallest sample with non-zero weight and the
+// estimate of Alpha is the reciprocal of the weighted mean of log(x/Xm).
+func (p *Pareto) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	xm := math.Inf(1)
+	for i, x := range samples {
+		if weightAt(weights, i) != 0 && x < xm {
+			xm = x
+		}
+	}
+	p.Xm = xm
+	p.Alpha = 1 / weightedMean(func(x float64) float64 { return math.Log(x / xm) }, samples, weights)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Xm, Alpha] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// The support of the distribution depends on Xm so it has no Fisher
+// information and its standard error is NaN.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (p Pareto) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	dst[0] = math.NaN()
+	dst[1] = p.Alpha / math.Sqrt(sumWeights(samples, weights))
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (p Pareto) LogProb(x float64) float64 {
@@ -39,6 +39,29 @@ func (p Poisson) ExKurtosis() float64 {
 	return 1 / p.Lambda
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimate of Lambda is the weighted mean of the samples.
+func (p *Poisson) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	p.Lambda = weightedMean(identity, samples, weights)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Lambda] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (p Poisson) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 1)
+	dst[0] = math.Sqrt(p.Lambda / sumWeights(samples, weights))
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (p Poisson) LogProb(x float64) float64 {
@@ -0,0 +1,148 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Rayleigh implements the Rayleigh distribution, a one-parameter continuous
+// distribution with support over the non-negative real numbers. It is the
+// distribution of the magnitude of a two-dimensional vector whose components
+// are independent zero-mean normal variables with equal variance.
+//
+// The Rayleigh distribution has density function
+//  x/σ^2 e^(-x^2/(2σ^2))
+//
+// For more information, see https://en.wikipedia.org/wiki/Rayleigh_distribution.
+type Rayleigh struct {
+	// Sigma is the scale parameter of the distribution. Sigma must be
+	// greater than 0.
+	Sigma float64
+
+	Src rand.Source
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (r Rayleigh) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return -math.Expm1(-x * x / (2 * r.Sigma * r.Sigma))
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (r Rayleigh) Entropy() float64 {
+	return 1 + math.Log(r.Sigma/math.Sqrt2) + eulerMascheroni/2
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (Rayleigh) ExKurtosis() float64 {
+	return -(6*math.Pi*math.Pi - 24*math.Pi + 16) / ((4 - math.Pi) * (4 - math.Pi))
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimate of Sigma is the square root of half the weighted mean of the
+// squared samples.
+func (r *Rayleigh) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	r.Sigma = math.Sqrt(weightedMean(func(x float64) float64 { return x * x }, samples, weights) / 2)
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Sigma] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (r Rayleigh) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 1)
+	// The Fisher information for a single sample is 4/σ².
+	dst[0] = r.Sigma / (2 * math.Sqrt(sumWeights(samples, weights)))
+	return dst
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (r Rayleigh) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	s2 := r.Sigma * r.Sigma
+	return math.Log(x/s2) - x*x/(2*s2)
+}
+
+// Mean returns the mean of the probability distribution.
+func (r Rayleigh) Mean() float64 {
+	return r.Sigma * math.Sqrt(math.Pi/2)
+}
+
+// Median returns the median of the probability distribution.
+func (r Rayleigh) Median() float64 {
+	return r.Sigma * math.Sqrt(2*math.Ln2)
+}
+
+// Mode returns the mode of the probability distribution.
+func (r Rayleigh) Mode() float64 {
+	return r.Sigma
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Rayleigh) NumParameters() int {
+	return 1
+}
+
+// Prob computes the value of the probability density function at x.
+func (r Rayleigh) Prob(x float64) float64 {
+	return math.Exp(r.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (r Rayleigh) Quantile(p float64) float64 {
+	if p < 0 || 1 < p {
+		panic(badPercentile)
+	}
+	return r.Sigma * math.Sqrt(-2*math.Log1p(-p))
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (r Rayleigh) Rand() float64 {
+	var rnd float64
+	if r.Src == nil {
+		rnd = rand.ExpFloat64()
+	} else {
+		rnd = rand.New(r.Src).ExpFloat64()
+	}
+	return r.Sigma * math.Sqrt(2*rnd)
+}
+
+// Skewness returns the skewness of the distribution.
+func (Rayleigh) Skewness() float64 {
+	return 2 * math.Sqrt(math.Pi) * (math.Pi - 3) / math.Pow(4-math.Pi, 1.5)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (r Rayleigh) StdDev() float64 {
+	return math.Sqrt(r.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (r Rayleigh) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return math.Exp(-x * x / (2 * r.Sigma * r.Sigma))
+}
+
+// Variance returns the variance of the probability distribution.
+func (r Rayleigh) Variance() float64 {
+	return (4 - math.Pi) / 2 * r.Sigma * r.Sigma
+}
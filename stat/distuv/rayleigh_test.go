@@ -0,0 +1,82 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestRayleighProb(t *testing.T) {
+	// Values calculated from the closed form of the distribution.
+	pts := []univariateProbPoint{
+		{
+			loc:     0.1,
+			prob:    0.0443457886704714,
+			cumProb: 0.002219754914393583,
+			logProb: -3.115737531432597,
+		},
+		{
+			loc:     1,
+			prob:    0.355883290185248,
+			cumProb: 0.19926259708319194,
+			logProb: -1.033152438438551,
+		},
+		{
+			loc:     1.5,
+			prob:    0.4043537731417556,
+			cumProb: 0.3934693402873666,
+			logProb: -0.9054651081081645,
+		},
+		{
+			loc:     3,
+			prob:    0.18044704431548358,
+			cumProb: 0.8646647167633873,
+			logProb: -1.712317927548219,
+		},
+		{
+			loc:     6,
+			prob:    0.0008945670077400316,
+			cumProb: 0.9996645373720975,
+			logProb: -7.019170746988274,
+		},
+	}
+	testDistributionProbs(t, Rayleigh{Sigma: 1.5}, "Rayleigh", pts)
+}
+
+func TestRayleigh(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, r := range []Rayleigh{
+		{1, src},
+		{0.2, src},
+		{7, src},
+	} {
+		testRayleigh(t, r, i)
+	}
+}
+
+func testRayleigh(t *testing.T, r Rayleigh, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, r)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, 0, x, r, tol, bins)
+	checkProbContinuous(t, i, x, r, 1e-10)
+	checkMean(t, i, x, r, tol)
+	checkMedian(t, i, x, r, tol)
+	checkVarAndStd(t, i, x, r, tol)
+	checkEntropy(t, i, x, r, tol)
+	checkExKurtosis(t, i, x, r, 5e-2)
+	checkSkewness(t, i, x, r, tol)
+	checkQuantileCDFSurvival(t, i, x, r, tol)
+	checkProbQuantContinuous(t, i, x, r, tol)
+}
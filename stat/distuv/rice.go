@@ -0,0 +1,224 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Rice implements the Rice distribution, the distribution of the magnitude of
+// a two-dimensional vector whose components are independent normal variables
+// with equal variance and whose mean has magnitude Nu.
+//
+// The Rice distribution has density function
+//  x/σ^2 e^(-(x^2+ν^2)/(2σ^2)) I_0(xν/σ^2)
+// where I_0 is the modified Bessel function of the first kind of order 0.
+//
+// For more information, see https://en.wikipedia.org/wiki/Rice_distribution.
+type Rice struct {
+	// Nu is the distance between the origin and the center of the
+	// bivariate distribution. Nu must be greater than or equal to 0.
+	Nu float64
+	// Sigma is the scale of the distribution. Sigma must be greater than 0.
+	Sigma float64
+
+	Src rand.Source
+}
+
+// chi2 returns the noncentral χ² distribution of (X/σ)^2.
+func (r Rice) chi2() NoncentralChiSquared {
+	return NoncentralChiSquared{K: 2, Lambda: r.Nu * r.Nu / (r.Sigma * r.Sigma)}
+}
+
+// laguerre returns the Laguerre functions L_{1/2}(-t) and L_{3/2}(-t) at
+// t = ν^2/(2σ^2).
+func (r Rice) laguerre() (l1, l3 float64) {
+	t := r.Nu * r.Nu / (2 * r.Sigma * r.Sigma)
+	i0 := besselIe(0, t/2)
+	i1 := besselIe(1, t/2)
+	// L_{-1/2}(-t) = e^(-t/2) I_0(t/2)
+	// L_{1/2}(-t) = e^(-t/2) ((1+t) I_0(t/2) + t I_1(t/2))
+	// L_{3/2}(-t) = ((2+t) L_{1/2}(-t) - L_{-1/2}(-t)/2) / (3/2)
+	l1 = (1+t)*i0 + t*i1
+	l3 = ((2+t)*l1 - i0/2) / 1.5
+	return l1, l3
+}
+
+// moments returns the first four raw moments of the distribution.
+func (r Rice) moments() (m1, m2, m3, m4 float64) {
+	l1, l3 := r.laguerre()
+	s2 := r.Sigma * r.Sigma
+	n2 := r.Nu * r.Nu
+	m1 = r.Sigma * math.Sqrt(math.Pi/2) * l1
+	m2 = 2*s2 + n2
+	m3 = 3 * s2 * r.Sigma * math.Sqrt(math.Pi/2) * l3
+	m4 = 8*s2*s2 + 8*s2*n2 + n2*n2
+	return m1, m2, m3, m4
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (r Rice) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	z := x / r.Sigma
+	return r.chi2().CDF(z * z)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (r Rice) ExKurtosis() float64 {
+	m1, m2, m3, m4 := r.moments()
+	v := m2 - m1*m1
+	return (m4-4*m1*m3+6*m1*m1*m2-3*m1*m1*m1*m1)/(v*v) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates have no closed form and are found by Newton's method
+// starting from the method of moments estimates using the second and fourth
+// moments of the samples.
+// If the iteration does not converge, the parameters are set to the best
+// estimate found.
+func (r *Rice) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	m2 := weightedMean(func(x float64) float64 { return x * x }, samples, weights)
+	m4 := weightedMean(func(x float64) float64 { return x * x * x * x }, samples, weights)
+
+	// The moments satisfy ν⁴ = 2 m2² - m4 and 2σ² = m2 - ν². Nu is kept
+	// away from zero since it is optimized over its logarithm.
+	nu2 := math.Sqrt(math.Max(2*m2*m2-m4, 0))
+	nu2 = math.Max(math.Min(nu2, 0.9*m2), 0.01*m2)
+	p := []float64{math.Sqrt(nu2), math.Sqrt((m2 - nu2) / 2)}
+	maximizeLikelihood(p, mlProblem{
+		positive: []bool{true, true},
+		weight:   sumWeights(samples, weights),
+		logLik: func(p []float64) float64 {
+			return logLikelihood(Rice{Nu: p[0], Sigma: p[1]}.LogProb, samples, weights)
+		},
+		score: func(dst, p []float64) {
+			weightedScore(dst, Rice{Nu: p[0], Sigma: p[1]}.logProbGrad, samples, weights)
+		},
+		hess: func(dst *mat.SymDense, p []float64) {
+			weightedHessian(dst, Rice{Nu: p[0], Sigma: p[1]}.logProbHess, samples, weights)
+		},
+	})
+	r.Nu = p[0]
+	r.Sigma = p[1]
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Nu, Sigma] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// The observed Fisher information is used since the expected information has
+// no simple closed form.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (r Rice) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	return observedStdErr(dst, []float64{r.Nu, r.Sigma}, func(dst *mat.SymDense, p []float64) {
+		weightedHessian(dst, Rice{Nu: p[0], Sigma: p[1]}.logProbHess, samples, weights)
+	})
+}
+
+// logProbGrad stores into deriv the gradient of LogProb at x with respect to
+// [Nu, Sigma].
+func (r Rice) logProbGrad(deriv []float64, x float64) []float64 {
+	s2 := r.Sigma * r.Sigma
+	t := x * r.Nu / s2
+	rho := besselRatio(t)
+	deriv[0] = (rho*x - r.Nu) / s2
+	deriv[1] = (-2 + (x*x+r.Nu*r.Nu)/s2 - 2*rho*t) / r.Sigma
+	return deriv
+}
+
+// logProbHess stores into hess the Hessian of LogProb at x with respect to
+// [Nu, Sigma].
+func (r Rice) logProbHess(hess *mat.SymDense, x float64) {
+	s2 := r.Sigma * r.Sigma
+	t := x * r.Nu / s2
+	rho := besselRatio(t)
+	drho := besselRatioDeriv(t, rho)
+	hess.SetSym(0, 0, (drho*x*x/s2-1)/s2)
+	hess.SetSym(0, 1, 2*(r.Nu-rho*x-drho*x*t)/(r.Sigma*s2))
+	hess.SetSym(1, 1, (2-3*(x*x+r.Nu*r.Nu)/s2+6*rho*t+4*drho*t*t)/s2)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (r Rice) LogProb(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	s2 := r.Sigma * r.Sigma
+	d := x - r.Nu
+	return math.Log(x/s2) - d*d/(2*s2) + math.Log(besselIe(0, x*r.Nu/s2))
+}
+
+// Mean returns the mean of the probability distribution.
+func (r Rice) Mean() float64 {
+	l1, _ := r.laguerre()
+	return r.Sigma * math.Sqrt(math.Pi/2) * l1
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (Rice) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (r Rice) Prob(x float64) float64 {
+	return math.Exp(r.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (r Rice) Quantile(p float64) float64 {
+	return continuousQuantile(p, r.CDF, r.Prob, 0, math.Inf(1), r.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (r Rice) Rand() float64 {
+	rnd := rand.NormFloat64
+	if r.Src != nil {
+		rnd = rand.New(r.Src).NormFloat64
+	}
+	x := r.Sigma*rnd() + r.Nu
+	y := r.Sigma * rnd()
+	return math.Hypot(x, y)
+}
+
+// Skewness returns the skewness of the distribution.
+func (r Rice) Skewness() float64 {
+	m1, m2, m3, _ := r.moments()
+	v := m2 - m1*m1
+	return (m3 - 3*m1*m2 + 2*m1*m1*m1) / math.Pow(v, 1.5)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (r Rice) StdDev() float64 {
+	return math.Sqrt(r.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (r Rice) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	z := x / r.Sigma
+	return r.chi2().Survival(z * z)
+}
+
+// Variance returns the variance of the probability distribution.
+func (r Rice) Variance() float64 {
+	m1 := r.Mean()
+	return 2*r.Sigma*r.Sigma + r.Nu*r.Nu - m1*m1
+}
@@ -0,0 +1,88 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestRiceProbCDF(t *testing.T) {
+	const tol = 1e-10
+	for i, tt := range []struct {
+		x, nu, sigma      float64
+		wantProb, wantCDF float64
+	}{
+		// Values calculated by numerical integration of the density function.
+		{0.5, 2, 1, 0.07560500290056606, 0.017930632708335368},
+		{1.5, 2, 1, 0.321670589812156, 0.20923222060323213},
+		{3, 2, 1, 0.30324852769512506, 0.7856379118373492},
+		{1, 0.5, 2, 0.21467244607305655, 0.1141058519390417},
+		{3, 0.5, 2, 0.24436798102434182, 0.6640118304615316},
+		{7, 0.5, 2, 0.004455226131012406, 0.9973802398529306},
+		{8, 10, 1.5, 0.09814613608697367, 0.07817993961877868},
+		{10, 10, 1.5, 0.26671923402970066, 0.46999408617166066},
+		{12, 10, 1.5, 0.12005976879185952, 0.8970198904329538},
+		{-1, 2, 1, 0, 0},
+	} {
+		r := Rice{Nu: tt.nu, Sigma: tt.sigma}
+		prob := r.Prob(tt.x)
+		if !floats.EqualWithinAbsOrRel(prob, tt.wantProb, tol, tol) {
+			t.Errorf("Prob mismatch case %d: got %v, want %v", i, prob, tt.wantProb)
+		}
+		cdf := r.CDF(tt.x)
+		if !floats.EqualWithinAbsOrRel(cdf, tt.wantCDF, tol, tol) {
+			t.Errorf("CDF mismatch case %d: got %v, want %v", i, cdf, tt.wantCDF)
+		}
+	}
+
+	// With ν = 0 the distribution is the Rayleigh distribution.
+	r := Rice{Nu: 0, Sigma: 1.5}
+	ray := Rayleigh{Sigma: 1.5}
+	for _, x := range []float64{0.1, 1, 2, 5} {
+		if !floats.EqualWithinAbsOrRel(r.Prob(x), ray.Prob(x), 1e-14, 1e-14) {
+			t.Errorf("Prob mismatch for ν = 0 at %v: got %v, want %v", x, r.Prob(x), ray.Prob(x))
+		}
+		if !floats.EqualWithinAbsOrRel(r.CDF(x), ray.CDF(x), 1e-14, 1e-14) {
+			t.Errorf("CDF mismatch for ν = 0 at %v: got %v, want %v", x, r.CDF(x), ray.CDF(x))
+		}
+	}
+}
+
+func TestRice(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, r := range []Rice{
+		{0, 1, src},
+		{2, 1, src},
+		{0.5, 2, src},
+		{10, 1.5, src},
+	} {
+		testRice(t, r, i)
+	}
+}
+
+func testRice(t *testing.T, r Rice, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, r)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, 0, x, r, tol, bins)
+	checkProbContinuous(t, i, x, r, 1e-10)
+	checkMean(t, i, x, r, tol)
+	checkVarAndStd(t, i, x, r, tol)
+	checkExKurtosis(t, i, x, r, 5e-2)
+	checkSkewness(t, i, x, r, 2e-2)
+	checkQuantileCDFSurvival(t, i, x, r, tol)
+	checkProbQuantContinuous(t, i, x, r, tol)
+}
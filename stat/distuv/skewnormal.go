@@ -0,0 +1,252 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// SkewNormal implements the skew normal distribution, a continuous
+// distribution that generalizes the normal distribution to allow for
+// non-zero skewness.
+//
+// The skew normal distribution has density function
+//  2/σ φ((x-μ)/σ) Φ(α(x-μ)/σ)
+// where φ and Φ are the density and distribution functions of the unit
+// normal distribution.
+//
+// For more information, see https://en.wikipedia.org/wiki/Skew_normal_distribution.
+type SkewNormal struct {
+	// Mu is the location of the distribution.
+	Mu float64
+	// Sigma is the scale of the distribution. Sigma must be greater than 0.
+	Sigma float64
+	// Alpha is the shape of the distribution. When Alpha is zero the
+	// distribution is normal.
+	Alpha float64
+
+	Src rand.Source
+}
+
+// delta returns α/sqrt(1+α^2).
+func (s SkewNormal) delta() float64 {
+	return s.Alpha / math.Sqrt(1+s.Alpha*s.Alpha)
+}
+
+// owensT returns Owen's T function
+//  T(h, a) = 1/(2π) \int_0^a e^(-h^2(1+x^2)/2)/(1+x^2) dx
+func owensT(h, a float64) float64 {
+	if a == 0 || math.IsInf(h, 0) {
+		return 0
+	}
+	if a < 0 {
+		return -owensT(h, -a)
+	}
+	h = math.Abs(h)
+	if a > 1 {
+		// Use the identity
+		//  T(h, a) = (Φ(h)Q(ah) + Φ(ah)Q(h))/2 - T(ah, 1/a)
+		// for h >= 0 and a > 0, where Q = 1-Φ.
+		ah := a * h
+		ph, qh := UnitNormal.CDF(h), UnitNormal.Survival(h)
+		pah, qah := UnitNormal.CDF(ah), UnitNormal.Survival(ah)
+		return (ph*qah+pah*qh)/2 - owensT(ah, 1/a)
+	}
+	f := func(x float64) float64 {
+		t := 1 + x*x
+		return math.Exp(-h*h*t/2) / t
+	}
+	return integrate(f, 0, a, 1e-17) / (2 * math.Pi)
+}
+
+// normLogCDFDeriv returns φ(t)/Φ(t), the derivative of the logarithm of the
+// standard normal cumulative distribution function at t.
+func normLogCDFDeriv(t float64) float64 {
+	if t > -5 {
+		return UnitNormal.Prob(t) / UnitNormal.CDF(t)
+	}
+	// Use the continued fraction for the Mills ratio of y = -t
+	//  Φ(t)/φ(t) = 1/(y+1/(y+2/(y+3/(y+...))))
+	// which avoids the underflow of Φ(t).
+	y := -t
+	var f float64
+	for k := 40; k >= 1; k-- {
+		f = float64(k) / (y + f)
+	}
+	return y + f
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (s SkewNormal) CDF(x float64) float64 {
+	z := (x - s.Mu) / s.Sigma
+	return UnitNormal.CDF(z) - 2*owensT(z, s.Alpha)
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (s SkewNormal) ExKurtosis() float64 {
+	d := s.delta()
+	m := d * math.Sqrt(2/math.Pi)
+	v := 1 - m*m
+	return 2 * (math.Pi - 3) * m * m * m * m / (v * v)
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates have no closed form and are found by Newton's method
+// starting from the method of moments estimates.
+// The likelihood may increase without bound with the magnitude of Alpha,
+// in particular for few samples, in which case Alpha is set to the largest
+// estimate found.
+// If the iteration does not converge, the parameters are set to the best
+// estimate found.
+func (s *SkewNormal) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mean := weightedMean(identity, samples, weights)
+	variance := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) }, samples, weights)
+	m3 := weightedMean(func(x float64) float64 { return (x - mean) * (x - mean) * (x - mean) }, samples, weights)
+
+	// The skewness of the distribution is less than 0.9953 in magnitude.
+	gamma := m3 / math.Pow(variance, 1.5)
+	gamma = math.Max(math.Min(gamma, 0.99), -0.99)
+	g := math.Pow(math.Abs(gamma), 2.0/3)
+	delta := math.Copysign(math.Sqrt(math.Pi/2*g/(g+math.Pow((4-math.Pi)/2, 2.0/3))), gamma)
+	b := math.Sqrt(2 / math.Pi)
+	sigma := math.Sqrt(variance / (1 - b*b*delta*delta))
+	if !(sigma > 0) {
+		sigma = 1
+		delta = 0
+	}
+	p := []float64{mean - sigma*b*delta, sigma, delta / math.Sqrt(1-delta*delta)}
+	maximizeLikelihood(p, mlProblem{
+		positive: []bool{false, true, false},
+		weight:   sumWeights(samples, weights),
+		logLik: func(p []float64) float64 {
+			return logLikelihood(SkewNormal{Mu: p[0], Sigma: p[1], Alpha: p[2]}.LogProb, samples, weights)
+		},
+		score: func(dst, p []float64) {
+			weightedScore(dst, SkewNormal{Mu: p[0], Sigma: p[1], Alpha: p[2]}.logProbGrad, samples, weights)
+		},
+		hess: func(dst *mat.SymDense, p []float64) {
+			weightedHessian(dst, SkewNormal{Mu: p[0], Sigma: p[1], Alpha: p[2]}.logProbHess, samples, weights)
+		},
+	})
+	s.Mu = p[0]
+	s.Sigma = p[1]
+	s.Alpha = p[2]
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Mu, Sigma, Alpha] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// The observed Fisher information is used since the expected information has
+// no simple closed form.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (s SkewNormal) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 3)
+	return observedStdErr(dst, []float64{s.Mu, s.Sigma, s.Alpha}, func(dst *mat.SymDense, p []float64) {
+		weightedHessian(dst, SkewNormal{Mu: p[0], Sigma: p[1], Alpha: p[2]}.logProbHess, samples, weights)
+	})
+}
+
+// logProbGrad stores into deriv the gradient of LogProb at x with respect to
+// [Mu, Sigma, Alpha].
+func (s SkewNormal) logProbGrad(deriv []float64, x float64) []float64 {
+	z := (x - s.Mu) / s.Sigma
+	q := normLogCDFDeriv(s.Alpha * z)
+	deriv[0] = (z - s.Alpha*q) / s.Sigma
+	deriv[1] = (z*z - 1 - s.Alpha*z*q) / s.Sigma
+	deriv[2] = z * q
+	return deriv
+}
+
+// logProbHess stores into hess the Hessian of LogProb at x with respect to
+// [Mu, Sigma, Alpha].
+func (s SkewNormal) logProbHess(hess *mat.SymDense, x float64) {
+	a := s.Alpha
+	z := (x - s.Mu) / s.Sigma
+	q := normLogCDFDeriv(a * z)
+	// dq is the derivative of q with respect to its argument.
+	dq := -q * (a*z + q)
+	s2 := s.Sigma * s.Sigma
+	hess.SetSym(0, 0, (a*a*dq-1)/s2)
+	hess.SetSym(0, 1, (a*a*dq*z-2*z+a*q)/s2)
+	hess.SetSym(0, 2, -(q+a*dq*z)/s.Sigma)
+	hess.SetSym(1, 1, (1-3*z*z+2*a*z*q+a*a*z*z*dq)/s2)
+	hess.SetSym(1, 2, -z*(q+a*z*dq)/s.Sigma)
+	hess.SetSym(2, 2, z*z*dq)
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (s SkewNormal) LogProb(x float64) float64 {
+	z := (x - s.Mu) / s.Sigma
+	return math.Ln2 - math.Log(s.Sigma) + UnitNormal.LogProb(z) + math.Log(UnitNormal.CDF(s.Alpha*z))
+}
+
+// Mean returns the mean of the probability distribution.
+func (s SkewNormal) Mean() float64 {
+	return s.Mu + s.Sigma*s.delta()*math.Sqrt(2/math.Pi)
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (SkewNormal) NumParameters() int {
+	return 3
+}
+
+// Prob computes the value of the probability density function at x.
+func (s SkewNormal) Prob(x float64) float64 {
+	return math.Exp(s.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (s SkewNormal) Quantile(p float64) float64 {
+	return continuousQuantile(p, s.CDF, s.Prob, math.Inf(-1), math.Inf(1), s.Mean())
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (s SkewNormal) Rand() float64 {
+	rnd := rand.NormFloat64
+	if s.Src != nil {
+		rnd = rand.New(s.Src).NormFloat64
+	}
+	d := s.delta()
+	u0 := rnd()
+	u1 := rnd()
+	return s.Mu + s.Sigma*(d*math.Abs(u0)+math.Sqrt(1-d*d)*u1)
+}
+
+// Skewness returns the skewness of the distribution.
+func (s SkewNormal) Skewness() float64 {
+	d := s.delta()
+	m := d * math.Sqrt(2/math.Pi)
+	v := 1 - m*m
+	return (4 - math.Pi) / 2 * m * m * m / math.Pow(v, 1.5)
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (s SkewNormal) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (s SkewNormal) Survival(x float64) float64 {
+	z := (x - s.Mu) / s.Sigma
+	return UnitNormal.Survival(z) + 2*owensT(z, s.Alpha)
+}
+
+// Variance returns the variance of the probability distribution.
+func (s SkewNormal) Variance() float64 {
+	d := s.delta()
+	return s.Sigma * s.Sigma * (1 - 2*d*d/math.Pi)
+}
@@ -0,0 +1,85 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestSkewNormalProbCDF(t *testing.T) {
+	const tol = 1e-10
+	for i, tt := range []struct {
+		x, mu, sigma, alpha float64
+		wantProb, wantCDF   float64
+	}{
+		// Values calculated by numerical integration of the density function.
+		{-1, 0, 1, 3, 0.0006532716094809995, 5.624443371187641e-05},
+		{0.5, 0, 1, 3, 0.6570896552387413, 0.38929437512197596},
+		{2, 0, 1, 3, 0.10798193291984248, 0.9544997361087316},
+		{-3, 1, 2, -4, 0.05399096651318803, 0.04550026389635841},
+		{0, 1, 2, -4, 0.3440557941260329, 0.6156503214118625},
+		{1.5, 1, 2, -4, 0.06134692825855436, 0.9845040628466769},
+		{-2.5, -2, 0.5, 0.5, 0.2986282071475212, 0.07252587168988611},
+		{-2, -2, 0.5, 0.5, 0.7978845608028654, 0.35241638234956457},
+		{-1, -2, 0.5, 0.5, 0.18170006404413366, 0.9599997120807836},
+	} {
+		s := SkewNormal{Mu: tt.mu, Sigma: tt.sigma, Alpha: tt.alpha}
+		prob := s.Prob(tt.x)
+		if !floats.EqualWithinAbsOrRel(prob, tt.wantProb, tol, tol) {
+			t.Errorf("Prob mismatch case %d: got %v, want %v", i, prob, tt.wantProb)
+		}
+		cdf := s.CDF(tt.x)
+		if !floats.EqualWithinAbsOrRel(cdf, tt.wantCDF, tol, tol) {
+			t.Errorf("CDF mismatch case %d: got %v, want %v", i, cdf, tt.wantCDF)
+		}
+	}
+
+	// With α = 1 the distribution function is Φ(x)^2.
+	s := SkewNormal{Mu: 0, Sigma: 1, Alpha: 1}
+	for _, x := range []float64{-3, -0.5, 0, 1, 4} {
+		want := math.Pow(UnitNormal.CDF(x), 2)
+		if !floats.EqualWithinAbsOrRel(s.CDF(x), want, 1e-14, 1e-14) {
+			t.Errorf("CDF mismatch for α = 1 at %v: got %v, want %v", x, s.CDF(x), want)
+		}
+	}
+}
+
+func TestSkewNormal(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, s := range []SkewNormal{
+		{0, 1, 0, src},
+		{0, 1, 3, src},
+		{1, 2, -4, src},
+		{-2, 0.5, 0.5, src},
+	} {
+		testSkewNormal(t, s, i)
+	}
+}
+
+func testSkewNormal(t *testing.T, s SkewNormal, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, s)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, math.Inf(-1), x, s, tol, bins)
+	checkProbContinuous(t, i, x, s, 1e-10)
+	checkMean(t, i, x, s, tol)
+	checkVarAndStd(t, i, x, s, tol)
+	checkExKurtosis(t, i, x, s, 1e-1)
+	checkSkewness(t, i, x, s, 5e-2)
+	checkQuantileCDFSurvival(t, i, x, s, tol)
+	checkProbQuantContinuous(t, i, x, s, tol)
+}
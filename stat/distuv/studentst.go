@@ -9,6 +9,7 @@ import (
 
 	"golang.org/x/exp/rand"
 
+	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/gonum/mathext"
 )
 
@@ -61,6 +62,93 @@ func (s StudentsT) CDF(x float64) float64 {
 	return 0.5 * mathext.RegIncBeta(s.Nu/2, 0.5, t)
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates have no closed form and are found by Newton's method
+// starting from the weighted median and median absolute deviation of the
+// samples.
+// If the iteration does not converge, the parameters are set to the best
+// estimate found.
+func (s *StudentsT) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	mu := weightedQuantile(0.5, samples, weights)
+	dev := make([]float64, len(samples))
+	for i, x := range samples {
+		dev[i] = math.Abs(x - mu)
+	}
+	// 1.4826 is the ratio of the standard deviation to the median absolute
+	// deviation of a normal distribution.
+	sigma := 1.4826 * weightedQuantile(0.5, dev, weights)
+	if sigma == 0 {
+		sigma = 1
+	}
+	p := []float64{mu, sigma, 5}
+	maximizeLikelihood(p, mlProblem{
+		positive: []bool{false, true, true},
+		weight:   sumWeights(samples, weights),
+		logLik: func(p []float64) float64 {
+			return logLikelihood(StudentsT{Mu: p[0], Sigma: p[1], Nu: p[2]}.LogProb, samples, weights)
+		},
+		score: func(dst, p []float64) {
+			weightedScore(dst, StudentsT{Mu: p[0], Sigma: p[1], Nu: p[2]}.logProbGrad, samples, weights)
+		},
+		hess: func(dst *mat.SymDense, p []float64) {
+			weightedHessian(dst, StudentsT{Mu: p[0], Sigma: p[1], Nu: p[2]}.logProbHess, samples, weights)
+		},
+	})
+	s.Mu = p[0]
+	s.Sigma = p[1]
+	s.Nu = p[2]
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Mu, Sigma, Nu] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// The observed Fisher information is used since the expected information has
+// no simple closed form.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (s StudentsT) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 3)
+	return observedStdErr(dst, []float64{s.Mu, s.Sigma, s.Nu}, func(dst *mat.SymDense, p []float64) {
+		weightedHessian(dst, StudentsT{Mu: p[0], Sigma: p[1], Nu: p[2]}.logProbHess, samples, weights)
+	})
+}
+
+// logProbGrad stores into deriv the gradient of LogProb at x with respect to
+// [Mu, Sigma, Nu].
+func (s StudentsT) logProbGrad(deriv []float64, x float64) []float64 {
+	// With r = x-μ and q = νσ²+r², the log density is
+	//  log Γ((ν+1)/2) - log Γ(ν/2) - log(π)/2 + ν log(ν)/2 + ν log(σ) - (ν+1) log(q)/2
+	mu, sigma, nu := s.Mu, s.Sigma, s.Nu
+	r := x - mu
+	q := nu*sigma*sigma + r*r
+	deriv[0] = (nu + 1) * r / q
+	deriv[1] = nu/sigma - nu*(nu+1)*sigma/q
+	deriv[2] = 0.5*(mathext.Digamma((nu+1)/2)-mathext.Digamma(nu/2)+math.Log(nu)+1-math.Log(q)) + math.Log(sigma) - (nu+1)*sigma*sigma/(2*q)
+	return deriv
+}
+
+// logProbHess stores into hess the Hessian of LogProb at x with respect to
+// [Mu, Sigma, Nu].
+func (s StudentsT) logProbHess(hess *mat.SymDense, x float64) {
+	mu, sigma, nu := s.Mu, s.Sigma, s.Nu
+	r := x - mu
+	s2 := sigma * sigma
+	q := nu*s2 + r*r
+	q2 := q * q
+	hess.SetSym(0, 0, (nu+1)*(2*r*r-q)/q2)
+	hess.SetSym(0, 1, -2*nu*(nu+1)*sigma*r/q2)
+	hess.SetSym(0, 2, r/q-(nu+1)*r*s2/q2)
+	hess.SetSym(1, 1, -nu/s2-nu*(nu+1)*(q-2*nu*s2)/q2)
+	hess.SetSym(1, 2, 1/sigma-(2*nu+1)*sigma/q+nu*(nu+1)*sigma*s2/q2)
+	hess.SetSym(2, 2, 0.25*(trigamma((nu+1)/2)-trigamma(nu/2))+1/(2*nu)-s2/q+(nu+1)*s2*s2/(2*q2))
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x.
 func (s StudentsT) LogProb(x float64) float64 {
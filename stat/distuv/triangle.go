@@ -8,6 +8,8 @@ import (
 	"math"
 
 	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/stat"
 )
 
 // Triangle represents a triangle distribution (https://en.wikipedia.org/wiki/Triangular_distribution).
@@ -62,7 +64,86 @@ func (Triangle) ExKurtosis() float64 {
 	return -3.0 / 5.0
 }
 
-// Fit is not appropriate for Triangle, because the distribution is generally used when there is little data.
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The limits a and b are not estimated, and all samples with non-zero weight
+// must lie within them. The estimate of the mode c is the sample that
+// maximizes the likelihood, which is always attained at one of the samples.
+func (t *Triangle) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	x := make([]float64, 0, len(samples))
+	w := make([]float64, 0, len(samples))
+	for i, v := range samples {
+		wi := weightAt(weights, i)
+		if wi == 0 {
+			continue
+		}
+		if v < t.a || t.b < v {
+			panic("triangle: sample out of bounds")
+		}
+		x = append(x, v)
+		w = append(w, wi)
+	}
+	if len(x) == 0 {
+		panic(badNoSamples)
+	}
+	stat.SortWeighted(x, w)
+
+	// The log-likelihood for the mode at c is, up to a constant,
+	//  \sum_{x_i < c} w_i (log(x_i-a) - log(c-a)) + \sum_{x_i > c} w_i (log(b-x_i) - log(b-c))
+	// and is evaluated for all candidate modes using cumulative sums over
+	// the sorted samples.
+	n := len(x)
+	logLeft := make([]float64, n+1)
+	sumLeft := make([]float64, n+1)
+	for i := 0; i < n; i++ {
+		logLeft[i+1] = logLeft[i] + w[i]*math.Log(x[i]-t.a)
+		sumLeft[i+1] = sumLeft[i] + w[i]
+	}
+	logRight := make([]float64, n+1)
+	sumRight := make([]float64, n+1)
+	for i := n - 1; i >= 0; i-- {
+		logRight[i] = logRight[i+1] + w[i]*math.Log(t.b-x[i])
+		sumRight[i] = sumRight[i+1] + w[i]
+	}
+	best := math.Inf(-1)
+	for lo := 0; lo < n; {
+		c := x[lo]
+		hi := lo + 1
+		for hi < n && x[hi] == c {
+			hi++
+		}
+		var ll float64
+		if sumLeft[lo] > 0 {
+			ll += logLeft[lo] - sumLeft[lo]*math.Log(c-t.a)
+		}
+		if sumRight[hi] > 0 {
+			ll += logRight[hi] - sumRight[hi]*math.Log(t.b-c)
+		}
+		if ll > best {
+			best = ll
+			t.c = c
+		}
+		lo = hi
+	}
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [c] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (t Triangle) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 1)
+	// The Fisher information for the mode of a single sample is
+	//  1 / ((c-a)(b-c))
+	dst[0] = math.Sqrt((t.c - t.a) * (t.b - t.c) / sumWeights(samples, weights))
+	return dst
+}
 
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (t Triangle) LogProb(x float64) float64 {
@@ -45,7 +45,42 @@ func (Uniform) ExKurtosis() float64 {
 	return -6.0 / 5.0
 }
 
-// Uniform doesn't have Fit because it's a bad idea to fit a uniform from data.
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates of Min and Max are the smallest and largest samples with
+// non-zero weight.
+func (u *Uniform) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	min := math.Inf(1)
+	max := math.Inf(-1)
+	for i, x := range samples {
+		if weightAt(weights, i) == 0 {
+			continue
+		}
+		min = math.Min(min, x)
+		max = math.Max(max, x)
+	}
+	u.Min = min
+	u.Max = max
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Min, Max] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// The support of the distribution depends on both parameters so it has no
+// Fisher information and the standard errors are NaN.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (u Uniform) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	dst[0] = math.NaN()
+	dst[1] = math.NaN()
+	return dst
+}
 
 // LogProb computes the natural logarithm of the value of the probability density function at x.
 func (u Uniform) LogProb(x float64) float64 {
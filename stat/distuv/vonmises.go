@@ -0,0 +1,237 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// VonMises implements the von Mises distribution, a continuous distribution on
+// the circle that is a close approximation to the wrapped normal distribution.
+// The distribution is represented with support over [Mu-π, Mu+π].
+//
+// The von Mises distribution has density function
+//  e^(κ cos(x-μ)) / (2π I_0(κ))
+// where I_0 is the modified Bessel function of the first kind of order 0.
+//
+// For more information, see https://en.wikipedia.org/wiki/Von_Mises_distribution.
+type VonMises struct {
+	// Mu is the location of the distribution.
+	Mu float64
+	// Kappa is the concentration of the distribution. Kappa must be greater
+	// than or equal to 0.
+	Kappa float64
+
+	Src rand.Source
+}
+
+// fourierSine returns the periodic component of the CDF at the centered
+// angle theta.
+func (v VonMises) fourierSine(theta float64) float64 {
+	var sum float64
+	for j, rho := range besselRatios(v.Kappa) {
+		k := float64(j + 1)
+		sum += rho * math.Sin(k*theta) / k
+	}
+	return sum / math.Pi
+}
+
+// CDF computes the value of the cumulative distribution function at x.
+func (v VonMises) CDF(x float64) float64 {
+	theta := x - v.Mu
+	if theta <= -math.Pi {
+		return 0
+	}
+	if theta >= math.Pi {
+		return 1
+	}
+	// Integrate the Fourier series of the density
+	//  f(θ) = 1/(2π) (1 + 2 \sum_j I_j(κ)/I_0(κ) cos(jθ))
+	// term by term.
+	return (theta+math.Pi)/(2*math.Pi) + v.fourierSine(theta)
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (v VonMises) Entropy() float64 {
+	var rho1 float64
+	if rho := besselRatios(v.Kappa); len(rho) > 0 {
+		rho1 = rho[0]
+	}
+	return -v.Kappa*rho1 + math.Log(2*math.Pi*besselIe(0, v.Kappa)) + v.Kappa
+}
+
+// ExKurtosis returns the excess kurtosis of the distribution.
+func (v VonMises) ExKurtosis() float64 {
+	// The fourth central moment is
+	//  E[θ^4] = π^4/5 + \sum_j (-1)^j I_j(κ)/I_0(κ) (8π^2/j^2 - 48/j^4)
+	m4 := math.Pow(math.Pi, 4) / 5
+	for j, rho := range besselRatios(v.Kappa) {
+		k := float64(j + 1)
+		t := rho * (8*math.Pi*math.Pi/(k*k) - 48/(k*k*k*k))
+		if (j+1)%2 == 1 {
+			t = -t
+		}
+		m4 += t
+	}
+	variance := v.Variance()
+	return m4/(variance*variance) - 3
+}
+
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The samples are angles in radians. The estimate of Mu is the direction of
+// the weighted mean resultant vector of the samples, in [-π, π], and the
+// estimate of Kappa solves I_1(κ)/I_0(κ) = R, where R is the length of the
+// mean resultant vector, and is found by Newton's method.
+func (v *VonMises) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	c := weightedMean(math.Cos, samples, weights)
+	s := weightedMean(math.Sin, samples, weights)
+	v.Mu = math.Atan2(s, c)
+	v.Kappa = fitVonMisesKappa(math.Hypot(c, s))
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [Mu, Kappa] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (v VonMises) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	// The Fisher information for a single sample is
+	//  [κA(κ)  0    ]
+	//  [0      A'(κ)]
+	// where A(κ) = I_1(κ)/I_0(κ).
+	sumW := sumWeights(samples, weights)
+	a := besselRatio(v.Kappa)
+	dst[0] = 1 / math.Sqrt(v.Kappa*a*sumW)
+	dst[1] = 1 / math.Sqrt(besselRatioDeriv(v.Kappa, a)*sumW)
+	return dst
+}
+
+// LogProb computes the natural logarithm of the value of the probability
+// density function at x.
+func (v VonMises) LogProb(x float64) float64 {
+	theta := x - v.Mu
+	if theta < -math.Pi || math.Pi < theta {
+		return math.Inf(-1)
+	}
+	return v.Kappa*(math.Cos(theta)-1) - math.Log(2*math.Pi*besselIe(0, v.Kappa))
+}
+
+// Mean returns the mean of the probability distribution.
+func (v VonMises) Mean() float64 {
+	return v.Mu
+}
+
+// Median returns the median of the probability distribution.
+func (v VonMises) Median() float64 {
+	return v.Mu
+}
+
+// Mode returns the mode of the probability distribution.
+func (v VonMises) Mode() float64 {
+	return v.Mu
+}
+
+// NumParameters returns the number of parameters in the distribution.
+func (VonMises) NumParameters() int {
+	return 2
+}
+
+// Prob computes the value of the probability density function at x.
+func (v VonMises) Prob(x float64) float64 {
+	return math.Exp(v.LogProb(x))
+}
+
+// Quantile returns the inverse of the cumulative distribution function.
+func (v VonMises) Quantile(p float64) float64 {
+	return continuousQuantile(p, v.CDF, v.Prob, v.Mu-math.Pi, v.Mu+math.Pi, v.Mu)
+}
+
+// Rand returns a random sample drawn from the distribution.
+func (v VonMises) Rand() float64 {
+	rnd := rand.Float64
+	if v.Src != nil {
+		rnd = rand.New(v.Src).Float64
+	}
+	if v.Kappa == 0 {
+		return v.Mu + math.Pi*(2*rnd()-1)
+	}
+
+	// Use the rejection sampler of Best and Fisher.
+	//
+	// Best, D. J. and Fisher, N. I. "Efficient simulation of the von Mises
+	// distribution." Applied Statistics 28 (1979): 152-157.
+	var s float64
+	if v.Kappa < 1e-5 {
+		s = 1/v.Kappa + v.Kappa
+	} else {
+		r := 1 + math.Sqrt(1+4*v.Kappa*v.Kappa)
+		rho := (r - math.Sqrt(2*r)) / (2 * v.Kappa)
+		s = (1 + rho*rho) / (2 * rho)
+	}
+	var w float64
+	for {
+		z := math.Cos(math.Pi * rnd())
+		w = (1 + s*z) / (s + z)
+		y := v.Kappa * (s - w)
+		u := rnd()
+		if y*(2-y)-u >= 0 || math.Log(y/u)+1-y >= 0 {
+			break
+		}
+	}
+	theta := math.Acos(math.Max(-1, math.Min(1, w)))
+	if rnd() < 0.5 {
+		theta = -theta
+	}
+	return v.Mu + theta
+}
+
+// Skewness returns the skewness of the distribution.
+func (VonMises) Skewness() float64 {
+	return 0
+}
+
+// StdDev returns the standard deviation of the probability distribution.
+func (v VonMises) StdDev() float64 {
+	return math.Sqrt(v.Variance())
+}
+
+// Survival returns the survival function (complementary CDF) at x.
+func (v VonMises) Survival(x float64) float64 {
+	theta := x - v.Mu
+	if theta <= -math.Pi {
+		return 1
+	}
+	if theta >= math.Pi {
+		return 0
+	}
+	return (math.Pi-theta)/(2*math.Pi) - v.fourierSine(theta)
+}
+
+// Variance returns the variance of the probability distribution.
+//
+// The variance is that of the distribution on the interval [Mu-π, Mu+π]
+// rather than the circular variance.
+func (v VonMises) Variance() float64 {
+	//  E[θ^2] = π^2/3 + 4 \sum_j (-1)^j I_j(κ)/I_0(κ) / j^2
+	variance := math.Pi * math.Pi / 3
+	for j, rho := range besselRatios(v.Kappa) {
+		k := float64(j + 1)
+		t := 4 * rho / (k * k)
+		if (j+1)%2 == 1 {
+			t = -t
+		}
+		variance += t
+	}
+	return variance
+}
@@ -0,0 +1,82 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distuv
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestVonMisesProbCDF(t *testing.T) {
+	const tol = 1e-10
+	for i, tt := range []struct {
+		x, mu, kappa      float64
+		wantProb, wantCDF float64
+	}{
+		// Values calculated by numerical integration of the density function.
+		{-2, 0.5, 2, 0.014063706052155362, 0.006985005694538294},
+		{0.5, 0.5, 2, 0.5158854120190137, 0.5},
+		{1, 0.5, 2, 0.40385253335183785, 0.7381922144185288},
+		{3, 0.5, 2, 0.014063706052155362, 0.9930149943054495},
+		{-3, -1, 0.3, 0.13736745286092591, 0.14010539565318425},
+		{0, -1, 0.3, 0.18301977657370547, 0.7004979433128193},
+		{1.5, -1, 0.3, 0.1223836079688369, 0.9245081464362346},
+		{-0.3, 0, 20, 0.7255990335302568, 0.09209425333177727},
+		{0.1, 0, 20, 1.6041528708302621, 0.6715383728108472},
+		{0.5, 0, 20, 0.1532267763115872, 0.9860337462809022},
+		{1, 0, 0, 1 / (2 * math.Pi), (1 + math.Pi) / (2 * math.Pi)},
+		{4, 0, 1, 0, 1},
+	} {
+		v := VonMises{Mu: tt.mu, Kappa: tt.kappa}
+		prob := v.Prob(tt.x)
+		if !floats.EqualWithinAbsOrRel(prob, tt.wantProb, tol, tol) {
+			t.Errorf("Prob mismatch case %d: got %v, want %v", i, prob, tt.wantProb)
+		}
+		cdf := v.CDF(tt.x)
+		if !floats.EqualWithinAbsOrRel(cdf, tt.wantCDF, tol, tol) {
+			t.Errorf("CDF mismatch case %d: got %v, want %v", i, cdf, tt.wantCDF)
+		}
+	}
+}
+
+func TestVonMises(t *testing.T) {
+	src := rand.New(rand.NewSource(1))
+	for i, v := range []VonMises{
+		{0, 0, src},
+		{0.5, 2, src},
+		{-1, 0.3, src},
+		{3, 20, src},
+		{0, 500, src},
+	} {
+		testVonMises(t, v, i)
+	}
+}
+
+func testVonMises(t *testing.T, v VonMises, i int) {
+	const (
+		tol  = 1e-2
+		n    = 1e5
+		bins = 50
+	)
+	x := make([]float64, n)
+	generateSamples(x, v)
+	sort.Float64s(x)
+
+	testRandLogProbContinuous(t, i, v.Mu-math.Pi, x, v, tol, bins)
+	checkProbContinuous(t, i, x, v, 1e-4)
+	checkMean(t, i, x, v, tol)
+	checkMedian(t, i, x, v, tol)
+	checkVarAndStd(t, i, x, v, tol)
+	checkEntropy(t, i, x, v, tol)
+	checkExKurtosis(t, i, x, v, 5e-2)
+	checkSkewness(t, i, x, v, 2e-2)
+	checkQuantileCDFSurvival(t, i, x, v, tol)
+	checkProbQuantContinuous(t, i, x, v, tol)
+}
@@ -54,6 +54,67 @@ func (w Weibull) LogCDF(x float64) complex128 {
 	return cmplx.Log(-1) + complex(-math.Pow(x/w.Lambda, w.K), 0)
 }
 
+// Fit sets the parameters of the probability distribution from the
+// data samples x with relative weights w.
+// If weights is nil, then all the weights are 1.
+// If weights is not nil, then the len(weights) must equal len(samples).
+//
+// The estimates have no closed form and are found by the BFGS method using
+// the Score of the samples, starting from the method of moments estimates
+// for the logarithm of the samples.
+// If the iteration does not converge, the parameters are set to the best
+// estimate found.
+func (w *Weibull) Fit(samples, weights []float64) {
+	checkFitInput(samples, weights)
+	meanLog := weightedMean(math.Log, samples, weights)
+	varLog := weightedMean(func(x float64) float64 {
+		d := math.Log(x) - meanLog
+		return d * d
+	}, samples, weights)
+
+	// The logarithm of a Weibull random variable follows a Gumbel
+	// distribution for the minimum with scale 1/K.
+	k := math.Pi / math.Sqrt(6*varLog)
+	if math.IsInf(k, 0) || math.IsNaN(k) {
+		k = 1
+	}
+	p := []float64{k, math.Exp(meanLog + eulerMascheroni/k)}
+	maximizeLikelihood(p, mlProblem{
+		positive: []bool{true, true},
+		weight:   sumWeights(samples, weights),
+		logLik: func(p []float64) float64 {
+			return logLikelihood(Weibull{K: p[0], Lambda: p[1]}.LogProb, samples, weights)
+		},
+		score: func(dst, p []float64) {
+			weightedScore(dst, Weibull{K: p[0], Lambda: p[1]}.Score, samples, weights)
+		},
+	})
+	w.K = p[0]
+	w.Lambda = p[1]
+}
+
+// FitStdErr returns the asymptotic standard errors of the maximum likelihood
+// estimates of [K, Lambda] for the samples with relative weights,
+// computed from the Fisher information at the current parameters.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated.
+func (w Weibull) FitStdErr(dst, samples, weights []float64) []float64 {
+	checkFitInput(samples, weights)
+	dst = useFitDst(dst, 2)
+	// The Fisher information for a single sample is
+	//  [((1-γ)² + π²/6)/k²   -(1-γ)/λ ]
+	//  [-(1-γ)/λ             k²/λ²    ]
+	// where γ is the Euler-Mascheroni constant.
+	c := 1 - eulerMascheroni
+	ikk := (c*c + math.Pi*math.Pi/6) / (w.K * w.K)
+	ikl := -c / w.Lambda
+	ill := w.K * w.K / (w.Lambda * w.Lambda)
+	d := (ikk*ill - ikl*ikl) * sumWeights(samples, weights)
+	dst[0] = math.Sqrt(ill / d)
+	dst[1] = math.Sqrt(ikk / d)
+	return dst
+}
+
 // LogProb computes the natural logarithm of the value of the probability
 // density function at x. Zero is returned if x is less than zero.
 //